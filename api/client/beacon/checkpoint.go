@@ -1,20 +1,25 @@
 package beacon
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
 	"path"
 
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/pkg/errors"
 	base "github.com/prysmaticlabs/prysm/v4/api/client"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/cache/depositsnapshot"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/rpc/eth/shared"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/state"
 	"github.com/prysmaticlabs/prysm/v4/consensus-types/interfaces"
 	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/v4/encoding/bytesutil"
 	"github.com/prysmaticlabs/prysm/v4/encoding/ssz/detect"
 	"github.com/prysmaticlabs/prysm/v4/io/file"
+	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/v4/runtime/version"
 	"github.com/prysmaticlabs/prysm/v4/time/slots"
 	log "github.com/sirupsen/logrus"
@@ -23,6 +28,15 @@ import (
 
 var errCheckpointBlockMismatch = errors.New("mismatch between checkpoint sync state and block")
 
+var (
+	// ErrDepositSnapshotAhead is returned by VerifyDepositSnapshot for a snapshot finalizing deposits which the
+	// state has not processed yet.
+	ErrDepositSnapshotAhead = errors.New("deposit snapshot is ahead of the finalized state")
+	// ErrDepositSnapshotRootMismatch is returned by VerifyDepositSnapshot for a snapshot whose deposit root differs
+	// from the deposit root of the state's eth1_data for the same deposits.
+	ErrDepositSnapshotRootMismatch = errors.New("deposit snapshot root does not match the eth1 data of the finalized state")
+)
+
 // OriginData represents the BeaconState and ReadOnlySignedBeaconBlock necessary to start an empty Beacon Node
 // using Checkpoint Sync.
 type OriginData struct {
@@ -33,6 +47,7 @@ type OriginData struct {
 	vu *detect.VersionedUnmarshaler
 	br [32]byte
 	sr [32]byte
+	ds *ethpb.DepositSnapshot
 }
 
 // SaveBlock saves the downloaded block to a unique file in the given path.
@@ -49,6 +64,31 @@ func (o *OriginData) SaveState(dir string) (string, error) {
 	return statePath, file.WriteFile(statePath, o.StateBytes())
 }
 
+// SaveDepositSnapshot saves the downloaded deposit snapshot, json encoded as served by the beacon node api, to a
+// unique file in the given path. The file name includes the config name and the deposit count of the snapshot.
+func (o *OriginData) SaveDepositSnapshot(dir string) (string, error) {
+	if o.ds == nil {
+		return "", errors.New("no deposit snapshot was downloaded")
+	}
+	b, err := json.Marshal(&depositSnapshotResponse{Data: shared.DepositSnapshotFromConsensus(o.ds)})
+	if err != nil {
+		return "", errors.Wrap(err, "could not marshal deposit snapshot")
+	}
+	snapshotPath := path.Join(dir, fmt.Sprintf("deposit-snapshot_%s_%d.json", o.vu.Config.ConfigName, o.ds.DepositCount))
+	return snapshotPath, file.WriteFile(snapshotPath, b)
+}
+
+// DepositSnapshot returns the downloaded deposit snapshot, which is nil if the remote beacon node did not serve
+// a snapshot usable with the downloaded BeaconState.
+func (o *OriginData) DepositSnapshot() *ethpb.DepositSnapshot {
+	return o.ds
+}
+
+// State returns the downloaded BeaconState value.
+func (o *OriginData) State() state.BeaconState {
+	return o.st
+}
+
 // StateBytes returns the ssz-encoded bytes of the downloaded BeaconState value.
 func (o *OriginData) StateBytes() []byte {
 	return o.sb
@@ -106,6 +146,10 @@ func DownloadFinalizedData(ctx context.Context, client *Client) (*OriginData, er
 	if err != nil {
 		return nil, errors.Wrapf(err, "failed to compute htr for finalized state at slot=%d", s.Slot())
 	}
+	ds, err := downloadDepositSnapshot(ctx, client, s)
+	if err != nil {
+		return nil, err
+	}
 
 	log.
 		WithField("block_slot", b.Block().Slot()).
@@ -121,9 +165,55 @@ func DownloadFinalizedData(ctx context.Context, client *Client) (*OriginData, er
 		vu: vu,
 		br: br,
 		sr: sr,
+		ds: ds,
 	}, nil
 }
 
+// downloadDepositSnapshot requests the deposit snapshot from the remote beacon node, so that the new node only needs
+// to process the deposit logs following it. Serving the snapshot is optional, so a missing snapshot is not an error.
+// A snapshot which is ahead of the finalized state is discarded, see VerifyDepositSnapshot.
+func downloadDepositSnapshot(ctx context.Context, client *Client, st state.BeaconState) (*ethpb.DepositSnapshot, error) {
+	ds, err := client.GetDepositSnapshot(ctx)
+	if err != nil {
+		if errors.Is(err, base.ErrNotOK) {
+			log.WithError(err).Warn("Remote beacon node did not provide a deposit snapshot")
+			return nil, nil
+		}
+		return nil, err
+	}
+	if err := VerifyDepositSnapshot(ds, st); err != nil {
+		if errors.Is(err, ErrDepositSnapshotAhead) {
+			log.WithError(err).Warn("Ignoring deposit snapshot")
+			return nil, nil
+		}
+		return nil, err
+	}
+	log.
+		WithField("deposit_count", ds.DepositCount).
+		WithField("execution_block_hash", hexutil.Encode(ds.ExecutionHash)).
+		WithField("execution_block_height", ds.ExecutionDepth).
+		Info("Downloaded deposit snapshot.")
+	return ds, nil
+}
+
+// VerifyDepositSnapshot checks that a deposit snapshot is well formed and can be used along with the given
+// finalized state. A snapshot finalizing deposits not yet processed by the state is rejected with
+// ErrDepositSnapshotAhead, as the proofs of these deposits are needed to propose blocks. A snapshot covering
+// the deposits of the state's eth1_data must have its deposit root.
+func VerifyDepositSnapshot(ds *ethpb.DepositSnapshot, st state.ReadOnlyBeaconState) error {
+	if _, err := depositsnapshot.DepositTreeFromSnapshotProto(ds); err != nil {
+		return errors.Wrap(err, "invalid deposit snapshot")
+	}
+	if ds.DepositCount > st.Eth1DepositIndex() {
+		return errors.Wrapf(ErrDepositSnapshotAhead, "deposit count %d, eth1 deposit index %d", ds.DepositCount, st.Eth1DepositIndex())
+	}
+	eth1Data := st.Eth1Data()
+	if eth1Data != nil && ds.DepositCount == eth1Data.DepositCount && !bytes.Equal(ds.DepositRoot, eth1Data.DepositRoot) {
+		return errors.Wrapf(ErrDepositSnapshotRootMismatch, "snapshot root %#x, eth1 data root %#x", ds.DepositRoot, eth1Data.DepositRoot)
+	}
+	return nil
+}
+
 // WeakSubjectivityData represents the state root, block root and epoch of the BeaconState + ReadOnlySignedBeaconBlock
 // that falls at the beginning of the current weak subjectivity period. These values can be used to construct
 // a weak subjectivity checkpoint beacon node flag to be used for validation.
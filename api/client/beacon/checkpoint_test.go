@@ -10,6 +10,8 @@ import (
 	"testing"
 
 	"github.com/prysmaticlabs/prysm/v4/api/client"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/cache/depositsnapshot"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/rpc/eth/shared"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/state"
 	"github.com/prysmaticlabs/prysm/v4/consensus-types/blocks"
 	blocktest "github.com/prysmaticlabs/prysm/v4/consensus-types/blocks/testing"
@@ -20,6 +22,7 @@ import (
 
 	"github.com/prysmaticlabs/prysm/v4/config/params"
 	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v4/encoding/bytesutil"
 	"github.com/prysmaticlabs/prysm/v4/encoding/ssz/detect"
 	"github.com/prysmaticlabs/prysm/v4/runtime/version"
 
@@ -481,3 +484,72 @@ func TestDownloadFinalizedData(t *testing.T) {
 	require.Equal(t, expected.br, od.br)
 	require.Equal(t, expected.sr, od.sr)
 }
+
+func TestDownloadDepositSnapshot(t *testing.T) {
+	ctx := context.Background()
+	tree := depositsnapshot.NewDepositTree()
+	for i := 0; i < 3; i++ {
+		require.NoError(t, tree.Insert(bytesutil.PadTo([]byte{byte(i + 1)}, 32), i))
+	}
+	require.NoError(t, tree.Finalize(2, [32]byte{'a'}, 100))
+	snapshot, err := tree.ToProto()
+	require.NoError(t, err)
+	body, err := json.Marshal(&depositSnapshotResponse{Data: shared.DepositSnapshotFromConsensus(snapshot)})
+	require.NoError(t, err)
+
+	newClient := func(status int, body []byte) *Client {
+		trans := &testRT{rt: func(req *http.Request) (*http.Response, error) {
+			res := &http.Response{Request: req}
+			if req.URL.Path == getDepositSnapshotPath {
+				res.StatusCode = status
+				res.Body = io.NopCloser(bytes.NewBuffer(body))
+			} else {
+				res.StatusCode = http.StatusInternalServerError
+				res.Body = io.NopCloser(bytes.NewBufferString(""))
+			}
+			return res, nil
+		}}
+		c, err := NewClient("http://localhost:3500", client.WithRoundTripper(trans))
+		require.NoError(t, err)
+		return c
+	}
+	st, err := util.NewBeaconState()
+	require.NoError(t, err)
+
+	t.Run("ok", func(t *testing.T) {
+		require.NoError(t, st.SetEth1DepositIndex(3))
+		ds, err := downloadDepositSnapshot(ctx, newClient(http.StatusOK, body), st)
+		require.NoError(t, err)
+		require.DeepEqual(t, snapshot, ds)
+	})
+	t.Run("ahead of finalized state", func(t *testing.T) {
+		require.NoError(t, st.SetEth1DepositIndex(2))
+		ds, err := downloadDepositSnapshot(ctx, newClient(http.StatusOK, body), st)
+		require.NoError(t, err)
+		require.Equal(t, true, ds == nil)
+	})
+	t.Run("root mismatch", func(t *testing.T) {
+		require.NoError(t, st.SetEth1DepositIndex(3))
+		require.NoError(t, st.SetEth1Data(&ethpb.Eth1Data{DepositCount: 3, DepositRoot: make([]byte, 32), BlockHash: make([]byte, 32)}))
+		_, err := downloadDepositSnapshot(ctx, newClient(http.StatusOK, body), st)
+		require.ErrorIs(t, err, ErrDepositSnapshotRootMismatch)
+
+		require.NoError(t, st.SetEth1Data(&ethpb.Eth1Data{DepositCount: 3, DepositRoot: snapshot.DepositRoot, BlockHash: make([]byte, 32)}))
+		ds, err := downloadDepositSnapshot(ctx, newClient(http.StatusOK, body), st)
+		require.NoError(t, err)
+		require.DeepEqual(t, snapshot, ds)
+	})
+	t.Run("not served", func(t *testing.T) {
+		ds, err := downloadDepositSnapshot(ctx, newClient(http.StatusNotFound, nil), st)
+		require.NoError(t, err)
+		require.Equal(t, true, ds == nil)
+	})
+	t.Run("invalid root", func(t *testing.T) {
+		invalid := shared.DepositSnapshotFromConsensus(snapshot)
+		invalid.DepositCount = "2"
+		b, err := json.Marshal(&depositSnapshotResponse{Data: invalid})
+		require.NoError(t, err)
+		_, err = downloadDepositSnapshot(ctx, newClient(http.StatusOK, b), st)
+		require.ErrorContains(t, "invalid deposit snapshot", err)
+	})
+}
@@ -32,6 +32,7 @@ const (
 	getBlockRootPath         = "/eth/v1/beacon/blocks/{{.Id}}/root"
 	getForkForStatePath      = "/eth/v1/beacon/states/{{.Id}}/fork"
 	getWeakSubjectivityPath  = "/eth/v1/beacon/weak_subjectivity"
+	getDepositSnapshotPath   = "/eth/v1/beacon/deposit_snapshot"
 	getForkSchedulePath      = "/eth/v1/config/fork_schedule"
 	getConfigSpecPath        = "/eth/v1/config/spec"
 	getStatePath             = "/eth/v2/debug/beacon/states"
@@ -282,6 +283,27 @@ func (c *Client) GetWeakSubjectivity(ctx context.Context) (*WeakSubjectivityData
 	}, nil
 }
 
+// GetDepositSnapshot retrieves the EIP-4881 snapshot of the finalized deposit tree from the beacon node api.
+func (c *Client) GetDepositSnapshot(ctx context.Context) (*ethpb.DepositSnapshot, error) {
+	body, err := c.Get(ctx, getDepositSnapshotPath)
+	if err != nil {
+		return nil, errors.Wrap(err, "error requesting deposit snapshot")
+	}
+	return UnmarshalDepositSnapshot(body)
+}
+
+// UnmarshalDepositSnapshot parses a deposit snapshot encoded as the json response of the deposit snapshot api.
+func UnmarshalDepositSnapshot(b []byte) (*ethpb.DepositSnapshot, error) {
+	resp := &depositSnapshotResponse{}
+	if err := json.Unmarshal(b, resp); err != nil {
+		return nil, errors.Wrap(err, "error unmarshaling deposit snapshot response")
+	}
+	if resp.Data == nil {
+		return nil, errors.New("deposit snapshot response is missing data")
+	}
+	return resp.Data.ToConsensus()
+}
+
 // SubmitChangeBLStoExecution calls a beacon API endpoint to set the withdrawal addresses based on the given signed messages.
 // If the API responds with something other than OK there will be failure messages associated to the corresponding request message.
 func (c *Client) SubmitChangeBLStoExecution(ctx context.Context, request []*apimiddleware.SignedBLSToExecutionChangeJson) error {
@@ -336,6 +358,10 @@ func (c *Client) GetBLStoExecutionChanges(ctx context.Context) (*apimiddleware.B
 	return poolResponse, nil
 }
 
+type depositSnapshotResponse struct {
+	Data *shared.DepositSnapshot `json:"data"`
+}
+
 type forkScheduleResponse struct {
 	Data []shared.Fork
 }
@@ -6,6 +6,7 @@ import (
 	"fmt"
 
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/state"
+	fieldparams "github.com/prysmaticlabs/prysm/v4/config/fieldparams"
 	"github.com/prysmaticlabs/prysm/v4/config/params"
 	"github.com/prysmaticlabs/prysm/v4/consensus-types/interfaces"
 	types "github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
@@ -17,7 +18,8 @@ import (
 )
 
 const (
-	finalityBranchNumOfLeaves = 6
+	finalityBranchNumOfLeaves      = 6
+	syncCommitteeBranchNumOfLeaves = 5
 )
 
 // CreateLightClientFinalityUpdate - implements https://github.com/ethereum/consensus-specs/blob/3d235740e5f1e641d3b160c8688f26e7dc5a1894/specs/altair/light-client/full-node.md#create_light_client_finality_update
@@ -244,3 +246,175 @@ func NewLightClientUpdateFromOptimisticUpdate(update *ethpbv2.LightClientOptimis
 		SignatureSlot:  update.SignatureSlot,
 	}
 }
+
+// NewLightClientUpdateFromBeaconState - implements https://github.com/ethereum/consensus-specs/blob/3d235740e5f1e641d3b160c8688f26e7dc5a1894/specs/altair/light-client/full-node.md#create_light_client_update
+// It extends the finality update of the block with the next sync committee of the attested state,
+// which is only included when the attested header and the signature are in the same sync committee period:
+//
+//	update_signature_period = compute_sync_committee_period_at_slot(block.message.slot)
+//	update_attested_period = compute_sync_committee_period_at_slot(attested_block.message.slot)
+//	if update_attested_period == update_signature_period:
+//	    update.next_sync_committee = attested_state.next_sync_committee
+//	    update.next_sync_committee_branch = compute_merkle_proof_for_state(attested_state, NEXT_SYNC_COMMITTEE_INDEX)
+func NewLightClientUpdateFromBeaconState(
+	ctx context.Context,
+	state state.BeaconState,
+	block interfaces.ReadOnlySignedBeaconBlock,
+	attestedState state.BeaconState,
+	finalizedBlock interfaces.ReadOnlySignedBeaconBlock) (*ethpbv2.LightClientUpdate, error) {
+	result, err := NewLightClientFinalityUpdateFromBeaconState(ctx, state, block, attestedState, finalizedBlock)
+	if err != nil {
+		return nil, err
+	}
+
+	attestedPeriod := slots.SyncCommitteePeriod(slots.ToEpoch(attestedState.Slot()))
+	signaturePeriod := slots.SyncCommitteePeriod(slots.ToEpoch(block.Block().Slot()))
+	if attestedPeriod != signaturePeriod {
+		result.NextSyncCommittee, result.NextSyncCommitteeBranch = emptySyncCommitteeWithBranch()
+		return result, nil
+	}
+
+	nextSyncCommittee, err := attestedState.NextSyncCommittee()
+	if err != nil {
+		return nil, fmt.Errorf("could not get next sync committee %v", err)
+	}
+	nextSyncCommitteeBranch, err := attestedState.NextSyncCommitteeProof(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not get next sync committee proof %v", err)
+	}
+	result.NextSyncCommittee = &ethpbv2.SyncCommittee{
+		Pubkeys:         nextSyncCommittee.Pubkeys,
+		AggregatePubkey: nextSyncCommittee.AggregatePubkey,
+	}
+	result.NextSyncCommitteeBranch = nextSyncCommitteeBranch
+	return result, nil
+}
+
+// NewLightClientBootstrapFromBeaconState - implements https://github.com/ethereum/consensus-specs/blob/3d235740e5f1e641d3b160c8688f26e7dc5a1894/specs/altair/light-client/full-node.md#create_light_client_bootstrap
+// def create_light_client_bootstrap(state: BeaconState) -> LightClientBootstrap:
+//
+//	assert compute_epoch_at_slot(state.slot) >= ALTAIR_FORK_EPOCH
+//	assert state.slot == state.latest_block_header.slot
+//
+//	return LightClientBootstrap(
+//	    header=BeaconBlockHeader(
+//	        slot=state.latest_block_header.slot,
+//	        proposer_index=state.latest_block_header.proposer_index,
+//	        parent_root=state.latest_block_header.parent_root,
+//	        state_root=hash_tree_root(state),
+//	        body_root=state.latest_block_header.body_root,
+//	    ),
+//	    current_sync_committee=state.current_sync_committee,
+//	    current_sync_committee_branch=compute_merkle_proof_for_state(state, CURRENT_SYNC_COMMITTEE_INDEX)
+//	)
+func NewLightClientBootstrapFromBeaconState(ctx context.Context, state state.BeaconState) (*ethpbv2.LightClientBootstrap, error) {
+	epoch := slots.ToEpoch(state.Slot())
+	if epoch < params.BeaconConfig().AltairForkEpoch {
+		return nil, fmt.Errorf("invalid state epoch %d", epoch)
+	}
+	if state.Slot() != state.LatestBlockHeader().Slot {
+		return nil, fmt.Errorf("state slot %d not equal to latest block header slot %d", state.Slot(), state.LatestBlockHeader().Slot)
+	}
+
+	header := state.LatestBlockHeader()
+	stateRoot, err := state.HashTreeRoot(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not get state root %v", err)
+	}
+	currentSyncCommittee, err := state.CurrentSyncCommittee()
+	if err != nil {
+		return nil, fmt.Errorf("could not get current sync committee %v", err)
+	}
+	currentSyncCommitteeBranch, err := state.CurrentSyncCommitteeProof(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not get current sync committee proof %v", err)
+	}
+
+	return &ethpbv2.LightClientBootstrap{
+		Header: &ethpbv1.BeaconBlockHeader{
+			Slot:          header.Slot,
+			ProposerIndex: header.ProposerIndex,
+			ParentRoot:    header.ParentRoot,
+			StateRoot:     stateRoot[:],
+			BodyRoot:      header.BodyRoot,
+		},
+		CurrentSyncCommittee: &ethpbv2.SyncCommittee{
+			Pubkeys:         currentSyncCommittee.Pubkeys,
+			AggregatePubkey: currentSyncCommittee.AggregatePubkey,
+		},
+		CurrentSyncCommitteeBranch: currentSyncCommitteeBranch,
+	}, nil
+}
+
+// IsBetterUpdate - implements https://github.com/ethereum/consensus-specs/blob/3d235740e5f1e641d3b160c8688f26e7dc5a1894/specs/altair/light-client/sync-protocol.md#is_better_update
+// It reports whether newUpdate should replace oldUpdate as the best update of a sync committee period.
+func IsBetterUpdate(newUpdate, oldUpdate *ethpbv2.LightClientUpdate) bool {
+	maxActiveParticipants := newUpdate.SyncAggregate.SyncCommitteeBits.Len()
+	newNumActiveParticipants := newUpdate.SyncAggregate.SyncCommitteeBits.Count()
+	oldNumActiveParticipants := oldUpdate.SyncAggregate.SyncCommitteeBits.Count()
+	newHasSupermajority := hasSupermajority(newNumActiveParticipants, maxActiveParticipants)
+	oldHasSupermajority := hasSupermajority(oldNumActiveParticipants, maxActiveParticipants)
+
+	// Compare supermajority (> 2/3) sync committee participation
+	if newHasSupermajority != oldHasSupermajority {
+		return newHasSupermajority
+	}
+	if !newHasSupermajority && newNumActiveParticipants != oldNumActiveParticipants {
+		return newNumActiveParticipants > oldNumActiveParticipants
+	}
+
+	// Compare presence of relevant sync committee
+	newHasRelevantSyncCommittee := newUpdate.IsSyncCommiteeUpdate() &&
+		syncCommitteePeriodAtSlot(newUpdate.AttestedHeader.Slot) == syncCommitteePeriodAtSlot(newUpdate.SignatureSlot)
+	oldHasRelevantSyncCommittee := oldUpdate.IsSyncCommiteeUpdate() &&
+		syncCommitteePeriodAtSlot(oldUpdate.AttestedHeader.Slot) == syncCommitteePeriodAtSlot(oldUpdate.SignatureSlot)
+	if newHasRelevantSyncCommittee != oldHasRelevantSyncCommittee {
+		return newHasRelevantSyncCommittee
+	}
+
+	// Compare indication of any finality
+	newHasFinality := newUpdate.IsFinalityUpdate()
+	oldHasFinality := oldUpdate.IsFinalityUpdate()
+	if newHasFinality != oldHasFinality {
+		return newHasFinality
+	}
+
+	// Compare sync committee finality
+	if newHasFinality {
+		newHasSyncCommitteeFinality := syncCommitteePeriodAtSlot(newUpdate.FinalizedHeader.Slot) == syncCommitteePeriodAtSlot(newUpdate.AttestedHeader.Slot)
+		oldHasSyncCommitteeFinality := syncCommitteePeriodAtSlot(oldUpdate.FinalizedHeader.Slot) == syncCommitteePeriodAtSlot(oldUpdate.AttestedHeader.Slot)
+		if newHasSyncCommitteeFinality != oldHasSyncCommitteeFinality {
+			return newHasSyncCommitteeFinality
+		}
+	}
+
+	// Tiebreaker 1: Sync committee participation beyond supermajority
+	if newNumActiveParticipants != oldNumActiveParticipants {
+		return newNumActiveParticipants > oldNumActiveParticipants
+	}
+
+	// Tiebreaker 2: Prefer older data (fewer changes to best)
+	if newUpdate.AttestedHeader.Slot != oldUpdate.AttestedHeader.Slot {
+		return newUpdate.AttestedHeader.Slot < oldUpdate.AttestedHeader.Slot
+	}
+	return newUpdate.SignatureSlot < oldUpdate.SignatureSlot
+}
+
+func syncCommitteePeriodAtSlot(slot types.Slot) uint64 {
+	return slots.SyncCommitteePeriod(slots.ToEpoch(slot))
+}
+
+func emptySyncCommitteeWithBranch() (*ethpbv2.SyncCommittee, [][]byte) {
+	pubkeys := make([][]byte, fieldparams.SyncCommitteeLength)
+	for i := range pubkeys {
+		pubkeys[i] = make([]byte, fieldparams.BLSPubkeyLength)
+	}
+	branch := make([][]byte, syncCommitteeBranchNumOfLeaves)
+	for i := range branch {
+		branch[i] = make([]byte, 32)
+	}
+	return &ethpbv2.SyncCommittee{
+		Pubkeys:         pubkeys,
+		AggregatePubkey: make([]byte, fieldparams.BLSPubkeyLength),
+	}, branch
+}
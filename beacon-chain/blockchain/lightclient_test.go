@@ -4,11 +4,13 @@ import (
 	"context"
 	"testing"
 
+	"github.com/prysmaticlabs/go-bitfield"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/state"
 	"github.com/prysmaticlabs/prysm/v4/config/params"
 	"github.com/prysmaticlabs/prysm/v4/consensus-types/blocks"
 	"github.com/prysmaticlabs/prysm/v4/consensus-types/interfaces"
 	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v4/encoding/bytesutil"
 	v1 "github.com/prysmaticlabs/prysm/v4/proto/eth/v1"
 	ethpbv2 "github.com/prysmaticlabs/prysm/v4/proto/eth/v2"
 	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
@@ -158,3 +160,178 @@ func TestLightClient_NewLightClientFinalityUpdateFromBeaconState(t *testing.T) {
 		require.DeepSSZEqual(t, zeroHash, leaf, "Leaf is not zero")
 	}
 }
+
+func TestLightClient_NewLightClientUpdateFromBeaconState(t *testing.T) {
+	l := newTestLc(t).setupTest()
+
+	update, err := NewLightClientUpdateFromBeaconState(l.ctx, l.state, l.block, l.attestedState, nil)
+	require.NoError(t, err)
+	require.NotNil(t, update, "update is nil")
+
+	l.checkSyncAggregate(update)
+	l.checkAttestedHeader(update)
+
+	nextSyncCommittee, err := l.attestedState.NextSyncCommittee()
+	require.NoError(t, err)
+	require.DeepSSZEqual(t, nextSyncCommittee.Pubkeys, update.NextSyncCommittee.Pubkeys, "Next sync committee is not equal")
+	require.DeepSSZEqual(t, nextSyncCommittee.AggregatePubkey, update.NextSyncCommittee.AggregatePubkey, "Next sync committee aggregate pubkey is not equal")
+	branch, err := l.attestedState.NextSyncCommitteeProof(l.ctx)
+	require.NoError(t, err)
+	require.DeepSSZEqual(t, branch, update.NextSyncCommitteeBranch, "Next sync committee branch is not equal")
+	require.Equal(t, true, update.IsSyncCommiteeUpdate())
+	require.Equal(t, false, update.IsFinalityUpdate())
+}
+
+func TestLightClient_NewLightClientUpdateFromBeaconState_DifferentPeriod(t *testing.T) {
+	l := newTestLc(t).setupTest()
+
+	// Sign the attested header from the next sync committee period.
+	blk := util.NewBeaconBlockCapella()
+	blk.Block.Slot = l.block.Block().Slot() + primitives.Slot(uint64(params.BeaconConfig().EpochsPerSyncCommitteePeriod)*uint64(params.BeaconConfig().SlotsPerEpoch))
+	parentRoot := l.block.Block().ParentRoot()
+	blk.Block.ParentRoot = parentRoot[:]
+	for i := uint64(0); i < params.BeaconConfig().MinSyncCommitteeParticipants; i++ {
+		blk.Block.Body.SyncAggregate.SyncCommitteeBits.SetBitAt(i, true)
+	}
+	require.NoError(t, l.state.SetSlot(blk.Block.Slot))
+	signed, err := blocks.NewSignedBeaconBlock(blk)
+	require.NoError(t, err)
+	h, err := signed.Header()
+	require.NoError(t, err)
+	require.NoError(t, l.state.SetLatestBlockHeader(h.Header))
+	stateRoot, err := l.state.HashTreeRoot(l.ctx)
+	require.NoError(t, err)
+	blk.Block.StateRoot = stateRoot[:]
+	signed, err = blocks.NewSignedBeaconBlock(blk)
+	require.NoError(t, err)
+
+	update, err := NewLightClientUpdateFromBeaconState(l.ctx, l.state, signed, l.attestedState, nil)
+	require.NoError(t, err)
+	require.Equal(t, false, update.IsSyncCommiteeUpdate())
+	require.Equal(t, syncCommitteeBranchNumOfLeaves, len(update.NextSyncCommitteeBranch))
+	_, err = update.MarshalSSZ()
+	require.NoError(t, err)
+}
+
+func TestLightClient_NewLightClientBootstrapFromBeaconState(t *testing.T) {
+	l := newTestLc(t).setupTest()
+
+	bootstrap, err := NewLightClientBootstrapFromBeaconState(l.ctx, l.attestedState)
+	require.NoError(t, err)
+
+	attestedStateRoot, err := l.attestedState.HashTreeRoot(l.ctx)
+	require.NoError(t, err)
+	require.DeepSSZEqual(t, attestedStateRoot[:], bootstrap.Header.StateRoot, "Header state root is not equal")
+	headerRoot, err := bootstrap.Header.HashTreeRoot()
+	require.NoError(t, err)
+	blockRoot := l.block.Block().ParentRoot()
+	require.Equal(t, blockRoot, headerRoot, "Header root is not the attested block root")
+
+	currentSyncCommittee, err := l.attestedState.CurrentSyncCommittee()
+	require.NoError(t, err)
+	require.DeepSSZEqual(t, currentSyncCommittee.Pubkeys, bootstrap.CurrentSyncCommittee.Pubkeys, "Current sync committee is not equal")
+	require.Equal(t, syncCommitteeBranchNumOfLeaves, len(bootstrap.CurrentSyncCommitteeBranch))
+
+	require.NoError(t, l.attestedState.SetSlot(l.attestedState.Slot()+1))
+	_, err = NewLightClientBootstrapFromBeaconState(l.ctx, l.attestedState)
+	require.ErrorContains(t, "not equal to latest block header slot", err)
+}
+
+func TestLightClient_IsBetterUpdate(t *testing.T) {
+	slotsPerPeriod := primitives.Slot(uint64(params.BeaconConfig().EpochsPerSyncCommitteePeriod) * uint64(params.BeaconConfig().SlotsPerEpoch))
+	nonZeroBranch := func(n int) [][]byte {
+		branch := make([][]byte, n)
+		for i := range branch {
+			branch[i] = bytesutil.PadTo([]byte{1}, 32)
+		}
+		return branch
+	}
+	newUpdate := func(participants uint64, attestedSlot, signatureSlot primitives.Slot, syncCommittee bool, finalizedSlot *primitives.Slot) *ethpbv2.LightClientUpdate {
+		bits := bitfield.NewBitvector512()
+		for i := uint64(0); i < participants; i++ {
+			bits.SetBitAt(i, true)
+		}
+		u := &ethpbv2.LightClientUpdate{
+			AttestedHeader: &v1.BeaconBlockHeader{Slot: attestedSlot},
+			SyncAggregate:  &v1.SyncAggregate{SyncCommitteeBits: bits},
+			SignatureSlot:  signatureSlot,
+		}
+		if syncCommittee {
+			u.NextSyncCommitteeBranch = nonZeroBranch(syncCommitteeBranchNumOfLeaves)
+		}
+		if finalizedSlot != nil {
+			u.FinalizedHeader = &v1.BeaconBlockHeader{Slot: *finalizedSlot}
+			u.FinalityBranch = nonZeroBranch(finalityBranchNumOfLeaves)
+		}
+		return u
+	}
+	samePeriod := primitives.Slot(10)
+	previousPeriod := slotsPerPeriod + 10
+
+	tests := []struct {
+		name      string
+		newUpdate *ethpbv2.LightClientUpdate
+		oldUpdate *ethpbv2.LightClientUpdate
+		want      bool
+	}{
+		{
+			name:      "supermajority wins",
+			newUpdate: newUpdate(342, 100, 101, false, nil),
+			oldUpdate: newUpdate(341, 100, 101, true, &samePeriod),
+			want:      true,
+		},
+		{
+			name:      "more participants without supermajority",
+			newUpdate: newUpdate(100, 100, 101, false, nil),
+			oldUpdate: newUpdate(200, 100, 101, true, &samePeriod),
+			want:      false,
+		},
+		{
+			name:      "relevant sync committee",
+			newUpdate: newUpdate(400, 100, 101, true, nil),
+			oldUpdate: newUpdate(400, 100, 101, false, &samePeriod),
+			want:      true,
+		},
+		{
+			name:      "sync committee of another period is not relevant",
+			newUpdate: newUpdate(400, slotsPerPeriod-1, slotsPerPeriod, true, nil),
+			oldUpdate: newUpdate(400, 100, 101, false, &samePeriod),
+			want:      false,
+		},
+		{
+			name:      "finality",
+			newUpdate: newUpdate(400, 100, 101, true, &samePeriod),
+			oldUpdate: newUpdate(500, 100, 101, true, nil),
+			want:      true,
+		},
+		{
+			name:      "sync committee finality",
+			newUpdate: newUpdate(400, slotsPerPeriod+100, slotsPerPeriod+101, true, &previousPeriod),
+			oldUpdate: newUpdate(500, slotsPerPeriod+100, slotsPerPeriod+101, true, &samePeriod),
+			want:      true,
+		},
+		{
+			name:      "more participants",
+			newUpdate: newUpdate(401, 100, 101, true, &samePeriod),
+			oldUpdate: newUpdate(400, 100, 101, true, &samePeriod),
+			want:      true,
+		},
+		{
+			name:      "older attested header",
+			newUpdate: newUpdate(400, 99, 101, true, &samePeriod),
+			oldUpdate: newUpdate(400, 100, 101, true, &samePeriod),
+			want:      true,
+		},
+		{
+			name:      "newer signature",
+			newUpdate: newUpdate(400, 100, 102, true, &samePeriod),
+			oldUpdate: newUpdate(400, 100, 101, true, &samePeriod),
+			want:      false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, IsBetterUpdate(tt.newUpdate, tt.oldUpdate))
+		})
+	}
+}
@@ -0,0 +1,204 @@
+package blockchain
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/core/feed"
+	statefeed "github.com/prysmaticlabs/prysm/v4/beacon-chain/core/feed/state"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/v4/config/params"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/interfaces"
+	"github.com/prysmaticlabs/prysm/v4/encoding/bytesutil"
+	ethpbv2 "github.com/prysmaticlabs/prysm/v4/proto/eth/v2"
+	"github.com/prysmaticlabs/prysm/v4/runtime/version"
+	"github.com/prysmaticlabs/prysm/v4/time/slots"
+	"go.opencensus.io/trace"
+	"google.golang.org/protobuf/proto"
+)
+
+// LightClientUpdateFetcher retrieves the latest light client updates computed from the canonical chain.
+type LightClientUpdateFetcher interface {
+	LightClientFinalityUpdate() *ethpbv2.LightClientFinalityUpdateWithVersion
+	LightClientOptimisticUpdate() *ethpbv2.LightClientOptimisticUpdateWithVersion
+}
+
+// lightClientUpdates keeps the latest finality and optimistic updates of the light client server.
+// The lock also serializes the comparison and saving of the best update of a sync committee period.
+type lightClientUpdates struct {
+	sync.RWMutex
+	finality   *ethpbv2.LightClientFinalityUpdateWithVersion
+	optimistic *ethpbv2.LightClientOptimisticUpdateWithVersion
+}
+
+// LightClientFinalityUpdate returns the latest light client finality update, or nil if none was computed yet.
+func (s *Service) LightClientFinalityUpdate() *ethpbv2.LightClientFinalityUpdateWithVersion {
+	s.lcUpdates.RLock()
+	defer s.lcUpdates.RUnlock()
+	return s.lcUpdates.finality
+}
+
+// LightClientOptimisticUpdate returns the latest light client optimistic update, or nil if none was computed yet.
+func (s *Service) LightClientOptimisticUpdate() *ethpbv2.LightClientOptimisticUpdateWithVersion {
+	s.lcUpdates.RLock()
+	defer s.lcUpdates.RUnlock()
+	return s.lcUpdates.optimistic
+}
+
+// processLightClientUpdates computes the light client update signed by the sync aggregate of an imported block.
+// The update replaces the saved update of its sync committee period if it is better, and when the block
+// is the head of the chain it becomes the latest finality and optimistic update served to light clients
+// and published on gossip.
+func (s *Service) processLightClientUpdates(signed interfaces.ReadOnlySignedBeaconBlock, blockRoot [32]byte, postState state.BeaconState) {
+	// This is done in the background to avoid adding more load to the critical block processing path.
+	ctx, span := trace.StartSpan(s.ctx, "blockChain.processLightClientUpdates")
+	defer span.End()
+
+	update, attestedVersion, err := s.lightClientUpdate(ctx, signed, postState)
+	if err != nil {
+		log.WithError(err).Error("Could not compute light client update")
+		return
+	}
+	if update == nil {
+		return
+	}
+
+	broadcasts := s.saveLightClientUpdate(ctx, update, attestedVersion, blockRoot)
+	if len(broadcasts) == 0 || signed.Block().Slot() != s.CurrentSlot() {
+		return
+	}
+	// Light client updates are only forwarded by peers once the block at the signature slot was given
+	// enough time to propagate through the network, that is one third of the slot has transpired.
+	due := slots.StartTime(uint64(s.genesisTime.Unix()), update.SignatureSlot).
+		Add(time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second / 3)
+	select {
+	case <-time.After(time.Until(due)):
+	case <-s.ctx.Done():
+		return
+	}
+	for _, msg := range broadcasts {
+		if err := s.cfg.P2p.Broadcast(ctx, msg); err != nil {
+			log.WithError(err).Error("Could not broadcast light client update")
+		}
+	}
+}
+
+// saveLightClientUpdate saves the update if it is the best of its sync committee period, and makes it the latest
+// finality and optimistic update when the block is the head. It returns the latest updates which changed.
+func (s *Service) saveLightClientUpdate(
+	ctx context.Context,
+	update *ethpbv2.LightClientUpdate,
+	attestedVersion int,
+	blockRoot [32]byte,
+) []proto.Message {
+	s.lcUpdates.Lock()
+	defer s.lcUpdates.Unlock()
+	period := syncCommitteePeriodAtSlot(update.AttestedHeader.Slot)
+	best, err := s.cfg.BeaconDB.LightClientUpdate(ctx, period)
+	if err != nil {
+		log.WithError(err).Error("Could not get light client update")
+		return nil
+	}
+	if best == nil || IsBetterUpdate(update, best) {
+		if err := s.cfg.BeaconDB.SaveLightClientUpdate(ctx, period, update); err != nil {
+			log.WithError(err).Error("Could not save light client update")
+			return nil
+		}
+	}
+
+	headRoot, err := s.HeadRoot(ctx)
+	if err != nil {
+		log.WithError(err).Error("Could not get head root")
+		return nil
+	}
+	if bytesutil.ToBytes32(headRoot) != blockRoot {
+		return nil
+	}
+	var changed []proto.Message
+	v := ethpbv2.Version(attestedVersion)
+	if update.IsFinalityUpdate() && isNewerFinalityUpdate(update, s.lcUpdates.finality) {
+		s.lcUpdates.finality = &ethpbv2.LightClientFinalityUpdateWithVersion{
+			Version: v,
+			Data:    CreateLightClientFinalityUpdate(update),
+		}
+		s.cfg.StateNotifier.StateFeed().Send(&feed.Event{
+			Type: statefeed.LightClientFinalityUpdate,
+			Data: s.lcUpdates.finality,
+		})
+		changed = append(changed, s.lcUpdates.finality.Data)
+	}
+	if s.lcUpdates.optimistic == nil || update.AttestedHeader.Slot > s.lcUpdates.optimistic.Data.AttestedHeader.Slot {
+		s.lcUpdates.optimistic = &ethpbv2.LightClientOptimisticUpdateWithVersion{
+			Version: v,
+			Data:    CreateLightClientOptimisticUpdate(update),
+		}
+		s.cfg.StateNotifier.StateFeed().Send(&feed.Event{
+			Type: statefeed.LightClientOptimisticUpdate,
+			Data: s.lcUpdates.optimistic,
+		})
+		changed = append(changed, s.lcUpdates.optimistic.Data)
+	}
+	return changed
+}
+
+// lightClientUpdate returns the light client update of the block along with the fork version of its attested state.
+// A nil update is returned when the block cannot be the signature block of an update.
+func (s *Service) lightClientUpdate(
+	ctx context.Context,
+	signed interfaces.ReadOnlySignedBeaconBlock,
+	postState state.BeaconState,
+) (*ethpbv2.LightClientUpdate, int, error) {
+	if signed.Version() < version.Altair {
+		return nil, 0, nil
+	}
+	syncAggregate, err := signed.Block().Body().SyncAggregate()
+	if err != nil {
+		return nil, 0, errors.Wrap(err, "could not get sync aggregate")
+	}
+	if syncAggregate.SyncCommitteeBits.Count() < params.BeaconConfig().MinSyncCommitteeParticipants {
+		return nil, 0, nil
+	}
+	attestedState, err := s.cfg.StateGen.StateByRoot(ctx, signed.Block().ParentRoot())
+	if err != nil {
+		return nil, 0, errors.Wrap(err, "could not get attested state")
+	}
+	if slots.ToEpoch(attestedState.Slot()) < params.BeaconConfig().AltairForkEpoch {
+		return nil, 0, nil
+	}
+
+	var finalizedBlock interfaces.ReadOnlySignedBeaconBlock
+	finalizedRoot := bytesutil.ToBytes32(attestedState.FinalizedCheckpoint().Root)
+	if finalizedRoot == params.BeaconConfig().ZeroHash {
+		finalizedBlock, err = s.cfg.BeaconDB.GenesisBlock(ctx)
+	} else {
+		finalizedBlock, err = s.cfg.BeaconDB.Block(ctx, finalizedRoot)
+	}
+	if err != nil {
+		return nil, 0, errors.Wrap(err, "could not get finalized block")
+	}
+	update, err := NewLightClientUpdateFromBeaconState(ctx, postState, signed, attestedState, finalizedBlock)
+	if err != nil {
+		return nil, 0, err
+	}
+	return update, attestedState.Version(), nil
+}
+
+// isNewerFinalityUpdate reports whether the update has a newer finalized header than the current finality update,
+// or the same finalized header with a supermajority of the sync committee participating where the current did not.
+func isNewerFinalityUpdate(update *ethpbv2.LightClientUpdate, current *ethpbv2.LightClientFinalityUpdateWithVersion) bool {
+	if current == nil {
+		return true
+	}
+	if update.FinalizedHeader.Slot != current.Data.FinalizedHeader.Slot {
+		return update.FinalizedHeader.Slot > current.Data.FinalizedHeader.Slot
+	}
+	bits := current.Data.SyncAggregate.SyncCommitteeBits
+	return !hasSupermajority(bits.Count(), bits.Len()) &&
+		hasSupermajority(update.SyncAggregate.SyncCommitteeBits.Count(), update.SyncAggregate.SyncCommitteeBits.Len())
+}
+
+func hasSupermajority(participants, size uint64) bool {
+	return participants*3 >= size*2
+}
@@ -5,6 +5,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/cache"
 	statefeed "github.com/prysmaticlabs/prysm/v4/beacon-chain/core/feed/state"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/db/filesystem"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/execution"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/forkchoice"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/operations/attestations"
@@ -52,6 +53,14 @@ func WithChainStartFetcher(f execution.ChainStartFetcher) Option {
 	}
 }
 
+// WithBlockFetcher to retrieve information about execution blocks.
+func WithBlockFetcher(f execution.POWBlockFetcher) Option {
+	return func(s *Service) error {
+		s.cfg.BlockFetcher = f
+		return nil
+	}
+}
+
 // WithExecutionEngineCaller to call execution engine.
 func WithExecutionEngineCaller(c execution.EngineCaller) Option {
 	return func(s *Service) error {
@@ -148,6 +157,14 @@ func WithStateGen(g *stategen.State) Option {
 	}
 }
 
+// WithBlobStorage sets the blob storage backend for the blockchain service.
+func WithBlobStorage(b *filesystem.BlobStorage) Option {
+	return func(s *Service) error {
+		s.cfg.BlobStorage = b
+		return nil
+	}
+}
+
 // WithSlasherAttestationsFeed to forward attestations into slasher if enabled.
 func WithSlasherAttestationsFeed(f *event.Feed) Option {
 	return func(s *Service) error {
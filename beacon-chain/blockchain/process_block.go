@@ -13,7 +13,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/core/helpers"
 	coreTime "github.com/prysmaticlabs/prysm/v4/beacon-chain/core/time"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/core/transition"
-	"github.com/prysmaticlabs/prysm/v4/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/db/filesystem"
 	forkchoicetypes "github.com/prysmaticlabs/prysm/v4/beacon-chain/forkchoice/types"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/state"
 	"github.com/prysmaticlabs/prysm/v4/config/features"
@@ -166,69 +166,140 @@ func (s *Service) onBlockBatch(ctx context.Context, blks []consensusblocks.ROBlo
 	ctx, span := trace.StartSpan(ctx, "blockChain.onBlockBatch")
 	defer span.End()
 
+	batch := s.verifiedBatches.take(blks)
+	if batch != nil {
+		// The batch was verified on top of the post state of the previous batch, which must have been imported since.
+		if err := s.verifyBlkPreState(ctx, blks[0].Block()); err != nil {
+			return err
+		}
+	} else {
+		preState, err := s.batchPreState(ctx, blks)
+		if err != nil {
+			return err
+		}
+		batch, err = s.verifyBlockBatch(ctx, preState, blks)
+		if err != nil {
+			return err
+		}
+	}
+	return s.importBlockBatch(ctx, batch)
+}
+
+// VerifyBlockBatch transitions the pre state through a linear batch of blocks, batch verifies the signatures of the
+// batch and checks its blob sidecars against the block commitments. The outcome is kept until ReceiveBlockBatch
+// imports the same blocks, which lets a batch be verified while the previous one is being imported. A nil pre state
+// stands for the post state of the parent of the first block, which must then have been imported. A copy of the
+// post state of the batch is returned, to verify the batch that follows it.
+func (s *Service) VerifyBlockBatch(ctx context.Context, preState state.BeaconState, bwb []consensusblocks.BlockWithVerifiedBlobs) (state.BeaconState, error) {
+	ctx, span := trace.StartSpan(ctx, "blockChain.VerifyBlockBatch")
+	defer span.End()
+
+	blks := consensusblocks.BlockWithVerifiedBlobsSlice(bwb).ROBlocks()
+	if preState == nil {
+		// Loading the pre state may flush the initial sync blocks, which must not race with the import of a batch.
+		s.cfg.ForkChoiceStore.Lock()
+		var err error
+		preState, err = s.batchPreState(ctx, blks)
+		s.cfg.ForkChoiceStore.Unlock()
+		if err != nil {
+			return nil, err
+		}
+	}
+	batch, err := s.verifyBlockBatch(ctx, preState, blks)
+	if err != nil {
+		return nil, err
+	}
+	for _, b := range bwb {
+		commitments := commitmentsToCheck(b.Block, s.CurrentSlot())
+		if len(commitments) == 0 {
+			continue
+		}
+		if err := kzg.IsDataAvailable(commitments, b.Blobs); err != nil {
+			return nil, errors.Wrap(err, "could not validate blob data availability")
+		}
+	}
+	batch.dataAvailable = true
+	s.verifiedBatches.add(batch)
+	return batch.postState.Copy(), nil
+}
+
+// batchPreState returns the post state of the parent of the first block of the batch.
+func (s *Service) batchPreState(ctx context.Context, blks []consensusblocks.ROBlock) (state.BeaconState, error) {
 	if len(blks) == 0 {
-		return errors.New("no blocks provided")
+		return nil, errors.New("no blocks provided")
 	}
 
 	if err := consensusblocks.BeaconBlockIsNil(blks[0]); err != nil {
-		return invalidBlock{error: err}
+		return nil, invalidBlock{error: err}
 	}
 	b := blks[0].Block()
 
 	// Retrieve incoming block's pre state.
 	if err := s.verifyBlkPreState(ctx, b); err != nil {
-		return err
+		return nil, err
 	}
 	preState, err := s.cfg.StateGen.StateByRootInitialSync(ctx, b.ParentRoot())
 	if err != nil {
-		return err
+		return nil, err
 	}
 	if preState == nil || preState.IsNil() {
-		return fmt.Errorf("nil pre state for slot %d", b.Slot())
+		return nil, fmt.Errorf("nil pre state for slot %d", b.Slot())
 	}
+	return preState, nil
+}
 
-	// Fill in missing blocks
-	if err := s.fillInForkChoiceMissingBlocks(ctx, blks[0].Block(), preState.CurrentJustifiedCheckpoint(), preState.FinalizedCheckpoint()); err != nil {
-		return errors.Wrap(err, "could not fill in missing blocks to forkchoice")
+// verifyBlockBatch applies the state transition of every block of the batch on top of the pre state without
+// verifying signatures, and then verifies all the signatures of the batch at once.
+func (s *Service) verifyBlockBatch(ctx context.Context, preState state.BeaconState, blks []consensusblocks.ROBlock) (*verifiedBlockBatch, error) {
+	ctx, span := trace.StartSpan(ctx, "blockChain.verifyBlockBatch")
+	defer span.End()
+
+	if len(blks) == 0 {
+		return nil, errors.New("no blocks provided")
+	}
+	if err := consensusblocks.BeaconBlockIsNil(blks[0]); err != nil {
+		return nil, invalidBlock{error: err}
 	}
 
-	jCheckpoints := make([]*ethpb.Checkpoint, len(blks))
-	fCheckpoints := make([]*ethpb.Checkpoint, len(blks))
-	sigSet := bls.NewSet()
-	type versionAndHeader struct {
-		version int
-		header  interfaces.ExecutionData
+	var err error
+	batch := &verifiedBlockBatch{
+		blks:                  blks,
+		preJustified:          preState.CurrentJustifiedCheckpoint(),
+		preFinalized:          preState.FinalizedCheckpoint(),
+		jCheckpoints:          make([]*ethpb.Checkpoint, len(blks)),
+		fCheckpoints:          make([]*ethpb.Checkpoint, len(blks)),
+		preVersionAndHeaders:  make([]*versionAndHeader, len(blks)),
+		postVersionAndHeaders: make([]*versionAndHeader, len(blks)),
+		boundaries:            make(map[[32]byte]state.BeaconState),
 	}
-	preVersionAndHeaders := make([]*versionAndHeader, len(blks))
-	postVersionAndHeaders := make([]*versionAndHeader, len(blks))
+	sigSet := bls.NewSet()
 	var set *bls.SignatureBatch
-	boundaries := make(map[[32]byte]state.BeaconState)
 	for i, b := range blks {
 		v, h, err := getStateVersionAndPayload(preState)
 		if err != nil {
-			return err
+			return nil, err
 		}
-		preVersionAndHeaders[i] = &versionAndHeader{
+		batch.preVersionAndHeaders[i] = &versionAndHeader{
 			version: v,
 			header:  h,
 		}
 
 		set, preState, err = transition.ExecuteStateTransitionNoVerifyAnySig(ctx, preState, b)
 		if err != nil {
-			return invalidBlock{error: err}
+			return nil, invalidBlock{error: err}
 		}
 		// Save potential boundary states.
 		if slots.IsEpochStart(preState.Slot()) {
-			boundaries[b.Root()] = preState.Copy()
+			batch.boundaries[b.Root()] = preState.Copy()
 		}
-		jCheckpoints[i] = preState.CurrentJustifiedCheckpoint()
-		fCheckpoints[i] = preState.FinalizedCheckpoint()
+		batch.jCheckpoints[i] = preState.CurrentJustifiedCheckpoint()
+		batch.fCheckpoints[i] = preState.FinalizedCheckpoint()
 
 		v, h, err = getStateVersionAndPayload(preState)
 		if err != nil {
-			return err
+			return nil, err
 		}
-		postVersionAndHeaders[i] = &versionAndHeader{
+		batch.postVersionAndHeaders[i] = &versionAndHeader{
 			version: v,
 			header:  h,
 		}
@@ -242,31 +313,50 @@ func (s *Service) onBlockBatch(ctx context.Context, blks []consensusblocks.ROBlo
 		verify, err = sigSet.Verify()
 	}
 	if err != nil {
-		return invalidBlock{error: err}
+		return nil, invalidBlock{error: err}
 	}
 	if !verify {
-		return errors.New("batch block signature verification failed")
+		return nil, errors.New("batch block signature verification failed")
+	}
+	batch.postState = preState
+	return batch, nil
+}
+
+// importBlockBatch saves the blocks of a verified batch, notifies the engine of their payloads and inserts them
+// into fork choice.
+func (s *Service) importBlockBatch(ctx context.Context, batch *verifiedBlockBatch) error {
+	ctx, span := trace.StartSpan(ctx, "blockChain.importBlockBatch")
+	defer span.End()
+
+	blks := batch.blks
+	// Fill in missing blocks
+	if err := s.fillInForkChoiceMissingBlocks(ctx, blks[0].Block(), batch.preJustified, batch.preFinalized); err != nil {
+		return errors.Wrap(err, "could not fill in missing blocks to forkchoice")
 	}
 
 	// blocks have been verified, save them and call the engine
+	jCheckpoints, fCheckpoints := batch.jCheckpoints, batch.fCheckpoints
 	pendingNodes := make([]*forkchoicetypes.BlockAndCheckpoints, len(blks))
 	var isValidPayload bool
+	var err error
 	for i, b := range blks {
 		root := b.Root()
 		isValidPayload, err = s.notifyNewPayload(ctx,
-			postVersionAndHeaders[i].version,
-			postVersionAndHeaders[i].header, b)
+			batch.postVersionAndHeaders[i].version,
+			batch.postVersionAndHeaders[i].header, b)
 		if err != nil {
 			return s.handleInvalidExecutionError(ctx, err, root, b.Block().ParentRoot())
 		}
 		if isValidPayload {
-			if err := s.validateMergeTransitionBlock(ctx, preVersionAndHeaders[i].version,
-				preVersionAndHeaders[i].header, b); err != nil {
+			if err := s.validateMergeTransitionBlock(ctx, batch.preVersionAndHeaders[i].version,
+				batch.preVersionAndHeaders[i].header, b); err != nil {
 				return err
 			}
 		}
-		if err := s.databaseDACheck(ctx, b); err != nil {
-			return errors.Wrap(err, "could not validate blob data availability")
+		if !batch.dataAvailable {
+			if err := s.databaseDACheck(ctx, b); err != nil {
+				return errors.Wrap(err, "could not validate blob data availability")
+			}
 		}
 		args := &forkchoicetypes.BlockAndCheckpoints{Block: b.Block(),
 			JustifiedCheckpoint: jCheckpoints[i],
@@ -297,15 +387,16 @@ func (s *Service) onBlockBatch(ctx context.Context, blks []consensusblocks.ROBlo
 		}
 	}
 	// Save boundary states that will be useful for forkchoice
-	for r, st := range boundaries {
+	for r, st := range batch.boundaries {
 		if err := s.cfg.StateGen.SaveState(ctx, r, st); err != nil {
 			return err
 		}
 	}
+	postState := batch.postState
 	lastB := blks[len(blks)-1]
 	lastBR := lastB.Root()
 	// Also saves the last post state which to be used as pre state for the next batch.
-	if err := s.cfg.StateGen.SaveState(ctx, lastBR, preState); err != nil {
+	if err := s.cfg.StateGen.SaveState(ctx, lastBR, postState); err != nil {
 		return err
 	}
 	// Insert all nodes but the last one to forkchoice
@@ -313,7 +404,7 @@ func (s *Service) onBlockBatch(ctx context.Context, blks []consensusblocks.ROBlo
 		return errors.Wrap(err, "could not insert batch to forkchoice")
 	}
 	// Insert the last block to forkchoice
-	if err := s.cfg.ForkChoiceStore.InsertNode(ctx, preState, lastBR); err != nil {
+	if err := s.cfg.ForkChoiceStore.InsertNode(ctx, postState, lastBR); err != nil {
 		return errors.Wrap(err, "could not insert last block in batch to forkchoice")
 	}
 	// Set their optimistic status
@@ -323,14 +414,14 @@ func (s *Service) onBlockBatch(ctx context.Context, blks []consensusblocks.ROBlo
 		}
 	}
 	arg := &notifyForkchoiceUpdateArg{
-		headState: preState,
+		headState: postState,
 		headRoot:  lastBR,
 		headBlock: lastB.Block(),
 	}
 	if _, err := s.notifyForkchoiceUpdate(ctx, arg); err != nil {
 		return err
 	}
-	return s.saveHeadNoDB(ctx, lastB, lastBR, preState, !isValidPayload)
+	return s.saveHeadNoDB(ctx, lastB, lastBR, postState, !isValidPayload)
 }
 
 func commitmentsToCheck(b consensusblocks.ROBlock, current primitives.Slot) [][]byte {
@@ -353,7 +444,7 @@ func (s *Service) databaseDACheck(ctx context.Context, b consensusblocks.ROBlock
 	if len(commitments) == 0 {
 		return nil
 	}
-	sidecars, err := s.cfg.BeaconDB.BlobSidecarsByRoot(ctx, b.Root())
+	sidecars, err := s.cfg.BlobStorage.SidecarsByRoot(b.Root())
 	if err != nil {
 		return errors.Wrap(err, "could not get blob sidecars")
 	}
@@ -557,8 +648,8 @@ func (s *Service) isDataAvailable(ctx context.Context, root [32]byte, signed int
 		return nil
 	}
 
-	// Read first from db in case we have the blobs
-	sidecars, err := s.cfg.BeaconDB.BlobSidecarsByRoot(ctx, root)
+	// Read first from blob storage in case we have the blobs
+	sidecars, err := s.cfg.BlobStorage.SidecarsByRoot(root)
 	switch {
 	case err == nil:
 		if len(sidecars) >= expected {
@@ -569,11 +660,11 @@ func (s *Service) isDataAvailable(ctx context.Context, root [32]byte, signed int
 			logBlobSidecar(sidecars, t)
 			return nil
 		}
-	case errors.Is(err, db.ErrNotFound):
+	case errors.Is(err, filesystem.ErrNotFound):
 		// If the blob sidecars haven't arrived yet, the subsequent code will wait for them.
 		// Note: The system will not exit with an error in this scenario.
 	default:
-		log.WithError(err).Error("could not get blob sidecars from DB")
+		log.WithError(err).Error("could not get blob sidecars from blob storage")
 	}
 
 	found := map[uint64]struct{}{}
@@ -589,7 +680,7 @@ func (s *Service) isDataAvailable(ctx context.Context, root [32]byte, signed int
 				continue
 			}
 			s.blobNotifiers.delete(root)
-			sidecars, err := s.cfg.BeaconDB.BlobSidecarsByRoot(ctx, root)
+			sidecars, err := s.cfg.BlobStorage.SidecarsByRoot(root)
 			if err != nil {
 				return errors.Wrap(err, "could not get blob sidecars")
 			}
@@ -236,8 +236,9 @@ func (s *Service) insertFinalizedDeposits(ctx context.Context, fRoot [32]byte) {
 	// to be included(rather than the last one to be processed). This was most likely
 	// done as the state cannot represent signed integers.
 	finalizedEth1DepIdx := eth1DepositIndex - 1
-	if err = s.cfg.DepositCache.InsertFinalizedDeposits(ctx, int64(finalizedEth1DepIdx), common.Hash(finalizedState.Eth1Data().BlockHash),
-		0 /* Setting a zero value as we have no access to block height */); err != nil {
+	eth1Data := finalizedState.Eth1Data()
+	if err = s.cfg.DepositCache.InsertFinalizedDeposits(ctx, int64(finalizedEth1DepIdx), common.Hash(eth1Data.BlockHash),
+		s.finalizedDepositsExecutionHeight(ctx, finalizedState.Eth1DepositIndex(), eth1Data)); err != nil {
 		log.WithError(err).Error("could not insert finalized deposits")
 		return
 	}
@@ -252,6 +253,21 @@ func (s *Service) insertFinalizedDeposits(ctx context.Context, fRoot [32]byte) {
 	log.WithField("duration", time.Since(startTime).String()).Debugf("Finalized deposit insertion completed at index %d", finalizedEth1DepIdx)
 }
 
+// finalizedDepositsExecutionHeight returns the height of the execution block recorded along with the finalized
+// deposits, which deposit snapshots use as the block to resume processing deposit logs from. This is only
+// the voted eth1 block when all of its deposits are finalized, zero otherwise.
+func (s *Service) finalizedDepositsExecutionHeight(ctx context.Context, eth1DepositIndex uint64, eth1Data *ethpb.Eth1Data) uint64 {
+	if s.cfg.BlockFetcher == nil || eth1DepositIndex != eth1Data.DepositCount {
+		return 0
+	}
+	exists, height, err := s.cfg.BlockFetcher.BlockExists(ctx, common.Hash(eth1Data.BlockHash))
+	if err != nil || !exists || height == nil {
+		log.WithError(err).Debug("Could not determine height of finalized deposits execution block")
+		return 0
+	}
+	return height.Uint64()
+}
+
 // This ensures that the input root defaults to using genesis root instead of zero hashes. This is needed for handling
 // fork choice justification routine.
 func (s *Service) ensureRootNotZeros(root [32]byte) [32]byte {
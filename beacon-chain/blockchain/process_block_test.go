@@ -101,6 +101,73 @@ func TestStore_OnBlockBatch_NotifyNewPayload(t *testing.T) {
 	require.NoError(t, service.onBlockBatch(ctx, blks))
 }
 
+func TestStore_VerifyBlockBatch(t *testing.T) {
+	service, tr := minimalTestService(t)
+	ctx := tr.ctx
+
+	st, keys := util.DeterministicGenesisState(t, 64)
+	require.NoError(t, service.saveGenesisData(ctx, st))
+	bState := st.Copy()
+
+	var bwb []consensusblocks.BlockWithVerifiedBlobs
+	for i := 0; i < 70; i++ {
+		b, err := util.GenerateFullBlock(bState, keys, util.DefaultBlockGenConfig(), primitives.Slot(i))
+		require.NoError(t, err)
+		wsb, err := consensusblocks.NewSignedBeaconBlock(b)
+		require.NoError(t, err)
+		bState, err = transition.ExecuteStateTransition(ctx, bState, wsb)
+		require.NoError(t, err)
+		rwsb, err := consensusblocks.NewROBlock(wsb)
+		require.NoError(t, err)
+		require.NoError(t, service.saveInitSyncBlock(ctx, rwsb.Root(), wsb))
+		bwb = append(bwb, consensusblocks.BlockWithVerifiedBlobs{Block: rwsb})
+	}
+	first, second := bwb[:40], bwb[40:]
+
+	// The second batch is verified on top of the first one before the first one is imported.
+	postState, err := service.VerifyBlockBatch(ctx, nil, first)
+	require.NoError(t, err)
+	require.Equal(t, first[len(first)-1].Block.Block().Slot(), postState.Slot())
+	postState, err = service.VerifyBlockBatch(ctx, postState, second)
+	require.NoError(t, err)
+	require.Equal(t, second[len(second)-1].Block.Block().Slot(), postState.Slot())
+
+	require.NoError(t, service.onBlockBatch(ctx, consensusblocks.BlockWithVerifiedBlobsSlice(first).ROBlocks()))
+	require.NoError(t, service.onBlockBatch(ctx, consensusblocks.BlockWithVerifiedBlobsSlice(second).ROBlocks()))
+	require.Equal(t, 0, len(service.verifiedBatches.batches))
+	require.Equal(t, second[len(second)-1].Block.Root(), service.headRoot())
+}
+
+func TestStore_VerifyBlockBatch_InvalidSignature(t *testing.T) {
+	service, tr := minimalTestService(t)
+	ctx := tr.ctx
+
+	st, keys := util.DeterministicGenesisState(t, 64)
+	require.NoError(t, service.saveGenesisData(ctx, st))
+	bState := st.Copy()
+
+	var bwb []consensusblocks.BlockWithVerifiedBlobs
+	for i := 0; i < 4; i++ {
+		b, err := util.GenerateFullBlock(bState, keys, util.DefaultBlockGenConfig(), primitives.Slot(i))
+		require.NoError(t, err)
+		wsb, err := consensusblocks.NewSignedBeaconBlock(b)
+		require.NoError(t, err)
+		bState, err = transition.ExecuteStateTransition(ctx, bState, wsb)
+		require.NoError(t, err)
+		if i == 2 {
+			b.Signature = bytesutil.PadTo([]byte("invalid"), fieldparams.BLSSignatureLength)
+			wsb, err = consensusblocks.NewSignedBeaconBlock(b)
+			require.NoError(t, err)
+		}
+		rwsb, err := consensusblocks.NewROBlock(wsb)
+		require.NoError(t, err)
+		bwb = append(bwb, consensusblocks.BlockWithVerifiedBlobs{Block: rwsb})
+	}
+	_, err := service.VerifyBlockBatch(ctx, nil, bwb)
+	require.NotNil(t, err)
+	require.Equal(t, 0, len(service.verifiedBatches.batches))
+}
+
 func TestCachedPreState_CanGetFromStateSummary(t *testing.T) {
 	service, tr := minimalTestService(t)
 	ctx, beaconDB := tr.ctx, tr.db
@@ -2106,3 +2173,18 @@ func Test_commitmentsToCheck(t *testing.T) {
 		})
 	}
 }
+
+func TestFinalizedDepositsExecutionHeight(t *testing.T) {
+	ctx := context.Background()
+	blockHash := bytesutil.PadTo([]byte{'a'}, 32)
+	service, _ := minimalTestService(t, WithBlockFetcher(&mockExecution.Chain{
+		HashesByHeight: map[int][]byte{100: blockHash},
+	}))
+
+	eth1Data := &ethpb.Eth1Data{DepositCount: 10, BlockHash: blockHash}
+	assert.Equal(t, uint64(100), service.finalizedDepositsExecutionHeight(ctx, 10, eth1Data))
+	// Deposits of the voted block which are not finalized yet must be processed again.
+	assert.Equal(t, uint64(0), service.finalizedDepositsExecutionHeight(ctx, 8, eth1Data))
+	unknown := &ethpb.Eth1Data{DepositCount: 10, BlockHash: bytesutil.PadTo([]byte{'b'}, 32)}
+	assert.Equal(t, uint64(0), service.finalizedDepositsExecutionHeight(ctx, 10, unknown))
+}
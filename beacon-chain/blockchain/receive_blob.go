@@ -7,14 +7,14 @@ import (
 )
 
 // SendNewBlobEvent sends a message to the BlobNotifier channel that the blob
-// for the blocroot `root` is ready in the database
+// for the blocroot `root` is ready in blob storage
 func (s *Service) sendNewBlobEvent(root [32]byte, index uint64) {
 	s.blobNotifiers.forRoot(root) <- index
 }
 
-// ReceiveBlob saves the blob to database and sends the new event
-func (s *Service) ReceiveBlob(ctx context.Context, b *ethpb.BlobSidecar) error {
-	if err := s.cfg.BeaconDB.SaveBlobSidecar(ctx, []*ethpb.BlobSidecar{b}); err != nil {
+// ReceiveBlob saves the blob to blob storage and sends the new event
+func (s *Service) ReceiveBlob(_ context.Context, b *ethpb.BlobSidecar) error {
+	if err := s.cfg.BlobStorage.Save(b); err != nil {
 		return err
 	}
 
@@ -34,6 +34,7 @@ var epochsSinceFinalitySaveHotStateDB = primitives.Epoch(100)
 type BlockReceiver interface {
 	ReceiveBlock(ctx context.Context, block interfaces.ReadOnlySignedBeaconBlock, blockRoot [32]byte) error
 	ReceiveBlockBatch(ctx context.Context, blocks []blocks.ROBlock) error
+	VerifyBlockBatch(ctx context.Context, preState state.BeaconState, blocks []blocks.BlockWithVerifiedBlobs) (state.BeaconState, error)
 	HasBlock(ctx context.Context, root [32]byte) bool
 	RecentBlockSlot(root [32]byte) (primitives.Slot, error)
 	BlockBeingSynced([32]byte) bool
@@ -147,6 +148,11 @@ func (s *Service) ReceiveBlock(ctx context.Context, block interfaces.ReadOnlySig
 		go s.sendBlockAttestationsToSlasher(blockCopy, preState)
 	}
 
+	// If the light client server is enabled, compute the light client update signed by the block in the background.
+	if features.Get().EnableLightClient {
+		go s.processLightClientUpdates(blockCopy, blockRoot, postState.Copy())
+	}
+
 	// Handle post block operations such as pruning exits and bls messages if incoming block is the head
 	if err := s.prunePostBlockOperationPools(ctx, blockCopy, blockRoot); err != nil {
 		log.WithError(err).Error("Could not prune canonical objects from pool ")
@@ -184,7 +190,8 @@ func (s *Service) ReceiveBlock(ctx context.Context, block interfaces.ReadOnlySig
 
 // ReceiveBlockBatch processes the whole block batch at once, assuming the block batch is linear ,transitioning
 // the state, performing batch verification of all collected signatures and then performing the appropriate
-// actions for a block post-transition.
+// actions for a block post-transition. The transition and verification are skipped when the batch has already been
+// verified by VerifyBlockBatch.
 func (s *Service) ReceiveBlockBatch(ctx context.Context, blocks []blocks.ROBlock) error {
 	ctx, span := trace.StartSpan(ctx, "blockChain.ReceiveBlockBatch")
 	defer span.End()
@@ -28,6 +28,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v4/encoding/bytesutil"
 	enginev1 "github.com/prysmaticlabs/prysm/v4/proto/engine/v1"
 	ethpbv1 "github.com/prysmaticlabs/prysm/v4/proto/eth/v1"
+	ethpbv2 "github.com/prysmaticlabs/prysm/v4/proto/eth/v2"
 	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
 	"github.com/sirupsen/logrus"
 )
@@ -72,6 +73,8 @@ type ChainService struct {
 	OptimisticRoots             map[[32]byte]bool
 	BlockSlot                   primitives.Slot
 	SyncingRoot                 [32]byte
+	LCFinalityUpdate            *ethpbv2.LightClientFinalityUpdateWithVersion
+	LCOptimisticUpdate          *ethpbv2.LightClientOptimisticUpdateWithVersion
 }
 
 func (s *ChainService) Ancestor(ctx context.Context, root []byte, slot primitives.Slot) ([]byte, error) {
@@ -205,6 +208,18 @@ func (s *ChainService) ReceiveBlockInitialSync(ctx context.Context, block interf
 	return nil
 }
 
+// VerifyBlockBatch mocks the verification of a batch of blocks from initial-sync, the batch is processed once
+// received.
+func (s *ChainService) VerifyBlockBatch(_ context.Context, preState state.BeaconState, _ []blocks.BlockWithVerifiedBlobs) (state.BeaconState, error) {
+	if preState != nil {
+		return preState, nil
+	}
+	if s.State == nil {
+		return nil, ErrNilState
+	}
+	return s.State, nil
+}
+
 // ReceiveBlockBatch processes blocks in batches from initial-sync.
 func (s *ChainService) ReceiveBlockBatch(ctx context.Context, blks []blocks.ROBlock) error {
 	if s.State == nil {
@@ -615,3 +630,13 @@ func (c *ChainService) BlockBeingSynced(root [32]byte) bool {
 func (*ChainService) ReceiveBlob(_ context.Context, _ *ethpb.BlobSidecar) error {
 	return nil
 }
+
+// LightClientFinalityUpdate mocks the same method in the chain service
+func (s *ChainService) LightClientFinalityUpdate() *ethpbv2.LightClientFinalityUpdateWithVersion {
+	return s.LCFinalityUpdate
+}
+
+// LightClientOptimisticUpdate mocks the same method in the chain service
+func (s *ChainService) LightClientOptimisticUpdate() *ethpbv2.LightClientOptimisticUpdateWithVersion {
+	return s.LCOptimisticUpdate
+}
@@ -0,0 +1,75 @@
+package blockchain
+
+import (
+	"sync"
+
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/state"
+	consensusblocks "github.com/prysmaticlabs/prysm/v4/consensus-types/blocks"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/interfaces"
+	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+)
+
+// maxVerifiedBlockBatches bounds the number of batches kept between their verification and their import.
+const maxVerifiedBlockBatches = 8
+
+type versionAndHeader struct {
+	version int
+	header  interfaces.ExecutionData
+}
+
+// verifiedBlockBatch is the outcome of the state transition of a linear batch of blocks whose signatures have been
+// verified. It holds everything needed to import the batch without transitioning the state again.
+type verifiedBlockBatch struct {
+	blks                  []consensusblocks.ROBlock
+	preJustified          *ethpb.Checkpoint
+	preFinalized          *ethpb.Checkpoint
+	jCheckpoints          []*ethpb.Checkpoint
+	fCheckpoints          []*ethpb.Checkpoint
+	preVersionAndHeaders  []*versionAndHeader
+	postVersionAndHeaders []*versionAndHeader
+	boundaries            map[[32]byte]state.BeaconState
+	postState             state.BeaconState
+	// dataAvailable is set once the blob sidecars of the batch have been checked against the block commitments.
+	dataAvailable bool
+}
+
+// verifiedBlockBatches keeps the batches verified ahead of their import, by the root of their last block.
+type verifiedBlockBatches struct {
+	sync.Mutex
+	batches map[[32]byte]*verifiedBlockBatch
+}
+
+func (v *verifiedBlockBatches) add(b *verifiedBlockBatch) {
+	v.Lock()
+	defer v.Unlock()
+	// Batches which are never imported, e.g. because the previous batch failed, are never taken. Drop them all
+	// rather than let them accumulate.
+	if v.batches == nil || len(v.batches) >= maxVerifiedBlockBatches {
+		v.batches = make(map[[32]byte]*verifiedBlockBatch)
+	}
+	v.batches[b.blks[len(b.blks)-1].Root()] = b
+}
+
+// take removes and returns the verified batch made of exactly the given blocks, or nil if there is none.
+func (v *verifiedBlockBatches) take(blks []consensusblocks.ROBlock) *verifiedBlockBatch {
+	if len(blks) == 0 {
+		return nil
+	}
+	v.Lock()
+	defer v.Unlock()
+	root := blks[len(blks)-1].Root()
+	b, ok := v.batches[root]
+	if !ok {
+		return nil
+	}
+	delete(v.batches, root)
+	if len(b.blks) != len(blks) {
+		return nil
+	}
+	for i := range blks {
+		if blks[i].Root() != b.blks[i].Root() {
+			return nil
+		}
+	}
+	return b
+}
@@ -0,0 +1,45 @@
+package builder
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v4/api/client/builder"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/core/signing"
+	"github.com/prysmaticlabs/prysm/v4/config/params"
+	"github.com/prysmaticlabs/prysm/v4/encoding/bytesutil"
+)
+
+// validateBid checks the signature of a relay bid and that it builds on the requested parent hash,
+// returning the value of the bid and the block hash of its header.
+func validateBid(signedBid builder.SignedBid, parentHash [32]byte) (*big.Int, [32]byte, error) {
+	if signedBid == nil || signedBid.IsNil() {
+		return nil, [32]byte{}, errors.New("nil bid")
+	}
+	bid, err := signedBid.Message()
+	if err != nil {
+		return nil, [32]byte{}, errors.Wrap(err, "could not get bid")
+	}
+	if bid == nil || bid.IsNil() {
+		return nil, [32]byte{}, errors.New("nil bid")
+	}
+	header, err := bid.Header()
+	if err != nil {
+		return nil, [32]byte{}, errors.Wrap(err, "could not get bid header")
+	}
+	if !bytes.Equal(header.ParentHash(), parentHash[:]) {
+		return nil, [32]byte{}, fmt.Errorf("incorrect parent hash %#x != %#x", header.ParentHash(), parentHash)
+	}
+	d, err := signing.ComputeDomain(params.BeaconConfig().DomainApplicationBuilder,
+		nil, /* fork version */
+		nil /* genesis val root */)
+	if err != nil {
+		return nil, [32]byte{}, err
+	}
+	if err := signing.VerifySigningRoot(bid, bid.Pubkey(), signedBid.Signature(), d); err != nil {
+		return nil, [32]byte{}, errors.Wrap(err, "could not verify bid signature")
+	}
+	return bytesutil.LittleEndianBytesToBigInt(bid.Value()), bytesutil.ToBytes32(header.BlockHash()), nil
+}
@@ -28,3 +28,42 @@ var (
 		},
 	)
 )
+
+var (
+	relayLatency = promauto.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "builder_relay_request_latency_milliseconds",
+			Help:    "Captures the latency of requests to each builder relay in milliseconds",
+			Buckets: []float64{1, 2, 5, 10, 20, 50, 100, 200, 500, 1000},
+		},
+		[]string{"relay", "method"},
+	)
+	relayFailures = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "builder_relay_request_failures_total",
+			Help: "Count the number of failed requests to each builder relay",
+		},
+		[]string{"relay", "method"},
+	)
+	relayInvalidBids = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "builder_relay_invalid_bids_total",
+			Help: "Count the number of bids from each builder relay which were rejected",
+		},
+		[]string{"relay"},
+	)
+	relayWinningBids = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "builder_relay_winning_bids_total",
+			Help: "Count the number of times the bid of each builder relay was the highest valid bid",
+		},
+		[]string{"relay"},
+	)
+	relayDeliveredPayloads = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "builder_relay_delivered_payloads_total",
+			Help: "Count the number of payloads delivered by each builder relay for submitted blinded blocks",
+		},
+		[]string{"relay"},
+	)
+)
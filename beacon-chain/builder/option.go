@@ -1,6 +1,8 @@
 package builder
 
 import (
+	"strings"
+
 	"github.com/prysmaticlabs/prysm/v4/api/client/builder"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/blockchain"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/cache"
@@ -13,25 +15,26 @@ type Option func(s *Service) error
 
 // FlagOptions for builder service flag configurations.
 func FlagOptions(c *cli.Context) ([]Option, error) {
-	endpoint := c.String(flags.MevRelayEndpoint.Name)
-	var client *builder.Client
-	if endpoint != "" {
-		var err error
-		client, err = builder.NewClient(endpoint)
+	var opts []Option
+	for _, endpoint := range strings.Split(c.String(flags.MevRelayEndpoint.Name), ",") {
+		endpoint = strings.TrimSpace(endpoint)
+		if endpoint == "" {
+			continue
+		}
+		client, err := builder.NewClient(endpoint)
 		if err != nil {
 			return nil, err
 		}
-	}
-	opts := []Option{
-		WithBuilderClient(client),
+		opts = append(opts, WithBuilderClient(client))
 	}
 	return opts, nil
 }
 
-// WithBuilderClient sets the builder client for the beacon chain builder service.
+// WithBuilderClient adds a builder relay client to the beacon chain builder service.
+// It can be given several times to use several relays.
 func WithBuilderClient(client builder.BuilderClient) Option {
 	return func(s *Service) error {
-		s.cfg.builderClient = client
+		s.cfg.builderClients = append(s.cfg.builderClients, client)
 		return nil
 	}
 }
@@ -0,0 +1,63 @@
+package builder
+
+import (
+	"sync"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/v4/api/client/builder"
+)
+
+// Number of consecutive failed requests after which a relay is no longer asked for headers,
+// until it answers a status check again.
+const maxRelayConsecutiveFailures = 3
+
+const (
+	getHeaderMethod          = "get_header"
+	submitBlindedBlockMethod = "submit_blinded_block"
+	registerValidatorMethod  = "register_validator"
+	statusMethod             = "status"
+)
+
+// relay wraps the client of a single builder relay, tracking its health.
+type relay struct {
+	client              builder.BuilderClient
+	lock                sync.RWMutex
+	consecutiveFailures uint64
+	deliveredPayloads   uint64
+}
+
+func newRelay(c builder.BuilderClient) *relay {
+	return &relay{client: c}
+}
+
+func (r *relay) url() string {
+	return r.client.NodeURL()
+}
+
+// healthy returns false once the relay failed too many requests in a row.
+func (r *relay) healthy() bool {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+	return r.consecutiveFailures < maxRelayConsecutiveFailures
+}
+
+// observe records the outcome and latency of a request to the relay.
+func (r *relay) observe(method string, start time.Time, err error) {
+	relayLatency.WithLabelValues(r.url(), method).Observe(float64(time.Since(start).Milliseconds()))
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	if err != nil {
+		relayFailures.WithLabelValues(r.url(), method).Inc()
+		r.consecutiveFailures++
+		return
+	}
+	r.consecutiveFailures = 0
+}
+
+// payloadDelivered records a payload revealed by the relay for a submitted blinded block.
+func (r *relay) payloadDelivered() {
+	relayDeliveredPayloads.WithLabelValues(r.url()).Inc()
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.deliveredPayloads++
+}
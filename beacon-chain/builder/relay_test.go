@@ -0,0 +1,206 @@
+package builder
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"sync"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v4/api/client/builder"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/core/signing"
+	fieldparams "github.com/prysmaticlabs/prysm/v4/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v4/config/params"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/blocks"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/interfaces"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v4/crypto/bls"
+	"github.com/prysmaticlabs/prysm/v4/encoding/bytesutil"
+	v1 "github.com/prysmaticlabs/prysm/v4/proto/engine/v1"
+	eth "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v4/testing/assert"
+	"github.com/prysmaticlabs/prysm/v4/testing/require"
+	"github.com/prysmaticlabs/prysm/v4/testing/util"
+)
+
+var _ builder.BuilderClient = (*fakeRelay)(nil)
+
+// fakeRelay is a builder client returning a fixed bid, recording the calls it receives.
+type fakeRelay struct {
+	endpoint    string
+	bid         builder.SignedBid
+	err         error
+	lock        sync.Mutex
+	submitted   int
+	registered  int
+	headerCalls int
+}
+
+func (r *fakeRelay) NodeURL() string {
+	return r.endpoint
+}
+
+func (r *fakeRelay) GetHeader(_ context.Context, _ primitives.Slot, _ [32]byte, _ [48]byte) (builder.SignedBid, error) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.headerCalls++
+	return r.bid, r.err
+}
+
+func (r *fakeRelay) RegisterValidator(_ context.Context, _ []*eth.SignedValidatorRegistrationV1) error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.registered++
+	return r.err
+}
+
+func (r *fakeRelay) SubmitBlindedBlock(_ context.Context, _ interfaces.ReadOnlySignedBeaconBlock, _ []*eth.SignedBlindedBlobSidecar) (interfaces.ExecutionData, *v1.BlobsBundle, error) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.submitted++
+	return nil, nil, r.err
+}
+
+func (r *fakeRelay) Status(_ context.Context) error {
+	return r.err
+}
+
+func signedBid(t *testing.T, parentHash, blockHash [32]byte, value int64, validSignature bool) builder.SignedBid {
+	sk, err := bls.RandKey()
+	require.NoError(t, err)
+	bid := &eth.BuilderBidCapella{
+		Header: &v1.ExecutionPayloadHeaderCapella{
+			ParentHash:       parentHash[:],
+			FeeRecipient:     make([]byte, fieldparams.FeeRecipientLength),
+			StateRoot:        make([]byte, fieldparams.RootLength),
+			ReceiptsRoot:     make([]byte, fieldparams.RootLength),
+			LogsBloom:        make([]byte, fieldparams.LogsBloomLength),
+			PrevRandao:       make([]byte, fieldparams.RootLength),
+			BaseFeePerGas:    make([]byte, fieldparams.RootLength),
+			BlockHash:        blockHash[:],
+			TransactionsRoot: make([]byte, fieldparams.RootLength),
+			WithdrawalsRoot:  make([]byte, fieldparams.RootLength),
+		},
+		Pubkey: sk.PublicKey().Marshal(),
+		Value:  bytesutil.PadTo(bytesutil.ReverseByteOrder(big.NewInt(value).Bytes()), 32),
+	}
+	domain, err := signing.ComputeDomain(params.BeaconConfig().DomainApplicationBuilder, nil, nil)
+	require.NoError(t, err)
+	sr, err := signing.ComputeSigningRoot(bid, domain)
+	require.NoError(t, err)
+	sig := sk.Sign(sr[:]).Marshal()
+	if !validSignature {
+		sig = sk.Sign([]byte("other message")).Marshal()
+	}
+	sBid, err := builder.WrappedSignedBuilderBidCapella(&eth.SignedBuilderBidCapella{Message: bid, Signature: sig})
+	require.NoError(t, err)
+	return sBid
+}
+
+func Test_GetHeader_HighestValidBid(t *testing.T) {
+	ctx := context.Background()
+	parentHash := [32]byte{'a'}
+	relays := []*fakeRelay{
+		{endpoint: "low", bid: signedBid(t, parentHash, [32]byte{1}, 1, true)},
+		{endpoint: "bad-signature", bid: signedBid(t, parentHash, [32]byte{2}, 4, false)},
+		{endpoint: "best", bid: signedBid(t, parentHash, [32]byte{3}, 3, true)},
+		{endpoint: "wrong-parent", bid: signedBid(t, [32]byte{'b'}, [32]byte{4}, 5, true)},
+		{endpoint: "no-bid", err: builder.ErrNoContent},
+		{endpoint: "down", err: errors.New("connection refused")},
+	}
+	opts := make([]Option, len(relays))
+	for i, r := range relays {
+		opts[i] = WithBuilderClient(r)
+	}
+	s, err := NewService(ctx, opts...)
+	require.NoError(t, err)
+
+	bid, err := s.GetHeader(ctx, 1, parentHash, [48]byte{})
+	require.NoError(t, err)
+	assert.Equal(t, relays[2].bid, bid)
+	for _, r := range relays {
+		assert.Equal(t, 1, r.headerCalls)
+	}
+
+	// The blinded block built on the winning bid is only submitted to the relay which supplied it.
+	b := util.NewBlindedBeaconBlockCapella()
+	b.Block.Body.ExecutionPayloadHeader.BlockHash = bytesutil.PadTo([]byte{3}, fieldparams.RootLength)
+	blk, err := blocks.NewSignedBeaconBlock(b)
+	require.NoError(t, err)
+	_, _, err = s.SubmitBlindedBlock(ctx, blk, nil)
+	require.NoError(t, err)
+	for i, r := range relays {
+		if i == 2 {
+			assert.Equal(t, 1, r.submitted)
+		} else {
+			assert.Equal(t, 0, r.submitted)
+		}
+	}
+	assert.Equal(t, uint64(1), s.relays[2].deliveredPayloads)
+}
+
+func Test_GetHeader_NoValidBid(t *testing.T) {
+	ctx := context.Background()
+	parentHash := [32]byte{'a'}
+	s, err := NewService(ctx,
+		WithBuilderClient(&fakeRelay{endpoint: "bad-signature", bid: signedBid(t, parentHash, [32]byte{1}, 1, false)}),
+		WithBuilderClient(&fakeRelay{endpoint: "no-bid", err: builder.ErrNoContent}),
+	)
+	require.NoError(t, err)
+	_, err = s.GetHeader(ctx, 1, parentHash, [48]byte{})
+	require.ErrorContains(t, "no valid bid received from any relay", err)
+}
+
+func Test_GetHeader_SkipsUnhealthyRelay(t *testing.T) {
+	ctx := context.Background()
+	parentHash := [32]byte{'a'}
+	down := &fakeRelay{endpoint: "down", err: errors.New("connection refused")}
+	up := &fakeRelay{endpoint: "up", bid: signedBid(t, parentHash, [32]byte{1}, 1, true)}
+	s, err := NewService(ctx, WithBuilderClient(down), WithBuilderClient(up))
+	require.NoError(t, err)
+
+	for i := 0; i < maxRelayConsecutiveFailures+2; i++ {
+		_, err = s.GetHeader(ctx, primitives.Slot(i), parentHash, [48]byte{})
+		require.NoError(t, err)
+	}
+	assert.Equal(t, maxRelayConsecutiveFailures, down.headerCalls)
+	assert.Equal(t, maxRelayConsecutiveFailures+2, up.headerCalls)
+	assert.Equal(t, false, s.relays[0].healthy())
+
+	// A successful status check makes the relay eligible again.
+	down.err = nil
+	s.checkRelaysStatus(ctx)
+	assert.Equal(t, true, s.relays[0].healthy())
+}
+
+func Test_SubmitBlindedBlock_UnknownBid(t *testing.T) {
+	ctx := context.Background()
+	first := &fakeRelay{endpoint: "first", err: errors.New("unknown payload")}
+	second := &fakeRelay{endpoint: "second"}
+	s, err := NewService(ctx, WithBuilderClient(first), WithBuilderClient(second))
+	require.NoError(t, err)
+
+	blk, err := blocks.NewSignedBeaconBlock(util.NewBlindedBeaconBlockCapella())
+	require.NoError(t, err)
+	_, _, err = s.SubmitBlindedBlock(ctx, blk, nil)
+	require.NoError(t, err)
+	assert.Equal(t, 1, first.submitted)
+	assert.Equal(t, 1, second.submitted)
+}
+
+func Test_RegisterValidator_AllRelays(t *testing.T) {
+	ctx := context.Background()
+	relays := []*fakeRelay{
+		{endpoint: "up"},
+		{endpoint: "down", err: errors.New("connection refused")},
+	}
+	s, err := NewService(ctx, WithBuilderClient(relays[0]), WithBuilderClient(relays[1]))
+	require.NoError(t, err)
+	reg := []*eth.SignedValidatorRegistrationV1{{Message: &eth.ValidatorRegistrationV1{}}}
+	require.NoError(t, s.registerWithRelays(ctx, reg))
+	assert.Equal(t, 1, relays[0].registered)
+	assert.Equal(t, 1, relays[1].registered)
+
+	relays[0].err = errors.New("connection refused")
+	require.ErrorContains(t, "connection refused", s.registerWithRelays(ctx, reg))
+}
@@ -3,7 +3,9 @@ package builder
 import (
 	"context"
 	"fmt"
+	"math/big"
 	"reflect"
+	"sync"
 	"time"
 
 	"github.com/pkg/errors"
@@ -34,47 +36,68 @@ type BlockBuilder interface {
 	Configured() bool
 }
 
+// Deadline for all relays to answer a header request, the highest valid bid received by then is used.
+const getHeaderTimeout = time.Second
+
+// Number of slots for which the relay which supplied a bid is remembered.
+const bidSourceRetentionSlots = 2
+
 // config defines a config struct for dependencies into the service.
 type config struct {
-	builderClient builder.BuilderClient
-	beaconDB      db.HeadAccessDatabase
-	headFetcher   blockchain.HeadFetcher
+	builderClients []builder.BuilderClient
+	beaconDB       db.HeadAccessDatabase
+	headFetcher    blockchain.HeadFetcher
 }
 
 // Service defines a service that provides a client for interacting with the beacon chain and MEV relay network.
 type Service struct {
 	cfg               *config
-	c                 builder.BuilderClient
+	relays            []*relay
 	ctx               context.Context
 	cancel            context.CancelFunc
 	registrationCache *cache.RegistrationCache
+	bidSourcesLock    sync.Mutex
+	bidSources        map[[32]byte]*bidSource
+}
+
+// bidSource is the relay which supplied the bid for an execution block hash.
+type bidSource struct {
+	relay *relay
+	slot  primitives.Slot
 }
 
 // NewService instantiates a new service.
 func NewService(ctx context.Context, opts ...Option) (*Service, error) {
 	ctx, cancel := context.WithCancel(ctx)
 	s := &Service{
-		ctx:    ctx,
-		cancel: cancel,
-		cfg:    &config{},
+		ctx:        ctx,
+		cancel:     cancel,
+		cfg:        &config{},
+		bidSources: make(map[[32]byte]*bidSource),
 	}
 	for _, opt := range opts {
 		if err := opt(s); err != nil {
 			return nil, err
 		}
 	}
-	if s.cfg.builderClient != nil && !reflect.ValueOf(s.cfg.builderClient).IsNil() {
-		s.c = s.cfg.builderClient
+	for _, c := range s.cfg.builderClients {
+		if c == nil || reflect.ValueOf(c).IsNil() {
+			continue
+		}
+		r := newRelay(c)
+		s.relays = append(s.relays, r)
 
 		// Is the builder up?
-		if err := s.c.Status(ctx); err != nil {
-			log.WithError(err).Error("Failed to check builder status")
+		if err := c.Status(ctx); err != nil {
+			log.WithError(err).WithField("endpoint", r.url()).Error("Failed to check builder status")
 		} else {
-			log.WithField("endpoint", s.c.NodeURL()).Info("Builder has been configured")
-			log.Warn("Outsourcing block construction to external builders adds non-trivial delay to block propagation time.  " +
-				"Builder-constructed blocks or fallback blocks may get orphaned. Use at your own risk!")
+			log.WithField("endpoint", r.url()).Info("Builder has been configured")
 		}
 	}
+	if len(s.relays) > 0 {
+		log.Warn("Outsourcing block construction to external builders adds non-trivial delay to block propagation time.  " +
+			"Builder-constructed blocks or fallback blocks may get orphaned. Use at your own risk!")
+	}
 	return s, nil
 }
 
@@ -89,7 +112,8 @@ func (s *Service) Stop() error {
 	return nil
 }
 
-// SubmitBlindedBlock submits a blinded block to the builder relay network.
+// SubmitBlindedBlock submits a blinded block to the builder relay network. The block is submitted to the relay
+// which supplied its bid, or to every relay in turn until one reveals the payload when that relay is not known.
 func (s *Service) SubmitBlindedBlock(ctx context.Context, b interfaces.ReadOnlySignedBeaconBlock, blobs []*ethpb.SignedBlindedBlobSidecar) (interfaces.ExecutionData, *v1.BlobsBundle, error) {
 	ctx, span := trace.StartSpan(ctx, "builder.SubmitBlindedBlock")
 	defer span.End()
@@ -97,17 +121,36 @@ func (s *Service) SubmitBlindedBlock(ctx context.Context, b interfaces.ReadOnlyS
 	defer func() {
 		submitBlindedBlockLatency.Observe(float64(time.Since(start).Milliseconds()))
 	}()
-	if s.c == nil {
+	if len(s.relays) == 0 {
 		return nil, nil, ErrNoBuilder
 	}
 	if uint64(len(blobs)) > fieldparams.MaxBlobsPerBlock {
 		return nil, nil, fmt.Errorf("blob count %d beyond max limit of %d", len(blobs), fieldparams.MaxBlobsPerBlock)
 	}
 
-	return s.c.SubmitBlindedBlock(ctx, b, blobs)
+	relays := s.relays
+	if r := s.bidRelay(b); r != nil {
+		relays = []*relay{r}
+	}
+	var lastErr error
+	for _, r := range relays {
+		relayStart := time.Now()
+		payload, blobsBundle, err := r.client.SubmitBlindedBlock(ctx, b, blobs)
+		r.observe(submitBlindedBlockMethod, relayStart, err)
+		if err != nil {
+			log.WithError(err).WithField("endpoint", r.url()).Debug("Relay could not reveal payload of blinded block")
+			lastErr = err
+			continue
+		}
+		r.payloadDelivered()
+		return payload, blobsBundle, nil
+	}
+	tracing.AnnotateError(span, lastErr)
+	return nil, nil, lastErr
 }
 
 // GetHeader retrieves the header for a given slot and parent hash from the builder relay network.
+// All healthy relays are queried in parallel, and the highest valid bid received before the deadline is returned.
 func (s *Service) GetHeader(ctx context.Context, slot primitives.Slot, parentHash [32]byte, pubKey [48]byte) (builder.SignedBid, error) {
 	ctx, span := trace.StartSpan(ctx, "builder.GetHeader")
 	defer span.End()
@@ -115,27 +158,80 @@ func (s *Service) GetHeader(ctx context.Context, slot primitives.Slot, parentHas
 	defer func() {
 		getHeaderLatency.Observe(float64(time.Since(start).Milliseconds()))
 	}()
-	if s.c == nil {
+	if len(s.relays) == 0 {
 		tracing.AnnotateError(span, ErrNoBuilder)
 		return nil, ErrNoBuilder
 	}
 
-	h, err := s.c.GetHeader(ctx, slot, parentHash, pubKey)
-	tracing.AnnotateError(span, err)
-	return h, err
+	ctx, cancel := context.WithTimeout(ctx, getHeaderTimeout)
+	defer cancel()
+
+	type relayBid struct {
+		relay     *relay
+		bid       builder.SignedBid
+		value     *big.Int
+		blockHash [32]byte
+	}
+	bids := make([]*relayBid, len(s.relays))
+	var wg sync.WaitGroup
+	for i, r := range s.relays {
+		if !r.healthy() {
+			log.WithField("endpoint", r.url()).Debug("Skipping unhealthy relay")
+			continue
+		}
+		wg.Add(1)
+		go func(i int, r *relay) {
+			defer wg.Done()
+			relayStart := time.Now()
+			bid, err := r.client.GetHeader(ctx, slot, parentHash, pubKey)
+			if errors.Is(err, builder.ErrNoContent) {
+				// The relay has no bid for this slot, which is not a failure of the relay.
+				r.observe(getHeaderMethod, relayStart, nil)
+				return
+			}
+			r.observe(getHeaderMethod, relayStart, err)
+			if err != nil {
+				log.WithError(err).WithField("endpoint", r.url()).Debug("Could not get header from relay")
+				return
+			}
+			value, blockHash, err := validateBid(bid, parentHash)
+			if err != nil {
+				relayInvalidBids.WithLabelValues(r.url()).Inc()
+				log.WithError(err).WithField("endpoint", r.url()).Warn("Relay returned an invalid bid")
+				return
+			}
+			bids[i] = &relayBid{relay: r, bid: bid, value: value, blockHash: blockHash}
+		}(i, r)
+	}
+	wg.Wait()
+
+	var best *relayBid
+	for _, b := range bids {
+		if b != nil && (best == nil || b.value.Cmp(best.value) > 0) {
+			best = b
+		}
+	}
+	if best == nil {
+		err := errors.New("no valid bid received from any relay")
+		tracing.AnnotateError(span, err)
+		return nil, err
+	}
+	relayWinningBids.WithLabelValues(best.relay.url()).Inc()
+	log.WithFields(log.Fields{
+		"endpoint": best.relay.url(),
+		"value":    best.value.String(),
+		"slot":     slot,
+	}).Debug("Selected highest bid among relays")
+	s.recordBidSource(slot, best.blockHash, best.relay)
+	return best.bid, nil
 }
 
 // Status retrieves the status of the builder relay network.
 func (s *Service) Status() error {
-	// Return early if builder isn't initialized in service.
-	if s.c == nil {
-		return nil
-	}
-
 	return nil
 }
 
-// RegisterValidator registers a validator with the builder relay network.
+// RegisterValidator registers a validator with every relay of the builder relay network.
 // It also saves the registration object to the DB.
 func (s *Service) RegisterValidator(ctx context.Context, reg []*ethpb.SignedValidatorRegistrationV1) error {
 	ctx, span := trace.StartSpan(ctx, "builder.RegisterValidator")
@@ -144,7 +240,7 @@ func (s *Service) RegisterValidator(ctx context.Context, reg []*ethpb.SignedVali
 	defer func() {
 		registerValidatorLatency.Observe(float64(time.Since(start).Milliseconds()))
 	}()
-	if s.c == nil {
+	if len(s.relays) == 0 {
 		return ErrNoBuilder
 	}
 
@@ -169,7 +265,7 @@ func (s *Service) RegisterValidator(ctx context.Context, reg []*ethpb.SignedVali
 		valid = append(valid, r)
 		indexToRegistration[nx] = r.Message
 	}
-	if err := s.c.RegisterValidator(ctx, valid); err != nil {
+	if err := s.registerWithRelays(ctx, valid); err != nil {
 		return errors.Wrap(err, "could not register validator(s)")
 	}
 
@@ -198,7 +294,64 @@ func (s *Service) RegistrationByValidatorID(ctx context.Context, id primitives.V
 
 // Configured returns true if the user has configured a builder client.
 func (s *Service) Configured() bool {
-	return s.c != nil && !reflect.ValueOf(s.c).IsNil()
+	return len(s.relays) > 0
+}
+
+// registerWithRelays sends the registrations to every relay in parallel,
+// it fails only if no relay accepted them.
+func (s *Service) registerWithRelays(ctx context.Context, reg []*ethpb.SignedValidatorRegistrationV1) error {
+	errs := make([]error, len(s.relays))
+	var wg sync.WaitGroup
+	for i, r := range s.relays {
+		wg.Add(1)
+		go func(i int, r *relay) {
+			defer wg.Done()
+			start := time.Now()
+			err := r.client.RegisterValidator(ctx, reg)
+			r.observe(registerValidatorMethod, start, err)
+			if err != nil {
+				log.WithError(err).WithField("endpoint", r.url()).Warn("Could not register validators with relay")
+			}
+			errs[i] = err
+		}(i, r)
+	}
+	wg.Wait()
+	for _, err := range errs {
+		if err == nil {
+			return nil
+		}
+	}
+	return errs[0]
+}
+
+// recordBidSource remembers the relay which supplied the bid for a block hash, forgetting bids of older slots.
+func (s *Service) recordBidSource(slot primitives.Slot, blockHash [32]byte, r *relay) {
+	s.bidSourcesLock.Lock()
+	defer s.bidSourcesLock.Unlock()
+	for h, src := range s.bidSources {
+		if src.slot+bidSourceRetentionSlots < slot {
+			delete(s.bidSources, h)
+		}
+	}
+	s.bidSources[blockHash] = &bidSource{relay: r, slot: slot}
+}
+
+// bidRelay returns the relay which supplied the bid of a blinded block, or nil if it is not known.
+func (s *Service) bidRelay(b interfaces.ReadOnlySignedBeaconBlock) *relay {
+	if b == nil || b.IsNil() {
+		return nil
+	}
+	header, err := b.Block().Body().Execution()
+	if err != nil || header == nil {
+		return nil
+	}
+	s.bidSourcesLock.Lock()
+	defer s.bidSourcesLock.Unlock()
+	src, ok := s.bidSources[bytesutil.ToBytes32(header.BlockHash())]
+	if !ok {
+		return nil
+	}
+	return src.relay
 }
 
 func (s *Service) pollRelayerStatus(ctx context.Context) {
@@ -207,13 +360,21 @@ func (s *Service) pollRelayerStatus(ctx context.Context) {
 	for {
 		select {
 		case <-ticker.C:
-			if s.c != nil {
-				if err := s.c.Status(ctx); err != nil {
-					log.WithError(err).Error("Failed to call relayer status endpoint, perhaps mev-boost or relayers are down")
-				}
-			}
+			s.checkRelaysStatus(ctx)
 		case <-ctx.Done():
 			return
 		}
 	}
 }
+
+// checkRelaysStatus calls the status endpoint of every relay, which makes relays answering it eligible for headers again.
+func (s *Service) checkRelaysStatus(ctx context.Context) {
+	for _, r := range s.relays {
+		start := time.Now()
+		err := r.client.Status(ctx)
+		r.observe(statusMethod, start, err)
+		if err != nil {
+			log.WithError(err).WithField("endpoint", r.url()).Error("Failed to call relayer status endpoint, perhaps mev-boost or relayers are down")
+		}
+	}
+}
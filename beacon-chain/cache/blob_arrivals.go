@@ -0,0 +1,85 @@
+package cache
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/v4/config/params"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+)
+
+// BlobArrival records when, and from which peer, a blob sidecar was first received over gossip.
+type BlobArrival struct {
+	Index uint64
+	Peer  string
+	Time  time.Time
+}
+
+type blockBlobArrivals struct {
+	slot     primitives.Slot
+	arrivals map[uint64]BlobArrival
+}
+
+// BlobArrivalsCache keeps the gossip arrivals of the blob sidecars of recent blocks, so that the
+// data availability of a block can be explained after the fact.
+type BlobArrivalsCache struct {
+	blocks map[[32]byte]*blockBlobArrivals
+	sync.RWMutex
+}
+
+// NewBlobArrivalsCache creates a new blob arrivals cache.
+func NewBlobArrivalsCache() *BlobArrivalsCache {
+	return &BlobArrivalsCache{
+		blocks: make(map[[32]byte]*blockBlobArrivals),
+	}
+}
+
+// Add records the arrival of the blob sidecar at the index of the block root. Only the first arrival of
+// each index is kept. Arrivals for blocks more than two epochs older than the slot are pruned.
+func (c *BlobArrivalsCache) Add(root [32]byte, slot primitives.Slot, index uint64, peer string, t time.Time) {
+	c.Lock()
+	defer c.Unlock()
+
+	c.prune(slot)
+	b, ok := c.blocks[root]
+	if !ok {
+		b = &blockBlobArrivals{slot: slot, arrivals: make(map[uint64]BlobArrival)}
+		c.blocks[root] = b
+	}
+	if _, ok := b.arrivals[index]; ok {
+		return
+	}
+	b.arrivals[index] = BlobArrival{Index: index, Peer: peer, Time: t}
+}
+
+// Arrivals returns the recorded blob sidecar arrivals of the block root, ordered by index.
+func (c *BlobArrivalsCache) Arrivals(root [32]byte) []BlobArrival {
+	c.RLock()
+	defer c.RUnlock()
+
+	b, ok := c.blocks[root]
+	if !ok {
+		return nil
+	}
+	arrivals := make([]BlobArrival, 0, len(b.arrivals))
+	for _, a := range b.arrivals {
+		arrivals = append(arrivals, a)
+	}
+	sort.Slice(arrivals, func(i, j int) bool {
+		return arrivals[i].Index < arrivals[j].Index
+	})
+	return arrivals
+}
+
+func (c *BlobArrivalsCache) prune(slot primitives.Slot) {
+	retained := 2 * params.BeaconConfig().SlotsPerEpoch
+	if slot < retained {
+		return
+	}
+	for root, b := range c.blocks {
+		if b.slot < slot-retained {
+			delete(c.blocks, root)
+		}
+	}
+}
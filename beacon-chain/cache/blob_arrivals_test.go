@@ -0,0 +1,35 @@
+package cache
+
+import (
+	"testing"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/v4/config/params"
+	"github.com/prysmaticlabs/prysm/v4/testing/require"
+)
+
+func TestBlobArrivalsCache(t *testing.T) {
+	c := NewBlobArrivalsCache()
+	root := [32]byte{'a'}
+	now := time.Now()
+
+	require.Equal(t, 0, len(c.Arrivals(root)))
+	c.Add(root, 10, 1, "peer1", now)
+	c.Add(root, 10, 0, "peer2", now.Add(time.Second))
+	// Only the first arrival of an index is kept.
+	c.Add(root, 10, 1, "peer3", now.Add(2*time.Second))
+
+	arrivals := c.Arrivals(root)
+	require.Equal(t, 2, len(arrivals))
+	require.Equal(t, uint64(0), arrivals[0].Index)
+	require.Equal(t, "peer2", arrivals[0].Peer)
+	require.Equal(t, uint64(1), arrivals[1].Index)
+	require.Equal(t, "peer1", arrivals[1].Peer)
+	require.Equal(t, now, arrivals[1].Time)
+
+	// Arrivals more than two epochs old are pruned.
+	other := [32]byte{'b'}
+	c.Add(other, 11+2*params.BeaconConfig().SlotsPerEpoch, 0, "peer1", now)
+	require.Equal(t, 0, len(c.Arrivals(root)))
+	require.Equal(t, 1, len(c.Arrivals(other)))
+}
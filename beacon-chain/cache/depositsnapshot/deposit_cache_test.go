@@ -1197,3 +1197,68 @@ func emptyEth1data() *ethpb.Eth1Data {
 		BlockHash:    make([]byte, 32),
 	}
 }
+
+func TestInsertFinalizedDepositSnapshot(t *testing.T) {
+	ctx := context.Background()
+	deposits := make([]*ethpb.Deposit, 4)
+	fullTree := NewDepositTree()
+	roots := make([][32]byte, len(deposits))
+	for i := range deposits {
+		deposits[i] = &ethpb.Deposit{
+			Data: &ethpb.Deposit_Data{
+				PublicKey:             bytesutil.PadTo([]byte{byte(i)}, 48),
+				WithdrawalCredentials: make([]byte, 32),
+				Signature:             make([]byte, 96),
+			},
+			Proof: [][]byte{{byte(i)}},
+		}
+		dataRoot, err := deposits[i].Data.HashTreeRoot()
+		require.NoError(t, err)
+		require.NoError(t, fullTree.Insert(dataRoot[:], i))
+		roots[i], err = fullTree.HashTreeRoot()
+		require.NoError(t, err)
+	}
+
+	snapshotTree := NewDepositTree()
+	for i := 0; i < 2; i++ {
+		dataRoot, err := deposits[i].Data.HashTreeRoot()
+		require.NoError(t, err)
+		require.NoError(t, snapshotTree.Insert(dataRoot[:], i))
+	}
+	require.NoError(t, snapshotTree.Finalize(1, [32]byte{'a'}, 100))
+	snapshot, err := snapshotTree.ToProto()
+	require.NoError(t, err)
+
+	dc, err := New()
+	require.NoError(t, err)
+	require.NoError(t, dc.InsertFinalizedDepositSnapshot(ctx, snapshot))
+	fd, err := dc.FinalizedDeposits(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), fd.MerkleTrieIndex())
+	require.ErrorContains(t, "empty cache", dc.InsertFinalizedDepositSnapshot(ctx, snapshot))
+
+	for i := 2; i < len(deposits); i++ {
+		require.NoError(t, dc.InsertDeposit(ctx, deposits[i], uint64(100+10*i), int64(i), roots[i]))
+	}
+
+	count, root := dc.DepositsNumberAndRootAtHeight(ctx, big.NewInt(110))
+	assert.Equal(t, uint64(2), count)
+	assert.Equal(t, roots[1], root)
+	count, root = dc.DepositsNumberAndRootAtHeight(ctx, big.NewInt(120))
+	assert.Equal(t, uint64(3), count)
+	assert.Equal(t, roots[2], root)
+	count, _ = dc.DepositsNumberAndRootAtHeight(ctx, big.NewInt(99))
+	assert.Equal(t, uint64(0), count)
+
+	require.NoError(t, dc.InsertFinalizedDeposits(ctx, 2, [32]byte{'b'}, 120))
+	fd, err = dc.FinalizedDeposits(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), fd.MerkleTrieIndex())
+	finalizedRoot, err := fd.Deposits().HashTreeRoot()
+	require.NoError(t, err)
+	assert.Equal(t, roots[2], finalizedRoot)
+
+	require.NoError(t, dc.PruneProofs(ctx, 2))
+	assert.DeepEqual(t, [][]byte(nil), dc.deposits[0].Deposit.Proof)
+	assert.DeepEqual(t, [][]byte{{3}}, dc.deposits[1].Deposit.Proof)
+}
@@ -29,8 +29,11 @@ type Cache struct {
 	pendingDeposits   []*ethpb.DepositContainer
 	deposits          []*ethpb.DepositContainer
 	finalizedDeposits finalizedDepositsContainer
-	depositsByKey     map[[fieldparams.BLSPubkeyLength]byte][]*ethpb.DepositContainer
-	depositsLock      sync.RWMutex
+	// depositSnapshot is the snapshot the finalized deposits were initialized from, in which case
+	// the deposits preceding it are not held by the cache.
+	depositSnapshot *ethpb.DepositSnapshot
+	depositsByKey   map[[fieldparams.BLSPubkeyLength]byte][]*ethpb.DepositContainer
+	depositsLock    sync.RWMutex
 }
 
 // finalizedDepositsContainer stores the trie of deposits that have been included
@@ -134,9 +137,13 @@ func (c *Cache) DepositsNumberAndRootAtHeight(ctx context.Context, blockHeight *
 	// send the deposit root of the empty trie, if eth1follow distance is greater than the time of the earliest
 	// deposit.
 	if heightIdx == 0 {
+		// Deposits preceding the snapshot the cache was initialized from are only known through the snapshot.
+		if c.depositSnapshot != nil && blockHeight.Uint64() >= c.depositSnapshot.ExecutionDepth {
+			return c.depositSnapshot.DepositCount, bytesutil.ToBytes32(c.depositSnapshot.DepositRoot)
+		}
 		return 0, [32]byte{}
 	}
-	return uint64(heightIdx), bytesutil.ToBytes32(c.deposits[heightIdx-1].DepositRoot)
+	return uint64(c.deposits[heightIdx-1].Index + 1), bytesutil.ToBytes32(c.deposits[heightIdx-1].DepositRoot)
 }
 
 // FinalizedDeposits returns the finalized deposits trie.
@@ -185,11 +192,10 @@ func (c *Cache) PruneProofs(ctx context.Context, untilDepositIndex int64) error
 	c.depositsLock.Lock()
 	defer c.depositsLock.Unlock()
 
-	if untilDepositIndex >= int64(len(c.deposits)) {
-		untilDepositIndex = int64(len(c.deposits) - 1)
-	}
-
-	for i := untilDepositIndex; i >= 0; i-- {
+	for i := len(c.deposits) - 1; i >= 0; i-- {
+		if c.deposits[i].Index > untilDepositIndex {
+			continue
+		}
 		// Finding a nil proof means that all proofs up to this deposit have been already pruned.
 		if c.deposits[i].Deposit.Proof == nil {
 			break
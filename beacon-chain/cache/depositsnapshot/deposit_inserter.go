@@ -40,8 +40,8 @@ func (c *Cache) InsertDeposit(ctx context.Context, d *ethpb.Deposit, blockNum ui
 	c.depositsLock.Lock()
 	defer c.depositsLock.Unlock()
 
-	if int(index) != len(c.deposits) {
-		return errors.Errorf("wanted deposit with index %d to be inserted but received %d", len(c.deposits), index)
+	if next := c.nextDepositIndex(); index != next {
+		return errors.Errorf("wanted deposit with index %d to be inserted but received %d", next, index)
 	}
 	// Keep the slice sorted on insertion in order to avoid costly sorting on retrieval.
 	heightIdx := sort.Search(len(c.deposits), func(i int) bool { return c.deposits[i].Index >= index })
@@ -58,6 +58,18 @@ func (c *Cache) InsertDeposit(ctx context.Context, d *ethpb.Deposit, blockNum ui
 	return nil
 }
 
+// nextDepositIndex returns the index of the deposit following the cached ones. Deposits of a cache
+// initialized from a deposit snapshot start after the snapshot.
+func (c *Cache) nextDepositIndex() int64 {
+	if len(c.deposits) > 0 {
+		return c.deposits[0].Index + int64(len(c.deposits))
+	}
+	if c.depositSnapshot != nil {
+		return int64(c.depositSnapshot.DepositCount) // lint:ignore uintcast -- deposit count will not exceed int64 in your lifetime.
+	}
+	return 0
+}
+
 // InsertDepositContainers inserts a set of deposit containers into our deposit cache.
 func (c *Cache) InsertDepositContainers(ctx context.Context, ctrs []*ethpb.DepositContainer) {
 	ctx, span := trace.StartSpan(ctx, "Cache.InsertDepositContainers")
@@ -99,8 +111,8 @@ func (c *Cache) InsertFinalizedDeposits(ctx context.Context, eth1DepositIndex in
 	}
 	// In the event we have less deposits than we need to
 	// finalize we finalize till the index on which we do have it.
-	if len(c.deposits) <= int(eth1DepositIndex) {
-		eth1DepositIndex = int64(len(c.deposits)) - 1
+	if lastIndex := c.deposits[len(c.deposits)-1].Index; lastIndex < eth1DepositIndex {
+		eth1DepositIndex = lastIndex
 	}
 	// If we finalize to some lower deposit index, we
 	// ignore it.
@@ -132,3 +144,24 @@ func (c *Cache) InsertFinalizedDeposits(ctx context.Context, eth1DepositIndex in
 	}
 	return nil
 }
+
+// InsertFinalizedDepositSnapshot initializes the finalized deposits from a deposit snapshot, so that the cache
+// only needs to hold the deposits following it. This must be done before inserting any deposit.
+func (c *Cache) InsertFinalizedDepositSnapshot(ctx context.Context, snapshot *ethpb.DepositSnapshot) error {
+	_, span := trace.StartSpan(ctx, "Cache.InsertFinalizedDepositSnapshot")
+	defer span.End()
+
+	depositTrie, err := DepositTreeFromSnapshotProto(snapshot)
+	if err != nil {
+		return errors.Wrap(err, "could not create deposit tree from snapshot")
+	}
+	c.depositsLock.Lock()
+	defer c.depositsLock.Unlock()
+
+	if len(c.deposits) > 0 || c.finalizedDeposits.MerkleTrieIndex() >= 0 {
+		return errors.New("deposit snapshot can only be inserted in an empty cache")
+	}
+	c.finalizedDeposits = toFinalizedDepositsContainer(depositTrie, int64(snapshot.DepositCount)-1) // lint:ignore uintcast -- deposit count will not exceed int64 in your lifetime.
+	c.depositSnapshot = snapshot
+	return nil
+}
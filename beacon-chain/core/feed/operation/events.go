@@ -2,6 +2,7 @@
 package operation
 
 import (
+	slashertypes "github.com/prysmaticlabs/prysm/v4/beacon-chain/slasher/types"
 	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
 )
 
@@ -25,6 +26,12 @@ const (
 
 	// BlobSidecarReceived is sent after a blob sidecar is received from gossip or rpc.
 	BlobSidecarReceived = 6
+
+	// BlobSidecarEquivocationDetected is sent after the slasher detected conflicting blob sidecars signed by a proposer.
+	BlobSidecarEquivocationDetected = 7
+
+	// SyncCommitteeEquivocationDetected is sent after the slasher detected conflicting sync committee messages signed by a validator.
+	SyncCommitteeEquivocationDetected = 8
 )
 
 // UnAggregatedAttReceivedData is the data sent with UnaggregatedAttReceived events.
@@ -60,3 +67,13 @@ type BLSToExecutionChangeReceivedData struct {
 type BlobSidecarReceivedData struct {
 	Blob *ethpb.SignedBlobSidecar
 }
+
+// BlobSidecarEquivocationDetectedData is the data sent with BlobSidecarEquivocationDetected events.
+type BlobSidecarEquivocationDetectedData struct {
+	Equivocation *slashertypes.BlobSidecarEquivocation
+}
+
+// SyncCommitteeEquivocationDetectedData is the data sent with SyncCommitteeEquivocationDetected events.
+type SyncCommitteeEquivocationDetectedData struct {
+	Equivocation *slashertypes.SyncCommitteeEquivocation
+}
@@ -0,0 +1,138 @@
+package era
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+
+	"github.com/golang/snappy"
+	"github.com/pkg/errors"
+)
+
+// An e2store file is a sequence of records, each made of an 8 byte header followed by the record data.
+// The header holds the record type, the length of the data as a little-endian uint32 and two reserved bytes.
+const headerSize = 8
+
+type recordType [2]byte
+
+var (
+	typeVersion         = recordType{0x65, 0x32}
+	typeCompressedBlock = recordType{0x01, 0x00}
+	typeCompressedState = recordType{0x02, 0x00}
+	typeSlotIndex       = recordType{0x69, 0x32}
+)
+
+var errInvalidRecord = errors.New("invalid e2store record")
+
+// recordWriter writes e2store records and keeps track of the offset of the next record in the file.
+type recordWriter struct {
+	w   io.Writer
+	pos int64
+}
+
+func (rw *recordWriter) write(t recordType, data []byte) (int64, error) {
+	if uint64(len(data)) > uint64(^uint32(0)) {
+		return 0, errors.Wrapf(errInvalidRecord, "record of %d bytes is too large", len(data))
+	}
+	var header [headerSize]byte
+	copy(header[:2], t[:])
+	binary.LittleEndian.PutUint32(header[2:6], uint32(len(data)))
+	start := rw.pos
+	if _, err := rw.w.Write(header[:]); err != nil {
+		return 0, err
+	}
+	if _, err := rw.w.Write(data); err != nil {
+		return 0, err
+	}
+	rw.pos += headerSize + int64(len(data))
+	return start, nil
+}
+
+// readRecord reads the record starting at the given offset of the file.
+func readRecord(r io.ReaderAt, off int64) (recordType, []byte, error) {
+	var header [headerSize]byte
+	if _, err := r.ReadAt(header[:], off); err != nil {
+		return recordType{}, nil, errors.Wrapf(err, "could not read record header at offset %d", off)
+	}
+	var t recordType
+	copy(t[:], header[:2])
+	if header[6] != 0 || header[7] != 0 {
+		return t, nil, errors.Wrapf(errInvalidRecord, "non-zero reserved bytes at offset %d", off)
+	}
+	data := make([]byte, binary.LittleEndian.Uint32(header[2:6]))
+	if _, err := r.ReadAt(data, off+headerSize); err != nil {
+		return t, nil, errors.Wrapf(err, "could not read record data at offset %d", off)
+	}
+	return t, data, nil
+}
+
+// slotIndex maps the slots of an era to the offsets of their records. The offsets are relative to the start of
+// the slot index record itself, and zero for slots without a record.
+type slotIndex struct {
+	start   uint64
+	offsets []int64
+}
+
+func (si *slotIndex) marshal() []byte {
+	out := make([]byte, 0, 16+8*len(si.offsets))
+	out = binary.LittleEndian.AppendUint64(out, si.start)
+	for _, o := range si.offsets {
+		out = binary.LittleEndian.AppendUint64(out, uint64(o))
+	}
+	return binary.LittleEndian.AppendUint64(out, uint64(len(si.offsets)))
+}
+
+func slotIndexSize(count int) int64 {
+	return headerSize + 16 + 8*int64(count)
+}
+
+// readSlotIndex reads the slot index record that ends at the given offset of the file.
+func readSlotIndex(r io.ReaderAt, end int64) (*slotIndex, int64, error) {
+	var enc [8]byte
+	if end < headerSize+16 {
+		return nil, 0, errors.Wrap(errInvalidRecord, "file too small for a slot index")
+	}
+	if _, err := r.ReadAt(enc[:], end-8); err != nil {
+		return nil, 0, errors.Wrap(err, "could not read slot index count")
+	}
+	count := binary.LittleEndian.Uint64(enc[:])
+	if count > uint64(end) {
+		return nil, 0, errors.Wrapf(errInvalidRecord, "slot index count %d larger than file", count)
+	}
+	off := end - slotIndexSize(int(count))
+	if off < 0 {
+		return nil, 0, errors.Wrapf(errInvalidRecord, "slot index count %d larger than file", count)
+	}
+	t, data, err := readRecord(r, off)
+	if err != nil {
+		return nil, 0, err
+	}
+	if t != typeSlotIndex || int64(len(data)) != slotIndexSize(int(count))-headerSize {
+		return nil, 0, errors.Wrapf(errInvalidRecord, "expected slot index at offset %d", off)
+	}
+	si := &slotIndex{
+		start:   binary.LittleEndian.Uint64(data[:8]),
+		offsets: make([]int64, count),
+	}
+	for i := range si.offsets {
+		si.offsets[i] = int64(binary.LittleEndian.Uint64(data[8+8*i:]))
+	}
+	return si, off, nil
+}
+
+// compress encodes data with the snappy framing format used by era files.
+func compress(data []byte) ([]byte, error) {
+	var buf bytes.Buffer
+	w := snappy.NewBufferedWriter(&buf)
+	if _, err := w.Write(data); err != nil {
+		return nil, err
+	}
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func decompress(data []byte) ([]byte, error) {
+	return io.ReadAll(snappy.NewReader(bytes.NewReader(data)))
+}
@@ -0,0 +1,295 @@
+// Package era reads and writes era files, the standard archive format for finalized beacon chain history.
+//
+// An era file is an e2store file holding the blocks of one era of SLOTS_PER_HISTORICAL_ROOT slots, followed by the
+// state at the first slot of the next era, and the slot indices used to find the records:
+//
+//	era := Version | block* | state | slot-index(block)? | slot-index(state)
+//
+// Era N holds the blocks of slots [(N-1)*SLOTS_PER_HISTORICAL_ROOT, N*SLOTS_PER_HISTORICAL_ROOT) and the state at
+// slot N*SLOTS_PER_HISTORICAL_ROOT, before the block at that slot is applied. The genesis era only holds the genesis
+// state. Blocks and states are ssz encoded and compressed with snappy framing.
+package era
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/v4/config/params"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/interfaces"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v4/encoding/ssz/detect"
+)
+
+var (
+	errBlindedBlock     = errors.New("era files require full blocks, but the block is blinded")
+	errSlotOutsideEra   = errors.New("slot is outside of the era")
+	errBlockOrder       = errors.New("blocks must be added in increasing slot order")
+	errUnexpectedRecord = errors.New("unexpected record type")
+)
+
+// StartSlot returns the slot of the first block of the given era.
+func StartSlot(era uint64) primitives.Slot {
+	if era == 0 {
+		return 0
+	}
+	return primitives.Slot((era - 1) * uint64(params.BeaconConfig().SlotsPerHistoricalRoot))
+}
+
+// StateSlot returns the slot of the state stored in the given era.
+func StateSlot(era uint64) primitives.Slot {
+	return primitives.Slot(era * uint64(params.BeaconConfig().SlotsPerHistoricalRoot))
+}
+
+// Filename returns the standard name of the era file, which contains the config name, the era number and the first
+// four bytes of the historical root of the era.
+func Filename(configName string, era uint64, shortRoot [4]byte) string {
+	return fmt.Sprintf("%s-%05d-%x.era", configName, era, shortRoot)
+}
+
+// ShortHistoricalRoot returns the first bytes of the root identifying the era the state is stored in,
+// which is the last historical root of the state, or its genesis validators root for the genesis era.
+func ShortHistoricalRoot(st state.ReadOnlyBeaconState, era uint64) ([4]byte, error) {
+	var short [4]byte
+	if era == 0 {
+		copy(short[:], st.GenesisValidatorsRoot())
+		return short, nil
+	}
+	roots, err := st.HistoricalRoots()
+	if err != nil {
+		return short, err
+	}
+	if era <= uint64(len(roots)) {
+		copy(short[:], roots[era-1])
+		return short, nil
+	}
+	summaries, err := st.HistoricalSummaries()
+	if err != nil {
+		return short, err
+	}
+	i := era - 1 - uint64(len(roots))
+	if i >= uint64(len(summaries)) {
+		return short, fmt.Errorf("state at slot %d has no historical root for era %d", st.Slot(), era)
+	}
+	root, err := summaries[i].HashTreeRoot()
+	if err != nil {
+		return short, err
+	}
+	copy(short[:], root[:])
+	return short, nil
+}
+
+// Writer writes a single era file. Blocks have to be added in increasing slot order, before the state.
+type Writer struct {
+	rw        *recordWriter
+	era       uint64
+	blocks    []int64
+	lastSlot  primitives.Slot
+	hasBlocks bool
+}
+
+// NewWriter starts an era file in w.
+func NewWriter(w io.Writer, era uint64) (*Writer, error) {
+	ew := &Writer{rw: &recordWriter{w: w}, era: era}
+	if era > 0 {
+		ew.blocks = make([]int64, params.BeaconConfig().SlotsPerHistoricalRoot)
+	}
+	if _, err := ew.rw.write(typeVersion, nil); err != nil {
+		return nil, err
+	}
+	return ew, nil
+}
+
+// AddBlock appends a block of the era to the file.
+func (w *Writer) AddBlock(blk interfaces.ReadOnlySignedBeaconBlock) error {
+	if blk.IsBlinded() {
+		return errBlindedBlock
+	}
+	slot := blk.Block().Slot()
+	start := StartSlot(w.era)
+	if w.era == 0 || slot < start || slot >= StateSlot(w.era) {
+		return errors.Wrapf(errSlotOutsideEra, "slot %d, era %d", slot, w.era)
+	}
+	if w.hasBlocks && slot <= w.lastSlot {
+		return errors.Wrapf(errBlockOrder, "slot %d after slot %d", slot, w.lastSlot)
+	}
+	enc, err := blk.MarshalSSZ()
+	if err != nil {
+		return errors.Wrapf(err, "could not marshal block at slot %d", slot)
+	}
+	if enc, err = compress(enc); err != nil {
+		return err
+	}
+	off, err := w.rw.write(typeCompressedBlock, enc)
+	if err != nil {
+		return err
+	}
+	w.blocks[slot-start] = off
+	w.lastSlot, w.hasBlocks = slot, true
+	return nil
+}
+
+// Finish writes the state of the era and the slot indices, completing the file.
+func (w *Writer) Finish(st state.ReadOnlyBeaconState) error {
+	if st.Slot() != StateSlot(w.era) {
+		return errors.Wrapf(errSlotOutsideEra, "state at slot %d, expected slot %d", st.Slot(), StateSlot(w.era))
+	}
+	enc, err := st.MarshalSSZ()
+	if err != nil {
+		return errors.Wrap(err, "could not marshal state")
+	}
+	if enc, err = compress(enc); err != nil {
+		return err
+	}
+	stateOff, err := w.rw.write(typeCompressedState, enc)
+	if err != nil {
+		return err
+	}
+	if w.era > 0 {
+		indexOff := w.rw.pos
+		bi := &slotIndex{start: uint64(StartSlot(w.era)), offsets: make([]int64, len(w.blocks))}
+		for i, off := range w.blocks {
+			if off != 0 {
+				bi.offsets[i] = off - indexOff
+			}
+		}
+		if _, err := w.rw.write(typeSlotIndex, bi.marshal()); err != nil {
+			return err
+		}
+	}
+	si := &slotIndex{start: uint64(st.Slot()), offsets: []int64{stateOff - w.rw.pos}}
+	_, err = w.rw.write(typeSlotIndex, si.marshal())
+	return err
+}
+
+// Reader reads the records of an era file.
+type Reader struct {
+	r        io.ReaderAt
+	era      uint64
+	stateOff int64
+	blocks   []int64
+}
+
+// NewReader reads the indices of the era file of the given size in r.
+func NewReader(r io.ReaderAt, size int64) (*Reader, error) {
+	t, _, err := readRecord(r, 0)
+	if err != nil {
+		return nil, err
+	}
+	if t != typeVersion {
+		return nil, errors.Wrap(errUnexpectedRecord, "era file does not start with a version record")
+	}
+	si, siOff, err := readSlotIndex(r, size)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not read state index")
+	}
+	sphr := uint64(params.BeaconConfig().SlotsPerHistoricalRoot)
+	if len(si.offsets) != 1 || si.start%sphr != 0 {
+		return nil, errors.Wrapf(errInvalidRecord, "state index for slot %d with %d entries", si.start, len(si.offsets))
+	}
+	er := &Reader{r: r, era: si.start / sphr, stateOff: siOff + si.offsets[0]}
+	if er.era == 0 {
+		return er, nil
+	}
+	bi, biOff, err := readSlotIndex(r, siOff)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not read block index")
+	}
+	if bi.start != uint64(StartSlot(er.era)) || uint64(len(bi.offsets)) != sphr {
+		return nil, errors.Wrapf(errInvalidRecord, "block index for slot %d with %d entries in era %d", bi.start, len(bi.offsets), er.era)
+	}
+	er.blocks = make([]int64, len(bi.offsets))
+	for i, off := range bi.offsets {
+		if off != 0 {
+			er.blocks[i] = biOff + off
+		}
+	}
+	return er, nil
+}
+
+// Era returns the number of the era stored in the file.
+func (r *Reader) Era() uint64 {
+	return r.era
+}
+
+// State reads the state of the era.
+func (r *Reader) State() (state.BeaconState, error) {
+	t, enc, err := readRecord(r.r, r.stateOff)
+	if err != nil {
+		return nil, err
+	}
+	if t != typeCompressedState {
+		return nil, errors.Wrapf(errUnexpectedRecord, "expected state at offset %d", r.stateOff)
+	}
+	if enc, err = decompress(enc); err != nil {
+		return nil, errors.Wrap(err, "could not decompress state")
+	}
+	vu, err := detect.FromState(enc)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not detect state version")
+	}
+	return vu.UnmarshalBeaconState(enc)
+}
+
+// Blocks reads all the blocks of the era, in slot order.
+func (r *Reader) Blocks() ([]interfaces.ReadOnlySignedBeaconBlock, error) {
+	blks := make([]interfaces.ReadOnlySignedBeaconBlock, 0)
+	for i, off := range r.blocks {
+		if off == 0 {
+			continue
+		}
+		blk, err := r.block(off)
+		if err != nil {
+			return nil, err
+		}
+		if slot := StartSlot(r.era) + primitives.Slot(i); blk.Block().Slot() != slot {
+			return nil, errors.Wrapf(errInvalidRecord, "block at slot %d indexed at slot %d", blk.Block().Slot(), slot)
+		}
+		blks = append(blks, blk)
+	}
+	return blks, nil
+}
+
+func (r *Reader) block(off int64) (interfaces.ReadOnlySignedBeaconBlock, error) {
+	t, enc, err := readRecord(r.r, off)
+	if err != nil {
+		return nil, err
+	}
+	if t != typeCompressedBlock {
+		return nil, errors.Wrapf(errUnexpectedRecord, "expected block at offset %d", off)
+	}
+	if enc, err = decompress(enc); err != nil {
+		return nil, errors.Wrap(err, "could not decompress block")
+	}
+	vu, err := detect.FromBlock(enc)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not detect block version")
+	}
+	return vu.UnmarshalBeaconBlock(enc)
+}
+
+// Open opens the era file at the given path. The file has to be closed by the caller.
+func Open(path string) (*Reader, *os.File, error) {
+	f, err := os.Open(path) // #nosec G304
+	if err != nil {
+		return nil, nil, err
+	}
+	closeOnErr := func() {
+		if err := f.Close(); err != nil {
+			log.WithError(err).Error("Could not close era file")
+		}
+	}
+	info, err := f.Stat()
+	if err != nil {
+		closeOnErr()
+		return nil, nil, err
+	}
+	r, err := NewReader(f, info.Size())
+	if err != nil {
+		closeOnErr()
+		return nil, nil, errors.Wrapf(err, "could not read era file %s", path)
+	}
+	return r, f, nil
+}
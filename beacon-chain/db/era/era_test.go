@@ -0,0 +1,111 @@
+package era
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/v4/config/params"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/blocks"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/interfaces"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v4/crypto/bls"
+	"github.com/prysmaticlabs/prysm/v4/testing/require"
+	"github.com/prysmaticlabs/prysm/v4/testing/util"
+)
+
+func signedBlock(t *testing.T, slot primitives.Slot) interfaces.ReadOnlySignedBeaconBlock {
+	b := util.NewBeaconBlock()
+	b.Block.Slot = slot
+	sb, err := blocks.NewSignedBeaconBlock(b)
+	require.NoError(t, err)
+	return sb
+}
+
+// genesisState returns a genesis state with mainnet sized fields, and shortens the eras to 64 slots.
+// The config is renamed so that the embedded mainnet genesis state is not used.
+func genesisState(t *testing.T, n uint64) (state.BeaconState, []bls.SecretKey) {
+	params.SetupTestConfigCleanup(t)
+	st, keys := util.DeterministicGenesisState(t, n)
+	cfg := params.BeaconConfig().Copy()
+	cfg.SlotsPerHistoricalRoot = 64
+	cfg.ConfigName = "era-test"
+	params.OverrideBeaconConfig(cfg)
+	return st, keys
+}
+
+func TestWriterReader_RoundTrip(t *testing.T) {
+	st, _ := genesisState(t, 8)
+
+	t.Run("genesis era", func(t *testing.T) {
+		var buf bytes.Buffer
+		w, err := NewWriter(&buf, 0)
+		require.NoError(t, err)
+		require.ErrorIs(t, w.AddBlock(signedBlock(t, 1)), errSlotOutsideEra)
+		require.NoError(t, w.Finish(st))
+
+		r, err := NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+		require.NoError(t, err)
+		require.Equal(t, uint64(0), r.Era())
+		blks, err := r.Blocks()
+		require.NoError(t, err)
+		require.Equal(t, 0, len(blks))
+		got, err := r.State()
+		require.NoError(t, err)
+		require.DeepSSZEqual(t, st.ToProtoUnsafe(), got.ToProtoUnsafe())
+	})
+	t.Run("era with blocks", func(t *testing.T) {
+		era := uint64(3)
+		s := st.Copy()
+		require.NoError(t, s.SetSlot(StateSlot(era)))
+		var buf bytes.Buffer
+		w, err := NewWriter(&buf, era)
+		require.NoError(t, err)
+		start := StartSlot(era)
+		require.Equal(t, primitives.Slot(128), start)
+		require.ErrorIs(t, w.AddBlock(signedBlock(t, start-1)), errSlotOutsideEra)
+		for _, slot := range []primitives.Slot{start, start + 5, StateSlot(era) - 1} {
+			require.NoError(t, w.AddBlock(signedBlock(t, slot)))
+		}
+		require.ErrorIs(t, w.AddBlock(signedBlock(t, start+5)), errBlockOrder)
+		require.ErrorIs(t, w.Finish(st), errSlotOutsideEra)
+		require.NoError(t, w.Finish(s))
+
+		r, err := NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+		require.NoError(t, err)
+		require.Equal(t, era, r.Era())
+		blks, err := r.Blocks()
+		require.NoError(t, err)
+		require.Equal(t, 3, len(blks))
+		require.Equal(t, start+5, blks[1].Block().Slot())
+		got, err := r.State()
+		require.NoError(t, err)
+		require.Equal(t, StateSlot(era), got.Slot())
+
+		// a truncated file has no valid index
+		_, err = NewReader(bytes.NewReader(buf.Bytes()[:buf.Len()-1]), int64(buf.Len()-1))
+		require.NotNil(t, err)
+	})
+}
+
+func TestSlotIndex(t *testing.T) {
+	si := &slotIndex{start: 64, offsets: []int64{-100, 0, -20}}
+	var buf bytes.Buffer
+	rw := &recordWriter{w: &buf}
+	_, err := rw.write(typeVersion, nil)
+	require.NoError(t, err)
+	_, err = rw.write(typeSlotIndex, si.marshal())
+	require.NoError(t, err)
+	got, off, err := readSlotIndex(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	require.NoError(t, err)
+	require.Equal(t, int64(headerSize), off)
+	require.Equal(t, si.start, got.start)
+	require.DeepEqual(t, si.offsets, got.offsets)
+
+	_, _, err = readSlotIndex(bytes.NewReader(buf.Bytes()[:headerSize]), headerSize)
+	require.ErrorIs(t, err, errInvalidRecord)
+}
+
+func TestFilename(t *testing.T) {
+	require.Equal(t, "mainnet-01234-0a0b0c0d.era", Filename("mainnet", 1234, [4]byte{0x0a, 0x0b, 0x0c, 0x0d}))
+}
@@ -0,0 +1,283 @@
+package era
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/core/transition"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/db/iface"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/db/kv"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/v4/config/params"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/interfaces"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v4/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/v4/io/file"
+)
+
+var (
+	errNotFinalized   = errors.New("era is not finalized")
+	errMissingState   = errors.New("state not available")
+	errChainBroken    = errors.New("blocks do not form a chain")
+	errNoCanonicalBlk = errors.New("could not determine the canonical block")
+)
+
+// StateByRooter regenerates the post state of a block, for blocks whose state is not saved in the db.
+type StateByRooter interface {
+	StateByRoot(ctx context.Context, blockRoot [32]byte) (state.BeaconState, error)
+}
+
+// Exporter writes finalized history from the beacon db to era files.
+type Exporter struct {
+	db     iface.ReadOnlyDatabase
+	states StateByRooter
+}
+
+// NewExporter creates an Exporter reading from db. States that are not saved in the db are obtained from states.
+func NewExporter(db iface.ReadOnlyDatabase, states StateByRooter) *Exporter {
+	return &Exporter{db: db, states: states}
+}
+
+// LastFinalizedEra returns the highest era that can be exported, whose state slot is finalized.
+func (e *Exporter) LastFinalizedEra(ctx context.Context) (uint64, error) {
+	cp, err := e.db.FinalizedCheckpoint(ctx)
+	if err != nil {
+		return 0, err
+	}
+	slot := primitives.Slot(uint64(cp.Epoch) * uint64(params.BeaconConfig().SlotsPerEpoch))
+	return uint64(slot / params.BeaconConfig().SlotsPerHistoricalRoot), nil
+}
+
+// Export writes the era file for the given era into dir, returning the path of the file.
+// The file is written under a temporary name and renamed once complete.
+func (e *Exporter) Export(ctx context.Context, era uint64, dir string) (string, error) {
+	last, err := e.LastFinalizedEra(ctx)
+	if err != nil {
+		return "", err
+	}
+	if era > last {
+		return "", errors.Wrapf(errNotFinalized, "era %d, last finalized era %d", era, last)
+	}
+	blks, root, err := e.eraBlocks(ctx, era)
+	if err != nil {
+		return "", err
+	}
+	st, err := e.eraState(ctx, era, root)
+	if err != nil {
+		return "", err
+	}
+	short, err := ShortHistoricalRoot(st, era)
+	if err != nil {
+		return "", err
+	}
+
+	if err := file.MkdirAll(dir); err != nil {
+		return "", err
+	}
+	path := filepath.Join(dir, Filename(params.BeaconConfig().ConfigName, era, short))
+	tmp := path + ".part"
+	if err := writeFile(tmp, era, blks, st); err != nil {
+		if rerr := os.Remove(tmp); rerr != nil && !os.IsNotExist(rerr) {
+			log.WithError(rerr).Error("Could not remove partial era file")
+		}
+		return "", err
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		return "", err
+	}
+	log.WithField("era", era).WithField("blocks", len(blks)).WithField("path", path).Info("Exported era file")
+	return path, nil
+}
+
+func writeFile(path string, era uint64, blks []interfaces.ReadOnlySignedBeaconBlock, st state.ReadOnlyBeaconState) (err error) {
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, params.BeaconIoConfig().ReadWritePermissions) // #nosec G304
+	if err != nil {
+		return err
+	}
+	defer func() {
+		cerr := f.Close()
+		if cerr == nil {
+			return
+		}
+		if err == nil {
+			err = cerr
+			return
+		}
+		log.WithError(cerr).Error("Could not close era file")
+	}()
+	bw := bufio.NewWriter(f)
+	if err := writeEra(bw, era, blks, st); err != nil {
+		return err
+	}
+	if err := bw.Flush(); err != nil {
+		return err
+	}
+	return f.Sync()
+}
+
+func writeEra(bw *bufio.Writer, era uint64, blks []interfaces.ReadOnlySignedBeaconBlock, st state.ReadOnlyBeaconState) error {
+	w, err := NewWriter(bw, era)
+	if err != nil {
+		return err
+	}
+	for _, b := range blks {
+		if err := w.AddBlock(b); err != nil {
+			return err
+		}
+	}
+	return w.Finish(st)
+}
+
+// eraBlocks returns the canonical blocks of the era, and the root of the last canonical block before the state
+// slot of the era, which the state of the era is built on.
+func (e *Exporter) eraBlocks(ctx context.Context, era uint64) ([]interfaces.ReadOnlySignedBeaconBlock, [32]byte, error) {
+	if era == 0 {
+		root, err := e.db.GenesisBlockRoot(ctx)
+		return nil, root, err
+	}
+	start := StartSlot(era)
+	root, err := e.canonicalRootBelow(ctx, StateSlot(era))
+	if err != nil {
+		return nil, root, err
+	}
+	// Walk the chain back from the last block, so that only canonical blocks end up in the era.
+	blks := make([]interfaces.ReadOnlySignedBeaconBlock, 0)
+	for r := root; ; {
+		if ctx.Err() != nil {
+			return nil, root, ctx.Err()
+		}
+		b, err := e.db.Block(ctx, r)
+		if err != nil {
+			return nil, root, err
+		}
+		if b == nil || b.IsNil() {
+			return nil, root, errors.Wrapf(errChainBroken, "missing block %#x", r)
+		}
+		// The genesis block is not a signed block, and is never part of an era file.
+		if b.Block().Slot() < start || b.Block().Slot() == 0 {
+			break
+		}
+		if b.IsBlinded() {
+			return nil, root, errors.Wrapf(errBlindedBlock, "slot %d; the db has to be synced with full execution payloads", b.Block().Slot())
+		}
+		blks = append(blks, b)
+		r = b.Block().ParentRoot()
+	}
+	for i, j := 0, len(blks)-1; i < j; i, j = i+1, j-1 {
+		blks[i], blks[j] = blks[j], blks[i]
+	}
+	return blks, root, nil
+}
+
+// canonicalRootBelow returns the root of the highest finalized block below the given slot.
+func (e *Exporter) canonicalRootBelow(ctx context.Context, end primitives.Slot) ([32]byte, error) {
+	originSlot, err := e.originSlot(ctx)
+	if err != nil {
+		return [32]byte{}, err
+	}
+	for slot := end; slot > 0; {
+		slot--
+		blks, err := e.db.BlocksBySlot(ctx, slot)
+		if err != nil {
+			return [32]byte{}, err
+		}
+		for _, b := range blks {
+			r, err := b.Block().HashTreeRoot()
+			if err != nil {
+				return [32]byte{}, err
+			}
+			// Blocks imported by backfill are not part of the finalized index, but there is only one block per slot
+			// below the origin of a checkpoint synced node.
+			if e.db.IsFinalizedBlock(ctx, r) || (len(blks) == 1 && slot < originSlot) {
+				return r, nil
+			}
+		}
+		if len(blks) > 0 {
+			return [32]byte{}, errors.Wrapf(errNoCanonicalBlk, "%d blocks at slot %d", len(blks), slot)
+		}
+	}
+	return e.db.GenesisBlockRoot(ctx)
+}
+
+// originSlot returns the slot of the checkpoint sync origin block, or zero for a node synced from genesis.
+func (e *Exporter) originSlot(ctx context.Context) (primitives.Slot, error) {
+	root, err := e.db.OriginCheckpointBlockRoot(ctx)
+	if errors.Is(err, kv.ErrNotFoundOriginBlockRoot) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	b, err := e.db.Block(ctx, root)
+	if err != nil {
+		return 0, err
+	}
+	if b == nil || b.IsNil() {
+		return 0, errors.Wrapf(errChainBroken, "missing origin block %#x", root)
+	}
+	return b.Block().Slot(), nil
+}
+
+// eraState returns the state at the state slot of the era, built on the given block.
+func (e *Exporter) eraState(ctx context.Context, era uint64, root [32]byte) (state.BeaconState, error) {
+	if era == 0 {
+		st, err := e.db.GenesisState(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if st == nil || st.IsNil() {
+			return nil, errors.Wrap(errMissingState, "genesis state")
+		}
+		return st, nil
+	}
+	slot := StateSlot(era)
+	var st state.BeaconState
+	var err error
+	// An archived point at the state slot can be used directly when it was built on the same block.
+	if e.db.ArchivedPointRoot(ctx, slot) == root {
+		if st, err = e.db.State(ctx, root); err != nil {
+			return nil, err
+		}
+	}
+	if st == nil || st.IsNil() || st.Slot() > slot {
+		if e.states == nil {
+			return nil, errors.Wrapf(errMissingState, "no state for block %#x", root)
+		}
+		if st, err = e.states.StateByRoot(ctx, root); err != nil {
+			return nil, errors.Wrapf(err, "could not regenerate state for block %#x", root)
+		}
+	}
+	if st.Slot() < slot {
+		if st, err = transition.ProcessSlots(ctx, st.Copy(), slot); err != nil {
+			return nil, errors.Wrapf(err, "could not process slots up to %d", slot)
+		}
+	}
+	if err := verifyStateBlock(st, root); err != nil {
+		return nil, err
+	}
+	return st, nil
+}
+
+// verifyStateBlock checks that the latest block header of the state is the block with the given root.
+func verifyStateBlock(st state.BeaconState, root [32]byte) error {
+	header := st.LatestBlockHeader()
+	if bytesutil.ToBytes32(header.StateRoot) == params.BeaconConfig().ZeroHash {
+		sr, err := st.HashTreeRoot(context.Background())
+		if err != nil {
+			return err
+		}
+		header.StateRoot = sr[:]
+	}
+	hr, err := header.HashTreeRoot()
+	if err != nil {
+		return err
+	}
+	if hr != root {
+		return fmt.Errorf("state at slot %d is built on block %#x, expected %#x", st.Slot(), hr, root)
+	}
+	return nil
+}
@@ -0,0 +1,283 @@
+package era
+
+import (
+	"context"
+	"path/filepath"
+	"sort"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/core/signing"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/db/iface"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/db/kv"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/v4/config/params"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/interfaces"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v4/crypto/bls"
+	"github.com/prysmaticlabs/prysm/v4/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/v4/network/forks"
+	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v4/time/slots"
+)
+
+var (
+	errNotFreshDB   = errors.New("era files can only be imported into a database without blocks beyond genesis")
+	errEraSequence  = errors.New("era files must form a contiguous sequence starting at the genesis era or era 1")
+	errNoGenesis    = errors.New("genesis state is required: provide the genesis era file or initialize the database with a genesis state")
+	errNoEraFiles   = errors.New("no era files found")
+	errGenesisMatch = errors.New("genesis era does not match the genesis state of the database")
+	errRegistry     = errors.New("validator registry of the era state does not extend the registry of the previous state")
+	errUnknownProp  = errors.New("proposer index not found in the validator registry of the era state")
+	errInvalidSig   = errors.New("era contains a block with an invalid proposer signature")
+)
+
+// Import seeds a fresh database with the finalized history stored in the era files of the given directory. The
+// blocks of every era are checked to form a chain from the genesis block, and the state of every era to be built on
+// the last block of the era. Those states are saved as archived states, and the state of the last era becomes the
+// finalized checkpoint and head of the chain, from which the node continues to sync. It returns the last imported era.
+func Import(ctx context.Context, db iface.HeadAccessDatabase, dir string) (uint64, error) {
+	readers, closeAll, err := openDir(dir)
+	if err != nil {
+		return 0, err
+	}
+	defer closeAll()
+	if len(readers) == 0 {
+		return 0, errors.Wrapf(errNoEraFiles, "directory %s", dir)
+	}
+	if readers[0].Era() > 1 {
+		return 0, errors.Wrapf(errEraSequence, "first era is %d", readers[0].Era())
+	}
+	for i, r := range readers {
+		if r.Era() != readers[0].Era()+uint64(i) {
+			return 0, errors.Wrapf(errEraSequence, "era %d at position %d", r.Era(), i)
+		}
+	}
+
+	if readers[0].Era() == 0 {
+		if err := importGenesis(ctx, db, readers[0]); err != nil {
+			return 0, err
+		}
+		readers = readers[1:]
+	}
+	parent, err := db.GenesisBlockRoot(ctx)
+	if errors.Is(err, kv.ErrNotFoundGenesisBlockRoot) {
+		return 0, errNoGenesis
+	}
+	if err != nil {
+		return 0, err
+	}
+	head, err := db.HeadBlock(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if head != nil && !head.IsNil() {
+		hr, err := head.Block().HashTreeRoot()
+		if err != nil {
+			return 0, err
+		}
+		if hr != parent {
+			return 0, errNotFreshDB
+		}
+	}
+
+	// The proposer keys of every era are checked against the registry of the previous state,
+	// starting with the trusted genesis state.
+	prev, err := db.GenesisState(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if prev == nil || prev.IsNil() {
+		return 0, errNoGenesis
+	}
+	var last uint64
+	var lastState state.BeaconState
+	for _, r := range readers {
+		if ctx.Err() != nil {
+			return last, ctx.Err()
+		}
+		if parent, lastState, err = importEra(ctx, db, r, parent, prev); err != nil {
+			return last, errors.Wrapf(err, "could not import era %d", r.Era())
+		}
+		last = r.Era()
+		prev = lastState
+	}
+	if lastState == nil {
+		return last, nil
+	}
+
+	// The state of the last era is the starting point of the node, in the same way as a checkpoint sync origin.
+	cp := &ethpb.Checkpoint{Epoch: slots.ToEpoch(lastState.Slot()), Root: parent[:]}
+	if err := db.SaveJustifiedCheckpoint(ctx, cp); err != nil {
+		return last, errors.Wrap(err, "could not save justified checkpoint")
+	}
+	if err := db.SaveFinalizedCheckpoint(ctx, cp); err != nil {
+		return last, errors.Wrap(err, "could not save finalized checkpoint")
+	}
+	if err := db.SaveHeadBlockRoot(ctx, parent); err != nil {
+		return last, errors.Wrap(err, "could not save head block root")
+	}
+	log.WithField("era", last).WithField("slot", lastState.Slot()).Info("Imported era files")
+	return last, nil
+}
+
+func importGenesis(ctx context.Context, db iface.HeadAccessDatabase, r *Reader) error {
+	gs, err := r.State()
+	if err != nil {
+		return err
+	}
+	// The genesis state of the db may be embedded in the binary, so its presence is decided by the genesis block.
+	if _, err := db.GenesisBlockRoot(ctx); errors.Is(err, kv.ErrNotFoundGenesisBlockRoot) {
+		return db.SaveGenesisData(ctx, gs)
+	} else if err != nil {
+		return err
+	}
+	existing, err := db.GenesisState(ctx)
+	if err != nil {
+		return err
+	}
+	if existing == nil || existing.IsNil() {
+		return errors.Wrap(errMissingState, "genesis state")
+	}
+	want, err := existing.HashTreeRoot(ctx)
+	if err != nil {
+		return err
+	}
+	got, err := gs.HashTreeRoot(ctx)
+	if err != nil {
+		return err
+	}
+	if want != got {
+		return errors.Wrapf(errGenesisMatch, "state root %#x, expected %#x", got, want)
+	}
+	return nil
+}
+
+// importEra saves the blocks and state of an era, checking that the first block is a child of parent
+// and that the proposer signatures of the blocks are valid. It returns the root of the last block of the era,
+// and the state of the era.
+func importEra(ctx context.Context, db iface.HeadAccessDatabase, r *Reader, parent [32]byte, prev state.ReadOnlyBeaconState) ([32]byte, state.BeaconState, error) {
+	blks, err := r.Blocks()
+	if err != nil {
+		return parent, nil, err
+	}
+	for _, b := range blks {
+		if b.Block().ParentRoot() != parent {
+			return parent, nil, errors.Wrapf(errChainBroken, "block at slot %d is not a child of %#x", b.Block().Slot(), parent)
+		}
+		if parent, err = b.Block().HashTreeRoot(); err != nil {
+			return parent, nil, err
+		}
+	}
+	st, err := r.State()
+	if err != nil {
+		return parent, nil, err
+	}
+	if err := verifyStateBlock(st, parent); err != nil {
+		return parent, nil, err
+	}
+	if err := verifyProposerSignatures(blks, st, prev); err != nil {
+		return parent, nil, err
+	}
+
+	if err := db.SaveBlocks(ctx, blks); err != nil {
+		return parent, nil, errors.Wrap(err, "could not save blocks")
+	}
+	if db.StateDiffInterval() != 0 {
+		err = db.SaveStateDiff(ctx, st.Slot(), st, parent)
+	} else {
+		err = db.SaveState(ctx, st, parent)
+	}
+	if err != nil {
+		return parent, nil, errors.Wrap(err, "could not save state")
+	}
+	if err := db.SaveStateSummary(ctx, &ethpb.StateSummary{Slot: st.Slot(), Root: parent[:]}); err != nil {
+		return parent, nil, errors.Wrap(err, "could not save state summary")
+	}
+	log.WithField("era", r.Era()).WithField("blocks", len(blks)).Debug("Imported era")
+	return parent, st, nil
+}
+
+// verifyProposerSignatures batch verifies the proposer signatures of the blocks of an era. The validator registry only
+// grows, so the public keys of all proposers of the era are found in the state of the era. That registry is checked
+// to extend the one of prev, so that the keys of existing validators are the ones of the trusted genesis state or of
+// an already verified era.
+func verifyProposerSignatures(blks []interfaces.ReadOnlySignedBeaconBlock, st, prev state.ReadOnlyBeaconState) error {
+	if st.NumValidators() < prev.NumValidators() {
+		return errors.Wrapf(errRegistry, "%d validators, previous state has %d", st.NumValidators(), prev.NumValidators())
+	}
+	for i := 0; i < prev.NumValidators(); i++ {
+		idx := primitives.ValidatorIndex(i)
+		if st.PubkeyAtIndex(idx) != prev.PubkeyAtIndex(idx) {
+			return errors.Wrapf(errRegistry, "public key of validator %d changed", i)
+		}
+	}
+	gvr := st.GenesisValidatorsRoot()
+	domains := make(map[[4]byte][]byte)
+	set := bls.NewSet()
+	for _, b := range blks {
+		blk := b.Block()
+		if uint64(blk.ProposerIndex()) >= uint64(st.NumValidators()) {
+			return errors.Wrapf(errUnknownProp, "slot=%d, proposer=%d", blk.Slot(), blk.ProposerIndex())
+		}
+		fork, err := forks.Fork(slots.ToEpoch(blk.Slot()))
+		if err != nil {
+			return errors.Wrapf(err, "could not determine fork for slot %d", blk.Slot())
+		}
+		version := bytesutil.ToBytes4(fork.CurrentVersion)
+		domain, ok := domains[version]
+		if !ok {
+			if domain, err = signing.ComputeDomain(params.BeaconConfig().DomainBeaconProposer, fork.CurrentVersion, gvr); err != nil {
+				return errors.Wrapf(err, "could not compute proposer domain for fork version %#x", version)
+			}
+			domains[version] = domain
+		}
+		pub := st.PubkeyAtIndex(blk.ProposerIndex())
+		sig := b.Signature()
+		bs, err := signing.BlockSignatureBatch(pub[:], sig[:], domain, blk.HashTreeRoot)
+		if err != nil {
+			return errors.Wrapf(err, "could not build signature batch for block at slot %d", blk.Slot())
+		}
+		set.Join(bs)
+	}
+	if len(set.Signatures) == 0 {
+		return nil
+	}
+	verified, err := set.Verify()
+	if err != nil {
+		return errors.Wrap(err, "could not verify proposer signatures")
+	}
+	if !verified {
+		return errInvalidSig
+	}
+	return nil
+}
+
+// openDir opens all the era files of the directory for the active config, sorted by era.
+func openDir(dir string) ([]*Reader, func(), error) {
+	paths, err := filepath.Glob(filepath.Join(dir, params.BeaconConfig().ConfigName+"-*.era"))
+	if err != nil {
+		return nil, nil, err
+	}
+	readers := make([]*Reader, 0, len(paths))
+	closers := make([]func() error, 0, len(paths))
+	closeAll := func() {
+		for _, c := range closers {
+			if err := c(); err != nil {
+				log.WithError(err).Error("Could not close era file")
+			}
+		}
+	}
+	for _, p := range paths {
+		r, f, err := Open(p)
+		if err != nil {
+			closeAll()
+			return nil, nil, err
+		}
+		readers = append(readers, r)
+		closers = append(closers, f.Close)
+	}
+	sort.Slice(readers, func(i, j int) bool {
+		return readers[i].Era() < readers[j].Era()
+	})
+	return readers, closeAll, nil
+}
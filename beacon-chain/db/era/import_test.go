@@ -0,0 +1,170 @@
+package era
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/core/transition"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/db/iface"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/db/kv"
+	dbtest "github.com/prysmaticlabs/prysm/v4/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/v4/config/params"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/blocks"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/interfaces"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v4/testing/require"
+	"github.com/prysmaticlabs/prysm/v4/testing/util"
+)
+
+type mockStates map[[32]byte]state.BeaconState
+
+func (m mockStates) StateByRoot(_ context.Context, root [32]byte) (state.BeaconState, error) {
+	st, ok := m[root]
+	if !ok {
+		return nil, errors.New("unknown state")
+	}
+	return st.Copy(), nil
+}
+
+// setupChain fills db with a finalized chain of valid blocks up to the given slot, skipping the given slots.
+func setupChain(t *testing.T, db iface.HeadAccessDatabase, to primitives.Slot, skip map[primitives.Slot]bool) (mockStates, [32]byte) {
+	ctx := context.Background()
+	st, keys := genesisState(t, 64)
+	require.NoError(t, db.SaveGenesisData(ctx, st))
+	gr, err := db.GenesisBlockRoot(ctx)
+	require.NoError(t, err)
+	states := mockStates{gr: st.Copy()}
+	var root [32]byte
+	for slot := primitives.Slot(1); slot <= to; slot++ {
+		if skip[slot] {
+			continue
+		}
+		b, err := util.GenerateFullBlock(st, keys, util.DefaultBlockGenConfig(), slot)
+		require.NoError(t, err)
+		wsb, err := blocks.NewSignedBeaconBlock(b)
+		require.NoError(t, err)
+		st, err = transition.ExecuteStateTransition(ctx, st, wsb)
+		require.NoError(t, err)
+		root, err = wsb.Block().HashTreeRoot()
+		require.NoError(t, err)
+		require.NoError(t, db.SaveBlock(ctx, wsb))
+		require.NoError(t, db.SaveStateSummary(ctx, &ethpb.StateSummary{Slot: slot, Root: root[:]}))
+		states[root] = st.Copy()
+	}
+	cp := &ethpb.Checkpoint{Epoch: primitives.Epoch(to / params.BeaconConfig().SlotsPerEpoch), Root: root[:]}
+	require.NoError(t, db.SaveFinalizedCheckpoint(ctx, cp))
+	require.NoError(t, db.SaveHeadBlockRoot(ctx, root))
+	return states, root
+}
+
+func TestExportImport(t *testing.T) {
+	ctx := context.Background()
+	// Only one db can be open at a time, so the source db is closed once the era files are exported.
+	source, err := kv.NewKVStore(ctx, t.TempDir())
+	require.NoError(t, err)
+	// the last slot of era 1 is skipped, so its state is built on the block at slot 62
+	states, _ := setupChain(t, source, 72, map[primitives.Slot]bool{5: true, 63: true})
+
+	e := NewExporter(source, states)
+	last, err := e.LastFinalizedEra(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), last)
+	dir := filepath.Join(t.TempDir(), "era")
+	_, err = e.Export(ctx, 2, dir)
+	require.ErrorIs(t, err, errNotFinalized)
+	for era := uint64(0); era <= last; era++ {
+		path, err := e.Export(ctx, era, dir)
+		require.NoError(t, err)
+		require.Equal(t, dir, filepath.Dir(path))
+	}
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(entries))
+	require.NoError(t, source.Close())
+
+	r, f, err := Open(filepath.Join(dir, entries[1].Name()))
+	require.NoError(t, err)
+	blks, err := r.Blocks()
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+	require.Equal(t, 61, len(blks))
+	require.Equal(t, primitives.Slot(1), blks[0].Block().Slot())
+	lastRoot, err := blks[len(blks)-1].Block().HashTreeRoot()
+	require.NoError(t, err)
+
+	t.Run("fresh db", func(t *testing.T) {
+		target := dbtest.SetupDB(t)
+		got, err := Import(ctx, target, dir)
+		require.NoError(t, err)
+		require.Equal(t, uint64(1), got)
+		for _, b := range blks {
+			r, err := b.Block().HashTreeRoot()
+			require.NoError(t, err)
+			require.Equal(t, true, target.HasBlock(ctx, r))
+		}
+		head, err := target.HeadBlock(ctx)
+		require.NoError(t, err)
+		headRoot, err := head.Block().HashTreeRoot()
+		require.NoError(t, err)
+		require.Equal(t, lastRoot, headRoot)
+		cp, err := target.FinalizedCheckpoint(ctx)
+		require.NoError(t, err)
+		require.Equal(t, primitives.Epoch(2), cp.Epoch)
+		st, err := target.State(ctx, lastRoot)
+		require.NoError(t, err)
+		require.Equal(t, primitives.Slot(64), st.Slot())
+		want, err := transition.ProcessSlots(ctx, states[lastRoot].Copy(), 64)
+		require.NoError(t, err)
+		require.DeepSSZEqual(t, want.ToProtoUnsafe(), st.ToProtoUnsafe())
+
+		_, err = Import(ctx, target, dir)
+		require.ErrorIs(t, err, errNotFreshDB)
+	})
+	t.Run("missing genesis", func(t *testing.T) {
+		require.NoError(t, os.Remove(filepath.Join(dir, entries[0].Name())))
+		_, err := Import(ctx, dbtest.SetupDB(t), dir)
+		require.ErrorIs(t, err, errNoGenesis)
+	})
+	t.Run("no files", func(t *testing.T) {
+		_, err := Import(ctx, dbtest.SetupDB(t), t.TempDir())
+		require.ErrorIs(t, err, errNoEraFiles)
+	})
+}
+
+func TestVerifyProposerSignatures(t *testing.T) {
+	st, keys := genesisState(t, 64)
+	b, err := util.GenerateFullBlock(st, keys, util.DefaultBlockGenConfig(), 1)
+	require.NoError(t, err)
+	wsb, err := blocks.NewSignedBeaconBlock(b)
+	require.NoError(t, err)
+	blks := []interfaces.ReadOnlySignedBeaconBlock{wsb}
+	require.NoError(t, verifyProposerSignatures(blks, st, st))
+
+	t.Run("invalid signature", func(t *testing.T) {
+		forged := ethpb.CopySignedBeaconBlock(b)
+		forged.Signature = keys[0].Sign([]byte("forged")).Marshal()
+		wsb, err := blocks.NewSignedBeaconBlock(forged)
+		require.NoError(t, err)
+		require.ErrorIs(t, verifyProposerSignatures([]interfaces.ReadOnlySignedBeaconBlock{wsb}, st, st), errInvalidSig)
+	})
+	t.Run("unknown proposer", func(t *testing.T) {
+		forged := ethpb.CopySignedBeaconBlock(b)
+		forged.Block.ProposerIndex = 64
+		wsb, err := blocks.NewSignedBeaconBlock(forged)
+		require.NoError(t, err)
+		require.ErrorIs(t, verifyProposerSignatures([]interfaces.ReadOnlySignedBeaconBlock{wsb}, st, st), errUnknownProp)
+	})
+	t.Run("registry replaced", func(t *testing.T) {
+		replaced := st.Copy()
+		v, err := replaced.ValidatorAtIndex(0)
+		require.NoError(t, err)
+		v.PublicKey = keys[1].PublicKey().Marshal()
+		require.NoError(t, replaced.UpdateValidatorAtIndex(0, v))
+		require.ErrorIs(t, verifyProposerSignatures(blks, replaced, st), errRegistry)
+	})
+}
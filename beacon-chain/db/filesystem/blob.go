@@ -0,0 +1,330 @@
+package filesystem
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/startup"
+	fieldparams "github.com/prysmaticlabs/prysm/v4/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v4/config/params"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v4/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/v4/io/file"
+	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v4/time/slots"
+	"github.com/sirupsen/logrus"
+)
+
+var (
+	// ErrNotFound is returned when no sidecar exists on disk for the requested root and index.
+	ErrNotFound = errors.New("blob sidecar not found in storage")
+
+	errIndexOutOfBounds = errors.New("blob index in file name >= MaxBlobsPerBlock")
+	errEmptyBlobWritten = errors.New("zero bytes written to disk when saving blob sidecar")
+
+	errBlobSlotMismatch     = errors.New("sidecar slot mismatch")
+	errBlobParentMismatch   = errors.New("sidecar parent root mismatch")
+	errBlobRootMismatch     = errors.New("sidecar root mismatch")
+	errBlobProposerMismatch = errors.New("sidecar proposer index mismatch")
+	errBlobSidecarLimit     = errors.New("sidecar exceeds maximum number of blobs")
+	errEmptySidecar         = errors.New("nil or empty blob sidecars")
+)
+
+const (
+	sszExt  = "ssz"
+	partExt = "part"
+)
+
+// BlobStorageOption is a functional option for configuring a BlobStorage.
+type BlobStorageOption func(*BlobStorage) error
+
+// WithBlobRetentionEpochs is an option that changes the number of epochs blobs will be persisted.
+func WithBlobRetentionEpochs(e primitives.Epoch) BlobStorageOption {
+	return func(b *BlobStorage) error {
+		if e < params.BeaconNetworkConfig().MinEpochsForBlobsSidecarsRequest {
+			return fmt.Errorf("blob retention epochs smaller than spec default, %d < %d", e, params.BeaconNetworkConfig().MinEpochsForBlobsSidecarsRequest)
+		}
+		b.retentionEpochs = e
+		return nil
+	}
+}
+
+// NewBlobStorage creates a new instance of the BlobStorage object rooted at the given directory, creating it
+// if needed. Only one BlobStorage should be initialized per directory, since the pruner assumes it has
+// exclusive control over the files below it.
+func NewBlobStorage(base string, opts ...BlobStorageOption) (*BlobStorage, error) {
+	base = path.Clean(base)
+	if err := file.MkdirAll(base); err != nil {
+		return nil, errors.Wrapf(err, "failed to create blob storage at %s", base)
+	}
+	b := &BlobStorage{
+		base:            base,
+		retentionEpochs: params.BeaconNetworkConfig().MinEpochsForBlobsSidecarsRequest,
+	}
+	for _, o := range opts {
+		if err := o(b); err != nil {
+			return nil, errors.Wrap(err, "failed to create blob storage")
+		}
+	}
+	b.pruner = newBlobPruner(b.base, b.retentionEpochs)
+	return b, nil
+}
+
+// BlobStorage is the concrete implementation of the filesystem backend for saving and retrieving BlobSidecars.
+// Each sidecar is written as an SSZ encoded file at <base>/<block root>/<index>.ssz.
+type BlobStorage struct {
+	base            string
+	retentionEpochs primitives.Epoch
+	pruner          *blobPruner
+}
+
+// Save writes a single sidecar to disk. Saving a sidecar that is already present is a no-op.
+func (bs *BlobStorage) Save(sidecar *ethpb.BlobSidecar) error {
+	if sidecar == nil {
+		return errors.New("nil blob sidecar")
+	}
+	if sidecar.Index >= fieldparams.MaxBlobsPerBlock {
+		return errors.Wrapf(errIndexOutOfBounds, "index %d", sidecar.Index)
+	}
+	root := bytesutil.ToBytes32(sidecar.BlockRoot)
+	fname := bs.sidecarFileKey(root, sidecar.Index)
+	if file.FileExists(fname) {
+		log.WithFields(logFields(sidecar)).Debug("Ignoring a duplicate blob sidecar save attempt")
+		return nil
+	}
+	sidecarData, err := sidecar.MarshalSSZ()
+	if err != nil {
+		return errors.Wrap(err, "failed to serialize sidecar data")
+	}
+	if len(sidecarData) == 0 {
+		return errEmptyBlobWritten
+	}
+	if err := file.MkdirAll(bs.rootDir(root)); err != nil {
+		return errors.Wrapf(err, "failed to create blob directory for root %#x", root)
+	}
+
+	// Write the sidecar to a partial file first and rename it once fully written, so that a crash
+	// part way through a save never leaves a truncated sidecar where a reader would find it.
+	partPath := fmt.Sprintf("%s.%s", fname, partExt)
+	if err := file.WriteFile(partPath, sidecarData); err != nil {
+		return errors.Wrap(err, "failed to write partial blob sidecar file")
+	}
+	if err := os.Rename(partPath, fname); err != nil {
+		if rmErr := os.Remove(partPath); rmErr != nil {
+			log.WithError(rmErr).WithField("path", partPath).Warn("Could not remove partial blob sidecar file")
+		}
+		return errors.Wrap(err, "failed to rename partial blob sidecar file")
+	}
+	blobsWrittenCounter.Inc()
+	bs.pruner.notify(root, sidecar.Slot)
+	return nil
+}
+
+// SaveSidecars writes the sidecars of a single block to disk, after checking that they all belong to the same block
+// and that there are no more than MAX_BLOBS_PER_BLOCK of them. Duplicate sidecars are only saved once.
+func (bs *BlobStorage) SaveSidecars(scs []*ethpb.BlobSidecar) error {
+	// Sort a copy, so that the order of the caller's sidecars is left untouched.
+	scs = append(make([]*ethpb.BlobSidecar, 0, len(scs)), scs...)
+	sortSidecars(scs)
+	scs, err := validUniqueSidecars(scs)
+	if err != nil {
+		return err
+	}
+	for _, sc := range scs {
+		if err := bs.Save(sc); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validUniqueSidecars ensures that all sidecars have the same slot, parent root, block root, and proposer index, and no more than MAX_BLOBS_PER_BLOCK.
+// The sidecars must be sorted by index, so that duplicates can be skipped.
+func validUniqueSidecars(scs []*ethpb.BlobSidecar) ([]*ethpb.BlobSidecar, error) {
+	if len(scs) == 0 {
+		return nil, errEmptySidecar
+	}
+
+	// If there's only 1 sidecar, we've got nothing to compare.
+	if len(scs) == 1 {
+		return scs, nil
+	}
+
+	prev := scs[0]
+	didx := 1
+	for i := 1; i < len(scs); i++ {
+		sc := scs[i]
+		if sc.Slot != prev.Slot {
+			return nil, errors.Wrapf(errBlobSlotMismatch, "%d != %d", sc.Slot, prev.Slot)
+		}
+		if !bytes.Equal(sc.BlockParentRoot, prev.BlockParentRoot) {
+			return nil, errors.Wrapf(errBlobParentMismatch, "%x != %x", sc.BlockParentRoot, prev.BlockParentRoot)
+		}
+		if !bytes.Equal(sc.BlockRoot, prev.BlockRoot) {
+			return nil, errors.Wrapf(errBlobRootMismatch, "%x != %x", sc.BlockRoot, prev.BlockRoot)
+		}
+		if sc.ProposerIndex != prev.ProposerIndex {
+			return nil, errors.Wrapf(errBlobProposerMismatch, "%d != %d", sc.ProposerIndex, prev.ProposerIndex)
+		}
+		// skip duplicate
+		if sc.Index == prev.Index {
+			continue
+		}
+		if didx != i {
+			scs[didx] = scs[i]
+		}
+		prev = scs[i]
+		didx += 1
+	}
+
+	if didx > fieldparams.MaxBlobsPerBlock {
+		return nil, errors.Wrapf(errBlobSidecarLimit, "%d > %d", didx, fieldparams.MaxBlobsPerBlock)
+	}
+	return scs[0:didx], nil
+}
+
+// sortSidecars sorts the sidecars by their index.
+func sortSidecars(scs []*ethpb.BlobSidecar) {
+	sort.Slice(scs, func(i, j int) bool {
+		return scs[i].Index < scs[j].Index
+	})
+}
+
+// Get retrieves a single BlobSidecar by its root and index.
+// An error wrapping ErrNotFound is returned if the sidecar is not on disk.
+func (bs *BlobStorage) Get(root [32]byte, idx uint64) (*ethpb.BlobSidecar, error) {
+	if idx >= fieldparams.MaxBlobsPerBlock {
+		return nil, errors.Wrapf(errIndexOutOfBounds, "index %d", idx)
+	}
+	enc, err := os.ReadFile(bs.sidecarFileKey(root, idx))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, errors.Wrapf(ErrNotFound, "root=%#x, index=%d", root, idx)
+		}
+		return nil, errors.Wrap(err, "failed to read blob sidecar file")
+	}
+	s := &ethpb.BlobSidecar{}
+	if err := s.UnmarshalSSZ(enc); err != nil {
+		return nil, errors.Wrap(err, "failed to decode blob sidecar file")
+	}
+	return s, nil
+}
+
+// Indices generates a bitmap representing which BlobSidecar.Index values are present on disk for a given root.
+// This value can be compared to the commitments observed in a block to determine which indices need to be found
+// on the network to confirm data availability.
+func (bs *BlobStorage) Indices(root [32]byte) ([fieldparams.MaxBlobsPerBlock]bool, error) {
+	var mask [fieldparams.MaxBlobsPerBlock]bool
+	entries, err := os.ReadDir(bs.rootDir(root))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return mask, nil
+		}
+		return mask, errors.Wrapf(err, "failed to read blob directory for root %#x", root)
+	}
+	for _, e := range entries {
+		if e.IsDir() {
+			continue
+		}
+		name := e.Name()
+		if !strings.HasSuffix(name, "."+sszExt) {
+			continue
+		}
+		u, err := strconv.ParseUint(strings.TrimSuffix(name, "."+sszExt), 10, 64)
+		if err != nil {
+			return mask, errors.Wrapf(err, "unexpected directory entry breaks listing, %s", name)
+		}
+		if u >= fieldparams.MaxBlobsPerBlock {
+			return mask, errors.Wrapf(errIndexOutOfBounds, "file name %s", name)
+		}
+		mask[u] = true
+	}
+	return mask, nil
+}
+
+// SidecarsByRoot retrieves the sidecars for the given beacon block root, ordered by index.
+// If the `indices` argument is omitted, every sidecar on disk for the root will be returned.
+// Otherwise, the result will only include the specified indices, and an error wrapping ErrNotFound
+// is returned if any of them is missing.
+func (bs *BlobStorage) SidecarsByRoot(root [32]byte, indices ...uint64) ([]*ethpb.BlobSidecar, error) {
+	if len(indices) == 0 {
+		mask, err := bs.Indices(root)
+		if err != nil {
+			return nil, err
+		}
+		for i := range mask {
+			if mask[i] {
+				indices = append(indices, uint64(i))
+			}
+		}
+		if len(indices) == 0 {
+			return nil, errors.Wrapf(ErrNotFound, "root=%#x", root)
+		}
+	}
+	sidecars := make([]*ethpb.BlobSidecar, 0, len(indices))
+	for _, idx := range indices {
+		sc, err := bs.Get(root, idx)
+		if err != nil {
+			return nil, err
+		}
+		sidecars = append(sidecars, sc)
+	}
+	return sidecars, nil
+}
+
+// Remove removes all blobs for a given root. It is not an error to remove a root without blobs.
+func (bs *BlobStorage) Remove(root [32]byte) error {
+	if err := os.RemoveAll(bs.rootDir(root)); err != nil {
+		return errors.Wrapf(err, "failed to remove blobs for root %#x", root)
+	}
+	bs.pruner.forget(root)
+	return nil
+}
+
+// Prune removes every blob sidecar whose slot is outside of the retention period relative to currentSlot.
+func (bs *BlobStorage) Prune(currentSlot primitives.Slot) error {
+	return bs.pruner.prune(currentSlot)
+}
+
+// PruneLoop prunes expired blob sidecars at every epoch boundary of the wall clock, so that blobs are pruned even
+// when no new sidecars are saved, for instance during an execution client outage or once sync has completed.
+// It waits for the clock to be available and returns when the context is canceled.
+func (bs *BlobStorage) PruneLoop(ctx context.Context, cw startup.ClockWaiter) {
+	clock, err := cw.WaitForClock(ctx)
+	if err != nil {
+		log.WithError(err).Error("Failed to receive genesis data, blob sidecars will only be pruned when saving new ones")
+		return
+	}
+	ticker := slots.NewSlotTicker(clock.GenesisTime(), params.BeaconConfig().SecondsPerSlot)
+	defer ticker.Done()
+	bs.pruner.pruneEveryEpoch(ctx, clock.CurrentSlot(), ticker.C())
+}
+
+func (bs *BlobStorage) rootDir(root [32]byte) string {
+	return path.Join(bs.base, rootString(root))
+}
+
+func (bs *BlobStorage) sidecarFileKey(root [32]byte, idx uint64) string {
+	return path.Join(bs.rootDir(root), fmt.Sprintf("%d.%s", idx, sszExt))
+}
+
+func rootString(root [32]byte) string {
+	return hexutil.Encode(root[:])
+}
+
+func logFields(s *ethpb.BlobSidecar) logrus.Fields {
+	return logrus.Fields{
+		"index":         s.Index,
+		"slot":          s.Slot,
+		"blockRoot":     fmt.Sprintf("%#x", s.BlockRoot),
+		"proposerIndex": s.ProposerIndex,
+	}
+}
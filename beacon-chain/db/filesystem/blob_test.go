@@ -0,0 +1,268 @@
+package filesystem
+
+import (
+	"os"
+	"path"
+	"testing"
+
+	"github.com/pkg/errors"
+	fieldparams "github.com/prysmaticlabs/prysm/v4/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v4/config/params"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v4/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v4/testing/require"
+	"github.com/prysmaticlabs/prysm/v4/testing/util"
+)
+
+func TestBlobStorage_SaveAndGet(t *testing.T) {
+	root := bytesutil.ToBytes32([]byte("root"))
+	sidecars := testSidecars(root, 10, 3)
+
+	t.Run("no error for duplicate", func(t *testing.T) {
+		bs := NewEphemeralBlobStorage(t)
+		require.NoError(t, bs.Save(sidecars[0]))
+		require.NoError(t, bs.Save(sidecars[0]))
+	})
+	t.Run("round trip", func(t *testing.T) {
+		bs := NewEphemeralBlobStorage(t)
+		for _, sc := range sidecars {
+			require.NoError(t, bs.Save(sc))
+		}
+		for _, sc := range sidecars {
+			got, err := bs.Get(root, sc.Index)
+			require.NoError(t, err)
+			require.DeepSSZEqual(t, sc, got)
+		}
+	})
+	t.Run("not found", func(t *testing.T) {
+		bs := NewEphemeralBlobStorage(t)
+		require.NoError(t, bs.Save(sidecars[0]))
+		_, err := bs.Get(root, 1)
+		require.Equal(t, true, errors.Is(err, ErrNotFound))
+		_, err = bs.Get(bytesutil.ToBytes32([]byte("other")), 0)
+		require.Equal(t, true, errors.Is(err, ErrNotFound))
+	})
+	t.Run("index out of bounds", func(t *testing.T) {
+		bs := NewEphemeralBlobStorage(t)
+		sc := util.HydrateBlobSidecar(&ethpb.BlobSidecar{BlockRoot: root[:], Index: fieldparams.MaxBlobsPerBlock})
+		require.ErrorIs(t, bs.Save(sc), errIndexOutOfBounds)
+		_, err := bs.Get(root, fieldparams.MaxBlobsPerBlock)
+		require.ErrorIs(t, err, errIndexOutOfBounds)
+	})
+	t.Run("no partial files left behind", func(t *testing.T) {
+		bs := NewEphemeralBlobStorage(t)
+		require.NoError(t, bs.Save(sidecars[0]))
+		entries, err := os.ReadDir(bs.rootDir(root))
+		require.NoError(t, err)
+		require.Equal(t, 1, len(entries))
+		require.Equal(t, "0.ssz", entries[0].Name())
+	})
+}
+
+func TestBlobStorage_Indices(t *testing.T) {
+	root := bytesutil.ToBytes32([]byte("root"))
+	bs := NewEphemeralBlobStorage(t)
+
+	mask, err := bs.Indices(root)
+	require.NoError(t, err)
+	require.Equal(t, [fieldparams.MaxBlobsPerBlock]bool{}, mask)
+
+	sidecars := testSidecars(root, 10, 4)
+	require.NoError(t, bs.Save(sidecars[0]))
+	require.NoError(t, bs.Save(sidecars[3]))
+	mask, err = bs.Indices(root)
+	require.NoError(t, err)
+	require.Equal(t, [fieldparams.MaxBlobsPerBlock]bool{true, false, false, true, false, false}, mask)
+}
+
+func TestBlobStorage_SidecarsByRoot(t *testing.T) {
+	root := bytesutil.ToBytes32([]byte("root"))
+	bs := NewEphemeralBlobStorage(t)
+
+	_, err := bs.SidecarsByRoot(root)
+	require.ErrorIs(t, err, ErrNotFound)
+
+	sidecars := testSidecars(root, 10, 4)
+	for _, sc := range sidecars {
+		require.NoError(t, bs.Save(sc))
+	}
+	got, err := bs.SidecarsByRoot(root)
+	require.NoError(t, err)
+	require.Equal(t, len(sidecars), len(got))
+	for i := range got {
+		require.DeepSSZEqual(t, sidecars[i], got[i])
+	}
+
+	got, err = bs.SidecarsByRoot(root, 2, 1)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(got))
+	require.Equal(t, uint64(2), got[0].Index)
+	require.Equal(t, uint64(1), got[1].Index)
+
+	_, err = bs.SidecarsByRoot(root, 5)
+	require.ErrorIs(t, err, ErrNotFound)
+}
+
+func TestBlobStorage_Remove(t *testing.T) {
+	root := bytesutil.ToBytes32([]byte("root"))
+	bs := NewEphemeralBlobStorage(t)
+	require.NoError(t, bs.Remove(root))
+
+	for _, sc := range testSidecars(root, 10, 2) {
+		require.NoError(t, bs.Save(sc))
+	}
+	require.NoError(t, bs.Remove(root))
+	_, err := bs.SidecarsByRoot(root)
+	require.ErrorIs(t, err, ErrNotFound)
+}
+
+func TestBlobStorage_SaveSidecars(t *testing.T) {
+	root := bytesutil.ToBytes32([]byte("root"))
+
+	t.Run("saves unique sidecars", func(t *testing.T) {
+		bs := NewEphemeralBlobStorage(t)
+		sidecars := testSidecars(root, 10, 3)
+		require.NoError(t, bs.SaveSidecars([]*ethpb.BlobSidecar{sidecars[2], sidecars[0], sidecars[2], sidecars[1]}))
+		got, err := bs.SidecarsByRoot(root)
+		require.NoError(t, err)
+		require.Equal(t, 3, len(got))
+		for i := range got {
+			require.DeepSSZEqual(t, sidecars[i], got[i])
+		}
+	})
+	t.Run("rejects mismatched sidecars", func(t *testing.T) {
+		bs := NewEphemeralBlobStorage(t)
+		sidecars := testSidecars(root, 10, 2)
+		sidecars[1].Slot = 11
+		require.ErrorIs(t, bs.SaveSidecars(sidecars), errBlobSlotMismatch)
+		_, err := bs.SidecarsByRoot(root)
+		require.ErrorIs(t, err, ErrNotFound)
+	})
+	t.Run("rejects empty set", func(t *testing.T) {
+		bs := NewEphemeralBlobStorage(t)
+		require.ErrorIs(t, bs.SaveSidecars(nil), errEmptySidecar)
+	})
+}
+
+func Test_validUniqueSidecars_validation(t *testing.T) {
+	tests := []struct {
+		name string
+		scs  []*ethpb.BlobSidecar
+		err  error
+	}{
+		{name: "empty", scs: []*ethpb.BlobSidecar{}, err: errEmptySidecar},
+		{name: "too many sidecars", scs: testSidecars([32]byte{'a'}, 100, fieldparams.MaxBlobsPerBlock+1), err: errBlobSidecarLimit},
+		{name: "invalid slot", scs: []*ethpb.BlobSidecar{{Slot: 1}, {Slot: 2}}, err: errBlobSlotMismatch},
+		{name: "invalid proposer index", scs: []*ethpb.BlobSidecar{{ProposerIndex: 1}, {ProposerIndex: 2}}, err: errBlobProposerMismatch},
+		{name: "invalid root", scs: []*ethpb.BlobSidecar{{BlockRoot: []byte{1}}, {BlockRoot: []byte{2}}}, err: errBlobRootMismatch},
+		{name: "invalid parent root", scs: []*ethpb.BlobSidecar{{BlockParentRoot: []byte{1}}, {BlockParentRoot: []byte{2}}}, err: errBlobParentMismatch},
+		{name: "happy path", scs: []*ethpb.BlobSidecar{{Index: 0}, {Index: 1}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := validUniqueSidecars(tt.scs)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func Test_validUniqueSidecars_dedup(t *testing.T) {
+	cases := []struct {
+		name     string
+		scs      []*ethpb.BlobSidecar
+		expected []*ethpb.BlobSidecar
+		err      error
+	}{
+		{
+			name:     "duplicate sidecar",
+			scs:      []*ethpb.BlobSidecar{{Index: 1}, {Index: 1}},
+			expected: []*ethpb.BlobSidecar{{Index: 1}},
+		},
+		{
+			name:     "single sidecar",
+			scs:      []*ethpb.BlobSidecar{{Index: 1}},
+			expected: []*ethpb.BlobSidecar{{Index: 1}},
+		},
+		{
+			name:     "multiple duplicates",
+			scs:      []*ethpb.BlobSidecar{{Index: 1}, {Index: 2}, {Index: 2}, {Index: 3}, {Index: 3}},
+			expected: []*ethpb.BlobSidecar{{Index: 1}, {Index: 2}, {Index: 3}},
+		},
+		{
+			name:     "ok number after de-dupe, > 6 before",
+			scs:      []*ethpb.BlobSidecar{{Index: 1}, {Index: 2}, {Index: 2}, {Index: 2}, {Index: 2}, {Index: 3}, {Index: 3}},
+			expected: []*ethpb.BlobSidecar{{Index: 1}, {Index: 2}, {Index: 3}},
+		},
+		{
+			name:     "max unique, no dupes",
+			scs:      []*ethpb.BlobSidecar{{Index: 1}, {Index: 2}, {Index: 3}, {Index: 4}, {Index: 5}, {Index: 6}},
+			expected: []*ethpb.BlobSidecar{{Index: 1}, {Index: 2}, {Index: 3}, {Index: 4}, {Index: 5}, {Index: 6}},
+		},
+		{
+			name: "too many unique",
+			scs:  []*ethpb.BlobSidecar{{Index: 1}, {Index: 2}, {Index: 3}, {Index: 4}, {Index: 5}, {Index: 6}, {Index: 7}},
+			err:  errBlobSidecarLimit,
+		},
+		{
+			name: "too many unique with dupes",
+			scs:  []*ethpb.BlobSidecar{{Index: 1}, {Index: 1}, {Index: 1}, {Index: 2}, {Index: 3}, {Index: 4}, {Index: 5}, {Index: 6}, {Index: 7}},
+			err:  errBlobSidecarLimit,
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			u, err := validUniqueSidecars(c.scs)
+			if c.err != nil {
+				require.ErrorIs(t, err, c.err)
+			} else {
+				require.NoError(t, err)
+			}
+			require.Equal(t, len(c.expected), len(u))
+		})
+	}
+}
+
+func Test_sortSidecars(t *testing.T) {
+	scs := []*ethpb.BlobSidecar{
+		{Index: 6},
+		{Index: 4},
+		{Index: 2},
+		{Index: 1},
+		{Index: 3},
+		{Index: 5},
+		{},
+	}
+	sortSidecars(scs)
+	for i := 0; i < len(scs)-1; i++ {
+		require.Equal(t, uint64(i), scs[i].Index)
+	}
+}
+
+func TestNewBlobStorage_RetentionEpochs(t *testing.T) {
+	minEpochs := params.BeaconNetworkConfig().MinEpochsForBlobsSidecarsRequest
+	_, err := NewBlobStorage(path.Join(t.TempDir(), "blobs"), WithBlobRetentionEpochs(minEpochs-1))
+	require.ErrorContains(t, "blob retention epochs smaller than spec default", err)
+
+	bs, err := NewBlobStorage(path.Join(t.TempDir(), "blobs"), WithBlobRetentionEpochs(minEpochs*2))
+	require.NoError(t, err)
+	require.Equal(t, minEpochs*2, bs.retentionEpochs)
+}
+
+func testSidecars(root [32]byte, slot primitives.Slot, n uint64) []*ethpb.BlobSidecar {
+	scs := make([]*ethpb.BlobSidecar, n)
+	for i := uint64(0); i < n; i++ {
+		scs[i] = util.HydrateBlobSidecar(&ethpb.BlobSidecar{
+			BlockRoot:     bytesutil.SafeCopyBytes(root[:]),
+			Index:         i,
+			Slot:          slot,
+			ProposerIndex: 1,
+			Blob:          bytesutil.PadTo([]byte{byte(i)}, fieldparams.BlobLength),
+		})
+	}
+	return scs
+}
@@ -0,0 +1,16 @@
+package filesystem
+
+import (
+	"path"
+	"testing"
+)
+
+// NewEphemeralBlobStorage should only be used for tests.
+// The directory backing the storage is removed when the test completes.
+func NewEphemeralBlobStorage(t testing.TB, opts ...BlobStorageOption) *BlobStorage {
+	bs, err := NewBlobStorage(path.Join(t.TempDir(), "blobs"), opts...)
+	if err != nil {
+		t.Fatalf("could not create ephemeral blob storage: %v", err)
+	}
+	return bs
+}
@@ -0,0 +1,22 @@
+package filesystem
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	blobsWrittenCounter = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "blobs_written_total",
+		Help: "Number of blob sidecars written to the filesystem blob storage.",
+	})
+	blobsPrunedCounter = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "blobs_pruned_total",
+		Help: "Number of blob sidecars removed from the filesystem blob storage by the pruner.",
+	})
+	blobsPruneLatency = promauto.NewHistogram(prometheus.HistogramOpts{
+		Name:    "blobs_prune_latency_milliseconds",
+		Help:    "Time taken to prune blob sidecars outside of the retention period.",
+		Buckets: []float64{10, 50, 100, 500, 1000, 5000, 10000, 30000},
+	})
+)
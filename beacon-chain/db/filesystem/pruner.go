@@ -0,0 +1,226 @@
+package filesystem
+
+import (
+	"context"
+	"encoding/binary"
+	"os"
+	"path"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v4/config/params"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v4/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/v4/time/slots"
+	"github.com/sirupsen/logrus"
+)
+
+// The ssz encoding of a BlobSidecar starts with the block root (32 bytes), followed by the
+// index (8 bytes) and the slot (8 bytes). The pruner only needs to read this far into a file
+// to learn which slot a root belongs to.
+const (
+	sszSlotOffset = 40
+	sszSlotLength = 8
+)
+
+// blobPruner deletes blob sidecars that fall outside of the retention period. It keeps a cache of the slot
+// of every root directory so that pruning doesn't have to read every file each time it runs.
+type blobPruner struct {
+	sync.Mutex
+	base           string
+	retentionSlots primitives.Slot
+	slotMap        map[[32]byte]primitives.Slot
+	warmed         bool
+	prunedBefore   atomic.Uint64
+	pruning        atomic.Bool
+}
+
+func newBlobPruner(base string, retentionEpochs primitives.Epoch) *blobPruner {
+	r, err := slots.EpochStart(retentionEpochs)
+	if err != nil {
+		r = params.BeaconConfig().FarFutureSlot
+	}
+	return &blobPruner{
+		base:           base,
+		retentionSlots: r,
+		slotMap:        make(map[[32]byte]primitives.Slot),
+	}
+}
+
+// notify records the slot of a newly saved root and prunes expired blobs if due.
+func (p *blobPruner) notify(root [32]byte, slot primitives.Slot) {
+	p.Lock()
+	p.slotMap[root] = slot
+	p.Unlock()
+	p.pruneIfDue(slot)
+}
+
+// pruneIfDue prunes expired blobs in the background if the retention window moved forward by at least an epoch
+// since the last run.
+func (p *blobPruner) pruneIfDue(slot primitives.Slot) {
+	if slot < p.retentionSlots {
+		return
+	}
+	cutoff := slot - p.retentionSlots
+	if uint64(cutoff) < p.prunedBefore.Load()+uint64(params.BeaconConfig().SlotsPerEpoch) {
+		return
+	}
+	if !p.pruning.CompareAndSwap(false, true) {
+		return
+	}
+	go func() {
+		defer p.pruning.Store(false)
+		if err := p.prune(slot); err != nil {
+			log.WithError(err).Error("Failed to prune blob sidecars")
+		}
+	}()
+}
+
+// pruneEveryEpoch prunes expired blobs for the current slot, then again at the start of every epoch
+// received from the slot ticker, until the context is canceled.
+func (p *blobPruner) pruneEveryEpoch(ctx context.Context, current primitives.Slot, ticker <-chan primitives.Slot) {
+	p.pruneIfDue(current)
+	for {
+		select {
+		case slot := <-ticker:
+			if slots.IsEpochStart(slot) {
+				p.pruneIfDue(slot)
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// forget drops a root from the slot cache once its directory has been removed.
+func (p *blobPruner) forget(root [32]byte) {
+	p.Lock()
+	defer p.Unlock()
+	delete(p.slotMap, root)
+}
+
+// prune removes the directories of all roots with a slot below currentSlot - retentionSlots.
+func (p *blobPruner) prune(currentSlot primitives.Slot) error {
+	if currentSlot < p.retentionSlots {
+		return nil
+	}
+	cutoff := currentSlot - p.retentionSlots
+
+	p.Lock()
+	defer p.Unlock()
+	start := time.Now()
+	if !p.warmed {
+		if err := p.warmCache(); err != nil {
+			return err
+		}
+		p.warmed = true
+	}
+	pruned := 0
+	for root, slot := range p.slotMap {
+		if slot >= cutoff {
+			continue
+		}
+		dir := path.Join(p.base, rootString(root))
+		n, err := countSidecarFiles(dir)
+		if err != nil {
+			return err
+		}
+		if err := os.RemoveAll(dir); err != nil {
+			return errors.Wrapf(err, "failed to remove blob directory %s", dir)
+		}
+		delete(p.slotMap, root)
+		blobsPrunedCounter.Add(float64(n))
+		pruned += n
+	}
+	p.prunedBefore.Store(uint64(cutoff))
+	blobsPruneLatency.Observe(float64(time.Since(start).Milliseconds()))
+	if pruned > 0 {
+		log.WithFields(logrus.Fields{
+			"prunedBefore": cutoff,
+			"sidecars":     pruned,
+		}).Debug("Pruned expired blob sidecars")
+	}
+	return nil
+}
+
+// warmCache populates the slot cache by reading the slot of every root directory already on disk.
+func (p *blobPruner) warmCache() error {
+	entries, err := os.ReadDir(p.base)
+	if err != nil {
+		return errors.Wrap(err, "failed to list blob storage directory")
+	}
+	for _, e := range entries {
+		if !e.IsDir() || !strings.HasPrefix(e.Name(), "0x") {
+			continue
+		}
+		r, err := hexutil.Decode(e.Name())
+		if err != nil || len(r) != 32 {
+			log.WithField("name", e.Name()).Warn("Unexpected directory in blob storage")
+			continue
+		}
+		root := bytesutil.ToBytes32(r)
+		if _, ok := p.slotMap[root]; ok {
+			continue
+		}
+		slot, err := slotFromRootDir(path.Join(p.base, e.Name()))
+		if err != nil {
+			return err
+		}
+		p.slotMap[root] = slot
+	}
+	return nil
+}
+
+// slotFromRootDir reads the slot from the first sidecar file found in a root directory.
+// An empty directory is reported at slot 0 so that it is removed by the next prune.
+func slotFromRootDir(dir string) (primitives.Slot, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return 0, errors.Wrapf(err, "failed to list blob directory %s", dir)
+	}
+	for _, e := range entries {
+		if e.IsDir() || !strings.HasSuffix(e.Name(), "."+sszExt) {
+			continue
+		}
+		return slotFromFile(path.Join(dir, e.Name()))
+	}
+	return 0, nil
+}
+
+func slotFromFile(name string) (primitives.Slot, error) {
+	f, err := os.Open(name) // #nosec G304
+	if err != nil {
+		return 0, err
+	}
+	defer func() {
+		if err := f.Close(); err != nil {
+			log.WithError(err).WithField("path", name).Warn("Could not close blob sidecar file")
+		}
+	}()
+	b := make([]byte, sszSlotLength)
+	if _, err := f.ReadAt(b, sszSlotOffset); err != nil {
+		return 0, errors.Wrapf(err, "failed to read slot from %s", name)
+	}
+	return primitives.Slot(binary.LittleEndian.Uint64(b)), nil
+}
+
+func countSidecarFiles(dir string) (int, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return 0, nil
+		}
+		return 0, errors.Wrapf(err, "failed to list blob directory %s", dir)
+	}
+	n := 0
+	for _, e := range entries {
+		if strings.HasSuffix(e.Name(), "."+sszExt) {
+			n++
+		}
+	}
+	return n, nil
+}
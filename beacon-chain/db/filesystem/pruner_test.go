@@ -0,0 +1,105 @@
+package filesystem
+
+import (
+	"context"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/v4/config/params"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v4/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/v4/testing/require"
+)
+
+func TestBlobPruner_Prune(t *testing.T) {
+	retention := params.BeaconNetworkConfig().MinEpochsForBlobsSidecarsRequest
+	retentionSlots := primitives.Slot(uint64(retention) * uint64(params.BeaconConfig().SlotsPerEpoch))
+
+	oldRoot := bytesutil.ToBytes32([]byte("old"))
+	keptRoot := bytesutil.ToBytes32([]byte("kept"))
+	dir := path.Join(t.TempDir(), "blobs")
+	bs, err := NewBlobStorage(dir)
+	require.NoError(t, err)
+	for _, sc := range testSidecars(oldRoot, 10, 2) {
+		require.NoError(t, bs.Save(sc))
+	}
+	for _, sc := range testSidecars(keptRoot, 100, 2) {
+		require.NoError(t, bs.Save(sc))
+	}
+
+	// A new instance has to discover the slots of existing directories from disk.
+	bs, err = NewBlobStorage(dir)
+	require.NoError(t, err)
+	require.NoError(t, bs.Prune(retentionSlots+50))
+
+	_, err = bs.SidecarsByRoot(oldRoot)
+	require.ErrorIs(t, err, ErrNotFound)
+	got, err := bs.SidecarsByRoot(keptRoot)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(got))
+}
+
+func TestBlobPruner_PrunesInBackgroundOnSave(t *testing.T) {
+	retention := params.BeaconNetworkConfig().MinEpochsForBlobsSidecarsRequest
+	retentionSlots := primitives.Slot(uint64(retention) * uint64(params.BeaconConfig().SlotsPerEpoch))
+
+	oldRoot := bytesutil.ToBytes32([]byte("old"))
+	newRoot := bytesutil.ToBytes32([]byte("new"))
+	bs := NewEphemeralBlobStorage(t)
+	for _, sc := range testSidecars(oldRoot, 1, 1) {
+		require.NoError(t, bs.Save(sc))
+	}
+	for _, sc := range testSidecars(newRoot, retentionSlots+params.BeaconConfig().SlotsPerEpoch, 1) {
+		require.NoError(t, bs.Save(sc))
+	}
+
+	// Pruning happens asynchronously, so poll until the old root is gone.
+	pruned := false
+	for i := 0; i < 500 && !pruned; i++ {
+		mask, err := bs.Indices(oldRoot)
+		require.NoError(t, err)
+		pruned = !mask[0]
+		time.Sleep(10 * time.Millisecond)
+	}
+	require.Equal(t, true, pruned)
+	mask, err := bs.Indices(newRoot)
+	require.NoError(t, err)
+	require.Equal(t, true, mask[0])
+}
+
+func TestBlobPruner_PrunesEveryEpochWithoutSaves(t *testing.T) {
+	retention := params.BeaconNetworkConfig().MinEpochsForBlobsSidecarsRequest
+	retentionSlots := primitives.Slot(uint64(retention) * uint64(params.BeaconConfig().SlotsPerEpoch))
+
+	oldRoot := bytesutil.ToBytes32([]byte("old"))
+	bs := NewEphemeralBlobStorage(t)
+	for _, sc := range testSidecars(oldRoot, 1, 1) {
+		require.NoError(t, bs.Save(sc))
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	ticker := make(chan primitives.Slot)
+	done := make(chan struct{})
+	go func() {
+		bs.pruner.pruneEveryEpoch(ctx, 1, ticker)
+		close(done)
+	}()
+	// Slots which don't start an epoch don't trigger pruning.
+	ticker <- retentionSlots + params.BeaconConfig().SlotsPerEpoch + 1
+	mask, err := bs.Indices(oldRoot)
+	require.NoError(t, err)
+	require.Equal(t, true, mask[0])
+
+	ticker <- retentionSlots + 2*params.BeaconConfig().SlotsPerEpoch
+	pruned := false
+	for i := 0; i < 500 && !pruned; i++ {
+		mask, err := bs.Indices(oldRoot)
+		require.NoError(t, err)
+		pruned = !mask[0]
+		time.Sleep(10 * time.Millisecond)
+	}
+	require.Equal(t, true, pruned)
+	cancel()
+	<-done
+}
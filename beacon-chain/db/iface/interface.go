@@ -9,11 +9,13 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/db/filters"
+	monitortypes "github.com/prysmaticlabs/prysm/v4/beacon-chain/monitor/types"
 	slashertypes "github.com/prysmaticlabs/prysm/v4/beacon-chain/slasher/types"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/state"
 	"github.com/prysmaticlabs/prysm/v4/consensus-types/interfaces"
 	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/v4/monitoring/backup"
+	ethpbv2 "github.com/prysmaticlabs/prysm/v4/proto/eth/v2"
 	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
 )
 
@@ -39,6 +41,7 @@ type ReadOnlyDatabase interface {
 	StateSummary(ctx context.Context, blockRoot [32]byte) (*ethpb.StateSummary, error)
 	HasStateSummary(ctx context.Context, blockRoot [32]byte) bool
 	HighestSlotStatesBelow(ctx context.Context, slot primitives.Slot) ([]state.ReadOnlyBeaconState, error)
+	StateDiffInterval() primitives.Slot
 	// Checkpoint operations.
 	JustifiedCheckpoint(ctx context.Context) (*ethpb.Checkpoint, error)
 	FinalizedCheckpoint(ctx context.Context) (*ethpb.Checkpoint, error)
@@ -51,13 +54,15 @@ type ReadOnlyDatabase interface {
 	DepositContractAddress(ctx context.Context) ([]byte, error)
 	// ExecutionChainData operations.
 	ExecutionChainData(ctx context.Context) (*ethpb.ETH1ChainData, error)
+	ExecutionBlockHeaders(ctx context.Context) (*ethpb.ExecutionBlockHeaders, error)
 	// Fee recipients operations.
 	FeeRecipientByValidatorID(ctx context.Context, id primitives.ValidatorIndex) (common.Address, error)
 	RegistrationByValidatorID(ctx context.Context, id primitives.ValidatorIndex) (*ethpb.ValidatorRegistrationV1, error)
-
-	// Blob operations.
-	BlobSidecarsByRoot(ctx context.Context, beaconBlockRoot [32]byte, indices ...uint64) ([]*ethpb.BlobSidecar, error)
-	BlobSidecarsBySlot(ctx context.Context, slot primitives.Slot, indices ...uint64) ([]*ethpb.BlobSidecar, error)
+	// Light client operations.
+	LightClientUpdate(ctx context.Context, period uint64) (*ethpbv2.LightClientUpdate, error)
+	LightClientUpdates(ctx context.Context, start, end uint64) ([]*ethpbv2.LightClientUpdate, error)
+	// Validator monitor operations.
+	ValidatorPerformances(ctx context.Context, idx primitives.ValidatorIndex, start, end primitives.Epoch) ([]*monitortypes.ValidatorPerformance, error)
 
 	// origin checkpoint sync support
 	OriginCheckpointBlockRoot(ctx context.Context) ([32]byte, error)
@@ -78,6 +83,7 @@ type NoHeadAccessDatabase interface {
 	SaveStates(ctx context.Context, states []state.ReadOnlyBeaconState, blockRoots [][32]byte) error
 	DeleteState(ctx context.Context, blockRoot [32]byte) error
 	DeleteStates(ctx context.Context, blockRoots [][32]byte) error
+	SaveStateDiff(ctx context.Context, slot primitives.Slot, state state.ReadOnlyBeaconState, blockRoot [32]byte) error
 	SaveStateSummary(ctx context.Context, summary *ethpb.StateSummary) error
 	SaveStateSummaries(ctx context.Context, summaries []*ethpb.StateSummary) error
 	// Checkpoint operations.
@@ -88,15 +94,16 @@ type NoHeadAccessDatabase interface {
 	SaveDepositContractAddress(ctx context.Context, addr common.Address) error
 	// SaveExecutionChainData operations.
 	SaveExecutionChainData(ctx context.Context, data *ethpb.ETH1ChainData) error
+	SaveExecutionBlockHeaders(ctx context.Context, headers *ethpb.ExecutionBlockHeaders) error
 	// Run any required database migrations.
 	RunMigrations(ctx context.Context) error
 	// Fee recipients operations.
 	SaveFeeRecipientsByValidatorIDs(ctx context.Context, ids []primitives.ValidatorIndex, addrs []common.Address) error
 	SaveRegistrationsByValidatorIDs(ctx context.Context, ids []primitives.ValidatorIndex, regs []*ethpb.ValidatorRegistrationV1) error
-
-	// Blob operations.
-	SaveBlobSidecar(ctx context.Context, sidecars []*ethpb.BlobSidecar) error
-	DeleteBlobSidecar(ctx context.Context, beaconBlockRoot [32]byte) error
+	// Light client operations.
+	SaveLightClientUpdate(ctx context.Context, period uint64, update *ethpbv2.LightClientUpdate) error
+	// Validator monitor operations.
+	SaveValidatorPerformances(ctx context.Context, performances []*monitortypes.ValidatorPerformance) error
 
 	CleanUpDirtyStates(ctx context.Context, slotsPerArchivedPoint primitives.Slot) error
 }
@@ -138,6 +145,7 @@ type SlasherDatabase interface {
 	LastEpochWrittenForValidators(
 		ctx context.Context, validatorIndices []primitives.ValidatorIndex,
 	) ([]*slashertypes.AttestedEpochForValidator, error)
+	LastEpochsWrittenForAllValidators(ctx context.Context) ([]*slashertypes.AttestedEpochForValidator, error)
 	AttestationRecordForValidator(
 		ctx context.Context, validatorIdx primitives.ValidatorIndex, targetEpoch primitives.Epoch,
 	) (*slashertypes.IndexedAttestationWrapper, error)
@@ -163,6 +171,33 @@ type SlasherDatabase interface {
 		ctx context.Context,
 		indices []primitives.ValidatorIndex,
 	) ([]*ethpb.HighestAttestation, error)
+	SaveBlobSidecarHeaders(
+		ctx context.Context, headers []*slashertypes.SignedBlobSidecarHeaderWrapper,
+	) error
+	SaveBlobSidecarEquivocations(
+		ctx context.Context, equivocations []*slashertypes.BlobSidecarEquivocation,
+	) error
+	CheckBlobSidecarEquivocations(
+		ctx context.Context, headers []*slashertypes.SignedBlobSidecarHeaderWrapper,
+	) ([]*slashertypes.BlobSidecarEquivocation, error)
+	BlobSidecarEquivocations(
+		ctx context.Context, startSlot, endSlot primitives.Slot,
+	) ([]*slashertypes.BlobSidecarEquivocation, error)
+	SaveSyncCommitteeMessages(
+		ctx context.Context, messages []*slashertypes.SyncCommitteeMessageWrapper,
+	) error
+	SaveSyncCommitteeEquivocations(
+		ctx context.Context, equivocations []*slashertypes.SyncCommitteeEquivocation,
+	) error
+	CheckSyncCommitteeEquivocations(
+		ctx context.Context, messages []*slashertypes.SyncCommitteeMessageWrapper,
+	) ([]*slashertypes.SyncCommitteeEquivocation, error)
+	SyncCommitteeEquivocations(
+		ctx context.Context, startSlot, endSlot primitives.Slot,
+	) ([]*slashertypes.SyncCommitteeEquivocation, error)
+	PruneEquivocationRecordsAtEpoch(
+		ctx context.Context, maxEpoch primitives.Epoch,
+	) (numPruned uint, err error)
 	DatabasePath() string
 	ClearDB() error
 }
@@ -175,4 +210,7 @@ type Database interface {
 
 	DatabasePath() string
 	ClearDB() error
+
+	// MigrateBlobSidecars moves blob sidecars out of the legacy database bucket through the given save function.
+	MigrateBlobSidecars(ctx context.Context, save func(*ethpb.BlobSidecar) error) (int, error)
 }
@@ -51,3 +51,44 @@ func (s *Store) ExecutionChainData(ctx context.Context) (*v2.ETH1ChainData, erro
 	})
 	return data, err
 }
+
+// SaveExecutionBlockHeaders saves the window of execution block headers followed by the powchain service.
+func (s *Store) SaveExecutionBlockHeaders(ctx context.Context, headers *v2.ExecutionBlockHeaders) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.SaveExecutionBlockHeaders")
+	defer span.End()
+
+	if headers == nil {
+		err := errors.New("cannot save nil execution block headers")
+		tracing.AnnotateError(span, err)
+		return err
+	}
+
+	err := s.db.Update(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket(powchainBucket)
+		enc, err := proto.Marshal(headers)
+		if err != nil {
+			return err
+		}
+		return bkt.Put(powchainHeadersKey, enc)
+	})
+	tracing.AnnotateError(span, err)
+	return err
+}
+
+// ExecutionBlockHeaders retrieves the window of execution block headers followed by the powchain service.
+func (s *Store) ExecutionBlockHeaders(ctx context.Context) (*v2.ExecutionBlockHeaders, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.ExecutionBlockHeaders")
+	defer span.End()
+
+	var headers *v2.ExecutionBlockHeaders
+	err := s.db.View(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket(powchainBucket)
+		enc := bkt.Get(powchainHeadersKey)
+		if len(enc) == 0 {
+			return nil
+		}
+		headers = &v2.ExecutionBlockHeaders{}
+		return proto.Unmarshal(enc, headers)
+	})
+	return headers, err
+}
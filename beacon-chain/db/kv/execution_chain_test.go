@@ -4,7 +4,9 @@ import (
 	"context"
 	"testing"
 
+	"github.com/prysmaticlabs/prysm/v4/encoding/bytesutil"
 	v2 "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v4/testing/require"
 )
 
 func TestStore_SavePowchainData(t *testing.T) {
@@ -33,3 +35,30 @@ func TestStore_SavePowchainData(t *testing.T) {
 		})
 	}
 }
+
+func TestStore_ExecutionBlockHeaders(t *testing.T) {
+	store := setupDB(t)
+	ctx := context.Background()
+
+	headers, err := store.ExecutionBlockHeaders(ctx)
+	require.NoError(t, err)
+	require.Equal(t, true, headers == nil)
+	require.ErrorContains(t, "cannot save nil", store.SaveExecutionBlockHeaders(ctx, nil))
+
+	want := &v2.ExecutionBlockHeaders{
+		Headers: []*v2.ExecutionBlockHeader{
+			{Number: 10, Hash: bytesutil.PadTo([]byte{'a'}, 32), Timestamp: 100},
+			{Number: 11, Hash: bytesutil.PadTo([]byte{'b'}, 32), Timestamp: 112},
+		},
+	}
+	require.NoError(t, store.SaveExecutionBlockHeaders(ctx, want))
+	headers, err = store.ExecutionBlockHeaders(ctx)
+	require.NoError(t, err)
+	require.DeepEqual(t, want, headers)
+
+	// Headers are kept apart from the execution chain data.
+	require.NoError(t, store.SaveExecutionChainData(ctx, &v2.ETH1ChainData{}))
+	headers, err = store.ExecutionBlockHeaders(ctx)
+	require.NoError(t, err)
+	require.DeepEqual(t, want, headers)
+}
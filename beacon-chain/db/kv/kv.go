@@ -89,6 +89,7 @@ type Store struct {
 	blockCache          *ristretto.Cache
 	validatorEntryCache *ristretto.Cache
 	stateSummaryCache   *stateSummaryCache
+	stateDiffs          *stateDiffConfig
 	ctx                 context.Context
 }
 
@@ -131,6 +132,13 @@ var Buckets = [][]byte{
 	registrationBucket,
 
 	blobsBucket,
+
+	stateDiffBucket,
+	stateDiffRootsBucket,
+
+	lightClientUpdatesBucket,
+
+	validatorPerformanceBucket,
 }
 
 // NewKVStore initializes a new boltDB key-value store at the directory
@@ -201,9 +209,8 @@ func NewKVStore(ctx context.Context, dirPath string) (*Store, error) {
 	if err := kv.setupBlockStorageType(ctx); err != nil {
 		return nil, err
 	}
-
-	if err := checkEpochsForBlobSidecarsRequestBucket(boltDB); err != nil {
-		return nil, errors.Wrap(err, "failed to check epochs for blob sidecars request bucket")
+	if err := kv.setupStateDiffs(ctx); err != nil {
+		return nil, err
 	}
 
 	return kv, nil
@@ -0,0 +1,80 @@
+package kv
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v4/encoding/bytesutil"
+	ethpbv2 "github.com/prysmaticlabs/prysm/v4/proto/eth/v2"
+	bolt "go.etcd.io/bbolt"
+	"go.opencensus.io/trace"
+)
+
+var errInvalidPeriodRange = errors.New("end period is before start period")
+
+// SaveLightClientUpdate saves the best light client update of a sync committee period,
+// replacing any update previously saved for that period.
+func (s *Store) SaveLightClientUpdate(ctx context.Context, period uint64, update *ethpbv2.LightClientUpdate) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.SaveLightClientUpdate")
+	defer span.End()
+
+	enc, err := encode(ctx, update)
+	if err != nil {
+		return errors.Wrapf(err, "could not encode light client update for period %d", period)
+	}
+	return s.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(lightClientUpdatesBucket).Put(bytesutil.Uint64ToBytesBigEndian(period), enc)
+	})
+}
+
+// LightClientUpdate returns the light client update saved for a sync committee period,
+// or nil if there is none.
+func (s *Store) LightClientUpdate(ctx context.Context, period uint64) (*ethpbv2.LightClientUpdate, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.LightClientUpdate")
+	defer span.End()
+
+	var enc []byte
+	if err := s.db.View(func(tx *bolt.Tx) error {
+		enc = tx.Bucket(lightClientUpdatesBucket).Get(bytesutil.Uint64ToBytesBigEndian(period))
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	if len(enc) == 0 {
+		return nil, nil
+	}
+	update := &ethpbv2.LightClientUpdate{}
+	if err := decode(ctx, enc, update); err != nil {
+		return nil, err
+	}
+	return update, nil
+}
+
+// LightClientUpdates returns the light client updates of the sync committee periods from start to end, inclusive.
+// The updates are returned for consecutive periods only, so the result stops before the first period without an update.
+func (s *Store) LightClientUpdates(ctx context.Context, start, end uint64) ([]*ethpbv2.LightClientUpdate, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.LightClientUpdates")
+	defer span.End()
+
+	if end < start {
+		return nil, errInvalidPeriodRange
+	}
+	updates := make([]*ethpbv2.LightClientUpdate, 0)
+	err := s.db.View(func(tx *bolt.Tx) error {
+		c := tx.Bucket(lightClientUpdatesBucket).Cursor()
+		next := start
+		for k, v := c.Seek(bytesutil.Uint64ToBytesBigEndian(start)); k != nil && next <= end; k, v = c.Next() {
+			if bytesutil.BytesToUint64BigEndian(k) != next {
+				break
+			}
+			update := &ethpbv2.LightClientUpdate{}
+			if err := decode(ctx, v, update); err != nil {
+				return err
+			}
+			updates = append(updates, update)
+			next++
+		}
+		return nil
+	})
+	return updates, err
+}
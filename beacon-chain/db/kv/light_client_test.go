@@ -0,0 +1,94 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/go-bitfield"
+	fieldparams "github.com/prysmaticlabs/prysm/v4/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v4/config/params"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	ethpbv1 "github.com/prysmaticlabs/prysm/v4/proto/eth/v1"
+	ethpbv2 "github.com/prysmaticlabs/prysm/v4/proto/eth/v2"
+	"github.com/prysmaticlabs/prysm/v4/testing/require"
+)
+
+func lightClientUpdate(slot primitives.Slot) *ethpbv2.LightClientUpdate {
+	header := func(slot primitives.Slot) *ethpbv1.BeaconBlockHeader {
+		return &ethpbv1.BeaconBlockHeader{
+			Slot:       slot,
+			ParentRoot: make([]byte, 32),
+			StateRoot:  make([]byte, 32),
+			BodyRoot:   make([]byte, 32),
+		}
+	}
+	branch := func(n int) [][]byte {
+		b := make([][]byte, n)
+		for i := range b {
+			b[i] = make([]byte, 32)
+		}
+		return b
+	}
+	pubkeys := make([][]byte, fieldparams.SyncCommitteeLength)
+	for i := range pubkeys {
+		pubkeys[i] = make([]byte, fieldparams.BLSPubkeyLength)
+	}
+	return &ethpbv2.LightClientUpdate{
+		AttestedHeader: header(slot),
+		NextSyncCommittee: &ethpbv2.SyncCommittee{
+			Pubkeys:         pubkeys,
+			AggregatePubkey: make([]byte, fieldparams.BLSPubkeyLength),
+		},
+		NextSyncCommitteeBranch: branch(5),
+		FinalizedHeader:         header(0),
+		FinalityBranch:          branch(6),
+		SyncAggregate: &ethpbv1.SyncAggregate{
+			SyncCommitteeBits:      bitfield.NewBitvector512(),
+			SyncCommitteeSignature: make([]byte, fieldparams.BLSSignatureLength),
+		},
+		SignatureSlot: slot + 1,
+	}
+}
+
+func TestStore_LightClientUpdates(t *testing.T) {
+	ctx := context.Background()
+	db := setupDB(t)
+	slotsPerPeriod := primitives.Slot(uint64(params.BeaconConfig().EpochsPerSyncCommitteePeriod) * uint64(params.BeaconConfig().SlotsPerEpoch))
+
+	update, err := db.LightClientUpdate(ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, (*ethpbv2.LightClientUpdate)(nil), update)
+
+	for _, period := range []uint64{1, 2, 3, 5} {
+		require.NoError(t, db.SaveLightClientUpdate(ctx, period, lightClientUpdate(primitives.Slot(period)*slotsPerPeriod)))
+	}
+	// A better update replaces the saved one.
+	better := lightClientUpdate(2*slotsPerPeriod + 1)
+	require.NoError(t, db.SaveLightClientUpdate(ctx, 2, better))
+	update, err = db.LightClientUpdate(ctx, 2)
+	require.NoError(t, err)
+	require.DeepSSZEqual(t, better, update)
+
+	t.Run("range", func(t *testing.T) {
+		updates, err := db.LightClientUpdates(ctx, 1, 3)
+		require.NoError(t, err)
+		require.Equal(t, 3, len(updates))
+		require.Equal(t, slotsPerPeriod, updates[0].AttestedHeader.Slot)
+		require.Equal(t, 2*slotsPerPeriod+1, updates[1].AttestedHeader.Slot)
+		require.Equal(t, 3*slotsPerPeriod, updates[2].AttestedHeader.Slot)
+	})
+	t.Run("stops at gap", func(t *testing.T) {
+		updates, err := db.LightClientUpdates(ctx, 2, 10)
+		require.NoError(t, err)
+		require.Equal(t, 2, len(updates))
+	})
+	t.Run("missing start", func(t *testing.T) {
+		updates, err := db.LightClientUpdates(ctx, 0, 10)
+		require.NoError(t, err)
+		require.Equal(t, 0, len(updates))
+	})
+	t.Run("invalid range", func(t *testing.T) {
+		_, err := db.LightClientUpdates(ctx, 3, 2)
+		require.ErrorIs(t, err, errInvalidPeriodRange)
+	})
+}
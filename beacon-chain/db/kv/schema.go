@@ -8,7 +8,6 @@ package kv
 // corresponding attestations.
 var (
 	attestationsBucket      = []byte("attestations")
-	blobsBucket             = []byte("blobs")
 	blocksBucket            = []byte("blocks")
 	stateBucket             = []byte("state")
 	stateSummaryBucket      = []byte("state-summary")
@@ -21,11 +20,21 @@ var (
 	stateValidatorsBucket   = []byte("state-validators")
 	feeRecipientBucket      = []byte("fee-recipient")
 	registrationBucket      = []byte("registration")
+	stateDiffBucket         = []byte("state-diff")
+	stateDiffRootsBucket    = []byte("state-diff-roots")
+
+	// Best light client update of every sync committee period.
+	lightClientUpdatesBucket = []byte("light-client-updates")
+
+	// Per-epoch performance of the validators tracked by the validator monitor.
+	validatorPerformanceBucket = []byte("validator-performance")
 
 	// Deprecated: This bucket was migrated in PR 6461. Do not use, except for migrations.
 	slotsHasObjectBucket = []byte("slots-has-objects")
 	// Deprecated: This bucket was migrated in PR 6461. Do not use, except for migrations.
 	archivedRootBucket = []byte("archived-index-root")
+	// Deprecated: Blob sidecars are kept in filesystem blob storage. Do not use, except for migrations.
+	blobsBucket = []byte("blobs")
 
 	// Key indices buckets.
 	blockParentRootIndicesBucket        = []byte("block-parent-root-indices")
@@ -46,11 +55,8 @@ var (
 	justifiedCheckpointKey     = []byte("justified-checkpoint")
 	finalizedCheckpointKey     = []byte("finalized-checkpoint")
 	powchainDataKey            = []byte("powchain-data")
+	powchainHeadersKey         = []byte("powchain-headers")
 	lastValidatedCheckpointKey = []byte("last-validated-checkpoint")
-	// blobRetentionEpochsKey determines the size of the blob circular buffer and how the keys in that buffer are
-	// determined. If this value changes, the existing data is invalidated, so storing it in the db
-	// allows us to assert at runtime that the db state is still consistent with the runtime state.
-	blobRetentionEpochsKey = []byte("blob-retention-epochs")
 
 	// Below keys are used to identify objects are to be fork compatible.
 	// Objects that are only compatible with specific forks should be prefixed with such keys.
@@ -67,6 +73,8 @@ var (
 	originCheckpointBlockRootKey = []byte("origin-checkpoint-block-root")
 	// block root tracking the progress of backfill, or pointing at genesis if backfill has not been initiated
 	backfillBlockRootKey = []byte("backfill-block-root")
+	// exponents of the hierarchical state diff intervals, only present when historical state diffs are enabled
+	stateDiffExponentsKey = []byte("state-diff-exponents")
 
 	// Deprecated: This index key was migrated in PR 6461. Do not use, except for migrations.
 	lastArchivedIndexKey = []byte("last-archived")
@@ -35,6 +35,9 @@ func (s *Store) State(ctx context.Context, blockRoot [32]byte) (state.BeaconStat
 	}
 
 	if len(enc) == 0 {
+		if s.stateDiffs != nil {
+			return s.stateDiffByRoot(ctx, blockRoot)
+		}
 		return nil, nil
 	}
 	// get the validator entries of the state
@@ -426,6 +429,14 @@ func (s *Store) HasState(ctx context.Context, blockRoot [32]byte) bool {
 	if err != nil {
 		panic(err)
 	}
+	if !hasState && s.stateDiffs != nil {
+		has, err := s.hasStateDiff(blockRoot)
+		if err != nil {
+			log.WithError(err).Error("Could not check for state diff")
+			return false
+		}
+		return has
+	}
 	return hasState
 }
 
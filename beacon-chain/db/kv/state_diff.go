@@ -0,0 +1,332 @@
+package kv
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/v4/config/features"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/blocks"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v4/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/v4/time"
+	bolt "go.etcd.io/bbolt"
+	"go.opencensus.io/trace"
+)
+
+// Historical states can be stored as a hierarchy of diffs. Every level of the hierarchy has an interval of
+// 2^exponent slots, with exponents in decreasing order. States at slots on the first level are stored as full
+// snapshots. A state at a slot whose highest level is n is stored as a diff against the state at the closest
+// lower slot of level n-1, so that rebuilding any state takes at most one snapshot and len(exponents)-1 diffs.
+// The last level has an exponent of 0 so that a state is stored at every slot, and historical states are
+// rebuilt without replaying blocks. With a higher last exponent, the states in between the points of the
+// last level are regenerated by replaying blocks, as with regular archived points.
+var defaultStateDiffExponents = []uint64{21, 17, 13, 9, 5, 0}
+
+const (
+	stateDiffKindSnapshot byte = iota
+	stateDiffKindDiff
+)
+
+var errStateDiffsDisabled = errors.New("historical state diffs are not enabled for this database")
+
+type stateDiffConfig struct {
+	exponents []uint64
+	// cache keeps the sections of the most recently rebuilt state of every level,
+	// as consecutive historical states usually share the same bases.
+	sync.Mutex
+	cache []cachedStateSections
+}
+
+type cachedStateSections struct {
+	slot     primitives.Slot
+	sections *stateSections
+}
+
+func newStateDiffConfig(exponents []uint64) *stateDiffConfig {
+	return &stateDiffConfig{
+		exponents: exponents,
+		cache:     make([]cachedStateSections, len(exponents)),
+	}
+}
+
+// level returns the level of the hierarchy that the slot belongs to, or -1 if the slot is not a diff point.
+func (c *stateDiffConfig) level(slot primitives.Slot) int {
+	for i, e := range c.exponents {
+		if uint64(slot)%(uint64(1)<<e) == 0 {
+			return i
+		}
+	}
+	return -1
+}
+
+// base returns the slot of the state that a state at the given level and slot is stored as a diff against.
+func (c *stateDiffConfig) base(lvl int, slot primitives.Slot) primitives.Slot {
+	interval := primitives.Slot(uint64(1) << c.exponents[lvl-1])
+	return slot - slot%interval
+}
+
+func (c *stateDiffConfig) cached(lvl int, slot primitives.Slot) *stateSections {
+	c.Lock()
+	defer c.Unlock()
+	if e := c.cache[lvl]; e.sections != nil && e.slot == slot {
+		return e.sections
+	}
+	return nil
+}
+
+func (c *stateDiffConfig) store(lvl int, slot primitives.Slot, sec *stateSections) {
+	c.Lock()
+	defer c.Unlock()
+	c.cache[lvl] = cachedStateSections{slot: slot, sections: sec}
+}
+
+// StateDiffInterval returns the number of slots between two states stored as diffs,
+// or zero if historical state diffs are not enabled for the database.
+func (s *Store) StateDiffInterval() primitives.Slot {
+	if s.stateDiffs == nil {
+		return 0
+	}
+	return primitives.Slot(uint64(1) << s.stateDiffs.exponents[len(s.stateDiffs.exponents)-1])
+}
+
+// SaveStateDiff stores a historical state as part of the state diff hierarchy. The slot is the diff point the
+// state is archived for, which can be higher than the slot of the state when the diff point is a skipped slot.
+// The state is stored as a full snapshot instead of a diff when its base state is not available, which is
+// the case for the first diff points after a checkpoint sync.
+func (s *Store) SaveStateDiff(ctx context.Context, slot primitives.Slot, st state.ReadOnlyBeaconState, blockRoot [32]byte) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.SaveStateDiff")
+	defer span.End()
+	if s.stateDiffs == nil {
+		return errStateDiffsDisabled
+	}
+	if st == nil || st.IsNil() {
+		return errors.New("nil state")
+	}
+	lvl := s.stateDiffs.level(slot)
+	if lvl < 0 {
+		return fmt.Errorf("slot %d is not a multiple of the state diff interval %d", slot, s.StateDiffInterval())
+	}
+	startTime := time.Now()
+	sec, err := splitState(st)
+	if err != nil {
+		return err
+	}
+
+	kind := stateDiffKindSnapshot
+	var base stateSections
+	if lvl > 0 {
+		b, err := s.stateDiffSections(ctx, s.stateDiffs.base(lvl, slot))
+		switch {
+		case errors.Is(err, ErrNotFoundState):
+		case err != nil:
+			return errors.Wrap(err, "could not rebuild base state")
+		default:
+			kind, base = stateDiffKindDiff, *b
+		}
+	}
+	enc := append([]byte{kind}, encodeStateDiff(sec, base)...)
+	if err := s.db.Update(func(tx *bolt.Tx) error {
+		key := bytesutil.Uint64ToBytesBigEndian(uint64(slot))
+		if err := tx.Bucket(stateDiffBucket).Put(key, enc); err != nil {
+			return err
+		}
+		return tx.Bucket(stateDiffRootsBucket).Put(blockRoot[:], key)
+	}); err != nil {
+		return err
+	}
+	s.stateDiffs.store(lvl, slot, &sec)
+	stateSavingTime.Observe(float64(time.Since(startTime).Milliseconds()))
+	return nil
+}
+
+// stateDiffByRoot rebuilds the state stored in the diff hierarchy for the given block root.
+// A nil state is returned when there is no such state.
+func (s *Store) stateDiffByRoot(ctx context.Context, blockRoot [32]byte) (state.BeaconState, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.stateDiffByRoot")
+	defer span.End()
+	var slot primitives.Slot
+	var found bool
+	if err := s.db.View(func(tx *bolt.Tx) error {
+		enc := tx.Bucket(stateDiffRootsBucket).Get(blockRoot[:])
+		if len(enc) == 0 {
+			return nil
+		}
+		slot, found = bytesutil.BytesToSlotBigEndian(enc), true
+		return nil
+	}); err != nil || !found {
+		return nil, err
+	}
+	sec, err := s.stateDiffSections(ctx, slot)
+	if err != nil {
+		return nil, err
+	}
+	return joinState(*sec)
+}
+
+func (s *Store) hasStateDiff(blockRoot [32]byte) (bool, error) {
+	var has bool
+	err := s.db.View(func(tx *bolt.Tx) error {
+		has = len(tx.Bucket(stateDiffRootsBucket).Get(blockRoot[:])) > 0
+		return nil
+	})
+	return has, err
+}
+
+// stateDiffSections rebuilds the sections of the state stored at the given diff point,
+// recursively applying diffs to the sections of its base.
+func (s *Store) stateDiffSections(ctx context.Context, slot primitives.Slot) (*stateSections, error) {
+	lvl := s.stateDiffs.level(slot)
+	if lvl < 0 {
+		return nil, fmt.Errorf("slot %d is not a multiple of the state diff interval %d", slot, s.StateDiffInterval())
+	}
+	if sec := s.stateDiffs.cached(lvl, slot); sec != nil {
+		return sec, nil
+	}
+	var enc []byte
+	if err := s.db.View(func(tx *bolt.Tx) error {
+		enc = bytesutil.SafeCopyBytes(tx.Bucket(stateDiffBucket).Get(bytesutil.Uint64ToBytesBigEndian(uint64(slot))))
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	var sec stateSections
+	var err error
+	switch {
+	case len(enc) == 0 && slot == 0:
+		// The genesis state is the root of the hierarchy when it has not been saved as a snapshot.
+		st, err := s.GenesisState(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if st == nil || st.IsNil() {
+			return nil, ErrNotFoundState
+		}
+		if sec, err = splitState(st); err != nil {
+			return nil, err
+		}
+	case len(enc) == 0:
+		return nil, errors.Wrapf(ErrNotFoundState, "no state diff at slot %d", slot)
+	case enc[0] == stateDiffKindSnapshot:
+		if sec, err = applyStateDiff(enc[1:], stateSections{}); err != nil {
+			return nil, errors.Wrapf(err, "could not decode state snapshot at slot %d", slot)
+		}
+	case enc[0] == stateDiffKindDiff && lvl > 0:
+		base, err := s.stateDiffSections(ctx, s.stateDiffs.base(lvl, slot))
+		if err != nil {
+			return nil, err
+		}
+		if sec, err = applyStateDiff(enc[1:], *base); err != nil {
+			return nil, errors.Wrapf(err, "could not apply state diff at slot %d", slot)
+		}
+	default:
+		return nil, errors.Wrapf(errInvalidStateDiff, "unknown kind %d at slot %d", enc[0], slot)
+	}
+	s.stateDiffs.store(lvl, slot, &sec)
+	return &sec, nil
+}
+
+// setupStateDiffs enables historical state diffs when the database was created with them, or when the
+// feature flag is set for a new database. Existing databases have to be converted with prysmctl first.
+func (s *Store) setupStateDiffs(ctx context.Context) error {
+	var exponents []uint64
+	if err := s.db.View(func(tx *bolt.Tx) error {
+		enc := tx.Bucket(chainMetadataBucket).Get(stateDiffExponentsKey)
+		for i := 0; i+8 <= len(enc); i += 8 {
+			exponents = append(exponents, bytesutil.BytesToUint64BigEndian(enc[i:i+8]))
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+	if len(exponents) > 0 {
+		s.stateDiffs = newStateDiffConfig(exponents)
+		return nil
+	}
+	if !features.Get().EnableHistoricalStateDiffs {
+		return nil
+	}
+
+	headBlock, err := s.HeadBlock(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not get head block when setting up state diffs")
+	}
+	if blocks.BeaconBlockIsNil(headBlock) == nil {
+		return errors.New("historical state diffs can only be enabled when creating a new database. " +
+			"Use `prysmctl db convert-state-diffs` to convert this database, or resync from an empty database")
+	}
+	return s.enableStateDiffs(defaultStateDiffExponents)
+}
+
+func (s *Store) enableStateDiffs(exponents []uint64) error {
+	enc := make([]byte, 0, len(exponents)*8)
+	for _, e := range exponents {
+		enc = append(enc, bytesutil.Uint64ToBytesBigEndian(e)...)
+	}
+	if err := s.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(chainMetadataBucket).Put(stateDiffExponentsKey, enc)
+	}); err != nil {
+		return err
+	}
+	s.stateDiffs = newStateDiffConfig(exponents)
+	log.WithField("interval", s.StateDiffInterval()).Info("Historical states are stored as state diffs")
+	return nil
+}
+
+// ConvertToStateDiffs enables historical state diffs for an existing database, and moves every saved state
+// that lies on a diff point into the diff hierarchy. States at other slots are kept as full states.
+// Only the states that were saved before the conversion are moved, so states at the slots in between
+// the former archived points are still regenerated by replaying blocks.
+// It returns the number of converted states.
+func (s *Store) ConvertToStateDiffs(ctx context.Context) (int, error) {
+	if s.stateDiffs == nil {
+		if err := s.enableStateDiffs(defaultStateDiffExponents); err != nil {
+			return 0, err
+		}
+	}
+
+	type savedState struct {
+		slot primitives.Slot
+		root [32]byte
+	}
+	var saved []savedState
+	if err := s.db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket(stateBucket).ForEach(func(k, _ []byte) error {
+			slot, err := s.slotByBlockRoot(ctx, tx, k)
+			if err != nil {
+				return err
+			}
+			if slot != 0 && s.stateDiffs.level(slot) >= 0 {
+				saved = append(saved, savedState{slot: slot, root: bytesutil.ToBytes32(k)})
+			}
+			return nil
+		})
+	}); err != nil {
+		return 0, err
+	}
+	// Lower states are converted first so that they are available as the bases of the higher ones.
+	sort.Slice(saved, func(i, j int) bool {
+		return saved[i].slot < saved[j].slot
+	})
+
+	for i, ss := range saved {
+		if ctx.Err() != nil {
+			return i, ctx.Err()
+		}
+		st, err := s.State(ctx, ss.root)
+		if err != nil {
+			return i, errors.Wrapf(err, "could not read state at slot %d", ss.slot)
+		}
+		if err := s.SaveStateDiff(ctx, ss.slot, st, ss.root); err != nil {
+			return i, errors.Wrapf(err, "could not save state diff at slot %d", ss.slot)
+		}
+		if err := s.DeleteState(ctx, ss.root); err != nil && !errors.Is(err, ErrDeleteJustifiedAndFinalized) {
+			return i, errors.Wrapf(err, "could not delete state at slot %d", ss.slot)
+		}
+	}
+	return len(saved), nil
+}
@@ -0,0 +1,294 @@
+package kv
+
+import (
+	"encoding/binary"
+
+	"github.com/golang/snappy"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/state"
+	statenative "github.com/prysmaticlabs/prysm/v4/beacon-chain/state/state-native"
+	"github.com/prysmaticlabs/prysm/v4/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+)
+
+// A state is split into sections before it is diffed. The large lists that make up most of a state and that change
+// in place from one epoch to the next are kept in their own sections, so that each of them lines up byte for byte
+// with the same section of the base state. The remainder section holds the rest of the state, encoded as ssz
+// with the large lists left empty and prefixed with the fork key used by the state bucket.
+const (
+	sectionRemainder = iota
+	sectionValidators
+	sectionBalances
+	sectionInactivityScores
+	sectionPreviousParticipation
+	sectionCurrentParticipation
+	numStateSections
+)
+
+// diffMergeGap is the number of unchanged bytes below which two changed regions are stored as a single region.
+// Each region costs 16 bytes of overhead, so it is cheaper to repeat a few unchanged bytes.
+const diffMergeGap = 16
+
+var errInvalidStateDiff = errors.New("invalid state diff encoding")
+
+type stateSections [numStateSections][]byte
+
+// splitState converts a state into its sections.
+func splitState(st state.ReadOnlyBeaconState) (stateSections, error) {
+	var sec stateSections
+	var err error
+	var vals []*ethpb.Validator
+	var bals, inactivity []uint64
+	switch pb := st.ToProto().(type) {
+	case *ethpb.BeaconState:
+		vals, bals = pb.Validators, pb.Balances
+		pb.Validators, pb.Balances = nil, nil
+		sec[sectionRemainder], err = pb.MarshalSSZ()
+	case *ethpb.BeaconStateAltair:
+		vals, bals, inactivity = pb.Validators, pb.Balances, pb.InactivityScores
+		sec[sectionPreviousParticipation], sec[sectionCurrentParticipation] = pb.PreviousEpochParticipation, pb.CurrentEpochParticipation
+		pb.Validators, pb.Balances, pb.InactivityScores = nil, nil, nil
+		pb.PreviousEpochParticipation, pb.CurrentEpochParticipation = nil, nil
+		sec[sectionRemainder], err = marshalWithKey(altairKey, pb)
+	case *ethpb.BeaconStateBellatrix:
+		vals, bals, inactivity = pb.Validators, pb.Balances, pb.InactivityScores
+		sec[sectionPreviousParticipation], sec[sectionCurrentParticipation] = pb.PreviousEpochParticipation, pb.CurrentEpochParticipation
+		pb.Validators, pb.Balances, pb.InactivityScores = nil, nil, nil
+		pb.PreviousEpochParticipation, pb.CurrentEpochParticipation = nil, nil
+		sec[sectionRemainder], err = marshalWithKey(bellatrixKey, pb)
+	case *ethpb.BeaconStateCapella:
+		vals, bals, inactivity = pb.Validators, pb.Balances, pb.InactivityScores
+		sec[sectionPreviousParticipation], sec[sectionCurrentParticipation] = pb.PreviousEpochParticipation, pb.CurrentEpochParticipation
+		pb.Validators, pb.Balances, pb.InactivityScores = nil, nil, nil
+		pb.PreviousEpochParticipation, pb.CurrentEpochParticipation = nil, nil
+		sec[sectionRemainder], err = marshalWithKey(capellaKey, pb)
+	case *ethpb.BeaconStateDeneb:
+		vals, bals, inactivity = pb.Validators, pb.Balances, pb.InactivityScores
+		sec[sectionPreviousParticipation], sec[sectionCurrentParticipation] = pb.PreviousEpochParticipation, pb.CurrentEpochParticipation
+		pb.Validators, pb.Balances, pb.InactivityScores = nil, nil, nil
+		pb.PreviousEpochParticipation, pb.CurrentEpochParticipation = nil, nil
+		sec[sectionRemainder], err = marshalWithKey(denebKey, pb)
+	default:
+		return sec, errors.New("invalid inner state")
+	}
+	if err != nil {
+		return sec, errors.Wrap(err, "could not marshal state remainder")
+	}
+	sec[sectionValidators], err = marshalValidators(vals)
+	if err != nil {
+		return sec, err
+	}
+	sec[sectionBalances] = uint64sToBytes(bals)
+	sec[sectionInactivityScores] = uint64sToBytes(inactivity)
+	return sec, nil
+}
+
+// joinState builds a state from its sections. The sections are not retained by the returned state.
+func joinState(sec stateSections) (state.BeaconState, error) {
+	vals, err := unmarshalValidators(sec[sectionValidators])
+	if err != nil {
+		return nil, err
+	}
+	bals, err := bytesToUint64s(sec[sectionBalances])
+	if err != nil {
+		return nil, err
+	}
+	inactivity, err := bytesToUint64s(sec[sectionInactivityScores])
+	if err != nil {
+		return nil, err
+	}
+	prev := bytesutil.SafeCopyBytes(sec[sectionPreviousParticipation])
+	cur := bytesutil.SafeCopyBytes(sec[sectionCurrentParticipation])
+	enc := sec[sectionRemainder]
+	switch {
+	case hasDenebKey(enc):
+		pb := &ethpb.BeaconStateDeneb{}
+		if err := pb.UnmarshalSSZ(enc[len(denebKey):]); err != nil {
+			return nil, errors.Wrap(err, "failed to unmarshal encoding for Deneb")
+		}
+		pb.Validators, pb.Balances, pb.InactivityScores = vals, bals, inactivity
+		pb.PreviousEpochParticipation, pb.CurrentEpochParticipation = prev, cur
+		return statenative.InitializeFromProtoUnsafeDeneb(pb)
+	case hasCapellaKey(enc):
+		pb := &ethpb.BeaconStateCapella{}
+		if err := pb.UnmarshalSSZ(enc[len(capellaKey):]); err != nil {
+			return nil, errors.Wrap(err, "failed to unmarshal encoding for capella")
+		}
+		pb.Validators, pb.Balances, pb.InactivityScores = vals, bals, inactivity
+		pb.PreviousEpochParticipation, pb.CurrentEpochParticipation = prev, cur
+		return statenative.InitializeFromProtoUnsafeCapella(pb)
+	case hasBellatrixKey(enc):
+		pb := &ethpb.BeaconStateBellatrix{}
+		if err := pb.UnmarshalSSZ(enc[len(bellatrixKey):]); err != nil {
+			return nil, errors.Wrap(err, "failed to unmarshal encoding for bellatrix")
+		}
+		pb.Validators, pb.Balances, pb.InactivityScores = vals, bals, inactivity
+		pb.PreviousEpochParticipation, pb.CurrentEpochParticipation = prev, cur
+		return statenative.InitializeFromProtoUnsafeBellatrix(pb)
+	case hasAltairKey(enc):
+		pb := &ethpb.BeaconStateAltair{}
+		if err := pb.UnmarshalSSZ(enc[len(altairKey):]); err != nil {
+			return nil, errors.Wrap(err, "failed to unmarshal encoding for altair")
+		}
+		pb.Validators, pb.Balances, pb.InactivityScores = vals, bals, inactivity
+		pb.PreviousEpochParticipation, pb.CurrentEpochParticipation = prev, cur
+		return statenative.InitializeFromProtoUnsafeAltair(pb)
+	default:
+		pb := &ethpb.BeaconState{}
+		if err := pb.UnmarshalSSZ(enc); err != nil {
+			return nil, errors.Wrap(err, "failed to unmarshal encoding")
+		}
+		pb.Validators, pb.Balances = vals, bals
+		return statenative.InitializeFromProtoUnsafePhase0(pb)
+	}
+}
+
+// encodeStateDiff encodes every section of target as a sparse diff against the same section of base.
+// A zero value base produces a snapshot, which is the same encoding against empty sections.
+func encodeStateDiff(target, base stateSections) []byte {
+	var out []byte
+	for i := range target {
+		out = appendSparseDiff(out, target[i], base[i])
+	}
+	return snappy.Encode(nil, out)
+}
+
+// applyStateDiff rebuilds the target sections from a diff created by encodeStateDiff and the same base.
+func applyStateDiff(enc []byte, base stateSections) (stateSections, error) {
+	var target stateSections
+	buf, err := snappy.Decode(nil, enc)
+	if err != nil {
+		return target, errors.Wrap(err, "could not decompress state diff")
+	}
+	for i := range target {
+		target[i], buf, err = readSparseDiff(buf, base[i])
+		if err != nil {
+			return target, errors.Wrapf(err, "section %d", i)
+		}
+	}
+	if len(buf) != 0 {
+		return target, errors.Wrapf(errInvalidStateDiff, "%d trailing bytes", len(buf))
+	}
+	return target, nil
+}
+
+// appendSparseDiff appends the encoding of target as the regions where it differs from base:
+// target length | region count | (offset | length | bytes) for each region.
+// Bytes beyond the end of base are always treated as changed.
+func appendSparseDiff(out, target, base []byte) []byte {
+	type region struct{ start, end int }
+	regions := make([]region, 0)
+	for i := 0; i < len(target); i++ {
+		if i < len(base) && target[i] == base[i] {
+			continue
+		}
+		if n := len(regions); n > 0 && i-regions[n-1].end < diffMergeGap {
+			regions[n-1].end = i + 1
+			continue
+		}
+		regions = append(regions, region{start: i, end: i + 1})
+	}
+	out = binary.LittleEndian.AppendUint64(out, uint64(len(target)))
+	out = binary.LittleEndian.AppendUint64(out, uint64(len(regions)))
+	for _, r := range regions {
+		out = binary.LittleEndian.AppendUint64(out, uint64(r.start))
+		out = binary.LittleEndian.AppendUint64(out, uint64(r.end-r.start))
+		out = append(out, target[r.start:r.end]...)
+	}
+	return out
+}
+
+// readSparseDiff decodes one section encoded by appendSparseDiff against base,
+// returning the target and the remaining, unread part of buf.
+func readSparseDiff(buf, base []byte) ([]byte, []byte, error) {
+	size, buf, err := readUint64(buf)
+	if err != nil {
+		return nil, nil, err
+	}
+	count, buf, err := readUint64(buf)
+	if err != nil {
+		return nil, nil, err
+	}
+	if size > uint64(len(base))+uint64(len(buf)) {
+		return nil, nil, errors.Wrapf(errInvalidStateDiff, "section size %d exceeds available data", size)
+	}
+	target := make([]byte, size)
+	copy(target, base)
+	for i := uint64(0); i < count; i++ {
+		var off, n uint64
+		if off, buf, err = readUint64(buf); err != nil {
+			return nil, nil, err
+		}
+		if n, buf, err = readUint64(buf); err != nil {
+			return nil, nil, err
+		}
+		if off+n > size || n > uint64(len(buf)) {
+			return nil, nil, errors.Wrapf(errInvalidStateDiff, "region %d-%d out of bounds", off, off+n)
+		}
+		copy(target[off:off+n], buf[:n])
+		buf = buf[n:]
+	}
+	return target, buf, nil
+}
+
+func readUint64(buf []byte) (uint64, []byte, error) {
+	if len(buf) < 8 {
+		return 0, nil, errors.Wrap(errInvalidStateDiff, "unexpected end of data")
+	}
+	return binary.LittleEndian.Uint64(buf[:8]), buf[8:], nil
+}
+
+func marshalWithKey(key []byte, obj interface{ MarshalSSZ() ([]byte, error) }) ([]byte, error) {
+	enc, err := obj.MarshalSSZ()
+	if err != nil {
+		return nil, err
+	}
+	return append(bytesutil.SafeCopyBytes(key), enc...), nil
+}
+
+func marshalValidators(vals []*ethpb.Validator) ([]byte, error) {
+	size := (&ethpb.Validator{}).SizeSSZ()
+	out := make([]byte, 0, len(vals)*size)
+	var err error
+	for i, v := range vals {
+		out, err = v.MarshalSSZTo(out)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not marshal validator %d", i)
+		}
+	}
+	return out, nil
+}
+
+func unmarshalValidators(enc []byte) ([]*ethpb.Validator, error) {
+	size := (&ethpb.Validator{}).SizeSSZ()
+	if len(enc)%size != 0 {
+		return nil, errors.Wrapf(errInvalidStateDiff, "validators section length %d", len(enc))
+	}
+	vals := make([]*ethpb.Validator, len(enc)/size)
+	for i := range vals {
+		vals[i] = &ethpb.Validator{}
+		if err := vals[i].UnmarshalSSZ(enc[i*size : (i+1)*size]); err != nil {
+			return nil, errors.Wrapf(err, "could not unmarshal validator %d", i)
+		}
+	}
+	return vals, nil
+}
+
+func uint64sToBytes(vals []uint64) []byte {
+	out := make([]byte, 0, len(vals)*8)
+	for _, v := range vals {
+		out = binary.LittleEndian.AppendUint64(out, v)
+	}
+	return out
+}
+
+func bytesToUint64s(enc []byte) ([]uint64, error) {
+	if len(enc)%8 != 0 {
+		return nil, errors.Wrapf(errInvalidStateDiff, "uint64 list section length %d", len(enc))
+	}
+	vals := make([]uint64, len(enc)/8)
+	for i := range vals {
+		vals[i] = binary.LittleEndian.Uint64(enc[i*8:])
+	}
+	return vals, nil
+}
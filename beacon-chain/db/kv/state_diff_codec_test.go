@@ -0,0 +1,105 @@
+package kv
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/v4/testing/require"
+	"github.com/prysmaticlabs/prysm/v4/testing/util"
+)
+
+func TestSparseDiff_RoundTrip(t *testing.T) {
+	long := make([]byte, 100)
+	for i := range long {
+		long[i] = byte(i)
+	}
+	changed := append([]byte{}, long...)
+	changed[3], changed[10], changed[90] = 0xff, 0xff, 0xff
+	cases := []struct {
+		name    string
+		target  []byte
+		base    []byte
+		regions uint64
+	}{
+		{name: "empty", regions: 0},
+		{name: "snapshot", target: long, regions: 1},
+		{name: "unchanged", target: long, base: long, regions: 0},
+		{name: "close changes are merged", target: changed, base: long, regions: 2},
+		{name: "grown", target: append(append([]byte{}, long...), 1, 2, 3), base: long, regions: 1},
+		{name: "shrunk", target: long[:50], base: long, regions: 0},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			enc := appendSparseDiff(nil, c.target, c.base)
+			count, _, err := readUint64(enc[8:])
+			require.NoError(t, err)
+			require.Equal(t, c.regions, count)
+			got, rest, err := readSparseDiff(enc, c.base)
+			require.NoError(t, err)
+			require.Equal(t, 0, len(rest))
+			require.Equal(t, true, bytes.Equal(c.target, got))
+		})
+	}
+}
+
+func TestSparseDiff_Invalid(t *testing.T) {
+	enc := appendSparseDiff(nil, []byte{1, 2, 3}, nil)
+	_, _, err := readSparseDiff(enc[:len(enc)-1], nil)
+	require.ErrorIs(t, err, errInvalidStateDiff)
+	_, _, err = readSparseDiff(enc[:4], nil)
+	require.ErrorIs(t, err, errInvalidStateDiff)
+}
+
+func TestStateSections_RoundTrip(t *testing.T) {
+	phase0, _ := util.DeterministicGenesisState(t, 16)
+	altair, _ := util.DeterministicGenesisStateAltair(t, 16)
+	bellatrix, _ := util.DeterministicGenesisStateBellatrix(t, 16)
+	capella, _ := util.DeterministicGenesisStateCapella(t, 16)
+	deneb, err := util.NewBeaconStateDeneb()
+	require.NoError(t, err)
+	ctx := context.Background()
+	for _, st := range []state.BeaconState{phase0, altair, bellatrix, capella, deneb} {
+		sec, err := splitState(st)
+		require.NoError(t, err)
+		got, err := joinState(sec)
+		require.NoError(t, err)
+		require.Equal(t, st.Version(), got.Version())
+		want, err := st.HashTreeRoot(ctx)
+		require.NoError(t, err)
+		root, err := got.HashTreeRoot(ctx)
+		require.NoError(t, err)
+		require.Equal(t, want, root)
+	}
+}
+
+func TestStateDiff_EncodeApply(t *testing.T) {
+	base, _ := util.DeterministicGenesisStateAltair(t, 64)
+	target := base.Copy()
+	require.NoError(t, target.SetSlot(32))
+	require.NoError(t, target.UpdateBalancesAtIndex(7, 1))
+	require.NoError(t, target.AppendInactivityScore(3))
+
+	baseSec, err := splitState(base)
+	require.NoError(t, err)
+	targetSec, err := splitState(target)
+	require.NoError(t, err)
+	diff := encodeStateDiff(targetSec, baseSec)
+	snapshot := encodeStateDiff(targetSec, stateSections{})
+	require.Equal(t, true, len(diff) < len(snapshot))
+
+	got, err := applyStateDiff(diff, baseSec)
+	require.NoError(t, err)
+	for i := range got {
+		require.Equal(t, true, bytes.Equal(targetSec[i], got[i]))
+	}
+	got, err = applyStateDiff(snapshot, stateSections{})
+	require.NoError(t, err)
+	for i := range got {
+		require.Equal(t, true, bytes.Equal(targetSec[i], got[i]))
+	}
+
+	_, err = applyStateDiff(append(diff, 0), baseSec)
+	require.NotNil(t, err)
+}
@@ -0,0 +1,178 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/v4/config/features"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/blocks"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v4/testing/assert"
+	"github.com/prysmaticlabs/prysm/v4/testing/require"
+	"github.com/prysmaticlabs/prysm/v4/testing/util"
+)
+
+func setupStateDiffDB(t *testing.T, exponents ...uint64) *Store {
+	resetCfg := features.InitWithReset(&features.Flags{EnableHistoricalStateDiffs: true})
+	defer resetCfg()
+	db := setupDB(t)
+	require.Equal(t, primitives.Slot(1), db.StateDiffInterval())
+	if len(exponents) > 0 {
+		require.NoError(t, db.enableStateDiffs(exponents))
+	}
+	return db
+}
+
+func requireSameState(t *testing.T, want, got state.BeaconState) {
+	require.NotNil(t, got)
+	wantRoot, err := want.HashTreeRoot(context.Background())
+	require.NoError(t, err)
+	gotRoot, err := got.HashTreeRoot(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, wantRoot, gotRoot)
+}
+
+func TestStateDiffConfig_Levels(t *testing.T) {
+	c := newStateDiffConfig([]uint64{6, 4, 2})
+	cases := []struct {
+		slot  primitives.Slot
+		level int
+		base  primitives.Slot
+	}{
+		{slot: 0, level: 0},
+		{slot: 128, level: 0},
+		{slot: 144, level: 1, base: 128},
+		{slot: 4, level: 2, base: 0},
+		{slot: 156, level: 2, base: 144},
+		{slot: 3, level: -1},
+	}
+	for _, c2 := range cases {
+		require.Equal(t, c2.level, c.level(c2.slot))
+		if c2.level > 0 {
+			require.Equal(t, c2.base, c.base(c2.level, c2.slot))
+		}
+	}
+}
+
+func TestStateDiffConfig_DefaultLevels(t *testing.T) {
+	c := newStateDiffConfig(defaultStateDiffExponents)
+	// every slot is a diff point, so no blocks are replayed to rebuild a state
+	for slot := primitives.Slot(1); slot < 32; slot++ {
+		require.Equal(t, len(defaultStateDiffExponents)-1, c.level(slot+64))
+		require.Equal(t, primitives.Slot(64), c.base(c.level(slot+64), slot+64))
+	}
+	require.Equal(t, len(defaultStateDiffExponents)-2, c.level(96))
+}
+
+func TestStore_StateDiffs(t *testing.T) {
+	ctx := context.Background()
+	db := setupStateDiffDB(t, 4, 2, 1)
+	require.Equal(t, primitives.Slot(2), db.StateDiffInterval())
+
+	st, _ := util.DeterministicGenesisStateAltair(t, 32)
+	saved := make(map[[32]byte]state.BeaconState)
+	for slot := primitives.Slot(2); slot <= 20; slot += 2 {
+		st = st.Copy()
+		require.NoError(t, st.SetSlot(slot))
+		require.NoError(t, st.UpdateBalancesAtIndex(primitives.ValidatorIndex(slot), uint64(slot)))
+		root := [32]byte{byte(slot)}
+		require.NoError(t, db.SaveStateDiff(ctx, slot, st, root))
+		saved[root] = st
+	}
+	require.ErrorContains(t, "not a multiple", db.SaveStateDiff(ctx, 3, st, [32]byte{3}))
+
+	// The genesis state was not saved, so the first states are snapshots.
+	require.Equal(t, false, db.HasState(ctx, [32]byte{1}))
+	for root, want := range saved {
+		// Rebuild every state from the db rather than from the cache.
+		db.stateDiffs = newStateDiffConfig(db.stateDiffs.exponents)
+		require.Equal(t, true, db.HasState(ctx, root))
+		got, err := db.State(ctx, root)
+		require.NoError(t, err)
+		requireSameState(t, want, got)
+	}
+	got, err := db.State(ctx, [32]byte{1})
+	require.NoError(t, err)
+	assert.Equal(t, state.ReadOnlyBeaconState(nil), got)
+}
+
+func TestStore_StateDiffsFromGenesis(t *testing.T) {
+	ctx := context.Background()
+	db := setupStateDiffDB(t)
+	genesis, _ := util.DeterministicGenesisState(t, 32)
+	require.NoError(t, db.SaveGenesisData(ctx, genesis))
+
+	st := genesis.Copy()
+	require.NoError(t, st.SetSlot(32))
+	require.NoError(t, db.SaveStateDiff(ctx, 32, st, [32]byte{'a'}))
+	got, err := db.State(ctx, [32]byte{'a'})
+	require.NoError(t, err)
+	requireSameState(t, st, got)
+}
+
+func Test_setupStateDiffs(t *testing.T) {
+	ctx := context.Background()
+	t.Run("disabled by default", func(t *testing.T) {
+		db := setupDB(t)
+		require.Equal(t, primitives.Slot(0), db.StateDiffInterval())
+		require.ErrorIs(t, db.SaveStateDiff(ctx, 32, nil, [32]byte{}), errStateDiffsDisabled)
+	})
+	t.Run("kept once enabled", func(t *testing.T) {
+		db := setupStateDiffDB(t)
+		require.NoError(t, db.setupStateDiffs(ctx))
+		require.Equal(t, primitives.Slot(1), db.StateDiffInterval())
+	})
+	t.Run("existing database cannot be enabled with the flag", func(t *testing.T) {
+		db := setupDB(t)
+		blk := util.NewBeaconBlock()
+		wsb, err := blocks.NewSignedBeaconBlock(blk)
+		require.NoError(t, err)
+		root, err := wsb.Block().HashTreeRoot()
+		require.NoError(t, err)
+		require.NoError(t, db.SaveBlock(ctx, wsb))
+		require.NoError(t, db.SaveStateSummary(ctx, &ethpb.StateSummary{Root: root[:]}))
+		require.NoError(t, db.SaveHeadBlockRoot(ctx, root))
+
+		resetCfg := features.InitWithReset(&features.Flags{EnableHistoricalStateDiffs: true})
+		defer resetCfg()
+		require.ErrorContains(t, "convert-state-diffs", db.setupStateDiffs(ctx))
+		require.Equal(t, primitives.Slot(0), db.StateDiffInterval())
+	})
+}
+
+func TestStore_ConvertToStateDiffs(t *testing.T) {
+	ctx := context.Background()
+	db := setupDB(t)
+	st, _ := util.DeterministicGenesisStateAltair(t, 32)
+	states := make(map[[32]byte]state.BeaconState)
+	for _, slot := range []primitives.Slot{64, 32, 40} {
+		blk := util.NewBeaconBlock()
+		blk.Block.Slot = slot
+		wsb, err := blocks.NewSignedBeaconBlock(blk)
+		require.NoError(t, err)
+		root, err := wsb.Block().HashTreeRoot()
+		require.NoError(t, err)
+		require.NoError(t, db.SaveBlock(ctx, wsb))
+		s := st.Copy()
+		require.NoError(t, s.SetSlot(slot))
+		require.NoError(t, db.SaveState(ctx, s, root))
+		states[root] = s
+	}
+
+	n, err := db.ConvertToStateDiffs(ctx)
+	require.NoError(t, err)
+	// a state is stored at every slot, so all states are converted
+	require.Equal(t, 3, n)
+	require.Equal(t, primitives.Slot(1), db.StateDiffInterval())
+	for root, want := range states {
+		require.Equal(t, true, db.HasState(ctx, root))
+		got, err := db.State(ctx, root)
+		require.NoError(t, err)
+		requireSameState(t, want, got)
+		enc, err := db.stateBytes(ctx, root)
+		require.NoError(t, err)
+		require.Equal(t, 0, len(enc))
+	}
+}
@@ -0,0 +1,68 @@
+package kv
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/pkg/errors"
+	monitortypes "github.com/prysmaticlabs/prysm/v4/beacon-chain/monitor/types"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v4/encoding/bytesutil"
+	bolt "go.etcd.io/bbolt"
+	"go.opencensus.io/trace"
+)
+
+var errInvalidEpochRange = errors.New("end epoch is before start epoch")
+
+// SaveValidatorPerformances saves the per-epoch performance records of validators,
+// replacing any record previously saved for the same validator and epoch.
+func (s *Store) SaveValidatorPerformances(ctx context.Context, performances []*monitortypes.ValidatorPerformance) error {
+	_, span := trace.StartSpan(ctx, "BeaconDB.SaveValidatorPerformances")
+	defer span.End()
+
+	return s.db.Update(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket(validatorPerformanceBucket)
+		for _, p := range performances {
+			enc, err := p.MarshalBinary()
+			if err != nil {
+				return errors.Wrapf(err, "could not encode performance of validator %d at epoch %d", p.ValidatorIndex, p.Epoch)
+			}
+			if err := bkt.Put(validatorPerformanceKey(p.ValidatorIndex, p.Epoch), enc); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// ValidatorPerformances returns the performance records of a validator for the epochs from start to end, inclusive.
+// Epochs without a record are skipped.
+func (s *Store) ValidatorPerformances(
+	ctx context.Context, idx primitives.ValidatorIndex, start, end primitives.Epoch,
+) ([]*monitortypes.ValidatorPerformance, error) {
+	_, span := trace.StartSpan(ctx, "BeaconDB.ValidatorPerformances")
+	defer span.End()
+
+	if end < start {
+		return nil, errInvalidEpochRange
+	}
+	performances := make([]*monitortypes.ValidatorPerformance, 0)
+	endKey := validatorPerformanceKey(idx, end)
+	err := s.db.View(func(tx *bolt.Tx) error {
+		c := tx.Bucket(validatorPerformanceBucket).Cursor()
+		for k, v := c.Seek(validatorPerformanceKey(idx, start)); k != nil && bytes.Compare(k, endKey) <= 0; k, v = c.Next() {
+			p := &monitortypes.ValidatorPerformance{}
+			if err := p.UnmarshalBinary(v); err != nil {
+				return err
+			}
+			performances = append(performances, p)
+		}
+		return nil
+	})
+	return performances, err
+}
+
+// The key of a performance record sorts records by validator, then by epoch.
+func validatorPerformanceKey(idx primitives.ValidatorIndex, epoch primitives.Epoch) []byte {
+	return append(bytesutil.Uint64ToBytesBigEndian(uint64(idx)), bytesutil.Uint64ToBytesBigEndian(uint64(epoch))...)
+}
@@ -0,0 +1,46 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	monitortypes "github.com/prysmaticlabs/prysm/v4/beacon-chain/monitor/types"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v4/testing/require"
+)
+
+func TestStore_ValidatorPerformances(t *testing.T) {
+	ctx := context.Background()
+	db := setupDB(t)
+
+	var performances []*monitortypes.ValidatorPerformance
+	for _, idx := range []primitives.ValidatorIndex{1, 2} {
+		for epoch := primitives.Epoch(3); epoch <= 6; epoch++ {
+			performances = append(performances, &monitortypes.ValidatorPerformance{
+				ValidatorIndex:      idx,
+				Epoch:               epoch,
+				AttestationIncluded: true,
+				InclusionDistance:   1,
+				CorrectTarget:       epoch%2 == 0,
+				SourceReward:        100,
+				TargetReward:        -50,
+			})
+		}
+	}
+	require.NoError(t, db.SaveValidatorPerformances(ctx, performances))
+
+	got, err := db.ValidatorPerformances(ctx, 2, 4, 5)
+	require.NoError(t, err)
+	require.DeepEqual(t, performances[5:7], got)
+
+	got, err = db.ValidatorPerformances(ctx, 1, 0, 100)
+	require.NoError(t, err)
+	require.DeepEqual(t, performances[0:4], got)
+
+	got, err = db.ValidatorPerformances(ctx, 3, 0, 100)
+	require.NoError(t, err)
+	require.Equal(t, 0, len(got))
+
+	_, err = db.ValidatorPerformances(ctx, 1, 5, 4)
+	require.ErrorIs(t, err, errInvalidEpochRange)
+}
@@ -0,0 +1,453 @@
+package slasherkv
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	"github.com/golang/snappy"
+	"github.com/pkg/errors"
+	slashertypes "github.com/prysmaticlabs/prysm/v4/beacon-chain/slasher/types"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v4/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v4/time/slots"
+	bolt "go.etcd.io/bbolt"
+	"go.opencensus.io/trace"
+)
+
+// Blob sidecar and sync committee message records are keyed by big-endian slot first, so that
+// they can be iterated in slot order:
+//
+//	blob sidecar:           (slot ++ proposerIndex ++ blobIndex) => signingRoot ++ encode(header)
+//	sync committee message: (slot ++ validatorIndex) => signingRoot ++ encode(message)
+const slotPrefixSize = 8 // Bytes.
+
+// CheckBlobSidecarEquivocations returns the evidence of blob sidecar headers conflicting with
+// the headers saved in the database for the same slot, proposer and blob index.
+func (s *Store) CheckBlobSidecarEquivocations(
+	ctx context.Context, headers []*slashertypes.SignedBlobSidecarHeaderWrapper,
+) ([]*slashertypes.BlobSidecarEquivocation, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.CheckBlobSidecarEquivocations")
+	defer span.End()
+	keys := make([][]byte, len(headers))
+	roots := make([][32]byte, len(headers))
+	for i, header := range headers {
+		if header == nil || header.SignedBlobSidecarHeader == nil || header.SignedBlobSidecarHeader.Message == nil {
+			return nil, errors.New("nil blob sidecar header")
+		}
+		keys[i] = keyForBlobSidecar(header.SignedBlobSidecarHeader.Message)
+		roots[i] = header.SigningRoot
+	}
+	existing, err := s.conflictingRecords(ctx, blobSidecarRecordsBucket, keys, roots)
+	if err != nil {
+		return nil, err
+	}
+	equivocations := make([]*slashertypes.BlobSidecarEquivocation, 0)
+	for i, enc := range existing {
+		if enc == nil {
+			continue
+		}
+		prev, err := decodeBlobSidecarRecord(enc)
+		if err != nil {
+			return nil, err
+		}
+		equivocations = append(equivocations, &slashertypes.BlobSidecarEquivocation{
+			PrevBlobSidecarWrapper: prev,
+			BlobSidecarWrapper:     headers[i],
+		})
+	}
+	return equivocations, nil
+}
+
+// SaveBlobSidecarHeaders saves blob sidecar headers to the database. A header already saved
+// for the same slot, proposer and blob index is kept.
+func (s *Store) SaveBlobSidecarHeaders(
+	ctx context.Context, headers []*slashertypes.SignedBlobSidecarHeaderWrapper,
+) error {
+	_, span := trace.StartSpan(ctx, "BeaconDB.SaveBlobSidecarHeaders")
+	defer span.End()
+	keys := make([][]byte, len(headers))
+	encoded := make([][]byte, len(headers))
+	for i, header := range headers {
+		enc, err := encodeBlobSidecarRecord(header)
+		if err != nil {
+			return err
+		}
+		keys[i] = keyForBlobSidecar(header.SignedBlobSidecarHeader.Message)
+		encoded[i] = enc
+	}
+	return s.db.Update(func(tx *bolt.Tx) error {
+		return putIfAbsent(tx.Bucket(blobSidecarRecordsBucket), keys, encoded)
+	})
+}
+
+// SaveBlobSidecarEquivocations saves the evidence of blob sidecar equivocations. Only the first
+// conflicting header is kept for a given slot, proposer and blob index.
+func (s *Store) SaveBlobSidecarEquivocations(
+	ctx context.Context, equivocations []*slashertypes.BlobSidecarEquivocation,
+) error {
+	_, span := trace.StartSpan(ctx, "BeaconDB.SaveBlobSidecarEquivocations")
+	defer span.End()
+	keys := make([][]byte, len(equivocations))
+	prevs := make([][]byte, len(equivocations))
+	encoded := make([][]byte, len(equivocations))
+	for i, e := range equivocations {
+		if e == nil {
+			return errors.New("nil blob sidecar equivocation")
+		}
+		prev, err := encodeBlobSidecarRecord(e.PrevBlobSidecarWrapper)
+		if err != nil {
+			return err
+		}
+		enc, err := encodeBlobSidecarRecord(e.BlobSidecarWrapper)
+		if err != nil {
+			return err
+		}
+		keys[i] = keyForBlobSidecar(e.PrevBlobSidecarWrapper.SignedBlobSidecarHeader.Message)
+		prevs[i] = prev
+		encoded[i] = enc
+	}
+	return s.db.Update(func(tx *bolt.Tx) error {
+		if err := putIfAbsent(tx.Bucket(blobSidecarRecordsBucket), keys, prevs); err != nil {
+			return err
+		}
+		return putIfAbsent(tx.Bucket(blobSidecarEquivocationsBucket), keys, encoded)
+	})
+}
+
+// BlobSidecarEquivocations returns the evidence of blob sidecar equivocations saved for slots
+// within the inclusive range [startSlot, endSlot].
+func (s *Store) BlobSidecarEquivocations(
+	ctx context.Context, startSlot, endSlot primitives.Slot,
+) ([]*slashertypes.BlobSidecarEquivocation, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.BlobSidecarEquivocations")
+	defer span.End()
+	prevs, encoded, err := s.equivocationsInRange(
+		ctx, blobSidecarRecordsBucket, blobSidecarEquivocationsBucket, startSlot, endSlot,
+	)
+	if err != nil {
+		return nil, err
+	}
+	equivocations := make([]*slashertypes.BlobSidecarEquivocation, len(encoded))
+	for i := range encoded {
+		prev, err := decodeBlobSidecarRecord(prevs[i])
+		if err != nil {
+			return nil, err
+		}
+		header, err := decodeBlobSidecarRecord(encoded[i])
+		if err != nil {
+			return nil, err
+		}
+		equivocations[i] = &slashertypes.BlobSidecarEquivocation{
+			PrevBlobSidecarWrapper: prev,
+			BlobSidecarWrapper:     header,
+		}
+	}
+	return equivocations, nil
+}
+
+// CheckSyncCommitteeEquivocations returns the evidence of sync committee messages conflicting
+// with the messages saved in the database for the same slot and validator.
+func (s *Store) CheckSyncCommitteeEquivocations(
+	ctx context.Context, messages []*slashertypes.SyncCommitteeMessageWrapper,
+) ([]*slashertypes.SyncCommitteeEquivocation, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.CheckSyncCommitteeEquivocations")
+	defer span.End()
+	keys := make([][]byte, len(messages))
+	roots := make([][32]byte, len(messages))
+	for i, msg := range messages {
+		if msg == nil || msg.SyncCommitteeMessage == nil {
+			return nil, errors.New("nil sync committee message")
+		}
+		keys[i] = keyForSyncCommitteeMessage(msg.SyncCommitteeMessage)
+		roots[i] = msg.SigningRoot
+	}
+	existing, err := s.conflictingRecords(ctx, syncCommitteeMessageRecordsBucket, keys, roots)
+	if err != nil {
+		return nil, err
+	}
+	equivocations := make([]*slashertypes.SyncCommitteeEquivocation, 0)
+	for i, enc := range existing {
+		if enc == nil {
+			continue
+		}
+		prev, err := decodeSyncCommitteeMessageRecord(enc)
+		if err != nil {
+			return nil, err
+		}
+		equivocations = append(equivocations, &slashertypes.SyncCommitteeEquivocation{
+			PrevMessageWrapper: prev,
+			MessageWrapper:     messages[i],
+		})
+	}
+	return equivocations, nil
+}
+
+// SaveSyncCommitteeMessages saves sync committee messages to the database. A message already
+// saved for the same slot and validator is kept.
+func (s *Store) SaveSyncCommitteeMessages(
+	ctx context.Context, messages []*slashertypes.SyncCommitteeMessageWrapper,
+) error {
+	_, span := trace.StartSpan(ctx, "BeaconDB.SaveSyncCommitteeMessages")
+	defer span.End()
+	keys := make([][]byte, len(messages))
+	encoded := make([][]byte, len(messages))
+	for i, msg := range messages {
+		enc, err := encodeSyncCommitteeMessageRecord(msg)
+		if err != nil {
+			return err
+		}
+		keys[i] = keyForSyncCommitteeMessage(msg.SyncCommitteeMessage)
+		encoded[i] = enc
+	}
+	return s.db.Update(func(tx *bolt.Tx) error {
+		return putIfAbsent(tx.Bucket(syncCommitteeMessageRecordsBucket), keys, encoded)
+	})
+}
+
+// SaveSyncCommitteeEquivocations saves the evidence of sync committee equivocations. Only the
+// first conflicting message is kept for a given slot and validator.
+func (s *Store) SaveSyncCommitteeEquivocations(
+	ctx context.Context, equivocations []*slashertypes.SyncCommitteeEquivocation,
+) error {
+	_, span := trace.StartSpan(ctx, "BeaconDB.SaveSyncCommitteeEquivocations")
+	defer span.End()
+	keys := make([][]byte, len(equivocations))
+	prevs := make([][]byte, len(equivocations))
+	encoded := make([][]byte, len(equivocations))
+	for i, e := range equivocations {
+		if e == nil {
+			return errors.New("nil sync committee equivocation")
+		}
+		prev, err := encodeSyncCommitteeMessageRecord(e.PrevMessageWrapper)
+		if err != nil {
+			return err
+		}
+		enc, err := encodeSyncCommitteeMessageRecord(e.MessageWrapper)
+		if err != nil {
+			return err
+		}
+		keys[i] = keyForSyncCommitteeMessage(e.PrevMessageWrapper.SyncCommitteeMessage)
+		prevs[i] = prev
+		encoded[i] = enc
+	}
+	return s.db.Update(func(tx *bolt.Tx) error {
+		if err := putIfAbsent(tx.Bucket(syncCommitteeMessageRecordsBucket), keys, prevs); err != nil {
+			return err
+		}
+		return putIfAbsent(tx.Bucket(syncCommitteeEquivocationsBucket), keys, encoded)
+	})
+}
+
+// SyncCommitteeEquivocations returns the evidence of sync committee equivocations saved for
+// slots within the inclusive range [startSlot, endSlot].
+func (s *Store) SyncCommitteeEquivocations(
+	ctx context.Context, startSlot, endSlot primitives.Slot,
+) ([]*slashertypes.SyncCommitteeEquivocation, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.SyncCommitteeEquivocations")
+	defer span.End()
+	prevs, encoded, err := s.equivocationsInRange(
+		ctx, syncCommitteeMessageRecordsBucket, syncCommitteeEquivocationsBucket, startSlot, endSlot,
+	)
+	if err != nil {
+		return nil, err
+	}
+	equivocations := make([]*slashertypes.SyncCommitteeEquivocation, len(encoded))
+	for i := range encoded {
+		prev, err := decodeSyncCommitteeMessageRecord(prevs[i])
+		if err != nil {
+			return nil, err
+		}
+		msg, err := decodeSyncCommitteeMessageRecord(encoded[i])
+		if err != nil {
+			return nil, err
+		}
+		equivocations[i] = &slashertypes.SyncCommitteeEquivocation{
+			PrevMessageWrapper: prev,
+			MessageWrapper:     msg,
+		}
+	}
+	return equivocations, nil
+}
+
+// PruneEquivocationRecordsAtEpoch deletes all blob sidecar headers and sync committee messages,
+// along with their equivocation evidence, from the slasher DB with epoch less than or equal to
+// the specified epoch.
+func (s *Store) PruneEquivocationRecordsAtEpoch(
+	ctx context.Context, maxEpoch primitives.Epoch,
+) (numPruned uint, err error) {
+	endPruneSlot, err := slots.EpochEnd(maxEpoch)
+	if err != nil {
+		return 0, err
+	}
+	encodedEndPruneSlot := bytesutil.Uint64ToBytesBigEndian(uint64(endPruneSlot))
+	err = s.db.Update(func(tx *bolt.Tx) error {
+		for _, bucket := range [][]byte{
+			blobSidecarRecordsBucket,
+			blobSidecarEquivocationsBucket,
+			syncCommitteeMessageRecordsBucket,
+			syncCommitteeEquivocationsBucket,
+		} {
+			bkt := tx.Bucket(bucket)
+			c := bkt.Cursor()
+			// Keys are sorted by slot, so we can stop at the first key past the end slot.
+			for k, _ := c.First(); k != nil; k, _ = c.First() {
+				if ctx.Err() != nil {
+					return ctx.Err()
+				}
+				if bytes.Compare(k[:slotPrefixSize], encodedEndPruneSlot) > 0 {
+					break
+				}
+				if err := bkt.Delete(k); err != nil {
+					return err
+				}
+				slasherEquivocationRecordsPrunedTotal.Inc()
+				numPruned++
+			}
+		}
+		return nil
+	})
+	return
+}
+
+// conflictingRecords returns, for each key, the encoded record saved under it if its signing
+// root differs from the given one, or nil otherwise.
+func (s *Store) conflictingRecords(
+	ctx context.Context, bucket []byte, keys [][]byte, signingRoots [][32]byte,
+) ([][]byte, error) {
+	_, span := trace.StartSpan(ctx, "BeaconDB.conflictingRecords")
+	defer span.End()
+	existing := make([][]byte, len(keys))
+	err := s.db.View(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket(bucket)
+		for i, key := range keys {
+			enc := bkt.Get(key)
+			if len(enc) < signingRootSize {
+				continue
+			}
+			if bytesutil.ToBytes32(enc[:signingRootSize]) != signingRoots[i] {
+				// Values returned by bolt are only valid for the life of the transaction.
+				existing[i] = bytesutil.SafeCopyBytes(enc)
+			}
+		}
+		return nil
+	})
+	return existing, err
+}
+
+// equivocationsInRange returns the encoded records and conflicting records of all equivocations
+// within the inclusive slot range, in slot order.
+func (s *Store) equivocationsInRange(
+	ctx context.Context, recordsBucket, equivocationsBucket []byte, startSlot, endSlot primitives.Slot,
+) (prevs, encoded [][]byte, err error) {
+	_, span := trace.StartSpan(ctx, "BeaconDB.equivocationsInRange")
+	defer span.End()
+	if startSlot > endSlot {
+		return nil, nil, fmt.Errorf("start slot %d is greater than end slot %d", startSlot, endSlot)
+	}
+	encodedEndSlot := bytesutil.Uint64ToBytesBigEndian(uint64(endSlot))
+	err = s.db.View(func(tx *bolt.Tx) error {
+		recordsBkt := tx.Bucket(recordsBucket)
+		c := tx.Bucket(equivocationsBucket).Cursor()
+		start := bytesutil.Uint64ToBytesBigEndian(uint64(startSlot))
+		for k, v := c.Seek(start); k != nil; k, v = c.Next() {
+			if bytes.Compare(k[:slotPrefixSize], encodedEndSlot) > 0 {
+				break
+			}
+			prev := recordsBkt.Get(k)
+			if prev == nil {
+				return fmt.Errorf("missing record for equivocation with key %#x", k)
+			}
+			prevs = append(prevs, bytesutil.SafeCopyBytes(prev))
+			encoded = append(encoded, bytesutil.SafeCopyBytes(v))
+		}
+		return nil
+	})
+	return
+}
+
+func putIfAbsent(bkt *bolt.Bucket, keys, values [][]byte) error {
+	for i, key := range keys {
+		if bkt.Get(key) != nil {
+			continue
+		}
+		if err := bkt.Put(key, values[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func keyForBlobSidecar(header *ethpb.BlindedBlobSidecar) []byte {
+	key := bytesutil.Uint64ToBytesBigEndian(uint64(header.Slot))
+	key = append(key, encodeValidatorIndex(header.ProposerIndex)...)
+	return append(key, bytesutil.Uint64ToBytesBigEndian(header.Index)...)
+}
+
+func keyForSyncCommitteeMessage(msg *ethpb.SyncCommitteeMessage) []byte {
+	key := bytesutil.Uint64ToBytesBigEndian(uint64(msg.Slot))
+	return append(key, encodeValidatorIndex(msg.ValidatorIndex)...)
+}
+
+func encodeBlobSidecarRecord(header *slashertypes.SignedBlobSidecarHeaderWrapper) ([]byte, error) {
+	if header == nil || header.SignedBlobSidecarHeader == nil || header.SignedBlobSidecarHeader.Message == nil {
+		return nil, errors.New("nil blob sidecar record")
+	}
+	enc, err := header.SignedBlobSidecarHeader.MarshalSSZ()
+	if err != nil {
+		return nil, err
+	}
+	return append(header.SigningRoot[:], snappy.Encode(nil, enc)...), nil
+}
+
+func decodeBlobSidecarRecord(encoded []byte) (*slashertypes.SignedBlobSidecarHeaderWrapper, error) {
+	if len(encoded) < signingRootSize {
+		return nil, fmt.Errorf(
+			"wrong length for encoded blob sidecar record, want %d, got %d", signingRootSize, len(encoded),
+		)
+	}
+	enc, err := snappy.Decode(nil, encoded[signingRootSize:])
+	if err != nil {
+		return nil, err
+	}
+	header := &ethpb.SignedBlindedBlobSidecar{}
+	if err := header.UnmarshalSSZ(enc); err != nil {
+		return nil, err
+	}
+	return &slashertypes.SignedBlobSidecarHeaderWrapper{
+		SignedBlobSidecarHeader: header,
+		SigningRoot:             bytesutil.ToBytes32(encoded[:signingRootSize]),
+	}, nil
+}
+
+func encodeSyncCommitteeMessageRecord(msg *slashertypes.SyncCommitteeMessageWrapper) ([]byte, error) {
+	if msg == nil || msg.SyncCommitteeMessage == nil {
+		return nil, errors.New("nil sync committee message record")
+	}
+	enc, err := msg.SyncCommitteeMessage.MarshalSSZ()
+	if err != nil {
+		return nil, err
+	}
+	return append(msg.SigningRoot[:], snappy.Encode(nil, enc)...), nil
+}
+
+func decodeSyncCommitteeMessageRecord(encoded []byte) (*slashertypes.SyncCommitteeMessageWrapper, error) {
+	if len(encoded) < signingRootSize {
+		return nil, fmt.Errorf(
+			"wrong length for encoded sync committee message record, want %d, got %d", signingRootSize, len(encoded),
+		)
+	}
+	enc, err := snappy.Decode(nil, encoded[signingRootSize:])
+	if err != nil {
+		return nil, err
+	}
+	msg := &ethpb.SyncCommitteeMessage{}
+	if err := msg.UnmarshalSSZ(enc); err != nil {
+		return nil, err
+	}
+	return &slashertypes.SyncCommitteeMessageWrapper{
+		SyncCommitteeMessage: msg,
+		SigningRoot:          bytesutil.ToBytes32(encoded[:signingRootSize]),
+	}, nil
+}
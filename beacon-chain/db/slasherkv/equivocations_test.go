@@ -0,0 +1,172 @@
+package slasherkv
+
+import (
+	"context"
+	"testing"
+
+	slashertypes "github.com/prysmaticlabs/prysm/v4/beacon-chain/slasher/types"
+	"github.com/prysmaticlabs/prysm/v4/config/params"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v4/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v4/testing/assert"
+	"github.com/prysmaticlabs/prysm/v4/testing/require"
+)
+
+func TestStore_BlobSidecarEquivocations(t *testing.T) {
+	ctx := context.Background()
+	beaconDB := setupDB(t)
+
+	saved := []*slashertypes.SignedBlobSidecarHeaderWrapper{
+		createBlobSidecarHeaderWrapper(t, 1, 3, 0, []byte{1}),
+		createBlobSidecarHeaderWrapper(t, 1, 3, 1, []byte{1}),
+		createBlobSidecarHeaderWrapper(t, 300, 3, 0, []byte{1}),
+	}
+	require.NoError(t, beaconDB.SaveBlobSidecarHeaders(ctx, saved))
+
+	incoming := []*slashertypes.SignedBlobSidecarHeaderWrapper{
+		// Same header as a saved one.
+		createBlobSidecarHeaderWrapper(t, 1, 3, 0, []byte{1}),
+		// Conflicting headers.
+		createBlobSidecarHeaderWrapper(t, 1, 3, 1, []byte{2}),
+		createBlobSidecarHeaderWrapper(t, 300, 3, 0, []byte{2}),
+		// Unknown header.
+		createBlobSidecarHeaderWrapper(t, 2, 3, 0, []byte{2}),
+	}
+	equivocations, err := beaconDB.CheckBlobSidecarEquivocations(ctx, incoming)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(equivocations))
+	assert.DeepEqual(t, saved[1].SigningRoot, equivocations[0].PrevBlobSidecarWrapper.SigningRoot)
+	assert.DeepEqual(t, incoming[1].SigningRoot, equivocations[0].BlobSidecarWrapper.SigningRoot)
+
+	// Saving a conflicting header does not replace the first one seen.
+	require.NoError(t, beaconDB.SaveBlobSidecarHeaders(ctx, incoming[1:2]))
+	again, err := beaconDB.CheckBlobSidecarEquivocations(ctx, incoming[1:2])
+	require.NoError(t, err)
+	require.Equal(t, 1, len(again))
+
+	require.NoError(t, beaconDB.SaveBlobSidecarEquivocations(ctx, equivocations))
+	stored, err := beaconDB.BlobSidecarEquivocations(ctx, 0, 10)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(stored))
+	assert.DeepEqual(t, saved[1].SignedBlobSidecarHeader, stored[0].PrevBlobSidecarWrapper.SignedBlobSidecarHeader)
+	assert.DeepEqual(t, incoming[1].SignedBlobSidecarHeader, stored[0].BlobSidecarWrapper.SignedBlobSidecarHeader)
+	stored, err = beaconDB.BlobSidecarEquivocations(ctx, 0, 300)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(stored))
+	assert.Equal(t, primitives.Slot(300), stored[1].BlobSidecarWrapper.SignedBlobSidecarHeader.Message.Slot)
+
+	_, err = beaconDB.BlobSidecarEquivocations(ctx, 10, 0)
+	require.ErrorContains(t, "greater than end slot", err)
+}
+
+func TestStore_SyncCommitteeEquivocations(t *testing.T) {
+	ctx := context.Background()
+	beaconDB := setupDB(t)
+
+	saved := []*slashertypes.SyncCommitteeMessageWrapper{
+		createSyncCommitteeMessageWrapper(5, 1, []byte{1}),
+		createSyncCommitteeMessageWrapper(5, 2, []byte{1}),
+	}
+	require.NoError(t, beaconDB.SaveSyncCommitteeMessages(ctx, saved))
+
+	incoming := []*slashertypes.SyncCommitteeMessageWrapper{
+		createSyncCommitteeMessageWrapper(5, 1, []byte{1}),
+		createSyncCommitteeMessageWrapper(5, 2, []byte{2}),
+		createSyncCommitteeMessageWrapper(6, 2, []byte{2}),
+	}
+	equivocations, err := beaconDB.CheckSyncCommitteeEquivocations(ctx, incoming)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(equivocations))
+	assert.Equal(t, primitives.ValidatorIndex(2), equivocations[0].PrevMessageWrapper.SyncCommitteeMessage.ValidatorIndex)
+	assert.DeepEqual(t, saved[1].SigningRoot, equivocations[0].PrevMessageWrapper.SigningRoot)
+
+	require.NoError(t, beaconDB.SaveSyncCommitteeEquivocations(ctx, equivocations))
+	stored, err := beaconDB.SyncCommitteeEquivocations(ctx, 5, 5)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(stored))
+	assert.DeepEqual(t, saved[1].SyncCommitteeMessage, stored[0].PrevMessageWrapper.SyncCommitteeMessage)
+	assert.DeepEqual(t, incoming[1].SyncCommitteeMessage, stored[0].MessageWrapper.SyncCommitteeMessage)
+	stored, err = beaconDB.SyncCommitteeEquivocations(ctx, 6, 100)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(stored))
+}
+
+func TestStore_PruneEquivocationRecordsAtEpoch(t *testing.T) {
+	ctx := context.Background()
+	beaconDB := setupDB(t)
+	slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
+
+	// Slots are chosen so that their little-endian encoding does not sort like the slots.
+	oldSlot, newSlot := slotsPerEpoch-1, 2*slotsPerEpoch
+	oldMsg := createSyncCommitteeMessageWrapper(oldSlot, 1, []byte{1})
+	newMsg := createSyncCommitteeMessageWrapper(newSlot, 1, []byte{1})
+	require.NoError(t, beaconDB.SaveSyncCommitteeMessages(ctx, []*slashertypes.SyncCommitteeMessageWrapper{newMsg, oldMsg}))
+	require.NoError(t, beaconDB.SaveSyncCommitteeEquivocations(ctx, []*slashertypes.SyncCommitteeEquivocation{{
+		PrevMessageWrapper: oldMsg,
+		MessageWrapper:     createSyncCommitteeMessageWrapper(oldSlot, 1, []byte{2}),
+	}}))
+	require.NoError(t, beaconDB.SaveBlobSidecarHeaders(ctx, []*slashertypes.SignedBlobSidecarHeaderWrapper{
+		createBlobSidecarHeaderWrapper(t, oldSlot, 1, 0, []byte{1}),
+		createBlobSidecarHeaderWrapper(t, newSlot, 1, 0, []byte{1}),
+	}))
+
+	numPruned, err := beaconDB.PruneEquivocationRecordsAtEpoch(ctx, 0)
+	require.NoError(t, err)
+	assert.Equal(t, uint(3), numPruned)
+
+	stored, err := beaconDB.SyncCommitteeEquivocations(ctx, 0, newSlot)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(stored))
+	equivocations, err := beaconDB.CheckSyncCommitteeEquivocations(ctx, []*slashertypes.SyncCommitteeMessageWrapper{
+		createSyncCommitteeMessageWrapper(newSlot, 1, []byte{2}),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 1, len(equivocations))
+	blobEquivocations, err := beaconDB.CheckBlobSidecarEquivocations(ctx, []*slashertypes.SignedBlobSidecarHeaderWrapper{
+		createBlobSidecarHeaderWrapper(t, oldSlot, 1, 0, []byte{2}),
+		createBlobSidecarHeaderWrapper(t, newSlot, 1, 0, []byte{2}),
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(blobEquivocations))
+	assert.Equal(t, newSlot, blobEquivocations[0].PrevBlobSidecarWrapper.SignedBlobSidecarHeader.Message.Slot)
+}
+
+func createBlobSidecarHeaderWrapper(
+	t *testing.T, slot primitives.Slot, proposerIndex primitives.ValidatorIndex, index uint64, blobRoot []byte,
+) *slashertypes.SignedBlobSidecarHeaderWrapper {
+	header := &ethpb.BlindedBlobSidecar{
+		BlockRoot:       bytesutil.PadTo([]byte{byte(slot)}, 32),
+		Index:           index,
+		Slot:            slot,
+		BlockParentRoot: params.BeaconConfig().ZeroHash[:],
+		ProposerIndex:   proposerIndex,
+		BlobRoot:        bytesutil.PadTo(blobRoot, 32),
+		KzgCommitment:   make([]byte, 48),
+		KzgProof:        make([]byte, 48),
+	}
+	signingRoot, err := header.HashTreeRoot()
+	require.NoError(t, err)
+	return &slashertypes.SignedBlobSidecarHeaderWrapper{
+		SignedBlobSidecarHeader: &ethpb.SignedBlindedBlobSidecar{
+			Message:   header,
+			Signature: params.BeaconConfig().EmptySignature[:],
+		},
+		SigningRoot: signingRoot,
+	}
+}
+
+func createSyncCommitteeMessageWrapper(
+	slot primitives.Slot, validatorIndex primitives.ValidatorIndex, blockRoot []byte,
+) *slashertypes.SyncCommitteeMessageWrapper {
+	root := bytesutil.ToBytes32(blockRoot)
+	return &slashertypes.SyncCommitteeMessageWrapper{
+		SyncCommitteeMessage: &ethpb.SyncCommitteeMessage{
+			Slot:           slot,
+			BlockRoot:      root[:],
+			ValidatorIndex: validatorIndex,
+			Signature:      params.BeaconConfig().EmptySignature[:],
+		},
+		SigningRoot: root,
+	}
+}
@@ -77,6 +77,11 @@ func NewKVStore(ctx context.Context, dirPath string) (*Store, error) {
 			attestationDataRootsBucket,
 			proposalRecordsBucket,
 			slasherChunksBucket,
+			// Equivocation evidence buckets.
+			blobSidecarRecordsBucket,
+			blobSidecarEquivocationsBucket,
+			syncCommitteeMessageRecordsBucket,
+			syncCommitteeEquivocationsBucket,
 		)
 	}); err != nil {
 		return nil, err
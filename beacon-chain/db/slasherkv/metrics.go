@@ -14,4 +14,8 @@ var (
 		Name: "slasher_proposals_pruned_total",
 		Help: "Total number of old proposals pruned by slasher",
 	})
+	slasherEquivocationRecordsPrunedTotal = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "slasher_equivocation_records_pruned_total",
+		Help: "Total number of old blob sidecar, sync committee message and equivocation records pruned by slasher",
+	})
 )
@@ -13,4 +13,11 @@ var (
 	attestationDataRootsBucket = []byte("attestation-data-roots")
 	proposalRecordsBucket      = []byte("proposal-records")
 	slasherChunksBucket        = []byte("slasher-chunks")
+
+	// Equivocation evidence buckets. The first message seen for a key is kept in a records bucket,
+	// and the first conflicting one in the matching equivocations bucket.
+	blobSidecarRecordsBucket          = []byte("blob-sidecar-records")
+	blobSidecarEquivocationsBucket    = []byte("blob-sidecar-equivocations")
+	syncCommitteeMessageRecordsBucket = []byte("sync-committee-message-records")
+	syncCommitteeEquivocationsBucket  = []byte("sync-committee-equivocations")
 )
@@ -58,6 +58,30 @@ func (s *Store) LastEpochWrittenForValidators(
 	return attestedEpochs, err
 }
 
+// LastEpochsWrittenForAllValidators returns the latest epoch we have recorded
+// writing data for, for every validator with such a record.
+func (s *Store) LastEpochsWrittenForAllValidators(
+	ctx context.Context,
+) ([]*slashertypes.AttestedEpochForValidator, error) {
+	_, span := trace.StartSpan(ctx, "BeaconDB.LastEpochsWrittenForAllValidators")
+	defer span.End()
+	attestedEpochs := make([]*slashertypes.AttestedEpochForValidator, 0)
+	err := s.db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket(attestedEpochsByValidator).ForEach(func(k, v []byte) error {
+			var epoch primitives.Epoch
+			if err := epoch.UnmarshalSSZ(v); err != nil {
+				return err
+			}
+			attestedEpochs = append(attestedEpochs, &slashertypes.AttestedEpochForValidator{
+				ValidatorIndex: decodeValidatorIndex(k),
+				Epoch:          epoch,
+			})
+			return nil
+		})
+	})
+	return attestedEpochs, err
+}
+
 // SaveLastEpochsWrittenForValidators updates the latest epoch a slice
 // of validator indices has attested to.
 func (s *Store) SaveLastEpochsWrittenForValidators(
@@ -598,3 +622,11 @@ func encodeValidatorIndex(index primitives.ValidatorIndex) []byte {
 	buf[4] = byte(v >> 32)
 	return buf
 }
+
+func decodeValidatorIndex(enc []byte) primitives.ValidatorIndex {
+	var v uint64
+	for i := len(enc) - 1; i >= 0; i-- {
+		v = v<<8 | uint64(enc[i])
+	}
+	return primitives.ValidatorIndex(v)
+}
@@ -77,6 +77,29 @@ func TestStore_LastEpochWrittenForValidators(t *testing.T) {
 	}
 }
 
+func TestStore_LastEpochsWrittenForAllValidators(t *testing.T) {
+	ctx := context.Background()
+	beaconDB := setupDB(t)
+
+	attestedEpochs, err := beaconDB.LastEpochsWrittenForAllValidators(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 0, len(attestedEpochs))
+
+	epochsByValidator := map[primitives.ValidatorIndex]primitives.Epoch{
+		1:       5,
+		300:     6,
+		1 << 33: 7,
+	}
+	require.NoError(t, beaconDB.SaveLastEpochsWrittenForValidators(ctx, epochsByValidator))
+
+	attestedEpochs, err = beaconDB.LastEpochsWrittenForAllValidators(ctx)
+	require.NoError(t, err)
+	require.Equal(t, len(epochsByValidator), len(attestedEpochs))
+	for _, item := range attestedEpochs {
+		require.Equal(t, epochsByValidator[item.ValidatorIndex], item.Epoch)
+	}
+}
+
 func TestStore_CheckAttesterDoubleVotes(t *testing.T) {
 	ctx := context.Background()
 	beaconDB := setupDB(t)
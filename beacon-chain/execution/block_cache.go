@@ -3,6 +3,7 @@ package execution
 import (
 	"errors"
 	"math/big"
+	"sort"
 	"sync"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -153,6 +154,36 @@ func (c *headerCache) AddHeader(hdr *types.HeaderInfo) error {
 	return nil
 }
 
+// Headers returns all the cached header infos, ordered by their block number.
+func (c *headerCache) Headers() ([]*types.HeaderInfo, error) {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	objs := c.heightCache.List()
+	headers := make([]*types.HeaderInfo, 0, len(objs))
+	for _, obj := range objs {
+		hInfo, ok := obj.(*types.HeaderInfo)
+		if !ok {
+			return nil, ErrNotAHeaderInfo
+		}
+		headers = append(headers, hInfo.Copy())
+	}
+	sort.Slice(headers, func(i, j int) bool {
+		return headers[i].Number.Cmp(headers[j].Number) < 0
+	})
+	return headers, nil
+}
+
+// Reset removes all the header infos from the cache.
+func (c *headerCache) Reset() {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.hashCache = cache.NewFIFO(hashKeyFn)
+	c.heightCache = cache.NewFIFO(heightKeyFn)
+	headerCacheSize.Set(0)
+}
+
 // trim the FIFO queue to the maxSize.
 func trim(queue *cache.FIFO, maxSize uint64) {
 	for s := uint64(len(queue.ListKeys())); s > maxSize; s-- {
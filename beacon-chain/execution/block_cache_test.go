@@ -100,3 +100,28 @@ func TestBlockCache_maxSize(t *testing.T) {
 	assert.Equal(t, int(maxCacheSize), len(cache.hashCache.ListKeys()))
 	assert.Equal(t, int(maxCacheSize), len(cache.heightCache.ListKeys()))
 }
+
+func TestBlockCache_headersAndReset(t *testing.T) {
+	cache := newHeaderCache()
+	for _, n := range []int64{3, 1, 2} {
+		require.NoError(t, cache.AddHeader(&types.HeaderInfo{
+			Number: big.NewInt(n),
+			Hash:   common.BigToHash(big.NewInt(n)),
+		}))
+	}
+
+	headers, err := cache.Headers()
+	require.NoError(t, err)
+	require.Equal(t, 3, len(headers))
+	for i, h := range headers {
+		assert.Equal(t, uint64(i+1), h.Number.Uint64(), "Headers are not ordered by number")
+	}
+
+	cache.Reset()
+	headers, err = cache.Headers()
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(headers))
+	exists, _, err := cache.HeaderInfoByHash(common.BigToHash(big.NewInt(1)))
+	require.NoError(t, err)
+	assert.Equal(t, false, exists)
+}
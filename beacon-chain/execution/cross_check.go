@@ -0,0 +1,251 @@
+package execution
+
+import (
+	"bytes"
+	"context"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	gethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	gethRPC "github.com/ethereum/go-ethereum/rpc"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/execution/types"
+	"github.com/prysmaticlabs/prysm/v4/io/logs"
+	"github.com/prysmaticlabs/prysm/v4/network"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// crossCheckTimeout bounds requests made to other endpoints, so that a slow endpoint
+	// doesn't hold up processing of the active one.
+	crossCheckTimeout = 10 * time.Second
+	// crossCheckQueueSize bounds the number of cross checks waiting for the worker. Checks are
+	// dropped once it is reached, rather than holding up processing of the active endpoint.
+	crossCheckQueueSize = 64
+
+	depositLogsCheck = "deposit_logs"
+	blockHeaderCheck = "block_header"
+)
+
+// crossCheck compares responses of the active endpoint with the other configured endpoints.
+type crossCheck func(ctx context.Context)
+
+// batchCallerWithContext is implemented by RPC clients which can bound batch requests with a context.
+type batchCallerWithContext interface {
+	BatchCallContext(ctx context.Context, b []gethRPC.BatchElem) error
+}
+
+// crossCheckSource is an execution endpoint, other than the active one, against which
+// the deposit logs and block headers received from the active endpoint are compared.
+type crossCheckSource struct {
+	endpoint   network.Endpoint
+	rpcClient  RPCClient
+	httpLogger bind.ContractFilterer
+}
+
+// endpointLabel returns the endpoint URL without credentials, to be used in logs and metrics.
+func endpointLabel(e network.Endpoint) string {
+	return logs.MaskCredentialsLogging(e.Url)
+}
+
+// sourcesToCrossCheck returns clients of all configured endpoints except for the given active one.
+// Clients are created on first use and kept around, as the active endpoint may change.
+func (s *Service) sourcesToCrossCheck(ctx context.Context, active network.Endpoint) []*crossCheckSource {
+	s.crossCheckLock.Lock()
+	defer s.crossCheckLock.Unlock()
+	sources := make([]*crossCheckSource, 0, len(s.cfg.httpEndpoints))
+	for _, e := range s.cfg.httpEndpoints {
+		if e.Equals(active) {
+			continue
+		}
+		src, ok := s.crossCheckSources[e.Url]
+		if !ok {
+			client, err := s.newRPCClientWithAuth(ctx, e)
+			if err != nil {
+				endpointErrorsCount.WithLabelValues(endpointLabel(e)).Inc()
+				log.WithError(err).WithField("endpoint", endpointLabel(e)).Debug("Could not dial execution endpoint for cross checking")
+				continue
+			}
+			src = &crossCheckSource{
+				endpoint:   e,
+				rpcClient:  client,
+				httpLogger: ethclient.NewClient(client),
+			}
+			s.crossCheckSources[e.Url] = src
+		}
+		sources = append(sources, src)
+	}
+	return sources
+}
+
+// closeCrossCheckSources closes clients of all the endpoints used for cross checking.
+func (s *Service) closeCrossCheckSources() {
+	s.crossCheckLock.Lock()
+	defer s.crossCheckLock.Unlock()
+	for url, src := range s.crossCheckSources {
+		src.rpcClient.Close()
+		delete(s.crossCheckSources, url)
+	}
+}
+
+// runCrossChecks runs queued cross checks one at a time, until the context is done.
+func (s *Service) runCrossChecks(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case check := <-s.crossChecks:
+			check(ctx)
+		}
+	}
+}
+
+// queueCrossCheck hands the check over to the cross check worker. The check is dropped
+// if the queue is full, or if there are no other endpoints to check against.
+// Checks must be queued from the goroutine which switches the active endpoint, as they
+// capture the active endpoint at the time they are queued.
+func (s *Service) queueCrossCheck(check crossCheck) {
+	if len(s.cfg.httpEndpoints) < 2 {
+		return
+	}
+	select {
+	case s.crossChecks <- check:
+	default:
+		crossChecksDroppedCount.Inc()
+		log.Debug("Cross check queue is full, dropping cross check")
+	}
+}
+
+// queueLogsCrossCheck queues a cross check of the deposit logs received for the given query.
+func (s *Service) queueLogsCrossCheck(query ethereum.FilterQuery, received []gethtypes.Log) {
+	// The caller may reuse the block numbers of the query.
+	if query.FromBlock != nil {
+		query.FromBlock = new(big.Int).Set(query.FromBlock)
+	}
+	if query.ToBlock != nil {
+		query.ToBlock = new(big.Int).Set(query.ToBlock)
+	}
+	active := s.cfg.currHttpEndpoint
+	s.queueCrossCheck(func(ctx context.Context) {
+		s.crossCheckLogs(ctx, active, query, received)
+	})
+}
+
+// queueHeadersCrossCheck queues a cross check of the block hashes received for the range of
+// blocks starting at the given height.
+func (s *Service) queueHeadersCrossCheck(from uint64, hashes []common.Hash) {
+	if len(hashes) == 0 {
+		return
+	}
+	active := s.cfg.currHttpEndpoint
+	s.queueCrossCheck(func(ctx context.Context) {
+		s.crossCheckHeaders(ctx, active, from, hashes)
+	})
+}
+
+// crossCheckLogs requests deposit logs matching the given query from every other configured endpoint,
+// and flags the endpoints whose logs differ from the ones received from the active endpoint. Failing
+// requests are only counted, as other endpoints must not affect processing of the active one.
+func (s *Service) crossCheckLogs(ctx context.Context, active network.Endpoint, query ethereum.FilterQuery, received []gethtypes.Log) {
+	for _, src := range s.sourcesToCrossCheck(ctx, active) {
+		label := endpointLabel(src.endpoint)
+		reqCtx, cancel := context.WithTimeout(ctx, crossCheckTimeout)
+		other, err := src.httpLogger.FilterLogs(reqCtx, query)
+		cancel()
+		if err != nil {
+			endpointErrorsCount.WithLabelValues(label).Inc()
+			log.WithError(err).WithField("endpoint", label).Debug("Could not cross check deposit logs")
+			continue
+		}
+		endpointCrossChecksCount.WithLabelValues(label, depositLogsCheck).Inc()
+		if equalLogs(received, other) {
+			continue
+		}
+		endpointDisagreementsCount.WithLabelValues(label, depositLogsCheck).Inc()
+		log.WithFields(logrus.Fields{
+			"endpoint":       label,
+			"activeEndpoint": endpointLabel(active),
+			"fromBlock":      query.FromBlock,
+			"toBlock":        query.ToBlock,
+			"logs":           len(received),
+			"endpointLogs":   len(other),
+		}).Warn("Execution endpoints disagree on deposit logs")
+	}
+}
+
+// crossCheckHeaders requests the headers of the range of blocks starting at the given height from every
+// other configured endpoint in a single batch, and flags the endpoints which don't agree with the block
+// hashes received from the active endpoint.
+func (s *Service) crossCheckHeaders(ctx context.Context, active network.Endpoint, from uint64, hashes []common.Hash) {
+	for _, src := range s.sourcesToCrossCheck(ctx, active) {
+		label := endpointLabel(src.endpoint)
+		hdrs := make([]*types.HeaderInfo, len(hashes))
+		elems := make([]gethRPC.BatchElem, len(hashes))
+		for i := range hashes {
+			elems[i] = gethRPC.BatchElem{
+				Method: ExecutionBlockByNumberMethod,
+				Args:   []interface{}{toBlockNumArg(new(big.Int).SetUint64(from + uint64(i))), false /* no transactions */},
+				Result: &hdrs[i],
+			}
+		}
+		if err := batchCall(ctx, src.rpcClient, elems); err != nil {
+			endpointErrorsCount.WithLabelValues(label).Inc()
+			log.WithError(err).WithField("endpoint", label).Debug("Could not cross check block headers")
+			continue
+		}
+		for i, hash := range hashes {
+			err := elems[i].Error
+			if err == nil && hdrs[i] == nil {
+				err = ethereum.NotFound
+			}
+			if err != nil {
+				endpointErrorsCount.WithLabelValues(label).Inc()
+				log.WithError(err).WithField("endpoint", label).Debug("Could not cross check block header")
+				continue
+			}
+			endpointCrossChecksCount.WithLabelValues(label, blockHeaderCheck).Inc()
+			if hdrs[i].Hash == hash {
+				continue
+			}
+			endpointDisagreementsCount.WithLabelValues(label, blockHeaderCheck).Inc()
+			log.WithFields(logrus.Fields{
+				"endpoint":          label,
+				"activeEndpoint":    endpointLabel(active),
+				"blockNumber":       from + uint64(i),
+				"blockHash":         hash,
+				"endpointBlockHash": hdrs[i].Hash,
+			}).Warn("Execution endpoints disagree on block header")
+		}
+	}
+}
+
+// batchCall sends the batch request, bounded by crossCheckTimeout if the client supports it.
+func batchCall(ctx context.Context, client RPCClient, elems []gethRPC.BatchElem) error {
+	c, ok := client.(batchCallerWithContext)
+	if !ok {
+		return client.BatchCall(elems)
+	}
+	reqCtx, cancel := context.WithTimeout(ctx, crossCheckTimeout)
+	defer cancel()
+	return c.BatchCallContext(reqCtx, elems)
+}
+
+// equalLogs returns true if both endpoints returned the same logs, from the same blocks.
+func equalLogs(a, b []gethtypes.Log) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i].BlockNumber != b[i].BlockNumber ||
+			a[i].BlockHash != b[i].BlockHash ||
+			a[i].TxHash != b[i].TxHash ||
+			a[i].Index != b[i].Index ||
+			!bytes.Equal(a[i].Data, b[i].Data) {
+			return false
+		}
+	}
+	return true
+}
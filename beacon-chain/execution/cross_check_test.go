@@ -0,0 +1,206 @@
+package execution
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	gethTypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v4/async/event"
+	mockExecution "github.com/prysmaticlabs/prysm/v4/beacon-chain/execution/testing"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/execution/types"
+	"github.com/prysmaticlabs/prysm/v4/network"
+	"github.com/prysmaticlabs/prysm/v4/testing/assert"
+	"github.com/prysmaticlabs/prysm/v4/testing/require"
+	logTest "github.com/sirupsen/logrus/hooks/test"
+)
+
+type staticLogger struct {
+	logs []gethTypes.Log
+	err  error
+}
+
+func (l *staticLogger) SubscribeFilterLogs(_ context.Context, _ ethereum.FilterQuery, ch chan<- gethTypes.Log) (ethereum.Subscription, error) {
+	return new(event.Feed).Subscribe(ch), nil
+}
+
+func (l *staticLogger) FilterLogs(_ context.Context, _ ethereum.FilterQuery) ([]gethTypes.Log, error) {
+	return l.logs, l.err
+}
+
+func crossCheckService(sources ...*crossCheckSource) *Service {
+	active := network.HttpEndpoint("http://active")
+	s := &Service{
+		cfg: &config{
+			currHttpEndpoint: active,
+			httpEndpoints:    []network.Endpoint{active},
+		},
+		crossCheckSources: make(map[string]*crossCheckSource),
+	}
+	for _, src := range sources {
+		s.cfg.httpEndpoints = append(s.cfg.httpEndpoints, src.endpoint)
+		s.crossCheckSources[src.endpoint.Url] = src
+	}
+	return s
+}
+
+func TestService_crossCheckLogs(t *testing.T) {
+	hook := logTest.NewGlobal()
+	logs := []gethTypes.Log{
+		{BlockNumber: 10, BlockHash: common.Hash{'a'}, TxHash: common.Hash{'b'}, Index: 1, Data: []byte{'c'}},
+		{BlockNumber: 11, BlockHash: common.Hash{'d'}, TxHash: common.Hash{'e'}, Index: 2, Data: []byte{'f'}},
+	}
+	reorged := make([]gethTypes.Log, len(logs))
+	copy(reorged, logs)
+	reorged[1].BlockHash = common.Hash{'g'}
+
+	s := crossCheckService(
+		&crossCheckSource{
+			endpoint:   network.HttpEndpoint("http://agreeing"),
+			rpcClient:  RPCClientEmpty{},
+			httpLogger: &staticLogger{logs: logs},
+		},
+		&crossCheckSource{
+			endpoint:   network.HttpEndpoint("http://missing"),
+			rpcClient:  RPCClientEmpty{},
+			httpLogger: &staticLogger{logs: logs[:1]},
+		},
+		&crossCheckSource{
+			endpoint:   network.HttpEndpoint("http://reorged"),
+			rpcClient:  RPCClientEmpty{},
+			httpLogger: &staticLogger{logs: reorged},
+		},
+		&crossCheckSource{
+			endpoint:   network.HttpEndpoint("http://failing"),
+			rpcClient:  RPCClientEmpty{},
+			httpLogger: &staticLogger{err: errors.New("connection refused")},
+		},
+	)
+	s.crossCheckLogs(context.Background(), s.cfg.currHttpEndpoint, ethereum.FilterQuery{FromBlock: big.NewInt(10), ToBlock: big.NewInt(11)}, logs)
+
+	var flagged []string
+	for _, e := range hook.AllEntries() {
+		if e.Message == "Execution endpoints disagree on deposit logs" {
+			flagged = append(flagged, e.Data["endpoint"].(string))
+		}
+	}
+	assert.DeepEqual(t, []string{"http://missing", "http://reorged"}, flagged)
+}
+
+func TestService_crossCheckHeaders(t *testing.T) {
+	hook := logTest.NewGlobal()
+	height := big.NewInt(20)
+	hash := common.Hash{'a'}
+	next := common.Hash{'c'}
+
+	s := crossCheckService(
+		&crossCheckSource{
+			endpoint: network.HttpEndpoint("http://agreeing"),
+			rpcClient: &mockExecution.RPCClient{BlockNumMap: map[uint64]*types.HeaderInfo{
+				20: {Number: height, Hash: hash},
+				21: {Number: big.NewInt(21), Hash: next},
+			}},
+		},
+		&crossCheckSource{
+			endpoint: network.HttpEndpoint("http://disagreeing"),
+			rpcClient: &mockExecution.RPCClient{BlockNumMap: map[uint64]*types.HeaderInfo{
+				20: {Number: height, Hash: hash},
+				21: {Number: big.NewInt(21), Hash: common.Hash{'b'}},
+			}},
+		},
+		&crossCheckSource{
+			endpoint: network.HttpEndpoint("http://behind"),
+			rpcClient: &mockExecution.RPCClient{BlockNumMap: map[uint64]*types.HeaderInfo{
+				20: {Number: height, Hash: hash},
+			}},
+		},
+	)
+	s.crossCheckHeaders(context.Background(), s.cfg.currHttpEndpoint, height.Uint64(), []common.Hash{hash, next})
+
+	var flagged []string
+	for _, e := range hook.AllEntries() {
+		if e.Message == "Execution endpoints disagree on block header" {
+			flagged = append(flagged, e.Data["endpoint"].(string))
+			assert.Equal(t, uint64(21), e.Data["blockNumber"])
+		}
+	}
+	assert.DeepEqual(t, []string{"http://disagreeing"}, flagged)
+}
+
+func TestService_queueCrossCheck(t *testing.T) {
+	s := crossCheckService(&crossCheckSource{endpoint: network.HttpEndpoint("http://fallback")})
+	s.crossChecks = make(chan crossCheck, crossCheckQueueSize)
+
+	ran := make(chan struct{}, crossCheckQueueSize+1)
+	for i := 0; i < crossCheckQueueSize+1; i++ {
+		s.queueCrossCheck(func(context.Context) { ran <- struct{}{} })
+	}
+	// Checks beyond the size of the queue are dropped instead of blocking the caller.
+	assert.Equal(t, crossCheckQueueSize, len(s.crossChecks))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go s.runCrossChecks(ctx)
+	for i := 0; i < crossCheckQueueSize; i++ {
+		<-ran
+	}
+
+	// Nothing is queued without other endpoints to check against.
+	s = crossCheckService()
+	s.crossChecks = make(chan crossCheck, crossCheckQueueSize)
+	s.queueCrossCheck(func(context.Context) {})
+	assert.Equal(t, 0, len(s.crossChecks))
+}
+
+func TestService_sourcesToCrossCheck(t *testing.T) {
+	s := crossCheckService()
+	fallback := network.HttpEndpoint("http://fallback")
+	s.cfg.httpEndpoints = append(s.cfg.httpEndpoints, fallback)
+
+	sources := s.sourcesToCrossCheck(context.Background(), s.cfg.currHttpEndpoint)
+	require.Equal(t, 1, len(sources))
+	assert.Equal(t, fallback.Url, sources[0].endpoint.Url)
+	// Clients are reused.
+	assert.Equal(t, sources[0], s.sourcesToCrossCheck(context.Background(), s.cfg.currHttpEndpoint)[0])
+
+	// The active endpoint is never checked against itself.
+	sources = s.sourcesToCrossCheck(context.Background(), fallback)
+	require.Equal(t, 1, len(sources))
+	assert.Equal(t, "http://active", sources[0].endpoint.Url)
+
+	s.closeCrossCheckSources()
+	assert.Equal(t, 0, len(s.crossCheckSources))
+}
+
+func TestService_crossCheckDuringFailover(t *testing.T) {
+	logs := []gethTypes.Log{{BlockNumber: 10, BlockHash: common.Hash{'a'}}}
+	s := crossCheckService(&crossCheckSource{
+		endpoint:   network.HttpEndpoint("http://fallback"),
+		rpcClient:  RPCClientEmpty{},
+		httpLogger: &staticLogger{},
+	})
+	// Checks run against the primary endpoint as well, once the fallback endpoint is active.
+	s.crossCheckSources["http://active"] = &crossCheckSource{
+		endpoint:   s.cfg.currHttpEndpoint,
+		rpcClient:  RPCClientEmpty{},
+		httpLogger: &staticLogger{},
+	}
+	s.crossChecks = make(chan crossCheck, crossCheckQueueSize)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go s.runCrossChecks(ctx)
+
+	// Endpoints are switched on the goroutine which queues the checks, while the worker runs them.
+	for i := 0; i < 10*crossCheckQueueSize; i++ {
+		s.fallbackToNextEndpoint()
+		s.queueLogsCrossCheck(ethereum.FilterQuery{FromBlock: big.NewInt(10), ToBlock: big.NewInt(10)}, logs)
+		s.queueHeadersCrossCheck(10, []common.Hash{{'a'}})
+	}
+	done := make(chan struct{})
+	s.crossChecks <- func(context.Context) { close(done) }
+	<-done
+}
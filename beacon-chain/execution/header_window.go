@@ -0,0 +1,121 @@
+package execution
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/execution/types"
+	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+	"github.com/sirupsen/logrus"
+)
+
+// headersSavingInterval is the number of execution blocks processed between
+// saves of the header window to disk.
+const headersSavingInterval = 64
+
+// initializeHeaders restores the header window saved to disk into the header cache,
+// so that headers used for eth1 data voting are not requested again on restart.
+func (s *Service) initializeHeaders(ctx context.Context) error {
+	saved, err := s.cfg.beaconDB.ExecutionBlockHeaders(ctx)
+	if err != nil {
+		return err
+	}
+	if len(saved.GetHeaders()) == 0 {
+		return nil
+	}
+	for _, h := range saved.Headers {
+		if err := s.headerCache.AddHeader(&types.HeaderInfo{
+			Number: new(big.Int).SetUint64(h.Number),
+			Hash:   common.BytesToHash(h.Hash),
+			Time:   h.Timestamp,
+		}); err != nil {
+			return err
+		}
+	}
+	last := saved.Headers[len(saved.Headers)-1]
+	s.lastSavedHeadersBlock = last.Number
+	log.WithFields(logrus.Fields{
+		"headers":   len(saved.Headers),
+		"lastBlock": last.Number,
+	}).Debug("Restored execution block headers from disk")
+	return nil
+}
+
+// saveHeaders saves the cached headers at or behind the last requested block to disk. Headers
+// ahead of it are not saved, as they are not yet past the follow distance and could be reorged.
+func (s *Service) saveHeaders(ctx context.Context) error {
+	s.latestEth1DataLock.RLock()
+	lastRequested := s.latestEth1Data.LastRequestedBlock
+	s.latestEth1DataLock.RUnlock()
+
+	cached, err := s.headerCache.Headers()
+	if err != nil {
+		return err
+	}
+	headers := &ethpb.ExecutionBlockHeaders{
+		Headers: make([]*ethpb.ExecutionBlockHeader, 0, len(cached)),
+	}
+	for _, h := range cached {
+		if h.Number.Uint64() > lastRequested {
+			break
+		}
+		headers.Headers = append(headers.Headers, &ethpb.ExecutionBlockHeader{
+			Number:    h.Number.Uint64(),
+			Hash:      h.Hash.Bytes(),
+			Timestamp: h.Time,
+		})
+	}
+	if err := s.cfg.beaconDB.SaveExecutionBlockHeaders(ctx, headers); err != nil {
+		return err
+	}
+	s.lastSavedHeadersBlock = lastRequested
+	return nil
+}
+
+// saveHeadersIfDue saves the header window to disk once enough blocks were processed since the last save.
+func (s *Service) saveHeadersIfDue(ctx context.Context) error {
+	s.latestEth1DataLock.RLock()
+	lastRequested := s.latestEth1Data.LastRequestedBlock
+	s.latestEth1DataLock.RUnlock()
+	if lastRequested < s.lastSavedHeadersBlock+headersSavingInterval {
+		return nil
+	}
+	return s.saveHeaders(ctx)
+}
+
+// firstUncachedHeight returns the height, within the provided range, from which headers are missing
+// from the cache. The highest cached header of the range is checked against the execution client, and
+// the cache is dropped when it is no longer canonical, in which case all headers are requested again.
+func (s *Service) firstUncachedHeight(ctx context.Context, start, end uint64) (uint64, error) {
+	height := start
+	var last *types.HeaderInfo
+	for ; height <= end; height++ {
+		exists, hInfo, err := s.headerCache.HeaderInfoByHeight(new(big.Int).SetUint64(height))
+		if err != nil {
+			return 0, err
+		}
+		if !exists {
+			break
+		}
+		last = hInfo
+	}
+	if last == nil {
+		return start, nil
+	}
+	hdr, err := s.HeaderByNumber(ctx, last.Number)
+	if err != nil {
+		return 0, errors.Wrapf(err, "could not query header with height %d", last.Number.Uint64())
+	}
+	if hdr.Hash != last.Hash {
+		log.WithFields(logrus.Fields{
+			"blockNumber":     last.Number,
+			"cachedBlockHash": last.Hash,
+			"blockHash":       hdr.Hash,
+		}).Warn("Cached execution block headers are no longer canonical, requesting them again")
+		s.headerCache.Reset()
+		return start, nil
+	}
+	return height, nil
+}
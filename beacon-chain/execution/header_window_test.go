@@ -0,0 +1,97 @@
+package execution
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	dbutil "github.com/prysmaticlabs/prysm/v4/beacon-chain/db/testing"
+	mockExecution "github.com/prysmaticlabs/prysm/v4/beacon-chain/execution/testing"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/execution/types"
+	"github.com/prysmaticlabs/prysm/v4/testing/assert"
+	"github.com/prysmaticlabs/prysm/v4/testing/require"
+)
+
+func testHeader(n uint64) *types.HeaderInfo {
+	return &types.HeaderInfo{
+		Number: new(big.Int).SetUint64(n),
+		Hash:   common.BigToHash(new(big.Int).SetUint64(n)),
+		Time:   n * 12,
+	}
+}
+
+func TestService_headersRestoredOnRestart(t *testing.T) {
+	ctx := context.Background()
+	beaconDB := dbutil.SetupDB(t)
+	s, err := NewService(ctx, WithDatabase(beaconDB))
+	require.NoError(t, err)
+	for i := uint64(1); i <= 10; i++ {
+		require.NoError(t, s.headerCache.AddHeader(testHeader(i)))
+	}
+	s.latestEth1Data.LastRequestedBlock = 8
+	require.NoError(t, s.saveHeaders(ctx))
+
+	s, err = NewService(ctx, WithDatabase(beaconDB))
+	require.NoError(t, err)
+	assert.Equal(t, uint64(8), s.lastSavedHeadersBlock)
+	headers, err := s.headerCache.Headers()
+	require.NoError(t, err)
+	require.Equal(t, 8, len(headers), "Headers ahead of the last requested block must not be saved")
+	for i, h := range headers {
+		assert.DeepEqual(t, testHeader(uint64(i+1)), h)
+	}
+}
+
+func TestService_saveHeadersIfDue(t *testing.T) {
+	ctx := context.Background()
+	beaconDB := dbutil.SetupDB(t)
+	s, err := NewService(ctx, WithDatabase(beaconDB))
+	require.NoError(t, err)
+	require.NoError(t, s.headerCache.AddHeader(testHeader(1)))
+
+	s.latestEth1Data.LastRequestedBlock = headersSavingInterval - 1
+	require.NoError(t, s.saveHeadersIfDue(ctx))
+	saved, err := beaconDB.ExecutionBlockHeaders(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, true, saved == nil)
+
+	s.latestEth1Data.LastRequestedBlock = headersSavingInterval
+	require.NoError(t, s.saveHeadersIfDue(ctx))
+	saved, err = beaconDB.ExecutionBlockHeaders(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, len(saved.Headers))
+	assert.Equal(t, uint64(headersSavingInterval), s.lastSavedHeadersBlock)
+}
+
+func TestService_firstUncachedHeight(t *testing.T) {
+	ctx := context.Background()
+	canonical := make(map[uint64]*types.HeaderInfo)
+	s := &Service{
+		headerCache: newHeaderCache(),
+		rpcClient:   &mockExecution.RPCClient{BlockNumMap: canonical},
+	}
+	for i := uint64(10); i < 15; i++ {
+		canonical[i] = testHeader(i)
+		require.NoError(t, s.headerCache.AddHeader(testHeader(i)))
+	}
+
+	height, err := s.firstUncachedHeight(ctx, 5, 20)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(5), height)
+	height, err = s.firstUncachedHeight(ctx, 10, 20)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(15), height)
+	height, err = s.firstUncachedHeight(ctx, 10, 12)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(13), height)
+
+	// Cached headers are dropped once they are reorged out.
+	canonical[14] = &types.HeaderInfo{Number: big.NewInt(14), Hash: common.Hash{'a'}}
+	height, err = s.firstUncachedHeight(ctx, 10, 20)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(10), height)
+	headers, err := s.headerCache.Headers()
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(headers))
+}
@@ -72,6 +72,7 @@ func (s *Service) ProcessETH1Block(ctx context.Context, blkNum *big.Int) error {
 	if err != nil {
 		return err
 	}
+	s.queueLogsCrossCheck(query, logs)
 	for i, filterLog := range logs {
 		// ignore logs that are not of the required block number
 		if filterLog.BlockNumber != blkNum.Uint64() {
@@ -407,6 +408,7 @@ func (s *Service) processBlockInBatch(ctx context.Context, currentBlockNum uint6
 		}
 		return 0, 0, err
 	}
+	s.queueLogsCrossCheck(query, logs)
 	// Only request headers before chainstart to correctly determine
 	// genesis.
 	if !s.chainStartData.Chainstarted {
@@ -471,12 +473,19 @@ func (s *Service) requestBatchedHeadersAndLogs(ctx context.Context) error {
 		log.Infof("Falling back to historical headers and logs sync. Current difference is %d", requestedBlock-s.latestEth1Data.LastRequestedBlock)
 		return s.processPastLogs(ctx)
 	}
-	for i := s.latestEth1Data.LastRequestedBlock + 1; i <= requestedBlock; i++ {
+	// Block hashes of the range are cross checked in a single batch, once the range is processed.
+	start := s.latestEth1Data.LastRequestedBlock + 1
+	var hashes []common.Hash
+	defer func() {
+		s.queueHeadersCrossCheck(start, hashes)
+	}()
+	for i := start; i <= requestedBlock; i++ {
 		// Cache eth1 block header here.
-		_, err := s.BlockHashByHeight(ctx, big.NewInt(0).SetUint64(i))
+		blkHash, err := s.BlockHashByHeight(ctx, big.NewInt(0).SetUint64(i))
 		if err != nil {
 			return err
 		}
+		hashes = append(hashes, blkHash)
 		err = s.ProcessETH1Block(ctx, big.NewInt(0).SetUint64(i))
 		if err != nil {
 			return err
@@ -75,4 +75,28 @@ var (
 		Name: "execution_payload_bodies_count",
 		Help: "The number of requested payload bodies is too large",
 	})
+	activeEndpointGauge = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "execution_endpoint_active",
+		Help: "Whether the execution endpoint is the one all requests are currently sent to (1) or not (0)",
+	}, []string{"endpoint"})
+	endpointFailoversCount = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "execution_endpoint_failovers_total",
+		Help: "The number of times the execution endpoint was switched to, after the active endpoint failed",
+	}, []string{"endpoint"})
+	endpointCrossChecksCount = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "execution_endpoint_cross_checks_total",
+		Help: "The number of deposit log and block header responses of the active endpoint compared against the endpoint",
+	}, []string{"endpoint", "kind"})
+	endpointDisagreementsCount = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "execution_endpoint_disagreements_total",
+		Help: "The number of deposit log and block header responses of the endpoint that differ from the active endpoint",
+	}, []string{"endpoint", "kind"})
+	endpointErrorsCount = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "execution_endpoint_cross_check_errors_total",
+		Help: "The number of failed deposit log and block header requests made to the endpoint for cross checking",
+	}, []string{"endpoint"})
+	crossChecksDroppedCount = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "execution_endpoint_cross_checks_dropped_total",
+		Help: "The number of deposit log and block header cross checks dropped because the cross check queue was full",
+	})
 )
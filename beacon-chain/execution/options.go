@@ -37,6 +37,41 @@ func WithHttpEndpointAndJWTSecret(endpointString string, secret []byte) Option {
 	}
 }
 
+// WithFallbackHttpEndpoints parse fallback http endpoints for the powchain service to fail over to,
+// and to cross check deposit logs and block headers against.
+func WithFallbackHttpEndpoints(endpointStrings []string) Option {
+	return func(s *Service) error {
+		s.cfg.fallbackHttpEndpoints = make([]network.Endpoint, 0, len(endpointStrings))
+		for _, e := range endpointStrings {
+			if e == "" {
+				continue
+			}
+			s.cfg.fallbackHttpEndpoints = append(s.cfg.fallbackHttpEndpoints, network.HttpEndpoint(e))
+		}
+		return nil
+	}
+}
+
+// WithFallbackHttpEndpointsAndJWTSecret for authenticating the fallback execution nodes JSON-RPC endpoints.
+func WithFallbackHttpEndpointsAndJWTSecret(endpointStrings []string, secret []byte) Option {
+	return func(s *Service) error {
+		if len(secret) == 0 {
+			return nil
+		}
+		s.cfg.fallbackHttpEndpoints = make([]network.Endpoint, 0, len(endpointStrings))
+		for _, e := range endpointStrings {
+			if e == "" {
+				continue
+			}
+			hEndpoint := network.HttpEndpoint(e)
+			hEndpoint.Auth.Method = authorization.Bearer
+			hEndpoint.Auth.Value = string(secret)
+			s.cfg.fallbackHttpEndpoints = append(s.cfg.fallbackHttpEndpoints, hEndpoint)
+		}
+		return nil
+	}
+}
+
 // WithHeaders adds headers to the execution node JSON-RPC requests.
 func WithHeaders(headers []string) Option {
 	return func(s *Service) error {
@@ -71,6 +71,7 @@ func (s *Service) pollConnectionStatus(ctx context.Context) {
 			currClient := s.rpcClient
 			if err := s.setupExecutionClientConnections(ctx, s.cfg.currHttpEndpoint); err != nil {
 				errorLogger(err, "Could not connect to execution client endpoint")
+				s.fallbackToNextEndpoint()
 				continue
 			}
 			// Close previous client, if connection was successful.
@@ -95,6 +96,7 @@ func (s *Service) retryExecutionClientConnection(ctx context.Context, err error)
 	currClient := s.rpcClient
 	if err := s.setupExecutionClientConnections(ctx, s.cfg.currHttpEndpoint); err != nil {
 		s.runError = errors.Wrap(err, "setupExecutionClientConnections")
+		s.fallbackToNextEndpoint()
 		return
 	}
 	// Close previous client, if connection was successful.
@@ -105,6 +107,94 @@ func (s *Service) retryExecutionClientConnection(ctx context.Context, err error)
 	s.runError = nil
 }
 
+// Initializes the list of all configured execution endpoints, the primary endpoint being the first one.
+func (s *Service) initHttpEndpoints() {
+	s.cfg.httpEndpoints = []network.Endpoint{s.cfg.currHttpEndpoint}
+	for _, e := range s.cfg.fallbackHttpEndpoints {
+		duplicate := false
+		for _, other := range s.cfg.httpEndpoints {
+			if e.Url == other.Url {
+				duplicate = true
+				break
+			}
+		}
+		if duplicate {
+			log.WithField("endpoint", logs.MaskCredentialsLogging(e.Url)).Warn("Ignoring duplicate fallback execution endpoint")
+			continue
+		}
+		s.cfg.httpEndpoints = append(s.cfg.httpEndpoints, e)
+	}
+	s.setActiveEndpoint(s.cfg.currHttpEndpoint)
+}
+
+// Sets the endpoint all execution client requests are sent to.
+func (s *Service) setActiveEndpoint(endpoint network.Endpoint) {
+	s.cfg.currHttpEndpoint = endpoint
+	for _, e := range s.cfg.httpEndpoints {
+		active := 0.0
+		if e.Equals(endpoint) {
+			active = 1
+		}
+		activeEndpointGauge.WithLabelValues(endpointLabel(e)).Set(active)
+	}
+}
+
+// Switches to the next configured execution endpoint, so that the following connection attempt
+// is made against it. Endpoints are tried in a round robin fashion.
+func (s *Service) fallbackToNextEndpoint() {
+	total := len(s.cfg.httpEndpoints)
+	if total < 2 {
+		return
+	}
+	currIndex := 0
+	for i, e := range s.cfg.httpEndpoints {
+		if e.Equals(s.cfg.currHttpEndpoint) {
+			currIndex = i
+			break
+		}
+	}
+	next := s.cfg.httpEndpoints[(currIndex+1)%total]
+	s.setActiveEndpoint(next)
+	endpointFailoversCount.WithLabelValues(endpointLabel(next)).Inc()
+	log.WithField("endpoint", logs.MaskCredentialsLogging(next.Url)).Warn("Falling back to alternative execution endpoint")
+}
+
+// Switches back to the primary execution endpoint after a fallback, once the primary endpoint
+// is reachable again.
+func (s *Service) checkPrimaryEndpoint(ctx context.Context) {
+	if len(s.cfg.httpEndpoints) == 0 {
+		return
+	}
+	primary := s.cfg.httpEndpoints[0]
+	if primary.Equals(s.cfg.currHttpEndpoint) {
+		return
+	}
+	// Probe the primary endpoint with a separate client, so that the active connection is not
+	// torn down in case the primary endpoint is still unavailable.
+	client, err := s.newRPCClientWithAuth(ctx, primary)
+	if err != nil {
+		log.WithError(err).Debug("Could not dial primary execution endpoint")
+		return
+	}
+	err = ensureCorrectExecutionChain(ctx, ethclient.NewClient(client))
+	client.Close()
+	if err != nil {
+		log.WithError(err).Debug("Primary execution endpoint is still unavailable")
+		return
+	}
+	currClient := s.rpcClient
+	if err := s.setupExecutionClientConnections(ctx, primary); err != nil {
+		log.WithError(err).Debug("Could not connect to primary execution endpoint")
+		return
+	}
+	// Close previous client, if connection was successful.
+	if currClient != nil {
+		currClient.Close()
+	}
+	s.setActiveEndpoint(primary)
+	log.WithField("endpoint", logs.MaskCredentialsLogging(primary.Url)).Info("Switched back to primary execution endpoint")
+}
+
 // Initializes an RPC connection with authentication headers.
 func (s *Service) newRPCClientWithAuth(ctx context.Context, endpoint network.Endpoint) (*gethRPC.Client, error) {
 	headers := http.Header{}
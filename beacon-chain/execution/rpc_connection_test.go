@@ -0,0 +1,76 @@
+package execution
+
+import (
+	"context"
+	"testing"
+
+	mockExecution "github.com/prysmaticlabs/prysm/v4/beacon-chain/execution/testing"
+	"github.com/prysmaticlabs/prysm/v4/network"
+	"github.com/prysmaticlabs/prysm/v4/testing/assert"
+	"github.com/prysmaticlabs/prysm/v4/testing/require"
+)
+
+func TestService_fallbackToNextEndpoint(t *testing.T) {
+	s := &Service{cfg: &config{
+		currHttpEndpoint: network.HttpEndpoint("http://a"),
+		fallbackHttpEndpoints: []network.Endpoint{
+			network.HttpEndpoint("http://b"),
+			network.HttpEndpoint("http://a"),
+			network.HttpEndpoint("http://c"),
+		},
+	}}
+	s.initHttpEndpoints()
+	require.Equal(t, 3, len(s.cfg.httpEndpoints), "Duplicate endpoint was not ignored")
+
+	for _, want := range []string{"http://b", "http://c", "http://a"} {
+		s.fallbackToNextEndpoint()
+		assert.Equal(t, want, s.ExecutionClientEndpoint())
+	}
+
+	s = &Service{cfg: &config{currHttpEndpoint: network.HttpEndpoint("http://a")}}
+	s.initHttpEndpoints()
+	s.fallbackToNextEndpoint()
+	assert.Equal(t, "http://a", s.ExecutionClientEndpoint())
+}
+
+func TestService_checkPrimaryEndpoint(t *testing.T) {
+	server, endpoint, err := mockExecution.SetupRPCServer()
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		server.Stop()
+	})
+	ctx := context.Background()
+
+	t.Run("primary endpoint unavailable", func(t *testing.T) {
+		s := &Service{
+			cfg: &config{
+				currHttpEndpoint:      network.HttpEndpoint("http://127.0.0.1:1"),
+				fallbackHttpEndpoints: []network.Endpoint{network.HttpEndpoint(endpoint)},
+			},
+			rpcClient: RPCClientEmpty{},
+		}
+		s.initHttpEndpoints()
+		s.fallbackToNextEndpoint()
+		require.Equal(t, endpoint, s.ExecutionClientEndpoint())
+
+		s.checkPrimaryEndpoint(ctx)
+		assert.Equal(t, endpoint, s.ExecutionClientEndpoint())
+	})
+	t.Run("primary endpoint available", func(t *testing.T) {
+		s := &Service{
+			cfg: &config{
+				currHttpEndpoint:       network.HttpEndpoint(endpoint),
+				fallbackHttpEndpoints:  []network.Endpoint{network.HttpEndpoint("http://127.0.0.1:1")},
+				beaconNodeStatsUpdater: &NopBeaconNodeStatsUpdater{},
+			},
+			rpcClient: RPCClientEmpty{},
+		}
+		s.initHttpEndpoints()
+		s.fallbackToNextEndpoint()
+		require.Equal(t, "http://127.0.0.1:1", s.ExecutionClientEndpoint())
+
+		s.checkPrimaryEndpoint(ctx)
+		assert.Equal(t, endpoint, s.ExecutionClientEndpoint())
+		assert.Equal(t, true, s.ExecutionClientConnected())
+	})
+}
@@ -65,6 +65,8 @@ var (
 	logThreshold = 8
 	// period to log chainstart related information
 	logPeriod = 1 * time.Minute
+	// period to check whether the primary execution endpoint is available again after a fallback.
+	primaryEndpointCheckPeriod = 2 * time.Minute
 )
 
 // ChainStartFetcher retrieves information pertaining to the chain start event
@@ -127,6 +129,8 @@ type config struct {
 	eth1HeaderReqLimit      uint64
 	beaconNodeStatsUpdater  BeaconNodeStatsUpdater
 	currHttpEndpoint        network.Endpoint
+	fallbackHttpEndpoints   []network.Endpoint
+	httpEndpoints           []network.Endpoint
 	headers                 []string
 	finalizedStateAtStartup state.BeaconState
 }
@@ -156,6 +160,10 @@ type Service struct {
 	lastReceivedMerkleIndex int64 // Keeps track of the last received index to prevent log spam.
 	runError                error
 	preGenesisState         state.BeaconState
+	crossCheckLock          sync.Mutex
+	crossCheckSources       map[string]*crossCheckSource // clients of endpoints other than the active one.
+	crossChecks             chan crossCheck              // cross checks waiting for the cross check worker.
+	lastSavedHeadersBlock   uint64
 }
 
 // NewService sets up a new instance with an ethclient when given a web3 endpoint as a string in the config.
@@ -200,6 +208,8 @@ func NewService(ctx context.Context, opts ...Option) (*Service, error) {
 		lastReceivedMerkleIndex: -1,
 		preGenesisState:         genState,
 		eth1HeadTicker:          time.NewTicker(time.Duration(params.BeaconConfig().SecondsPerETH1Block) * time.Second),
+		crossCheckSources:       make(map[string]*crossCheckSource),
+		crossChecks:             make(chan crossCheck, crossCheckQueueSize),
 	}
 
 	for _, opt := range opts {
@@ -207,6 +217,7 @@ func NewService(ctx context.Context, opts ...Option) (*Service, error) {
 			return nil, err
 		}
 	}
+	s.initHttpEndpoints()
 
 	if err := s.ensureValidPowchainData(ctx); err != nil {
 		return nil, errors.Wrap(err, "unable to validate powchain data")
@@ -219,6 +230,9 @@ func NewService(ctx context.Context, opts ...Option) (*Service, error) {
 	if err := s.initializeEth1Data(ctx, eth1Data); err != nil {
 		return nil, err
 	}
+	if err := s.initializeHeaders(ctx); err != nil {
+		return nil, errors.Wrap(err, "unable to restore execution block headers")
+	}
 	return s, nil
 }
 
@@ -249,6 +263,9 @@ func (s *Service) Start() {
 	// Check transition configuration for the engine API client in the background.
 	go s.checkTransitionConfiguration(s.ctx, make(chan *feed.Event, 1))
 
+	// Compare responses of the active endpoint with the other endpoints in the background.
+	go s.runCrossChecks(s.ctx)
+
 	go s.run(s.ctx.Done())
 }
 
@@ -260,6 +277,12 @@ func (s *Service) Stop() error {
 	if s.rpcClient != nil {
 		s.rpcClient.Close()
 	}
+	s.closeCrossCheckSources()
+	if s.isRunning {
+		if err := s.saveHeaders(s.ctx); err != nil {
+			log.WithError(err).Error("Could not save execution block headers")
+		}
+	}
 	return nil
 }
 
@@ -387,10 +410,9 @@ func (s *Service) initDepositCaches(ctx context.Context, ctrs []*ethpb.DepositCo
 		}
 	}
 	validDepositsCount.Add(float64(currIndex))
-	// Only add pending deposits if the container slice length
-	// is more than the current index in state.
-	if uint64(len(ctrs)) > currIndex {
-		for _, c := range ctrs[currIndex:] {
+	// Only add pending deposits which are not yet included in the state.
+	for _, c := range ctrs {
+		if uint64(c.Index) >= currIndex {
 			s.cfg.depositCache.InsertPendingDeposit(ctx, c.Deposit, c.Eth1BlockHeight, c.Index, bytesutil.ToBytes32(c.DepositRoot))
 		}
 	}
@@ -497,6 +519,9 @@ func (s *Service) handleETH1FollowDistance() {
 		log.Error(err)
 		return
 	}
+	if err := s.saveHeadersIfDue(ctx); err != nil {
+		log.WithError(err).Error("Could not save execution block headers")
+	}
 	// Reset the Status.
 	if s.runError != nil {
 		s.runError = nil
@@ -555,6 +580,9 @@ func (s *Service) initPOWService() {
 				}
 				continue
 			}
+			if err := s.saveHeaders(ctx); err != nil {
+				log.WithError(err).Error("Could not save execution block headers")
+			}
 			// Handle edge case with embedded genesis state by fetching genesis header to determine
 			// its height.
 			if s.chainStartData.Chainstarted && s.chainStartData.GenesisBlock == 0 {
@@ -593,6 +621,8 @@ func (s *Service) run(done <-chan struct{}) {
 
 	chainstartTicker := time.NewTicker(logPeriod)
 	defer chainstartTicker.Stop()
+	primaryEndpointTicker := time.NewTicker(primaryEndpointCheckPeriod)
+	defer primaryEndpointTicker.Stop()
 
 	for {
 		select {
@@ -612,6 +642,8 @@ func (s *Service) run(done <-chan struct{}) {
 			}
 			s.processBlockHeader(head)
 			s.handleETH1FollowDistance()
+		case <-primaryEndpointTicker.C:
+			s.checkPrimaryEndpoint(s.ctx)
 		case <-chainstartTicker.C:
 			if s.chainStartData.Chainstarted {
 				chainstartTicker.Stop()
@@ -664,7 +696,12 @@ func (s *Service) cacheHeadersForEth1DataVote(ctx context.Context) error {
 	if err != nil {
 		return errors.Wrapf(err, "determineEarliestVotingBlock=%d", end)
 	}
-	return s.cacheBlockHeaders(start, end)
+	// Skip headers restored from disk.
+	uncached, err := s.firstUncachedHeight(ctx, start, end)
+	if err != nil {
+		return errors.Wrapf(err, "firstUncachedHeight=%d", start)
+	}
+	return s.cacheBlockHeaders(uncached, end)
 }
 
 // Caches block headers from the desired range.
@@ -791,15 +828,37 @@ func (s *Service) initializeEth1Data(ctx context.Context, eth1DataInDB *ethpb.ET
 	}
 	numOfItems := s.depositTrie.NumOfItems()
 	s.lastReceivedMerkleIndex = int64(numOfItems - 1)
+	if err := s.initFinalizedDepositsFromSnapshot(ctx, eth1DataInDB); err != nil {
+		return errors.Wrap(err, "could not initialize finalized deposits from snapshot")
+	}
 	if err := s.initDepositCaches(ctx, eth1DataInDB.DepositContainers); err != nil {
 		return errors.Wrap(err, "could not initialize caches")
 	}
 	return nil
 }
 
+// initFinalizedDepositsFromSnapshot initializes the finalized deposits of the cache from the deposit snapshot, when
+// the node does not hold the deposits preceding it as it was initialized from a snapshot.
+func (s *Service) initFinalizedDepositsFromSnapshot(ctx context.Context, eth1DataInDB *ethpb.ETH1ChainData) error {
+	snapshot := eth1DataInDB.DepositSnapshot
+	if !features.Get().EnableEIP4881 || snapshot.GetDepositCount() == 0 {
+		return nil
+	}
+	ctrs := eth1DataInDB.DepositContainers
+	if len(ctrs) > 0 && ctrs[0].Index == 0 {
+		return nil
+	}
+	dc, ok := s.cfg.depositCache.(*depositsnapshot.Cache)
+	if !ok {
+		return errors.New("deposit cache was not EIP4881 Cache")
+	}
+	return dc.InsertFinalizedDepositSnapshot(ctx, snapshot)
+}
+
 // Validates that all deposit containers are valid and have their relevant indices
-// in order.
-func validateDepositContainers(ctrs []*ethpb.DepositContainer) bool {
+// in order. Containers of a node initialized from a deposit snapshot may start
+// at any deposit covered by the given snapshot.
+func validateDepositContainers(ctrs []*ethpb.DepositContainer, snapshot *ethpb.DepositSnapshot) bool {
 	ctrLen := len(ctrs)
 	// Exit for empty containers.
 	if ctrLen == 0 {
@@ -810,6 +869,9 @@ func validateDepositContainers(ctrs []*ethpb.DepositContainer) bool {
 		return ctrs[i].Index < ctrs[j].Index
 	})
 	startIndex := int64(0)
+	if ctrs[0].Index <= int64(snapshot.GetDepositCount()) { // lint:ignore uintcast -- deposit count will not exceed int64 in your lifetime.
+		startIndex = ctrs[0].Index
+	}
 	for _, c := range ctrs {
 		if c.Index != startIndex {
 			log.Info("Recovering missing deposit containers, node is re-requesting missing deposit data")
@@ -835,7 +897,18 @@ func (s *Service) ensureValidPowchainData(ctx context.Context) error {
 	if err != nil {
 		return errors.Wrap(err, "unable to retrieve eth1 data")
 	}
-	if eth1Data == nil || !eth1Data.ChainstartData.Chainstarted || !validateDepositContainers(eth1Data.DepositContainers) {
+	var snapshot *ethpb.DepositSnapshot
+	if features.Get().EnableEIP4881 {
+		snapshot = eth1Data.GetDepositSnapshot()
+	}
+	if eth1Data == nil || !eth1Data.ChainstartData.GetChainstarted() || !validateDepositContainers(eth1Data.DepositContainers, snapshot) {
+		// A deposit snapshot saved without chain start data was downloaded along with the checkpoint
+		// sync state, deposit logs are then only processed from the snapshot's execution block.
+		if eth1Data != nil && !eth1Data.ChainstartData.GetChainstarted() && eth1Data.DepositSnapshot != nil {
+			if err := s.initializeFromDepositSnapshot(eth1Data.DepositSnapshot); err != nil {
+				return errors.Wrap(err, "could not initialize from deposit snapshot")
+			}
+		}
 		pbState, err := native.ProtobufBeaconStatePhase0(s.preGenesisState.ToProtoUnsafe())
 		if err != nil {
 			return err
@@ -874,6 +947,32 @@ func (s *Service) ensureValidPowchainData(ctx context.Context) error {
 	return nil
 }
 
+// initializes the deposit trie and the latest eth1 data of the service from a deposit snapshot, so that
+// deposit logs are requested from the snapshot's execution block.
+func (s *Service) initializeFromDepositSnapshot(snapshot *ethpb.DepositSnapshot) error {
+	if !features.Get().EnableEIP4881 {
+		log.Warn("Ignoring deposit snapshot as the EIP-4881 deposit tree is not enabled")
+		return nil
+	}
+	depositTrie, err := depositsnapshot.DepositTreeFromSnapshotProto(snapshot)
+	if err != nil {
+		return err
+	}
+	s.depositTrie = depositTrie
+	s.lastReceivedMerkleIndex = int64(snapshot.DepositCount) - 1 // lint:ignore uintcast -- deposit count will not exceed int64 in your lifetime.
+	s.latestEth1DataLock.Lock()
+	s.latestEth1Data.BlockHeight = snapshot.ExecutionDepth
+	s.latestEth1Data.BlockHash = bytesutil.SafeCopyBytes(snapshot.ExecutionHash)
+	s.latestEth1Data.LastRequestedBlock = snapshot.ExecutionDepth
+	s.latestEth1DataLock.Unlock()
+	log.WithFields(logrus.Fields{
+		"depositCount":       snapshot.DepositCount,
+		"executionBlockHash": fmt.Sprintf("%#x", snapshot.ExecutionHash),
+		"executionBlock":     snapshot.ExecutionDepth,
+	}).Info("Initialized deposit tree from deposit snapshot")
+	return nil
+}
+
 func dedupEndpoints(endpoints []string) []string {
 	selectionMap := make(map[string]bool)
 	newEndpoints := make([]string, 0, len(endpoints))
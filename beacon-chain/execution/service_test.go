@@ -16,11 +16,13 @@ import (
 	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/v4/async/event"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/cache/depositcache"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/cache/depositsnapshot"
 	dbutil "github.com/prysmaticlabs/prysm/v4/beacon-chain/db/testing"
 	mockExecution "github.com/prysmaticlabs/prysm/v4/beacon-chain/execution/testing"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/execution/types"
 	doublylinkedtree "github.com/prysmaticlabs/prysm/v4/beacon-chain/forkchoice/doubly-linked-tree"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/state/stategen"
+	"github.com/prysmaticlabs/prysm/v4/config/features"
 	"github.com/prysmaticlabs/prysm/v4/config/params"
 	"github.com/prysmaticlabs/prysm/v4/container/trie"
 	contracts "github.com/prysmaticlabs/prysm/v4/contracts/deposit"
@@ -645,10 +647,63 @@ func TestService_EnsureValidPowchainData(t *testing.T) {
 	assert.Equal(t, 0, len(eth1Data.DepositContainers))
 }
 
+func TestService_InitializeFromDepositSnapshot(t *testing.T) {
+	resetFn := features.InitWithReset(&features.Flags{EnableEIP4881: true})
+	defer resetFn()
+	ctx := context.Background()
+	beaconDB := dbutil.SetupDB(t)
+	depositCache, err := depositsnapshot.New()
+	require.NoError(t, err)
+	srv, endpoint, err := mockExecution.SetupRPCServer()
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		srv.Stop()
+	})
+
+	tree := depositsnapshot.NewDepositTree()
+	for i := 0; i < 3; i++ {
+		require.NoError(t, tree.Insert(bytesutil.PadTo([]byte{byte(i + 1)}, 32), i))
+	}
+	require.NoError(t, tree.Finalize(2, common.Hash{'a'}, 100))
+	snapshot, err := tree.ToProto()
+	require.NoError(t, err)
+	root, err := tree.HashTreeRoot()
+	require.NoError(t, err)
+
+	genState, err := util.NewBeaconState()
+	require.NoError(t, err)
+	require.NoError(t, beaconDB.SaveGenesisData(ctx, genState))
+	// The deposit snapshot is saved without chain start data by checkpoint sync.
+	require.NoError(t, beaconDB.SaveExecutionChainData(ctx, &ethpb.ETH1ChainData{DepositSnapshot: snapshot}))
+
+	s, err := NewService(ctx,
+		WithHttpEndpoint(endpoint),
+		WithDatabase(beaconDB),
+		WithDepositCache(depositCache),
+	)
+	require.NoError(t, err)
+
+	assert.Equal(t, uint64(100), s.latestEth1Data.LastRequestedBlock)
+	assert.Equal(t, int64(2), s.lastReceivedMerkleIndex)
+	trieRoot, err := s.depositTrie.HashTreeRoot()
+	require.NoError(t, err)
+	assert.Equal(t, root, trieRoot)
+	fd, err := depositCache.FinalizedDeposits(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), fd.MerkleTrieIndex())
+
+	eth1Data, err := beaconDB.ExecutionChainData(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, true, eth1Data.ChainstartData.Chainstarted)
+	assert.Equal(t, uint64(100), eth1Data.CurrentEth1Data.LastRequestedBlock)
+	assert.DeepEqual(t, snapshot, eth1Data.DepositSnapshot)
+}
+
 func TestService_ValidateDepositContainers(t *testing.T) {
 	var tt = []struct {
 		name        string
 		ctrsFunc    func() []*ethpb.DepositContainer
+		snapshot    *ethpb.DepositSnapshot
 		expectedRes bool
 	}{
 		{
@@ -694,10 +749,34 @@ func TestService_ValidateDepositContainers(t *testing.T) {
 			},
 			expectedRes: false,
 		},
+		{
+			name: "containers following snapshot",
+			ctrsFunc: func() []*ethpb.DepositContainer {
+				ctrs := make([]*ethpb.DepositContainer, 0)
+				for i := 5; i < 10; i++ {
+					ctrs = append(ctrs, &ethpb.DepositContainer{Index: int64(i), Eth1BlockHeight: uint64(i + 10)})
+				}
+				return ctrs
+			},
+			snapshot:    &ethpb.DepositSnapshot{DepositCount: 7},
+			expectedRes: true,
+		},
+		{
+			name: "containers missing after snapshot",
+			ctrsFunc: func() []*ethpb.DepositContainer {
+				ctrs := make([]*ethpb.DepositContainer, 0)
+				for i := 8; i < 10; i++ {
+					ctrs = append(ctrs, &ethpb.DepositContainer{Index: int64(i), Eth1BlockHeight: uint64(i + 10)})
+				}
+				return ctrs
+			},
+			snapshot:    &ethpb.DepositSnapshot{DepositCount: 7},
+			expectedRes: false,
+		},
 	}
 
 	for _, test := range tt {
-		assert.Equal(t, test.expectedRes, validateDepositContainers(test.ctrsFunc()))
+		assert.Equal(t, test.expectedRes, validateDepositContainers(test.ctrsFunc(), test.snapshot))
 	}
 }
 
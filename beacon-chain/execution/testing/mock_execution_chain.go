@@ -232,6 +232,20 @@ func (r *RPCClient) CallContext(ctx context.Context, obj interface{}, methodName
 
 // BatchCall --
 func (r *RPCClient) BatchCall(b []rpc.BatchElem) error {
+	if r.BlockNumMap != nil {
+		for _, e := range b {
+			num, err := hexutil.DecodeBig(e.Args[0].(string))
+			if err != nil {
+				return err
+			}
+			assertedObj, ok := e.Result.(**types.HeaderInfo)
+			if !ok {
+				return errors.Errorf("wrong argument type provided: %T", e.Result)
+			}
+			*assertedObj = r.BlockNumMap[num.Uint64()]
+		}
+		return nil
+	}
 	if r.Backend == nil {
 		return nil
 	}
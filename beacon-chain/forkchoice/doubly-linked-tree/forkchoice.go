@@ -3,7 +3,6 @@ package doublylinkedtree
 import (
 	"context"
 	"fmt"
-	"time"
 
 	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/core/blocks"
@@ -71,11 +70,15 @@ func (f *ForkChoice) Head(
 
 	jc := f.JustifiedCheckpoint()
 	fc := f.FinalizedCheckpoint()
-	currentEpoch := slots.EpochsSinceGenesis(time.Unix(int64(f.store.genesisTime), 0))
+	currentEpoch := slots.ToEpoch(f.store.currentSlot())
 	if err := f.store.treeRootNode.updateBestDescendant(ctx, jc.Epoch, fc.Epoch, currentEpoch); err != nil {
 		return [32]byte{}, errors.Wrap(err, "could not update best descendant")
 	}
-	return f.store.head(ctx)
+	root, err := f.store.head(ctx)
+	if f.tracer != nil {
+		f.trace(&TraceEvent{Kind: traceHead, Root: root[:]})
+	}
+	return root, err
 }
 
 // ProcessAttestation processes attestation for vote accounting, it iterates around validator indices
@@ -102,6 +105,9 @@ func (f *ForkChoice) ProcessAttestation(ctx context.Context, validatorIndices []
 	}
 
 	processedAttestationCount.Inc()
+	if f.tracer != nil {
+		f.trace(&TraceEvent{Kind: traceAttestation, Indices: validatorIndices, Root: blockRoot[:], Epoch: targetEpoch})
+	}
 }
 
 // InsertNode processes a new block by inserting it to the fork choice store.
@@ -135,13 +141,21 @@ func (f *ForkChoice) InsertNode(ctx context.Context, state state.BeaconState, ro
 		return errInvalidNilCheckpoint
 	}
 	finalizedEpoch := fc.Epoch
+	boostRoot := f.store.proposerBoostRoot
 	node, err := f.store.insert(ctx, slot, root, parentRoot, payloadHash, justifiedEpoch, finalizedEpoch)
 	if err != nil {
 		return err
 	}
 
-	jc, fc = f.store.pullTips(state, node, jc, fc)
-	return f.updateCheckpoints(ctx, jc, fc)
+	pulledJc, pulledFc := f.store.pullTips(state, node, jc, fc)
+	err = f.updateCheckpoints(ctx, pulledJc, pulledFc)
+	if f.tracer != nil {
+		f.traceInsertedNode(node, parentRoot, jc, fc, f.store.pulledTips(node, pulledJc, pulledFc))
+		if f.store.proposerBoostRoot != boostRoot {
+			f.trace(&TraceEvent{Kind: traceProposerBoost, Slot: slot, Root: root[:]})
+		}
+	}
+	return err
 }
 
 // updateCheckpoints update the checkpoints when inserting a new node.
@@ -349,7 +363,11 @@ func (f *ForkChoice) SetOptimisticToValid(ctx context.Context, root [fieldparams
 	if !ok || node == nil {
 		return errors.Wrap(ErrNilNode, "could not set node to valid")
 	}
-	return node.setNodeAndParentValidated(ctx)
+	if err := node.setNodeAndParentValidated(ctx); err != nil {
+		return err
+	}
+	f.trace(&TraceEvent{Kind: traceValid, Root: root[:]})
+	return nil
 }
 
 // PreviousJustifiedCheckpoint of fork choice store.
@@ -369,7 +387,9 @@ func (f *ForkChoice) FinalizedCheckpoint() *forkchoicetypes.Checkpoint {
 
 // SetOptimisticToInvalid removes a block with an invalid execution payload from fork choice store
 func (f *ForkChoice) SetOptimisticToInvalid(ctx context.Context, root, parentRoot, payloadHash [fieldparams.RootLength]byte) ([][32]byte, error) {
-	return f.store.setOptimisticToInvalid(ctx, root, parentRoot, payloadHash)
+	invalidRoots, err := f.store.setOptimisticToInvalid(ctx, root, parentRoot, payloadHash)
+	f.trace(&TraceEvent{Kind: traceInvalid, Root: root[:], ParentRoot: parentRoot[:], PayloadHash: payloadHash[:]})
+	return invalidRoots, err
 }
 
 // InsertSlashedIndex adds the given slashed validator index to the
@@ -381,6 +401,7 @@ func (f *ForkChoice) InsertSlashedIndex(_ context.Context, index primitives.Vali
 		return
 	}
 	f.store.slashedIndices[index] = true
+	f.trace(&TraceEvent{Kind: traceSlashed, Index: index})
 
 	// Subtract last vote from this equivocating validator
 
@@ -411,7 +432,9 @@ func (f *ForkChoice) UpdateJustifiedCheckpoint(ctx context.Context, jc *forkchoi
 	}
 	f.store.prevJustifiedCheckpoint = f.store.justifiedCheckpoint
 	f.store.justifiedCheckpoint = jc
-	if err := f.updateJustifiedBalances(ctx, jc.Root); err != nil {
+	err := f.updateJustifiedBalances(ctx, jc.Root)
+	f.trace(&TraceEvent{Kind: traceJustified, Justified: traceCheckpoint(jc)})
+	if err != nil {
 		return errors.Wrap(err, "could not update justified balances")
 	}
 	return nil
@@ -423,6 +446,7 @@ func (f *ForkChoice) UpdateFinalizedCheckpoint(fc *forkchoicetypes.Checkpoint) e
 		return errInvalidNilCheckpoint
 	}
 	f.store.finalizedCheckpoint = fc
+	f.trace(&TraceEvent{Kind: traceFinalized, Finalized: traceCheckpoint(fc)})
 	return nil
 }
 
@@ -494,7 +518,13 @@ func (f *ForkChoice) InsertChain(ctx context.Context, chain []*forkchoicetypes.B
 			chain[i].JustifiedCheckpoint.Epoch, chain[i].FinalizedCheckpoint.Epoch); err != nil {
 			return err
 		}
-		if err := f.updateCheckpoints(ctx, chain[i].JustifiedCheckpoint, chain[i].FinalizedCheckpoint); err != nil {
+		err = f.updateCheckpoints(ctx, chain[i].JustifiedCheckpoint, chain[i].FinalizedCheckpoint)
+		if f.tracer != nil {
+			if node, ok := f.store.nodeByRoot[r]; ok {
+				f.traceInsertedNode(node, parentRoot, chain[i].JustifiedCheckpoint, chain[i].FinalizedCheckpoint, nil)
+			}
+		}
+		if err != nil {
 			return err
 		}
 	}
@@ -504,11 +534,13 @@ func (f *ForkChoice) InsertChain(ctx context.Context, chain []*forkchoicetypes.B
 // SetGenesisTime sets the genesisTime tracked by forkchoice
 func (f *ForkChoice) SetGenesisTime(genesisTime uint64) {
 	f.store.genesisTime = genesisTime
+	f.trace(&TraceEvent{Kind: traceGenesisTime, GenesisTime: genesisTime})
 }
 
 // SetOriginRoot sets the genesis block root
 func (f *ForkChoice) SetOriginRoot(root [32]byte) {
 	f.store.originRoot = root
+	f.trace(&TraceEvent{Kind: traceOriginRoot, Root: root[:]})
 }
 
 // CachedHeadRoot returns the last cached head root
@@ -616,6 +648,9 @@ func (f *ForkChoice) updateJustifiedBalances(ctx context.Context, root [32]byte)
 	if err != nil {
 		return errors.Wrap(err, "could not get justified balances")
 	}
+	if f.tracer != nil {
+		f.trace(&TraceEvent{Kind: traceBalances, Root: root[:], Balances: balances})
+	}
 	f.justifiedBalances = balances
 	f.store.committeeWeight = 0
 	f.numActiveValidators = 0
@@ -30,6 +30,9 @@ import (
 //	    if ancestor_at_finalized_slot == store.finalized_checkpoint.root:
 //	        store.justified_checkpoint = store.best_justified_checkpoint
 func (f *ForkChoice) NewSlot(ctx context.Context, slot primitives.Slot) error {
+	if f.tracer != nil {
+		defer f.trace(&TraceEvent{Kind: traceTick, Slot: slot})
+	}
 	// Reset proposer boost root
 	f.store.proposerBoostRoot = [32]byte{}
 
@@ -1,8 +1,6 @@
 package doublylinkedtree
 
 import (
-	"time"
-
 	"github.com/prysmaticlabs/prysm/v4/config/features"
 	"github.com/prysmaticlabs/prysm/v4/config/params"
 	"github.com/prysmaticlabs/prysm/v4/time/slots"
@@ -34,6 +32,11 @@ const orphanLateBlockProposingEarly = 2
 // proposal time by calling GetProposerHead.
 func (f *ForkChoice) ShouldOverrideFCU() (override bool) {
 	override = false
+	if f.tracer != nil {
+		defer func() {
+			f.trace(&TraceEvent{Kind: traceOverrideFCU, Override: override})
+		}()
+	}
 
 	// We only need to override FCU if our current head is from the current
 	// slot. This differs from the spec implementation in that we assume
@@ -43,7 +46,7 @@ func (f *ForkChoice) ShouldOverrideFCU() (override bool) {
 		return
 	}
 
-	if head.slot != slots.CurrentSlot(f.store.genesisTime) {
+	if head.slot != f.store.currentSlot() {
 		return
 	}
 
@@ -98,6 +101,15 @@ func (f *ForkChoice) ShouldOverrideFCU() (override bool) {
 // This function needs to be called only when proposing a block and all
 // attestation processing has already happened.
 func (f *ForkChoice) GetProposerHead() [32]byte {
+	root := f.proposerHead()
+	if f.tracer != nil {
+		f.trace(&TraceEvent{Kind: traceProposerHead, Root: root[:]})
+	}
+	return root
+}
+
+// proposerHead returns the block root to be used as ParentRoot by a proposer.
+func (f *ForkChoice) proposerHead() [32]byte {
 	if features.Get().DisableReorgLateBlocks {
 		return f.CachedHeadRoot()
 	}
@@ -107,7 +119,7 @@ func (f *ForkChoice) GetProposerHead() [32]byte {
 	}
 
 	// Only reorg blocks from the previous slot.
-	if head.slot+1 != slots.CurrentSlot(f.store.genesisTime) {
+	if head.slot+1 != f.store.currentSlot() {
 		return head.root
 	}
 	// Do not reorg on epoch boundaries
@@ -148,7 +160,7 @@ func (f *ForkChoice) GetProposerHead() [32]byte {
 	}
 
 	// Only reorg if we are proposing early
-	secs, err := slots.SecondsSinceSlotStart(head.slot+1, f.store.genesisTime, uint64(time.Now().Unix()))
+	secs, err := slots.SecondsSinceSlotStart(head.slot+1, f.store.genesisTime, uint64(f.store.currentTime().Unix()))
 	if err != nil {
 		log.WithError(err).Error("could not check if proposing early")
 		return head.root
@@ -0,0 +1,239 @@
+package doublylinkedtree
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+	forkchoicetypes "github.com/prysmaticlabs/prysm/v4/beacon-chain/forkchoice/types"
+	"github.com/prysmaticlabs/prysm/v4/config/params"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v4/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+)
+
+// ReplayTick is the head of a replayed trace at the end of a slot.
+type ReplayTick struct {
+	Slot         primitives.Slot
+	Head         [32]byte // head computed by the replay at the end of the slot.
+	RecordedHead [32]byte // last head computed by the node during the slot.
+}
+
+// ReplayDiff is a fork choice result which differs between the node and the replay.
+type ReplayDiff struct {
+	Kind     string
+	Time     time.Time
+	Slot     primitives.Slot
+	Recorded string
+	Replayed string
+}
+
+// ReplayResult summarizes the replay of a fork choice trace.
+type ReplayResult struct {
+	Events int
+	Ticks  []*ReplayTick
+	Diffs  []*ReplayDiff
+}
+
+// replayer feeds the events of a trace into a fork choice store whose clock follows the trace.
+type replayer struct {
+	f            *ForkChoice
+	now          time.Time
+	slot         primitives.Slot
+	ticked       bool
+	recordedHead [32]byte
+	balancesRoot [32]byte
+	balances     []uint64
+	result       *ReplayResult
+}
+
+// Replay feeds the events of a trace into a new fork choice store, with the clock of the store
+// following the recorded time of the events. Fork choice parameters are taken from the active
+// config, so that the proposer boost or the reorg weight thresholds can be changed to see how
+// they would have affected the head. The trace must have been recorded with the same config.
+func Replay(ctx context.Context, tr *TraceReader) (*ReplayResult, error) {
+	if name := params.BeaconConfig().ConfigName; tr.ConfigName() != name {
+		return nil, errors.Errorf("trace was recorded with config %s, active config is %s", tr.ConfigName(), name)
+	}
+	r := &replayer{
+		f:      New(),
+		result: &ReplayResult{},
+	}
+	r.f.store.now = func() time.Time { return r.now }
+	r.f.SetBalancesByRooter(r.balancesByRoot)
+	for {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		e, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not read trace event %d", r.result.Events+1)
+		}
+		if err := r.replay(ctx, e); err != nil {
+			return nil, errors.Wrapf(err, "could not replay trace event %d", r.result.Events)
+		}
+	}
+	r.endSlot(ctx)
+	return r.result, nil
+}
+
+// balancesByRoot returns the justified balances recorded right before they were requested.
+func (r *replayer) balancesByRoot(_ context.Context, root [32]byte) ([]uint64, error) {
+	if root != r.balancesRoot {
+		return nil, errors.Errorf("no balances recorded for root %#x", root)
+	}
+	return r.balances, nil
+}
+
+// applyBalancesDelta returns the balances recorded by a balances event, which only holds the balances
+// that changed since the previous balances event. A new list is returned, as fork choice keeps the
+// previous one.
+func (r *replayer) applyBalancesDelta(e *TraceEvent) ([]uint64, error) {
+	if len(e.Indices) != len(e.Balances) {
+		return nil, errors.Errorf("balances event has %d indices and %d balances", len(e.Indices), len(e.Balances))
+	}
+	balances := make([]uint64, e.NumBalances)
+	copy(balances, r.balances)
+	for i, idx := range e.Indices {
+		if idx >= e.NumBalances {
+			return nil, errors.Errorf("balance index %d out of range %d", idx, e.NumBalances)
+		}
+		balances[idx] = e.Balances[i]
+	}
+	return balances, nil
+}
+
+// replay applies a single event to the fork choice store. Errors returned by fork choice are
+// only logged, as the node may have failed to process the same event, an error is returned
+// when the event is malformed.
+func (r *replayer) replay(ctx context.Context, e *TraceEvent) error {
+	r.now = time.UnixMilli(e.Time)
+	r.result.Events++
+	root := bytesutil.ToBytes32(e.Root)
+	var err error
+	switch e.Kind {
+	case traceGenesisTime:
+		r.f.SetGenesisTime(e.GenesisTime)
+	case traceOriginRoot:
+		r.f.SetOriginRoot(root)
+	case traceInsertNode:
+		if e.Justified == nil || e.Finalized == nil {
+			return errInvalidNilCheckpoint
+		}
+		err = r.insert(ctx, e)
+	case traceAttestation:
+		r.f.ProcessAttestation(ctx, e.Indices, root, e.Epoch)
+	case traceBalances:
+		balances, err := r.applyBalancesDelta(e)
+		if err != nil {
+			return err
+		}
+		r.balancesRoot, r.balances = root, balances
+	case traceJustified:
+		if e.Justified == nil {
+			return errInvalidNilCheckpoint
+		}
+		err = r.f.UpdateJustifiedCheckpoint(ctx, replayCheckpoint(e.Justified))
+	case traceFinalized:
+		if e.Finalized == nil {
+			return errInvalidNilCheckpoint
+		}
+		err = r.f.UpdateFinalizedCheckpoint(replayCheckpoint(e.Finalized))
+	case traceSlashed:
+		r.f.InsertSlashedIndex(ctx, e.Index)
+	case traceValid:
+		err = r.f.SetOptimisticToValid(ctx, root)
+	case traceInvalid:
+		_, err = r.f.SetOptimisticToInvalid(ctx, root, bytesutil.ToBytes32(e.ParentRoot), bytesutil.ToBytes32(e.PayloadHash))
+	case traceProposerBoost:
+		if boost := r.f.ProposerBoost(); boost != root {
+			r.diff(e.Kind, fmt.Sprintf("%#x", root), fmt.Sprintf("%#x", boost))
+		}
+	case traceTick:
+		r.endSlot(ctx)
+		r.slot, r.ticked = e.Slot, true
+		err = r.f.NewSlot(ctx, e.Slot)
+	case traceHead:
+		r.recordedHead = root
+		var head [32]byte
+		head, err = r.f.Head(ctx)
+		if head != root {
+			r.diff(e.Kind, fmt.Sprintf("%#x", root), fmt.Sprintf("%#x", head))
+		}
+	case traceOverrideFCU:
+		if override := r.f.ShouldOverrideFCU(); override != e.Override {
+			r.diff(e.Kind, strconv.FormatBool(e.Override), strconv.FormatBool(override))
+		}
+	case traceProposerHead:
+		if head := r.f.GetProposerHead(); head != root {
+			r.diff(e.Kind, fmt.Sprintf("%#x", root), fmt.Sprintf("%#x", head))
+		}
+	default:
+		return errors.Errorf("unknown trace event %q", e.Kind)
+	}
+	if err != nil {
+		log.WithError(err).WithField("event", e.Kind).Debug("Could not replay fork choice event")
+	}
+	return nil
+}
+
+// insert inserts the recorded node. Tips are pulled up using the recorded outcome, as the state
+// of the block is not available.
+func (r *replayer) insert(ctx context.Context, e *TraceEvent) error {
+	s := r.f.store
+	node, err := s.insert(ctx, e.Slot, bytesutil.ToBytes32(e.Root), bytesutil.ToBytes32(e.ParentRoot),
+		bytesutil.ToBytes32(e.PayloadHash), e.Justified.Epoch, e.Finalized.Epoch)
+	if err != nil {
+		return err
+	}
+	jc, fc := e.Justified, e.Finalized
+	if p := e.Pulled; p != nil {
+		if p.Justified == nil || p.Finalized == nil || p.UnrealizedJustified == nil || p.UnrealizedFinalized == nil {
+			return errInvalidNilCheckpoint
+		}
+		node.justifiedEpoch, node.finalizedEpoch = p.JustifiedEpoch, p.FinalizedEpoch
+		node.unrealizedJustifiedEpoch, node.unrealizedFinalizedEpoch = p.UnrealizedJustifiedEpoch, p.UnrealizedFinalizedEpoch
+		s.unrealizedJustifiedCheckpoint = replayCheckpoint(p.UnrealizedJustified)
+		s.unrealizedFinalizedCheckpoint = replayCheckpoint(p.UnrealizedFinalized)
+		jc, fc = p.Justified, p.Finalized
+	}
+	return r.f.updateCheckpoints(ctx,
+		&ethpb.Checkpoint{Epoch: jc.Epoch, Root: jc.Root},
+		&ethpb.Checkpoint{Epoch: fc.Epoch, Root: fc.Root})
+}
+
+// endSlot records the head at the end of the last ticked slot.
+func (r *replayer) endSlot(ctx context.Context) {
+	if !r.ticked {
+		return
+	}
+	head, err := r.f.Head(ctx)
+	if err != nil {
+		log.WithError(err).WithField("slot", r.slot).Debug("Could not compute head at the end of the slot")
+	}
+	r.result.Ticks = append(r.result.Ticks, &ReplayTick{
+		Slot:         r.slot,
+		Head:         head,
+		RecordedHead: r.recordedHead,
+	})
+}
+
+func (r *replayer) diff(kind, recorded, replayed string) {
+	r.result.Diffs = append(r.result.Diffs, &ReplayDiff{
+		Kind:     kind,
+		Time:     r.now,
+		Slot:     r.f.store.currentSlot(),
+		Recorded: recorded,
+		Replayed: replayed,
+	})
+}
+
+func replayCheckpoint(cp *TraceCheckpoint) *forkchoicetypes.Checkpoint {
+	return &forkchoicetypes.Checkpoint{Epoch: cp.Epoch, Root: bytesutil.ToBytes32(cp.Root)}
+}
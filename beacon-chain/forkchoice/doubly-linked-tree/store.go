@@ -9,6 +9,7 @@ import (
 	fieldparams "github.com/prysmaticlabs/prysm/v4/config/fieldparams"
 	"github.com/prysmaticlabs/prysm/v4/config/params"
 	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	prysmTime "github.com/prysmaticlabs/prysm/v4/time"
 	"github.com/prysmaticlabs/prysm/v4/time/slots"
 	"go.opencensus.io/trace"
 )
@@ -42,7 +43,7 @@ func (s *Store) head(ctx context.Context) ([32]byte, error) {
 	if bestDescendant == nil {
 		bestDescendant = justifiedNode
 	}
-	currentEpoch := slots.EpochsSinceGenesis(time.Unix(int64(s.genesisTime), 0))
+	currentEpoch := slots.ToEpoch(s.currentSlot())
 	if !bestDescendant.viableForHead(s.justifiedCheckpoint.Epoch, currentEpoch) {
 		s.allTipsAreInvalid = true
 		return [32]byte{}, fmt.Errorf("head at slot %d with weight %d is not eligible, finalizedEpoch, justified Epoch %d, %d != %d, %d",
@@ -86,7 +87,7 @@ func (s *Store) insert(ctx context.Context,
 		unrealizedFinalizedEpoch: finalizedEpoch,
 		optimistic:               true,
 		payloadHash:              payloadHash,
-		timestamp:                uint64(time.Now().Unix()),
+		timestamp:                uint64(s.currentTime().Unix()),
 	}
 
 	s.nodeByPayload[payloadHash] = n
@@ -102,12 +103,12 @@ func (s *Store) insert(ctx context.Context,
 	} else {
 		parent.children = append(parent.children, n)
 		// Apply proposer boost
-		timeNow := uint64(time.Now().Unix())
+		timeNow := uint64(s.currentTime().Unix())
 		if timeNow < s.genesisTime {
 			return n, nil
 		}
 		secondsIntoSlot := (timeNow - s.genesisTime) % params.BeaconConfig().SecondsPerSlot
-		currentSlot := slots.CurrentSlot(s.genesisTime)
+		currentSlot := s.currentSlot()
 		boostThreshold := params.BeaconConfig().SecondsPerSlot / params.BeaconConfig().IntervalsPerSlot
 		isFirstBlock := s.proposerBoostRoot == [32]byte{}
 		if currentSlot == slot && secondsIntoSlot < boostThreshold && isFirstBlock {
@@ -126,7 +127,7 @@ func (s *Store) insert(ctx context.Context,
 	nodeCount.Set(float64(len(s.nodeByRoot)))
 
 	// Only update received block slot if it's within epoch from current time.
-	if slot+params.BeaconConfig().SlotsPerEpoch > slots.CurrentSlot(s.genesisTime) {
+	if slot+params.BeaconConfig().SlotsPerEpoch > s.currentSlot() {
 		s.receivedBlocksLastEpoch[slot%params.BeaconConfig().SlotsPerEpoch] = slot
 	}
 	// Update highest slot tracking.
@@ -230,7 +231,7 @@ func (f *ForkChoice) HighestReceivedBlockSlot() primitives.Slot {
 // ReceivedBlocksLastEpoch returns the number of blocks received in the last epoch
 func (f *ForkChoice) ReceivedBlocksLastEpoch() (uint64, error) {
 	count := uint64(0)
-	lowerBound := slots.CurrentSlot(f.store.genesisTime)
+	lowerBound := f.store.currentSlot()
 	var err error
 	if lowerBound > fieldparams.SlotsPerEpoch {
 		lowerBound, err = lowerBound.SafeSub(fieldparams.SlotsPerEpoch)
@@ -246,3 +247,21 @@ func (f *ForkChoice) ReceivedBlocksLastEpoch() (uint64, error) {
 	}
 	return count, nil
 }
+
+// currentTime returns the time fork choice considers to be the current one. This is the wall
+// clock time, except when replaying a fork choice trace.
+func (s *Store) currentTime() time.Time {
+	if s.now == nil {
+		return prysmTime.Now()
+	}
+	return s.now()
+}
+
+// currentSlot returns the slot at the current time of fork choice.
+func (s *Store) currentSlot() primitives.Slot {
+	now := uint64(s.currentTime().Unix())
+	if now < s.genesisTime {
+		return 0
+	}
+	return primitives.Slot((now - s.genesisTime) / params.BeaconConfig().SecondsPerSlot)
+}
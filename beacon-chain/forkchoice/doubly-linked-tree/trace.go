@@ -0,0 +1,274 @@
+package doublylinkedtree
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/golang/snappy"
+	"github.com/pkg/errors"
+	forkchoicetypes "github.com/prysmaticlabs/prysm/v4/beacon-chain/forkchoice/types"
+	"github.com/prysmaticlabs/prysm/v4/config/params"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v4/io/file"
+	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+	prysmTime "github.com/prysmaticlabs/prysm/v4/time"
+)
+
+// Kinds of the events recorded in a fork choice trace.
+const (
+	traceHeader        = "trace"
+	traceGenesisTime   = "genesis_time"
+	traceOriginRoot    = "origin_root"
+	traceInsertNode    = "insert"
+	traceAttestation   = "attestation"
+	traceProposerBoost = "proposer_boost"
+	traceBalances      = "balances"
+	traceJustified     = "justified"
+	traceFinalized     = "finalized"
+	traceSlashed       = "slashed"
+	traceValid         = "valid"
+	traceInvalid       = "invalid"
+	traceTick          = "tick"
+	traceHead          = "head"
+	traceOverrideFCU   = "override_fcu"
+	traceProposerHead  = "proposer_head"
+)
+
+// TraceEvent is a single event of a fork choice trace. Only the fields relevant to the kind
+// of the event are set. Justified balances are recorded as the number of balances along with
+// the indices and values of the balances which changed since the previous balances event.
+type TraceEvent struct {
+	Kind        string                    `json:"kind"`
+	Time        int64                     `json:"time"` // unix time in milliseconds.
+	ConfigName  string                    `json:"config,omitempty"`
+	GenesisTime uint64                    `json:"genesis_time,omitempty"`
+	Slot        primitives.Slot           `json:"slot,omitempty"`
+	Root        hexutil.Bytes             `json:"root,omitempty"`
+	ParentRoot  hexutil.Bytes             `json:"parent_root,omitempty"`
+	PayloadHash hexutil.Bytes             `json:"payload_hash,omitempty"`
+	Epoch       primitives.Epoch          `json:"epoch,omitempty"`
+	Index       primitives.ValidatorIndex `json:"index,omitempty"`
+	Indices     []uint64                  `json:"indices,omitempty"`
+	Balances    []uint64                  `json:"balances,omitempty"`
+	NumBalances uint64                    `json:"num_balances,omitempty"`
+	Override    bool                      `json:"override,omitempty"`
+	Justified   *TraceCheckpoint          `json:"justified,omitempty"`
+	Finalized   *TraceCheckpoint          `json:"finalized,omitempty"`
+	Pulled      *TracePulledTips          `json:"pulled,omitempty"`
+}
+
+// TraceCheckpoint is a checkpoint recorded in a fork choice trace.
+type TraceCheckpoint struct {
+	Epoch primitives.Epoch `json:"epoch"`
+	Root  hexutil.Bytes    `json:"root"`
+}
+
+// TracePulledTips is the outcome of pulling up the tips of an inserted node. It is recorded
+// as it requires the state of the block, which is not available when replaying a trace.
+type TracePulledTips struct {
+	Justified                *TraceCheckpoint `json:"justified"`
+	Finalized                *TraceCheckpoint `json:"finalized"`
+	UnrealizedJustified      *TraceCheckpoint `json:"unrealized_justified"`
+	UnrealizedFinalized      *TraceCheckpoint `json:"unrealized_finalized"`
+	JustifiedEpoch           primitives.Epoch `json:"node_justified_epoch"`
+	FinalizedEpoch           primitives.Epoch `json:"node_finalized_epoch"`
+	UnrealizedJustifiedEpoch primitives.Epoch `json:"node_unrealized_justified_epoch"`
+	UnrealizedFinalizedEpoch primitives.Epoch `json:"node_unrealized_finalized_epoch"`
+}
+
+// TraceRecorder records the events processed by fork choice, so that they can be replayed
+// offline. The trace is a snappy compressed stream of JSON encoded events, one per line.
+type TraceRecorder struct {
+	sync.Mutex
+	out      io.Closer
+	w        *snappy.Writer
+	enc      *json.Encoder
+	failed   bool
+	path     string
+	balances []uint64
+}
+
+// NewTraceRecorder creates a recorder which writes the trace to a new file named after the given
+// path, with the start time appended to the file name, as a restarted node starts with a new fork
+// choice store and the traces of previous runs are kept.
+func NewTraceRecorder(path string) (*TraceRecorder, error) {
+	expanded, err := file.ExpandPath(path)
+	if err != nil {
+		return nil, err
+	}
+	if err := file.MkdirAll(filepath.Dir(expanded)); err != nil {
+		return nil, errors.Wrap(err, "could not create trace directory")
+	}
+	ext := filepath.Ext(expanded)
+	base := fmt.Sprintf("%s-%s", strings.TrimSuffix(expanded, ext), prysmTime.Now().UTC().Format("20060102-150405"))
+	for i := 0; ; i++ {
+		name := base + ext
+		if i > 0 {
+			name = fmt.Sprintf("%s-%d%s", base, i, ext)
+		}
+		f, err := os.OpenFile(name, os.O_CREATE|os.O_EXCL|os.O_WRONLY, params.BeaconIoConfig().ReadWritePermissions) // #nosec G304
+		if errors.Is(err, os.ErrExist) {
+			continue
+		}
+		if err != nil {
+			return nil, errors.Wrap(err, "could not create trace file")
+		}
+		r := newTraceRecorder(f)
+		r.path = name
+		return r, nil
+	}
+}
+
+// Path returns the path of the file the trace is written to.
+func (r *TraceRecorder) Path() string {
+	return r.path
+}
+
+func newTraceRecorder(out io.WriteCloser) *TraceRecorder {
+	w := snappy.NewBufferedWriter(out)
+	r := &TraceRecorder{
+		out: out,
+		w:   w,
+		enc: json.NewEncoder(w),
+	}
+	r.record(&TraceEvent{Kind: traceHeader, Time: prysmTime.Now().UnixMilli(), ConfigName: params.BeaconConfig().ConfigName})
+	return r
+}
+
+// record writes the event to the trace. Recording stops after the first failure, so that a
+// broken trace doesn't flood the logs.
+func (r *TraceRecorder) record(e *TraceEvent) {
+	r.Lock()
+	defer r.Unlock()
+	if r.failed {
+		return
+	}
+	if e.Kind == traceBalances {
+		e = r.balancesDelta(e)
+	}
+	if err := r.enc.Encode(e); err != nil {
+		r.failed = true
+		log.WithError(err).Error("Could not record fork choice trace, recording stopped")
+		return
+	}
+	// Events are flushed once per slot, a crash loses at most the events of the current slot.
+	if e.Kind != traceTick {
+		return
+	}
+	if err := r.w.Flush(); err != nil {
+		r.failed = true
+		log.WithError(err).Error("Could not flush fork choice trace, recording stopped")
+	}
+}
+
+// balancesDelta returns the balances event with only the balances which changed since the
+// previous balances event, as the justified balances hardly change from one update to the next.
+func (r *TraceRecorder) balancesDelta(e *TraceEvent) *TraceEvent {
+	d := &TraceEvent{Kind: e.Kind, Time: e.Time, Root: e.Root, NumBalances: uint64(len(e.Balances))}
+	for i, b := range e.Balances {
+		if i >= len(r.balances) || r.balances[i] != b {
+			d.Indices = append(d.Indices, uint64(i))
+			d.Balances = append(d.Balances, b)
+		}
+	}
+	r.balances = append(r.balances[:0], e.Balances...)
+	return d
+}
+
+// Close flushes the recorded events and closes the trace.
+func (r *TraceRecorder) Close() error {
+	r.Lock()
+	defer r.Unlock()
+	r.failed = true
+	if err := r.w.Close(); err != nil {
+		return errors.Wrap(err, "could not flush trace")
+	}
+	return r.out.Close()
+}
+
+// TraceReader reads the events of a fork choice trace.
+type TraceReader struct {
+	dec        *json.Decoder
+	configName string
+}
+
+// NewTraceReader creates a reader of the trace, and reads its header.
+func NewTraceReader(r io.Reader) (*TraceReader, error) {
+	tr := &TraceReader{dec: json.NewDecoder(snappy.NewReader(r))}
+	header, err := tr.Next()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not read trace header")
+	}
+	if header.Kind != traceHeader {
+		return nil, errors.Errorf("unexpected first trace event %q", header.Kind)
+	}
+	tr.configName = header.ConfigName
+	return tr, nil
+}
+
+// ConfigName returns the name of the beacon chain config the trace was recorded with.
+func (r *TraceReader) ConfigName() string {
+	return r.configName
+}
+
+// Next returns the next event of the trace, or io.EOF once all events were read.
+func (r *TraceReader) Next() (*TraceEvent, error) {
+	e := &TraceEvent{}
+	if err := r.dec.Decode(e); err != nil {
+		return nil, err
+	}
+	return e, nil
+}
+
+// SetTraceRecorder sets the recorder of the events processed by fork choice.
+func (f *ForkChoice) SetTraceRecorder(r *TraceRecorder) {
+	f.tracer = r
+}
+
+// trace records the event at the current time of fork choice, if a trace recorder is set.
+func (f *ForkChoice) trace(e *TraceEvent) {
+	if f.tracer == nil {
+		return
+	}
+	e.Time = f.store.currentTime().UnixMilli()
+	f.tracer.record(e)
+}
+
+// traceInsertedNode records the insertion of a node. The checkpoints of the block's state are
+// recorded along with the outcome of pulling up the tips, when the state was used to do so.
+func (f *ForkChoice) traceInsertedNode(node *Node, parentRoot [32]byte, jc, fc *ethpb.Checkpoint, pulled *TracePulledTips) {
+	f.trace(&TraceEvent{
+		Kind:        traceInsertNode,
+		Slot:        node.slot,
+		Root:        node.root[:],
+		ParentRoot:  parentRoot[:],
+		PayloadHash: node.payloadHash[:],
+		Justified:   &TraceCheckpoint{Epoch: jc.Epoch, Root: jc.Root},
+		Finalized:   &TraceCheckpoint{Epoch: fc.Epoch, Root: fc.Root},
+		Pulled:      pulled,
+	})
+}
+
+// pulledTips returns the trace record of the tips pulled up for the given node.
+func (s *Store) pulledTips(node *Node, jc, fc *ethpb.Checkpoint) *TracePulledTips {
+	return &TracePulledTips{
+		Justified:                &TraceCheckpoint{Epoch: jc.Epoch, Root: jc.Root},
+		Finalized:                &TraceCheckpoint{Epoch: fc.Epoch, Root: fc.Root},
+		UnrealizedJustified:      traceCheckpoint(s.unrealizedJustifiedCheckpoint),
+		UnrealizedFinalized:      traceCheckpoint(s.unrealizedFinalizedCheckpoint),
+		JustifiedEpoch:           node.justifiedEpoch,
+		FinalizedEpoch:           node.finalizedEpoch,
+		UnrealizedJustifiedEpoch: node.unrealizedJustifiedEpoch,
+		UnrealizedFinalizedEpoch: node.unrealizedFinalizedEpoch,
+	}
+}
+
+func traceCheckpoint(cp *forkchoicetypes.Checkpoint) *TraceCheckpoint {
+	return &TraceCheckpoint{Epoch: cp.Epoch, Root: cp.Root[:]}
+}
@@ -0,0 +1,184 @@
+package doublylinkedtree
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	forkchoicetypes "github.com/prysmaticlabs/prysm/v4/beacon-chain/forkchoice/types"
+	"github.com/prysmaticlabs/prysm/v4/config/params"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v4/io/file"
+	"github.com/prysmaticlabs/prysm/v4/testing/require"
+)
+
+type nopWriteCloser struct {
+	io.Writer
+}
+
+func (nopWriteCloser) Close() error {
+	return nil
+}
+
+// recordLateBlockTrace records a trace in which a weak block arrives late in slot 2, and is
+// orphaned by the proposer of slot 3.
+func recordLateBlockTrace(t *testing.T) []byte {
+	ctx := context.Background()
+	genesis := uint64(1_000_000)
+	secondsPerSlot := params.BeaconConfig().SecondsPerSlot
+	now := time.Unix(int64(genesis), 0)
+	f := New()
+	f.store.now = func() time.Time { return now }
+	buf := &bytes.Buffer{}
+	recorder := newTraceRecorder(nopWriteCloser{buf})
+	f.SetTraceRecorder(recorder)
+
+	balances := make([]uint64, 640)
+	for i := range balances {
+		balances[i] = 10
+	}
+	f.SetBalancesByRooter(func(_ context.Context, _ [32]byte) ([]uint64, error) { return balances, nil })
+	f.SetGenesisTime(genesis)
+	st, root, err := prepareForkchoiceState(ctx, 0, params.BeaconConfig().ZeroHash, [32]byte{}, params.BeaconConfig().ZeroHash, 0, 0)
+	require.NoError(t, err)
+	require.NoError(t, f.InsertNode(ctx, st, root))
+	require.NoError(t, f.UpdateJustifiedCheckpoint(ctx, &forkchoicetypes.Checkpoint{Root: params.BeaconConfig().ZeroHash}))
+
+	now = time.Unix(int64(genesis+secondsPerSlot), 0)
+	require.NoError(t, f.NewSlot(ctx, 1))
+	st, root, err = prepareForkchoiceState(ctx, 1, [32]byte{'a'}, params.BeaconConfig().ZeroHash, [32]byte{'A'}, 0, 0)
+	require.NoError(t, err)
+	require.NoError(t, f.InsertNode(ctx, st, root))
+	attesters := make([]uint64, len(balances)-64)
+	for i := range attesters {
+		attesters[i] = uint64(i + 64)
+	}
+	f.ProcessAttestation(ctx, attesters, root, 0)
+	head, err := f.Head(ctx)
+	require.NoError(t, err)
+	require.Equal(t, [32]byte{'a'}, head)
+
+	now = time.Unix(int64(genesis+2*secondsPerSlot), 0)
+	require.NoError(t, f.NewSlot(ctx, 2))
+	now = now.Add(time.Duration(orphanLateBlockFirstThreshold+1) * time.Second)
+	st, root, err = prepareForkchoiceState(ctx, 2, [32]byte{'b'}, [32]byte{'a'}, [32]byte{'B'}, 0, 0)
+	require.NoError(t, err)
+	require.NoError(t, f.InsertNode(ctx, st, root))
+	head, err = f.Head(ctx)
+	require.NoError(t, err)
+	require.Equal(t, [32]byte{'b'}, head)
+	require.Equal(t, true, f.ShouldOverrideFCU())
+
+	now = time.Unix(int64(genesis+3*secondsPerSlot), 0)
+	require.NoError(t, f.NewSlot(ctx, 3))
+	require.Equal(t, [32]byte{'a'}, f.GetProposerHead())
+	require.NoError(t, recorder.Close())
+	return buf.Bytes()
+}
+
+func TestReplay(t *testing.T) {
+	ctx := context.Background()
+	trace := recordLateBlockTrace(t)
+
+	tr, err := NewTraceReader(bytes.NewReader(trace))
+	require.NoError(t, err)
+	require.Equal(t, params.BeaconConfig().ConfigName, tr.ConfigName())
+	res, err := Replay(ctx, tr)
+	require.NoError(t, err)
+	require.Equal(t, 0, len(res.Diffs))
+	require.Equal(t, 3, len(res.Ticks))
+	wanted := [][32]byte{{'a'}, {'b'}, {'b'}}
+	for i, tick := range res.Ticks {
+		require.Equal(t, primitives.Slot(i+1), tick.Slot)
+		require.Equal(t, wanted[i], tick.Head)
+		require.Equal(t, wanted[i], tick.RecordedHead)
+	}
+}
+
+func TestReplay_ChangedParameters(t *testing.T) {
+	ctx := context.Background()
+	trace := recordLateBlockTrace(t)
+
+	params.SetupTestConfigCleanup(t)
+	cfg := params.BeaconConfig().Copy()
+	cfg.ReorgParentWeightThreshold = 10000
+	params.OverrideBeaconConfig(cfg)
+
+	tr, err := NewTraceReader(bytes.NewReader(trace))
+	require.NoError(t, err)
+	res, err := Replay(ctx, tr)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(res.Diffs))
+	require.Equal(t, traceOverrideFCU, res.Diffs[0].Kind)
+	require.Equal(t, "true", res.Diffs[0].Recorded)
+	require.Equal(t, "false", res.Diffs[0].Replayed)
+	require.Equal(t, primitives.Slot(2), res.Diffs[0].Slot)
+	require.Equal(t, traceProposerHead, res.Diffs[1].Kind)
+	require.Equal(t, primitives.Slot(3), res.Diffs[1].Slot)
+}
+
+func TestReplay_ConfigMismatch(t *testing.T) {
+	trace := recordLateBlockTrace(t)
+
+	params.SetupTestConfigCleanup(t)
+	cfg := params.BeaconConfig().Copy()
+	cfg.ConfigName = "other"
+	params.OverrideBeaconConfig(cfg)
+
+	tr, err := NewTraceReader(bytes.NewReader(trace))
+	require.NoError(t, err)
+	_, err = Replay(context.Background(), tr)
+	require.ErrorContains(t, "active config is other", err)
+}
+
+func TestNewTraceReader_NoHeader(t *testing.T) {
+	_, err := NewTraceReader(bytes.NewReader(nil))
+	require.ErrorContains(t, "could not read trace header", err)
+}
+
+func TestNewTraceRecorder_NewFilePerStart(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "forkchoice.trace")
+	first, err := NewTraceRecorder(path)
+	require.NoError(t, err)
+	second, err := NewTraceRecorder(path)
+	require.NoError(t, err)
+	require.NotEqual(t, first.Path(), second.Path())
+	for _, r := range []*TraceRecorder{first, second} {
+		require.Equal(t, true, strings.HasSuffix(r.Path(), ".trace"))
+		require.NoError(t, r.Close())
+		require.Equal(t, true, file.FileExists(r.Path()))
+	}
+}
+
+func TestTraceRecorder_BalancesDelta(t *testing.T) {
+	buf := &bytes.Buffer{}
+	recorder := newTraceRecorder(nopWriteCloser{buf})
+	recorder.record(&TraceEvent{Kind: traceBalances, Root: []byte{'a'}, Balances: []uint64{1, 2, 3}})
+	recorder.record(&TraceEvent{Kind: traceBalances, Root: []byte{'b'}, Balances: []uint64{1, 5, 3, 4}})
+	recorder.record(&TraceEvent{Kind: traceBalances, Root: []byte{'c'}, Balances: []uint64{1, 5}})
+	require.NoError(t, recorder.Close())
+
+	tr, err := NewTraceReader(bytes.NewReader(buf.Bytes()))
+	require.NoError(t, err)
+	r := &replayer{}
+	wanted := []struct {
+		indices  []uint64
+		balances []uint64
+	}{
+		{indices: []uint64{0, 1, 2}, balances: []uint64{1, 2, 3}},
+		{indices: []uint64{1, 3}, balances: []uint64{1, 5, 3, 4}},
+		{indices: nil, balances: []uint64{1, 5}},
+	}
+	for _, w := range wanted {
+		e, err := tr.Next()
+		require.NoError(t, err)
+		require.DeepEqual(t, w.indices, e.Indices)
+		r.balances, err = r.applyBalancesDelta(e)
+		require.NoError(t, err)
+		require.DeepEqual(t, w.balances, r.balances)
+	}
+}
@@ -62,7 +62,7 @@ func (s *Store) pullTips(state state.BeaconState, node *Node, jc, fc *ethpb.Chec
 	if node.parent == nil { // Nothing to do if the parent is nil.
 		return jc, fc
 	}
-	currentEpoch := slots.ToEpoch(slots.CurrentSlot(s.genesisTime))
+	currentEpoch := slots.ToEpoch(s.currentSlot())
 	stateSlot := state.Slot()
 	stateEpoch := slots.ToEpoch(stateSlot)
 	currJustified := node.parent.unrealizedJustifiedEpoch == currentEpoch
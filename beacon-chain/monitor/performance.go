@@ -0,0 +1,139 @@
+package monitor
+
+import (
+	"context"
+	"sort"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/core/altair"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/core/epoch/precompute"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/core/time"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/monitor/types"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v4/runtime/version"
+	"github.com/sirupsen/logrus"
+)
+
+// recordPerformance updates the performance record of a tracked validator for an epoch, creating
+// the record if needed. Records are only kept when they are persisted to the database.
+// It assumes the caller holds the service Lock.
+func (s *Service) recordPerformance(idx primitives.ValidatorIndex, epoch primitives.Epoch, update func(p *types.ValidatorPerformance)) {
+	if s.config.Database == nil {
+		return
+	}
+	records, ok := s.epochPerformance[epoch]
+	if !ok {
+		records = make(map[primitives.ValidatorIndex]*types.ValidatorPerformance)
+		s.epochPerformance[epoch] = records
+	}
+	p, ok := records[idx]
+	if !ok {
+		p = &types.ValidatorPerformance{ValidatorIndex: idx, Epoch: epoch}
+		records[idx] = p
+	}
+	update(p)
+}
+
+// persistEpochPerformance completes the performance records of the tracked validators for the previous epoch
+// of the given state, which must be the last state of the epoch after it, and saves them to the database.
+// The attestation duties of an epoch can be fulfilled until the end of the next epoch, so the records of
+// that epoch are then final. Rewards and penalties are attributed as done by the rewards API.
+// Epochs between the last saved one and the previous epoch of the state, which had no block to close them,
+// are saved as well with the duties recorded for them.
+func (s *Service) persistEpochPerformance(ctx context.Context, st state.BeaconState) error {
+	if s.config.Database == nil {
+		return nil
+	}
+	epoch := time.PrevEpoch(st)
+
+	s.RLock()
+	tracked := make([]primitives.ValidatorIndex, 0, len(s.TrackedValidators))
+	for idx := range s.TrackedValidators {
+		if uint64(idx) < uint64(st.NumValidators()) {
+			tracked = append(tracked, idx)
+		}
+	}
+	s.RUnlock()
+	sort.Slice(tracked, func(i, j int) bool { return tracked[i] < tracked[j] })
+
+	var trackedVals []*precompute.Validator
+	var deltas []*altair.AttDelta
+	if st.Version() >= version.Altair {
+		vals, bal, err := altair.InitializePrecomputeValidators(ctx, st)
+		if err != nil {
+			return errors.Wrap(err, "could not initialize precompute validators")
+		}
+		vals, bal, err = altair.ProcessEpochParticipation(ctx, st, bal, vals)
+		if err != nil {
+			return errors.Wrap(err, "could not process epoch participation")
+		}
+		trackedVals = make([]*precompute.Validator, len(tracked))
+		for i, idx := range tracked {
+			trackedVals[i] = vals[idx]
+		}
+		deltas, err = altair.AttestationsDelta(st, bal, trackedVals)
+		if err != nil {
+			return errors.Wrap(err, "could not get attestations delta")
+		}
+	}
+
+	s.Lock()
+	start := epoch
+	if s.hasPersistedEpoch && s.lastPersistedEpoch < epoch {
+		start = s.lastPersistedEpoch + 1
+	}
+	var records []*types.ValidatorPerformance
+	for e := start; e <= epoch; e++ {
+		for i, idx := range tracked {
+			s.recordPerformance(idx, e, func(p *types.ValidatorPerformance) {
+				if deltas == nil || e != epoch {
+					return
+				}
+				v, d := trackedVals[i], deltas[i]
+				p.CorrectSource = v.IsPrevEpochSourceAttester
+				p.CorrectTarget = v.IsPrevEpochTargetAttester
+				p.CorrectHead = v.IsPrevEpochHeadAttester
+				p.InactivityScore = v.InactivityScore
+				p.HeadReward = int64(d.HeadReward)                              // lint:ignore uintcast -- Rewards of an epoch are far below the int64 limit.
+				p.SourceReward = int64(d.SourceReward) - int64(d.SourcePenalty) // lint:ignore uintcast -- Rewards of an epoch are far below the int64 limit.
+				p.TargetReward = int64(d.TargetReward) - int64(d.TargetPenalty) // lint:ignore uintcast -- Rewards of an epoch are far below the int64 limit.
+			})
+		}
+		for _, p := range s.epochPerformance[e] {
+			records = append(records, p)
+		}
+	}
+	// Records of older epochs were either saved or are incomplete, as the monitor was not running then.
+	for e := range s.epochPerformance {
+		if e <= epoch {
+			delete(s.epochPerformance, e)
+		}
+	}
+	s.lastPersistedEpoch = epoch
+	s.hasPersistedEpoch = true
+	s.Unlock()
+
+	sort.Slice(records, func(i, j int) bool {
+		if records[i].Epoch != records[j].Epoch {
+			return records[i].Epoch < records[j].Epoch
+		}
+		return records[i].ValidatorIndex < records[j].ValidatorIndex
+	})
+	if err := s.config.Database.SaveValidatorPerformances(ctx, records); err != nil {
+		return errors.Wrap(err, "could not save validator performances")
+	}
+	for _, p := range records {
+		log.WithFields(logrus.Fields{
+			"ValidatorIndex":      p.ValidatorIndex,
+			"Epoch":               p.Epoch,
+			"AttestationIncluded": p.AttestationIncluded,
+			"InclusionDistance":   p.InclusionDistance,
+			"CorrectSource":       p.CorrectSource,
+			"CorrectTarget":       p.CorrectTarget,
+			"CorrectHead":         p.CorrectHead,
+			"TotalReward":         p.TotalReward(),
+		}).Debug("Saved epoch performance")
+	}
+	return nil
+}
@@ -0,0 +1,94 @@
+package monitor
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/monitor/types"
+	"github.com/prysmaticlabs/prysm/v4/config/params"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v4/testing/require"
+	"github.com/prysmaticlabs/prysm/v4/testing/util"
+)
+
+func TestRecordPerformance_NoDatabase(t *testing.T) {
+	s := setupService(t)
+	s.config.Database = nil
+	s.recordPerformance(1, 1, func(p *types.ValidatorPerformance) {
+		p.BlocksProposed++
+	})
+	require.Equal(t, 0, len(s.epochPerformance))
+}
+
+func TestPersistEpochPerformance(t *testing.T) {
+	ctx := context.Background()
+	s := setupService(t)
+	beaconDB := s.config.Database
+
+	s.Lock()
+	s.recordPerformance(1, 1, func(p *types.ValidatorPerformance) {
+		p.AttestationIncluded = true
+		p.InclusionDistance = 1
+		p.BlocksProposed = 1
+	})
+	s.recordPerformance(2, 2, func(p *types.ValidatorPerformance) {
+		p.BlocksProposed = 1
+	})
+	s.Unlock()
+
+	st, _ := util.DeterministicGenesisStateAltair(t, 256)
+	require.NoError(t, st.SetSlot(params.BeaconConfig().SlotsPerEpoch*2))
+	require.NoError(t, s.persistEpochPerformance(ctx, st))
+
+	got, err := beaconDB.ValidatorPerformances(ctx, 1, 0, 2)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(got))
+	require.Equal(t, primitives.Epoch(1), got[0].Epoch)
+	require.Equal(t, true, got[0].AttestationIncluded)
+	require.Equal(t, primitives.Slot(1), got[0].InclusionDistance)
+	require.Equal(t, uint64(1), got[0].BlocksProposed)
+	require.Equal(t, false, got[0].CorrectTarget)
+	require.Equal(t, true, got[0].TargetReward < 0)
+
+	// Validator 12 is tracked and gets a record with its penalties even without any recorded duty.
+	got, err = beaconDB.ValidatorPerformances(ctx, 12, 0, 2)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(got))
+	require.Equal(t, true, got[0].SourceReward < 0)
+
+	// Records of the current epoch are kept until that epoch is final.
+	got, err = beaconDB.ValidatorPerformances(ctx, 2, 0, 2)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(got))
+	require.Equal(t, 1, len(s.epochPerformance))
+	require.Equal(t, uint64(1), s.epochPerformance[2][2].BlocksProposed)
+}
+
+func TestPersistEpochPerformance_EpochsWithoutBlocks(t *testing.T) {
+	ctx := context.Background()
+	s := setupService(t)
+	beaconDB := s.config.Database
+
+	st, _ := util.DeterministicGenesisStateAltair(t, 256)
+	require.NoError(t, st.SetSlot(params.BeaconConfig().SlotsPerEpoch*2))
+	require.NoError(t, s.persistEpochPerformance(ctx, st))
+
+	s.Lock()
+	s.recordPerformance(2, 3, func(p *types.ValidatorPerformance) {
+		p.BlocksProposed = 1
+	})
+	s.Unlock()
+
+	// No block closed epochs 2 and 3, so they are saved with epoch 4.
+	require.NoError(t, st.SetSlot(params.BeaconConfig().SlotsPerEpoch*5))
+	require.NoError(t, s.persistEpochPerformance(ctx, st))
+
+	got, err := beaconDB.ValidatorPerformances(ctx, 2, 0, 5)
+	require.NoError(t, err)
+	require.Equal(t, 4, len(got))
+	for i, p := range got {
+		require.Equal(t, primitives.Epoch(i+1), p.Epoch)
+	}
+	require.Equal(t, uint64(1), got[2].BlocksProposed)
+	require.Equal(t, 0, len(s.epochPerformance))
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/core/altair"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/monitor/types"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/state"
 	"github.com/prysmaticlabs/prysm/v4/config/params"
 	"github.com/prysmaticlabs/prysm/v4/consensus-types/interfaces"
@@ -92,6 +93,13 @@ func (s *Service) processIncludedAttestation(ctx context.Context, state state.Be
 			latestPerf.inclusionSlot = state.Slot()
 			inclusionSlotGauge.WithLabelValues(fmt.Sprintf("%d", idx)).Set(float64(latestPerf.inclusionSlot))
 			aggregatedPerf.totalDistance += uint64(latestPerf.inclusionSlot - latestPerf.attestedSlot)
+			s.recordPerformance(primitives.ValidatorIndex(idx), slots.ToEpoch(att.Data.Slot), func(p *types.ValidatorPerformance) {
+				distance := latestPerf.inclusionSlot - latestPerf.attestedSlot
+				if !p.AttestationIncluded || distance < p.InclusionDistance {
+					p.InclusionDistance = distance
+				}
+				p.AttestationIncluded = true
+			})
 
 			if state.Version() == version.Altair {
 				targetIdx := params.BeaconConfig().TimelyTargetFlagIndex
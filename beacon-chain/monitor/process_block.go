@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/core/blocks"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/monitor/types"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/state"
 	"github.com/prysmaticlabs/prysm/v4/config/params"
 	"github.com/prysmaticlabs/prysm/v4/consensus-types/interfaces"
@@ -45,9 +46,17 @@ func (s *Service) processBlock(ctx context.Context, b interfaces.ReadOnlySignedB
 	}
 
 	currEpoch := slots.ToEpoch(blk.Slot())
-	s.RLock()
+	s.Lock()
 	lastSyncedEpoch := s.lastSyncedEpoch
-	s.RUnlock()
+	lastBlockEpoch := s.lastBlockEpoch
+	if currEpoch > lastBlockEpoch {
+		s.lastBlockEpoch = currEpoch
+	}
+	s.Unlock()
+
+	if currEpoch > lastBlockEpoch {
+		s.processEpochBoundary(ctx, blk)
+	}
 
 	if currEpoch != lastSyncedEpoch &&
 		slots.SyncCommitteePeriod(currEpoch) == slots.SyncCommitteePeriod(lastSyncedEpoch) {
@@ -63,6 +72,24 @@ func (s *Service) processBlock(ctx context.Context, b interfaces.ReadOnlySignedB
 	}
 }
 
+// processEpochBoundary saves the performance of the tracked validators for the epoch which became final
+// with the first block of a new epoch, using the post state of the last block before it.
+func (s *Service) processEpochBoundary(ctx context.Context, blk interfaces.ReadOnlyBeaconBlock) {
+	if s.config.Database == nil {
+		return
+	}
+	parentRoot := blk.ParentRoot()
+	parentState := s.config.StateGen.StateByRootIfCachedNoCopy(parentRoot)
+	if parentState == nil {
+		log.WithField("ParentRoot", fmt.Sprintf("%#x", bytesutil.Trunc(parentRoot[:]))).Debug(
+			"Skipping saving epoch performance due to parent state not found in cache")
+		return
+	}
+	if err := s.persistEpochPerformance(ctx, parentState); err != nil {
+		log.WithError(err).Error("Could not save epoch performance")
+	}
+}
+
 // processProposedBlock logs when the beacon node observes a beacon block from a tracked validator.
 func (s *Service) processProposedBlock(state state.BeaconState, root [32]byte, blk interfaces.ReadOnlyBeaconBlock) {
 	s.Lock()
@@ -88,6 +115,10 @@ func (s *Service) processProposedBlock(state state.BeaconState, root [32]byte, b
 		aggPerf.totalProposedCount++
 		s.aggregatedPerformance[blk.ProposerIndex()] = aggPerf
 
+		s.recordPerformance(blk.ProposerIndex(), slots.ToEpoch(blk.Slot()), func(p *types.ValidatorPerformance) {
+			p.BlocksProposed++
+		})
+
 		parentRoot := blk.ParentRoot()
 		log.WithFields(logrus.Fields{
 			"ProposerIndex": blk.ProposerIndex(),
@@ -3,10 +3,14 @@ package monitor
 import (
 	"fmt"
 
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/core/altair"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/monitor/types"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/state"
 	"github.com/prysmaticlabs/prysm/v4/consensus-types/interfaces"
 	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/v4/runtime/version"
+	"github.com/prysmaticlabs/prysm/v4/time/slots"
 	"github.com/sirupsen/logrus"
 )
 
@@ -38,6 +42,20 @@ func (s *Service) processSyncAggregate(state state.BeaconState, blk interfaces.R
 		log.WithError(err).Error("Could not get SyncAggregate")
 		return
 	}
+	var participantReward uint64
+	if s.config.Database != nil {
+		totalBalance, err := helpers.TotalActiveBalance(state)
+		if err != nil {
+			log.WithError(err).Error("Could not get total active balance")
+			return
+		}
+		participantReward, _, err = altair.SyncRewards(totalBalance)
+		if err != nil {
+			log.WithError(err).Error("Could not get sync committee rewards")
+			return
+		}
+	}
+	epoch := slots.ToEpoch(blk.Slot())
 	s.Lock()
 	defer s.Unlock()
 	for validatorIdx, committeeIndices := range s.trackedSyncCommitteeIndices {
@@ -68,6 +86,13 @@ func (s *Service) processSyncAggregate(state state.BeaconState, blk interfaces.R
 			syncCommitteeContributionCounter.WithLabelValues(
 				fmt.Sprintf("%d", validatorIdx)).Add(float64(contrib))
 
+			missed := len(committeeIndices) - contrib
+			s.recordPerformance(validatorIdx, epoch, func(p *types.ValidatorPerformance) {
+				p.SyncCommitteeIncluded += uint64(contrib)
+				p.SyncCommitteeMissed += uint64(missed)
+				p.SyncCommitteeReward += int64(contrib-missed) * int64(participantReward)
+			})
+
 			log.WithFields(logrus.Fields{
 				"ValidatorIndex":       validatorIdx,
 				"ExpectedContribCount": len(committeeIndices),
@@ -12,6 +12,8 @@ import (
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/core/feed/operation"
 	statefeed "github.com/prysmaticlabs/prysm/v4/beacon-chain/core/feed/state"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/monitor/types"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/state"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/state/stategen"
 	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
@@ -52,13 +54,15 @@ type ValidatorAggregatedPerformance struct {
 
 // ValidatorMonitorConfig contains the list of validator indices that the
 // monitor service tracks, and the event feed notifier that the
-// monitor needs to subscribe.
+// monitor needs to subscribe. The per-epoch performance of the tracked
+// validators is saved to the database when one is given.
 type ValidatorMonitorConfig struct {
 	StateNotifier       statefeed.Notifier
 	AttestationNotifier operation.Notifier
 	HeadFetcher         blockchain.HeadFetcher
 	StateGen            stategen.StateManager
 	InitialSyncComplete chan struct{}
+	Database            db.NoHeadAccessDatabase
 }
 
 // Service is the main structure that tracks validators and reports logs and
@@ -70,14 +74,19 @@ type Service struct {
 	isLogging bool
 
 	// Locks access to TrackedValidators, latestPerformance, aggregatedPerformance,
-	// trackedSyncedCommitteeIndices and lastSyncedEpoch
+	// epochPerformance, trackedSyncedCommitteeIndices, lastSyncedEpoch, lastBlockEpoch and lastPersistedEpoch
 	sync.RWMutex
 
 	TrackedValidators           map[primitives.ValidatorIndex]bool
 	latestPerformance           map[primitives.ValidatorIndex]ValidatorLatestPerformance
 	aggregatedPerformance       map[primitives.ValidatorIndex]ValidatorAggregatedPerformance
+	epochPerformance            map[primitives.Epoch]map[primitives.ValidatorIndex]*types.ValidatorPerformance
 	trackedSyncCommitteeIndices map[primitives.ValidatorIndex][]primitives.CommitteeIndex
 	lastSyncedEpoch             primitives.Epoch
+	lastBlockEpoch              primitives.Epoch
+	// lastPersistedEpoch is the last epoch whose performance was saved, if hasPersistedEpoch is set.
+	lastPersistedEpoch primitives.Epoch
+	hasPersistedEpoch  bool
 }
 
 // NewService sets up a new validator monitor service instance when given a list of validator indices to track.
@@ -90,6 +99,7 @@ func NewService(ctx context.Context, config *ValidatorMonitorConfig, tracked []p
 		TrackedValidators:           make(map[primitives.ValidatorIndex]bool, len(tracked)),
 		latestPerformance:           make(map[primitives.ValidatorIndex]ValidatorLatestPerformance),
 		aggregatedPerformance:       make(map[primitives.ValidatorIndex]ValidatorAggregatedPerformance),
+		epochPerformance:            make(map[primitives.Epoch]map[primitives.ValidatorIndex]*types.ValidatorPerformance),
 		trackedSyncCommitteeIndices: make(map[primitives.ValidatorIndex][]primitives.CommitteeIndex),
 		isLogging:                   false,
 	}
@@ -138,6 +148,7 @@ func (s *Service) run() {
 
 	s.Lock()
 	s.initializePerformanceStructures(st, epoch)
+	s.lastBlockEpoch = epoch
 	s.Unlock()
 
 	s.updateSyncCommitteeTrackedVals(st)
@@ -13,6 +13,7 @@ import (
 	statefeed "github.com/prysmaticlabs/prysm/v4/beacon-chain/core/feed/state"
 	testDB "github.com/prysmaticlabs/prysm/v4/beacon-chain/db/testing"
 	doublylinkedtree "github.com/prysmaticlabs/prysm/v4/beacon-chain/forkchoice/doubly-linked-tree"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/monitor/types"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/state/stategen"
 	"github.com/prysmaticlabs/prysm/v4/consensus-types/blocks"
 	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
@@ -94,12 +95,14 @@ func setupService(t *testing.T) *Service {
 			HeadFetcher:         chainService,
 			AttestationNotifier: chainService.OperationNotifier(),
 			InitialSyncComplete: make(chan struct{}),
+			Database:            beaconDB,
 		},
 
 		ctx:                         context.Background(),
 		TrackedValidators:           trackedVals,
 		latestPerformance:           latestPerformance,
 		aggregatedPerformance:       aggregatedPerformance,
+		epochPerformance:            make(map[primitives.Epoch]map[primitives.ValidatorIndex]*types.ValidatorPerformance),
 		trackedSyncCommitteeIndices: trackedSyncCommitteeIndices,
 		lastSyncedEpoch:             0,
 	}
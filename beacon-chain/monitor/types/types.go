@@ -0,0 +1,116 @@
+// Package types defines the per-epoch performance records of the validators
+// tracked by the validator monitor, as persisted in the beacon database.
+package types
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+)
+
+// Size of an encoded ValidatorPerformance.
+const encodedPerformanceSize = 8*2 + 1 + 8*9
+
+const (
+	attestationIncludedFlag byte = 1 << iota
+	correctSourceFlag
+	correctTargetFlag
+	correctHeadFlag
+)
+
+// ValidatorPerformance is the performance of a tracked validator during an epoch,
+// with the split of the rewards and penalties it earned for its duties of that epoch.
+// Rewards and penalties are in Gwei, penalties being negative values.
+type ValidatorPerformance struct {
+	ValidatorIndex primitives.ValidatorIndex
+	Epoch          primitives.Epoch
+
+	// Attestation duty of the epoch.
+	AttestationIncluded bool
+	InclusionDistance   primitives.Slot
+	CorrectSource       bool
+	CorrectTarget       bool
+	CorrectHead         bool
+
+	// Block proposals and sync committee participation during the epoch.
+	BlocksProposed        uint64
+	SyncCommitteeIncluded uint64
+	SyncCommitteeMissed   uint64
+
+	// Rewards and penalties of the epoch, and the inactivity score at the end of the epoch.
+	SyncCommitteeReward int64
+	SourceReward        int64
+	TargetReward        int64
+	HeadReward          int64
+	InactivityScore     uint64
+}
+
+// TotalReward returns the sum of the rewards and penalties of the epoch.
+func (p *ValidatorPerformance) TotalReward() int64 {
+	return p.SourceReward + p.TargetReward + p.HeadReward + p.SyncCommitteeReward
+}
+
+// MarshalBinary encodes the performance record.
+func (p *ValidatorPerformance) MarshalBinary() ([]byte, error) {
+	enc := make([]byte, encodedPerformanceSize)
+	binary.LittleEndian.PutUint64(enc[0:8], uint64(p.ValidatorIndex))
+	binary.LittleEndian.PutUint64(enc[8:16], uint64(p.Epoch))
+	var flags byte
+	if p.AttestationIncluded {
+		flags |= attestationIncludedFlag
+	}
+	if p.CorrectSource {
+		flags |= correctSourceFlag
+	}
+	if p.CorrectTarget {
+		flags |= correctTargetFlag
+	}
+	if p.CorrectHead {
+		flags |= correctHeadFlag
+	}
+	enc[16] = flags
+	offset := 17
+	for _, v := range []uint64{
+		uint64(p.InclusionDistance),
+		p.BlocksProposed,
+		p.SyncCommitteeIncluded,
+		p.SyncCommitteeMissed,
+		uint64(p.SyncCommitteeReward),
+		uint64(p.SourceReward),
+		uint64(p.TargetReward),
+		uint64(p.HeadReward),
+		p.InactivityScore,
+	} {
+		binary.LittleEndian.PutUint64(enc[offset:offset+8], v)
+		offset += 8
+	}
+	return enc, nil
+}
+
+// UnmarshalBinary decodes a performance record encoded with MarshalBinary.
+func (p *ValidatorPerformance) UnmarshalBinary(enc []byte) error {
+	if len(enc) != encodedPerformanceSize {
+		return fmt.Errorf("encoded validator performance has size %d, expected %d", len(enc), encodedPerformanceSize)
+	}
+	p.ValidatorIndex = primitives.ValidatorIndex(binary.LittleEndian.Uint64(enc[0:8]))
+	p.Epoch = primitives.Epoch(binary.LittleEndian.Uint64(enc[8:16]))
+	flags := enc[16]
+	p.AttestationIncluded = flags&attestationIncludedFlag != 0
+	p.CorrectSource = flags&correctSourceFlag != 0
+	p.CorrectTarget = flags&correctTargetFlag != 0
+	p.CorrectHead = flags&correctHeadFlag != 0
+	next := func(i int) uint64 {
+		return binary.LittleEndian.Uint64(enc[17+8*i : 25+8*i])
+	}
+	p.InclusionDistance = primitives.Slot(next(0))
+	p.BlocksProposed = next(1)
+	p.SyncCommitteeIncluded = next(2)
+	p.SyncCommitteeMissed = next(3)
+	p.SyncCommitteeReward = int64(next(4)) // lint:ignore uintcast -- Encoded from an int64.
+	p.SourceReward = int64(next(5))        // lint:ignore uintcast -- Encoded from an int64.
+	p.TargetReward = int64(next(6))        // lint:ignore uintcast -- Encoded from an int64.
+	p.HeadReward = int64(next(7))          // lint:ignore uintcast -- Encoded from an int64.
+	p.InactivityScore = next(8)
+	return nil
+}
@@ -0,0 +1,34 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v4/testing/require"
+)
+
+func TestValidatorPerformance_MarshalRoundTrip(t *testing.T) {
+	p := &ValidatorPerformance{
+		ValidatorIndex:        7,
+		Epoch:                 100,
+		AttestationIncluded:   true,
+		InclusionDistance:     2,
+		CorrectSource:         true,
+		CorrectHead:           true,
+		BlocksProposed:        1,
+		SyncCommitteeIncluded: 30,
+		SyncCommitteeMissed:   2,
+		SyncCommitteeReward:   -500,
+		SourceReward:          1200,
+		TargetReward:          -2300,
+		HeadReward:            800,
+		InactivityScore:       4,
+	}
+	enc, err := p.MarshalBinary()
+	require.NoError(t, err)
+	decoded := &ValidatorPerformance{}
+	require.NoError(t, decoded.UnmarshalBinary(enc))
+	require.DeepEqual(t, p, decoded)
+	require.Equal(t, int64(-800), decoded.TotalReward())
+
+	require.ErrorContains(t, "encoded validator performance has size", decoded.UnmarshalBinary(enc[1:]))
+}
@@ -25,6 +25,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/cache/depositcache"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/cache/depositsnapshot"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/db/filesystem"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/db/kv"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/db/slasherkv"
 	interopcoldstart "github.com/prysmaticlabs/prysm/v4/beacon-chain/deterministic-genesis"
@@ -41,7 +42,6 @@ import (
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/operations/voluntaryexits"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/p2p"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/rpc"
-	"github.com/prysmaticlabs/prysm/v4/beacon-chain/rpc/apimiddleware"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/slasher"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/startup"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/state"
@@ -97,6 +97,7 @@ type BeaconNode struct {
 	blsToExecPool           blstoexec.PoolManager
 	depositCache            cache.DepositCache
 	proposerIdsCache        *cache.ProposerPayloadIDsCache
+	blobArrivals            *cache.BlobArrivalsCache
 	stateFeed               *event.Feed
 	blockFeed               *event.Feed
 	opFeed                  *event.Feed
@@ -104,13 +105,18 @@ type BeaconNode struct {
 	collector               *bcnodeCollector
 	slasherBlockHeadersFeed *event.Feed
 	slasherAttestationsFeed *event.Feed
+	slasherBlobSidecarsFeed *event.Feed
+	slasherSyncMessagesFeed *event.Feed
 	finalizedStateAtStartUp state.BeaconState
 	serviceFlagOpts         *serviceFlagOpts
 	GenesisInitializer      genesis.Initializer
 	CheckpointInitializer   checkpoint.Initializer
 	forkChoicer             forkchoice.ForkChoicer
+	forkChoiceTracer        *doublylinkedtree.TraceRecorder
 	clockWaiter             startup.ClockWaiter
 	initialSyncComplete     chan struct{}
+	blobStorage             *filesystem.BlobStorage
+	backfillStatus          *backfill.Status
 }
 
 // New creates a new node instance, sets up configuration options, and registers
@@ -153,9 +159,6 @@ func New(cliCtx *cli.Context, opts ...Option) (*BeaconNode, error) {
 	if err := configureExecutionSetting(cliCtx); err != nil {
 		return nil, err
 	}
-	if err := kv.ConfigureBlobRetentionEpoch(cliCtx); err != nil {
-		return nil, err
-	}
 	configureFastSSZHashingAlgorithm()
 
 	// Initializes any forks here.
@@ -180,8 +183,11 @@ func New(cliCtx *cli.Context, opts ...Option) (*BeaconNode, error) {
 		blsToExecPool:           blstoexec.NewPool(),
 		slasherBlockHeadersFeed: new(event.Feed),
 		slasherAttestationsFeed: new(event.Feed),
+		slasherBlobSidecarsFeed: new(event.Feed),
+		slasherSyncMessagesFeed: new(event.Feed),
 		serviceFlagOpts:         &serviceFlagOpts{},
 		proposerIdsCache:        cache.NewProposerPayloadIDsCache(),
+		blobArrivals:            cache.NewBlobArrivalsCache(),
 	}
 
 	beacon.initialSyncComplete = make(chan struct{})
@@ -194,7 +200,9 @@ func New(cliCtx *cli.Context, opts ...Option) (*BeaconNode, error) {
 	synchronizer := startup.NewClockSynchronizer()
 	beacon.clockWaiter = synchronizer
 
-	beacon.forkChoicer = doublylinkedtree.New()
+	if err := beacon.initForkChoice(cliCtx); err != nil {
+		return nil, err
+	}
 	depositAddress, err := execution.DepositContractAddress()
 	if err != nil {
 		return nil, err
@@ -204,18 +212,23 @@ func New(cliCtx *cli.Context, opts ...Option) (*BeaconNode, error) {
 		return nil, err
 	}
 
+	log.Debugln("Starting Blob Storage")
+	if err := beacon.startBlobStorage(cliCtx); err != nil {
+		return nil, err
+	}
+
 	log.Debugln("Starting Slashing DB")
 	if err := beacon.startSlasherDB(cliCtx); err != nil {
 		return nil, err
 	}
 
-	bfs := backfill.NewStatus(beacon.db)
-	if err := bfs.Reload(ctx); err != nil {
+	beacon.backfillStatus = backfill.NewStatus(beacon.db)
+	if err := beacon.backfillStatus.ReloadFillBack(ctx); err != nil {
 		return nil, errors.Wrap(err, "backfill status initialization error")
 	}
 
 	log.Debugln("Starting State Gen")
-	if err := beacon.startStateGen(ctx, bfs, beacon.forkChoicer); err != nil {
+	if err := beacon.startStateGen(ctx, beacon.backfillStatus, beacon.forkChoicer); err != nil {
 		if errors.Is(err, stategen.ErrNoGenesisBlock) {
 			log.Errorf("No genesis block/state is found. Prysm only provides a mainnet genesis "+
 				"state bundled in the application. You must provide the --%s or --%s flag to load "+
@@ -259,6 +272,11 @@ func New(cliCtx *cli.Context, opts ...Option) (*BeaconNode, error) {
 		return nil, err
 	}
 
+	log.Debugln("Registering Backfill Service")
+	if err := beacon.registerBackfillService(cliCtx); err != nil {
+		return nil, err
+	}
+
 	log.Debugln("Registering Slasher Service")
 	if err := beacon.registerSlasherService(); err != nil {
 		return nil, err
@@ -364,11 +382,31 @@ func (b *BeaconNode) Close() {
 	if err := b.db.Close(); err != nil {
 		log.WithError(err).Error("Failed to close database")
 	}
+	if b.forkChoiceTracer != nil {
+		if err := b.forkChoiceTracer.Close(); err != nil {
+			log.WithError(err).Error("Failed to close fork choice trace")
+		}
+	}
 	b.collector.unregister()
 	b.cancel()
 	close(b.stop)
 }
 
+func (b *BeaconNode) initForkChoice(cliCtx *cli.Context) error {
+	fc := doublylinkedtree.New()
+	if path := cliCtx.String(flags.ForkChoiceTraceFile.Name); path != "" {
+		tracer, err := doublylinkedtree.NewTraceRecorder(path)
+		if err != nil {
+			return errors.Wrap(err, "could not create fork choice trace recorder")
+		}
+		log.WithField("path", tracer.Path()).Info("Recording fork choice trace")
+		fc.SetTraceRecorder(tracer)
+		b.forkChoiceTracer = tracer
+	}
+	b.forkChoicer = fc
+	return nil
+}
+
 func (b *BeaconNode) startDB(cliCtx *cli.Context, depositAddress string) error {
 	baseDir := cliCtx.String(cmd.DataDirFlag.Name)
 	dbPath := filepath.Join(baseDir, kv.BeaconNodeDbDirName)
@@ -464,6 +502,34 @@ func (b *BeaconNode) startDB(cliCtx *cli.Context, depositAddress string) error {
 	return nil
 }
 
+func (b *BeaconNode) startBlobStorage(cliCtx *cli.Context) error {
+	blobPath := cliCtx.String(flags.BlobStoragePathFlag.Name)
+	if blobPath == "" {
+		blobPath = filepath.Join(cliCtx.String(cmd.DataDirFlag.Name), "blobs")
+	}
+	var opts []filesystem.BlobStorageOption
+	if cliCtx.IsSet(flags.BlobRetentionEpoch.Name) {
+		opts = append(opts, filesystem.WithBlobRetentionEpochs(primitives.Epoch(cliCtx.Uint64(flags.BlobRetentionEpoch.Name))))
+	}
+	log.WithField("blob-path", blobPath).Info("Opening blob storage")
+	bs, err := filesystem.NewBlobStorage(blobPath, opts...)
+	if err != nil {
+		return err
+	}
+	b.blobStorage = bs
+	go bs.PruneLoop(b.ctx, b.clockWaiter)
+
+	// Blob sidecars used to be kept in the beacon database. Move any that are still there to blob storage.
+	n, err := b.db.MigrateBlobSidecars(b.ctx, bs.Save)
+	if err != nil {
+		return errors.Wrap(err, "could not migrate blob sidecars from the beacon database to blob storage")
+	}
+	if n > 0 {
+		log.WithField("count", n).Info("Migrated blob sidecars from the beacon database to blob storage")
+	}
+	return nil
+}
+
 func (b *BeaconNode) startSlasherDB(cliCtx *cli.Context) error {
 	if !features.Get().EnableSlasher {
 		return nil
@@ -624,6 +690,7 @@ func (b *BeaconNode) registerBlockchainService(fc forkchoice.ForkChoicer, gs *st
 		blockchain.WithDatabase(b.db),
 		blockchain.WithDepositCache(b.depositCache),
 		blockchain.WithChainStartFetcher(web3Service),
+		blockchain.WithBlockFetcher(web3Service),
 		blockchain.WithExecutionEngineCaller(web3Service),
 		blockchain.WithAttestationPool(b.attestationPool),
 		blockchain.WithExitPool(b.exitPool),
@@ -638,6 +705,7 @@ func (b *BeaconNode) registerBlockchainService(fc forkchoice.ForkChoicer, gs *st
 		blockchain.WithProposerIdsCache(b.proposerIdsCache),
 		blockchain.WithClockSynchronizer(gs),
 		blockchain.WithSyncComplete(syncComplete),
+		blockchain.WithBlobStorage(b.blobStorage),
 	)
 
 	blockchainService, err := blockchain.NewService(b.ctx, opts...)
@@ -712,9 +780,13 @@ func (b *BeaconNode) registerSyncService(initialSyncComplete chan struct{}) erro
 		regularsync.WithStateGen(b.stateGen),
 		regularsync.WithSlasherAttestationsFeed(b.slasherAttestationsFeed),
 		regularsync.WithSlasherBlockHeadersFeed(b.slasherBlockHeadersFeed),
+		regularsync.WithSlasherBlobSidecarsFeed(b.slasherBlobSidecarsFeed),
+		regularsync.WithSlasherSyncCommitteeMessagesFeed(b.slasherSyncMessagesFeed),
 		regularsync.WithExecutionPayloadReconstructor(web3Service),
 		regularsync.WithClockWaiter(b.clockWaiter),
 		regularsync.WithInitialSyncComplete(initialSyncComplete),
+		regularsync.WithBlobStorage(b.blobStorage),
+		regularsync.WithBlobArrivalsCache(b.blobArrivals),
 	)
 	return b.services.RegisterService(rs)
 }
@@ -733,10 +805,33 @@ func (b *BeaconNode) registerInitialSyncService(complete chan struct{}) error {
 		BlockNotifier:       b,
 		ClockWaiter:         b.clockWaiter,
 		InitialSyncComplete: complete,
+		BlobStorage:         b.blobStorage,
 	})
 	return b.services.RegisterService(is)
 }
 
+func (b *BeaconNode) registerBackfillService(cliCtx *cli.Context) error {
+	if !cliCtx.Bool(flags.EnableExperimentalBackfill.Name) {
+		return nil
+	}
+	var syncService *initialsync.Service
+	if err := b.services.FetchService(&syncService); err != nil {
+		return err
+	}
+
+	bf := backfill.NewService(b.ctx, &backfill.Config{
+		P2P:                 b.fetchP2P(),
+		DB:                  b.db,
+		Status:              b.backfillStatus,
+		ClockWaiter:         b.clockWaiter,
+		InitialSyncComplete: b.initialSyncComplete,
+		SyncChecker:         syncService,
+		BatchSize:           cliCtx.Uint64(flags.BackfillBatchSize.Name),
+		WorkerCount:         cliCtx.Int(flags.BackfillWorkerCount.Name),
+	})
+	return b.services.RegisterService(bf)
+}
+
 func (b *BeaconNode) registerSlasherService() error {
 	if !features.Get().EnableSlasher {
 		return nil
@@ -751,16 +846,19 @@ func (b *BeaconNode) registerSlasherService() error {
 	}
 
 	slasherSrv, err := slasher.New(b.ctx, &slasher.ServiceConfig{
-		IndexedAttestationsFeed: b.slasherAttestationsFeed,
-		BeaconBlockHeadersFeed:  b.slasherBlockHeadersFeed,
-		Database:                b.slasherDB,
-		StateNotifier:           b,
-		AttestationStateFetcher: chainService,
-		StateGen:                b.stateGen,
-		SlashingPoolInserter:    b.slashingsPool,
-		SyncChecker:             syncService,
-		HeadStateFetcher:        chainService,
-		ClockWaiter:             b.clockWaiter,
+		IndexedAttestationsFeed:   b.slasherAttestationsFeed,
+		BeaconBlockHeadersFeed:    b.slasherBlockHeadersFeed,
+		BlobSidecarsFeed:          b.slasherBlobSidecarsFeed,
+		SyncCommitteeMessagesFeed: b.slasherSyncMessagesFeed,
+		Database:                  b.slasherDB,
+		StateNotifier:             b,
+		OperationNotifier:         b,
+		AttestationStateFetcher:   chainService,
+		StateGen:                  b.stateGen,
+		SlashingPoolInserter:      b.slashingsPool,
+		SyncChecker:               syncService,
+		HeadStateFetcher:          chainService,
+		ClockWaiter:               b.clockWaiter,
 	})
 	if err != nil {
 		return err
@@ -828,6 +926,7 @@ func (b *BeaconNode) registerRPCService(router *mux.Router) error {
 		CertFlag:                      cert,
 		KeyFlag:                       key,
 		BeaconDB:                      b.db,
+		BlobStorage:                   b.blobStorage,
 		Broadcaster:                   p2pService,
 		PeersFetcher:                  p2pService,
 		PeerManager:                   p2pService,
@@ -843,6 +942,7 @@ func (b *BeaconNode) registerRPCService(router *mux.Router) error {
 		GenesisTimeFetcher:            chainService,
 		GenesisFetcher:                chainService,
 		OptimisticModeFetcher:         chainService,
+		LightClientUpdateFetcher:      chainService,
 		AttestationsPool:              b.attestationPool,
 		ExitPool:                      b.exitPool,
 		SlashingsPool:                 b.slashingsPool,
@@ -863,6 +963,7 @@ func (b *BeaconNode) registerRPCService(router *mux.Router) error {
 		EnableDebugRPCEndpoints:       enableDebugRPCEndpoints,
 		MaxMsgSize:                    maxMsgSize,
 		ProposerIdsCache:              b.proposerIdsCache,
+		BlobArrivals:                  b.blobArrivals,
 		BlockBuilder:                  b.fetchBuilderService(),
 		Router:                        router,
 		ClockWaiter:                   b.clockWaiter,
@@ -930,9 +1031,6 @@ func (b *BeaconNode) registerGRPCGateway(router *mux.Router) error {
 		apigateway.WithAllowedOrigins(allowedOrigins),
 		apigateway.WithTimeout(uint64(timeout)),
 	}
-	if flags.EnableHTTPEthAPI(httpModules) {
-		opts = append(opts, apigateway.WithApiMiddleware(&apimiddleware.BeaconEndpointFactory{}))
-	}
 	g, err := apigateway.New(b.ctx, opts...)
 	if err != nil {
 		return err
@@ -986,6 +1084,7 @@ func (b *BeaconNode) registerValidatorMonitorService(initialSyncComplete chan st
 		StateGen:            b.stateGen,
 		HeadFetcher:         chainService,
 		InitialSyncComplete: initialSyncComplete,
+		Database:            b.db,
 	}
 	svc, err := monitor.NewService(b.ctx, monitorConfig, tracked)
 	if err != nil {
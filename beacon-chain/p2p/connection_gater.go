@@ -9,6 +9,7 @@ import (
 	"github.com/libp2p/go-libp2p/core/peer"
 	"github.com/multiformats/go-multiaddr"
 	manet "github.com/multiformats/go-multiaddr/net"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/p2p/peers"
 	"github.com/sirupsen/logrus"
 )
 
@@ -25,15 +26,25 @@ const (
 )
 
 // InterceptPeerDial tests whether we're permitted to Dial the specified peer.
-func (_ *Service) InterceptPeerDial(_ peer.ID) (allow bool) {
+func (s *Service) InterceptPeerDial(pid peer.ID) (allow bool) {
+	// Disallow dialing peers denied by the operator.
+	if action, rule := s.peers.AccessList().Check(pid, nil); action == peers.AccessDeny {
+		logAccessDenied(pid, nil, rule)
+		return false
+	}
 	return true
 }
 
 // InterceptAddrDial tests whether we're permitted to dial the specified
 // multiaddr for the given peer.
 func (s *Service) InterceptAddrDial(pid peer.ID, m multiaddr.Multiaddr) (allow bool) {
-	// Disallow bad peers from dialing in.
-	if s.peers.IsBad(pid) {
+	action, rule := s.peers.AccessList().Check(pid, m)
+	if action == peers.AccessDeny {
+		logAccessDenied(pid, m, rule)
+		return false
+	}
+	// Disallow bad peers from dialing in, unless the operator allowed them.
+	if action != peers.AccessAllow && s.peers.IsBad(pid) {
 		return false
 	}
 	return filterConnections(s.addrFilter, m)
@@ -45,6 +56,10 @@ func (s *Service) InterceptAccept(n network.ConnMultiaddrs) (allow bool) {
 	if !s.started {
 		return false
 	}
+	if action, rule := s.peers.AccessList().Check("", n.RemoteMultiaddr()); action == peers.AccessDeny {
+		logAccessDenied("", n.RemoteMultiaddr(), rule)
+		return false
+	}
 	if !s.validateDial(n.RemoteMultiaddr()) {
 		// Allow other go-routines to run in the event
 		// we receive a large amount of junk connections.
@@ -63,7 +78,12 @@ func (s *Service) InterceptAccept(n network.ConnMultiaddrs) (allow bool) {
 
 // InterceptSecured tests whether a given connection, now authenticated,
 // is allowed.
-func (_ *Service) InterceptSecured(_ network.Direction, _ peer.ID, _ network.ConnMultiaddrs) (allow bool) {
+func (s *Service) InterceptSecured(_ network.Direction, pid peer.ID, n network.ConnMultiaddrs) (allow bool) {
+	// The peer ID of inbound connections is only known once they are secured.
+	if action, rule := s.peers.AccessList().Check(pid, n.RemoteMultiaddr()); action == peers.AccessDeny {
+		logAccessDenied(pid, n.RemoteMultiaddr(), rule)
+		return false
+	}
 	return true
 }
 
@@ -72,6 +92,19 @@ func (_ *Service) InterceptUpgraded(_ network.Conn) (allow bool, reason control.
 	return true, 0
 }
 
+// logAccessDenied records a connection refused by an access rule.
+func logAccessDenied(pid peer.ID, addr multiaddr.Multiaddr, rule *peers.AccessRule) {
+	accessListDeniedConnections.Inc()
+	fields := logrus.Fields{"rule": rule.ID, "reason": rule.Reason}
+	if pid != "" {
+		fields["peer"] = pid
+	}
+	if addr != nil {
+		fields["addr"] = addr
+	}
+	log.WithFields(fields).Trace("Connection denied by peer access list")
+}
+
 func (s *Service) validateDial(addr multiaddr.Multiaddr) bool {
 	ip, err := manet.ToIP(addr)
 	if err != nil {
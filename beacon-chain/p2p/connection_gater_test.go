@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/libp2p/go-libp2p"
+	"github.com/libp2p/go-libp2p/core/network"
 	"github.com/libp2p/go-libp2p/core/peer"
 	ma "github.com/multiformats/go-multiaddr"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/p2p/peers"
@@ -493,6 +494,63 @@ func TestService_InterceptAddrDial_AllowConflict(t *testing.T) {
 }
 
 // Mock type for testing.
+func TestService_InterceptAccessList(t *testing.T) {
+	s := &Service{
+		ipLimiter: leakybucket.NewCollector(ipLimit, ipBurst, 1*time.Second, false),
+		peers: peers.NewStatus(context.Background(), &peers.StatusConfig{
+			PeerLimit: 20,
+			ScorerParams: &scorers.Config{
+				BadResponsesScorerConfig: &scorers.BadResponsesScorerConfig{
+					Threshold: 1,
+				},
+			},
+		}),
+		cfg:     &Config{MaxPeers: 20},
+		started: true,
+	}
+	var err error
+	s.addrFilter, err = configureFilter(&Config{})
+	require.NoError(t, err)
+	h, err := libp2p.New(libp2p.NoListenAddrs)
+	require.NoError(t, err)
+	s.host = h
+	defer func() {
+		require.NoError(t, h.Close())
+	}()
+	deniedAddr, err := ma.NewMultiaddr("/ip4/212.67.10.122/tcp/3000")
+	require.NoError(t, err)
+	otherAddr, err := ma.NewMultiaddr("/ip4/52.23.23.253/tcp/3000")
+	require.NoError(t, err)
+	pid, err := peer.Decode("16Uiu2HAkyWZ4Ni1TpvDS8dPxsozmHY85KaiFjodQuV6Tz5tkHVeR")
+	require.NoError(t, err)
+
+	_, err = s.peers.AccessList().Add(&peers.AccessRule{Action: peers.AccessDeny, CIDR: "212.67.0.0/16"})
+	require.NoError(t, err)
+	assert.Equal(t, false, s.InterceptAccept(&maEndpoints{raddr: deniedAddr}))
+	assert.Equal(t, true, s.InterceptAccept(&maEndpoints{raddr: otherAddr}))
+	assert.Equal(t, false, s.InterceptAddrDial(pid, deniedAddr))
+	assert.Equal(t, true, s.InterceptAddrDial(pid, otherAddr))
+
+	denyRule, err := s.peers.AccessList().Add(&peers.AccessRule{Action: peers.AccessDeny, PeerID: pid.String(), Reason: "invalid blocks"})
+	require.NoError(t, err)
+	assert.Equal(t, false, s.InterceptPeerDial(pid))
+	assert.Equal(t, false, s.InterceptAddrDial(pid, otherAddr))
+	assert.Equal(t, false, s.InterceptSecured(network.DirInbound, pid, &maEndpoints{raddr: otherAddr}))
+	require.NoError(t, s.peers.AccessList().Remove(denyRule.ID))
+	assert.Equal(t, true, s.InterceptPeerDial(pid))
+
+	// Allowed peers can be dialed even if they are bad or in a denied range.
+	s.peers.Add(nil, pid, deniedAddr, network.DirOutbound)
+	s.peers.Scorers().BadResponsesScorer().Increment(pid)
+	require.Equal(t, true, s.peers.IsBad(pid))
+	assert.Equal(t, false, s.InterceptAddrDial(pid, otherAddr))
+	_, err = s.peers.AccessList().Add(&peers.AccessRule{Action: peers.AccessAllow, PeerID: pid.String()})
+	require.NoError(t, err)
+	assert.Equal(t, true, s.InterceptAddrDial(pid, otherAddr))
+	assert.Equal(t, true, s.InterceptAddrDial(pid, deniedAddr))
+	assert.Equal(t, true, s.InterceptSecured(network.DirInbound, pid, &maEndpoints{raddr: deniedAddr}))
+}
+
 type maEndpoints struct {
 	laddr ma.Multiaddr
 	raddr ma.Multiaddr
@@ -288,6 +288,9 @@ func (s *Service) filterPeer(node *enode.Node) bool {
 	if s.peers.IsBad(peerData.ID) {
 		return false
 	}
+	if s.peers.AccessList().IsDenied(peerData.ID, multiAddr) {
+		return false
+	}
 	if s.peers.IsActive(peerData.ID) {
 		return false
 	}
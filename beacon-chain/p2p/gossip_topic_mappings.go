@@ -5,6 +5,7 @@ import (
 
 	"github.com/prysmaticlabs/prysm/v4/config/params"
 	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	ethpbv2 "github.com/prysmaticlabs/prysm/v4/proto/eth/v2"
 	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
 	"google.golang.org/protobuf/proto"
 )
@@ -22,6 +23,8 @@ var gossipTopicMappings = map[string]proto.Message{
 	SyncCommitteeSubnetTopicFormat:            &ethpb.SyncCommitteeMessage{},
 	BlsToExecutionChangeSubnetTopicFormat:     &ethpb.SignedBLSToExecutionChange{},
 	BlobSubnetTopicFormat:                     &ethpb.SignedBlobSidecar{},
+	LightClientFinalityUpdateTopicFormat:      &ethpbv2.LightClientFinalityUpdate{},
+	LightClientOptimisticUpdateTopicFormat:    &ethpbv2.LightClientOptimisticUpdate{},
 }
 
 // GossipTopicMappings is a function to return the assigned data type
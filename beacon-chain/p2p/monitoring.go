@@ -40,6 +40,10 @@ var (
 		Name: "p2p_repeat_attempts",
 		Help: "The number of repeat attempts the connection handler is triggered for a peer.",
 	})
+	accessListDeniedConnections = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "p2p_access_list_denied_connections_total",
+		Help: "The number of connections refused because of a deny rule of the peer access list.",
+	})
 	statusMessageMissing = promauto.NewCounter(prometheus.CounterOpts{
 		Name: "p2p_status_message_missing",
 		Help: "The number of attempts the connection handler rejects a peer for a missing status message.",
@@ -0,0 +1,311 @@
+package peers
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net"
+	"os"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/p2p/enode"
+	"github.com/libp2p/go-libp2p/core/peer"
+	ma "github.com/multiformats/go-multiaddr"
+	manet "github.com/multiformats/go-multiaddr/net"
+	"github.com/pkg/errors"
+	ecdsaprysm "github.com/prysmaticlabs/prysm/v4/crypto/ecdsa"
+	"github.com/prysmaticlabs/prysm/v4/io/file"
+)
+
+// AccessAction is the action taken for connections matching an access rule.
+type AccessAction string
+
+const (
+	// AccessAllow always lets matching peers connect, even if they are denied by another rule or
+	// considered bad by the peer scorers.
+	AccessAllow AccessAction = "allow"
+	// AccessDeny refuses connections to and from matching peers.
+	AccessDeny AccessAction = "deny"
+)
+
+var (
+	// ErrInvalidAccessRule is returned when an access rule cannot be added.
+	ErrInvalidAccessRule = errors.New("invalid access rule")
+	// ErrAccessRuleNotFound is returned when there is an attempt to remove an unknown access rule.
+	ErrAccessRuleNotFound = errors.New("access rule not found")
+)
+
+// AccessRule allows or denies connections of peers by peer ID, by ENR or by IP range. Exactly one
+// of PeerID, ENR and CIDR is set.
+type AccessRule struct {
+	ID     string       `json:"id"`
+	Action AccessAction `json:"action"`
+	PeerID string       `json:"peer_id,omitempty"`
+	ENR    string       `json:"enr,omitempty"`
+	CIDR   string       `json:"cidr,omitempty"`
+	Reason string       `json:"reason,omitempty"`
+	// CreatedAt and ExpiresAt are unix timestamps in seconds. A rule without expiry never expires.
+	CreatedAt int64 `json:"created_at"`
+	ExpiresAt int64 `json:"expires_at,omitempty"`
+
+	pid   peer.ID
+	ipNet *net.IPNet
+}
+
+// AccessList holds the allow and deny rules that operators manage at runtime. Rules are saved
+// to disk on every change once a path is set.
+type AccessList struct {
+	lock  sync.RWMutex
+	path  string
+	rules map[string]*AccessRule
+}
+
+// NewAccessList creates an empty access list that is only kept in memory.
+func NewAccessList() *AccessList {
+	return &AccessList{
+		rules: make(map[string]*AccessRule),
+	}
+}
+
+// Load reads the rules saved at the given path, which is then used to save later changes. A
+// missing file is not an error.
+func (l *AccessList) Load(path string) error {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	l.path = path
+	enc, err := os.ReadFile(path) // #nosec G304 -- path is derived from the data directory
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return errors.Wrap(err, "could not read peer access list")
+	}
+	var rules []*AccessRule
+	if err := json.Unmarshal(enc, &rules); err != nil {
+		return errors.Wrap(err, "could not decode peer access list")
+	}
+	for _, rule := range rules {
+		if err := rule.parse(); err != nil {
+			log.WithError(err).WithField("rule", rule.ID).Warn("Skipping invalid peer access rule")
+			continue
+		}
+		l.rules[rule.ID] = rule
+	}
+	return nil
+}
+
+// Add validates and adds a rule, replacing any rule with the same target. The rule is given an
+// ID derived from its target and its creation time is set.
+func (l *AccessList) Add(rule *AccessRule) (*AccessRule, error) {
+	if rule == nil {
+		return nil, errors.Wrap(ErrInvalidAccessRule, "rule is nil")
+	}
+	added := &AccessRule{
+		Action:    rule.Action,
+		PeerID:    rule.PeerID,
+		ENR:       rule.ENR,
+		CIDR:      rule.CIDR,
+		Reason:    rule.Reason,
+		CreatedAt: time.Now().Unix(),
+		ExpiresAt: rule.ExpiresAt,
+	}
+	if err := added.parse(); err != nil {
+		return nil, err
+	}
+	if added.expired(time.Now()) {
+		return nil, errors.Wrap(ErrInvalidAccessRule, "rule is already expired")
+	}
+
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	// The rule is only kept if it could be saved, so that the rules in memory match the ones on disk.
+	replaced, ok := l.rules[added.ID]
+	l.rules[added.ID] = added
+	if err := l.save(); err != nil {
+		if ok {
+			l.rules[added.ID] = replaced
+		} else {
+			delete(l.rules, added.ID)
+		}
+		return nil, err
+	}
+	return added, nil
+}
+
+// Remove removes the rule with the given ID.
+func (l *AccessList) Remove(id string) error {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	removed, ok := l.rules[id]
+	if !ok {
+		return ErrAccessRuleNotFound
+	}
+	delete(l.rules, id)
+	if err := l.save(); err != nil {
+		l.rules[id] = removed
+		return err
+	}
+	return nil
+}
+
+// Rules returns the rules that have not expired, oldest first.
+func (l *AccessList) Rules() []*AccessRule {
+	l.lock.RLock()
+	defer l.lock.RUnlock()
+
+	now := time.Now()
+	rules := make([]*AccessRule, 0, len(l.rules))
+	for _, rule := range l.rules {
+		if !rule.expired(now) {
+			rules = append(rules, rule)
+		}
+	}
+	sort.Slice(rules, func(i, j int) bool {
+		if rules[i].CreatedAt != rules[j].CreatedAt {
+			return rules[i].CreatedAt < rules[j].CreatedAt
+		}
+		return rules[i].ID < rules[j].ID
+	})
+	return rules
+}
+
+// Check returns the action and the rule that apply to a peer, given its ID, its address, or both.
+// Allow rules take precedence over deny rules. An empty action is returned when no rule matches.
+func (l *AccessList) Check(pid peer.ID, addr ma.Multiaddr) (AccessAction, *AccessRule) {
+	l.lock.RLock()
+	defer l.lock.RUnlock()
+
+	if len(l.rules) == 0 {
+		return "", nil
+	}
+	var ip net.IP
+	if addr != nil {
+		// Addresses which are not IP based, such as relayed ones, are only matched by peer ID.
+		ip, _ = manet.ToIP(addr)
+	}
+	now := time.Now()
+	var denied *AccessRule
+	for _, rule := range l.rules {
+		if rule.expired(now) || !rule.matches(pid, ip) {
+			continue
+		}
+		if rule.Action == AccessAllow {
+			return AccessAllow, rule
+		}
+		denied = rule
+	}
+	if denied != nil {
+		return AccessDeny, denied
+	}
+	return "", nil
+}
+
+// IsDenied checks whether a peer is denied by the access list.
+func (l *AccessList) IsDenied(pid peer.ID, addr ma.Multiaddr) bool {
+	action, _ := l.Check(pid, addr)
+	return action == AccessDeny
+}
+
+// IsAllowed checks whether a peer is explicitly allowed by the access list.
+func (l *AccessList) IsAllowed(pid peer.ID, addr ma.Multiaddr) bool {
+	action, _ := l.Check(pid, addr)
+	return action == AccessAllow
+}
+
+// save writes the rules that have not expired to disk, dropping expired ones.
+// Important: it is assumed that the list lock is held when calling this method.
+func (l *AccessList) save() error {
+	now := time.Now()
+	rules := make([]*AccessRule, 0, len(l.rules))
+	for id, rule := range l.rules {
+		if rule.expired(now) {
+			delete(l.rules, id)
+			continue
+		}
+		rules = append(rules, rule)
+	}
+	if l.path == "" {
+		return nil
+	}
+	sort.Slice(rules, func(i, j int) bool {
+		return rules[i].ID < rules[j].ID
+	})
+	enc, err := json.MarshalIndent(rules, "", "  ")
+	if err != nil {
+		return errors.Wrap(err, "could not encode peer access list")
+	}
+	if err := file.WriteFile(l.path, enc); err != nil {
+		return errors.Wrap(err, "could not write peer access list")
+	}
+	return nil
+}
+
+// parse validates the rule, and derives its ID and the values it is matched against.
+func (r *AccessRule) parse() error {
+	if r.Action != AccessAllow && r.Action != AccessDeny {
+		return errors.Wrapf(ErrInvalidAccessRule, "action must be %q or %q", AccessAllow, AccessDeny)
+	}
+	var target string
+	targets := 0
+	if r.PeerID != "" {
+		pid, err := peer.Decode(r.PeerID)
+		if err != nil {
+			return errors.Wrapf(ErrInvalidAccessRule, "could not decode peer ID: %v", err)
+		}
+		r.pid = pid
+		target = "peer_id:" + pid.String()
+		targets++
+	}
+	if r.ENR != "" {
+		node, err := enode.Parse(enode.ValidSchemes, r.ENR)
+		if err != nil {
+			return errors.Wrapf(ErrInvalidAccessRule, "could not decode ENR: %v", err)
+		}
+		pubkey, err := ecdsaprysm.ConvertToInterfacePubkey(node.Pubkey())
+		if err != nil {
+			return errors.Wrapf(ErrInvalidAccessRule, "could not get pubkey of ENR: %v", err)
+		}
+		pid, err := peer.IDFromPublicKey(pubkey)
+		if err != nil {
+			return errors.Wrapf(ErrInvalidAccessRule, "could not get peer ID of ENR: %v", err)
+		}
+		r.pid = pid
+		target = "enr:" + pid.String()
+		targets++
+	}
+	if r.CIDR != "" {
+		_, ipNet, err := net.ParseCIDR(r.CIDR)
+		if err != nil {
+			return errors.Wrapf(ErrInvalidAccessRule, "could not parse CIDR: %v", err)
+		}
+		r.ipNet = ipNet
+		target = "cidr:" + ipNet.String()
+		targets++
+	}
+	if targets != 1 {
+		return errors.Wrap(ErrInvalidAccessRule, "exactly one of peer ID, ENR and CIDR must be set")
+	}
+	if r.ExpiresAt < 0 {
+		return errors.Wrap(ErrInvalidAccessRule, "expiry must not be negative")
+	}
+	h := sha256.Sum256([]byte(target))
+	r.ID = hex.EncodeToString(h[:8])
+	return nil
+}
+
+// matches checks whether the rule targets the given peer ID or IP.
+func (r *AccessRule) matches(pid peer.ID, ip net.IP) bool {
+	if r.ipNet != nil {
+		return ip != nil && r.ipNet.Contains(ip)
+	}
+	return pid != "" && r.pid == pid
+}
+
+// expired checks whether the rule expired at the given time.
+func (r *AccessRule) expired(now time.Time) bool {
+	return r.ExpiresAt != 0 && now.Unix() >= r.ExpiresAt
+}
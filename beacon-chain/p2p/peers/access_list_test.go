@@ -0,0 +1,154 @@
+package peers_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/p2p/enode"
+	"github.com/ethereum/go-ethereum/p2p/enr"
+	"github.com/libp2p/go-libp2p/core/peer"
+	ma "github.com/multiformats/go-multiaddr"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/p2p/peers"
+	ecdsaprysm "github.com/prysmaticlabs/prysm/v4/crypto/ecdsa"
+	"github.com/prysmaticlabs/prysm/v4/testing/assert"
+	"github.com/prysmaticlabs/prysm/v4/testing/require"
+)
+
+func TestAccessList_Check(t *testing.T) {
+	l := peers.NewAccessList()
+	pid1, err := peer.Decode("16Uiu2HAkyWZ4Ni1TpvDS8dPxsozmHY85KaiFjodQuV6Tz5tkHVeR")
+	require.NoError(t, err)
+	pid2, err := peer.Decode("16Uiu2HAm4HgJ9N1o222xK61o7LSgToYWoAy1wNTJRkh9gLZapVAy")
+	require.NoError(t, err)
+	addr1, err := ma.NewMultiaddr("/ip4/52.23.23.253/tcp/13000")
+	require.NoError(t, err)
+	addr2, err := ma.NewMultiaddr("/ip4/213.202.254.180/tcp/13000")
+	require.NoError(t, err)
+
+	action, rule := l.Check(pid1, addr1)
+	assert.Equal(t, peers.AccessAction(""), action)
+	assert.Equal(t, (*peers.AccessRule)(nil), rule)
+
+	denied, err := l.Add(&peers.AccessRule{Action: peers.AccessDeny, CIDR: "52.23.0.0/16", Reason: "spam"})
+	require.NoError(t, err)
+	assert.Equal(t, true, l.IsDenied(pid1, addr1))
+	assert.Equal(t, true, l.IsDenied("", addr1))
+	assert.Equal(t, false, l.IsDenied(pid1, addr2))
+	assert.Equal(t, false, l.IsDenied(pid1, nil))
+
+	// Allow rules take precedence over deny rules.
+	_, err = l.Add(&peers.AccessRule{Action: peers.AccessAllow, PeerID: pid1.String()})
+	require.NoError(t, err)
+	action, rule = l.Check(pid1, addr1)
+	assert.Equal(t, peers.AccessAllow, action)
+	assert.Equal(t, pid1.String(), rule.PeerID)
+	action, rule = l.Check(pid2, addr1)
+	assert.Equal(t, peers.AccessDeny, action)
+	assert.Equal(t, "spam", rule.Reason)
+
+	// A rule with the same target replaces the existing one.
+	replaced, err := l.Add(&peers.AccessRule{Action: peers.AccessAllow, CIDR: "52.23.1.1/16"})
+	require.NoError(t, err)
+	assert.Equal(t, denied.ID, replaced.ID)
+	assert.Equal(t, 2, len(l.Rules()))
+	assert.Equal(t, false, l.IsDenied(pid2, addr1))
+
+	require.NoError(t, l.Remove(replaced.ID))
+	require.ErrorIs(t, l.Remove(replaced.ID), peers.ErrAccessRuleNotFound)
+	assert.Equal(t, 1, len(l.Rules()))
+}
+
+func TestAccessList_ENR(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	record := &enr.Record{}
+	record.Set(enr.IPv4{10, 0, 0, 1})
+	require.NoError(t, enode.SignV4(record, key))
+	node, err := enode.New(enode.ValidSchemes, record)
+	require.NoError(t, err)
+	pubkey, err := ecdsaprysm.ConvertToInterfacePubkey(&key.PublicKey)
+	require.NoError(t, err)
+	pid, err := peer.IDFromPublicKey(pubkey)
+	require.NoError(t, err)
+
+	l := peers.NewAccessList()
+	_, err = l.Add(&peers.AccessRule{Action: peers.AccessDeny, ENR: node.String()})
+	require.NoError(t, err)
+	assert.Equal(t, true, l.IsDenied(pid, nil))
+	assert.Equal(t, false, l.IsDenied("16Uiu2HAkyWZ4Ni1TpvDS8dPxsozmHY85KaiFjodQuV6Tz5tkHVeR", nil))
+}
+
+func TestAccessList_InvalidRules(t *testing.T) {
+	l := peers.NewAccessList()
+	tests := []struct {
+		name string
+		rule *peers.AccessRule
+		err  string
+	}{
+		{name: "unknown action", rule: &peers.AccessRule{Action: "ban", CIDR: "10.0.0.0/8"}, err: "action must be"},
+		{name: "no target", rule: &peers.AccessRule{Action: peers.AccessDeny}, err: "exactly one of"},
+		{name: "two targets", rule: &peers.AccessRule{Action: peers.AccessDeny, CIDR: "10.0.0.0/8", PeerID: "16Uiu2HAkyWZ4Ni1TpvDS8dPxsozmHY85KaiFjodQuV6Tz5tkHVeR"}, err: "exactly one of"},
+		{name: "bad peer ID", rule: &peers.AccessRule{Action: peers.AccessDeny, PeerID: "foo"}, err: "could not decode peer ID"},
+		{name: "bad ENR", rule: &peers.AccessRule{Action: peers.AccessDeny, ENR: "enr:foo"}, err: "could not decode ENR"},
+		{name: "bad CIDR", rule: &peers.AccessRule{Action: peers.AccessDeny, CIDR: "10.0.0.0"}, err: "could not parse CIDR"},
+		{name: "expired", rule: &peers.AccessRule{Action: peers.AccessDeny, CIDR: "10.0.0.0/8", ExpiresAt: time.Now().Add(-time.Minute).Unix()}, err: "already expired"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := l.Add(tt.rule)
+			require.ErrorIs(t, err, peers.ErrInvalidAccessRule)
+			require.ErrorContains(t, tt.err, err)
+		})
+	}
+}
+
+func TestAccessList_ExpiryAndPersistence(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "access-list.json")
+	l := peers.NewAccessList()
+	require.NoError(t, l.Load(path))
+	addr, err := ma.NewMultiaddr("/ip4/10.1.2.3/tcp/13000")
+	require.NoError(t, err)
+
+	_, err = l.Add(&peers.AccessRule{Action: peers.AccessDeny, CIDR: "10.0.0.0/8", Reason: "private", ExpiresAt: time.Now().Add(2 * time.Second).Unix()})
+	require.NoError(t, err)
+	_, err = l.Add(&peers.AccessRule{Action: peers.AccessDeny, PeerID: "16Uiu2HAkyWZ4Ni1TpvDS8dPxsozmHY85KaiFjodQuV6Tz5tkHVeR", Reason: "bad blocks"})
+	require.NoError(t, err)
+	assert.Equal(t, true, l.IsDenied("", addr))
+
+	// Rules are reloaded with their reason and expiry.
+	reloaded := peers.NewAccessList()
+	require.NoError(t, reloaded.Load(path))
+	rules := reloaded.Rules()
+	require.Equal(t, 2, len(rules))
+	reasons := map[string]bool{rules[0].Reason: true, rules[1].Reason: true}
+	assert.Equal(t, true, reasons["private"] && reasons["bad blocks"])
+	assert.Equal(t, true, reloaded.IsDenied("", addr))
+
+	// Expired rules no longer apply.
+	time.Sleep(2 * time.Second)
+	assert.Equal(t, false, reloaded.IsDenied("", addr))
+	assert.Equal(t, 1, len(reloaded.Rules()))
+}
+
+func TestAccessList_SaveFailure(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "access-list.json")
+	l := peers.NewAccessList()
+	require.NoError(t, l.Load(path))
+	kept, err := l.Add(&peers.AccessRule{Action: peers.AccessDeny, CIDR: "10.0.0.0/8", Reason: "private"})
+	require.NoError(t, err)
+
+	// Saving fails once the path is a directory, changes are then not applied.
+	require.NoError(t, os.Remove(path))
+	require.NoError(t, os.Mkdir(path, 0700))
+	_, err = l.Add(&peers.AccessRule{Action: peers.AccessDeny, PeerID: "16Uiu2HAkyWZ4Ni1TpvDS8dPxsozmHY85KaiFjodQuV6Tz5tkHVeR"})
+	require.ErrorContains(t, "could not write peer access list", err)
+	_, err = l.Add(&peers.AccessRule{Action: peers.AccessAllow, CIDR: "10.0.0.0/8"})
+	require.ErrorContains(t, "could not write peer access list", err)
+	require.ErrorContains(t, "could not write peer access list", l.Remove(kept.ID))
+	rules := l.Rules()
+	require.Equal(t, 1, len(rules))
+	assert.Equal(t, kept, rules[0])
+}
@@ -0,0 +1,73 @@
+package peers
+
+import (
+	"encoding/json"
+	"os"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/p2p/peers/scorers"
+	"github.com/prysmaticlabs/prysm/v4/io/file"
+	"github.com/sirupsen/logrus"
+)
+
+// reputationFile is the on-disk format of peer reputations.
+type reputationFile struct {
+	SavedAt int64                          `json:"saved_at"`
+	Peers   map[string]*scorers.Reputation `json:"peers"`
+}
+
+// SaveReputation writes the reputation of known peers to the given file, so that misbehaving
+// peers are still recognized after a restart.
+func (p *Status) SaveReputation(path string) error {
+	reputations := p.scorers.Reputations()
+	f := &reputationFile{
+		SavedAt: time.Now().Unix(),
+		Peers:   make(map[string]*scorers.Reputation, len(reputations)),
+	}
+	for pid, r := range reputations {
+		f.Peers[pid.String()] = r
+	}
+	enc, err := json.Marshal(f)
+	if err != nil {
+		return errors.Wrap(err, "could not encode peer reputations")
+	}
+	if err := file.WriteFile(path, enc); err != nil {
+		return errors.Wrap(err, "could not write peer reputations")
+	}
+	return nil
+}
+
+// LoadReputation restores the reputation of peers from the given file, decaying it by the time
+// elapsed since it was saved. A missing file is not an error.
+func (p *Status) LoadReputation(path string) error {
+	enc, err := os.ReadFile(path) // #nosec G304 -- path is derived from the data directory
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return errors.Wrap(err, "could not read peer reputations")
+	}
+	f := &reputationFile{}
+	if err := json.Unmarshal(enc, f); err != nil {
+		return errors.Wrap(err, "could not decode peer reputations")
+	}
+	reputations := make(map[peer.ID]*scorers.Reputation, len(f.Peers))
+	for id, r := range f.Peers {
+		pid, err := peer.Decode(id)
+		if err != nil {
+			log.WithError(err).WithField("peer", id).Debug("Skipping reputation of invalid peer ID")
+			continue
+		}
+		reputations[pid] = r
+	}
+	elapsed := time.Since(time.Unix(f.SavedAt, 0))
+	restored := p.scorers.RestoreReputations(reputations, elapsed)
+	log.WithFields(logrus.Fields{
+		"restored": restored,
+		"saved":    len(reputations),
+		"age":      elapsed.Round(time.Second),
+	}).Info("Restored peer reputations")
+	return nil
+}
@@ -0,0 +1,111 @@
+package peers_test
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/network"
+	"github.com/libp2p/go-libp2p/core/peer"
+	libp2ptest "github.com/libp2p/go-libp2p/p2p/host/peerstore/test"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/p2p/peers"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/p2p/peers/scorers"
+	p2ptypes "github.com/prysmaticlabs/prysm/v4/beacon-chain/p2p/types"
+	pb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v4/testing/assert"
+	"github.com/prysmaticlabs/prysm/v4/testing/require"
+)
+
+func newReputationTestStatus() *peers.Status {
+	return peers.NewStatus(context.Background(), &peers.StatusConfig{
+		PeerLimit: 30,
+		ScorerParams: &scorers.Config{
+			BadResponsesScorerConfig: &scorers.BadResponsesScorerConfig{
+				Threshold:     5,
+				DecayInterval: time.Hour,
+			},
+			BlockProviderScorerConfig: &scorers.BlockProviderScorerConfig{
+				DecayInterval: time.Minute,
+				Decay:         64,
+			},
+		},
+	})
+}
+
+func TestStatus_SaveLoadReputation(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "peer-reputation.json")
+	p := newReputationTestStatus()
+	// Peer IDs must be valid to be saved.
+	ids := libp2ptest.GeneratePeerIDs(4)
+	badPeer, wrongForkPeer, goodPeer, neutralPeer := ids[0], ids[1], ids[2], ids[3]
+	for _, id := range ids {
+		p.Add(nil, id, nil, network.DirOutbound)
+		p.SetConnectionState(id, peers.PeerConnected)
+	}
+	for i := 0; i < 5; i++ {
+		p.Scorers().BadResponsesScorer().Increment(badPeer)
+	}
+	p.Scorers().GossipScorer().SetGossipData(badPeer, -200, 10, nil)
+	p.Scorers().PeerStatusScorer().SetPeerStatus(wrongForkPeer, &pb.Status{}, fmt.Errorf("handshake: %w", p2ptypes.ErrWrongForkDigestVersion))
+	p.Scorers().BlockProviderScorer().IncrementProcessedBlocks(goodPeer, 320)
+	p.Scorers().GossipScorer().SetGossipData(goodPeer, 20, 0, nil)
+	require.Equal(t, true, p.IsBad(badPeer))
+	require.Equal(t, true, p.IsBad(wrongForkPeer))
+	require.NoError(t, p.SaveReputation(path))
+
+	// A restarted node still knows the bad peers.
+	restarted := newReputationTestStatus()
+	require.NoError(t, restarted.LoadReputation(path))
+	assert.Equal(t, true, restarted.IsBad(badPeer))
+	assert.Equal(t, true, restarted.IsBad(wrongForkPeer))
+	assert.Equal(t, false, restarted.IsBad(goodPeer))
+	assert.Equal(t, uint64(320), restarted.Scorers().BlockProviderScorer().ProcessedBlocks(goodPeer))
+	gossipScore, _, _, err := restarted.Scorers().GossipScorer().GossipData(goodPeer)
+	require.NoError(t, err)
+	assert.Equal(t, float64(0), gossipScore, "Gossip rewards must not be restored")
+	_, err = restarted.Scorers().BadResponsesScorer().Count(neutralPeer)
+	assert.ErrorContains(t, "peer unknown", err)
+	// Restored peers are known but not connected.
+	state, err := restarted.ConnectionState(badPeer)
+	require.NoError(t, err)
+	assert.Equal(t, peers.PeerDisconnected, state)
+
+	// A missing file is not an error.
+	require.NoError(t, newReputationTestStatus().LoadReputation(filepath.Join(t.TempDir(), "missing.json")))
+}
+
+func TestStatus_LoadReputation_Decay(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "peer-reputation.json")
+	pid1, err := peer.Decode("16Uiu2HAkyWZ4Ni1TpvDS8dPxsozmHY85KaiFjodQuV6Tz5tkHVeR")
+	require.NoError(t, err)
+	pid2, err := peer.Decode("16Uiu2HAm4HgJ9N1o222xK61o7LSgToYWoAy1wNTJRkh9gLZapVAy")
+	require.NoError(t, err)
+	savedAt := time.Now().Add(-3 * time.Hour).Unix()
+	content := fmt.Sprintf(`{"saved_at":%d,"peers":{`+
+		`"%s":{"bad_responses":5,"processed_blocks":320,"gossip_score":-800},`+
+		`"%s":{"bad_responses":2,"status_error":"%s"},`+
+		`"invalid":{"bad_responses":5}}}`,
+		savedAt, pid1, pid2, p2ptypes.ErrInvalidFinalizedRoot.Error())
+	require.NoError(t, os.WriteFile(path, []byte(content), 0600))
+
+	p := newReputationTestStatus()
+	require.NoError(t, p.LoadReputation(path))
+	// One bad response is forgiven every hour.
+	count, err := p.Scorers().BadResponsesScorer().Count(pid1)
+	require.NoError(t, err)
+	assert.Equal(t, 2, count)
+	// Block provider stats decay within minutes.
+	assert.Equal(t, uint64(0), p.Scorers().BlockProviderScorer().ProcessedBlocks(pid1))
+	// Gossip penalties are halved every hour.
+	gossipScore, _, _, err := p.Scorers().GossipScorer().GossipData(pid1)
+	require.NoError(t, err)
+	assert.Equal(t, float64(-100), math.Round(gossipScore))
+	// Terminal status errors are kept for a few hours.
+	assert.Equal(t, true, p.IsBad(pid2))
+	require.ErrorIs(t, p.Scorers().ValidationError(pid2), p2ptypes.ErrInvalidFinalizedRoot)
+	assert.Equal(t, 2, len(p.All()))
+}
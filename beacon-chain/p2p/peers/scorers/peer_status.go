@@ -14,6 +14,13 @@ import (
 
 var _ Scorer = (*PeerStatusScorer)(nil)
 
+// terminalStatusErrs are the chain status validation errors which make a peer bad.
+var terminalStatusErrs = []error{
+	p2ptypes.ErrWrongForkDigestVersion,
+	p2ptypes.ErrInvalidFinalizedRoot,
+	p2ptypes.ErrInvalidRequest,
+}
+
 // PeerStatusScorer represents scorer that evaluates peers based on their statuses.
 // Peer statuses are updated by regularly polling peers (see sync/rpc_status.go).
 type PeerStatusScorer struct {
@@ -80,17 +87,17 @@ func (s *PeerStatusScorer) isBadPeer(pid peer.ID) bool {
 		return false
 	}
 	// Mark peer as bad, if the latest error is one of the terminal ones.
-	terminalErrs := []error{
-		p2ptypes.ErrWrongForkDigestVersion,
-		p2ptypes.ErrInvalidFinalizedRoot,
-		p2ptypes.ErrInvalidRequest,
-	}
-	for _, err := range terminalErrs {
-		if errors.Is(peerData.ChainStateValidationError, err) {
-			return true
+	return terminalStatusError(peerData.ChainStateValidationError) != nil
+}
+
+// terminalStatusError returns the terminal error a chain status validation error wraps, if any.
+func terminalStatusError(validationErr error) error {
+	for _, err := range terminalStatusErrs {
+		if errors.Is(validationErr, err) {
+			return err
 		}
 	}
-	return false
+	return nil
 }
 
 // BadPeers returns the peers that are considered bad.
@@ -0,0 +1,122 @@
+package scorers
+
+import (
+	"math"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+)
+
+const (
+	// reputationGossipScoreHalfLife defines how fast a restored gossip penalty fades away. Gossip scores are
+	// recalculated by libp2p once a peer is connected again, so a restored penalty only has to keep a peer
+	// away for a while.
+	reputationGossipScoreHalfLife = time.Hour
+	// reputationStatusErrorTTL defines how long a terminal chain status error is kept across restarts.
+	reputationStatusErrorTTL = 6 * time.Hour
+)
+
+// Reputation is the part of the peer data that is kept across restarts.
+type Reputation struct {
+	BadResponses    int     `json:"bad_responses,omitempty"`
+	ProcessedBlocks uint64  `json:"processed_blocks,omitempty"`
+	GossipScore     float64 `json:"gossip_score,omitempty"`
+	StatusError     string  `json:"status_error,omitempty"`
+}
+
+// isEmpty checks whether reputation holds nothing worth keeping.
+func (r *Reputation) isEmpty() bool {
+	return r.BadResponses <= 0 && r.ProcessedBlocks == 0 && r.GossipScore >= 0 && r.StatusError == ""
+}
+
+// Reputations returns the reputation of every peer that has one. Only penalties are kept from
+// gossip scores, as rewards are earned again by taking part in meshes.
+func (s *Service) Reputations() map[peer.ID]*Reputation {
+	s.store.RLock()
+	defer s.store.RUnlock()
+
+	reputations := make(map[peer.ID]*Reputation)
+	for pid, peerData := range s.store.Peers() {
+		r := &Reputation{
+			BadResponses:    peerData.BadResponses,
+			ProcessedBlocks: peerData.ProcessedBlocks,
+		}
+		if peerData.GossipScore < 0 {
+			r.GossipScore = peerData.GossipScore
+		}
+		if err := terminalStatusError(peerData.ChainStateValidationError); err != nil {
+			r.StatusError = err.Error()
+		}
+		if !r.isEmpty() {
+			reputations[pid] = r
+		}
+	}
+	return reputations
+}
+
+// RestoreReputations restores reputations which were saved some time ago. Stats are decayed as the
+// scorers would have decayed them in the meantime, and peers that are left with nothing are skipped.
+// It returns the number of restored peers.
+func (s *Service) RestoreReputations(reputations map[peer.ID]*Reputation, elapsed time.Duration) int {
+	if elapsed < 0 {
+		elapsed = 0
+	}
+	badResponsesDecay := int(elapsed / s.scorers.badResponsesScorer.Params().DecayInterval)
+	blockProviderParams := s.scorers.blockProviderScorer.Params()
+	processedBlocksDecay := uint64(elapsed/blockProviderParams.DecayInterval) * blockProviderParams.Decay
+	gossipScoreDecay := math.Pow(0.5, float64(elapsed)/float64(reputationGossipScoreHalfLife))
+
+	s.store.Lock()
+	defer s.store.Unlock()
+
+	restored := 0
+	for pid, r := range reputations {
+		if r == nil {
+			continue
+		}
+		decayed := &Reputation{BadResponses: r.BadResponses - badResponsesDecay}
+		if r.ProcessedBlocks > processedBlocksDecay {
+			decayed.ProcessedBlocks = r.ProcessedBlocks - processedBlocksDecay
+		}
+		// Penalties that are too small to matter are dropped.
+		if score := r.GossipScore * gossipScoreDecay; score < -1 {
+			decayed.GossipScore = score
+		}
+		if elapsed < reputationStatusErrorTTL {
+			decayed.StatusError = r.StatusError
+		}
+		statusErr := statusErrorFromString(decayed.StatusError)
+		if statusErr == nil {
+			decayed.StatusError = ""
+		}
+		if decayed.isEmpty() {
+			continue
+		}
+		peerData := s.store.PeerDataGetOrCreate(pid)
+		if decayed.BadResponses > peerData.BadResponses {
+			peerData.BadResponses = decayed.BadResponses
+		}
+		if decayed.ProcessedBlocks > peerData.ProcessedBlocks {
+			peerData.ProcessedBlocks = decayed.ProcessedBlocks
+		}
+		if decayed.GossipScore < peerData.GossipScore {
+			peerData.GossipScore = decayed.GossipScore
+		}
+		if statusErr != nil && peerData.ChainStateValidationError == nil {
+			peerData.ChainStateValidationError = statusErr
+		}
+		restored++
+	}
+	return restored
+}
+
+// statusErrorFromString maps the message of a persisted chain status error back to the terminal error
+// it was, returning nil for any other message.
+func statusErrorFromString(msg string) error {
+	for _, err := range terminalStatusErrs {
+		if msg == err.Error() {
+			return err
+		}
+	}
+	return nil
+}
@@ -78,11 +78,12 @@ const (
 
 // Status is the structure holding the peer status information.
 type Status struct {
-	ctx       context.Context
-	scorers   *scorers.Service
-	store     *peerdata.Store
-	ipTracker map[string]uint64
-	rand      *rand.Rand
+	ctx        context.Context
+	scorers    *scorers.Service
+	store      *peerdata.Store
+	accessList *AccessList
+	ipTracker  map[string]uint64
+	rand       *rand.Rand
 }
 
 // StatusConfig represents peer status service params.
@@ -99,10 +100,11 @@ func NewStatus(ctx context.Context, config *StatusConfig) *Status {
 		MaxPeers: maxLimitBuffer + config.PeerLimit,
 	})
 	return &Status{
-		ctx:       ctx,
-		store:     store,
-		scorers:   scorers.NewService(ctx, store, config.ScorerParams),
-		ipTracker: map[string]uint64{},
+		ctx:        ctx,
+		store:      store,
+		scorers:    scorers.NewService(ctx, store, config.ScorerParams),
+		accessList: NewAccessList(),
+		ipTracker:  map[string]uint64{},
 		// Random generator used to calculate dial backoff period.
 		// It is ok to use deterministic generator, no need for true entropy.
 		rand: rand.NewDeterministicGenerator(),
@@ -114,6 +116,11 @@ func (p *Status) Scorers() *scorers.Service {
 	return p.scorers
 }
 
+// AccessList exposes the allow and deny rules managed by the operator.
+func (p *Status) AccessList() *AccessList {
+	return p.accessList
+}
+
 // MaxPeerLimit returns the max peer limit stored in the current peer store.
 func (p *Status) MaxPeerLimit() int {
 	return p.store.Config().MaxPeers
@@ -43,6 +43,12 @@ const BlobSidecarsByRangeName = "/blob_sidecars_by_range"
 // BlobSidecarsByRootName is the name for the BlobSidecarsByRoot v1 message topic.
 const BlobSidecarsByRootName = "/blob_sidecars_by_root"
 
+// LightClientBootstrapName is the name for the LightClientBootstrap v1 message topic.
+const LightClientBootstrapName = "/light_client_bootstrap"
+
+// LightClientUpdatesByRangeName is the name for the LightClientUpdatesByRange v1 message topic.
+const LightClientUpdatesByRangeName = "/light_client_updates_by_range"
+
 const (
 	// V1 RPC Topics
 	// RPCStatusTopicV1 defines the v1 topic for the status rpc method.
@@ -66,6 +72,14 @@ const (
 	// /eth2/beacon_chain/req/blob_sidecars_by_root/1/
 	RPCBlobSidecarsByRootTopicV1 = protocolPrefix + BlobSidecarsByRootName + SchemaVersionV1
 
+	// RPCLightClientBootstrapTopicV1 is a topic for requesting the light client bootstrap of a block root.
+	// /eth2/beacon_chain/req/light_client_bootstrap/1/
+	RPCLightClientBootstrapTopicV1 = protocolPrefix + LightClientBootstrapName + SchemaVersionV1
+	// RPCLightClientUpdatesByRangeTopicV1 is a topic for requesting the best light client updates
+	// of the sync committee periods in the range [start_period, start_period + count).
+	// /eth2/beacon_chain/req/light_client_updates_by_range/1/
+	RPCLightClientUpdatesByRangeTopicV1 = protocolPrefix + LightClientUpdatesByRangeName + SchemaVersionV1
+
 	// V2 RPC Topics
 	// RPCBlocksByRangeTopicV2 defines v2 the topic for the blocks by range rpc method.
 	RPCBlocksByRangeTopicV2 = protocolPrefix + BeaconBlocksByRangeMessageName + SchemaVersionV2
@@ -101,6 +115,10 @@ var RPCTopicMappings = map[string]interface{}{
 	RPCBlobSidecarsByRangeTopicV1: new(pb.BlobSidecarsByRangeRequest),
 	// BlobSidecarsByRoot v1 Message
 	RPCBlobSidecarsByRootTopicV1: new(p2ptypes.BlobSidecarsByRootReq),
+	// LightClientBootstrap v1 Message
+	RPCLightClientBootstrapTopicV1: new(p2ptypes.LightClientBootstrapReq),
+	// LightClientUpdatesByRange v1 Message
+	RPCLightClientUpdatesByRangeTopicV1: new(p2ptypes.LightClientUpdatesByRangeReq),
 }
 
 // Maps all registered protocol prefixes.
@@ -119,6 +137,8 @@ var messageMapping = map[string]bool{
 	MetadataMessageName:            true,
 	BlobSidecarsByRangeName:        true,
 	BlobSidecarsByRootName:         true,
+	LightClientBootstrapName:       true,
+	LightClientUpdatesByRangeName:  true,
 }
 
 // Maps all the RPC messages which are to updated in altair.
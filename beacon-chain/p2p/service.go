@@ -6,6 +6,7 @@ package p2p
 import (
 	"context"
 	"crypto/ecdsa"
+	"path"
 	"sync"
 	"time"
 
@@ -52,6 +53,9 @@ const maxBadResponses = 5
 // gossipsub.
 const pubsubQueueSize = 600
 
+// reputationSaveInterval is how often peer reputations are saved to disk.
+const reputationSaveInterval = 10 * time.Minute
+
 // maxDialTimeout is the timeout for a single peer dial.
 var maxDialTimeout = params.BeaconNetworkConfig().RespTimeout
 
@@ -156,6 +160,17 @@ func NewService(ctx context.Context, cfg *Config) (*Service, error) {
 		},
 	})
 
+	// Peer reputations and access rules are kept across restarts in the data directory.
+	if s.cfg.DataDir != "" {
+		if err := s.peers.LoadReputation(path.Join(s.cfg.DataDir, peerReputationPath)); err != nil {
+			log.WithError(err).Warn("Could not restore peer reputations")
+		}
+		if err := s.peers.AccessList().Load(path.Join(s.cfg.DataDir, accessListPath)); err != nil {
+			log.WithError(err).Error("Failed to load peer access list")
+			return nil, err
+		}
+	}
+
 	// Initialize Data maps.
 	types.InitializeDataMaps()
 
@@ -228,6 +243,7 @@ func (s *Service) Start() {
 		ensurePeerConnections(s.ctx, s.host, s.peers, relayNodes...)
 	})
 	async.RunEvery(s.ctx, 30*time.Minute, s.Peers().Prune)
+	async.RunEvery(s.ctx, reputationSaveInterval, s.saveReputation)
 	async.RunEvery(s.ctx, params.BeaconNetworkConfig().RespTimeout, s.updateMetrics)
 	async.RunEvery(s.ctx, refreshRate, s.RefreshENR)
 	async.RunEvery(s.ctx, 1*time.Minute, func() {
@@ -263,9 +279,20 @@ func (s *Service) Stop() error {
 	if s.dv5Listener != nil {
 		s.dv5Listener.Close()
 	}
+	s.saveReputation()
 	return nil
 }
 
+// saveReputation saves peer reputations to the data directory, if any.
+func (s *Service) saveReputation() {
+	if s.cfg == nil || s.cfg.DataDir == "" || s.peers == nil {
+		return
+	}
+	if err := s.peers.SaveReputation(path.Join(s.cfg.DataDir, peerReputationPath)); err != nil {
+		log.WithError(err).Error("Could not save peer reputations")
+	}
+}
+
 // Status of the p2p service. Will return an error if the service is considered unhealthy to
 // indicate that this node should not serve traffic until the issue has been resolved.
 func (s *Service) Status() error {
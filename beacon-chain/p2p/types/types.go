@@ -207,6 +207,69 @@ func (s BlobSidecarsByRootReq) Len() int {
 	return len(s)
 }
 
+// LightClientBootstrapReq specifies the block root of a light client bootstrap request.
+type LightClientBootstrapReq [rootLength]byte
+
+// MarshalSSZTo marshals the light client bootstrap request with the provided byte slice.
+func (r *LightClientBootstrapReq) MarshalSSZTo(dst []byte) ([]byte, error) {
+	return append(dst, r[:]...), nil
+}
+
+// MarshalSSZ marshals the light client bootstrap request into the serialized object.
+func (r *LightClientBootstrapReq) MarshalSSZ() ([]byte, error) {
+	return r.MarshalSSZTo(make([]byte, 0, rootLength))
+}
+
+// SizeSSZ returns the size of the serialized representation.
+func (*LightClientBootstrapReq) SizeSSZ() int {
+	return rootLength
+}
+
+// UnmarshalSSZ unmarshals the provided bytes buffer into the
+// light client bootstrap request object.
+func (r *LightClientBootstrapReq) UnmarshalSSZ(buf []byte) error {
+	if len(buf) != rootLength {
+		return errors.Wrapf(ssz.ErrIncorrectByteSize, "size=%d", len(buf))
+	}
+	copy(r[:], buf)
+	return nil
+}
+
+// LightClientUpdatesByRangeReq specifies the sync committee periods of a light client updates by range request.
+type LightClientUpdatesByRangeReq struct {
+	StartPeriod uint64
+	Count       uint64
+}
+
+const lightClientUpdatesByRangeReqSize = 16
+
+// MarshalSSZTo marshals the light client updates by range request with the provided byte slice.
+func (r *LightClientUpdatesByRangeReq) MarshalSSZTo(dst []byte) ([]byte, error) {
+	dst = ssz.MarshalUint64(dst, r.StartPeriod)
+	return ssz.MarshalUint64(dst, r.Count), nil
+}
+
+// MarshalSSZ marshals the light client updates by range request into the serialized object.
+func (r *LightClientUpdatesByRangeReq) MarshalSSZ() ([]byte, error) {
+	return r.MarshalSSZTo(make([]byte, 0, lightClientUpdatesByRangeReqSize))
+}
+
+// SizeSSZ returns the size of the serialized representation.
+func (*LightClientUpdatesByRangeReq) SizeSSZ() int {
+	return lightClientUpdatesByRangeReqSize
+}
+
+// UnmarshalSSZ unmarshals the provided bytes buffer into the
+// light client updates by range request object.
+func (r *LightClientUpdatesByRangeReq) UnmarshalSSZ(buf []byte) error {
+	if len(buf) != lightClientUpdatesByRangeReqSize {
+		return errors.Wrapf(ssz.ErrIncorrectByteSize, "size=%d", len(buf))
+	}
+	r.StartPeriod = ssz.UnmarshallUint64(buf[0:8])
+	r.Count = ssz.UnmarshallUint64(buf[8:16])
+	return nil
+}
+
 func init() {
 	sizer := &eth.BlobIdentifier{}
 	blobIdSize = sizer.SizeSSZ()
@@ -80,6 +80,29 @@ func TestBlobSidecarsByRootReq_MarshalSSZ(t *testing.T) {
 	}
 }
 
+func TestLightClientReqs_MarshalSSZ(t *testing.T) {
+	t.Run("bootstrap", func(t *testing.T) {
+		r := LightClientBootstrapReq(bytesutil.ToBytes32([]byte("root")))
+		by, err := r.MarshalSSZ()
+		require.NoError(t, err)
+		require.Equal(t, rootLength, len(by))
+		got := LightClientBootstrapReq{}
+		require.NoError(t, got.UnmarshalSSZ(by))
+		require.Equal(t, r, got)
+		require.ErrorIs(t, got.UnmarshalSSZ(by[1:]), ssz.ErrIncorrectByteSize)
+	})
+	t.Run("updates by range", func(t *testing.T) {
+		r := &LightClientUpdatesByRangeReq{StartPeriod: 100, Count: 5}
+		by, err := r.MarshalSSZ()
+		require.NoError(t, err)
+		require.Equal(t, "64000000000000000500000000000000", hex.EncodeToString(by))
+		got := &LightClientUpdatesByRangeReq{}
+		require.NoError(t, got.UnmarshalSSZ(by))
+		require.DeepEqual(t, r, got)
+		require.ErrorIs(t, got.UnmarshalSSZ(append(by, 0)), ssz.ErrIncorrectByteSize)
+	})
+}
+
 func TestBeaconBlockByRootsReq_Limit(t *testing.T) {
 	fixedRoots := make([][32]byte, 0)
 	for i := uint64(0); i < params.BeaconNetworkConfig().MaxRequestBlocks+100; i++ {
@@ -27,6 +27,8 @@ import (
 
 const keyPath = "network-keys"
 const metaDataPath = "metaData"
+const peerReputationPath = "peer-reputation.json"
+const accessListPath = "peer-access-list.json"
 
 const dialTimeout = 1 * time.Second
 
@@ -111,6 +113,13 @@ func privKeyFromFile(path string) (*ecdsa.PrivateKey, error) {
 // from the p2p service.
 // TODO: Figure out how to do a v1/v2 check.
 func metaDataFromConfig(cfg *Config) (metadata.Metadata, error) {
+	// Without a data directory there is nowhere to persist default metadata, so keep it in memory only.
+	if cfg.DataDir == "" && cfg.MetaDataDir == "" {
+		return wrapper.WrappedMetadataV0(&pb.MetaDataV0{
+			SeqNumber: 0,
+			Attnets:   bitfield.NewBitvector64(),
+		}), nil
+	}
 	defaultKeyPath := path.Join(cfg.DataDir, metaDataPath)
 	metaDataPath := cfg.MetaDataDir
 
@@ -2,6 +2,8 @@ package p2p
 
 import (
 	"fmt"
+	"os"
+	"path"
 	"testing"
 
 	"github.com/ethereum/go-ethereum/crypto"
@@ -64,3 +66,21 @@ func TestSerializeENR(t *testing.T) {
 		assert.ErrorContains(t, "could not serialize nil record", err)
 	})
 }
+
+func TestMetaDataFromConfig_NoDataDir(t *testing.T) {
+	wd, err := os.Getwd()
+	require.NoError(t, err)
+	md, err := metaDataFromConfig(&Config{})
+	require.NoError(t, err)
+	assert.Equal(t, uint64(0), md.SequenceNumber())
+	_, err = os.Stat(path.Join(wd, metaDataPath))
+	assert.Equal(t, true, os.IsNotExist(err), "Metadata should not be written without a data directory")
+}
+
+func TestMetaDataFromConfig_PersistsToDataDir(t *testing.T) {
+	dir := t.TempDir()
+	_, err := metaDataFromConfig(&Config{DataDir: dir})
+	require.NoError(t, err)
+	_, err = os.Stat(path.Join(dir, metaDataPath))
+	require.NoError(t, err)
+}
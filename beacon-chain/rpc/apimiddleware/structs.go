@@ -1,11 +1,8 @@
 package apimiddleware
 
 import (
-	"strings"
-
 	"github.com/prysmaticlabs/prysm/v4/api/gateway/apimiddleware"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/rpc/eth/shared"
-	ethpbv2 "github.com/prysmaticlabs/prysm/v4/proto/eth/v2"
 )
 
 //----------------
@@ -21,107 +18,20 @@ type WeakSubjectivityResponse struct {
 	} `json:"data"`
 }
 
-type StateRootResponseJson struct {
-	Data                *StateRootResponse_StateRootJson `json:"data"`
-	ExecutionOptimistic bool                             `json:"execution_optimistic"`
-	Finalized           bool                             `json:"finalized"`
-}
-
-type StateRootResponse_StateRootJson struct {
-	StateRoot string `json:"root" hex:"true"`
-}
-
-type SyncCommitteesResponseJson struct {
-	Data                *SyncCommitteeValidatorsJson `json:"data"`
-	ExecutionOptimistic bool                         `json:"execution_optimistic"`
-	Finalized           bool                         `json:"finalized"`
-}
-
-type RandaoResponseJson struct {
-	Data *struct {
-		Randao string `json:"randao" hex:"true"`
-	} `json:"data"`
-	ExecutionOptimistic bool `json:"execution_optimistic"`
-	Finalized           bool `json:"finalized"`
-}
-
-type BlockResponseJson struct {
-	Data *SignedBeaconBlockJson `json:"data"`
-}
-
-type BlockV2ResponseJson struct {
-	Version             string                            `json:"version" enum:"true"`
-	Data                *SignedBeaconBlockContainerV2Json `json:"data"`
-	ExecutionOptimistic bool                              `json:"execution_optimistic"`
-	Finalized           bool                              `json:"finalized"`
-}
-
-type BlindedBlockResponseJson struct {
-	Version             string                                 `json:"version" enum:"true"`
-	Data                *SignedBlindedBeaconBlockContainerJson `json:"data"`
-	ExecutionOptimistic bool                                   `json:"execution_optimistic"`
-	Finalized           bool                                   `json:"finalized"`
-}
-
 type BlockRootResponseJson struct {
 	Data                *BlockRootContainerJson `json:"data"`
 	ExecutionOptimistic bool                    `json:"execution_optimistic"`
 	Finalized           bool                    `json:"finalized"`
 }
 
-type BlockAttestationsResponseJson struct {
-	Data                []*AttestationJson `json:"data"`
-	ExecutionOptimistic bool               `json:"execution_optimistic"`
-	Finalized           bool               `json:"finalized"`
-}
-
-type AttesterSlashingsPoolResponseJson struct {
-	Data []*AttesterSlashingJson `json:"data"`
-}
-
-type ProposerSlashingsPoolResponseJson struct {
-	Data []*ProposerSlashingJson `json:"data"`
-}
-
 type BLSToExecutionChangesPoolResponseJson struct {
 	Data []*SignedBLSToExecutionChangeJson `json:"data"`
 }
 
-type IdentityResponseJson struct {
-	Data *IdentityJson `json:"data"`
-}
-
-type PeersResponseJson struct {
-	Data []*PeerJson `json:"data"`
-}
-
-type PeerResponseJson struct {
-	Data *PeerJson `json:"data"`
-}
-
-type PeerCountResponseJson struct {
-	Data PeerCountResponse_PeerCountJson `json:"data"`
-}
-
-type PeerCountResponse_PeerCountJson struct {
-	Disconnected  string `json:"disconnected"`
-	Connecting    string `json:"connecting"`
-	Connected     string `json:"connected"`
-	Disconnecting string `json:"disconnecting"`
-}
-
-type VersionResponseJson struct {
-	Data *VersionJson `json:"data"`
-}
-
 type SyncingResponseJson struct {
 	Data *shared.SyncDetails `json:"data"`
 }
 
-type BeaconStateResponseJson struct {
-	Data *BeaconStateJson `json:"data"`
-}
-
 type BeaconStateV2ResponseJson struct {
 	Version             string                      `json:"version" enum:"true"`
 	Data                *BeaconStateContainerV2Json `json:"data"`
@@ -129,18 +39,6 @@ type BeaconStateV2ResponseJson struct {
 	Finalized           bool                        `json:"finalized"`
 }
 
-type ForkChoiceHeadsResponseJson struct {
-	Data []*ForkChoiceHeadJson `json:"data"`
-}
-
-type V2ForkChoiceHeadsResponseJson struct {
-	Data []*V2ForkChoiceHeadJson `json:"data"`
-}
-
-type ForkScheduleResponseJson struct {
-	Data []*ForkJson `json:"data"`
-}
-
 type DepositContractResponseJson struct {
 	Data *DepositContractJson `json:"data"`
 }
@@ -149,20 +47,6 @@ type SpecResponseJson struct {
 	Data interface{} `json:"data"`
 }
 
-type ProduceBlockResponseJson struct {
-	Data *BeaconBlockJson `json:"data"`
-}
-
-type ProduceBlockResponseV2Json struct {
-	Version string                      `json:"version" enum:"true"`
-	Data    *BeaconBlockContainerV2Json `json:"data"`
-}
-
-type ProduceBlindedBlockResponseJson struct {
-	Version string                           `json:"version" enum:"true"`
-	Data    *BlindedBeaconBlockContainerJson `json:"data"`
-}
-
 type AggregateAttestationResponseJson struct {
 	Data *AttestationJson `json:"data"`
 }
@@ -179,42 +63,6 @@ type ProduceSyncCommitteeContributionResponseJson struct {
 	Data *SyncCommitteeContributionJson `json:"data"`
 }
 
-type ForkChoiceNodeResponseJson struct {
-	Slot               string                       `json:"slot"`
-	BlockRoot          string                       `json:"block_root" hex:"true"`
-	ParentRoot         string                       `json:"parent_root" hex:"true"`
-	JustifiedEpoch     string                       `json:"justified_epoch"`
-	FinalizedEpoch     string                       `json:"finalized_epoch"`
-	Weight             string                       `json:"weight"`
-	Validity           string                       `json:"validity" enum:"true"`
-	ExecutionBlockHash string                       `json:"execution_block_hash" hex:"true"`
-	ExtraData          *ForkChoiceNodeExtraDataJson `json:"extra_data"`
-}
-
-type ForkChoiceNodeExtraDataJson struct {
-	UnrealizedJustifiedEpoch string `json:"unrealized_justified_epoch"`
-	UnrealizedFinalizedEpoch string `json:"unrealized_finalized_epoch"`
-	Balance                  string `json:"balance"`
-	ExecutionOptimistic      bool   `json:"execution_optimistic"`
-	TimeStamp                string `json:"timestamp"`
-}
-
-type ForkChoiceResponseJson struct {
-	JustifiedCheckpoint *CheckpointJson                  `json:"justified_checkpoint"`
-	FinalizedCheckpoint *CheckpointJson                  `json:"finalized_checkpoint"`
-	ForkChoiceNodes     []*ForkChoiceNodeResponseJson    `json:"fork_choice_nodes"`
-	ExtraData           *ForkChoiceResponseExtraDataJson `json:"extra_data"`
-}
-
-type ForkChoiceResponseExtraDataJson struct {
-	BestJustifiedCheckpoint       *CheckpointJson `json:"best_justified_checkpoint"`
-	UnrealizedJustifiedCheckpoint *CheckpointJson `json:"unrealized_justified_checkpoint"`
-	UnrealizedFinalizedCheckpoint *CheckpointJson `json:"unrealized_finalized_checkpoint"`
-	ProposerBoostRoot             string          `json:"proposer_boost_root" hex:"true"`
-	PreviousProposerBoostRoot     string          `json:"previous_proposer_boost_root" hex:"true"`
-	HeadRoot                      string          `json:"head_root" hex:"true"`
-}
-
 //----------------
 // Reusable types.
 //----------------
@@ -252,48 +100,6 @@ type BeaconBlockBodyJson struct {
 	VoluntaryExits    []*SignedVoluntaryExitJson `json:"voluntary_exits"`
 }
 
-type SignedBeaconBlockContainerV2Json struct {
-	Phase0Block    *BeaconBlockJson          `json:"phase0_block"`
-	AltairBlock    *BeaconBlockAltairJson    `json:"altair_block"`
-	BellatrixBlock *BeaconBlockBellatrixJson `json:"bellatrix_block"`
-	CapellaBlock   *BeaconBlockCapellaJson   `json:"capella_block"`
-	DenebBlock     *BeaconBlockDenebJson     `json:"deneb_block"`
-	Signature      string                    `json:"signature" hex:"true"`
-}
-
-type SignedBlindedBeaconBlockContainerJson struct {
-	Phase0Block    *BeaconBlockJson                 `json:"phase0_block"`
-	AltairBlock    *BeaconBlockAltairJson           `json:"altair_block"`
-	BellatrixBlock *BlindedBeaconBlockBellatrixJson `json:"bellatrix_block"`
-	CapellaBlock   *BlindedBeaconBlockCapellaJson   `json:"capella_block"`
-	DenebBlock     *BlindedBeaconBlockDenebJson     `json:"deneb_block"`
-	Signature      string                           `json:"signature" hex:"true"`
-}
-
-type SignedBlindedBeaconBlockContentsContainerJson struct {
-	Phase0Block    *SignedBeaconBlockJson                     `json:"phase0_block"`
-	AltairBlock    *SignedBeaconBlockAltairJson               `json:"altair_block"`
-	BellatrixBlock *SignedBlindedBeaconBlockBellatrixJson     `json:"bellatrix_block"`
-	CapellaBlock   *SignedBlindedBeaconBlockCapellaJson       `json:"capella_block"`
-	DenebContents  *SignedBlindedBeaconBlockContentsDenebJson `json:"deneb_contents"`
-}
-
-type BeaconBlockContainerV2Json struct {
-	Phase0Block    *BeaconBlockJson              `json:"phase0_block"`
-	AltairBlock    *BeaconBlockAltairJson        `json:"altair_block"`
-	BellatrixBlock *BeaconBlockBellatrixJson     `json:"bellatrix_block"`
-	CapellaBlock   *BeaconBlockCapellaJson       `json:"capella_block"`
-	DenebContents  *BeaconBlockContentsDenebJson `json:"deneb_contents"`
-}
-
-type BlindedBeaconBlockContainerJson struct {
-	Phase0Block    *BeaconBlockJson                     `json:"phase0_block"`
-	AltairBlock    *BeaconBlockAltairJson               `json:"altair_block"`
-	BellatrixBlock *BlindedBeaconBlockBellatrixJson     `json:"bellatrix_block"`
-	CapellaBlock   *BlindedBeaconBlockCapellaJson       `json:"capella_block"`
-	DenebContents  *BlindedBeaconBlockContentsDenebJson `json:"deneb_contents"`
-}
-
 type SignedBeaconBlockAltairJson struct {
 	Message   *BeaconBlockAltairJson `json:"message"`
 	Signature string                 `json:"signature" hex:"true"`
@@ -309,43 +115,6 @@ type SignedBeaconBlockCapellaJson struct {
 	Signature string                  `json:"signature" hex:"true"`
 }
 
-type SignedBeaconBlockContentsDenebJson struct {
-	SignedBlock        *SignedBeaconBlockDenebJson `json:"signed_block"`
-	SignedBlobSidecars []*SignedBlobSidecarJson    `json:"signed_blob_sidecars"`
-}
-
-type SignedBlobSidecarJson struct {
-	Message   *BlobSidecarJson `json:"message"`
-	Signature string           `json:"signature" hex:"true"`
-}
-
-type BlobSidecarJson struct {
-	BlockRoot       string `json:"block_root" hex:"true"`
-	Index           string `json:"index"`
-	Slot            string `json:"slot"`
-	BlockParentRoot string `json:"block_parent_root" hex:"true"`
-	ProposerIndex   string `json:"proposer_index"`
-	Blob            string `json:"blob" hex:"true"`                // pattern: "^0x[a-fA-F0-9]{262144}$"
-	KzgCommitment   string `json:"kzg_commitment" hex:"true"`      // pattern: "^0x[a-fA-F0-9]{96}$" ssz-size:"48"
-	KzgProof        string `json:"kzg_proof,omitempty" hex:"true"` // pattern: "^0x[a-fA-F0-9]{96}$" ssz-size:"48"
-}
-
-type BlindedBlobSidecarJson struct {
-	BlockRoot       string `json:"block_root" hex:"true"`
-	Index           string `json:"index"`
-	Slot            string `json:"slot"`
-	BlockParentRoot string `json:"block_parent_root" hex:"true"`
-	ProposerIndex   string `json:"proposer_index"`
-	BlobRoot        string `json:"blob_root" hex:"true"`
-	KzgCommitment   string `json:"kzg_commitment" hex:"true"`      // pattern: "^0x[a-fA-F0-9]{96}$" ssz-size:"48"
-	KzgProof        string `json:"kzg_proof,omitempty" hex:"true"` // pattern: "^0x[a-fA-F0-9]{96}$" ssz-size:"48"
-}
-
-type SignedBeaconBlockDenebJson struct {
-	Message   *BeaconBlockDenebJson `json:"message"`
-	Signature string                `json:"signature" hex:"true"`
-}
-
 type SignedBlindedBeaconBlockBellatrixJson struct {
 	Message   *BlindedBeaconBlockBellatrixJson `json:"message"`
 	Signature string                           `json:"signature" hex:"true"`
@@ -356,21 +125,6 @@ type SignedBlindedBeaconBlockCapellaJson struct {
 	Signature string                         `json:"signature" hex:"true"`
 }
 
-type SignedBlindedBeaconBlockContentsDenebJson struct {
-	SignedBlindedBlock        *SignedBlindedBeaconBlockDenebJson `json:"signed_blinded_block"`
-	SignedBlindedBlobSidecars []*SignedBlindedBlobSidecarJson    `json:"signed_blinded_blob_sidecars"`
-}
-
-type SignedBlindedBeaconBlockDenebJson struct {
-	Message   *BlindedBeaconBlockDenebJson `json:"message"`
-	Signature string                       `json:"signature" hex:"true"`
-}
-
-type SignedBlindedBlobSidecarJson struct {
-	Message   *BlindedBlobSidecarJson `json:"message"`
-	Signature string                  `json:"signature" hex:"true"`
-}
-
 type BeaconBlockAltairJson struct {
 	Slot          string                     `json:"slot"`
 	ProposerIndex string                     `json:"proposer_index"`
@@ -395,19 +149,6 @@ type BeaconBlockCapellaJson struct {
 	Body          *BeaconBlockBodyCapellaJson `json:"body"`
 }
 
-type BeaconBlockContentsDenebJson struct {
-	Block        *BeaconBlockDenebJson `json:"block"`
-	BlobSidecars []*BlobSidecarJson    `json:"blob_sidecars"`
-}
-
-type BeaconBlockDenebJson struct {
-	Slot          string                    `json:"slot"`
-	ProposerIndex string                    `json:"proposer_index"`
-	ParentRoot    string                    `json:"parent_root" hex:"true"`
-	StateRoot     string                    `json:"state_root" hex:"true"`
-	Body          *BeaconBlockBodyDenebJson `json:"body"`
-}
-
 type BlindedBeaconBlockBellatrixJson struct {
 	Slot          string                               `json:"slot"`
 	ProposerIndex string                               `json:"proposer_index"`
@@ -424,19 +165,6 @@ type BlindedBeaconBlockCapellaJson struct {
 	Body          *BlindedBeaconBlockBodyCapellaJson `json:"body"`
 }
 
-type BlindedBeaconBlockDenebJson struct {
-	Slot          string                           `json:"slot"`
-	ProposerIndex string                           `json:"proposer_index"`
-	ParentRoot    string                           `json:"parent_root" hex:"true"`
-	StateRoot     string                           `json:"state_root" hex:"true"`
-	Body          *BlindedBeaconBlockBodyDenebJson `json:"body"`
-}
-
-type BlindedBeaconBlockContentsDenebJson struct {
-	BlindedBlock        *BlindedBeaconBlockDenebJson `json:"blinded_block"`
-	BlindedBlobSidecars []*BlindedBlobSidecarJson    `json:"blinded_blob_sidecars"`
-}
-
 type BeaconBlockBodyAltairJson struct {
 	RandaoReveal      string                     `json:"randao_reveal" hex:"true"`
 	Eth1Data          *Eth1DataJson              `json:"eth1_data"`
@@ -476,21 +204,6 @@ type BeaconBlockBodyCapellaJson struct {
 	BLSToExecutionChanges []*SignedBLSToExecutionChangeJson `json:"bls_to_execution_changes"`
 }
 
-type BeaconBlockBodyDenebJson struct {
-	RandaoReveal          string                            `json:"randao_reveal" hex:"true"`
-	Eth1Data              *Eth1DataJson                     `json:"eth1_data"`
-	Graffiti              string                            `json:"graffiti" hex:"true"`
-	ProposerSlashings     []*ProposerSlashingJson           `json:"proposer_slashings"`
-	AttesterSlashings     []*AttesterSlashingJson           `json:"attester_slashings"`
-	Attestations          []*AttestationJson                `json:"attestations"`
-	Deposits              []*DepositJson                    `json:"deposits"`
-	VoluntaryExits        []*SignedVoluntaryExitJson        `json:"voluntary_exits"`
-	SyncAggregate         *SyncAggregateJson                `json:"sync_aggregate"`
-	ExecutionPayload      *ExecutionPayloadDenebJson        `json:"execution_payload"`
-	BLSToExecutionChanges []*SignedBLSToExecutionChangeJson `json:"bls_to_execution_changes"`
-	BlobKzgCommitments    []string                          `json:"blob_kzg_commitments" hex:"true"`
-}
-
 type BlindedBeaconBlockBodyBellatrixJson struct {
 	RandaoReveal           string                      `json:"randao_reveal" hex:"true"`
 	Eth1Data               *Eth1DataJson               `json:"eth1_data"`
@@ -518,21 +231,6 @@ type BlindedBeaconBlockBodyCapellaJson struct {
 	BLSToExecutionChanges  []*SignedBLSToExecutionChangeJson  `json:"bls_to_execution_changes"`
 }
 
-type BlindedBeaconBlockBodyDenebJson struct {
-	RandaoReveal           string                            `json:"randao_reveal" hex:"true"`
-	Eth1Data               *Eth1DataJson                     `json:"eth1_data"`
-	Graffiti               string                            `json:"graffiti" hex:"true"`
-	ProposerSlashings      []*ProposerSlashingJson           `json:"proposer_slashings"`
-	AttesterSlashings      []*AttesterSlashingJson           `json:"attester_slashings"`
-	Attestations           []*AttestationJson                `json:"attestations"`
-	Deposits               []*DepositJson                    `json:"deposits"`
-	VoluntaryExits         []*SignedVoluntaryExitJson        `json:"voluntary_exits"`
-	SyncAggregate          *SyncAggregateJson                `json:"sync_aggregate"`
-	ExecutionPayloadHeader *ExecutionPayloadHeaderDenebJson  `json:"execution_payload_header"`
-	BLSToExecutionChanges  []*SignedBLSToExecutionChangeJson `json:"bls_to_execution_changes"`
-	BlobKzgCommitments     []string                          `json:"blob_kzg_commitments" hex:"true"`
-}
-
 type ExecutionPayloadJson struct {
 	ParentHash    string   `json:"parent_hash" hex:"true"`
 	FeeRecipient  string   `json:"fee_recipient" hex:"true"`
@@ -568,26 +266,6 @@ type ExecutionPayloadCapellaJson struct {
 	Withdrawals   []*WithdrawalJson `json:"withdrawals"`
 }
 
-type ExecutionPayloadDenebJson struct {
-	ParentHash    string            `json:"parent_hash" hex:"true"`
-	FeeRecipient  string            `json:"fee_recipient" hex:"true"`
-	StateRoot     string            `json:"state_root" hex:"true"`
-	ReceiptsRoot  string            `json:"receipts_root" hex:"true"`
-	LogsBloom     string            `json:"logs_bloom" hex:"true"`
-	PrevRandao    string            `json:"prev_randao" hex:"true"`
-	BlockNumber   string            `json:"block_number"`
-	GasLimit      string            `json:"gas_limit"`
-	GasUsed       string            `json:"gas_used"`
-	TimeStamp     string            `json:"timestamp"`
-	ExtraData     string            `json:"extra_data" hex:"true"`
-	BaseFeePerGas string            `json:"base_fee_per_gas" uint256:"true"`
-	BlobGasUsed   string            `json:"blob_gas_used"`   // new in deneb
-	ExcessBlobGas string            `json:"excess_blob_gas"` // new in deneb
-	BlockHash     string            `json:"block_hash" hex:"true"`
-	Transactions  []string          `json:"transactions" hex:"true"`
-	Withdrawals   []*WithdrawalJson `json:"withdrawals"`
-}
-
 type ExecutionPayloadHeaderJson struct {
 	ParentHash       string `json:"parent_hash" hex:"true"`
 	FeeRecipient     string `json:"fee_recipient" hex:"true"`
@@ -708,10 +386,6 @@ type BLSToExecutionChangeJson struct {
 	ToExecutionAddress string `json:"to_execution_address" hex:"true"`
 }
 
-type SubmitBLSToExecutionChangesRequest struct {
-	Changes []*SignedBLSToExecutionChangeJson `json:"changes"`
-}
-
 type DepositJson struct {
 	Proof []string          `json:"proof" hex:"true"`
 	Data  *Deposit_DataJson `json:"data"`
@@ -734,31 +408,6 @@ type VoluntaryExitJson struct {
 	ValidatorIndex string `json:"validator_index"`
 }
 
-type IdentityJson struct {
-	PeerId             string        `json:"peer_id"`
-	Enr                string        `json:"enr"`
-	P2PAddresses       []string      `json:"p2p_addresses"`
-	DiscoveryAddresses []string      `json:"discovery_addresses"`
-	Metadata           *MetadataJson `json:"metadata"`
-}
-
-type MetadataJson struct {
-	SeqNumber string `json:"seq_number"`
-	Attnets   string `json:"attnets" hex:"true"`
-}
-
-type PeerJson struct {
-	PeerId    string `json:"peer_id"`
-	Enr       string `json:"enr"`
-	Address   string `json:"last_seen_p2p_address"`
-	State     string `json:"state" enum:"true"`
-	Direction string `json:"direction" enum:"true"`
-}
-
-type VersionJson struct {
-	Version string `json:"version" enum:"true"`
-}
-
 type WithdrawalJson struct {
 	WithdrawalIndex  string `json:"index"`
 	ValidatorIndex   string `json:"validator_index"`
@@ -937,11 +586,6 @@ type SyncCommitteeJson struct {
 	AggregatePubkey string   `json:"aggregate_pubkey" hex:"true"`
 }
 
-type SyncCommitteeValidatorsJson struct {
-	Validators          []string   `json:"validators"`
-	ValidatorAggregates [][]string `json:"validator_aggregates"`
-}
-
 type PendingAttestationJson struct {
 	AggregationBits string               `json:"aggregation_bits" hex:"true"`
 	Data            *AttestationDataJson `json:"data"`
@@ -949,17 +593,6 @@ type PendingAttestationJson struct {
 	ProposerIndex   string               `json:"proposer_index"`
 }
 
-type ForkChoiceHeadJson struct {
-	Root string `json:"root" hex:"true"`
-	Slot string `json:"slot"`
-}
-
-type V2ForkChoiceHeadJson struct {
-	Root                string `json:"root" hex:"true"`
-	Slot                string `json:"slot"`
-	ExecutionOptimistic bool   `json:"execution_optimistic"`
-}
-
 type DepositContractJson struct {
 	ChainId string `json:"chain_id"`
 	Address string `json:"address"`
@@ -995,187 +628,11 @@ type SyncCommitteeContributionJson struct {
 	Signature         string `json:"signature" hex:"true"`
 }
 
-type ForkChoiceNodeJson struct {
-	Slot                     string `json:"slot"`
-	BlockRoot                string `json:"block_root" hex:"true"`
-	ParentRoot               string `json:"parent_root" hex:"true"`
-	JustifiedEpoch           string `json:"justified_epoch"`
-	FinalizedEpoch           string `json:"finalized_epoch"`
-	UnrealizedJustifiedEpoch string `json:"unrealized_justified_epoch"`
-	UnrealizedFinalizedEpoch string `json:"unrealized_finalized_epoch"`
-	Balance                  string `json:"balance"`
-	Weight                   string `json:"weight"`
-	ExecutionOptimistic      bool   `json:"execution_optimistic"`
-	ExecutionBlockHash       string `json:"execution_block_hash" hex:"true"`
-	TimeStamp                string `json:"timestamp"`
-	Validity                 string `json:"validity" enum:"true"`
-}
-
-type ForkChoiceDumpJson struct {
-	JustifiedCheckpoint           *CheckpointJson       `json:"justified_checkpoint"`
-	FinalizedCheckpoint           *CheckpointJson       `json:"finalized_checkpoint"`
-	BestJustifiedCheckpoint       *CheckpointJson       `json:"best_justified_checkpoint"`
-	UnrealizedJustifiedCheckpoint *CheckpointJson       `json:"unrealized_justified_checkpoint"`
-	UnrealizedFinalizedCheckpoint *CheckpointJson       `json:"unrealized_finalized_checkpoint"`
-	ProposerBoostRoot             string                `json:"proposer_boost_root" hex:"true"`
-	PreviousProposerBoostRoot     string                `json:"previous_proposer_boost_root" hex:"true"`
-	HeadRoot                      string                `json:"head_root" hex:"true"`
-	ForkChoiceNodes               []*ForkChoiceNodeJson `json:"fork_choice_nodes"`
-}
-
 type HistoricalSummaryJson struct {
 	BlockSummaryRoot string `json:"block_summary_root" hex:"true"`
 	StateSummaryRoot string `json:"state_summary_root" hex:"true"`
 }
 
-//----------------
-// SSZ
-// ---------------
-
-type SszRequestJson struct {
-	Data string `json:"data"`
-}
-
-// SszResponse is a common abstraction over all SSZ responses.
-type SszResponse interface {
-	SSZVersion() string
-	SSZOptimistic() bool
-	SSZData() string
-	SSZFinalized() bool
-}
-
-type SszResponseJson struct {
-	Data string `json:"data"`
-}
-
-func (ssz *SszResponseJson) SSZData() string {
-	return ssz.Data
-}
-
-func (*SszResponseJson) SSZVersion() string {
-	return strings.ToLower(ethpbv2.Version_PHASE0.String())
-}
-
-func (*SszResponseJson) SSZOptimistic() bool {
-	return false
-}
-
-func (*SszResponseJson) SSZFinalized() bool {
-	return true
-}
-
-type VersionedSSZResponseJson struct {
-	Version             string `json:"version" enum:"true"`
-	ExecutionOptimistic bool   `json:"execution_optimistic"`
-	Finalized           bool   `json:"finalized"`
-	Data                string `json:"data"`
-}
-
-func (ssz *VersionedSSZResponseJson) SSZData() string {
-	return ssz.Data
-}
-
-func (ssz *VersionedSSZResponseJson) SSZVersion() string {
-	return ssz.Version
-}
-
-func (ssz *VersionedSSZResponseJson) SSZOptimistic() bool {
-	return ssz.ExecutionOptimistic
-}
-
-func (ssz *VersionedSSZResponseJson) SSZFinalized() bool {
-	return ssz.Finalized
-}
-
-// ---------------
-// Events.
-// ---------------
-
-type EventHeadJson struct {
-	Slot                      string `json:"slot"`
-	Block                     string `json:"block" hex:"true"`
-	State                     string `json:"state" hex:"true"`
-	EpochTransition           bool   `json:"epoch_transition"`
-	ExecutionOptimistic       bool   `json:"execution_optimistic"`
-	PreviousDutyDependentRoot string `json:"previous_duty_dependent_root" hex:"true"`
-	CurrentDutyDependentRoot  string `json:"current_duty_dependent_root" hex:"true"`
-}
-
-type ReceivedBlockDataJson struct {
-	Slot                string `json:"slot"`
-	Block               string `json:"block" hex:"true"`
-	ExecutionOptimistic bool   `json:"execution_optimistic"`
-}
-
-type AggregatedAttReceivedDataJson struct {
-	Aggregate *AttestationJson `json:"aggregate"`
-}
-
-type UnaggregatedAttReceivedDataJson struct {
-	AggregationBits string               `json:"aggregation_bits" hex:"true"`
-	Data            *AttestationDataJson `json:"data"`
-	Signature       string               `json:"signature" hex:"true"`
-}
-
-type EventFinalizedCheckpointJson struct {
-	Block               string `json:"block" hex:"true"`
-	State               string `json:"state" hex:"true"`
-	Epoch               string `json:"epoch"`
-	ExecutionOptimistic bool   `json:"execution_optimistic"`
-}
-
-type EventChainReorgJson struct {
-	Slot                string `json:"slot"`
-	Depth               string `json:"depth"`
-	OldHeadBlock        string `json:"old_head_block" hex:"true"`
-	NewHeadBlock        string `json:"old_head_state" hex:"true"`
-	OldHeadState        string `json:"new_head_block" hex:"true"`
-	NewHeadState        string `json:"new_head_state" hex:"true"`
-	Epoch               string `json:"epoch"`
-	ExecutionOptimistic bool   `json:"execution_optimistic"`
-}
-
-type EventPayloadAttributeStreamV1Json struct {
-	Version string `json:"version"`
-	Data    *EventPayloadAttributeV1Json
-}
-
-type EventPayloadAttributeStreamV2Json struct {
-	Version string                       `json:"version"`
-	Data    *EventPayloadAttributeV2Json `json:"data"`
-}
-
-type EventPayloadAttributeV1Json struct {
-	ProposerIndex     string                   `json:"proposer_index"`
-	ProposalSlot      string                   `json:"proposal_slot"`
-	ParentBlockNumber string                   `json:"parent_block_number"`
-	ParentBlockRoot   string                   `json:"parent_block_root" hex:"true"`
-	ParentBlockHash   string                   `json:"parent_block_hash" hex:"true"`
-	PayloadAttributes *PayloadAttributesV1Json `json:"payload_attributes"`
-}
-
-type EventPayloadAttributeV2Json struct {
-	ProposerIndex     string                   `json:"proposer_index"`
-	ProposalSlot      string                   `json:"proposal_slot"`
-	ParentBlockNumber string                   `json:"parent_block_number"`
-	ParentBlockRoot   string                   `json:"parent_block_root" hex:"true"`
-	ParentBlockHash   string                   `json:"parent_block_hash" hex:"true"`
-	PayloadAttributes *PayloadAttributesV2Json `json:"payload_attributes"`
-}
-
-type PayloadAttributesV1Json struct {
-	Timestamp             string `json:"timestamp"`
-	Random                string `json:"prev_randao" hex:"true"`
-	SuggestedFeeRecipient string `json:"suggested_fee_recipient" hex:"true"`
-}
-
-type PayloadAttributesV2Json struct {
-	Timestamp             string            `json:"timestamp"`
-	Random                string            `json:"prev_randao" hex:"true"`
-	SuggestedFeeRecipient string            `json:"suggested_fee_recipient" hex:"true"`
-	Withdrawals           []*WithdrawalJson `json:"withdrawals"`
-}
-
 // ---------------
 // Error handling.
 // ---------------
@@ -1191,8 +648,3 @@ type SingleIndexedVerificationFailureJson struct {
 	Index   int    `json:"index"`
 	Message string `json:"message"`
 }
-
-type EventErrorJson struct {
-	StatusCode int    `json:"status_code"`
-	Message    string `json:"message"`
-}
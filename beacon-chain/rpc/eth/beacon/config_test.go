@@ -1,18 +1,22 @@
 package beacon
 
 import (
-	"context"
+	"bytes"
 	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/prysmaticlabs/prysm/v4/config/params"
 	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/v4/encoding/bytesutil"
 	"github.com/prysmaticlabs/prysm/v4/network/forks"
 	"github.com/prysmaticlabs/prysm/v4/testing/assert"
 	"github.com/prysmaticlabs/prysm/v4/testing/require"
-	"google.golang.org/protobuf/types/known/emptypb"
 )
 
 func TestGetSpec(t *testing.T) {
@@ -138,8 +142,14 @@ func TestGetSpec(t *testing.T) {
 	params.OverrideBeaconConfig(config)
 
 	server := &Server{}
-	resp, err := server.GetSpec(context.Background(), &emptypb.Empty{})
-	require.NoError(t, err)
+	request := httptest.NewRequest(http.MethodGet, "http://example.com/eth/v1/config/spec", nil)
+	writer := httptest.NewRecorder()
+	writer.Body = &bytes.Buffer{}
+
+	server.GetSpec(writer, request)
+	require.Equal(t, http.StatusOK, writer.Code)
+	resp := &GetSpecResponse{}
+	require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
 
 	assert.Equal(t, 112, len(resp.Data))
 	for k, v := range resp.Data {
@@ -406,28 +416,40 @@ func TestForkSchedule_Ok(t *testing.T) {
 	config.ForkVersionSchedule = schedule
 	params.OverrideBeaconConfig(config)
 
+	request := httptest.NewRequest(http.MethodGet, "http://example.com/eth/v1/config/fork_schedule", nil)
+	writer := httptest.NewRecorder()
+	writer.Body = &bytes.Buffer{}
+
 	s := &Server{}
-	resp, err := s.GetForkSchedule(context.Background(), &emptypb.Empty{})
-	require.NoError(t, err)
+	s.GetForkSchedule(writer, request)
+	require.Equal(t, http.StatusOK, writer.Code)
+	resp := &GetForkScheduleResponse{}
+	require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
 	require.Equal(t, 3, len(resp.Data))
 	fork := resp.Data[0]
-	assert.DeepEqual(t, genesisForkVersion, fork.PreviousVersion)
-	assert.DeepEqual(t, string(firstForkVersion), string(fork.CurrentVersion))
-	assert.Equal(t, firstForkEpoch, fork.Epoch)
+	assert.DeepEqual(t, hexutil.Encode(genesisForkVersion), fork.PreviousVersion)
+	assert.DeepEqual(t, hexutil.Encode(firstForkVersion), fork.CurrentVersion)
+	assert.DeepEqual(t, fmt.Sprintf("%d", firstForkEpoch), fork.Epoch)
 	fork = resp.Data[1]
-	assert.DeepEqual(t, firstForkVersion, fork.PreviousVersion)
-	assert.DeepEqual(t, secondForkVersion, fork.CurrentVersion)
-	assert.Equal(t, secondForkEpoch, fork.Epoch)
+	assert.DeepEqual(t, hexutil.Encode(firstForkVersion), fork.PreviousVersion)
+	assert.DeepEqual(t, hexutil.Encode(secondForkVersion), fork.CurrentVersion)
+	assert.DeepEqual(t, fmt.Sprintf("%d", secondForkEpoch), fork.Epoch)
 	fork = resp.Data[2]
-	assert.DeepEqual(t, secondForkVersion, fork.PreviousVersion)
-	assert.DeepEqual(t, thirdForkVersion, fork.CurrentVersion)
-	assert.Equal(t, thirdForkEpoch, fork.Epoch)
+	assert.DeepEqual(t, hexutil.Encode(secondForkVersion), fork.PreviousVersion)
+	assert.DeepEqual(t, hexutil.Encode(thirdForkVersion), fork.CurrentVersion)
+	assert.DeepEqual(t, fmt.Sprintf("%d", thirdForkEpoch), fork.Epoch)
 }
 
 func TestForkSchedule_CorrectNumberOfForks(t *testing.T) {
+	request := httptest.NewRequest(http.MethodGet, "http://example.com/eth/v1/config/fork_schedule", nil)
+	writer := httptest.NewRecorder()
+	writer.Body = &bytes.Buffer{}
+
 	s := &Server{}
-	resp, err := s.GetForkSchedule(context.Background(), &emptypb.Empty{})
-	require.NoError(t, err)
+	s.GetForkSchedule(writer, request)
+	require.Equal(t, http.StatusOK, writer.Code)
+	resp := &GetForkScheduleResponse{}
+	require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
 	os := forks.NewOrderedSchedule(params.BeaconConfig())
 	assert.Equal(t, os.Len(), len(resp.Data))
 }
@@ -536,6 +536,302 @@ func (s *Server) validateEquivocation(blk interfaces.ReadOnlyBeaconBlock) error
 	return nil
 }
 
+// GetWeakSubjectivity computes the starting epoch of the current weak subjectivity period, and then also
+// determines the best block root and state root to use for a Checkpoint Sync starting from that point.
+func (s *Server) GetWeakSubjectivity(w http.ResponseWriter, r *http.Request) {
+	ctx, span := trace.StartSpan(r.Context(), "beacon.GetWeakSubjectivity")
+	defer span.End()
+
+	if shared.IsSyncing(ctx, w, s.SyncChecker, s.HeadFetcher, s.GenesisTimeFetcher, s.OptimisticModeFetcher) {
+		return
+	}
+
+	hs, err := s.HeadFetcher.HeadStateReadOnly(ctx)
+	if err != nil {
+		http2.HandleError(w, "Could not get head state: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	wsEpoch, err := corehelpers.LatestWeakSubjectivityEpoch(ctx, hs, params.BeaconConfig())
+	if err != nil {
+		http2.HandleError(w, "Could not get weak subjectivity epoch: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	wsSlot, err := slots.EpochStart(wsEpoch)
+	if err != nil {
+		http2.HandleError(w, "Could not get weak subjectivity slot: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	cbr, err := s.CanonicalHistory.BlockRootForSlot(ctx, wsSlot)
+	if err != nil {
+		http2.HandleError(w, fmt.Sprintf("Could not find highest block below slot %d: %s", wsSlot, err.Error()), http.StatusInternalServerError)
+		return
+	}
+	cb, err := s.BeaconDB.Block(ctx, cbr)
+	if err != nil {
+		http2.HandleError(w, fmt.Sprintf("Could not get block with root %#x from slot index %d: %s", cbr, wsSlot, err.Error()), http.StatusInternalServerError)
+		return
+	}
+	if err = blocks.BeaconBlockIsNil(cb); err != nil {
+		http2.HandleError(w, fmt.Sprintf("Block with root %#x from slot index %d not found in db", cbr, wsSlot), http.StatusInternalServerError)
+		return
+	}
+	stateRoot := cb.Block().StateRoot()
+	log.Printf("weak subjectivity checkpoint reported as epoch=%d, block root=%#x, state root=%#x", wsEpoch, cbr, stateRoot)
+
+	resp := &GetWeakSubjectivityResponse{
+		Data: &WeakSubjectivityData{
+			WsCheckpoint: &shared.Checkpoint{
+				Epoch: strconv.FormatUint(uint64(wsEpoch), 10),
+				Root:  hexutil.Encode(cbr[:]),
+			},
+			StateRoot: hexutil.Encode(stateRoot[:]),
+		},
+	}
+	http2.WriteJson(w, resp)
+}
+
+// GetBlock retrieves block details for given block ID.
+// DEPRECATED: please use GetBlockV2 instead
+func (s *Server) GetBlock(w http.ResponseWriter, r *http.Request) {
+	ctx, span := trace.StartSpan(r.Context(), "beacon.GetBlock")
+	defer span.End()
+
+	blk, ok := s.blockFromRequest(ctx, w, r)
+	if !ok {
+		return
+	}
+	if blk.Version() != version.Phase0 {
+		http2.HandleError(w, fmt.Sprintf("Block version %s is not supported by this endpoint, use the v2 endpoint instead", version.String(blk.Version())), http.StatusBadRequest)
+		return
+	}
+	pbBlk, err := blk.PbPhase0Block()
+	if err != nil {
+		http2.HandleError(w, "Could not get signed beacon block: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	if http2.SszRequested(r) {
+		sszBlk, err := pbBlk.MarshalSSZ()
+		if err != nil {
+			http2.HandleError(w, "Could not marshal block into SSZ: "+err.Error(), http.StatusInternalServerError)
+			return
+		}
+		http2.WriteSsz(w, sszBlk, "beacon_block.ssz")
+		return
+	}
+
+	msg, err := shared.BeaconBlockFromConsensus(pbBlk.Block)
+	if err != nil {
+		http2.HandleError(w, "Could not convert block: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	resp := &GetBlockResponse{
+		Data: &shared.SignedBeaconBlock{
+			Message:   msg,
+			Signature: hexutil.Encode(pbBlk.Signature),
+		},
+	}
+	http2.WriteJson(w, resp)
+}
+
+// GetBlockV2 retrieves block details for given block ID.
+func (s *Server) GetBlockV2(w http.ResponseWriter, r *http.Request) {
+	ctx, span := trace.StartSpan(r.Context(), "beacon.GetBlockV2")
+	defer span.End()
+
+	blk, ok := s.blockFromRequest(ctx, w, r)
+	if !ok {
+		return
+	}
+	if blk.IsBlinded() {
+		fullBlk, err := s.ExecutionPayloadReconstructor.ReconstructFullBlock(ctx, blk)
+		if err != nil {
+			http2.HandleError(w, "Could not reconstruct full execution payload to create signed beacon block: "+err.Error(), http.StatusInternalServerError)
+			return
+		}
+		blk = fullBlk
+	}
+	s.writeBlock(ctx, w, r, blk)
+}
+
+// GetBlindedBlock retrieves blinded block for given block id.
+func (s *Server) GetBlindedBlock(w http.ResponseWriter, r *http.Request) {
+	ctx, span := trace.StartSpan(r.Context(), "beacon.GetBlindedBlock")
+	defer span.End()
+
+	blk, ok := s.blockFromRequest(ctx, w, r)
+	if !ok {
+		return
+	}
+	if !blk.IsBlinded() && blk.Version() >= version.Bellatrix {
+		blindedBlk, err := blk.ToBlinded()
+		if err != nil {
+			http2.HandleError(w, "Could not convert block to blinded block: "+err.Error(), http.StatusInternalServerError)
+			return
+		}
+		blk = blindedBlk
+	}
+	s.writeBlock(ctx, w, r, blk)
+}
+
+// GetBlockAttestations retrieves attestation included in requested block.
+func (s *Server) GetBlockAttestations(w http.ResponseWriter, r *http.Request) {
+	ctx, span := trace.StartSpan(r.Context(), "beacon.GetBlockAttestations")
+	defer span.End()
+
+	blk, ok := s.blockFromRequest(ctx, w, r)
+	if !ok {
+		return
+	}
+	atts, err := shared.AttsFromConsensus(blk.Block().Body().Attestations())
+	if err != nil {
+		http2.HandleError(w, "Could not convert attestations: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	root, err := blk.Block().HashTreeRoot()
+	if err != nil {
+		http2.HandleError(w, "Could not get block root: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	isOptimistic, err := s.OptimisticModeFetcher.IsOptimisticForRoot(ctx, root)
+	if err != nil {
+		http2.HandleError(w, "Could not check if block is optimistic: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	resp := &GetBlockAttestationsResponse{
+		Data:                atts,
+		ExecutionOptimistic: isOptimistic,
+		Finalized:           s.FinalizationFetcher.IsFinalized(ctx, root),
+	}
+	http2.WriteJson(w, resp)
+}
+
+// blockFromRequest fetches the block referenced by the block_id URL parameter.
+// It writes an error response and returns false when the block cannot be fetched.
+func (s *Server) blockFromRequest(ctx context.Context, w http.ResponseWriter, r *http.Request) (interfaces.ReadOnlySignedBeaconBlock, bool) {
+	blockId := mux.Vars(r)["block_id"]
+	if blockId == "" {
+		http2.HandleError(w, "block_id is required in URL params", http.StatusBadRequest)
+		return nil, false
+	}
+	blk, err := s.Blocker.Block(ctx, []byte(blockId))
+	if !shared.WriteBlockFetchError(w, blk, err) {
+		return nil, false
+	}
+	return blk, true
+}
+
+// writeBlock writes the block either as SSZ or as JSON, depending on the request's Accept header.
+func (s *Server) writeBlock(ctx context.Context, w http.ResponseWriter, r *http.Request, blk interfaces.ReadOnlySignedBeaconBlock) {
+	w.Header().Set(api.VersionHeader, version.String(blk.Version()))
+
+	if http2.SszRequested(r) {
+		sszBlk, err := blk.MarshalSSZ()
+		if err != nil {
+			http2.HandleError(w, "Could not marshal block into SSZ: "+err.Error(), http.StatusInternalServerError)
+			return
+		}
+		http2.WriteSsz(w, sszBlk, "beacon_block.ssz")
+		return
+	}
+
+	blkRoot, err := blk.Block().HashTreeRoot()
+	if err != nil {
+		http2.HandleError(w, "Could not get block root: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	isOptimistic := false
+	if blk.Version() >= version.Bellatrix {
+		isOptimistic, err = s.OptimisticModeFetcher.IsOptimisticForRoot(ctx, blkRoot)
+		if err != nil {
+			http2.HandleError(w, "Could not check if block is optimistic: "+err.Error(), http.StatusInternalServerError)
+			return
+		}
+	}
+	msg, err := blockMessageToJson(blk)
+	if err != nil {
+		http2.HandleError(w, "Could not convert block: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	jsonMsg, err := json.Marshal(msg)
+	if err != nil {
+		http2.HandleError(w, "Could not marshal block: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	sig := blk.Signature()
+
+	resp := &GetBlockV2Response{
+		Version:             version.String(blk.Version()),
+		ExecutionOptimistic: isOptimistic,
+		Finalized:           s.FinalizationFetcher.IsFinalized(ctx, blkRoot),
+		Data: &SignedBlock{
+			Message:   jsonMsg,
+			Signature: hexutil.Encode(sig[:]),
+		},
+	}
+	http2.WriteJson(w, resp)
+}
+
+// blockMessageToJson converts the message of a signed block into the JSON struct matching its version.
+func blockMessageToJson(blk interfaces.ReadOnlySignedBeaconBlock) (interface{}, error) {
+	switch blk.Version() {
+	case version.Phase0:
+		pbBlk, err := blk.PbPhase0Block()
+		if err != nil {
+			return nil, err
+		}
+		return shared.BeaconBlockFromConsensus(pbBlk.Block)
+	case version.Altair:
+		pbBlk, err := blk.PbAltairBlock()
+		if err != nil {
+			return nil, err
+		}
+		return shared.BeaconBlockAltairFromConsensus(pbBlk.Block)
+	case version.Bellatrix:
+		if blk.IsBlinded() {
+			pbBlk, err := blk.PbBlindedBellatrixBlock()
+			if err != nil {
+				return nil, err
+			}
+			return shared.BlindedBeaconBlockBellatrixFromConsensus(pbBlk.Block)
+		}
+		pbBlk, err := blk.PbBellatrixBlock()
+		if err != nil {
+			return nil, err
+		}
+		return shared.BeaconBlockBellatrixFromConsensus(pbBlk.Block)
+	case version.Capella:
+		if blk.IsBlinded() {
+			pbBlk, err := blk.PbBlindedCapellaBlock()
+			if err != nil {
+				return nil, err
+			}
+			return shared.BlindedBeaconBlockCapellaFromConsensus(pbBlk.Block)
+		}
+		pbBlk, err := blk.PbCapellaBlock()
+		if err != nil {
+			return nil, err
+		}
+		return shared.BeaconBlockCapellaFromConsensus(pbBlk.Block)
+	case version.Deneb:
+		if blk.IsBlinded() {
+			pbBlk, err := blk.PbBlindedDenebBlock()
+			if err != nil {
+				return nil, err
+			}
+			return shared.BlindedBeaconBlockDenebFromConsensus(pbBlk.Message)
+		}
+		pbBlk, err := blk.PbDenebBlock()
+		if err != nil {
+			return nil, err
+		}
+		return shared.BeaconBlockDenebFromConsensus(pbBlk.Block)
+	default:
+		return nil, fmt.Errorf("unsupported block version %s", version.String(blk.Version()))
+	}
+}
+
 // GetBlockRoot retrieves the root of a block.
 func (s *Server) GetBlockRoot(w http.ResponseWriter, r *http.Request) {
 	ctx, span := trace.StartSpan(r.Context(), "beacon.GetBlockRoot")
@@ -801,6 +1097,24 @@ func (*Server) GetDepositContract(w http.ResponseWriter, r *http.Request) {
 	})
 }
 
+// GetDepositSnapshot retrieves the EIP-4881 snapshot of the finalized deposit tree, which allows
+// syncing nodes to start processing deposit logs from the snapshot's execution block.
+func (s *Server) GetDepositSnapshot(w http.ResponseWriter, r *http.Request) {
+	ctx, span := trace.StartSpan(r.Context(), "beacon.GetDepositSnapshot")
+	defer span.End()
+
+	eth1Data, err := s.BeaconDB.ExecutionChainData(ctx)
+	if err != nil {
+		http2.HandleError(w, "Could not retrieve execution chain data: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if eth1Data == nil || eth1Data.DepositSnapshot == nil || eth1Data.DepositSnapshot.DepositCount == 0 {
+		http2.HandleError(w, "No deposit snapshot available", http.StatusNotFound)
+		return
+	}
+	http2.WriteJson(w, &GetDepositSnapshotResponse{Data: shared.DepositSnapshotFromConsensus(eth1Data.DepositSnapshot)})
+}
+
 // GetBlockHeaders retrieves block headers matching given query. By default it will fetch current head slot blocks.
 func (s *Server) GetBlockHeaders(w http.ResponseWriter, r *http.Request) {
 	ctx, span := trace.StartSpan(r.Context(), "beacon.GetBlockHeaders")
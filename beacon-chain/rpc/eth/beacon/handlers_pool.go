@@ -1,6 +1,7 @@
 package beacon
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -9,6 +10,7 @@ import (
 	"strconv"
 	"strings"
 
+	fssz "github.com/prysmaticlabs/fastssz"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/core/blocks"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/core/feed"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/core/feed/operation"
@@ -16,11 +18,14 @@ import (
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/core/transition"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/rpc/core"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/rpc/eth/shared"
+	"github.com/prysmaticlabs/prysm/v4/config/features"
 	consensus_types "github.com/prysmaticlabs/prysm/v4/consensus-types"
 	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/v4/crypto/bls"
 	http2 "github.com/prysmaticlabs/prysm/v4/network/http"
+	"github.com/prysmaticlabs/prysm/v4/proto/migration"
 	ethpbalpha "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v4/runtime/version"
 	"github.com/prysmaticlabs/prysm/v4/time/slots"
 	"go.opencensus.io/trace"
 )
@@ -48,26 +53,34 @@ func (s *Server) ListAttestations(w http.ResponseWriter, r *http.Request) {
 	}
 	attestations = append(attestations, unaggAtts...)
 	isEmptyReq := rawSlot == "" && rawCommitteeIndex == ""
-	if isEmptyReq {
-		allAtts := make([]*shared.Attestation, len(attestations))
-		for i, att := range attestations {
-			allAtts[i] = shared.AttestationFromConsensus(att)
+	if !isEmptyReq {
+		bothDefined := rawSlot != "" && rawCommitteeIndex != ""
+		filteredAtts := make([]*ethpbalpha.Attestation, 0, len(attestations))
+		for _, att := range attestations {
+			committeeIndexMatch := rawCommitteeIndex != "" && att.Data.CommitteeIndex == primitives.CommitteeIndex(committeeIndex)
+			slotMatch := rawSlot != "" && att.Data.Slot == primitives.Slot(slot)
+			shouldAppend := (bothDefined && committeeIndexMatch && slotMatch) || (!bothDefined && (committeeIndexMatch || slotMatch))
+			if shouldAppend {
+				filteredAtts = append(filteredAtts, att)
+			}
 		}
-		http2.WriteJson(w, &ListAttestationsResponse{Data: allAtts})
-		return
+		attestations = filteredAtts
 	}
 
-	bothDefined := rawSlot != "" && rawCommitteeIndex != ""
-	filteredAtts := make([]*shared.Attestation, 0, len(attestations))
-	for _, att := range attestations {
-		committeeIndexMatch := rawCommitteeIndex != "" && att.Data.CommitteeIndex == primitives.CommitteeIndex(committeeIndex)
-		slotMatch := rawSlot != "" && att.Data.Slot == primitives.Slot(slot)
-		shouldAppend := (bothDefined && committeeIndexMatch && slotMatch) || (!bothDefined && (committeeIndexMatch || slotMatch))
-		if shouldAppend {
-			filteredAtts = append(filteredAtts, shared.AttestationFromConsensus(att))
+	if http2.SszRequested(r) {
+		sszResp, err := marshalSSZList(attestations, true /* variable size */)
+		if err != nil {
+			http2.HandleError(w, "Could not marshal attestations into SSZ: "+err.Error(), http.StatusInternalServerError)
+			return
 		}
+		http2.WriteSsz(w, sszResp, "attestations.ssz")
+		return
+	}
+	atts := make([]*shared.Attestation, len(attestations))
+	for i, att := range attestations {
+		atts[i] = shared.AttestationFromConsensus(att)
 	}
-	http2.WriteJson(w, &ListAttestationsResponse{Data: filteredAtts})
+	http2.WriteJson(w, &ListAttestationsResponse{Data: atts})
 }
 
 // SubmitAttestations submits an attestation object to node. If the attestation passes all validation
@@ -181,6 +194,15 @@ func (s *Server) ListVoluntaryExits(w http.ResponseWriter, r *http.Request) {
 		http2.HandleError(w, "Could not get exits from the pool: "+err.Error(), http.StatusInternalServerError)
 		return
 	}
+	if http2.SszRequested(r) {
+		sszResp, err := marshalSSZList(sourceExits, false /* fixed size */)
+		if err != nil {
+			http2.HandleError(w, "Could not marshal exits into SSZ: "+err.Error(), http.StatusInternalServerError)
+			return
+		}
+		http2.WriteSsz(w, sszResp, "voluntary_exits.ssz")
+		return
+	}
 	exits := make([]*shared.SignedVoluntaryExit, len(sourceExits))
 	for i, e := range sourceExits {
 		exits[i] = shared.SignedVoluntaryExitFromConsensus(e)
@@ -298,3 +320,318 @@ func (s *Server) SubmitSyncCommitteeSignatures(w http.ResponseWriter, r *http.Re
 		http2.WriteError(w, failuresErr)
 	}
 }
+
+// GetAttesterSlashings retrieves attester slashings known by the node but
+// not necessarily incorporated into any block.
+func (s *Server) GetAttesterSlashings(w http.ResponseWriter, r *http.Request) {
+	ctx, span := trace.StartSpan(r.Context(), "beacon.GetAttesterSlashings")
+	defer span.End()
+
+	headState, err := s.ChainInfoFetcher.HeadStateReadOnly(ctx)
+	if err != nil {
+		http2.HandleError(w, "Could not get head state: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	sourceSlashings := s.SlashingsPool.PendingAttesterSlashings(ctx, headState, true /* return unlimited slashings */)
+	if http2.SszRequested(r) {
+		sszResp, err := marshalSSZList(sourceSlashings, true /* variable size */)
+		if err != nil {
+			http2.HandleError(w, "Could not marshal slashings into SSZ: "+err.Error(), http.StatusInternalServerError)
+			return
+		}
+		http2.WriteSsz(w, sszResp, "attester_slashings.ssz")
+		return
+	}
+	slashings, err := shared.AttesterSlashingsFromConsensus(sourceSlashings)
+	if err != nil {
+		http2.HandleError(w, "Could not convert slashings: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	http2.WriteJson(w, &GetAttesterSlashingsResponse{Data: slashings})
+}
+
+// SubmitAttesterSlashing submits an attester slashing object to node's pool and
+// if passes validation node MUST broadcast it to network.
+func (s *Server) SubmitAttesterSlashing(w http.ResponseWriter, r *http.Request) {
+	ctx, span := trace.StartSpan(r.Context(), "beacon.SubmitAttesterSlashing")
+	defer span.End()
+
+	var slashing *ethpbalpha.AttesterSlashing
+	if http2.SszRequested(r) {
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			http2.HandleError(w, "Could not read request body: "+err.Error(), http.StatusInternalServerError)
+			return
+		}
+		slashing = &ethpbalpha.AttesterSlashing{}
+		if err = slashing.UnmarshalSSZ(body); err != nil {
+			http2.HandleError(w, "Could not unmarshal request body: "+err.Error(), http.StatusBadRequest)
+			return
+		}
+	} else {
+		var req shared.AttesterSlashing
+		err := json.NewDecoder(r.Body).Decode(&req)
+		switch {
+		case err == io.EOF:
+			http2.HandleError(w, "No data submitted", http.StatusBadRequest)
+			return
+		case err != nil:
+			http2.HandleError(w, "Could not decode request body: "+err.Error(), http.StatusBadRequest)
+			return
+		}
+		slashing, err = req.ToConsensus()
+		if err != nil {
+			http2.HandleError(w, "Could not convert request slashing to consensus slashing: "+err.Error(), http.StatusBadRequest)
+			return
+		}
+	}
+
+	headState, err := s.ChainInfoFetcher.HeadState(ctx)
+	if err != nil {
+		http2.HandleError(w, "Could not get head state: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	headState, err = transition.ProcessSlotsIfPossible(ctx, headState, slashing.Attestation_1.Data.Slot)
+	if err != nil {
+		http2.HandleError(w, "Could not process slots: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if err = blocks.VerifyAttesterSlashing(ctx, headState, slashing); err != nil {
+		http2.HandleError(w, "Invalid attester slashing: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+	if err = s.SlashingsPool.InsertAttesterSlashing(ctx, headState, slashing); err != nil {
+		http2.HandleError(w, "Could not insert attester slashing into pool: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if !features.Get().DisableBroadcastSlashings {
+		if err = s.Broadcaster.Broadcast(ctx, slashing); err != nil {
+			http2.HandleError(w, "Could not broadcast slashing object: "+err.Error(), http.StatusInternalServerError)
+			return
+		}
+	}
+}
+
+// GetProposerSlashings retrieves proposer slashings known by the node
+// but not necessarily incorporated into any block.
+func (s *Server) GetProposerSlashings(w http.ResponseWriter, r *http.Request) {
+	ctx, span := trace.StartSpan(r.Context(), "beacon.GetProposerSlashings")
+	defer span.End()
+
+	headState, err := s.ChainInfoFetcher.HeadStateReadOnly(ctx)
+	if err != nil {
+		http2.HandleError(w, "Could not get head state: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	sourceSlashings := s.SlashingsPool.PendingProposerSlashings(ctx, headState, true /* return unlimited slashings */)
+	if http2.SszRequested(r) {
+		sszResp, err := marshalSSZList(sourceSlashings, false /* fixed size */)
+		if err != nil {
+			http2.HandleError(w, "Could not marshal slashings into SSZ: "+err.Error(), http.StatusInternalServerError)
+			return
+		}
+		http2.WriteSsz(w, sszResp, "proposer_slashings.ssz")
+		return
+	}
+	slashings, err := shared.ProposerSlashingsFromConsensus(sourceSlashings)
+	if err != nil {
+		http2.HandleError(w, "Could not convert slashings: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	http2.WriteJson(w, &GetProposerSlashingsResponse{Data: slashings})
+}
+
+// SubmitProposerSlashing submits a proposer slashing object to node's pool and if
+// passes validation node MUST broadcast it to network.
+func (s *Server) SubmitProposerSlashing(w http.ResponseWriter, r *http.Request) {
+	ctx, span := trace.StartSpan(r.Context(), "beacon.SubmitProposerSlashing")
+	defer span.End()
+
+	var slashing *ethpbalpha.ProposerSlashing
+	if http2.SszRequested(r) {
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			http2.HandleError(w, "Could not read request body: "+err.Error(), http.StatusInternalServerError)
+			return
+		}
+		slashing = &ethpbalpha.ProposerSlashing{}
+		if err = slashing.UnmarshalSSZ(body); err != nil {
+			http2.HandleError(w, "Could not unmarshal request body: "+err.Error(), http.StatusBadRequest)
+			return
+		}
+	} else {
+		var req shared.ProposerSlashing
+		err := json.NewDecoder(r.Body).Decode(&req)
+		switch {
+		case err == io.EOF:
+			http2.HandleError(w, "No data submitted", http.StatusBadRequest)
+			return
+		case err != nil:
+			http2.HandleError(w, "Could not decode request body: "+err.Error(), http.StatusBadRequest)
+			return
+		}
+		slashing, err = req.ToConsensus()
+		if err != nil {
+			http2.HandleError(w, "Could not convert request slashing to consensus slashing: "+err.Error(), http.StatusBadRequest)
+			return
+		}
+	}
+
+	headState, err := s.ChainInfoFetcher.HeadState(ctx)
+	if err != nil {
+		http2.HandleError(w, "Could not get head state: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	headState, err = transition.ProcessSlotsIfPossible(ctx, headState, slashing.Header_1.Header.Slot)
+	if err != nil {
+		http2.HandleError(w, "Could not process slots: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if err = blocks.VerifyProposerSlashing(headState, slashing); err != nil {
+		http2.HandleError(w, "Invalid proposer slashing: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+	if err = s.SlashingsPool.InsertProposerSlashing(ctx, headState, slashing); err != nil {
+		http2.HandleError(w, "Could not insert proposer slashing into pool: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if !features.Get().DisableBroadcastSlashings {
+		if err = s.Broadcaster.Broadcast(ctx, slashing); err != nil {
+			http2.HandleError(w, "Could not broadcast slashing object: "+err.Error(), http.StatusInternalServerError)
+			return
+		}
+	}
+}
+
+// ListBLSToExecutionChanges retrieves BLS to execution changes known by the node but not necessarily incorporated into any block.
+func (s *Server) ListBLSToExecutionChanges(w http.ResponseWriter, r *http.Request) {
+	_, span := trace.StartSpan(r.Context(), "beacon.ListBLSToExecutionChanges")
+	defer span.End()
+
+	sourceChanges, err := s.BLSChangesPool.PendingBLSToExecChanges()
+	if err != nil {
+		http2.HandleError(w, "Could not get BLS to execution changes: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if http2.SszRequested(r) {
+		sszResp, err := marshalSSZList(sourceChanges, false /* fixed size */)
+		if err != nil {
+			http2.HandleError(w, "Could not marshal BLS to execution changes into SSZ: "+err.Error(), http.StatusInternalServerError)
+			return
+		}
+		http2.WriteSsz(w, sszResp, "bls_to_execution_changes.ssz")
+		return
+	}
+	changes, err := shared.BlsChangesFromConsensus(sourceChanges)
+	if err != nil {
+		http2.HandleError(w, "Could not convert BLS to execution changes: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	http2.WriteJson(w, &BLSToExecutionChangesPoolResponse{Data: changes})
+}
+
+// SubmitBLSToExecutionChanges submits BLS to execution change objects to the node's pool.
+// Every change that passes validation is broadcast to the network once the Capella fork is reached.
+func (s *Server) SubmitBLSToExecutionChanges(w http.ResponseWriter, r *http.Request) {
+	ctx, span := trace.StartSpan(r.Context(), "beacon.SubmitBLSToExecutionChanges")
+	defer span.End()
+
+	var req []*shared.SignedBlsToExecutionChange
+	err := json.NewDecoder(r.Body).Decode(&req)
+	switch {
+	case err == io.EOF:
+		http2.HandleError(w, "No data submitted", http.StatusBadRequest)
+		return
+	case err != nil:
+		http2.HandleError(w, "Could not decode request body: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+	if len(req) == 0 {
+		http2.HandleError(w, "No data submitted", http.StatusBadRequest)
+		return
+	}
+
+	st, err := s.ChainInfoFetcher.HeadStateReadOnly(ctx)
+	if err != nil {
+		http2.HandleError(w, "Could not get head state: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	var failures []*shared.IndexedVerificationFailure
+	var toBroadcast []*ethpbalpha.SignedBLSToExecutionChange
+	for i, change := range req {
+		if change == nil {
+			failures = append(failures, &shared.IndexedVerificationFailure{
+				Index:   i,
+				Message: "Change is empty",
+			})
+			continue
+		}
+		sbls, err := change.ToConsensus()
+		if err != nil {
+			failures = append(failures, &shared.IndexedVerificationFailure{
+				Index:   i,
+				Message: "Could not convert request change to consensus change: " + err.Error(),
+			})
+			continue
+		}
+		if _, err = blocks.ValidateBLSToExecutionChange(st, sbls); err != nil {
+			failures = append(failures, &shared.IndexedVerificationFailure{
+				Index:   i,
+				Message: "Could not validate SignedBLSToExecutionChange: " + err.Error(),
+			})
+			continue
+		}
+		if err = blocks.VerifyBLSChangeSignature(st, migration.V1Alpha1SignedBLSToExecChangeToV2(sbls)); err != nil {
+			failures = append(failures, &shared.IndexedVerificationFailure{
+				Index:   i,
+				Message: "Could not validate signature: " + err.Error(),
+			})
+			continue
+		}
+		s.OperationNotifier.OperationFeed().Send(&feed.Event{
+			Type: operation.BLSToExecutionChangeReceived,
+			Data: &operation.BLSToExecutionChangeReceivedData{
+				Change: sbls,
+			},
+		})
+		s.BLSChangesPool.InsertBLSToExecChange(sbls)
+		if st.Version() >= version.Capella {
+			toBroadcast = append(toBroadcast, sbls)
+		}
+	}
+	// Broadcasting is rate limited and outlives the request, so it must not use the request's context.
+	go s.broadcastBLSChanges(context.Background(), toBroadcast)
+	if len(failures) > 0 {
+		failuresErr := &shared.IndexedVerificationFailureError{
+			Code:     http.StatusBadRequest,
+			Message:  "One or more BLSToExecutionChange failed validation",
+			Failures: failures,
+		}
+		http2.WriteError(w, failuresErr)
+	}
+}
+
+// bytesPerLengthOffset is the size of the offsets preceding variable size objects in SSZ.
+const bytesPerLengthOffset = 4
+
+// marshalSSZList encodes the objects as an SSZ list. Variable size objects are preceded by their offsets.
+func marshalSSZList[T fssz.Marshaler](objs []T, variableSize bool) ([]byte, error) {
+	var buf []byte
+	if variableSize {
+		offset := len(objs) * bytesPerLengthOffset
+		for _, o := range objs {
+			buf = fssz.WriteOffset(buf, offset)
+			offset += o.SizeSSZ()
+		}
+	}
+	var err error
+	for _, o := range objs {
+		if buf, err = o.MarshalSSZTo(buf); err != nil {
+			return nil, err
+		}
+	}
+	return buf, nil
+}
@@ -2,31 +2,46 @@ package beacon
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	fssz "github.com/prysmaticlabs/fastssz"
 	"github.com/prysmaticlabs/go-bitfield"
 	blockchainmock "github.com/prysmaticlabs/prysm/v4/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/core/signing"
+	prysmtime "github.com/prysmaticlabs/prysm/v4/beacon-chain/core/time"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/core/transition"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/operations/attestations"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/operations/blstoexec"
+	blstoexecmock "github.com/prysmaticlabs/prysm/v4/beacon-chain/operations/blstoexec/mock"
+	slashingsmock "github.com/prysmaticlabs/prysm/v4/beacon-chain/operations/slashings/mock"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/operations/synccommittee"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/operations/voluntaryexits/mock"
 	p2pMock "github.com/prysmaticlabs/prysm/v4/beacon-chain/p2p/testing"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/rpc/apimiddleware"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/rpc/core"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/rpc/eth/shared"
+	state_native "github.com/prysmaticlabs/prysm/v4/beacon-chain/state/state-native"
 	"github.com/prysmaticlabs/prysm/v4/config/params"
 	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v4/crypto/bls"
+	"github.com/prysmaticlabs/prysm/v4/crypto/bls/common"
+	"github.com/prysmaticlabs/prysm/v4/crypto/hash"
 	"github.com/prysmaticlabs/prysm/v4/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/v4/encoding/ssz"
 	http2 "github.com/prysmaticlabs/prysm/v4/network/http"
 	ethpbv1alpha1 "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/v4/testing/assert"
 	"github.com/prysmaticlabs/prysm/v4/testing/require"
 	"github.com/prysmaticlabs/prysm/v4/testing/util"
+	"github.com/prysmaticlabs/prysm/v4/time/slots"
 )
 
 func TestListAttestations(t *testing.T) {
@@ -170,6 +185,20 @@ func TestListAttestations(t *testing.T) {
 			assert.Equal(t, "4", a.Data.CommitteeIndex)
 		}
 	})
+	t.Run("SSZ", func(t *testing.T) {
+		url := "http://example.com?slot=2&committee_index=4"
+		request := httptest.NewRequest(http.MethodGet, url, nil)
+		request.Header.Set("Accept", "application/octet-stream")
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.ListAttestations(writer, request)
+		assert.Equal(t, http.StatusOK, writer.Code)
+		sszAtt, err := att4.MarshalSSZ()
+		require.NoError(t, err)
+		// The attestation is preceded by its offset.
+		assert.DeepEqual(t, append([]byte{4, 0, 0, 0}, sszAtt...), writer.Body.Bytes())
+	})
 }
 
 func TestSubmitAttestations(t *testing.T) {
@@ -337,6 +366,23 @@ func TestListVoluntaryExits(t *testing.T) {
 	assert.Equal(t, "0x7369676e6174757265320000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000", resp.Data[1].Signature)
 	assert.Equal(t, "2", resp.Data[1].Message.Epoch)
 	assert.Equal(t, "2", resp.Data[1].Message.ValidatorIndex)
+
+	t.Run("SSZ", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com", nil)
+		request.Header.Set("Accept", "application/octet-stream")
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.ListVoluntaryExits(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		var expected []byte
+		for _, o := range []fssz.Marshaler{exit1, exit2} {
+			sszObj, err := o.MarshalSSZ()
+			require.NoError(t, err)
+			expected = append(expected, sszObj...)
+		}
+		assert.DeepEqual(t, expected, writer.Body.Bytes())
+	})
 }
 
 func TestSubmitVoluntaryExit(t *testing.T) {
@@ -764,3 +810,958 @@ var (
   }
 ]`
 )
+
+func TestGetAttesterSlashings(t *testing.T) {
+	bs, err := util.NewBeaconState()
+	require.NoError(t, err)
+	slashing1 := &ethpbv1alpha1.AttesterSlashing{
+		Attestation_1: &ethpbv1alpha1.IndexedAttestation{
+			AttestingIndices: []uint64{1, 10},
+			Data: &ethpbv1alpha1.AttestationData{
+				Slot:            1,
+				CommitteeIndex:  1,
+				BeaconBlockRoot: bytesutil.PadTo([]byte("blockroot1"), 32),
+				Source: &ethpbv1alpha1.Checkpoint{
+					Epoch: 1,
+					Root:  bytesutil.PadTo([]byte("sourceroot1"), 32),
+				},
+				Target: &ethpbv1alpha1.Checkpoint{
+					Epoch: 10,
+					Root:  bytesutil.PadTo([]byte("targetroot1"), 32),
+				},
+			},
+			Signature: bytesutil.PadTo([]byte("signature1"), 96),
+		},
+		Attestation_2: &ethpbv1alpha1.IndexedAttestation{
+			AttestingIndices: []uint64{2, 20},
+			Data: &ethpbv1alpha1.AttestationData{
+				Slot:            2,
+				CommitteeIndex:  2,
+				BeaconBlockRoot: bytesutil.PadTo([]byte("blockroot2"), 32),
+				Source: &ethpbv1alpha1.Checkpoint{
+					Epoch: 2,
+					Root:  bytesutil.PadTo([]byte("sourceroot2"), 32),
+				},
+				Target: &ethpbv1alpha1.Checkpoint{
+					Epoch: 20,
+					Root:  bytesutil.PadTo([]byte("targetroot2"), 32),
+				},
+			},
+			Signature: bytesutil.PadTo([]byte("signature2"), 96),
+		},
+	}
+	slashing2 := &ethpbv1alpha1.AttesterSlashing{
+		Attestation_1: &ethpbv1alpha1.IndexedAttestation{
+			AttestingIndices: []uint64{3, 30},
+			Data: &ethpbv1alpha1.AttestationData{
+				Slot:            3,
+				CommitteeIndex:  3,
+				BeaconBlockRoot: bytesutil.PadTo([]byte("blockroot3"), 32),
+				Source: &ethpbv1alpha1.Checkpoint{
+					Epoch: 3,
+					Root:  bytesutil.PadTo([]byte("sourceroot3"), 32),
+				},
+				Target: &ethpbv1alpha1.Checkpoint{
+					Epoch: 30,
+					Root:  bytesutil.PadTo([]byte("targetroot3"), 32),
+				},
+			},
+			Signature: bytesutil.PadTo([]byte("signature3"), 96),
+		},
+		Attestation_2: &ethpbv1alpha1.IndexedAttestation{
+			AttestingIndices: []uint64{4, 40},
+			Data: &ethpbv1alpha1.AttestationData{
+				Slot:            4,
+				CommitteeIndex:  4,
+				BeaconBlockRoot: bytesutil.PadTo([]byte("blockroot4"), 32),
+				Source: &ethpbv1alpha1.Checkpoint{
+					Epoch: 4,
+					Root:  bytesutil.PadTo([]byte("sourceroot4"), 32),
+				},
+				Target: &ethpbv1alpha1.Checkpoint{
+					Epoch: 40,
+					Root:  bytesutil.PadTo([]byte("targetroot4"), 32),
+				},
+			},
+			Signature: bytesutil.PadTo([]byte("signature4"), 96),
+		},
+	}
+
+	s := &Server{
+		ChainInfoFetcher: &blockchainmock.ChainService{State: bs},
+		SlashingsPool:    &slashingsmock.PoolMock{PendingAttSlashings: []*ethpbv1alpha1.AttesterSlashing{slashing1, slashing2}},
+	}
+
+	request := httptest.NewRequest(http.MethodGet, "http://example.com/eth/v1/beacon/pool/attester_slashings", nil)
+	writer := httptest.NewRecorder()
+	writer.Body = &bytes.Buffer{}
+
+	s.GetAttesterSlashings(writer, request)
+	require.Equal(t, http.StatusOK, writer.Code)
+	resp := &GetAttesterSlashingsResponse{}
+	require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+	require.Equal(t, 2, len(resp.Data))
+	ss, err := shared.AttesterSlashingsToConsensus(resp.Data)
+	require.NoError(t, err)
+	assert.DeepEqual(t, slashing1, ss[0])
+	assert.DeepEqual(t, slashing2, ss[1])
+
+	t.Run("SSZ", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/eth/v1/beacon/pool/attester_slashings", nil)
+		request.Header.Set("Accept", "application/octet-stream")
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetAttesterSlashings(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		sszSlashing1, err := slashing1.MarshalSSZ()
+		require.NoError(t, err)
+		sszSlashing2, err := slashing2.MarshalSSZ()
+		require.NoError(t, err)
+		// Both slashings are preceded by their offsets.
+		expected := fssz.WriteOffset(nil, 8)
+		expected = fssz.WriteOffset(expected, 8+len(sszSlashing1))
+		expected = append(append(expected, sszSlashing1...), sszSlashing2...)
+		assert.DeepEqual(t, expected, writer.Body.Bytes())
+	})
+}
+
+func TestGetProposerSlashings(t *testing.T) {
+	bs, err := util.NewBeaconState()
+	require.NoError(t, err)
+	slashing1 := &ethpbv1alpha1.ProposerSlashing{
+		Header_1: &ethpbv1alpha1.SignedBeaconBlockHeader{
+			Header: &ethpbv1alpha1.BeaconBlockHeader{
+				Slot:          1,
+				ProposerIndex: 1,
+				ParentRoot:    bytesutil.PadTo([]byte("parentroot1"), 32),
+				StateRoot:     bytesutil.PadTo([]byte("stateroot1"), 32),
+				BodyRoot:      bytesutil.PadTo([]byte("bodyroot1"), 32),
+			},
+			Signature: bytesutil.PadTo([]byte("signature1"), 96),
+		},
+		Header_2: &ethpbv1alpha1.SignedBeaconBlockHeader{
+			Header: &ethpbv1alpha1.BeaconBlockHeader{
+				Slot:          2,
+				ProposerIndex: 2,
+				ParentRoot:    bytesutil.PadTo([]byte("parentroot2"), 32),
+				StateRoot:     bytesutil.PadTo([]byte("stateroot2"), 32),
+				BodyRoot:      bytesutil.PadTo([]byte("bodyroot2"), 32),
+			},
+			Signature: bytesutil.PadTo([]byte("signature2"), 96),
+		},
+	}
+	slashing2 := &ethpbv1alpha1.ProposerSlashing{
+		Header_1: &ethpbv1alpha1.SignedBeaconBlockHeader{
+			Header: &ethpbv1alpha1.BeaconBlockHeader{
+				Slot:          3,
+				ProposerIndex: 3,
+				ParentRoot:    bytesutil.PadTo([]byte("parentroot3"), 32),
+				StateRoot:     bytesutil.PadTo([]byte("stateroot3"), 32),
+				BodyRoot:      bytesutil.PadTo([]byte("bodyroot3"), 32),
+			},
+			Signature: bytesutil.PadTo([]byte("signature3"), 96),
+		},
+		Header_2: &ethpbv1alpha1.SignedBeaconBlockHeader{
+			Header: &ethpbv1alpha1.BeaconBlockHeader{
+				Slot:          4,
+				ProposerIndex: 4,
+				ParentRoot:    bytesutil.PadTo([]byte("parentroot4"), 32),
+				StateRoot:     bytesutil.PadTo([]byte("stateroot4"), 32),
+				BodyRoot:      bytesutil.PadTo([]byte("bodyroot4"), 32),
+			},
+			Signature: bytesutil.PadTo([]byte("signature4"), 96),
+		},
+	}
+
+	s := &Server{
+		ChainInfoFetcher: &blockchainmock.ChainService{State: bs},
+		SlashingsPool:    &slashingsmock.PoolMock{PendingPropSlashings: []*ethpbv1alpha1.ProposerSlashing{slashing1, slashing2}},
+	}
+
+	request := httptest.NewRequest(http.MethodGet, "http://example.com/eth/v1/beacon/pool/proposer_slashings", nil)
+	writer := httptest.NewRecorder()
+	writer.Body = &bytes.Buffer{}
+
+	s.GetProposerSlashings(writer, request)
+	require.Equal(t, http.StatusOK, writer.Code)
+	resp := &GetProposerSlashingsResponse{}
+	require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+	require.Equal(t, 2, len(resp.Data))
+	ss, err := shared.ProposerSlashingsToConsensus(resp.Data)
+	require.NoError(t, err)
+	assert.DeepEqual(t, slashing1, ss[0])
+	assert.DeepEqual(t, slashing2, ss[1])
+
+	t.Run("SSZ", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/eth/v1/beacon/pool/proposer_slashings", nil)
+		request.Header.Set("Accept", "application/octet-stream")
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetProposerSlashings(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		var expected []byte
+		for _, o := range []fssz.Marshaler{slashing1, slashing2} {
+			sszObj, err := o.MarshalSSZ()
+			require.NoError(t, err)
+			expected = append(expected, sszObj...)
+		}
+		assert.DeepEqual(t, expected, writer.Body.Bytes())
+	})
+}
+
+func TestSubmitAttesterSlashing(t *testing.T) {
+	ctx := context.Background()
+
+	transition.SkipSlotCache.Disable()
+	defer transition.SkipSlotCache.Enable()
+
+	attestationData1 := &ethpbv1alpha1.AttestationData{
+		CommitteeIndex:  1,
+		BeaconBlockRoot: bytesutil.PadTo([]byte("blockroot1"), 32),
+		Source: &ethpbv1alpha1.Checkpoint{
+			Epoch: 1,
+			Root:  bytesutil.PadTo([]byte("sourceroot1"), 32),
+		},
+		Target: &ethpbv1alpha1.Checkpoint{
+			Epoch: 10,
+			Root:  bytesutil.PadTo([]byte("targetroot1"), 32),
+		},
+	}
+	attestationData2 := &ethpbv1alpha1.AttestationData{
+		CommitteeIndex:  1,
+		BeaconBlockRoot: bytesutil.PadTo([]byte("blockroot2"), 32),
+		Source: &ethpbv1alpha1.Checkpoint{
+			Epoch: 1,
+			Root:  bytesutil.PadTo([]byte("sourceroot2"), 32),
+		},
+		Target: &ethpbv1alpha1.Checkpoint{
+			Epoch: 10,
+			Root:  bytesutil.PadTo([]byte("targetroot2"), 32),
+		},
+	}
+
+	t.Run("ok", func(t *testing.T) {
+		attestationData1.Slot = 1
+		attestationData2.Slot = 1
+		slashing := &ethpbv1alpha1.AttesterSlashing{
+			Attestation_1: &ethpbv1alpha1.IndexedAttestation{
+				AttestingIndices: []uint64{0},
+				Data:             attestationData1,
+				Signature:        make([]byte, 96),
+			},
+			Attestation_2: &ethpbv1alpha1.IndexedAttestation{
+				AttestingIndices: []uint64{0},
+				Data:             attestationData2,
+				Signature:        make([]byte, 96),
+			},
+		}
+
+		_, keys, err := util.DeterministicDepositsAndKeys(1)
+		require.NoError(t, err)
+		validator := &ethpbv1alpha1.Validator{
+			PublicKey: keys[0].PublicKey().Marshal(),
+		}
+		bs, err := util.NewBeaconState(func(state *ethpbv1alpha1.BeaconState) error {
+			state.Validators = []*ethpbv1alpha1.Validator{validator}
+			return nil
+		})
+		require.NoError(t, err)
+
+		for _, att := range []*ethpbv1alpha1.IndexedAttestation{slashing.Attestation_1, slashing.Attestation_2} {
+			sb, err := signing.ComputeDomainAndSign(bs, att.Data.Target.Epoch, att.Data, params.BeaconConfig().DomainBeaconAttester, keys[0])
+			require.NoError(t, err)
+			sig, err := bls.SignatureFromBytes(sb)
+			require.NoError(t, err)
+			att.Signature = sig.Marshal()
+		}
+
+		broadcaster := &p2pMock.MockBroadcaster{}
+		s := &Server{
+			ChainInfoFetcher: &blockchainmock.ChainService{State: bs},
+			SlashingsPool:    &slashingsmock.PoolMock{},
+			Broadcaster:      broadcaster,
+		}
+
+		toSubmit, err := shared.AttesterSlashingsFromConsensus([]*ethpbv1alpha1.AttesterSlashing{slashing})
+		require.NoError(t, err)
+		b, err := json.Marshal(toSubmit[0])
+		require.NoError(t, err)
+		var body bytes.Buffer
+		_, err = body.Write(b)
+		require.NoError(t, err)
+		request := httptest.NewRequest(http.MethodPost, "http://example.com/eth/v1/beacon/pool/attester_slashings", &body)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.SubmitAttesterSlashing(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		pendingSlashings := s.SlashingsPool.PendingAttesterSlashings(ctx, bs, true)
+		require.Equal(t, 1, len(pendingSlashings))
+		assert.DeepEqual(t, slashing, pendingSlashings[0])
+		assert.Equal(t, true, broadcaster.BroadcastCalled)
+		require.Equal(t, 1, len(broadcaster.BroadcastMessages))
+		_, ok := broadcaster.BroadcastMessages[0].(*ethpbv1alpha1.AttesterSlashing)
+		assert.Equal(t, true, ok)
+	})
+	t.Run("across fork", func(t *testing.T) {
+		attestationData1.Slot = params.BeaconConfig().SlotsPerEpoch
+		attestationData2.Slot = params.BeaconConfig().SlotsPerEpoch
+		slashing := &ethpbv1alpha1.AttesterSlashing{
+			Attestation_1: &ethpbv1alpha1.IndexedAttestation{
+				AttestingIndices: []uint64{0},
+				Data:             attestationData1,
+				Signature:        make([]byte, 96),
+			},
+			Attestation_2: &ethpbv1alpha1.IndexedAttestation{
+				AttestingIndices: []uint64{0},
+				Data:             attestationData2,
+				Signature:        make([]byte, 96),
+			},
+		}
+
+		params.SetupTestConfigCleanup(t)
+		config := params.BeaconConfig()
+		config.AltairForkEpoch = 1
+		params.OverrideBeaconConfig(config)
+
+		bs, keys := util.DeterministicGenesisState(t, 1)
+		newBs := bs.Copy()
+		newBs, err := transition.ProcessSlots(ctx, newBs, params.BeaconConfig().SlotsPerEpoch)
+		require.NoError(t, err)
+
+		for _, att := range []*ethpbv1alpha1.IndexedAttestation{slashing.Attestation_1, slashing.Attestation_2} {
+			sb, err := signing.ComputeDomainAndSign(newBs, att.Data.Target.Epoch, att.Data, params.BeaconConfig().DomainBeaconAttester, keys[0])
+			require.NoError(t, err)
+			sig, err := bls.SignatureFromBytes(sb)
+			require.NoError(t, err)
+			att.Signature = sig.Marshal()
+		}
+
+		broadcaster := &p2pMock.MockBroadcaster{}
+		s := &Server{
+			ChainInfoFetcher: &blockchainmock.ChainService{State: bs},
+			SlashingsPool:    &slashingsmock.PoolMock{},
+			Broadcaster:      broadcaster,
+		}
+
+		toSubmit, err := shared.AttesterSlashingsFromConsensus([]*ethpbv1alpha1.AttesterSlashing{slashing})
+		require.NoError(t, err)
+		b, err := json.Marshal(toSubmit[0])
+		require.NoError(t, err)
+		var body bytes.Buffer
+		_, err = body.Write(b)
+		require.NoError(t, err)
+		request := httptest.NewRequest(http.MethodPost, "http://example.com/eth/v1/beacon/pool/attester_slashings", &body)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.SubmitAttesterSlashing(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		pendingSlashings := s.SlashingsPool.PendingAttesterSlashings(ctx, bs, true)
+		require.Equal(t, 1, len(pendingSlashings))
+		assert.DeepEqual(t, slashing, pendingSlashings[0])
+		assert.Equal(t, true, broadcaster.BroadcastCalled)
+		require.Equal(t, 1, len(broadcaster.BroadcastMessages))
+		_, ok := broadcaster.BroadcastMessages[0].(*ethpbv1alpha1.AttesterSlashing)
+		assert.Equal(t, true, ok)
+	})
+	t.Run("invalid", func(t *testing.T) {
+		attestationData1.Slot = 1
+		attestation := &ethpbv1alpha1.IndexedAttestation{
+			AttestingIndices: []uint64{0},
+			Data:             attestationData1,
+			Signature:        make([]byte, 96),
+		}
+		slashing := &ethpbv1alpha1.AttesterSlashing{
+			Attestation_1: attestation,
+			Attestation_2: attestation,
+		}
+
+		bs, err := util.NewBeaconState()
+		require.NoError(t, err)
+
+		broadcaster := &p2pMock.MockBroadcaster{}
+		s := &Server{
+			ChainInfoFetcher: &blockchainmock.ChainService{State: bs},
+			SlashingsPool:    &slashingsmock.PoolMock{},
+			Broadcaster:      broadcaster,
+		}
+
+		toSubmit, err := shared.AttesterSlashingsFromConsensus([]*ethpbv1alpha1.AttesterSlashing{slashing})
+		require.NoError(t, err)
+		b, err := json.Marshal(toSubmit[0])
+		require.NoError(t, err)
+		var body bytes.Buffer
+		_, err = body.Write(b)
+		require.NoError(t, err)
+		request := httptest.NewRequest(http.MethodPost, "http://example.com/eth/v1/beacon/pool/attester_slashings", &body)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.SubmitAttesterSlashing(writer, request)
+		require.Equal(t, http.StatusBadRequest, writer.Code)
+		e := &http2.DefaultErrorJson{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), e))
+		assert.Equal(t, http.StatusBadRequest, e.Code)
+		assert.Equal(t, true, strings.Contains(e.Message, "Invalid attester slashing"))
+		assert.Equal(t, false, broadcaster.BroadcastCalled)
+	})
+	t.Run("no body", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodPost, "http://example.com/eth/v1/beacon/pool/attester_slashings", nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s := &Server{}
+		s.SubmitAttesterSlashing(writer, request)
+		require.Equal(t, http.StatusBadRequest, writer.Code)
+		e := &http2.DefaultErrorJson{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), e))
+		assert.Equal(t, http.StatusBadRequest, e.Code)
+		assert.Equal(t, true, strings.Contains(e.Message, "No data submitted"))
+	})
+}
+
+func TestSubmitProposerSlashing(t *testing.T) {
+	ctx := context.Background()
+
+	transition.SkipSlotCache.Disable()
+	defer transition.SkipSlotCache.Enable()
+
+	t.Run("ok", func(t *testing.T) {
+		_, keys, err := util.DeterministicDepositsAndKeys(1)
+		require.NoError(t, err)
+		validator := &ethpbv1alpha1.Validator{
+			PublicKey:         keys[0].PublicKey().Marshal(),
+			WithdrawableEpoch: primitives.Epoch(1),
+		}
+		bs, err := util.NewBeaconState(func(state *ethpbv1alpha1.BeaconState) error {
+			state.Validators = []*ethpbv1alpha1.Validator{validator}
+			return nil
+		})
+		require.NoError(t, err)
+
+		slashing := &ethpbv1alpha1.ProposerSlashing{
+			Header_1: &ethpbv1alpha1.SignedBeaconBlockHeader{
+				Header: &ethpbv1alpha1.BeaconBlockHeader{
+					Slot:          1,
+					ProposerIndex: 0,
+					ParentRoot:    bytesutil.PadTo([]byte("parentroot1"), 32),
+					StateRoot:     bytesutil.PadTo([]byte("stateroot1"), 32),
+					BodyRoot:      bytesutil.PadTo([]byte("bodyroot1"), 32),
+				},
+				Signature: make([]byte, 96),
+			},
+			Header_2: &ethpbv1alpha1.SignedBeaconBlockHeader{
+				Header: &ethpbv1alpha1.BeaconBlockHeader{
+					Slot:          1,
+					ProposerIndex: 0,
+					ParentRoot:    bytesutil.PadTo([]byte("parentroot2"), 32),
+					StateRoot:     bytesutil.PadTo([]byte("stateroot2"), 32),
+					BodyRoot:      bytesutil.PadTo([]byte("bodyroot2"), 32),
+				},
+				Signature: make([]byte, 96),
+			},
+		}
+
+		for _, h := range []*ethpbv1alpha1.SignedBeaconBlockHeader{slashing.Header_1, slashing.Header_2} {
+			sb, err := signing.ComputeDomainAndSign(
+				bs,
+				slots.ToEpoch(h.Header.Slot),
+				h.Header,
+				params.BeaconConfig().DomainBeaconProposer,
+				keys[0],
+			)
+			require.NoError(t, err)
+			sig, err := bls.SignatureFromBytes(sb)
+			require.NoError(t, err)
+			h.Signature = sig.Marshal()
+		}
+
+		broadcaster := &p2pMock.MockBroadcaster{}
+		s := &Server{
+			ChainInfoFetcher: &blockchainmock.ChainService{State: bs},
+			SlashingsPool:    &slashingsmock.PoolMock{},
+			Broadcaster:      broadcaster,
+		}
+
+		toSubmit, err := shared.ProposerSlashingsFromConsensus([]*ethpbv1alpha1.ProposerSlashing{slashing})
+		require.NoError(t, err)
+		b, err := json.Marshal(toSubmit[0])
+		require.NoError(t, err)
+		var body bytes.Buffer
+		_, err = body.Write(b)
+		require.NoError(t, err)
+		request := httptest.NewRequest(http.MethodPost, "http://example.com/eth/v1/beacon/pool/proposer_slashings", &body)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.SubmitProposerSlashing(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		pendingSlashings := s.SlashingsPool.PendingProposerSlashings(ctx, bs, true)
+		require.Equal(t, 1, len(pendingSlashings))
+		assert.DeepEqual(t, slashing, pendingSlashings[0])
+		assert.Equal(t, true, broadcaster.BroadcastCalled)
+		require.Equal(t, 1, len(broadcaster.BroadcastMessages))
+		_, ok := broadcaster.BroadcastMessages[0].(*ethpbv1alpha1.ProposerSlashing)
+		assert.Equal(t, true, ok)
+	})
+	t.Run("across fork", func(t *testing.T) {
+		params.SetupTestConfigCleanup(t)
+		config := params.BeaconConfig()
+		config.AltairForkEpoch = 1
+		params.OverrideBeaconConfig(config)
+
+		bs, keys := util.DeterministicGenesisState(t, 1)
+
+		slashing := &ethpbv1alpha1.ProposerSlashing{
+			Header_1: &ethpbv1alpha1.SignedBeaconBlockHeader{
+				Header: &ethpbv1alpha1.BeaconBlockHeader{
+					Slot:          params.BeaconConfig().SlotsPerEpoch,
+					ProposerIndex: 0,
+					ParentRoot:    bytesutil.PadTo([]byte("parentroot1"), 32),
+					StateRoot:     bytesutil.PadTo([]byte("stateroot1"), 32),
+					BodyRoot:      bytesutil.PadTo([]byte("bodyroot1"), 32),
+				},
+				Signature: make([]byte, 96),
+			},
+			Header_2: &ethpbv1alpha1.SignedBeaconBlockHeader{
+				Header: &ethpbv1alpha1.BeaconBlockHeader{
+					Slot:          params.BeaconConfig().SlotsPerEpoch,
+					ProposerIndex: 0,
+					ParentRoot:    bytesutil.PadTo([]byte("parentroot2"), 32),
+					StateRoot:     bytesutil.PadTo([]byte("stateroot2"), 32),
+					BodyRoot:      bytesutil.PadTo([]byte("bodyroot2"), 32),
+				},
+				Signature: make([]byte, 96),
+			},
+		}
+
+		newBs := bs.Copy()
+		newBs, err := transition.ProcessSlots(ctx, newBs, params.BeaconConfig().SlotsPerEpoch)
+		require.NoError(t, err)
+
+		for _, h := range []*ethpbv1alpha1.SignedBeaconBlockHeader{slashing.Header_1, slashing.Header_2} {
+			sb, err := signing.ComputeDomainAndSign(
+				newBs,
+				slots.ToEpoch(h.Header.Slot),
+				h.Header,
+				params.BeaconConfig().DomainBeaconProposer,
+				keys[0],
+			)
+			require.NoError(t, err)
+			sig, err := bls.SignatureFromBytes(sb)
+			require.NoError(t, err)
+			h.Signature = sig.Marshal()
+		}
+
+		broadcaster := &p2pMock.MockBroadcaster{}
+		s := &Server{
+			ChainInfoFetcher: &blockchainmock.ChainService{State: bs},
+			SlashingsPool:    &slashingsmock.PoolMock{},
+			Broadcaster:      broadcaster,
+		}
+
+		toSubmit, err := shared.ProposerSlashingsFromConsensus([]*ethpbv1alpha1.ProposerSlashing{slashing})
+		require.NoError(t, err)
+		b, err := json.Marshal(toSubmit[0])
+		require.NoError(t, err)
+		var body bytes.Buffer
+		_, err = body.Write(b)
+		require.NoError(t, err)
+		request := httptest.NewRequest(http.MethodPost, "http://example.com/eth/v1/beacon/pool/proposer_slashings", &body)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.SubmitProposerSlashing(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		pendingSlashings := s.SlashingsPool.PendingProposerSlashings(ctx, bs, true)
+		require.Equal(t, 1, len(pendingSlashings))
+		assert.DeepEqual(t, slashing, pendingSlashings[0])
+		assert.Equal(t, true, broadcaster.BroadcastCalled)
+		require.Equal(t, 1, len(broadcaster.BroadcastMessages))
+		_, ok := broadcaster.BroadcastMessages[0].(*ethpbv1alpha1.ProposerSlashing)
+		assert.Equal(t, true, ok)
+	})
+	t.Run("invalid", func(t *testing.T) {
+		bs, err := util.NewBeaconState()
+		require.NoError(t, err)
+
+		header := &ethpbv1alpha1.SignedBeaconBlockHeader{
+			Header: &ethpbv1alpha1.BeaconBlockHeader{
+				Slot:          1,
+				ProposerIndex: 0,
+				ParentRoot:    bytesutil.PadTo([]byte("parentroot1"), 32),
+				StateRoot:     bytesutil.PadTo([]byte("stateroot1"), 32),
+				BodyRoot:      bytesutil.PadTo([]byte("bodyroot1"), 32),
+			},
+			Signature: make([]byte, 96),
+		}
+		slashing := &ethpbv1alpha1.ProposerSlashing{
+			Header_1: header,
+			Header_2: header,
+		}
+
+		broadcaster := &p2pMock.MockBroadcaster{}
+		s := &Server{
+			ChainInfoFetcher: &blockchainmock.ChainService{State: bs},
+			SlashingsPool:    &slashingsmock.PoolMock{},
+			Broadcaster:      broadcaster,
+		}
+
+		toSubmit, err := shared.ProposerSlashingsFromConsensus([]*ethpbv1alpha1.ProposerSlashing{slashing})
+		require.NoError(t, err)
+		b, err := json.Marshal(toSubmit[0])
+		require.NoError(t, err)
+		var body bytes.Buffer
+		_, err = body.Write(b)
+		require.NoError(t, err)
+		request := httptest.NewRequest(http.MethodPost, "http://example.com/eth/v1/beacon/pool/proposer_slashings", &body)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.SubmitProposerSlashing(writer, request)
+		require.Equal(t, http.StatusBadRequest, writer.Code)
+		e := &http2.DefaultErrorJson{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), e))
+		assert.Equal(t, http.StatusBadRequest, e.Code)
+		assert.Equal(t, true, strings.Contains(e.Message, "Invalid proposer slashing"))
+		assert.Equal(t, false, broadcaster.BroadcastCalled)
+	})
+	t.Run("no body", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodPost, "http://example.com/eth/v1/beacon/pool/proposer_slashings", nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s := &Server{}
+		s.SubmitProposerSlashing(writer, request)
+		require.Equal(t, http.StatusBadRequest, writer.Code)
+		e := &http2.DefaultErrorJson{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), e))
+		assert.Equal(t, http.StatusBadRequest, e.Code)
+		assert.Equal(t, true, strings.Contains(e.Message, "No data submitted"))
+	})
+}
+
+func TestListBLSToExecutionChanges(t *testing.T) {
+	change1 := &ethpbv1alpha1.SignedBLSToExecutionChange{
+		Message: &ethpbv1alpha1.BLSToExecutionChange{
+			ValidatorIndex:     1,
+			FromBlsPubkey:      bytesutil.PadTo([]byte("pubkey1"), 48),
+			ToExecutionAddress: bytesutil.PadTo([]byte("address1"), 20),
+		},
+		Signature: bytesutil.PadTo([]byte("signature1"), 96),
+	}
+	change2 := &ethpbv1alpha1.SignedBLSToExecutionChange{
+		Message: &ethpbv1alpha1.BLSToExecutionChange{
+			ValidatorIndex:     2,
+			FromBlsPubkey:      bytesutil.PadTo([]byte("pubkey2"), 48),
+			ToExecutionAddress: bytesutil.PadTo([]byte("address2"), 20),
+		},
+		Signature: bytesutil.PadTo([]byte("signature2"), 96),
+	}
+
+	s := &Server{
+		BLSChangesPool: &blstoexecmock.PoolMock{Changes: []*ethpbv1alpha1.SignedBLSToExecutionChange{change1, change2}},
+	}
+
+	request := httptest.NewRequest(http.MethodGet, "http://example.com/eth/v1/beacon/pool/bls_to_execution_changes", nil)
+	writer := httptest.NewRecorder()
+	writer.Body = &bytes.Buffer{}
+
+	s.ListBLSToExecutionChanges(writer, request)
+	require.Equal(t, http.StatusOK, writer.Code)
+	resp := &BLSToExecutionChangesPoolResponse{}
+	require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+	require.Equal(t, 2, len(resp.Data))
+	changes, err := shared.BlsChangesToConsensus(resp.Data)
+	require.NoError(t, err)
+	assert.DeepEqual(t, change1, changes[0])
+	assert.DeepEqual(t, change2, changes[1])
+
+	t.Run("SSZ", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/eth/v1/beacon/pool/bls_to_execution_changes", nil)
+		request.Header.Set("Accept", "application/octet-stream")
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.ListBLSToExecutionChanges(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		var expected []byte
+		for _, o := range []fssz.Marshaler{change1, change2} {
+			sszObj, err := o.MarshalSSZ()
+			require.NoError(t, err)
+			expected = append(expected, sszObj...)
+		}
+		assert.DeepEqual(t, expected, writer.Body.Bytes())
+	})
+}
+
+// blsChangesTestValidators returns validators with BLS withdrawal credentials, unsigned changes for each of them
+// and the private keys needed to sign those changes.
+func blsChangesTestValidators(t *testing.T, numValidators int) ([]*ethpbv1alpha1.Validator, []*ethpbv1alpha1.BLSToExecutionChange, []common.SecretKey) {
+	validators := make([]*ethpbv1alpha1.Validator, numValidators)
+	blsChanges := make([]*ethpbv1alpha1.BLSToExecutionChange, numValidators)
+	privKeys := make([]common.SecretKey, numValidators)
+	maxEffectiveBalance := params.BeaconConfig().MaxEffectiveBalance
+	executionAddress := []byte{0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0x0f, 0x10, 0x11, 0x12, 0x13}
+
+	for i := range validators {
+		v := &ethpbv1alpha1.Validator{}
+		v.EffectiveBalance = maxEffectiveBalance
+		v.WithdrawableEpoch = params.BeaconConfig().FarFutureEpoch
+		v.WithdrawalCredentials = make([]byte, 32)
+		priv, err := bls.RandKey()
+		require.NoError(t, err)
+		privKeys[i] = priv
+		pubkey := priv.PublicKey().Marshal()
+
+		message := &ethpbv1alpha1.BLSToExecutionChange{
+			ToExecutionAddress: executionAddress,
+			ValidatorIndex:     primitives.ValidatorIndex(i),
+			FromBlsPubkey:      pubkey,
+		}
+
+		hashFn := ssz.NewHasherFunc(hash.CustomSHA256Hasher())
+		digest := hashFn.Hash(pubkey)
+		digest[0] = params.BeaconConfig().BLSWithdrawalPrefixByte
+		copy(v.WithdrawalCredentials, digest[:])
+		validators[i] = v
+		blsChanges[i] = message
+	}
+	return validators, blsChanges, privKeys
+}
+
+func TestSubmitBLSToExecutionChanges(t *testing.T) {
+	transition.SkipSlotCache.Disable()
+	defer transition.SkipSlotCache.Enable()
+
+	params.SetupTestConfigCleanup(t)
+	c := params.BeaconConfig().Copy()
+	// Required for correct committee size calculation.
+	c.CapellaForkEpoch = c.BellatrixForkEpoch.Add(2)
+	params.OverrideBeaconConfig(c)
+
+	numValidators := 10
+
+	t.Run("ok", func(t *testing.T) {
+		validators, blsChanges, privKeys := blsChangesTestValidators(t, numValidators)
+		spb := &ethpbv1alpha1.BeaconStateCapella{
+			Fork: &ethpbv1alpha1.Fork{
+				CurrentVersion:  params.BeaconConfig().GenesisForkVersion,
+				PreviousVersion: params.BeaconConfig().GenesisForkVersion,
+				Epoch:           params.BeaconConfig().CapellaForkEpoch,
+			},
+			Validators: validators,
+			Balances:   make([]uint64, numValidators),
+		}
+		slot, err := slots.EpochStart(params.BeaconConfig().CapellaForkEpoch)
+		require.NoError(t, err)
+		spb.Slot = slot
+		st, err := state_native.InitializeFromProtoCapella(spb)
+		require.NoError(t, err)
+
+		signedChanges := make([]*ethpbv1alpha1.SignedBLSToExecutionChange, numValidators)
+		for i, message := range blsChanges {
+			signature, err := signing.ComputeDomainAndSign(st, prysmtime.CurrentEpoch(st), message, params.BeaconConfig().DomainBLSToExecutionChange, privKeys[i])
+			require.NoError(t, err)
+			signedChanges[i] = &ethpbv1alpha1.SignedBLSToExecutionChange{
+				Message:   message,
+				Signature: signature,
+			}
+		}
+
+		broadcaster := &p2pMock.MockBroadcaster{}
+		chainService := &blockchainmock.ChainService{State: st}
+		s := &Server{
+			HeadFetcher:       chainService,
+			ChainInfoFetcher:  chainService,
+			AttestationsPool:  attestations.NewPool(),
+			Broadcaster:       broadcaster,
+			OperationNotifier: &blockchainmock.MockOperationNotifier{},
+			BLSChangesPool:    blstoexec.NewPool(),
+		}
+
+		toSubmit, err := shared.BlsChangesFromConsensus(signedChanges)
+		require.NoError(t, err)
+		b, err := json.Marshal(toSubmit)
+		require.NoError(t, err)
+		var body bytes.Buffer
+		_, err = body.Write(b)
+		require.NoError(t, err)
+		request := httptest.NewRequest(http.MethodPost, "http://example.com/eth/v1/beacon/pool/bls_to_execution_changes", &body)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.SubmitBLSToExecutionChanges(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		time.Sleep(100 * time.Millisecond) // Delay to let the routine start
+		assert.Equal(t, true, broadcaster.BroadcastCalled)
+		assert.Equal(t, numValidators, len(broadcaster.BroadcastMessages))
+
+		poolChanges, err := s.BLSChangesPool.PendingBLSToExecChanges()
+		require.NoError(t, err)
+		require.Equal(t, len(poolChanges), len(signedChanges))
+		for i, change := range poolChanges {
+			require.DeepEqual(t, change, signedChanges[i])
+		}
+	})
+	t.Run("before Capella", func(t *testing.T) {
+		validators, blsChanges, privKeys := blsChangesTestValidators(t, numValidators)
+		spb := &ethpbv1alpha1.BeaconStateBellatrix{
+			Fork: &ethpbv1alpha1.Fork{
+				CurrentVersion:  params.BeaconConfig().BellatrixForkVersion,
+				PreviousVersion: params.BeaconConfig().AltairForkVersion,
+				Epoch:           params.BeaconConfig().BellatrixForkEpoch,
+			},
+			Validators: validators,
+			Balances:   make([]uint64, numValidators),
+		}
+		slot, err := slots.EpochStart(params.BeaconConfig().BellatrixForkEpoch)
+		require.NoError(t, err)
+		spb.Slot = slot
+		st, err := state_native.InitializeFromProtoBellatrix(spb)
+		require.NoError(t, err)
+
+		spc := &ethpbv1alpha1.BeaconStateCapella{
+			Fork: &ethpbv1alpha1.Fork{
+				CurrentVersion:  params.BeaconConfig().GenesisForkVersion,
+				PreviousVersion: params.BeaconConfig().GenesisForkVersion,
+				Epoch:           params.BeaconConfig().CapellaForkEpoch,
+			},
+		}
+		slot, err = slots.EpochStart(params.BeaconConfig().CapellaForkEpoch)
+		require.NoError(t, err)
+		spc.Slot = slot
+		stc, err := state_native.InitializeFromProtoCapella(spc)
+		require.NoError(t, err)
+
+		signedChanges := make([]*ethpbv1alpha1.SignedBLSToExecutionChange, numValidators)
+		for i, message := range blsChanges {
+			signature, err := signing.ComputeDomainAndSign(stc, prysmtime.CurrentEpoch(stc), message, params.BeaconConfig().DomainBLSToExecutionChange, privKeys[i])
+			require.NoError(t, err)
+			signedChanges[i] = &ethpbv1alpha1.SignedBLSToExecutionChange{
+				Message:   message,
+				Signature: signature,
+			}
+		}
+
+		broadcaster := &p2pMock.MockBroadcaster{}
+		chainService := &blockchainmock.ChainService{State: st}
+		s := &Server{
+			HeadFetcher:       chainService,
+			ChainInfoFetcher:  chainService,
+			AttestationsPool:  attestations.NewPool(),
+			Broadcaster:       broadcaster,
+			OperationNotifier: &blockchainmock.MockOperationNotifier{},
+			BLSChangesPool:    blstoexec.NewPool(),
+		}
+
+		toSubmit, err := shared.BlsChangesFromConsensus(signedChanges)
+		require.NoError(t, err)
+		b, err := json.Marshal(toSubmit)
+		require.NoError(t, err)
+		var body bytes.Buffer
+		_, err = body.Write(b)
+		require.NoError(t, err)
+		request := httptest.NewRequest(http.MethodPost, "http://example.com/eth/v1/beacon/pool/bls_to_execution_changes", &body)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.SubmitBLSToExecutionChanges(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		// Check that we didn't broadcast the messages but did in fact fill in the pool.
+		assert.Equal(t, false, broadcaster.BroadcastCalled)
+
+		poolChanges, err := s.BLSChangesPool.PendingBLSToExecChanges()
+		require.NoError(t, err)
+		require.Equal(t, len(poolChanges), len(signedChanges))
+		for i, change := range poolChanges {
+			require.DeepEqual(t, change, signedChanges[i])
+		}
+	})
+	t.Run("failures", func(t *testing.T) {
+		validators, blsChanges, privKeys := blsChangesTestValidators(t, numValidators)
+		spb := &ethpbv1alpha1.BeaconStateCapella{
+			Fork: &ethpbv1alpha1.Fork{
+				CurrentVersion:  params.BeaconConfig().GenesisForkVersion,
+				PreviousVersion: params.BeaconConfig().GenesisForkVersion,
+				Epoch:           params.BeaconConfig().CapellaForkEpoch,
+			},
+			Validators: validators,
+			Balances:   make([]uint64, numValidators),
+		}
+		slot, err := slots.EpochStart(params.BeaconConfig().CapellaForkEpoch)
+		require.NoError(t, err)
+		spb.Slot = slot
+		st, err := state_native.InitializeFromProtoCapella(spb)
+		require.NoError(t, err)
+
+		signedChanges := make([]*ethpbv1alpha1.SignedBLSToExecutionChange, numValidators)
+		for i, message := range blsChanges {
+			signature, err := signing.ComputeDomainAndSign(st, prysmtime.CurrentEpoch(st), message, params.BeaconConfig().DomainBLSToExecutionChange, privKeys[i])
+			require.NoError(t, err)
+			signedChanges[i] = &ethpbv1alpha1.SignedBLSToExecutionChange{
+				Message:   message,
+				Signature: signature,
+			}
+		}
+		signedChanges[1].Signature[0] = 0x00
+
+		broadcaster := &p2pMock.MockBroadcaster{}
+		chainService := &blockchainmock.ChainService{State: st}
+		s := &Server{
+			HeadFetcher:       chainService,
+			ChainInfoFetcher:  chainService,
+			AttestationsPool:  attestations.NewPool(),
+			Broadcaster:       broadcaster,
+			OperationNotifier: &blockchainmock.MockOperationNotifier{},
+			BLSChangesPool:    blstoexec.NewPool(),
+		}
+
+		toSubmit, err := shared.BlsChangesFromConsensus(signedChanges)
+		require.NoError(t, err)
+		b, err := json.Marshal(toSubmit)
+		require.NoError(t, err)
+		var body bytes.Buffer
+		_, err = body.Write(b)
+		require.NoError(t, err)
+		request := httptest.NewRequest(http.MethodPost, "http://example.com/eth/v1/beacon/pool/bls_to_execution_changes", &body)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.SubmitBLSToExecutionChanges(writer, request)
+		time.Sleep(10 * time.Millisecond) // Delay to allow the routine to start
+		require.Equal(t, http.StatusBadRequest, writer.Code)
+		e := &shared.IndexedVerificationFailureError{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), e))
+		assert.Equal(t, http.StatusBadRequest, e.Code)
+		assert.Equal(t, "One or more BLSToExecutionChange failed validation", e.Message)
+		require.Equal(t, 1, len(e.Failures))
+		assert.Equal(t, 1, e.Failures[0].Index)
+		assert.Equal(t, true, broadcaster.BroadcastCalled)
+		assert.Equal(t, numValidators, len(broadcaster.BroadcastMessages)+1)
+
+		poolChanges, err := s.BLSChangesPool.PendingBLSToExecChanges()
+		require.NoError(t, err)
+		require.Equal(t, len(poolChanges)+1, len(signedChanges))
+		require.DeepEqual(t, poolChanges[0], signedChanges[0])
+		for i := 2; i < numValidators; i++ {
+			require.DeepEqual(t, poolChanges[i-1], signedChanges[i])
+		}
+	})
+	t.Run("no body", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodPost, "http://example.com/eth/v1/beacon/pool/bls_to_execution_changes", nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s := &Server{}
+		s.SubmitBLSToExecutionChanges(writer, request)
+		require.Equal(t, http.StatusBadRequest, writer.Code)
+		e := &http2.DefaultErrorJson{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), e))
+		assert.Equal(t, http.StatusBadRequest, e.Code)
+		assert.Equal(t, true, strings.Contains(e.Message, "No data submitted"))
+	})
+}
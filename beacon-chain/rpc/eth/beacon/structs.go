@@ -1,6 +1,8 @@
 package beacon
 
 import (
+	"encoding/json"
+
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/rpc/eth/shared"
 )
 
@@ -25,6 +27,10 @@ type DepositContractResponse struct {
 	} `json:"data"`
 }
 
+type GetDepositSnapshotResponse struct {
+	Data *shared.DepositSnapshot `json:"data"`
+}
+
 type ListAttestationsResponse struct {
 	Data []*shared.Attestation `json:"data"`
 }
@@ -81,6 +87,48 @@ type GetBlockHeaderResponse struct {
 	Data                *shared.SignedBeaconBlockHeaderContainer `json:"data"`
 }
 
+type GetBlockResponse struct {
+	Data *shared.SignedBeaconBlock `json:"data"`
+}
+
+type GetBlockV2Response struct {
+	Version             string       `json:"version"`
+	ExecutionOptimistic bool         `json:"execution_optimistic"`
+	Finalized           bool         `json:"finalized"`
+	Data                *SignedBlock `json:"data"`
+}
+
+type SignedBlock struct {
+	Message   json.RawMessage `json:"message"` // represents the block values based on the version
+	Signature string          `json:"signature"`
+}
+
+type GetBlockAttestationsResponse struct {
+	ExecutionOptimistic bool                  `json:"execution_optimistic"`
+	Finalized           bool                  `json:"finalized"`
+	Data                []*shared.Attestation `json:"data"`
+}
+
+type GetWeakSubjectivityResponse struct {
+	Data *WeakSubjectivityData `json:"data"`
+}
+
+type WeakSubjectivityData struct {
+	WsCheckpoint *shared.Checkpoint `json:"ws_checkpoint"`
+	StateRoot    string             `json:"state_root"`
+}
+
+type GetSyncCommitteeResponse struct {
+	ExecutionOptimistic bool                     `json:"execution_optimistic"`
+	Finalized           bool                     `json:"finalized"`
+	Data                *SyncCommitteeValidators `json:"data"`
+}
+
+type SyncCommitteeValidators struct {
+	Validators          []string   `json:"validators"`
+	ValidatorAggregates [][]string `json:"validator_aggregates"`
+}
+
 type GetValidatorsResponse struct {
 	ExecutionOptimistic bool                  `json:"execution_optimistic"`
 	Finalized           bool                  `json:"finalized"`
@@ -121,3 +169,43 @@ type ValidatorBalance struct {
 	Index   string `json:"index"`
 	Balance string `json:"balance"`
 }
+
+type GetStateRootResponse struct {
+	ExecutionOptimistic bool       `json:"execution_optimistic"`
+	Finalized           bool       `json:"finalized"`
+	Data                *StateRoot `json:"data"`
+}
+
+type StateRoot struct {
+	Root string `json:"root"`
+}
+
+type GetRandaoResponse struct {
+	ExecutionOptimistic bool    `json:"execution_optimistic"`
+	Finalized           bool    `json:"finalized"`
+	Data                *Randao `json:"data"`
+}
+
+type Randao struct {
+	Randao string `json:"randao"`
+}
+
+type GetAttesterSlashingsResponse struct {
+	Data []*shared.AttesterSlashing `json:"data"`
+}
+
+type GetProposerSlashingsResponse struct {
+	Data []*shared.ProposerSlashing `json:"data"`
+}
+
+type BLSToExecutionChangesPoolResponse struct {
+	Data []*shared.SignedBlsToExecutionChange `json:"data"`
+}
+
+type GetForkScheduleResponse struct {
+	Data []*shared.Fork `json:"data"`
+}
+
+type GetSpecResponse struct {
+	Data map[string]string `json:"data"`
+}
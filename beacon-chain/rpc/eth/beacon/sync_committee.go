@@ -1,71 +1,88 @@
 package beacon
 
 import (
-	"context"
 	"fmt"
+	"net/http"
+	"strconv"
 
+	"github.com/gorilla/mux"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/core/altair"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/rpc/eth/helpers"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/rpc/eth/shared"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/state"
 	"github.com/prysmaticlabs/prysm/v4/config/params"
 	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/v4/encoding/bytesutil"
-	ethpbv2 "github.com/prysmaticlabs/prysm/v4/proto/eth/v2"
+	http2 "github.com/prysmaticlabs/prysm/v4/network/http"
 	ethpbalpha "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/v4/time/slots"
 	"go.opencensus.io/trace"
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
 )
 
-// ListSyncCommittees retrieves the sync committees for the given epoch.
+// GetSyncCommittees retrieves the sync committees for the given epoch.
 // If the epoch is not passed in, then the sync committees for the epoch of the state will be obtained.
-func (bs *Server) ListSyncCommittees(ctx context.Context, req *ethpbv2.StateSyncCommitteesRequest) (*ethpbv2.StateSyncCommitteesResponse, error) {
-	ctx, span := trace.StartSpan(ctx, "beacon.ListSyncCommittees")
+func (s *Server) GetSyncCommittees(w http.ResponseWriter, r *http.Request) {
+	ctx, span := trace.StartSpan(r.Context(), "beacon.GetSyncCommittees")
 	defer span.End()
 
-	currentSlot := bs.GenesisTimeFetcher.CurrentSlot()
+	stateId := mux.Vars(r)["state_id"]
+	if stateId == "" {
+		http2.HandleError(w, "state_id is required in URL params", http.StatusBadRequest)
+		return
+	}
+	ok, rawEpoch, e := shared.UintFromQuery(w, r, "epoch")
+	if !ok {
+		return
+	}
+	epoch := primitives.Epoch(e)
+
+	currentSlot := s.GenesisTimeFetcher.CurrentSlot()
 	currentEpoch := slots.ToEpoch(currentSlot)
 	currentPeriodStartEpoch, err := slots.SyncCommitteePeriodStartEpoch(currentEpoch)
 	if err != nil {
-		return nil, status.Errorf(
-			codes.Internal,
-			"Could not calculate start period for slot %d: %v",
-			currentSlot,
-			err,
-		)
+		http2.HandleError(w, fmt.Sprintf("Could not calculate start period for slot %d: %v", currentSlot, err), http.StatusInternalServerError)
+		return
 	}
 
 	requestNextCommittee := false
-	if req.Epoch != nil {
-		reqPeriodStartEpoch, err := slots.SyncCommitteePeriodStartEpoch(*req.Epoch)
+	if rawEpoch != "" {
+		reqPeriodStartEpoch, err := slots.SyncCommitteePeriodStartEpoch(epoch)
 		if err != nil {
-			return nil, status.Errorf(
-				codes.Internal,
-				"Could not calculate start period for epoch %d: %v",
-				*req.Epoch,
-				err,
-			)
+			http2.HandleError(w, fmt.Sprintf("Could not calculate start period for epoch %d: %v", epoch, err), http.StatusInternalServerError)
+			return
 		}
 		if reqPeriodStartEpoch > currentPeriodStartEpoch+params.BeaconConfig().EpochsPerSyncCommitteePeriod {
-			return nil, status.Errorf(
-				codes.Internal,
-				"Could not fetch sync committee too far in the future. Requested epoch: %d, current epoch: %d",
-				*req.Epoch, currentEpoch,
+			http2.HandleError(
+				w,
+				fmt.Sprintf("Could not fetch sync committee too far in the future (requested epoch %d, current epoch %d)", epoch, currentEpoch),
+				http.StatusBadRequest,
 			)
+			return
 		}
 		if reqPeriodStartEpoch > currentPeriodStartEpoch {
 			requestNextCommittee = true
-			req.Epoch = &currentPeriodStartEpoch
+			epoch = currentPeriodStartEpoch
 		}
 	}
 
-	st, err := bs.stateFromRequest(ctx, &stateRequest{
-		epoch:   req.Epoch,
-		stateId: req.StateId,
-	})
-	if err != nil {
-		return nil, status.Errorf(codes.Internal, "Could not fetch beacon state using request: %v", err)
+	var st state.BeaconState
+	if rawEpoch != "" {
+		slot, err := slots.EpochStart(epoch)
+		if err != nil {
+			http2.HandleError(w, fmt.Sprintf("Could not calculate start slot for epoch %d: %v", epoch, err), http.StatusInternalServerError)
+			return
+		}
+		st, err = s.Stater.State(ctx, []byte(strconv.FormatUint(uint64(slot), 10)))
+		if err != nil {
+			shared.WriteStateFetchError(w, err)
+			return
+		}
+	} else {
+		st, err = s.Stater.State(ctx, []byte(stateId))
+		if err != nil {
+			shared.WriteStateFetchError(w, err)
+			return
+		}
 	}
 
 	var committeeIndices []primitives.ValidatorIndex
@@ -74,39 +91,47 @@ func (bs *Server) ListSyncCommittees(ctx context.Context, req *ethpbv2.StateSync
 		// Get the next sync committee and sync committee indices from the state.
 		committeeIndices, committee, err = nextCommitteeIndicesFromState(st)
 		if err != nil {
-			return nil, status.Errorf(codes.Internal, "Could not get next sync committee indices: %v", err)
+			http2.HandleError(w, "Could not get next sync committee indices: "+err.Error(), http.StatusInternalServerError)
+			return
 		}
 	} else {
 		// Get the current sync committee and sync committee indices from the state.
 		committeeIndices, committee, err = currentCommitteeIndicesFromState(st)
 		if err != nil {
-			return nil, status.Errorf(codes.Internal, "Could not get current sync committee indices: %v", err)
+			http2.HandleError(w, "Could not get current sync committee indices: "+err.Error(), http.StatusInternalServerError)
+			return
 		}
 	}
 	subcommittees, err := extractSyncSubcommittees(st, committee)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "Could not extract sync subcommittees: %v", err)
+		http2.HandleError(w, "Could not extract sync subcommittees: "+err.Error(), http.StatusInternalServerError)
+		return
 	}
 
-	isOptimistic, err := helpers.IsOptimistic(ctx, req.StateId, bs.OptimisticModeFetcher, bs.Stater, bs.ChainInfoFetcher, bs.BeaconDB)
+	isOptimistic, err := helpers.IsOptimistic(ctx, []byte(stateId), s.OptimisticModeFetcher, s.Stater, s.ChainInfoFetcher, s.BeaconDB)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "Could not check if slot's block is optimistic: %v", err)
+		http2.HandleError(w, "Could not check if slot's block is optimistic: "+err.Error(), http.StatusInternalServerError)
+		return
 	}
-
 	blockRoot, err := st.LatestBlockHeader().HashTreeRoot()
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "Could not calculate root of latest block header")
+		http2.HandleError(w, "Could not calculate root of latest block header: "+err.Error(), http.StatusInternalServerError)
+		return
 	}
-	isFinalized := bs.FinalizationFetcher.IsFinalized(ctx, blockRoot)
+	isFinalized := s.FinalizationFetcher.IsFinalized(ctx, blockRoot)
 
-	return &ethpbv2.StateSyncCommitteesResponse{
-		Data: &ethpbv2.SyncCommitteeValidators{
-			Validators:          committeeIndices,
+	validators := make([]string, len(committeeIndices))
+	for i, idx := range committeeIndices {
+		validators[i] = strconv.FormatUint(uint64(idx), 10)
+	}
+	http2.WriteJson(w, &GetSyncCommitteeResponse{
+		Data: &SyncCommitteeValidators{
+			Validators:          validators,
 			ValidatorAggregates: subcommittees,
 		},
 		ExecutionOptimistic: isOptimistic,
 		Finalized:           isFinalized,
-	}, nil
+	})
 }
 
 func committeeIndicesFromState(st state.BeaconState, committee *ethpbalpha.SyncCommittee) ([]primitives.ValidatorIndex, *ethpbalpha.SyncCommittee, error) {
@@ -146,9 +171,9 @@ func nextCommitteeIndicesFromState(st state.BeaconState) ([]primitives.Validator
 	return committeeIndicesFromState(st, committee)
 }
 
-func extractSyncSubcommittees(st state.BeaconState, committee *ethpbalpha.SyncCommittee) ([]*ethpbv2.SyncSubcommitteeValidators, error) {
+func extractSyncSubcommittees(st state.BeaconState, committee *ethpbalpha.SyncCommittee) ([][]string, error) {
 	subcommitteeCount := params.BeaconConfig().SyncCommitteeSubnetCount
-	subcommittees := make([]*ethpbv2.SyncSubcommitteeValidators, subcommitteeCount)
+	subcommittees := make([][]string, subcommitteeCount)
 	for i := uint64(0); i < subcommitteeCount; i++ {
 		pubkeys, err := altair.SyncSubCommitteePubkeys(committee, primitives.CommitteeIndex(i))
 		if err != nil {
@@ -156,7 +181,7 @@ func extractSyncSubcommittees(st state.BeaconState, committee *ethpbalpha.SyncCo
 				"failed to get subcommittee pubkeys: %v", err,
 			)
 		}
-		subcommittee := &ethpbv2.SyncSubcommitteeValidators{Validators: make([]primitives.ValidatorIndex, len(pubkeys))}
+		subcommittee := make([]string, len(pubkeys))
 		for j, key := range pubkeys {
 			index, ok := st.ValidatorIndexByPubkey(bytesutil.ToBytes48(key))
 			if !ok {
@@ -165,7 +190,7 @@ func extractSyncSubcommittees(st state.BeaconState, committee *ethpbalpha.SyncCo
 					bytesutil.Trunc(key),
 				)
 			}
-			subcommittee.Validators[j] = index
+			subcommittee[j] = strconv.FormatUint(uint64(index), 10)
 		}
 		subcommittees[i] = subcommittee
 	}
@@ -3,10 +3,16 @@ package beacon
 import (
 	"bytes"
 	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"strconv"
 	"testing"
 	"time"
 
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/gorilla/mux"
 	mock "github.com/prysmaticlabs/prysm/v4/beacon-chain/blockchain/testing"
 	dbTest "github.com/prysmaticlabs/prysm/v4/beacon-chain/db/testing"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/rpc/testutil"
@@ -14,13 +20,11 @@ import (
 	"github.com/prysmaticlabs/prysm/v4/config/params"
 	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/v4/encoding/bytesutil"
-	ethpbv2 "github.com/prysmaticlabs/prysm/v4/proto/eth/v2"
+	http2 "github.com/prysmaticlabs/prysm/v4/network/http"
 	ethpbalpha "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/v4/testing/assert"
 	"github.com/prysmaticlabs/prysm/v4/testing/require"
 	"github.com/prysmaticlabs/prysm/v4/testing/util"
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
 )
 
 func Test_currentCommitteeIndicesFromState(t *testing.T) {
@@ -99,17 +103,17 @@ func Test_extractSyncSubcommittees(t *testing.T) {
 
 	commSize := params.BeaconConfig().SyncCommitteeSize
 	subCommSize := params.BeaconConfig().SyncCommitteeSize / params.BeaconConfig().SyncCommitteeSubnetCount
-	wantedSubcommitteeValidators := make([][]primitives.ValidatorIndex, 0)
+	wantedSubcommitteeValidators := make([][]string, 0)
 
 	for i := uint64(0); i < commSize; i += subCommSize {
-		sub := make([]primitives.ValidatorIndex, 0)
+		sub := make([]string, 0)
 		start := i
 		end := i + subCommSize
 		if end > commSize {
 			end = commSize
 		}
 		for j := start; j < end; j++ {
-			sub = append(sub, primitives.ValidatorIndex(j))
+			sub = append(sub, strconv.FormatUint(j, 10))
 		}
 		wantedSubcommitteeValidators = append(wantedSubcommitteeValidators, sub)
 	}
@@ -121,7 +125,7 @@ func Test_extractSyncSubcommittees(t *testing.T) {
 		require.NoError(t, err)
 		for i, got := range subcommittee {
 			want := wantedSubcommitteeValidators[i]
-			require.DeepEqual(t, want, got.Validators)
+			require.DeepEqual(t, want, got)
 		}
 	})
 	t.Run("validator in subcommittee not found in state", func(t *testing.T) {
@@ -137,7 +141,7 @@ func Test_extractSyncSubcommittees(t *testing.T) {
 	})
 }
 
-func TestListSyncCommittees(t *testing.T) {
+func TestGetSyncCommittees(t *testing.T) {
 	ctx := context.Background()
 	st, _ := util.DeterministicGenesisStateAltair(t, params.BeaconConfig().SyncCommitteeSize)
 	syncCommittee := make([][]byte, params.BeaconConfig().SyncCommitteeSize)
@@ -168,24 +172,31 @@ func TestListSyncCommittees(t *testing.T) {
 		BeaconDB:              db,
 		ChainInfoFetcher:      chainService,
 	}
-	req := &ethpbv2.StateSyncCommitteesRequest{StateId: stRoot[:]}
-	resp, err := s.ListSyncCommittees(ctx, req)
-	require.NoError(t, err)
+
+	request := httptest.NewRequest(http.MethodGet, "http://example.com/eth/v1/beacon/states/{state_id}/sync_committees", nil)
+	request = mux.SetURLVars(request, map[string]string{"state_id": hexutil.Encode(stRoot[:])})
+	writer := httptest.NewRecorder()
+	writer.Body = &bytes.Buffer{}
+
+	s.GetSyncCommittees(writer, request)
+	assert.Equal(t, http.StatusOK, writer.Code)
+	resp := &GetSyncCommitteeResponse{}
+	require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
 	require.NotNil(t, resp.Data)
 	committeeVals := resp.Data.Validators
 	require.NotNil(t, committeeVals)
 	require.Equal(t, params.BeaconConfig().SyncCommitteeSize, uint64(len(committeeVals)), "incorrect committee size")
 	for i := uint64(0); i < params.BeaconConfig().SyncCommitteeSize; i++ {
-		assert.Equal(t, primitives.ValidatorIndex(i), committeeVals[i])
+		assert.Equal(t, strconv.FormatUint(i, 10), committeeVals[i])
 	}
 	require.NotNil(t, resp.Data.ValidatorAggregates)
 	assert.Equal(t, params.BeaconConfig().SyncCommitteeSubnetCount, uint64(len(resp.Data.ValidatorAggregates)))
 	for i := uint64(0); i < params.BeaconConfig().SyncCommitteeSubnetCount; i++ {
-		vStartIndex := primitives.ValidatorIndex(params.BeaconConfig().SyncCommitteeSize / params.BeaconConfig().SyncCommitteeSubnetCount * i)
-		vEndIndex := primitives.ValidatorIndex(params.BeaconConfig().SyncCommitteeSize/params.BeaconConfig().SyncCommitteeSubnetCount*(i+1) - 1)
+		vStartIndex := params.BeaconConfig().SyncCommitteeSize / params.BeaconConfig().SyncCommitteeSubnetCount * i
+		vEndIndex := params.BeaconConfig().SyncCommitteeSize/params.BeaconConfig().SyncCommitteeSubnetCount*(i+1) - 1
 		j := 0
 		for vIndex := vStartIndex; vIndex <= vEndIndex; vIndex++ {
-			assert.Equal(t, vIndex, resp.Data.ValidatorAggregates[i].Validators[j])
+			assert.Equal(t, strconv.FormatUint(vIndex, 10), resp.Data.ValidatorAggregates[i][j])
 			j++
 		}
 	}
@@ -214,8 +225,16 @@ func TestListSyncCommittees(t *testing.T) {
 			BeaconDB:              db,
 			ChainInfoFetcher:      chainService,
 		}
-		resp, err := s.ListSyncCommittees(ctx, req)
-		require.NoError(t, err)
+
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/eth/v1/beacon/states/{state_id}/sync_committees", nil)
+		request = mux.SetURLVars(request, map[string]string{"state_id": hexutil.Encode(stRoot[:])})
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetSyncCommittees(writer, request)
+		assert.Equal(t, http.StatusOK, writer.Code)
+		resp := &GetSyncCommitteeResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
 		assert.Equal(t, true, resp.ExecutionOptimistic)
 	})
 
@@ -250,8 +269,16 @@ func TestListSyncCommittees(t *testing.T) {
 			BeaconDB:              db,
 			ChainInfoFetcher:      chainService,
 		}
-		resp, err := s.ListSyncCommittees(ctx, req)
-		require.NoError(t, err)
+
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/eth/v1/beacon/states/{state_id}/sync_committees", nil)
+		request = mux.SetURLVars(request, map[string]string{"state_id": hexutil.Encode(stRoot[:])})
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetSyncCommittees(writer, request)
+		assert.Equal(t, http.StatusOK, writer.Code)
+		resp := &GetSyncCommitteeResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
 		assert.Equal(t, true, resp.Finalized)
 	})
 }
@@ -265,9 +292,8 @@ func (m *futureSyncMockFetcher) State(_ context.Context, stateId []byte) (state.
 	expectedRequest := []byte(strconv.FormatUint(uint64(0), 10))
 	res := bytes.Compare(stateId, expectedRequest)
 	if res != 0 {
-		return nil, status.Errorf(
-			codes.Internal,
-			"Requested wrong epoch for next sync committee, expected: %#x, received: %#x",
+		return nil, fmt.Errorf(
+			"requested wrong epoch for next sync committee, expected: %#x, received: %#x",
 			expectedRequest,
 			stateId,
 		)
@@ -282,8 +308,7 @@ func (m *futureSyncMockFetcher) StateBySlot(context.Context, primitives.Slot) (s
 	return m.BeaconState, nil
 }
 
-func TestListSyncCommitteesFuture(t *testing.T) {
-	ctx := context.Background()
+func TestGetSyncCommitteeFuture(t *testing.T) {
 	st, _ := util.DeterministicGenesisStateAltair(t, params.BeaconConfig().SyncCommitteeSize)
 	syncCommittee := make([][]byte, params.BeaconConfig().SyncCommitteeSize)
 	vals := st.Validators()
@@ -309,31 +334,43 @@ func TestListSyncCommitteesFuture(t *testing.T) {
 		FinalizationFetcher:   chainService,
 		BeaconDB:              db,
 	}
-	req := &ethpbv2.StateSyncCommitteesRequest{StateId: []byte("head")}
+
 	epoch := 2 * params.BeaconConfig().EpochsPerSyncCommitteePeriod
-	req.Epoch = &epoch
-	_, err := s.ListSyncCommittees(ctx, req)
-	require.ErrorContains(t, "Could not fetch sync committee too far in the future", err)
+	request := httptest.NewRequest(http.MethodGet, fmt.Sprintf("http://example.com/eth/v1/beacon/states/{state_id}/sync_committees?epoch=%d", epoch), nil)
+	request = mux.SetURLVars(request, map[string]string{"state_id": "head"})
+	writer := httptest.NewRecorder()
+	writer.Body = &bytes.Buffer{}
+	s.GetSyncCommittees(writer, request)
+	require.Equal(t, http.StatusBadRequest, writer.Code)
+	e := &http2.DefaultErrorJson{}
+	require.NoError(t, json.Unmarshal(writer.Body.Bytes(), e))
+	assert.Equal(t, http.StatusBadRequest, e.Code)
+	assert.StringContains(t, "Could not fetch sync committee too far in the future", e.Message)
 
 	epoch = 2*params.BeaconConfig().EpochsPerSyncCommitteePeriod - 1
-	resp, err := s.ListSyncCommittees(ctx, req)
-	require.NoError(t, err)
-
+	request = httptest.NewRequest(http.MethodGet, fmt.Sprintf("http://example.com/eth/v1/beacon/states/{state_id}/sync_committees?epoch=%d", epoch), nil)
+	request = mux.SetURLVars(request, map[string]string{"state_id": "head"})
+	writer = httptest.NewRecorder()
+	writer.Body = &bytes.Buffer{}
+	s.GetSyncCommittees(writer, request)
+	require.Equal(t, http.StatusOK, writer.Code)
+	resp := &GetSyncCommitteeResponse{}
+	require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
 	require.NotNil(t, resp.Data)
 	committeeVals := resp.Data.Validators
 	require.NotNil(t, committeeVals)
 	require.Equal(t, params.BeaconConfig().SyncCommitteeSize, uint64(len(committeeVals)), "incorrect committee size")
 	for i := uint64(0); i < params.BeaconConfig().SyncCommitteeSize; i++ {
-		assert.Equal(t, primitives.ValidatorIndex(i), committeeVals[i])
+		assert.Equal(t, strconv.FormatUint(i, 10), committeeVals[i])
 	}
 	require.NotNil(t, resp.Data.ValidatorAggregates)
 	assert.Equal(t, params.BeaconConfig().SyncCommitteeSubnetCount, uint64(len(resp.Data.ValidatorAggregates)))
 	for i := uint64(0); i < params.BeaconConfig().SyncCommitteeSubnetCount; i++ {
-		vStartIndex := primitives.ValidatorIndex(params.BeaconConfig().SyncCommitteeSize / params.BeaconConfig().SyncCommitteeSubnetCount * i)
-		vEndIndex := primitives.ValidatorIndex(params.BeaconConfig().SyncCommitteeSize/params.BeaconConfig().SyncCommitteeSubnetCount*(i+1) - 1)
+		vStartIndex := params.BeaconConfig().SyncCommitteeSize / params.BeaconConfig().SyncCommitteeSubnetCount * i
+		vEndIndex := params.BeaconConfig().SyncCommitteeSize/params.BeaconConfig().SyncCommitteeSubnetCount*(i+1) - 1
 		j := 0
 		for vIndex := vStartIndex; vIndex <= vEndIndex; vIndex++ {
-			assert.Equal(t, vIndex, resp.Data.ValidatorAggregates[i].Validators[j])
+			assert.Equal(t, strconv.FormatUint(vIndex, 10), resp.Data.ValidatorAggregates[i][j])
 			j++
 		}
 	}
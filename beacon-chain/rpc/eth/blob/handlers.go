@@ -1,6 +1,7 @@
 package blob
 
 import (
+	"context"
 	"net/http"
 	"net/url"
 	"strconv"
@@ -8,6 +9,7 @@ import (
 
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/db/filesystem"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/rpc/lookup"
 	field_params "github.com/prysmaticlabs/prysm/v4/config/fieldparams"
 	"github.com/prysmaticlabs/prysm/v4/config/params"
@@ -80,19 +82,29 @@ func (s *Server) Blobs(w http.ResponseWriter, r *http.Request) {
 				http2.HandleError(w, "blobs are not supported before Deneb fork", http.StatusBadRequest)
 				return
 			}
-			sidecars, err = s.BeaconDB.BlobSidecarsBySlot(r.Context(), primitives.Slot(slot), indices...)
+			root, err = s.blockRootAtSlot(r.Context(), primitives.Slot(slot))
 			if err != nil {
-				http2.HandleError(w, errors.Wrapf(err, "could not retrieve blobs for slot %d", slot).Error(), http.StatusInternalServerError)
+				if errors.Is(err, errNoBlockAtSlot) {
+					http2.HandleError(w, err.Error(), http.StatusNotFound)
+					return
+				}
+				http2.HandleError(w, errors.Wrapf(err, "could not retrieve block root for slot %d", slot).Error(), http.StatusInternalServerError)
 				return
 			}
-			http2.WriteJson(w, buildSidecardsResponse(sidecars))
-			return
 		}
 	}
 
 	var err error
-	sidecars, err = s.BeaconDB.BlobSidecarsByRoot(r.Context(), bytesutil.ToBytes32(root), indices...)
-	if err != nil {
+	sidecars, err = s.BlobStorage.SidecarsByRoot(bytesutil.ToBytes32(root), indices...)
+	switch {
+	case err == nil:
+	case errors.Is(err, filesystem.ErrNotFound) && len(indices) == 0 && s.BeaconDB.HasBlock(r.Context(), bytesutil.ToBytes32(root)):
+		// A known block without blobs has an empty list of sidecars.
+		sidecars = []*eth.BlobSidecar{}
+	case errors.Is(err, filesystem.ErrNotFound):
+		http2.HandleError(w, errors.Wrapf(err, "could not find blobs for root %#x", root).Error(), http.StatusNotFound)
+		return
+	default:
 		http2.HandleError(w, errors.Wrapf(err, "could not retrieve blobs for root %#x", root).Error(), http.StatusInternalServerError)
 		return
 	}
@@ -155,3 +167,30 @@ func buildSidecardsResponse(sidecars []*eth.BlobSidecar) *SidecarsResponse {
 	}
 	return resp
 }
+
+var errNoBlockAtSlot = errors.New("no block found at slot")
+
+// blockRootAtSlot returns the root of the block at the given slot. When the database holds more than one
+// block for the slot, the canonical one is returned.
+func (s *Server) blockRootAtSlot(ctx context.Context, slot primitives.Slot) ([]byte, error) {
+	_, roots, err := s.BeaconDB.BlockRootsBySlot(ctx, slot)
+	if err != nil {
+		return nil, err
+	}
+	switch len(roots) {
+	case 0:
+		return nil, errors.Wrapf(errNoBlockAtSlot, "slot %d", slot)
+	case 1:
+		return roots[0][:], nil
+	}
+	for _, r := range roots {
+		canonical, err := s.ChainInfoFetcher.IsCanonical(ctx, r)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not determine if block root %#x is canonical", r)
+		}
+		if canonical {
+			return r[:], nil
+		}
+	}
+	return nil, errors.Wrapf(errNoBlockAtSlot, "no canonical block at slot %d", slot)
+}
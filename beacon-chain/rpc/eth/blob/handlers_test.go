@@ -12,6 +12,7 @@ import (
 
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	mockChain "github.com/prysmaticlabs/prysm/v4/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/db/filesystem"
 	testDB "github.com/prysmaticlabs/prysm/v4/beacon-chain/db/testing"
 	fieldparams "github.com/prysmaticlabs/prysm/v4/config/fieldparams"
 	"github.com/prysmaticlabs/prysm/v4/config/params"
@@ -20,6 +21,7 @@ import (
 	eth "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/v4/testing/assert"
 	"github.com/prysmaticlabs/prysm/v4/testing/require"
+	"github.com/prysmaticlabs/prysm/v4/testing/util"
 )
 
 func TestParseIndices(t *testing.T) {
@@ -33,49 +35,57 @@ func TestBlobs(t *testing.T) {
 	params.OverrideBeaconConfig(cfg)
 
 	db := testDB.SetupDB(t)
-	blockroot := bytesutil.PadTo([]byte("blockroot"), 32)
-	require.NoError(t, db.SaveBlobSidecar(context.Background(), []*eth.BlobSidecar{
+	bs := filesystem.NewEphemeralBlobStorage(t)
+	b := util.NewBeaconBlockDeneb()
+	b.Block.Slot = 123
+	r, err := b.Block.HashTreeRoot()
+	require.NoError(t, err)
+	util.SaveBlock(t, context.Background(), db, b)
+	blockroot := r[:]
+	for _, sc := range []*eth.BlobSidecar{
 		{
 			BlockRoot:       blockroot,
 			Index:           0,
 			Slot:            123,
-			BlockParentRoot: []byte("blockparentroot"),
+			BlockParentRoot: bytesutil.PadTo([]byte("blockparentroot"), fieldparams.RootLength),
 			ProposerIndex:   123,
-			Blob:            []byte("blob0"),
-			KzgCommitment:   []byte("kzgcommitment0"),
-			KzgProof:        []byte("kzgproof0"),
+			Blob:            bytesutil.PadTo([]byte("blob0"), fieldparams.BlobLength),
+			KzgCommitment:   bytesutil.PadTo([]byte("kzgcommitment0"), fieldparams.BLSPubkeyLength),
+			KzgProof:        bytesutil.PadTo([]byte("kzgproof0"), fieldparams.BLSPubkeyLength),
 		},
 		{
 			BlockRoot:       blockroot,
 			Index:           1,
 			Slot:            123,
-			BlockParentRoot: []byte("blockparentroot"),
+			BlockParentRoot: bytesutil.PadTo([]byte("blockparentroot"), fieldparams.RootLength),
 			ProposerIndex:   123,
-			Blob:            []byte("blob1"),
-			KzgCommitment:   []byte("kzgcommitment1"),
-			KzgProof:        []byte("kzgproof1"),
+			Blob:            bytesutil.PadTo([]byte("blob1"), fieldparams.BlobLength),
+			KzgCommitment:   bytesutil.PadTo([]byte("kzgcommitment1"), fieldparams.BLSPubkeyLength),
+			KzgProof:        bytesutil.PadTo([]byte("kzgproof1"), fieldparams.BLSPubkeyLength),
 		},
 		{
 			BlockRoot:       blockroot,
 			Index:           2,
 			Slot:            123,
-			BlockParentRoot: []byte("blockparentroot"),
+			BlockParentRoot: bytesutil.PadTo([]byte("blockparentroot"), fieldparams.RootLength),
 			ProposerIndex:   123,
-			Blob:            []byte("blob2"),
-			KzgCommitment:   []byte("kzgcommitment2"),
-			KzgProof:        []byte("kzgproof2"),
+			Blob:            bytesutil.PadTo([]byte("blob2"), fieldparams.BlobLength),
+			KzgCommitment:   bytesutil.PadTo([]byte("kzgcommitment2"), fieldparams.BLSPubkeyLength),
+			KzgProof:        bytesutil.PadTo([]byte("kzgproof2"), fieldparams.BLSPubkeyLength),
 		},
 		{
 			BlockRoot:       blockroot,
 			Index:           3,
 			Slot:            123,
-			BlockParentRoot: []byte("blockparentroot"),
+			BlockParentRoot: bytesutil.PadTo([]byte("blockparentroot"), fieldparams.RootLength),
 			ProposerIndex:   123,
-			Blob:            []byte("blob3"),
-			KzgCommitment:   []byte("kzgcommitment3"),
-			KzgProof:        []byte("kzgproof3"),
+			Blob:            bytesutil.PadTo([]byte("blob3"), fieldparams.BlobLength),
+			KzgCommitment:   bytesutil.PadTo([]byte("kzgcommitment3"), fieldparams.BLSPubkeyLength),
+			KzgProof:        bytesutil.PadTo([]byte("kzgproof3"), fieldparams.BLSPubkeyLength),
 		},
-	}))
+	} {
+		require.NoError(t, bs.Save(sc))
+	}
 
 	t.Run("genesis", func(t *testing.T) {
 		u := "http://foo.example/genesis"
@@ -100,6 +110,7 @@ func TestBlobs(t *testing.T) {
 		s := &Server{
 			ChainInfoFetcher: &mockChain.ChainService{Root: blockroot},
 			BeaconDB:         db,
+			BlobStorage:      bs,
 		}
 
 		s.Blobs(writer, request)
@@ -110,44 +121,44 @@ func TestBlobs(t *testing.T) {
 		require.Equal(t, 4, len(resp.Data))
 		sidecar := resp.Data[0]
 		require.NotNil(t, sidecar)
-		assert.Equal(t, "0x626c6f636b726f6f740000000000000000000000000000000000000000000000", sidecar.BlockRoot)
+		assert.Equal(t, hexutil.Encode(blockroot), sidecar.BlockRoot)
 		assert.Equal(t, "0", sidecar.Index)
 		assert.Equal(t, "123", sidecar.Slot)
-		assert.Equal(t, "0x626c6f636b706172656e74726f6f74", sidecar.BlockParentRoot)
+		assert.Equal(t, true, strings.HasPrefix(sidecar.BlockParentRoot, "0x626c6f636b706172656e74726f6f74"))
 		assert.Equal(t, "123", sidecar.ProposerIndex)
-		assert.Equal(t, "0x626c6f6230", sidecar.Blob)
-		assert.Equal(t, "0x6b7a67636f6d6d69746d656e7430", sidecar.KZGCommitment)
-		assert.Equal(t, "0x6b7a6770726f6f6630", sidecar.KZGProof)
+		assert.Equal(t, true, strings.HasPrefix(sidecar.Blob, "0x626c6f6230"))
+		assert.Equal(t, true, strings.HasPrefix(sidecar.KZGCommitment, "0x6b7a67636f6d6d69746d656e7430"))
+		assert.Equal(t, true, strings.HasPrefix(sidecar.KZGProof, "0x6b7a6770726f6f6630"))
 		sidecar = resp.Data[1]
 		require.NotNil(t, sidecar)
-		assert.Equal(t, "0x626c6f636b726f6f740000000000000000000000000000000000000000000000", sidecar.BlockRoot)
+		assert.Equal(t, hexutil.Encode(blockroot), sidecar.BlockRoot)
 		assert.Equal(t, "1", sidecar.Index)
 		assert.Equal(t, "123", sidecar.Slot)
-		assert.Equal(t, "0x626c6f636b706172656e74726f6f74", sidecar.BlockParentRoot)
+		assert.Equal(t, true, strings.HasPrefix(sidecar.BlockParentRoot, "0x626c6f636b706172656e74726f6f74"))
 		assert.Equal(t, "123", sidecar.ProposerIndex)
-		assert.Equal(t, "0x626c6f6231", sidecar.Blob)
-		assert.Equal(t, "0x6b7a67636f6d6d69746d656e7431", sidecar.KZGCommitment)
-		assert.Equal(t, "0x6b7a6770726f6f6631", sidecar.KZGProof)
+		assert.Equal(t, true, strings.HasPrefix(sidecar.Blob, "0x626c6f6231"))
+		assert.Equal(t, true, strings.HasPrefix(sidecar.KZGCommitment, "0x6b7a67636f6d6d69746d656e7431"))
+		assert.Equal(t, true, strings.HasPrefix(sidecar.KZGProof, "0x6b7a6770726f6f6631"))
 		sidecar = resp.Data[2]
 		require.NotNil(t, sidecar)
-		assert.Equal(t, "0x626c6f636b726f6f740000000000000000000000000000000000000000000000", sidecar.BlockRoot)
+		assert.Equal(t, hexutil.Encode(blockroot), sidecar.BlockRoot)
 		assert.Equal(t, "2", sidecar.Index)
 		assert.Equal(t, "123", sidecar.Slot)
-		assert.Equal(t, "0x626c6f636b706172656e74726f6f74", sidecar.BlockParentRoot)
+		assert.Equal(t, true, strings.HasPrefix(sidecar.BlockParentRoot, "0x626c6f636b706172656e74726f6f74"))
 		assert.Equal(t, "123", sidecar.ProposerIndex)
-		assert.Equal(t, "0x626c6f6232", sidecar.Blob)
-		assert.Equal(t, "0x6b7a67636f6d6d69746d656e7432", sidecar.KZGCommitment)
-		assert.Equal(t, "0x6b7a6770726f6f6632", sidecar.KZGProof)
+		assert.Equal(t, true, strings.HasPrefix(sidecar.Blob, "0x626c6f6232"))
+		assert.Equal(t, true, strings.HasPrefix(sidecar.KZGCommitment, "0x6b7a67636f6d6d69746d656e7432"))
+		assert.Equal(t, true, strings.HasPrefix(sidecar.KZGProof, "0x6b7a6770726f6f6632"))
 		sidecar = resp.Data[3]
 		require.NotNil(t, sidecar)
-		assert.Equal(t, "0x626c6f636b726f6f740000000000000000000000000000000000000000000000", sidecar.BlockRoot)
+		assert.Equal(t, hexutil.Encode(blockroot), sidecar.BlockRoot)
 		assert.Equal(t, "3", sidecar.Index)
 		assert.Equal(t, "123", sidecar.Slot)
-		assert.Equal(t, "0x626c6f636b706172656e74726f6f74", sidecar.BlockParentRoot)
+		assert.Equal(t, true, strings.HasPrefix(sidecar.BlockParentRoot, "0x626c6f636b706172656e74726f6f74"))
 		assert.Equal(t, "123", sidecar.ProposerIndex)
-		assert.Equal(t, "0x626c6f6233", sidecar.Blob)
-		assert.Equal(t, "0x6b7a67636f6d6d69746d656e7433", sidecar.KZGCommitment)
-		assert.Equal(t, "0x6b7a6770726f6f6633", sidecar.KZGProof)
+		assert.Equal(t, true, strings.HasPrefix(sidecar.Blob, "0x626c6f6233"))
+		assert.Equal(t, true, strings.HasPrefix(sidecar.KZGCommitment, "0x6b7a67636f6d6d69746d656e7433"))
+		assert.Equal(t, true, strings.HasPrefix(sidecar.KZGProof, "0x6b7a6770726f6f6633"))
 	})
 	t.Run("finalized", func(t *testing.T) {
 		u := "http://foo.example/finalized"
@@ -157,6 +168,7 @@ func TestBlobs(t *testing.T) {
 		s := &Server{
 			ChainInfoFetcher: &mockChain.ChainService{FinalizedCheckPoint: &eth.Checkpoint{Root: blockroot}},
 			BeaconDB:         db,
+			BlobStorage:      bs,
 		}
 
 		s.Blobs(writer, request)
@@ -174,6 +186,7 @@ func TestBlobs(t *testing.T) {
 		s := &Server{
 			ChainInfoFetcher: &mockChain.ChainService{CurrentJustifiedCheckPoint: &eth.Checkpoint{Root: blockroot}},
 			BeaconDB:         db,
+			BlobStorage:      bs,
 		}
 
 		s.Blobs(writer, request)
@@ -189,7 +202,8 @@ func TestBlobs(t *testing.T) {
 		writer := httptest.NewRecorder()
 		writer.Body = &bytes.Buffer{}
 		s := &Server{
-			BeaconDB: db,
+			BeaconDB:    db,
+			BlobStorage: bs,
 		}
 
 		s.Blobs(writer, request)
@@ -205,7 +219,8 @@ func TestBlobs(t *testing.T) {
 		writer := httptest.NewRecorder()
 		writer.Body = &bytes.Buffer{}
 		s := &Server{
-			BeaconDB: db,
+			BeaconDB:    db,
+			BlobStorage: bs,
 		}
 
 		s.Blobs(writer, request)
@@ -221,7 +236,8 @@ func TestBlobs(t *testing.T) {
 		writer := httptest.NewRecorder()
 		writer.Body = &bytes.Buffer{}
 		s := &Server{
-			BeaconDB: db,
+			BeaconDB:    db,
+			BlobStorage: bs,
 		}
 
 		s.Blobs(writer, request)
@@ -232,14 +248,14 @@ func TestBlobs(t *testing.T) {
 		require.Equal(t, 1, len(resp.Data))
 		sidecar := resp.Data[0]
 		require.NotNil(t, sidecar)
-		assert.Equal(t, "0x626c6f636b726f6f740000000000000000000000000000000000000000000000", sidecar.BlockRoot)
+		assert.Equal(t, hexutil.Encode(blockroot), sidecar.BlockRoot)
 		assert.Equal(t, "2", sidecar.Index)
 		assert.Equal(t, "123", sidecar.Slot)
-		assert.Equal(t, "0x626c6f636b706172656e74726f6f74", sidecar.BlockParentRoot)
+		assert.Equal(t, true, strings.HasPrefix(sidecar.BlockParentRoot, "0x626c6f636b706172656e74726f6f74"))
 		assert.Equal(t, "123", sidecar.ProposerIndex)
-		assert.Equal(t, "0x626c6f6232", sidecar.Blob)
-		assert.Equal(t, "0x6b7a67636f6d6d69746d656e7432", sidecar.KZGCommitment)
-		assert.Equal(t, "0x6b7a6770726f6f6632", sidecar.KZGProof)
+		assert.Equal(t, true, strings.HasPrefix(sidecar.Blob, "0x626c6f6232"))
+		assert.Equal(t, true, strings.HasPrefix(sidecar.KZGCommitment, "0x6b7a67636f6d6d69746d656e7432"))
+		assert.Equal(t, true, strings.HasPrefix(sidecar.KZGProof, "0x6b7a6770726f6f6632"))
 	})
 	t.Run("slot before Deneb fork", func(t *testing.T) {
 		u := "http://foo.example/31"
@@ -271,18 +287,66 @@ func TestBlobs(t *testing.T) {
 		assert.Equal(t, http.StatusBadRequest, e.Code)
 		assert.Equal(t, true, strings.Contains(e.Message, "could not parse block ID"))
 	})
+	t.Run("no blobs for root", func(t *testing.T) {
+		u := "http://foo.example/" + hexutil.Encode(bytesutil.PadTo([]byte("unknown"), 32))
+		request := httptest.NewRequest("GET", u, nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+		s := &Server{
+			BeaconDB:    db,
+			BlobStorage: bs,
+		}
+
+		s.Blobs(writer, request)
+
+		assert.Equal(t, http.StatusNotFound, writer.Code)
+	})
+	t.Run("block without blobs", func(t *testing.T) {
+		noBlobsBlock := util.NewBeaconBlockDeneb()
+		noBlobsBlock.Block.Slot = 125
+		util.SaveBlock(t, context.Background(), db, noBlobsBlock)
+		u := "http://foo.example/125"
+		request := httptest.NewRequest("GET", u, nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+		s := &Server{
+			BeaconDB:    db,
+			BlobStorage: bs,
+		}
+
+		s.Blobs(writer, request)
+
+		assert.Equal(t, http.StatusOK, writer.Code)
+		resp := &SidecarsResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		require.NotNil(t, resp.Data)
+		assert.Equal(t, 0, len(resp.Data))
+	})
+	t.Run("no block at slot", func(t *testing.T) {
+		u := "http://foo.example/124"
+		request := httptest.NewRequest("GET", u, nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+		s := &Server{
+			BeaconDB:    db,
+			BlobStorage: bs,
+		}
+
+		s.Blobs(writer, request)
+
+		assert.Equal(t, http.StatusNotFound, writer.Code)
+	})
 	t.Run("ssz", func(t *testing.T) {
-		require.NoError(t, db.SaveBlobSidecar(context.Background(), []*eth.BlobSidecar{
-			{
-				BlockRoot:       blockroot,
-				Index:           0,
-				Slot:            3,
-				BlockParentRoot: make([]byte, fieldparams.RootLength),
-				ProposerIndex:   123,
-				Blob:            make([]byte, fieldparams.BlobLength),
-				KzgCommitment:   make([]byte, fieldparams.BLSPubkeyLength),
-				KzgProof:        make([]byte, fieldparams.BLSPubkeyLength),
-			},
+		sszRoot := bytesutil.PadTo([]byte("blockroot"), 32)
+		require.NoError(t, bs.Save(&eth.BlobSidecar{
+			BlockRoot:       sszRoot,
+			Index:           0,
+			Slot:            3,
+			BlockParentRoot: make([]byte, fieldparams.RootLength),
+			ProposerIndex:   123,
+			Blob:            make([]byte, fieldparams.BlobLength),
+			KzgCommitment:   make([]byte, fieldparams.BLSPubkeyLength),
+			KzgProof:        make([]byte, fieldparams.BLSPubkeyLength),
 		}))
 		u := "http://foo.example/finalized?indices=0"
 		request := httptest.NewRequest("GET", u, nil)
@@ -290,8 +354,9 @@ func TestBlobs(t *testing.T) {
 		writer := httptest.NewRecorder()
 		writer.Body = &bytes.Buffer{}
 		s := &Server{
-			ChainInfoFetcher: &mockChain.ChainService{FinalizedCheckPoint: &eth.Checkpoint{Root: blockroot}},
+			ChainInfoFetcher: &mockChain.ChainService{FinalizedCheckPoint: &eth.Checkpoint{Root: sszRoot}},
 			BeaconDB:         db,
+			BlobStorage:      bs,
 		}
 
 		s.Blobs(writer, request)
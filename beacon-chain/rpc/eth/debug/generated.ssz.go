@@ -0,0 +1,1158 @@
+// Code generated by fastssz. DO NOT EDIT.
+// Hash: 209d2e9d883d30bd78ce41c4e60241c6091d7568d6db77dfc50c9f2fb0fc5e44
+package debug
+
+import (
+	ssz "github.com/prysmaticlabs/fastssz"
+)
+
+// MarshalSSZ ssz marshals the checkpointSSZ object
+func (c *checkpointSSZ) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(c)
+}
+
+// MarshalSSZTo ssz marshals the checkpointSSZ object to a target array
+func (c *checkpointSSZ) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'Epoch'
+	dst = ssz.MarshalUint64(dst, c.Epoch)
+
+	// Field (1) 'Root'
+	if size := len(c.Root); size != 32 {
+		err = ssz.ErrBytesLengthFn("--.Root", size, 32)
+		return
+	}
+	dst = append(dst, c.Root...)
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the checkpointSSZ object
+func (c *checkpointSSZ) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 40 {
+		return ssz.ErrSize
+	}
+
+	// Field (0) 'Epoch'
+	c.Epoch = ssz.UnmarshallUint64(buf[0:8])
+
+	// Field (1) 'Root'
+	if cap(c.Root) == 0 {
+		c.Root = make([]byte, 0, len(buf[8:40]))
+	}
+	c.Root = append(c.Root, buf[8:40]...)
+
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the checkpointSSZ object
+func (c *checkpointSSZ) SizeSSZ() (size int) {
+	size = 40
+	return
+}
+
+// HashTreeRoot ssz hashes the checkpointSSZ object
+func (c *checkpointSSZ) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(c)
+}
+
+// HashTreeRootWith ssz hashes the checkpointSSZ object with a hasher
+func (c *checkpointSSZ) HashTreeRootWith(hh *ssz.Hasher) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Epoch'
+	hh.PutUint64(c.Epoch)
+
+	// Field (1) 'Root'
+	if size := len(c.Root); size != 32 {
+		err = ssz.ErrBytesLengthFn("--.Root", size, 32)
+		return
+	}
+	hh.PutBytes(c.Root)
+
+	if ssz.EnableVectorizedHTR {
+		hh.MerkleizeVectorizedHTR(indx)
+	} else {
+		hh.Merkleize(indx)
+	}
+	return
+}
+
+// MarshalSSZ ssz marshals the forkChoiceSSZ object
+func (f *forkChoiceSSZ) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(f)
+}
+
+// MarshalSSZTo ssz marshals the forkChoiceSSZ object to a target array
+func (f *forkChoiceSSZ) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := int(260)
+
+	// Field (0) 'JustifiedCheckpoint'
+	if f.JustifiedCheckpoint == nil {
+		f.JustifiedCheckpoint = new(checkpointSSZ)
+	}
+	if dst, err = f.JustifiedCheckpoint.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	// Field (1) 'FinalizedCheckpoint'
+	if f.FinalizedCheckpoint == nil {
+		f.FinalizedCheckpoint = new(checkpointSSZ)
+	}
+	if dst, err = f.FinalizedCheckpoint.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	// Field (2) 'UnrealizedJustifiedCheckpoint'
+	if f.UnrealizedJustifiedCheckpoint == nil {
+		f.UnrealizedJustifiedCheckpoint = new(checkpointSSZ)
+	}
+	if dst, err = f.UnrealizedJustifiedCheckpoint.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	// Field (3) 'UnrealizedFinalizedCheckpoint'
+	if f.UnrealizedFinalizedCheckpoint == nil {
+		f.UnrealizedFinalizedCheckpoint = new(checkpointSSZ)
+	}
+	if dst, err = f.UnrealizedFinalizedCheckpoint.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	// Field (4) 'ProposerBoostRoot'
+	if size := len(f.ProposerBoostRoot); size != 32 {
+		err = ssz.ErrBytesLengthFn("--.ProposerBoostRoot", size, 32)
+		return
+	}
+	dst = append(dst, f.ProposerBoostRoot...)
+
+	// Field (5) 'PreviousProposerBoostRoot'
+	if size := len(f.PreviousProposerBoostRoot); size != 32 {
+		err = ssz.ErrBytesLengthFn("--.PreviousProposerBoostRoot", size, 32)
+		return
+	}
+	dst = append(dst, f.PreviousProposerBoostRoot...)
+
+	// Field (6) 'HeadRoot'
+	if size := len(f.HeadRoot); size != 32 {
+		err = ssz.ErrBytesLengthFn("--.HeadRoot", size, 32)
+		return
+	}
+	dst = append(dst, f.HeadRoot...)
+
+	// Offset (7) 'ForkChoiceNodes'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(f.ForkChoiceNodes) * 162
+
+	// Field (7) 'ForkChoiceNodes'
+	if size := len(f.ForkChoiceNodes); size > 1048576 {
+		err = ssz.ErrListTooBigFn("--.ForkChoiceNodes", size, 1048576)
+		return
+	}
+	for ii := 0; ii < len(f.ForkChoiceNodes); ii++ {
+		if dst, err = f.ForkChoiceNodes[ii].MarshalSSZTo(dst); err != nil {
+			return
+		}
+	}
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the forkChoiceSSZ object
+func (f *forkChoiceSSZ) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 260 {
+		return ssz.ErrSize
+	}
+
+	tail := buf
+	var o7 uint64
+
+	// Field (0) 'JustifiedCheckpoint'
+	if f.JustifiedCheckpoint == nil {
+		f.JustifiedCheckpoint = new(checkpointSSZ)
+	}
+	if err = f.JustifiedCheckpoint.UnmarshalSSZ(buf[0:40]); err != nil {
+		return err
+	}
+
+	// Field (1) 'FinalizedCheckpoint'
+	if f.FinalizedCheckpoint == nil {
+		f.FinalizedCheckpoint = new(checkpointSSZ)
+	}
+	if err = f.FinalizedCheckpoint.UnmarshalSSZ(buf[40:80]); err != nil {
+		return err
+	}
+
+	// Field (2) 'UnrealizedJustifiedCheckpoint'
+	if f.UnrealizedJustifiedCheckpoint == nil {
+		f.UnrealizedJustifiedCheckpoint = new(checkpointSSZ)
+	}
+	if err = f.UnrealizedJustifiedCheckpoint.UnmarshalSSZ(buf[80:120]); err != nil {
+		return err
+	}
+
+	// Field (3) 'UnrealizedFinalizedCheckpoint'
+	if f.UnrealizedFinalizedCheckpoint == nil {
+		f.UnrealizedFinalizedCheckpoint = new(checkpointSSZ)
+	}
+	if err = f.UnrealizedFinalizedCheckpoint.UnmarshalSSZ(buf[120:160]); err != nil {
+		return err
+	}
+
+	// Field (4) 'ProposerBoostRoot'
+	if cap(f.ProposerBoostRoot) == 0 {
+		f.ProposerBoostRoot = make([]byte, 0, len(buf[160:192]))
+	}
+	f.ProposerBoostRoot = append(f.ProposerBoostRoot, buf[160:192]...)
+
+	// Field (5) 'PreviousProposerBoostRoot'
+	if cap(f.PreviousProposerBoostRoot) == 0 {
+		f.PreviousProposerBoostRoot = make([]byte, 0, len(buf[192:224]))
+	}
+	f.PreviousProposerBoostRoot = append(f.PreviousProposerBoostRoot, buf[192:224]...)
+
+	// Field (6) 'HeadRoot'
+	if cap(f.HeadRoot) == 0 {
+		f.HeadRoot = make([]byte, 0, len(buf[224:256]))
+	}
+	f.HeadRoot = append(f.HeadRoot, buf[224:256]...)
+
+	// Offset (7) 'ForkChoiceNodes'
+	if o7 = ssz.ReadOffset(buf[256:260]); o7 > size {
+		return ssz.ErrOffset
+	}
+
+	if o7 < 260 {
+		return ssz.ErrInvalidVariableOffset
+	}
+
+	// Field (7) 'ForkChoiceNodes'
+	{
+		buf = tail[o7:]
+		num, err := ssz.DivideInt2(len(buf), 162, 1048576)
+		if err != nil {
+			return err
+		}
+		f.ForkChoiceNodes = make([]*forkChoiceNodeSSZ, num)
+		for ii := 0; ii < num; ii++ {
+			if f.ForkChoiceNodes[ii] == nil {
+				f.ForkChoiceNodes[ii] = new(forkChoiceNodeSSZ)
+			}
+			if err = f.ForkChoiceNodes[ii].UnmarshalSSZ(buf[ii*162 : (ii+1)*162]); err != nil {
+				return err
+			}
+		}
+	}
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the forkChoiceSSZ object
+func (f *forkChoiceSSZ) SizeSSZ() (size int) {
+	size = 260
+
+	// Field (7) 'ForkChoiceNodes'
+	size += len(f.ForkChoiceNodes) * 162
+
+	return
+}
+
+// HashTreeRoot ssz hashes the forkChoiceSSZ object
+func (f *forkChoiceSSZ) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(f)
+}
+
+// HashTreeRootWith ssz hashes the forkChoiceSSZ object with a hasher
+func (f *forkChoiceSSZ) HashTreeRootWith(hh *ssz.Hasher) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'JustifiedCheckpoint'
+	if err = f.JustifiedCheckpoint.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	// Field (1) 'FinalizedCheckpoint'
+	if err = f.FinalizedCheckpoint.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	// Field (2) 'UnrealizedJustifiedCheckpoint'
+	if err = f.UnrealizedJustifiedCheckpoint.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	// Field (3) 'UnrealizedFinalizedCheckpoint'
+	if err = f.UnrealizedFinalizedCheckpoint.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	// Field (4) 'ProposerBoostRoot'
+	if size := len(f.ProposerBoostRoot); size != 32 {
+		err = ssz.ErrBytesLengthFn("--.ProposerBoostRoot", size, 32)
+		return
+	}
+	hh.PutBytes(f.ProposerBoostRoot)
+
+	// Field (5) 'PreviousProposerBoostRoot'
+	if size := len(f.PreviousProposerBoostRoot); size != 32 {
+		err = ssz.ErrBytesLengthFn("--.PreviousProposerBoostRoot", size, 32)
+		return
+	}
+	hh.PutBytes(f.PreviousProposerBoostRoot)
+
+	// Field (6) 'HeadRoot'
+	if size := len(f.HeadRoot); size != 32 {
+		err = ssz.ErrBytesLengthFn("--.HeadRoot", size, 32)
+		return
+	}
+	hh.PutBytes(f.HeadRoot)
+
+	// Field (7) 'ForkChoiceNodes'
+	{
+		subIndx := hh.Index()
+		num := uint64(len(f.ForkChoiceNodes))
+		if num > 1048576 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		for _, elem := range f.ForkChoiceNodes {
+			if err = elem.HashTreeRootWith(hh); err != nil {
+				return
+			}
+		}
+		if ssz.EnableVectorizedHTR {
+			hh.MerkleizeWithMixinVectorizedHTR(subIndx, num, 1048576)
+		} else {
+			hh.MerkleizeWithMixin(subIndx, num, 1048576)
+		}
+	}
+
+	if ssz.EnableVectorizedHTR {
+		hh.MerkleizeVectorizedHTR(indx)
+	} else {
+		hh.Merkleize(indx)
+	}
+	return
+}
+
+// MarshalSSZ ssz marshals the forkChoiceNodeSSZ object
+func (f *forkChoiceNodeSSZ) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(f)
+}
+
+// MarshalSSZTo ssz marshals the forkChoiceNodeSSZ object to a target array
+func (f *forkChoiceNodeSSZ) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'Slot'
+	dst = ssz.MarshalUint64(dst, f.Slot)
+
+	// Field (1) 'BlockRoot'
+	if size := len(f.BlockRoot); size != 32 {
+		err = ssz.ErrBytesLengthFn("--.BlockRoot", size, 32)
+		return
+	}
+	dst = append(dst, f.BlockRoot...)
+
+	// Field (2) 'ParentRoot'
+	if size := len(f.ParentRoot); size != 32 {
+		err = ssz.ErrBytesLengthFn("--.ParentRoot", size, 32)
+		return
+	}
+	dst = append(dst, f.ParentRoot...)
+
+	// Field (3) 'JustifiedEpoch'
+	dst = ssz.MarshalUint64(dst, f.JustifiedEpoch)
+
+	// Field (4) 'FinalizedEpoch'
+	dst = ssz.MarshalUint64(dst, f.FinalizedEpoch)
+
+	// Field (5) 'UnrealizedJustifiedEpoch'
+	dst = ssz.MarshalUint64(dst, f.UnrealizedJustifiedEpoch)
+
+	// Field (6) 'UnrealizedFinalizedEpoch'
+	dst = ssz.MarshalUint64(dst, f.UnrealizedFinalizedEpoch)
+
+	// Field (7) 'Balance'
+	dst = ssz.MarshalUint64(dst, f.Balance)
+
+	// Field (8) 'Weight'
+	dst = ssz.MarshalUint64(dst, f.Weight)
+
+	// Field (9) 'ExecutionOptimistic'
+	dst = ssz.MarshalBool(dst, f.ExecutionOptimistic)
+
+	// Field (10) 'ExecutionBlockHash'
+	if size := len(f.ExecutionBlockHash); size != 32 {
+		err = ssz.ErrBytesLengthFn("--.ExecutionBlockHash", size, 32)
+		return
+	}
+	dst = append(dst, f.ExecutionBlockHash...)
+
+	// Field (11) 'Timestamp'
+	dst = ssz.MarshalUint64(dst, f.Timestamp)
+
+	// Field (12) 'Timely'
+	dst = ssz.MarshalBool(dst, f.Timely)
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the forkChoiceNodeSSZ object
+func (f *forkChoiceNodeSSZ) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 162 {
+		return ssz.ErrSize
+	}
+
+	// Field (0) 'Slot'
+	f.Slot = ssz.UnmarshallUint64(buf[0:8])
+
+	// Field (1) 'BlockRoot'
+	if cap(f.BlockRoot) == 0 {
+		f.BlockRoot = make([]byte, 0, len(buf[8:40]))
+	}
+	f.BlockRoot = append(f.BlockRoot, buf[8:40]...)
+
+	// Field (2) 'ParentRoot'
+	if cap(f.ParentRoot) == 0 {
+		f.ParentRoot = make([]byte, 0, len(buf[40:72]))
+	}
+	f.ParentRoot = append(f.ParentRoot, buf[40:72]...)
+
+	// Field (3) 'JustifiedEpoch'
+	f.JustifiedEpoch = ssz.UnmarshallUint64(buf[72:80])
+
+	// Field (4) 'FinalizedEpoch'
+	f.FinalizedEpoch = ssz.UnmarshallUint64(buf[80:88])
+
+	// Field (5) 'UnrealizedJustifiedEpoch'
+	f.UnrealizedJustifiedEpoch = ssz.UnmarshallUint64(buf[88:96])
+
+	// Field (6) 'UnrealizedFinalizedEpoch'
+	f.UnrealizedFinalizedEpoch = ssz.UnmarshallUint64(buf[96:104])
+
+	// Field (7) 'Balance'
+	f.Balance = ssz.UnmarshallUint64(buf[104:112])
+
+	// Field (8) 'Weight'
+	f.Weight = ssz.UnmarshallUint64(buf[112:120])
+
+	// Field (9) 'ExecutionOptimistic'
+	f.ExecutionOptimistic = ssz.UnmarshalBool(buf[120:121])
+
+	// Field (10) 'ExecutionBlockHash'
+	if cap(f.ExecutionBlockHash) == 0 {
+		f.ExecutionBlockHash = make([]byte, 0, len(buf[121:153]))
+	}
+	f.ExecutionBlockHash = append(f.ExecutionBlockHash, buf[121:153]...)
+
+	// Field (11) 'Timestamp'
+	f.Timestamp = ssz.UnmarshallUint64(buf[153:161])
+
+	// Field (12) 'Timely'
+	f.Timely = ssz.UnmarshalBool(buf[161:162])
+
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the forkChoiceNodeSSZ object
+func (f *forkChoiceNodeSSZ) SizeSSZ() (size int) {
+	size = 162
+	return
+}
+
+// HashTreeRoot ssz hashes the forkChoiceNodeSSZ object
+func (f *forkChoiceNodeSSZ) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(f)
+}
+
+// HashTreeRootWith ssz hashes the forkChoiceNodeSSZ object with a hasher
+func (f *forkChoiceNodeSSZ) HashTreeRootWith(hh *ssz.Hasher) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Slot'
+	hh.PutUint64(f.Slot)
+
+	// Field (1) 'BlockRoot'
+	if size := len(f.BlockRoot); size != 32 {
+		err = ssz.ErrBytesLengthFn("--.BlockRoot", size, 32)
+		return
+	}
+	hh.PutBytes(f.BlockRoot)
+
+	// Field (2) 'ParentRoot'
+	if size := len(f.ParentRoot); size != 32 {
+		err = ssz.ErrBytesLengthFn("--.ParentRoot", size, 32)
+		return
+	}
+	hh.PutBytes(f.ParentRoot)
+
+	// Field (3) 'JustifiedEpoch'
+	hh.PutUint64(f.JustifiedEpoch)
+
+	// Field (4) 'FinalizedEpoch'
+	hh.PutUint64(f.FinalizedEpoch)
+
+	// Field (5) 'UnrealizedJustifiedEpoch'
+	hh.PutUint64(f.UnrealizedJustifiedEpoch)
+
+	// Field (6) 'UnrealizedFinalizedEpoch'
+	hh.PutUint64(f.UnrealizedFinalizedEpoch)
+
+	// Field (7) 'Balance'
+	hh.PutUint64(f.Balance)
+
+	// Field (8) 'Weight'
+	hh.PutUint64(f.Weight)
+
+	// Field (9) 'ExecutionOptimistic'
+	hh.PutBool(f.ExecutionOptimistic)
+
+	// Field (10) 'ExecutionBlockHash'
+	if size := len(f.ExecutionBlockHash); size != 32 {
+		err = ssz.ErrBytesLengthFn("--.ExecutionBlockHash", size, 32)
+		return
+	}
+	hh.PutBytes(f.ExecutionBlockHash)
+
+	// Field (11) 'Timestamp'
+	hh.PutUint64(f.Timestamp)
+
+	// Field (12) 'Timely'
+	hh.PutBool(f.Timely)
+
+	if ssz.EnableVectorizedHTR {
+		hh.MerkleizeVectorizedHTR(indx)
+	} else {
+		hh.Merkleize(indx)
+	}
+	return
+}
+
+// MarshalSSZ ssz marshals the dataAvailabilitySSZ object
+func (d *dataAvailabilitySSZ) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(d)
+}
+
+// MarshalSSZTo ssz marshals the dataAvailabilitySSZ object to a target array
+func (d *dataAvailabilitySSZ) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := int(53)
+
+	// Field (0) 'BlockRoot'
+	if size := len(d.BlockRoot); size != 32 {
+		err = ssz.ErrBytesLengthFn("--.BlockRoot", size, 32)
+		return
+	}
+	dst = append(dst, d.BlockRoot...)
+
+	// Field (1) 'Slot'
+	dst = ssz.MarshalUint64(dst, d.Slot)
+
+	// Field (2) 'ExpectedBlobs'
+	dst = ssz.MarshalUint64(dst, d.ExpectedBlobs)
+
+	// Field (3) 'Available'
+	dst = ssz.MarshalBool(dst, d.Available)
+
+	// Offset (4) 'Blobs'
+	dst = ssz.WriteOffset(dst, offset)
+	for ii := 0; ii < len(d.Blobs); ii++ {
+		offset += 4
+		offset += d.Blobs[ii].SizeSSZ()
+	}
+
+	// Field (4) 'Blobs'
+	if size := len(d.Blobs); size > 6 {
+		err = ssz.ErrListTooBigFn("--.Blobs", size, 6)
+		return
+	}
+	{
+		offset = 4 * len(d.Blobs)
+		for ii := 0; ii < len(d.Blobs); ii++ {
+			dst = ssz.WriteOffset(dst, offset)
+			offset += d.Blobs[ii].SizeSSZ()
+		}
+	}
+	for ii := 0; ii < len(d.Blobs); ii++ {
+		if dst, err = d.Blobs[ii].MarshalSSZTo(dst); err != nil {
+			return
+		}
+	}
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the dataAvailabilitySSZ object
+func (d *dataAvailabilitySSZ) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 53 {
+		return ssz.ErrSize
+	}
+
+	tail := buf
+	var o4 uint64
+
+	// Field (0) 'BlockRoot'
+	if cap(d.BlockRoot) == 0 {
+		d.BlockRoot = make([]byte, 0, len(buf[0:32]))
+	}
+	d.BlockRoot = append(d.BlockRoot, buf[0:32]...)
+
+	// Field (1) 'Slot'
+	d.Slot = ssz.UnmarshallUint64(buf[32:40])
+
+	// Field (2) 'ExpectedBlobs'
+	d.ExpectedBlobs = ssz.UnmarshallUint64(buf[40:48])
+
+	// Field (3) 'Available'
+	d.Available = ssz.UnmarshalBool(buf[48:49])
+
+	// Offset (4) 'Blobs'
+	if o4 = ssz.ReadOffset(buf[49:53]); o4 > size {
+		return ssz.ErrOffset
+	}
+
+	if o4 < 53 {
+		return ssz.ErrInvalidVariableOffset
+	}
+
+	// Field (4) 'Blobs'
+	{
+		buf = tail[o4:]
+		num, err := ssz.DecodeDynamicLength(buf, 6)
+		if err != nil {
+			return err
+		}
+		d.Blobs = make([]*blobAvailabilitySSZ, num)
+		err = ssz.UnmarshalDynamic(buf, num, func(indx int, buf []byte) (err error) {
+			if d.Blobs[indx] == nil {
+				d.Blobs[indx] = new(blobAvailabilitySSZ)
+			}
+			if err = d.Blobs[indx].UnmarshalSSZ(buf); err != nil {
+				return err
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the dataAvailabilitySSZ object
+func (d *dataAvailabilitySSZ) SizeSSZ() (size int) {
+	size = 53
+
+	// Field (4) 'Blobs'
+	for ii := 0; ii < len(d.Blobs); ii++ {
+		size += 4
+		size += d.Blobs[ii].SizeSSZ()
+	}
+
+	return
+}
+
+// HashTreeRoot ssz hashes the dataAvailabilitySSZ object
+func (d *dataAvailabilitySSZ) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(d)
+}
+
+// HashTreeRootWith ssz hashes the dataAvailabilitySSZ object with a hasher
+func (d *dataAvailabilitySSZ) HashTreeRootWith(hh *ssz.Hasher) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'BlockRoot'
+	if size := len(d.BlockRoot); size != 32 {
+		err = ssz.ErrBytesLengthFn("--.BlockRoot", size, 32)
+		return
+	}
+	hh.PutBytes(d.BlockRoot)
+
+	// Field (1) 'Slot'
+	hh.PutUint64(d.Slot)
+
+	// Field (2) 'ExpectedBlobs'
+	hh.PutUint64(d.ExpectedBlobs)
+
+	// Field (3) 'Available'
+	hh.PutBool(d.Available)
+
+	// Field (4) 'Blobs'
+	{
+		subIndx := hh.Index()
+		num := uint64(len(d.Blobs))
+		if num > 6 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		for _, elem := range d.Blobs {
+			if err = elem.HashTreeRootWith(hh); err != nil {
+				return
+			}
+		}
+		if ssz.EnableVectorizedHTR {
+			hh.MerkleizeWithMixinVectorizedHTR(subIndx, num, 6)
+		} else {
+			hh.MerkleizeWithMixin(subIndx, num, 6)
+		}
+	}
+
+	if ssz.EnableVectorizedHTR {
+		hh.MerkleizeVectorizedHTR(indx)
+	} else {
+		hh.Merkleize(indx)
+	}
+	return
+}
+
+// MarshalSSZ ssz marshals the blobAvailabilitySSZ object
+func (b *blobAvailabilitySSZ) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(b)
+}
+
+// MarshalSSZTo ssz marshals the blobAvailabilitySSZ object to a target array
+func (b *blobAvailabilitySSZ) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := int(21)
+
+	// Field (0) 'Index'
+	dst = ssz.MarshalUint64(dst, b.Index)
+
+	// Field (1) 'Received'
+	dst = ssz.MarshalBool(dst, b.Received)
+
+	// Field (2) 'ReceivedAt'
+	dst = ssz.MarshalUint64(dst, b.ReceivedAt)
+
+	// Offset (3) 'Peer'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(b.Peer)
+
+	// Field (3) 'Peer'
+	if size := len(b.Peer); size > 128 {
+		err = ssz.ErrBytesLengthFn("--.Peer", size, 128)
+		return
+	}
+	dst = append(dst, b.Peer...)
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the blobAvailabilitySSZ object
+func (b *blobAvailabilitySSZ) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 21 {
+		return ssz.ErrSize
+	}
+
+	tail := buf
+	var o3 uint64
+
+	// Field (0) 'Index'
+	b.Index = ssz.UnmarshallUint64(buf[0:8])
+
+	// Field (1) 'Received'
+	b.Received = ssz.UnmarshalBool(buf[8:9])
+
+	// Field (2) 'ReceivedAt'
+	b.ReceivedAt = ssz.UnmarshallUint64(buf[9:17])
+
+	// Offset (3) 'Peer'
+	if o3 = ssz.ReadOffset(buf[17:21]); o3 > size {
+		return ssz.ErrOffset
+	}
+
+	if o3 < 21 {
+		return ssz.ErrInvalidVariableOffset
+	}
+
+	// Field (3) 'Peer'
+	{
+		buf = tail[o3:]
+		if len(buf) > 128 {
+			return ssz.ErrBytesLength
+		}
+		if cap(b.Peer) == 0 {
+			b.Peer = make([]byte, 0, len(buf))
+		}
+		b.Peer = append(b.Peer, buf...)
+	}
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the blobAvailabilitySSZ object
+func (b *blobAvailabilitySSZ) SizeSSZ() (size int) {
+	size = 21
+
+	// Field (3) 'Peer'
+	size += len(b.Peer)
+
+	return
+}
+
+// HashTreeRoot ssz hashes the blobAvailabilitySSZ object
+func (b *blobAvailabilitySSZ) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(b)
+}
+
+// HashTreeRootWith ssz hashes the blobAvailabilitySSZ object with a hasher
+func (b *blobAvailabilitySSZ) HashTreeRootWith(hh *ssz.Hasher) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Index'
+	hh.PutUint64(b.Index)
+
+	// Field (1) 'Received'
+	hh.PutBool(b.Received)
+
+	// Field (2) 'ReceivedAt'
+	hh.PutUint64(b.ReceivedAt)
+
+	// Field (3) 'Peer'
+	{
+		elemIndx := hh.Index()
+		byteLen := uint64(len(b.Peer))
+		if byteLen > 128 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		hh.PutBytes(b.Peer)
+		if ssz.EnableVectorizedHTR {
+			hh.MerkleizeWithMixinVectorizedHTR(elemIndx, byteLen, (128+31)/32)
+		} else {
+			hh.MerkleizeWithMixin(elemIndx, byteLen, (128+31)/32)
+		}
+	}
+
+	if ssz.EnableVectorizedHTR {
+		hh.MerkleizeVectorizedHTR(indx)
+	} else {
+		hh.Merkleize(indx)
+	}
+	return
+}
+
+// MarshalSSZ ssz marshals the peerScoresSSZ object
+func (p *peerScoresSSZ) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(p)
+}
+
+// MarshalSSZTo ssz marshals the peerScoresSSZ object to a target array
+func (p *peerScoresSSZ) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := int(4)
+
+	// Offset (0) 'Peers'
+	dst = ssz.WriteOffset(dst, offset)
+	for ii := 0; ii < len(p.Peers); ii++ {
+		offset += 4
+		offset += p.Peers[ii].SizeSSZ()
+	}
+
+	// Field (0) 'Peers'
+	if size := len(p.Peers); size > 16384 {
+		err = ssz.ErrListTooBigFn("--.Peers", size, 16384)
+		return
+	}
+	{
+		offset = 4 * len(p.Peers)
+		for ii := 0; ii < len(p.Peers); ii++ {
+			dst = ssz.WriteOffset(dst, offset)
+			offset += p.Peers[ii].SizeSSZ()
+		}
+	}
+	for ii := 0; ii < len(p.Peers); ii++ {
+		if dst, err = p.Peers[ii].MarshalSSZTo(dst); err != nil {
+			return
+		}
+	}
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the peerScoresSSZ object
+func (p *peerScoresSSZ) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 4 {
+		return ssz.ErrSize
+	}
+
+	tail := buf
+	var o0 uint64
+
+	// Offset (0) 'Peers'
+	if o0 = ssz.ReadOffset(buf[0:4]); o0 > size {
+		return ssz.ErrOffset
+	}
+
+	if o0 < 4 {
+		return ssz.ErrInvalidVariableOffset
+	}
+
+	// Field (0) 'Peers'
+	{
+		buf = tail[o0:]
+		num, err := ssz.DecodeDynamicLength(buf, 16384)
+		if err != nil {
+			return err
+		}
+		p.Peers = make([]*peerScoreSSZ, num)
+		err = ssz.UnmarshalDynamic(buf, num, func(indx int, buf []byte) (err error) {
+			if p.Peers[indx] == nil {
+				p.Peers[indx] = new(peerScoreSSZ)
+			}
+			if err = p.Peers[indx].UnmarshalSSZ(buf); err != nil {
+				return err
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the peerScoresSSZ object
+func (p *peerScoresSSZ) SizeSSZ() (size int) {
+	size = 4
+
+	// Field (0) 'Peers'
+	for ii := 0; ii < len(p.Peers); ii++ {
+		size += 4
+		size += p.Peers[ii].SizeSSZ()
+	}
+
+	return
+}
+
+// HashTreeRoot ssz hashes the peerScoresSSZ object
+func (p *peerScoresSSZ) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(p)
+}
+
+// HashTreeRootWith ssz hashes the peerScoresSSZ object with a hasher
+func (p *peerScoresSSZ) HashTreeRootWith(hh *ssz.Hasher) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Peers'
+	{
+		subIndx := hh.Index()
+		num := uint64(len(p.Peers))
+		if num > 16384 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		for _, elem := range p.Peers {
+			if err = elem.HashTreeRootWith(hh); err != nil {
+				return
+			}
+		}
+		if ssz.EnableVectorizedHTR {
+			hh.MerkleizeWithMixinVectorizedHTR(subIndx, num, 16384)
+		} else {
+			hh.MerkleizeWithMixin(subIndx, num, 16384)
+		}
+	}
+
+	if ssz.EnableVectorizedHTR {
+		hh.MerkleizeVectorizedHTR(indx)
+	} else {
+		hh.Merkleize(indx)
+	}
+	return
+}
+
+// MarshalSSZ ssz marshals the peerScoreSSZ object
+func (p *peerScoreSSZ) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(p)
+}
+
+// MarshalSSZTo ssz marshals the peerScoreSSZ object to a target array
+func (p *peerScoreSSZ) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := int(69)
+
+	// Field (0) 'Score'
+	dst = ssz.MarshalUint64(dst, p.Score)
+
+	// Field (1) 'IsBad'
+	dst = ssz.MarshalBool(dst, p.IsBad)
+
+	// Field (2) 'BadResponses'
+	dst = ssz.MarshalUint64(dst, p.BadResponses)
+
+	// Field (3) 'BadResponsesScore'
+	dst = ssz.MarshalUint64(dst, p.BadResponsesScore)
+
+	// Field (4) 'ProcessedBlocks'
+	dst = ssz.MarshalUint64(dst, p.ProcessedBlocks)
+
+	// Field (5) 'BlockProviderScore'
+	dst = ssz.MarshalUint64(dst, p.BlockProviderScore)
+
+	// Field (6) 'PeerStatusScore'
+	dst = ssz.MarshalUint64(dst, p.PeerStatusScore)
+
+	// Field (7) 'GossipScore'
+	dst = ssz.MarshalUint64(dst, p.GossipScore)
+
+	// Field (8) 'BehaviourPenalty'
+	dst = ssz.MarshalUint64(dst, p.BehaviourPenalty)
+
+	// Offset (9) 'PeerId'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(p.PeerId)
+
+	// Field (9) 'PeerId'
+	if size := len(p.PeerId); size > 128 {
+		err = ssz.ErrBytesLengthFn("--.PeerId", size, 128)
+		return
+	}
+	dst = append(dst, p.PeerId...)
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the peerScoreSSZ object
+func (p *peerScoreSSZ) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 69 {
+		return ssz.ErrSize
+	}
+
+	tail := buf
+	var o9 uint64
+
+	// Field (0) 'Score'
+	p.Score = ssz.UnmarshallUint64(buf[0:8])
+
+	// Field (1) 'IsBad'
+	p.IsBad = ssz.UnmarshalBool(buf[8:9])
+
+	// Field (2) 'BadResponses'
+	p.BadResponses = ssz.UnmarshallUint64(buf[9:17])
+
+	// Field (3) 'BadResponsesScore'
+	p.BadResponsesScore = ssz.UnmarshallUint64(buf[17:25])
+
+	// Field (4) 'ProcessedBlocks'
+	p.ProcessedBlocks = ssz.UnmarshallUint64(buf[25:33])
+
+	// Field (5) 'BlockProviderScore'
+	p.BlockProviderScore = ssz.UnmarshallUint64(buf[33:41])
+
+	// Field (6) 'PeerStatusScore'
+	p.PeerStatusScore = ssz.UnmarshallUint64(buf[41:49])
+
+	// Field (7) 'GossipScore'
+	p.GossipScore = ssz.UnmarshallUint64(buf[49:57])
+
+	// Field (8) 'BehaviourPenalty'
+	p.BehaviourPenalty = ssz.UnmarshallUint64(buf[57:65])
+
+	// Offset (9) 'PeerId'
+	if o9 = ssz.ReadOffset(buf[65:69]); o9 > size {
+		return ssz.ErrOffset
+	}
+
+	if o9 < 69 {
+		return ssz.ErrInvalidVariableOffset
+	}
+
+	// Field (9) 'PeerId'
+	{
+		buf = tail[o9:]
+		if len(buf) > 128 {
+			return ssz.ErrBytesLength
+		}
+		if cap(p.PeerId) == 0 {
+			p.PeerId = make([]byte, 0, len(buf))
+		}
+		p.PeerId = append(p.PeerId, buf...)
+	}
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the peerScoreSSZ object
+func (p *peerScoreSSZ) SizeSSZ() (size int) {
+	size = 69
+
+	// Field (9) 'PeerId'
+	size += len(p.PeerId)
+
+	return
+}
+
+// HashTreeRoot ssz hashes the peerScoreSSZ object
+func (p *peerScoreSSZ) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(p)
+}
+
+// HashTreeRootWith ssz hashes the peerScoreSSZ object with a hasher
+func (p *peerScoreSSZ) HashTreeRootWith(hh *ssz.Hasher) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Score'
+	hh.PutUint64(p.Score)
+
+	// Field (1) 'IsBad'
+	hh.PutBool(p.IsBad)
+
+	// Field (2) 'BadResponses'
+	hh.PutUint64(p.BadResponses)
+
+	// Field (3) 'BadResponsesScore'
+	hh.PutUint64(p.BadResponsesScore)
+
+	// Field (4) 'ProcessedBlocks'
+	hh.PutUint64(p.ProcessedBlocks)
+
+	// Field (5) 'BlockProviderScore'
+	hh.PutUint64(p.BlockProviderScore)
+
+	// Field (6) 'PeerStatusScore'
+	hh.PutUint64(p.PeerStatusScore)
+
+	// Field (7) 'GossipScore'
+	hh.PutUint64(p.GossipScore)
+
+	// Field (8) 'BehaviourPenalty'
+	hh.PutUint64(p.BehaviourPenalty)
+
+	// Field (9) 'PeerId'
+	{
+		elemIndx := hh.Index()
+		byteLen := uint64(len(p.PeerId))
+		if byteLen > 128 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		hh.PutBytes(p.PeerId)
+		if ssz.EnableVectorizedHTR {
+			hh.MerkleizeWithMixinVectorizedHTR(elemIndx, byteLen, (128+31)/32)
+		} else {
+			hh.MerkleizeWithMixin(elemIndx, byteLen, (128+31)/32)
+		}
+	}
+
+	if ssz.EnableVectorizedHTR {
+		hh.MerkleizeVectorizedHTR(indx)
+	} else {
+		hh.Merkleize(indx)
+	}
+	return
+}
@@ -0,0 +1,482 @@
+package debug
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"net/http"
+	"strconv"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/gorilla/mux"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v4/api"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/cache"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/rpc/eth/helpers"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/rpc/eth/shared"
+	fieldparams "github.com/prysmaticlabs/prysm/v4/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v4/config/params"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v4/encoding/bytesutil"
+	http2 "github.com/prysmaticlabs/prysm/v4/network/http"
+	ethpbv1 "github.com/prysmaticlabs/prysm/v4/proto/eth/v1"
+	"github.com/prysmaticlabs/prysm/v4/runtime/version"
+	"github.com/prysmaticlabs/prysm/v4/time/slots"
+	"go.opencensus.io/trace"
+)
+
+// GetBeaconState returns the full phase 0 beacon state for a given state ID. States of later forks are only served
+// as SSZ, their JSON representation is available from GetBeaconStateV2.
+func (s *Server) GetBeaconState(w http.ResponseWriter, r *http.Request) {
+	ctx, span := trace.StartSpan(r.Context(), "debug.GetBeaconState")
+	defer span.End()
+
+	stateId := mux.Vars(r)["state_id"]
+	if stateId == "" {
+		http2.HandleError(w, "state_id is required in URL params", http.StatusBadRequest)
+		return
+	}
+	st, err := s.Stater.State(ctx, []byte(stateId))
+	if err != nil {
+		shared.WriteStateFetchError(w, err)
+		return
+	}
+
+	if http2.SszRequested(r) {
+		sszState, err := st.MarshalSSZ()
+		if err != nil {
+			http2.HandleError(w, "Could not marshal state into SSZ: "+err.Error(), http.StatusInternalServerError)
+			return
+		}
+		http2.WriteSsz(w, sszState, "beacon_state.ssz")
+		return
+	}
+
+	if st.Version() != version.Phase0 {
+		http2.HandleError(w, fmt.Sprintf("State is a %s state, use the v2 endpoint to get it as JSON", version.String(st.Version())), http.StatusBadRequest)
+		return
+	}
+	respSt, err := shared.BeaconStateFromConsensus(st)
+	if err != nil {
+		http2.HandleError(w, "Could not convert state to JSON: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	http2.WriteJson(w, &GetBeaconStateResponse{Data: respSt})
+}
+
+// GetBeaconStateV2 returns the full beacon state for a given state ID.
+func (s *Server) GetBeaconStateV2(w http.ResponseWriter, r *http.Request) {
+	ctx, span := trace.StartSpan(r.Context(), "debug.GetBeaconStateV2")
+	defer span.End()
+
+	stateId := mux.Vars(r)["state_id"]
+	if stateId == "" {
+		http2.HandleError(w, "state_id is required in URL params", http.StatusBadRequest)
+		return
+	}
+	st, err := s.Stater.State(ctx, []byte(stateId))
+	if err != nil {
+		shared.WriteStateFetchError(w, err)
+		return
+	}
+
+	if http2.SszRequested(r) {
+		sszState, err := st.MarshalSSZ()
+		if err != nil {
+			http2.HandleError(w, "Could not marshal state into SSZ: "+err.Error(), http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set(api.VersionHeader, version.String(st.Version()))
+		http2.WriteSsz(w, sszState, "beacon_state.ssz")
+		return
+	}
+
+	var respSt interface{}
+	switch st.Version() {
+	case version.Phase0:
+		respSt, err = shared.BeaconStateFromConsensus(st)
+	case version.Altair:
+		respSt, err = shared.BeaconStateAltairFromConsensus(st)
+	case version.Bellatrix:
+		respSt, err = shared.BeaconStateBellatrixFromConsensus(st)
+	case version.Capella:
+		respSt, err = shared.BeaconStateCapellaFromConsensus(st)
+	case version.Deneb:
+		respSt, err = shared.BeaconStateDenebFromConsensus(st)
+	default:
+		http2.HandleError(w, "Unsupported state version", http.StatusInternalServerError)
+		return
+	}
+	if err != nil {
+		http2.HandleError(w, "Could not convert state to JSON: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	jsonBytes, err := json.Marshal(respSt)
+	if err != nil {
+		http2.HandleError(w, "Could not marshal state into JSON: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	isOptimistic, err := helpers.IsOptimistic(ctx, []byte(stateId), s.OptimisticModeFetcher, s.Stater, s.ChainInfoFetcher, s.BeaconDB)
+	if err != nil {
+		http2.HandleError(w, "Could not check if slot's block is optimistic: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	blockRoot, err := st.LatestBlockHeader().HashTreeRoot()
+	if err != nil {
+		http2.HandleError(w, "Could not calculate root of latest block header: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	isFinalized := s.FinalizationFetcher.IsFinalized(ctx, blockRoot)
+
+	w.Header().Set(api.VersionHeader, version.String(st.Version()))
+	http2.WriteJson(w, &GetBeaconStateV2Response{
+		Version:             version.String(st.Version()),
+		ExecutionOptimistic: isOptimistic,
+		Finalized:           isFinalized,
+		Data:                jsonBytes,
+	})
+}
+
+// GetForkChoiceHeadsV2 retrieves the leaves of the current fork choice tree.
+func (s *Server) GetForkChoiceHeadsV2(w http.ResponseWriter, r *http.Request) {
+	ctx, span := trace.StartSpan(r.Context(), "debug.GetForkChoiceHeadsV2")
+	defer span.End()
+
+	headRoots, headSlots := s.HeadFetcher.ChainHeads()
+	resp := &GetForkChoiceHeadsV2Response{
+		Data: make([]*ForkChoiceHead, len(headRoots)),
+	}
+	for i := range headRoots {
+		isOptimistic, err := s.OptimisticModeFetcher.IsOptimisticForRoot(ctx, headRoots[i])
+		if err != nil {
+			http2.HandleError(w, "Could not check if head is optimistic: "+err.Error(), http.StatusInternalServerError)
+			return
+		}
+		resp.Data[i] = &ForkChoiceHead{
+			Root:                hexutil.Encode(headRoots[i][:]),
+			Slot:                strconv.FormatUint(uint64(headSlots[i]), 10),
+			ExecutionOptimistic: isOptimistic,
+		}
+	}
+
+	http2.WriteJson(w, resp)
+}
+
+// GetForkChoice returns a dump of the fork choice store.
+func (s *Server) GetForkChoice(w http.ResponseWriter, r *http.Request) {
+	ctx, span := trace.StartSpan(r.Context(), "debug.GetForkChoice")
+	defer span.End()
+
+	dump, err := s.ForkchoiceFetcher.ForkChoiceDump(ctx)
+	if err != nil {
+		http2.HandleError(w, "Could not get fork choice dump: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	nodes := make([]*ForkChoiceDumpNode, len(dump.ForkChoiceNodes))
+	for i, n := range dump.ForkChoiceNodes {
+		nodes[i] = &ForkChoiceDumpNode{
+			Slot:               strconv.FormatUint(uint64(n.Slot), 10),
+			BlockRoot:          hexutil.Encode(n.BlockRoot),
+			ParentRoot:         hexutil.Encode(n.ParentRoot),
+			JustifiedEpoch:     strconv.FormatUint(uint64(n.JustifiedEpoch), 10),
+			FinalizedEpoch:     strconv.FormatUint(uint64(n.FinalizedEpoch), 10),
+			Weight:             strconv.FormatUint(n.Weight, 10),
+			ExecutionBlockHash: hexutil.Encode(n.ExecutionBlockHash),
+			Validity:           n.Validity.String(),
+			ExtraData: &ForkChoiceNodeExtraData{
+				UnrealizedJustifiedEpoch: strconv.FormatUint(uint64(n.UnrealizedJustifiedEpoch), 10),
+				UnrealizedFinalizedEpoch: strconv.FormatUint(uint64(n.UnrealizedFinalizedEpoch), 10),
+				Balance:                  strconv.FormatUint(n.Balance, 10),
+				ExecutionOptimistic:      n.ExecutionOptimistic,
+				Timestamp:                strconv.FormatUint(n.Timestamp, 10),
+			},
+		}
+	}
+	http2.WriteJson(w, &GetForkChoiceDumpResponse{
+		JustifiedCheckpoint: checkpointToJson(dump.JustifiedCheckpoint),
+		FinalizedCheckpoint: checkpointToJson(dump.FinalizedCheckpoint),
+		ForkChoiceNodes:     nodes,
+		ExtraData: &ForkChoiceDumpExtraData{
+			UnrealizedJustifiedCheckpoint: checkpointToJson(dump.UnrealizedJustifiedCheckpoint),
+			UnrealizedFinalizedCheckpoint: checkpointToJson(dump.UnrealizedFinalizedCheckpoint),
+			ProposerBoostRoot:             hexutil.Encode(dump.ProposerBoostRoot),
+			PreviousProposerBoostRoot:     hexutil.Encode(dump.PreviousProposerBoostRoot),
+			HeadRoot:                      hexutil.Encode(dump.HeadRoot),
+		},
+	})
+}
+
+// GetForkChoiceStore returns the full fork choice store, including the weight, balance,
+// unrealized justification, optimistic status and timeliness of every node.
+func (s *Server) GetForkChoiceStore(w http.ResponseWriter, r *http.Request) {
+	ctx, span := trace.StartSpan(r.Context(), "debug.GetForkChoiceStore")
+	defer span.End()
+
+	dump, err := s.ForkchoiceFetcher.ForkChoiceDump(ctx)
+	if err != nil {
+		http2.HandleError(w, "Could not get fork choice dump: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	genesis := uint64(s.GenesisTimeFetcher.GenesisTime().Unix())
+
+	if http2.SszRequested(r) {
+		sszResp, err := forkChoiceToSSZ(dump, genesis).MarshalSSZ()
+		if err != nil {
+			http2.HandleError(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		http2.WriteSsz(w, sszResp, "fork_choice.ssz")
+		return
+	}
+	http2.WriteJson(w, forkChoiceToJson(dump, genesis))
+}
+
+// GetDataAvailability returns the data availability status of a block, i.e. which of the blobs
+// committed to in the block have been received and, for blobs received over gossip, from which
+// peer and when.
+func (s *Server) GetDataAvailability(w http.ResponseWriter, r *http.Request) {
+	ctx, span := trace.StartSpan(r.Context(), "debug.GetDataAvailability")
+	defer span.End()
+
+	rawRoot := mux.Vars(r)["block_root"]
+	root, err := hexutil.Decode(rawRoot)
+	if err != nil || len(root) != fieldparams.RootLength {
+		http2.HandleError(w, "Invalid block root: "+rawRoot, http.StatusBadRequest)
+		return
+	}
+	blockRoot := bytesutil.ToBytes32(root)
+	blk, err := s.BeaconDB.Block(ctx, blockRoot)
+	if err != nil {
+		http2.HandleError(w, errors.Wrapf(err, "could not get block %#x", blockRoot).Error(), http.StatusInternalServerError)
+		return
+	}
+	if blk == nil || blk.IsNil() {
+		http2.HandleError(w, fmt.Sprintf("Block %#x not found", blockRoot), http.StatusNotFound)
+		return
+	}
+
+	var expected uint64
+	if blk.Version() >= version.Deneb {
+		commitments, err := blk.Block().Body().BlobKzgCommitments()
+		if err != nil {
+			http2.HandleError(w, "Could not get blob KZG commitments: "+err.Error(), http.StatusInternalServerError)
+			return
+		}
+		expected = uint64(len(commitments))
+	}
+	var received [fieldparams.MaxBlobsPerBlock]bool
+	if expected > 0 && s.BlobStorage != nil {
+		received, err = s.BlobStorage.Indices(blockRoot)
+		if err != nil {
+			http2.HandleError(w, "Could not get stored blob indices: "+err.Error(), http.StatusInternalServerError)
+			return
+		}
+	}
+	arrivals := make(map[uint64]cache.BlobArrival)
+	if s.BlobArrivals != nil {
+		for _, a := range s.BlobArrivals.Arrivals(blockRoot) {
+			arrivals[a.Index] = a
+		}
+	}
+
+	da := &dataAvailabilitySSZ{
+		BlockRoot:     blockRoot[:],
+		Slot:          uint64(blk.Block().Slot()),
+		ExpectedBlobs: expected,
+		Available:     true,
+		Blobs:         make([]*blobAvailabilitySSZ, 0, expected),
+	}
+	for i := uint64(0); i < expected && i < fieldparams.MaxBlobsPerBlock; i++ {
+		b := &blobAvailabilitySSZ{Index: i, Received: received[i], Peer: []byte{}}
+		if a, ok := arrivals[i]; ok {
+			b.Peer = []byte(a.Peer)
+			b.ReceivedAt = uint64(a.Time.UnixMilli())
+		}
+		if !b.Received {
+			da.Available = false
+		}
+		da.Blobs = append(da.Blobs, b)
+	}
+
+	if http2.SszRequested(r) {
+		sszResp, err := da.MarshalSSZ()
+		if err != nil {
+			http2.HandleError(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		http2.WriteSsz(w, sszResp, "data_availability.ssz")
+		return
+	}
+	http2.WriteJson(w, &DataAvailabilityResponse{Data: dataAvailabilityToJson(da)})
+}
+
+// GetPeerScores returns the breakdown of the score of every known peer into the components
+// computed by each of the peer scorers.
+func (s *Server) GetPeerScores(w http.ResponseWriter, r *http.Request) {
+	_, span := trace.StartSpan(r.Context(), "debug.GetPeerScores")
+	defer span.End()
+
+	peerStatus := s.PeersFetcher.Peers()
+	scorers := peerStatus.Scorers()
+	all := peerStatus.All()
+	resp := &peerScoresSSZ{Peers: make([]*peerScoreSSZ, 0, len(all))}
+	for _, pid := range all {
+		badResponses, err := scorers.BadResponsesScorer().Count(pid)
+		if err != nil {
+			badResponses = 0
+		}
+		gossipScore, behaviourPenalty, _, err := scorers.GossipScorer().GossipData(pid)
+		if err != nil {
+			gossipScore, behaviourPenalty = 0, 0
+		}
+		resp.Peers = append(resp.Peers, &peerScoreSSZ{
+			PeerId:             []byte(pid.String()),
+			Score:              math.Float64bits(scorers.Score(pid)),
+			IsBad:              scorers.IsBadPeer(pid),
+			BadResponses:       uint64(badResponses),
+			BadResponsesScore:  math.Float64bits(scorers.BadResponsesScorer().Score(pid)),
+			ProcessedBlocks:    scorers.BlockProviderScorer().ProcessedBlocks(pid),
+			BlockProviderScore: math.Float64bits(scorers.BlockProviderScorer().Score(pid)),
+			PeerStatusScore:    math.Float64bits(scorers.PeerStatusScorer().Score(pid)),
+			GossipScore:        math.Float64bits(gossipScore),
+			BehaviourPenalty:   math.Float64bits(behaviourPenalty),
+		})
+	}
+
+	if http2.SszRequested(r) {
+		sszResp, err := resp.MarshalSSZ()
+		if err != nil {
+			http2.HandleError(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		http2.WriteSsz(w, sszResp, "peer_scores.ssz")
+		return
+	}
+	http2.WriteJson(w, &PeerScoresResponse{Data: peerScoresToJson(resp)})
+}
+
+// isTimely reports whether a block was received before the attestation deadline of its slot.
+func isTimely(slot primitives.Slot, genesis, timestamp uint64) bool {
+	since, err := slots.SecondsSinceSlotStart(slot, genesis, timestamp)
+	if err != nil {
+		return false
+	}
+	return since < params.BeaconConfig().SecondsPerSlot/params.BeaconConfig().IntervalsPerSlot
+}
+
+func forkChoiceToSSZ(dump *ethpbv1.ForkChoiceDump, genesis uint64) *forkChoiceSSZ {
+	nodes := make([]*forkChoiceNodeSSZ, len(dump.ForkChoiceNodes))
+	for i, n := range dump.ForkChoiceNodes {
+		nodes[i] = &forkChoiceNodeSSZ{
+			Slot:                     uint64(n.Slot),
+			BlockRoot:                bytesutil.PadTo(n.BlockRoot, fieldparams.RootLength),
+			ParentRoot:               bytesutil.PadTo(n.ParentRoot, fieldparams.RootLength),
+			JustifiedEpoch:           uint64(n.JustifiedEpoch),
+			FinalizedEpoch:           uint64(n.FinalizedEpoch),
+			UnrealizedJustifiedEpoch: uint64(n.UnrealizedJustifiedEpoch),
+			UnrealizedFinalizedEpoch: uint64(n.UnrealizedFinalizedEpoch),
+			Balance:                  n.Balance,
+			Weight:                   n.Weight,
+			ExecutionOptimistic:      n.ExecutionOptimistic,
+			ExecutionBlockHash:       bytesutil.PadTo(n.ExecutionBlockHash, fieldparams.RootLength),
+			Timestamp:                n.Timestamp,
+			Timely:                   isTimely(n.Slot, genesis, n.Timestamp),
+		}
+	}
+	return &forkChoiceSSZ{
+		JustifiedCheckpoint:           checkpointToSSZ(dump.JustifiedCheckpoint),
+		FinalizedCheckpoint:           checkpointToSSZ(dump.FinalizedCheckpoint),
+		UnrealizedJustifiedCheckpoint: checkpointToSSZ(dump.UnrealizedJustifiedCheckpoint),
+		UnrealizedFinalizedCheckpoint: checkpointToSSZ(dump.UnrealizedFinalizedCheckpoint),
+		ProposerBoostRoot:             bytesutil.PadTo(dump.ProposerBoostRoot, fieldparams.RootLength),
+		PreviousProposerBoostRoot:     bytesutil.PadTo(dump.PreviousProposerBoostRoot, fieldparams.RootLength),
+		HeadRoot:                      bytesutil.PadTo(dump.HeadRoot, fieldparams.RootLength),
+		ForkChoiceNodes:               nodes,
+	}
+}
+
+func checkpointToSSZ(cp *ethpbv1.Checkpoint) *checkpointSSZ {
+	if cp == nil {
+		return &checkpointSSZ{Root: make([]byte, fieldparams.RootLength)}
+	}
+	return &checkpointSSZ{Epoch: uint64(cp.Epoch), Root: bytesutil.PadTo(cp.Root, fieldparams.RootLength)}
+}
+
+func forkChoiceToJson(dump *ethpbv1.ForkChoiceDump, genesis uint64) *ForkChoiceResponse {
+	nodes := make([]*ForkChoiceNode, len(dump.ForkChoiceNodes))
+	for i, n := range dump.ForkChoiceNodes {
+		nodes[i] = &ForkChoiceNode{
+			Slot:                     strconv.FormatUint(uint64(n.Slot), 10),
+			BlockRoot:                hexutil.Encode(n.BlockRoot),
+			ParentRoot:               hexutil.Encode(n.ParentRoot),
+			JustifiedEpoch:           strconv.FormatUint(uint64(n.JustifiedEpoch), 10),
+			FinalizedEpoch:           strconv.FormatUint(uint64(n.FinalizedEpoch), 10),
+			UnrealizedJustifiedEpoch: strconv.FormatUint(uint64(n.UnrealizedJustifiedEpoch), 10),
+			UnrealizedFinalizedEpoch: strconv.FormatUint(uint64(n.UnrealizedFinalizedEpoch), 10),
+			Balance:                  strconv.FormatUint(n.Balance, 10),
+			Weight:                   strconv.FormatUint(n.Weight, 10),
+			ExecutionOptimistic:      n.ExecutionOptimistic,
+			ExecutionBlockHash:       hexutil.Encode(n.ExecutionBlockHash),
+			Timestamp:                strconv.FormatUint(n.Timestamp, 10),
+			Validity:                 n.Validity.String(),
+			Timely:                   isTimely(n.Slot, genesis, n.Timestamp),
+		}
+	}
+	return &ForkChoiceResponse{
+		JustifiedCheckpoint:           checkpointToJson(dump.JustifiedCheckpoint),
+		FinalizedCheckpoint:           checkpointToJson(dump.FinalizedCheckpoint),
+		UnrealizedJustifiedCheckpoint: checkpointToJson(dump.UnrealizedJustifiedCheckpoint),
+		UnrealizedFinalizedCheckpoint: checkpointToJson(dump.UnrealizedFinalizedCheckpoint),
+		ProposerBoostRoot:             hexutil.Encode(dump.ProposerBoostRoot),
+		PreviousProposerBoostRoot:     hexutil.Encode(dump.PreviousProposerBoostRoot),
+		HeadRoot:                      hexutil.Encode(dump.HeadRoot),
+		ForkChoiceNodes:               nodes,
+	}
+}
+
+func checkpointToJson(cp *ethpbv1.Checkpoint) *shared.Checkpoint {
+	if cp == nil {
+		return nil
+	}
+	return &shared.Checkpoint{Epoch: strconv.FormatUint(uint64(cp.Epoch), 10), Root: hexutil.Encode(cp.Root)}
+}
+
+func dataAvailabilityToJson(da *dataAvailabilitySSZ) *DataAvailability {
+	blobs := make([]*BlobAvailability, len(da.Blobs))
+	for i, b := range da.Blobs {
+		blobs[i] = &BlobAvailability{
+			Index:      strconv.FormatUint(b.Index, 10),
+			Received:   b.Received,
+			Peer:       string(b.Peer),
+			ReceivedAt: strconv.FormatUint(b.ReceivedAt, 10),
+		}
+	}
+	return &DataAvailability{
+		BlockRoot:     hexutil.Encode(da.BlockRoot),
+		Slot:          strconv.FormatUint(da.Slot, 10),
+		ExpectedBlobs: strconv.FormatUint(da.ExpectedBlobs, 10),
+		Available:     da.Available,
+		Blobs:         blobs,
+	}
+}
+
+func peerScoresToJson(resp *peerScoresSSZ) []*PeerScore {
+	scores := make([]*PeerScore, len(resp.Peers))
+	for i, p := range resp.Peers {
+		scores[i] = &PeerScore{
+			PeerId:             string(p.PeerId),
+			Score:              math.Float64frombits(p.Score),
+			IsBad:              p.IsBad,
+			BadResponses:       strconv.FormatUint(p.BadResponses, 10),
+			BadResponsesScore:  math.Float64frombits(p.BadResponsesScore),
+			ProcessedBlocks:    strconv.FormatUint(p.ProcessedBlocks, 10),
+			BlockProviderScore: math.Float64frombits(p.BlockProviderScore),
+			PeerStatusScore:    math.Float64frombits(p.PeerStatusScore),
+			GossipScore:        math.Float64frombits(p.GossipScore),
+			BehaviourPenalty:   math.Float64frombits(p.BehaviourPenalty),
+		}
+	}
+	return scores
+}
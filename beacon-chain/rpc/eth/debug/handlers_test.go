@@ -0,0 +1,673 @@
+package debug
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/gorilla/mux"
+	"github.com/prysmaticlabs/prysm/v4/api"
+	blockchainmock "github.com/prysmaticlabs/prysm/v4/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/cache"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/db/filesystem"
+	dbtest "github.com/prysmaticlabs/prysm/v4/beacon-chain/db/testing"
+	doublylinkedtree "github.com/prysmaticlabs/prysm/v4/beacon-chain/forkchoice/doubly-linked-tree"
+	forkchoicetypes "github.com/prysmaticlabs/prysm/v4/beacon-chain/forkchoice/types"
+	p2ptest "github.com/prysmaticlabs/prysm/v4/beacon-chain/p2p/testing"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/rpc/eth/shared"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/rpc/testutil"
+	"github.com/prysmaticlabs/prysm/v4/config/params"
+	"github.com/prysmaticlabs/prysm/v4/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/v4/runtime/version"
+	"github.com/prysmaticlabs/prysm/v4/testing/assert"
+	"github.com/prysmaticlabs/prysm/v4/testing/require"
+	"github.com/prysmaticlabs/prysm/v4/testing/util"
+)
+
+func TestGetForkChoiceHeadsV2(t *testing.T) {
+	expectedSlotsAndRoots := []struct {
+		Slot string
+		Root string
+	}{{
+		Slot: "0",
+		Root: hexutil.Encode(bytesutil.PadTo([]byte("foo"), 32)),
+	}, {
+		Slot: "1",
+		Root: hexutil.Encode(bytesutil.PadTo([]byte("bar"), 32)),
+	}}
+
+	chainService := &blockchainmock.ChainService{}
+	s := &Server{
+		HeadFetcher:           chainService,
+		OptimisticModeFetcher: chainService,
+	}
+
+	request := httptest.NewRequest(http.MethodGet, "http://example.com/eth/v2/debug/beacon/heads", nil)
+	writer := httptest.NewRecorder()
+	writer.Body = &bytes.Buffer{}
+
+	s.GetForkChoiceHeadsV2(writer, request)
+	require.Equal(t, http.StatusOK, writer.Code)
+	resp := &GetForkChoiceHeadsV2Response{}
+	require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+	assert.Equal(t, 2, len(resp.Data))
+	for _, sr := range expectedSlotsAndRoots {
+		found := false
+		for _, h := range resp.Data {
+			if h.Slot == sr.Slot {
+				found = true
+				assert.Equal(t, sr.Root, h.Root)
+			}
+			assert.Equal(t, false, h.ExecutionOptimistic)
+		}
+		assert.Equal(t, true, found, "Expected head not found")
+	}
+
+	t.Run("optimistic head", func(t *testing.T) {
+		chainService := &blockchainmock.ChainService{
+			Optimistic:      true,
+			OptimisticRoots: make(map[[32]byte]bool),
+		}
+		for _, sr := range expectedSlotsAndRoots {
+			b, err := hexutil.Decode(sr.Root)
+			require.NoError(t, err)
+			chainService.OptimisticRoots[bytesutil.ToBytes32(b)] = true
+		}
+		s := &Server{
+			HeadFetcher:           chainService,
+			OptimisticModeFetcher: chainService,
+		}
+
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/eth/v2/debug/beacon/heads", nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetForkChoiceHeadsV2(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		resp := &GetForkChoiceHeadsV2Response{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		assert.Equal(t, 2, len(resp.Data))
+		for _, sr := range expectedSlotsAndRoots {
+			found := false
+			for _, h := range resp.Data {
+				if h.Slot == sr.Slot {
+					found = true
+					assert.Equal(t, sr.Root, h.Root)
+				}
+				assert.Equal(t, true, h.ExecutionOptimistic)
+			}
+			assert.Equal(t, true, found, "Expected head not found")
+		}
+	})
+}
+
+func TestGetForkChoice(t *testing.T) {
+	store := doublylinkedtree.New()
+	fRoot := [32]byte{'a'}
+	fc := &forkchoicetypes.Checkpoint{Epoch: 2, Root: fRoot}
+	require.NoError(t, store.UpdateFinalizedCheckpoint(fc))
+	s := &Server{ForkchoiceFetcher: &blockchainmock.ChainService{ForkChoiceStore: store}}
+
+	request := httptest.NewRequest(http.MethodGet, "http://example.com/eth/v1/debug/fork_choice", nil)
+	writer := httptest.NewRecorder()
+	writer.Body = &bytes.Buffer{}
+
+	s.GetForkChoice(writer, request)
+	require.Equal(t, http.StatusOK, writer.Code)
+	resp := &GetForkChoiceDumpResponse{}
+	require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+	require.Equal(t, "2", resp.FinalizedCheckpoint.Epoch)
+	require.NotNil(t, resp.ExtraData)
+}
+
+func TestGetForkChoiceStore(t *testing.T) {
+	store := doublylinkedtree.New()
+	fRoot := [32]byte{'a'}
+	require.NoError(t, store.UpdateFinalizedCheckpoint(&forkchoicetypes.Checkpoint{Epoch: 2, Root: fRoot}))
+	s := &Server{
+		ForkchoiceFetcher:  &blockchainmock.ChainService{ForkChoiceStore: store},
+		GenesisTimeFetcher: &blockchainmock.ChainService{Genesis: time.Now()},
+	}
+
+	t.Run("JSON", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/prysm/debug/fork_choice", nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetForkChoiceStore(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		resp := &ForkChoiceResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		assert.Equal(t, "2", resp.FinalizedCheckpoint.Epoch)
+		assert.Equal(t, hexutil.Encode(fRoot[:]), resp.FinalizedCheckpoint.Root)
+	})
+	t.Run("SSZ", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/prysm/debug/fork_choice", nil)
+		request.Header.Add("Accept", "application/octet-stream")
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetForkChoiceStore(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		resp := &forkChoiceSSZ{}
+		require.NoError(t, resp.UnmarshalSSZ(writer.Body.Bytes()))
+		assert.Equal(t, uint64(2), resp.FinalizedCheckpoint.Epoch)
+		assert.DeepEqual(t, fRoot[:], resp.FinalizedCheckpoint.Root)
+	})
+}
+
+func TestIsTimely(t *testing.T) {
+	genesis := uint64(1000)
+	slotStart := genesis + 3*params.BeaconConfig().SecondsPerSlot
+	assert.Equal(t, true, isTimely(3, genesis, slotStart))
+	assert.Equal(t, false, isTimely(3, genesis, slotStart+params.BeaconConfig().SecondsPerSlot/params.BeaconConfig().IntervalsPerSlot))
+	assert.Equal(t, false, isTimely(3, genesis, slotStart-1))
+}
+
+func TestGetDataAvailability(t *testing.T) {
+	ctx := context.Background()
+	beaconDB := dbtest.SetupDB(t)
+	blk, sidecars := util.GenerateTestDenebBlockWithSidecar(t, [32]byte{}, 1, 3)
+	require.NoError(t, beaconDB.SaveBlock(ctx, blk))
+	root := blk.Root()
+
+	bs := filesystem.NewEphemeralBlobStorage(t)
+	require.NoError(t, bs.Save(sidecars[0]))
+	require.NoError(t, bs.Save(sidecars[2]))
+	arrivals := cache.NewBlobArrivalsCache()
+	arrival := time.Unix(1700000000, 0)
+	arrivals.Add(root, 1, 2, "peer2", arrival)
+
+	s := &Server{BeaconDB: beaconDB, BlobStorage: bs, BlobArrivals: arrivals}
+
+	t.Run("JSON", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/prysm/debug/data_availability/{block_root}", nil)
+		request = mux.SetURLVars(request, map[string]string{"block_root": hexutil.Encode(root[:])})
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetDataAvailability(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		resp := &DataAvailabilityResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		assert.Equal(t, "1", resp.Data.Slot)
+		assert.Equal(t, "3", resp.Data.ExpectedBlobs)
+		assert.Equal(t, false, resp.Data.Available)
+		require.Equal(t, 3, len(resp.Data.Blobs))
+		assert.Equal(t, true, resp.Data.Blobs[0].Received)
+		assert.Equal(t, "", resp.Data.Blobs[0].Peer)
+		assert.Equal(t, false, resp.Data.Blobs[1].Received)
+		assert.Equal(t, true, resp.Data.Blobs[2].Received)
+		assert.Equal(t, "peer2", resp.Data.Blobs[2].Peer)
+		assert.Equal(t, "1700000000000", resp.Data.Blobs[2].ReceivedAt)
+	})
+	t.Run("SSZ", func(t *testing.T) {
+		require.NoError(t, bs.Save(sidecars[1]))
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/prysm/debug/data_availability/{block_root}", nil)
+		request = mux.SetURLVars(request, map[string]string{"block_root": hexutil.Encode(root[:])})
+		request.Header.Add("Accept", "application/octet-stream")
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetDataAvailability(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		resp := &dataAvailabilitySSZ{}
+		require.NoError(t, resp.UnmarshalSSZ(writer.Body.Bytes()))
+		assert.Equal(t, true, resp.Available)
+		assert.Equal(t, 3, len(resp.Blobs))
+	})
+	t.Run("unknown block", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/prysm/debug/data_availability/{block_root}", nil)
+		request = mux.SetURLVars(request, map[string]string{"block_root": hexutil.Encode(make([]byte, 32))})
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetDataAvailability(writer, request)
+		assert.Equal(t, http.StatusNotFound, writer.Code)
+	})
+	t.Run("invalid root", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/prysm/debug/data_availability/{block_root}", nil)
+		request = mux.SetURLVars(request, map[string]string{"block_root": "0x1234"})
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetDataAvailability(writer, request)
+		assert.Equal(t, http.StatusBadRequest, writer.Code)
+	})
+}
+
+func TestGetPeerScores(t *testing.T) {
+	peersProvider := &p2ptest.MockPeersProvider{}
+	connected := peersProvider.Peers().Connected()
+	require.Equal(t, 2, len(connected))
+	peersProvider.Peers().Scorers().BadResponsesScorer().Increment(connected[0])
+	peersProvider.Peers().Scorers().BlockProviderScorer().IncrementProcessedBlocks(connected[1], 64)
+	s := &Server{PeersFetcher: peersProvider}
+
+	t.Run("JSON", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/prysm/debug/peer_scores", nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetPeerScores(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		resp := &PeerScoresResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		require.Equal(t, 2, len(resp.Data))
+		scores := make(map[string]*PeerScore)
+		for _, p := range resp.Data {
+			scores[p.PeerId] = p
+		}
+		assert.Equal(t, "1", scores[connected[0].String()].BadResponses)
+		assert.Equal(t, "64", scores[connected[1].String()].ProcessedBlocks)
+		assert.Equal(t, true, scores[connected[0].String()].BadResponsesScore < 0)
+	})
+	t.Run("SSZ", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/prysm/debug/peer_scores", nil)
+		request.Header.Add("Accept", "application/octet-stream")
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetPeerScores(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		resp := &peerScoresSSZ{}
+		require.NoError(t, resp.UnmarshalSSZ(writer.Body.Bytes()))
+		require.Equal(t, 2, len(resp.Peers))
+		for _, p := range resp.Peers {
+			assert.Equal(t, false, math.IsNaN(math.Float64frombits(p.Score)))
+		}
+	})
+}
+
+func TestGetBeaconState(t *testing.T) {
+	t.Run("Phase 0", func(t *testing.T) {
+		fakeState, err := util.NewBeaconState()
+		require.NoError(t, err)
+		require.NoError(t, fakeState.SetSlot(123))
+		s := &Server{
+			Stater: &testutil.MockStater{
+				BeaconState: fakeState,
+			},
+		}
+
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/eth/v1/debug/beacon/states/{state_id}", nil)
+		request = mux.SetURLVars(request, map[string]string{"state_id": "head"})
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetBeaconState(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		resp := &GetBeaconStateResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		assert.Equal(t, "123", resp.Data.Slot)
+	})
+	t.Run("Altair", func(t *testing.T) {
+		fakeState, _ := util.DeterministicGenesisStateAltair(t, 1)
+		s := &Server{
+			Stater: &testutil.MockStater{
+				BeaconState: fakeState,
+			},
+		}
+
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/eth/v1/debug/beacon/states/{state_id}", nil)
+		request = mux.SetURLVars(request, map[string]string{"state_id": "head"})
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetBeaconState(writer, request)
+		assert.Equal(t, http.StatusBadRequest, writer.Code)
+		assert.StringContains(t, "use the v2 endpoint", writer.Body.String())
+	})
+	t.Run("SSZ", func(t *testing.T) {
+		fakeState, _ := util.DeterministicGenesisStateAltair(t, 1)
+		require.NoError(t, fakeState.SetSlot(123))
+		s := &Server{
+			Stater: &testutil.MockStater{
+				BeaconState: fakeState,
+			},
+		}
+
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/eth/v1/debug/beacon/states/{state_id}", nil)
+		request = mux.SetURLVars(request, map[string]string{"state_id": "head"})
+		request.Header.Set("Accept", api.OctetStreamMediaType)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetBeaconState(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		sszExpected, err := fakeState.MarshalSSZ()
+		require.NoError(t, err)
+		assert.DeepEqual(t, sszExpected, writer.Body.Bytes())
+	})
+}
+
+func TestGetBeaconStateV2(t *testing.T) {
+	ctx := context.Background()
+	db := dbtest.SetupDB(t)
+
+	t.Run("Phase 0", func(t *testing.T) {
+		fakeState, err := util.NewBeaconState()
+		require.NoError(t, err)
+		require.NoError(t, fakeState.SetSlot(123))
+		s := &Server{
+			Stater: &testutil.MockStater{
+				BeaconState: fakeState,
+			},
+			HeadFetcher:           &blockchainmock.ChainService{},
+			OptimisticModeFetcher: &blockchainmock.ChainService{},
+			FinalizationFetcher:   &blockchainmock.ChainService{},
+			BeaconDB:              db,
+		}
+
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/eth/v2/debug/beacon/states/{state_id}", nil)
+		request = mux.SetURLVars(request, map[string]string{"state_id": "head"})
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetBeaconStateV2(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		resp := &GetBeaconStateV2Response{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		assert.Equal(t, version.String(version.Phase0), resp.Version)
+		st := &shared.BeaconState{}
+		require.NoError(t, json.Unmarshal(resp.Data, st))
+		assert.Equal(t, "123", st.Slot)
+	})
+	t.Run("Altair", func(t *testing.T) {
+		fakeState, _ := util.DeterministicGenesisStateAltair(t, 1)
+		require.NoError(t, fakeState.SetSlot(123))
+		s := &Server{
+			Stater: &testutil.MockStater{
+				BeaconState: fakeState,
+			},
+			HeadFetcher:           &blockchainmock.ChainService{},
+			OptimisticModeFetcher: &blockchainmock.ChainService{},
+			FinalizationFetcher:   &blockchainmock.ChainService{},
+			BeaconDB:              db,
+		}
+
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/eth/v2/debug/beacon/states/{state_id}", nil)
+		request = mux.SetURLVars(request, map[string]string{"state_id": "head"})
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetBeaconStateV2(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		resp := &GetBeaconStateV2Response{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		assert.Equal(t, version.String(version.Altair), resp.Version)
+		st := &shared.BeaconStateAltair{}
+		require.NoError(t, json.Unmarshal(resp.Data, st))
+		assert.Equal(t, "123", st.Slot)
+	})
+	t.Run("Bellatrix", func(t *testing.T) {
+		fakeState, _ := util.DeterministicGenesisStateBellatrix(t, 1)
+		require.NoError(t, fakeState.SetSlot(123))
+		s := &Server{
+			Stater: &testutil.MockStater{
+				BeaconState: fakeState,
+			},
+			HeadFetcher:           &blockchainmock.ChainService{},
+			OptimisticModeFetcher: &blockchainmock.ChainService{},
+			FinalizationFetcher:   &blockchainmock.ChainService{},
+			BeaconDB:              db,
+		}
+
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/eth/v2/debug/beacon/states/{state_id}", nil)
+		request = mux.SetURLVars(request, map[string]string{"state_id": "head"})
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetBeaconStateV2(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		resp := &GetBeaconStateV2Response{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		assert.Equal(t, version.String(version.Bellatrix), resp.Version)
+		st := &shared.BeaconStateBellatrix{}
+		require.NoError(t, json.Unmarshal(resp.Data, st))
+		assert.Equal(t, "123", st.Slot)
+	})
+	t.Run("Capella", func(t *testing.T) {
+		fakeState, _ := util.DeterministicGenesisStateCapella(t, 1)
+		require.NoError(t, fakeState.SetSlot(123))
+		s := &Server{
+			Stater: &testutil.MockStater{
+				BeaconState: fakeState,
+			},
+			HeadFetcher:           &blockchainmock.ChainService{},
+			OptimisticModeFetcher: &blockchainmock.ChainService{},
+			FinalizationFetcher:   &blockchainmock.ChainService{},
+			BeaconDB:              db,
+		}
+
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/eth/v2/debug/beacon/states/{state_id}", nil)
+		request = mux.SetURLVars(request, map[string]string{"state_id": "head"})
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetBeaconStateV2(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		resp := &GetBeaconStateV2Response{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		assert.Equal(t, version.String(version.Capella), resp.Version)
+		st := &shared.BeaconStateCapella{}
+		require.NoError(t, json.Unmarshal(resp.Data, st))
+		assert.Equal(t, "123", st.Slot)
+	})
+	t.Run("Deneb", func(t *testing.T) {
+		fakeState, _ := util.DeterministicGenesisStateDeneb(t, 1)
+		require.NoError(t, fakeState.SetSlot(123))
+		s := &Server{
+			Stater: &testutil.MockStater{
+				BeaconState: fakeState,
+			},
+			HeadFetcher:           &blockchainmock.ChainService{},
+			OptimisticModeFetcher: &blockchainmock.ChainService{},
+			FinalizationFetcher:   &blockchainmock.ChainService{},
+			BeaconDB:              db,
+		}
+
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/eth/v2/debug/beacon/states/{state_id}", nil)
+		request = mux.SetURLVars(request, map[string]string{"state_id": "head"})
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetBeaconStateV2(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		resp := &GetBeaconStateV2Response{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		assert.Equal(t, version.String(version.Deneb), resp.Version)
+		st := &shared.BeaconStateDeneb{}
+		require.NoError(t, json.Unmarshal(resp.Data, st))
+		assert.Equal(t, "123", st.Slot)
+	})
+	t.Run("execution optimistic", func(t *testing.T) {
+		parentRoot := [32]byte{'a'}
+		blk := util.NewBeaconBlock()
+		blk.Block.ParentRoot = parentRoot[:]
+		root, err := blk.Block.HashTreeRoot()
+		require.NoError(t, err)
+		util.SaveBlock(t, ctx, db, blk)
+		require.NoError(t, db.SaveGenesisBlockRoot(ctx, root))
+
+		fakeState, _ := util.DeterministicGenesisStateBellatrix(t, 1)
+		s := &Server{
+			Stater: &testutil.MockStater{
+				BeaconState: fakeState,
+			},
+			HeadFetcher:           &blockchainmock.ChainService{},
+			OptimisticModeFetcher: &blockchainmock.ChainService{Optimistic: true},
+			FinalizationFetcher:   &blockchainmock.ChainService{},
+			BeaconDB:              db,
+		}
+
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/eth/v2/debug/beacon/states/{state_id}", nil)
+		request = mux.SetURLVars(request, map[string]string{"state_id": "head"})
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetBeaconStateV2(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		resp := &GetBeaconStateV2Response{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		assert.Equal(t, true, resp.ExecutionOptimistic)
+	})
+	t.Run("finalized", func(t *testing.T) {
+		parentRoot := [32]byte{'a'}
+		blk := util.NewBeaconBlock()
+		blk.Block.ParentRoot = parentRoot[:]
+		root, err := blk.Block.HashTreeRoot()
+		require.NoError(t, err)
+		util.SaveBlock(t, ctx, db, blk)
+		require.NoError(t, db.SaveGenesisBlockRoot(ctx, root))
+
+		fakeState, _ := util.DeterministicGenesisStateBellatrix(t, 1)
+		headerRoot, err := fakeState.LatestBlockHeader().HashTreeRoot()
+		require.NoError(t, err)
+		chainService := &blockchainmock.ChainService{
+			FinalizedRoots: map[[32]byte]bool{
+				headerRoot: true,
+			},
+		}
+		s := &Server{
+			Stater: &testutil.MockStater{
+				BeaconState: fakeState,
+			},
+			HeadFetcher:           chainService,
+			OptimisticModeFetcher: chainService,
+			FinalizationFetcher:   chainService,
+			BeaconDB:              db,
+		}
+
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/eth/v2/debug/beacon/states/{state_id}", nil)
+		request = mux.SetURLVars(request, map[string]string{"state_id": "head"})
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetBeaconStateV2(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		resp := &GetBeaconStateV2Response{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		assert.Equal(t, true, resp.Finalized)
+	})
+}
+
+func TestGetBeaconStateSSZV2(t *testing.T) {
+	t.Run("Phase 0", func(t *testing.T) {
+		fakeState, err := util.NewBeaconState()
+		require.NoError(t, err)
+		require.NoError(t, fakeState.SetSlot(123))
+		s := &Server{
+			Stater: &testutil.MockStater{
+				BeaconState: fakeState,
+			},
+		}
+
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/eth/v2/debug/beacon/states/{state_id}", nil)
+		request = mux.SetURLVars(request, map[string]string{"state_id": "head"})
+		request.Header.Set("Accept", api.OctetStreamMediaType)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetBeaconStateV2(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		assert.Equal(t, version.String(version.Phase0), writer.Header().Get(api.VersionHeader))
+		sszExpected, err := fakeState.MarshalSSZ()
+		require.NoError(t, err)
+		assert.DeepEqual(t, sszExpected, writer.Body.Bytes())
+	})
+	t.Run("Altair", func(t *testing.T) {
+		fakeState, _ := util.DeterministicGenesisStateAltair(t, 1)
+		require.NoError(t, fakeState.SetSlot(123))
+		s := &Server{
+			Stater: &testutil.MockStater{
+				BeaconState: fakeState,
+			},
+		}
+
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/eth/v2/debug/beacon/states/{state_id}", nil)
+		request = mux.SetURLVars(request, map[string]string{"state_id": "head"})
+		request.Header.Set("Accept", api.OctetStreamMediaType)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetBeaconStateV2(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		assert.Equal(t, version.String(version.Altair), writer.Header().Get(api.VersionHeader))
+		sszExpected, err := fakeState.MarshalSSZ()
+		require.NoError(t, err)
+		assert.DeepEqual(t, sszExpected, writer.Body.Bytes())
+	})
+	t.Run("Bellatrix", func(t *testing.T) {
+		fakeState, _ := util.DeterministicGenesisStateBellatrix(t, 1)
+		require.NoError(t, fakeState.SetSlot(123))
+		s := &Server{
+			Stater: &testutil.MockStater{
+				BeaconState: fakeState,
+			},
+		}
+
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/eth/v2/debug/beacon/states/{state_id}", nil)
+		request = mux.SetURLVars(request, map[string]string{"state_id": "head"})
+		request.Header.Set("Accept", api.OctetStreamMediaType)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetBeaconStateV2(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		assert.Equal(t, version.String(version.Bellatrix), writer.Header().Get(api.VersionHeader))
+		sszExpected, err := fakeState.MarshalSSZ()
+		require.NoError(t, err)
+		assert.DeepEqual(t, sszExpected, writer.Body.Bytes())
+	})
+	t.Run("Capella", func(t *testing.T) {
+		fakeState, _ := util.DeterministicGenesisStateCapella(t, 1)
+		require.NoError(t, fakeState.SetSlot(123))
+		s := &Server{
+			Stater: &testutil.MockStater{
+				BeaconState: fakeState,
+			},
+		}
+
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/eth/v2/debug/beacon/states/{state_id}", nil)
+		request = mux.SetURLVars(request, map[string]string{"state_id": "head"})
+		request.Header.Set("Accept", api.OctetStreamMediaType)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetBeaconStateV2(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		assert.Equal(t, version.String(version.Capella), writer.Header().Get(api.VersionHeader))
+		sszExpected, err := fakeState.MarshalSSZ()
+		require.NoError(t, err)
+		assert.DeepEqual(t, sszExpected, writer.Body.Bytes())
+	})
+	t.Run("Deneb", func(t *testing.T) {
+		fakeState, _ := util.DeterministicGenesisStateDeneb(t, 1)
+		require.NoError(t, fakeState.SetSlot(123))
+		s := &Server{
+			Stater: &testutil.MockStater{
+				BeaconState: fakeState,
+			},
+		}
+
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/eth/v2/debug/beacon/states/{state_id}", nil)
+		request = mux.SetURLVars(request, map[string]string{"state_id": "head"})
+		request.Header.Set("Accept", api.OctetStreamMediaType)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetBeaconStateV2(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		assert.Equal(t, version.String(version.Deneb), writer.Header().Get(api.VersionHeader))
+		sszExpected, err := fakeState.MarshalSSZ()
+		require.NoError(t, err)
+		assert.DeepEqual(t, sszExpected, writer.Body.Bytes())
+	})
+}
@@ -1,11 +1,14 @@
-// Package debug defines a gRPC beacon service implementation,
+// Package debug defines the debug API endpoints implementation,
 // following the official API standards https://ethereum.github.io/beacon-apis/#/.
 // This package includes the beacon and config endpoints.
 package debug
 
 import (
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/blockchain"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/cache"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/db/filesystem"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/p2p"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/rpc/lookup"
 )
 
@@ -20,4 +23,8 @@ type Server struct {
 	ForkchoiceFetcher     blockchain.ForkchoiceFetcher
 	FinalizationFetcher   blockchain.FinalizationFetcher
 	ChainInfoFetcher      blockchain.ChainInfoFetcher
+	GenesisTimeFetcher    blockchain.TimeFetcher
+	BlobStorage           *filesystem.BlobStorage
+	BlobArrivals          *cache.BlobArrivalsCache
+	PeersFetcher          p2p.PeersProvider
 }
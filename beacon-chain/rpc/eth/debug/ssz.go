@@ -0,0 +1,68 @@
+package debug
+
+// The containers below are the SSZ encodings of the analytics endpoints. Scores are encoded as
+// the IEEE 754 binary representation of the float64 values.
+
+type checkpointSSZ struct {
+	Epoch uint64
+	Root  []byte `ssz-size:"32"`
+}
+
+type forkChoiceSSZ struct {
+	JustifiedCheckpoint           *checkpointSSZ
+	FinalizedCheckpoint           *checkpointSSZ
+	UnrealizedJustifiedCheckpoint *checkpointSSZ
+	UnrealizedFinalizedCheckpoint *checkpointSSZ
+	ProposerBoostRoot             []byte               `ssz-size:"32"`
+	PreviousProposerBoostRoot     []byte               `ssz-size:"32"`
+	HeadRoot                      []byte               `ssz-size:"32"`
+	ForkChoiceNodes               []*forkChoiceNodeSSZ `ssz-max:"1048576"`
+}
+
+type forkChoiceNodeSSZ struct {
+	Slot                     uint64
+	BlockRoot                []byte `ssz-size:"32"`
+	ParentRoot               []byte `ssz-size:"32"`
+	JustifiedEpoch           uint64
+	FinalizedEpoch           uint64
+	UnrealizedJustifiedEpoch uint64
+	UnrealizedFinalizedEpoch uint64
+	Balance                  uint64
+	Weight                   uint64
+	ExecutionOptimistic      bool
+	ExecutionBlockHash       []byte `ssz-size:"32"`
+	Timestamp                uint64
+	Timely                   bool
+}
+
+type dataAvailabilitySSZ struct {
+	BlockRoot     []byte `ssz-size:"32"`
+	Slot          uint64
+	ExpectedBlobs uint64
+	Available     bool
+	Blobs         []*blobAvailabilitySSZ `ssz-max:"6"`
+}
+
+type blobAvailabilitySSZ struct {
+	Index      uint64
+	Received   bool
+	ReceivedAt uint64
+	Peer       []byte `ssz-max:"128"`
+}
+
+type peerScoresSSZ struct {
+	Peers []*peerScoreSSZ `ssz-max:"16384"`
+}
+
+type peerScoreSSZ struct {
+	Score              uint64
+	IsBad              bool
+	BadResponses       uint64
+	BadResponsesScore  uint64
+	ProcessedBlocks    uint64
+	BlockProviderScore uint64
+	PeerStatusScore    uint64
+	GossipScore        uint64
+	BehaviourPenalty   uint64
+	PeerId             []byte `ssz-max:"128"`
+}
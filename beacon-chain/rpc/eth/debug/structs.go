@@ -0,0 +1,127 @@
+package debug
+
+import (
+	"encoding/json"
+
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/rpc/eth/shared"
+)
+
+type GetForkChoiceHeadsV2Response struct {
+	Data []*ForkChoiceHead `json:"data"`
+}
+
+type ForkChoiceHead struct {
+	Root                string `json:"root"`
+	Slot                string `json:"slot"`
+	ExecutionOptimistic bool   `json:"execution_optimistic"`
+}
+
+type GetForkChoiceDumpResponse struct {
+	JustifiedCheckpoint *shared.Checkpoint       `json:"justified_checkpoint"`
+	FinalizedCheckpoint *shared.Checkpoint       `json:"finalized_checkpoint"`
+	ForkChoiceNodes     []*ForkChoiceDumpNode    `json:"fork_choice_nodes"`
+	ExtraData           *ForkChoiceDumpExtraData `json:"extra_data"`
+}
+
+type ForkChoiceDumpExtraData struct {
+	UnrealizedJustifiedCheckpoint *shared.Checkpoint `json:"unrealized_justified_checkpoint"`
+	UnrealizedFinalizedCheckpoint *shared.Checkpoint `json:"unrealized_finalized_checkpoint"`
+	ProposerBoostRoot             string             `json:"proposer_boost_root"`
+	PreviousProposerBoostRoot     string             `json:"previous_proposer_boost_root"`
+	HeadRoot                      string             `json:"head_root"`
+}
+
+type ForkChoiceDumpNode struct {
+	Slot               string                   `json:"slot"`
+	BlockRoot          string                   `json:"block_root"`
+	ParentRoot         string                   `json:"parent_root"`
+	JustifiedEpoch     string                   `json:"justified_epoch"`
+	FinalizedEpoch     string                   `json:"finalized_epoch"`
+	Weight             string                   `json:"weight"`
+	Validity           string                   `json:"validity"`
+	ExecutionBlockHash string                   `json:"execution_block_hash"`
+	ExtraData          *ForkChoiceNodeExtraData `json:"extra_data"`
+}
+
+type ForkChoiceNodeExtraData struct {
+	UnrealizedJustifiedEpoch string `json:"unrealized_justified_epoch"`
+	UnrealizedFinalizedEpoch string `json:"unrealized_finalized_epoch"`
+	Balance                  string `json:"balance"`
+	ExecutionOptimistic      bool   `json:"execution_optimistic"`
+	Timestamp                string `json:"timestamp"`
+}
+
+type ForkChoiceResponse struct {
+	JustifiedCheckpoint           *shared.Checkpoint `json:"justified_checkpoint"`
+	FinalizedCheckpoint           *shared.Checkpoint `json:"finalized_checkpoint"`
+	UnrealizedJustifiedCheckpoint *shared.Checkpoint `json:"unrealized_justified_checkpoint"`
+	UnrealizedFinalizedCheckpoint *shared.Checkpoint `json:"unrealized_finalized_checkpoint"`
+	ProposerBoostRoot             string             `json:"proposer_boost_root"`
+	PreviousProposerBoostRoot     string             `json:"previous_proposer_boost_root"`
+	HeadRoot                      string             `json:"head_root"`
+	ForkChoiceNodes               []*ForkChoiceNode  `json:"fork_choice_nodes"`
+}
+
+type ForkChoiceNode struct {
+	Slot                     string `json:"slot"`
+	BlockRoot                string `json:"block_root"`
+	ParentRoot               string `json:"parent_root"`
+	JustifiedEpoch           string `json:"justified_epoch"`
+	FinalizedEpoch           string `json:"finalized_epoch"`
+	UnrealizedJustifiedEpoch string `json:"unrealized_justified_epoch"`
+	UnrealizedFinalizedEpoch string `json:"unrealized_finalized_epoch"`
+	Balance                  string `json:"balance"`
+	Weight                   string `json:"weight"`
+	ExecutionOptimistic      bool   `json:"execution_optimistic"`
+	ExecutionBlockHash       string `json:"execution_block_hash"`
+	Timestamp                string `json:"timestamp"`
+	Validity                 string `json:"validity"`
+	Timely                   bool   `json:"timely"`
+}
+
+type DataAvailabilityResponse struct {
+	Data *DataAvailability `json:"data"`
+}
+
+type DataAvailability struct {
+	BlockRoot     string              `json:"block_root"`
+	Slot          string              `json:"slot"`
+	ExpectedBlobs string              `json:"expected_blobs"`
+	Available     bool                `json:"available"`
+	Blobs         []*BlobAvailability `json:"blobs"`
+}
+
+type BlobAvailability struct {
+	Index      string `json:"index"`
+	Received   bool   `json:"received"`
+	Peer       string `json:"peer"`
+	ReceivedAt string `json:"received_at"`
+}
+
+type PeerScoresResponse struct {
+	Data []*PeerScore `json:"data"`
+}
+
+type PeerScore struct {
+	PeerId             string  `json:"peer_id"`
+	Score              float64 `json:"score"`
+	IsBad              bool    `json:"is_bad"`
+	BadResponses       string  `json:"bad_responses"`
+	BadResponsesScore  float64 `json:"bad_responses_score"`
+	ProcessedBlocks    string  `json:"processed_blocks"`
+	BlockProviderScore float64 `json:"block_provider_score"`
+	PeerStatusScore    float64 `json:"peer_status_score"`
+	GossipScore        float64 `json:"gossip_score"`
+	BehaviourPenalty   float64 `json:"behaviour_penalty"`
+}
+
+type GetBeaconStateResponse struct {
+	Data *shared.BeaconState `json:"data"`
+}
+
+type GetBeaconStateV2Response struct {
+	Version             string          `json:"version"`
+	ExecutionOptimistic bool            `json:"execution_optimistic"`
+	Finalized           bool            `json:"finalized"`
+	Data                json.RawMessage `json:"data"` // represents the state values based on the version
+}
@@ -0,0 +1,269 @@
+package events
+
+import (
+	"encoding/json"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	// DroppedTopic is the topic of the event notifying a subscriber that some of its events were not delivered.
+	DroppedTopic = "dropped"
+	// DefaultReplaySize is the default number of events kept per topic for resuming streams.
+	DefaultReplaySize = 1024
+	// DefaultSubscriberBufferSize is the default number of events queued for a subscriber before events are dropped.
+	DefaultSubscriberBufferSize = 1024
+)
+
+var droppedEventsCount = promauto.NewCounterVec(prometheus.CounterOpts{
+	Name: "events_api_dropped_events_total",
+	Help: "Number of events not delivered to event stream subscribers that fell behind",
+}, []string{"topic"})
+
+// Event is a single event published to the event stream.
+type Event struct {
+	// ID is unique and increasing across all topics. Events without an ID are not replayable.
+	ID    uint64
+	Topic string
+	Data  []byte
+}
+
+// DroppedEvent notifies a subscriber that some of the events it subscribed to were not delivered.
+// Events of subscribed topics with an ID in [FirstID, LastID] may be missing from the stream,
+// and can be requested again by reconnecting with a Last-Event-ID of FirstID - 1.
+// Count is omitted when the number of missing events is not known.
+type DroppedEvent struct {
+	Count   string `json:"count,omitempty"`
+	FirstID string `json:"first_id"`
+	LastID  string `json:"last_id"`
+}
+
+// Broadcaster fans out published events to subscribers. It keeps the most recent events
+// of every topic so that a subscriber can resume the stream after reconnecting.
+// Subscribers that do not keep up have events dropped instead of slowing down the publisher.
+type Broadcaster struct {
+	lock       sync.Mutex
+	lastID     uint64
+	replaySize int
+	bufferSize int
+	replay     map[string]*ring
+	subs       map[*Subscription]bool
+}
+
+// NewBroadcaster creates a broadcaster keeping replaySize events per topic for replay,
+// and queueing at most bufferSize events for each subscriber.
+func NewBroadcaster(replaySize, bufferSize int) *Broadcaster {
+	if replaySize < 1 {
+		replaySize = 1
+	}
+	// Delivering an event after a drop requires room for the dropped event notification as well.
+	if bufferSize < 2 {
+		bufferSize = 2
+	}
+	return &Broadcaster{
+		// IDs are seeded from the wall clock so that they keep increasing across restarts of the node.
+		lastID:     uint64(time.Now().UnixNano()),
+		replaySize: replaySize,
+		bufferSize: bufferSize,
+		replay:     make(map[string]*ring),
+		subs:       make(map[*Subscription]bool),
+	}
+}
+
+// Subscription receives the events of a set of topics published to a Broadcaster.
+type Subscription struct {
+	topics map[string]bool
+	events chan *Event
+	// Guarded by the broadcaster lock.
+	dropped droppedRange
+}
+
+// Events returns the channel on which the subscription's events are delivered.
+func (s *Subscription) Events() <-chan *Event {
+	return s.events
+}
+
+type droppedRange struct {
+	count   uint64
+	firstID uint64
+	lastID  uint64
+}
+
+func (d droppedRange) event() *Event {
+	dropped := &DroppedEvent{
+		FirstID: strconv.FormatUint(d.firstID, 10),
+		LastID:  strconv.FormatUint(d.lastID, 10),
+	}
+	if d.count > 0 {
+		dropped.Count = strconv.FormatUint(d.count, 10)
+	}
+	data, err := json.Marshal(dropped)
+	if err != nil {
+		log.WithError(err).Error("Could not marshal dropped event")
+	}
+	return &Event{Topic: DroppedTopic, Data: data}
+}
+
+func (d *droppedRange) add(id uint64) {
+	if d.count == 0 {
+		d.firstID = id
+	}
+	d.count++
+	d.lastID = id
+}
+
+// Publish assigns the next ID to the event, stores it for replay and delivers it to all subscribers of the topic.
+// Publish never blocks on subscribers.
+func (b *Broadcaster) Publish(topic string, data []byte) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	b.lastID++
+	e := &Event{ID: b.lastID, Topic: topic, Data: data}
+	r, ok := b.replay[topic]
+	if !ok {
+		r = newRing(b.replaySize)
+		b.replay[topic] = r
+	}
+	r.push(e)
+	for sub := range b.subs {
+		if sub.topics[topic] {
+			sub.deliver(e)
+		}
+	}
+}
+
+// Subscribe registers a subscription to the given topics. When lastEventID is not zero,
+// the stored events of these topics published after lastEventID are returned in order,
+// preceded by a dropped event if some of them are no longer stored.
+// A lastEventID ahead of the latest published ID comes from before a restart of the node, whose events are lost.
+// All stored events of these topics are then returned, preceded by a dropped event covering every earlier ID.
+// Events published after Subscribe returns are delivered on the subscription's channel.
+func (b *Broadcaster) Subscribe(topics map[string]bool, lastEventID uint64) (*Subscription, []*Event) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	sub := &Subscription{
+		topics: topics,
+		events: make(chan *Event, b.bufferSize),
+	}
+	b.subs[sub] = true
+	if lastEventID == 0 {
+		return sub, nil
+	}
+
+	// The IDs of the previous run of the node have no relation with the current ones, so nothing can be skipped.
+	ahead := lastEventID > b.lastID
+	if ahead {
+		lastEventID = 0
+	}
+	var replay []*Event
+	var evicted uint64
+	for topic := range topics {
+		r, ok := b.replay[topic]
+		if !ok {
+			continue
+		}
+		if r.evicted > evicted {
+			evicted = r.evicted
+		}
+		replay = append(replay, r.since(lastEventID)...)
+	}
+	sort.Slice(replay, func(i, j int) bool {
+		return replay[i].ID < replay[j].ID
+	})
+	switch {
+	case ahead:
+		missing := droppedRange{firstID: 0, lastID: b.lastID}
+		if len(replay) > 0 {
+			missing.lastID = replay[0].ID - 1
+		}
+		replay = append([]*Event{missing.event()}, replay...)
+	case evicted > lastEventID:
+		missing := droppedRange{firstID: lastEventID + 1, lastID: evicted}
+		replay = append([]*Event{missing.event()}, replay...)
+	}
+	return sub, replay
+}
+
+// Unsubscribe stops delivering events to the subscription.
+func (b *Broadcaster) Unsubscribe(sub *Subscription) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	delete(b.subs, sub)
+}
+
+// HasSubscribers returns true if there is at least one subscription to the topic.
+func (b *Broadcaster) HasSubscribers(topic string) bool {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	for sub := range b.subs {
+		if sub.topics[topic] {
+			return true
+		}
+	}
+	return false
+}
+
+// deliver must be called with the broadcaster lock held, which makes the broadcaster the only sender.
+func (s *Subscription) deliver(e *Event) {
+	if s.dropped.count > 0 {
+		// The dropped event notification has to precede the next delivered event.
+		if cap(s.events)-len(s.events) < 2 {
+			s.drop(e)
+			return
+		}
+		s.events <- s.dropped.event()
+		s.dropped = droppedRange{}
+	}
+	select {
+	case s.events <- e:
+	default:
+		s.drop(e)
+	}
+}
+
+func (s *Subscription) drop(e *Event) {
+	s.dropped.add(e.ID)
+	droppedEventsCount.WithLabelValues(e.Topic).Inc()
+}
+
+// ring is a fixed size buffer of the most recent events of a topic.
+type ring struct {
+	events []*Event
+	start  int
+	// ID of the most recent event no longer stored in the ring.
+	evicted uint64
+}
+
+func newRing(size int) *ring {
+	return &ring{events: make([]*Event, 0, size)}
+}
+
+func (r *ring) push(e *Event) {
+	if len(r.events) < cap(r.events) {
+		r.events = append(r.events, e)
+		return
+	}
+	r.evicted = r.events[r.start].ID
+	r.events[r.start] = e
+	r.start = (r.start + 1) % len(r.events)
+}
+
+// since returns the stored events with an ID greater than id, oldest first.
+func (r *ring) since(id uint64) []*Event {
+	var events []*Event
+	for i := 0; i < len(r.events); i++ {
+		e := r.events[(r.start+i)%len(r.events)]
+		if e.ID > id {
+			events = append(events, e)
+		}
+	}
+	return events
+}
@@ -0,0 +1,171 @@
+package events
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v4/testing/assert"
+	"github.com/prysmaticlabs/prysm/v4/testing/require"
+)
+
+func testBroadcaster(replaySize, bufferSize int) *Broadcaster {
+	b := NewBroadcaster(replaySize, bufferSize)
+	// Predictable IDs make assertions easier to read.
+	b.lastID = 0
+	return b
+}
+
+func publishN(b *Broadcaster, topic string, n int) {
+	for i := 0; i < n; i++ {
+		b.Publish(topic, []byte(fmt.Sprintf(`{"n":%d}`, i)))
+	}
+}
+
+func receiveEvent(t *testing.T, sub *Subscription) *Event {
+	select {
+	case e := <-sub.Events():
+		return e
+	default:
+		t.Fatal("No event received")
+		return nil
+	}
+}
+
+func assertDropped(t *testing.T, e *Event, want *DroppedEvent) {
+	require.Equal(t, DroppedTopic, e.Topic)
+	assert.Equal(t, uint64(0), e.ID)
+	got := &DroppedEvent{}
+	require.NoError(t, json.Unmarshal(e.Data, got))
+	assert.DeepEqual(t, want, got)
+}
+
+func TestBroadcaster_Publish(t *testing.T) {
+	b := NewBroadcaster(DefaultReplaySize, DefaultSubscriberBufferSize)
+	sub, replay := b.Subscribe(map[string]bool{HeadTopic: true, BlockTopic: true}, 0)
+	assert.Equal(t, 0, len(replay))
+
+	b.Publish(HeadTopic, []byte("head"))
+	b.Publish(AttestationTopic, []byte("attestation"))
+	b.Publish(BlockTopic, []byte("block"))
+
+	head := receiveEvent(t, sub)
+	assert.Equal(t, HeadTopic, head.Topic)
+	assert.DeepEqual(t, []byte("head"), head.Data)
+	block := receiveEvent(t, sub)
+	assert.Equal(t, BlockTopic, block.Topic)
+	// IDs are shared by all topics.
+	assert.Equal(t, head.ID+2, block.ID)
+	assert.Equal(t, 0, len(sub.Events()))
+
+	b.Unsubscribe(sub)
+	b.Publish(HeadTopic, []byte("head"))
+	assert.Equal(t, 0, len(sub.Events()))
+}
+
+func TestBroadcaster_Replay(t *testing.T) {
+	t.Run("events after last event ID", func(t *testing.T) {
+		b := testBroadcaster(DefaultReplaySize, DefaultSubscriberBufferSize)
+		publishN(b, HeadTopic, 2)
+		publishN(b, BlockTopic, 1)
+		publishN(b, HeadTopic, 1)
+
+		_, replay := b.Subscribe(map[string]bool{HeadTopic: true, BlockTopic: true}, 1)
+		require.Equal(t, 3, len(replay))
+		for i, e := range replay {
+			assert.Equal(t, uint64(i+2), e.ID)
+		}
+		_, replay = b.Subscribe(map[string]bool{HeadTopic: true}, 2)
+		require.Equal(t, 1, len(replay))
+		assert.Equal(t, uint64(4), replay[0].ID)
+		_, replay = b.Subscribe(map[string]bool{HeadTopic: true}, 4)
+		assert.Equal(t, 0, len(replay))
+	})
+	t.Run("no last event ID", func(t *testing.T) {
+		b := testBroadcaster(DefaultReplaySize, DefaultSubscriberBufferSize)
+		publishN(b, HeadTopic, 2)
+		_, replay := b.Subscribe(map[string]bool{HeadTopic: true}, 0)
+		assert.Equal(t, 0, len(replay))
+	})
+	t.Run("replay window exceeded", func(t *testing.T) {
+		b := testBroadcaster(2, DefaultSubscriberBufferSize)
+		publishN(b, HeadTopic, 5)
+
+		_, replay := b.Subscribe(map[string]bool{HeadTopic: true}, 1)
+		require.Equal(t, 3, len(replay))
+		assertDropped(t, replay[0], &DroppedEvent{FirstID: "2", LastID: "3"})
+		assert.Equal(t, uint64(4), replay[1].ID)
+		assert.Equal(t, uint64(5), replay[2].ID)
+
+		_, replay = b.Subscribe(map[string]bool{HeadTopic: true}, 3)
+		require.Equal(t, 2, len(replay))
+		assert.Equal(t, uint64(4), replay[0].ID)
+	})
+	t.Run("last event ID ahead", func(t *testing.T) {
+		b := testBroadcaster(2, DefaultSubscriberBufferSize)
+		publishN(b, HeadTopic, 5)
+
+		// Every stored event is replayed, after the range of events that can't be.
+		_, replay := b.Subscribe(map[string]bool{HeadTopic: true}, 10)
+		require.Equal(t, 3, len(replay))
+		assertDropped(t, replay[0], &DroppedEvent{FirstID: "0", LastID: "3"})
+		assert.Equal(t, uint64(4), replay[1].ID)
+		assert.Equal(t, uint64(5), replay[2].ID)
+
+		_, replay = b.Subscribe(map[string]bool{BlockTopic: true}, 10)
+		require.Equal(t, 1, len(replay))
+		assertDropped(t, replay[0], &DroppedEvent{FirstID: "0", LastID: "5"})
+	})
+}
+
+func TestBroadcaster_SlowSubscriber(t *testing.T) {
+	b := testBroadcaster(DefaultReplaySize, 2)
+	slow, _ := b.Subscribe(map[string]bool{HeadTopic: true}, 0)
+	fast, _ := b.Subscribe(map[string]bool{HeadTopic: true}, 0)
+
+	for i := 0; i < 5; i++ {
+		publishN(b, HeadTopic, 1)
+		assert.Equal(t, uint64(i+1), receiveEvent(t, fast).ID)
+	}
+	// Only the events fitting in the buffer are queued, the rest is dropped without blocking the publisher.
+	assert.Equal(t, uint64(1), receiveEvent(t, slow).ID)
+	assert.Equal(t, uint64(2), receiveEvent(t, slow).ID)
+	assert.Equal(t, 0, len(slow.Events()))
+
+	// The next delivered event is preceded by the range of dropped events.
+	publishN(b, HeadTopic, 1)
+	assertDropped(t, receiveEvent(t, slow), &DroppedEvent{Count: "3", FirstID: "3", LastID: "5"})
+	assert.Equal(t, uint64(6), receiveEvent(t, slow).ID)
+	assert.Equal(t, uint64(6), receiveEvent(t, fast).ID)
+
+	// Dropped events can be recovered by resuming the stream before the first dropped event.
+	_, replay := b.Subscribe(map[string]bool{HeadTopic: true}, 2)
+	require.Equal(t, 4, len(replay))
+	assert.Equal(t, uint64(3), replay[0].ID)
+}
+
+func TestBroadcaster_SlowSubscriber_NoRoomForNotification(t *testing.T) {
+	b := testBroadcaster(DefaultReplaySize, 2)
+	sub, _ := b.Subscribe(map[string]bool{HeadTopic: true}, 0)
+
+	publishN(b, HeadTopic, 3)
+	assert.Equal(t, uint64(1), receiveEvent(t, sub).ID)
+	// A single free slot cannot hold both the notification and the event, so the event is dropped as well.
+	publishN(b, HeadTopic, 1)
+	assert.Equal(t, 1, len(sub.Events()))
+	assert.Equal(t, uint64(2), receiveEvent(t, sub).ID)
+
+	publishN(b, HeadTopic, 1)
+	assertDropped(t, receiveEvent(t, sub), &DroppedEvent{Count: "2", FirstID: "3", LastID: "4"})
+	assert.Equal(t, uint64(5), receiveEvent(t, sub).ID)
+}
+
+func TestBroadcaster_HasSubscribers(t *testing.T) {
+	b := NewBroadcaster(DefaultReplaySize, DefaultSubscriberBufferSize)
+	assert.Equal(t, false, b.HasSubscribers(PayloadAttributesTopic))
+	sub, _ := b.Subscribe(map[string]bool{HeadTopic: true, PayloadAttributesTopic: true}, 0)
+	assert.Equal(t, true, b.HasSubscribers(PayloadAttributesTopic))
+	assert.Equal(t, false, b.HasSubscribers(BlockTopic))
+	b.Unsubscribe(sub)
+	assert.Equal(t, false, b.HasSubscribers(PayloadAttributesTopic))
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/blockchain"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/core/feed"
+	blockfeed "github.com/prysmaticlabs/prysm/v4/beacon-chain/core/feed/block"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/core/feed/operation"
 	statefeed "github.com/prysmaticlabs/prysm/v4/beacon-chain/core/feed/state"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/core/helpers"
@@ -16,6 +17,7 @@ import (
 	enginev1 "github.com/prysmaticlabs/prysm/v4/proto/engine/v1"
 	ethpbservice "github.com/prysmaticlabs/prysm/v4/proto/eth/service"
 	ethpb "github.com/prysmaticlabs/prysm/v4/proto/eth/v1"
+	ethpbv2 "github.com/prysmaticlabs/prysm/v4/proto/eth/v2"
 	"github.com/prysmaticlabs/prysm/v4/proto/migration"
 	"github.com/prysmaticlabs/prysm/v4/runtime/version"
 	"github.com/prysmaticlabs/prysm/v4/time/slots"
@@ -47,19 +49,39 @@ const (
 	PayloadAttributesTopic = "payload_attributes"
 	// BlobSidecarTopic represents a new blob sidecar event topic
 	BlobSidecarTopic = "blob_sidecar"
+	// LightClientFinalityUpdateTopic represents a new light client finality update event topic.
+	LightClientFinalityUpdateTopic = "light_client_finality_update"
+	// LightClientOptimisticUpdateTopic represents a new light client optimistic update event topic.
+	LightClientOptimisticUpdateTopic = "light_client_optimistic_update"
+	// EquivocationTopic represents a new equivocation detected by the slasher event topic.
+	EquivocationTopic = "equivocation"
+	// BlockGossipTopic represents a block received from gossip or proposed by the node, published
+	// before blocks of the same proposer and slot are deduplicated and before the block is imported.
+	BlockGossipTopic = "block_gossip"
+)
+
+const (
+	// EquivocationKindBlobSidecar is the kind of equivocation events for conflicting blob sidecars.
+	EquivocationKindBlobSidecar = "blob_sidecar"
+	// EquivocationKindSyncCommitteeMessage is the kind of equivocation events for conflicting sync committee messages.
+	EquivocationKindSyncCommitteeMessage = "sync_committee_message"
 )
 
 var casesHandled = map[string]bool{
-	HeadTopic:                      true,
-	BlockTopic:                     true,
-	AttestationTopic:               true,
-	VoluntaryExitTopic:             true,
-	FinalizedCheckpointTopic:       true,
-	ChainReorgTopic:                true,
-	SyncCommitteeContributionTopic: true,
-	BLSToExecutionChangeTopic:      true,
-	PayloadAttributesTopic:         true,
-	BlobSidecarTopic:               true,
+	HeadTopic:                        true,
+	BlockTopic:                       true,
+	AttestationTopic:                 true,
+	VoluntaryExitTopic:               true,
+	FinalizedCheckpointTopic:         true,
+	ChainReorgTopic:                  true,
+	SyncCommitteeContributionTopic:   true,
+	BLSToExecutionChangeTopic:        true,
+	PayloadAttributesTopic:           true,
+	BlobSidecarTopic:                 true,
+	LightClientFinalityUpdateTopic:   true,
+	LightClientOptimisticUpdateTopic: true,
+	EquivocationTopic:                true,
+	BlockGossipTopic:                 true,
 }
 
 // StreamEvents allows requesting all events from a set of topics defined in the Ethereum consensus API standard.
@@ -90,20 +112,32 @@ func (s *Server) StreamEvents(
 	stateChan := make(chan *feed.Event, 1)
 	stateSub := s.StateNotifier.StateFeed().Subscribe(stateChan)
 
+	blockChan := make(chan *feed.Event, 1)
+	blockSub := s.BlockNotifier.BlockFeed().Subscribe(blockChan)
+
 	defer opsSub.Unsubscribe()
 	defer stateSub.Unsubscribe()
+	defer blockSub.Unsubscribe()
+
+	send := func(topic string, data proto.Message) error {
+		return streamData(stream, topic, data)
+	}
 
 	// Handle each event received and context cancelation.
 	for {
 		select {
 		case event := <-opsChan:
-			if err := handleBlockOperationEvents(stream, requestedTopics, event); err != nil {
+			if err := handleBlockOperationEvents(send, requestedTopics, event); err != nil {
 				return status.Errorf(codes.Internal, "Could not handle block operations event: %v", err)
 			}
 		case event := <-stateChan:
-			if err := s.handleStateEvents(stream, requestedTopics, event); err != nil {
+			if err := s.handleStateEvents(send, requestedTopics, event); err != nil {
 				return status.Errorf(codes.Internal, "Could not handle state event: %v", err)
 			}
+		case event := <-blockChan:
+			if err := handleBlockEvents(send, requestedTopics, event); err != nil {
+				return status.Errorf(codes.Internal, "Could not handle block event: %v", err)
+			}
 		case <-s.Ctx.Done():
 			return status.Errorf(codes.Canceled, "Context canceled")
 		case <-stream.Context().Done():
@@ -112,9 +146,10 @@ func (s *Server) StreamEvents(
 	}
 }
 
-func handleBlockOperationEvents(
-	stream ethpbservice.Events_StreamEventsServer, requestedTopics map[string]bool, event *feed.Event,
-) error {
+// sendFunc delivers the data of an event of the given topic to a subscriber.
+type sendFunc func(topic string, data proto.Message) error
+
+func handleBlockOperationEvents(send sendFunc, requestedTopics map[string]bool, event *feed.Event) error {
 	switch event.Type {
 	case operation.AggregatedAttReceived:
 		if _, ok := requestedTopics[AttestationTopic]; !ok {
@@ -125,7 +160,7 @@ func handleBlockOperationEvents(
 			return nil
 		}
 		v1Data := migration.V1Alpha1AggregateAttAndProofToV1(attData.Attestation)
-		return streamData(stream, AttestationTopic, v1Data)
+		return send(AttestationTopic, v1Data)
 	case operation.UnaggregatedAttReceived:
 		if _, ok := requestedTopics[AttestationTopic]; !ok {
 			return nil
@@ -135,7 +170,7 @@ func handleBlockOperationEvents(
 			return nil
 		}
 		v1Data := migration.V1Alpha1AttestationToV1(attData.Attestation)
-		return streamData(stream, AttestationTopic, v1Data)
+		return send(AttestationTopic, v1Data)
 	case operation.ExitReceived:
 		if _, ok := requestedTopics[VoluntaryExitTopic]; !ok {
 			return nil
@@ -145,7 +180,7 @@ func handleBlockOperationEvents(
 			return nil
 		}
 		v1Data := migration.V1Alpha1ExitToV1(exitData.Exit)
-		return streamData(stream, VoluntaryExitTopic, v1Data)
+		return send(VoluntaryExitTopic, v1Data)
 	case operation.SyncCommitteeContributionReceived:
 		if _, ok := requestedTopics[SyncCommitteeContributionTopic]; !ok {
 			return nil
@@ -155,7 +190,7 @@ func handleBlockOperationEvents(
 			return nil
 		}
 		v2Data := migration.V1Alpha1SignedContributionAndProofToV2(contributionData.Contribution)
-		return streamData(stream, SyncCommitteeContributionTopic, v2Data)
+		return send(SyncCommitteeContributionTopic, v2Data)
 	case operation.BLSToExecutionChangeReceived:
 		if _, ok := requestedTopics[BLSToExecutionChangeTopic]; !ok {
 			return nil
@@ -165,7 +200,7 @@ func handleBlockOperationEvents(
 			return nil
 		}
 		v2Change := migration.V1Alpha1SignedBLSToExecChangeToV2(changeData.Change)
-		return streamData(stream, BLSToExecutionChangeTopic, v2Change)
+		return send(BLSToExecutionChangeTopic, v2Change)
 	case operation.BlobSidecarReceived:
 		if _, ok := requestedTopics[BlobSidecarTopic]; !ok {
 			return nil
@@ -185,15 +220,76 @@ func handleBlockOperationEvents(
 			VersionedHash: bytesutil.SafeCopyBytes(versionedHash.Bytes()),
 			KzgCommitment: bytesutil.SafeCopyBytes(blobData.Blob.Message.KzgCommitment),
 		}
-		return streamData(stream, BlobSidecarTopic, blobEvent)
+		return send(BlobSidecarTopic, blobEvent)
+	case operation.BlobSidecarEquivocationDetected:
+		if _, ok := requestedTopics[EquivocationTopic]; !ok {
+			return nil
+		}
+		equivocationData, ok := event.Data.(*operation.BlobSidecarEquivocationDetectedData)
+		if !ok || equivocationData == nil || equivocationData.Equivocation == nil {
+			return nil
+		}
+		prev := equivocationData.Equivocation.PrevBlobSidecarWrapper
+		curr := equivocationData.Equivocation.BlobSidecarWrapper
+		return send(EquivocationTopic, &ethpb.EventEquivocation{
+			Kind:           EquivocationKindBlobSidecar,
+			Slot:           curr.SignedBlobSidecarHeader.Message.Slot,
+			ValidatorIndex: curr.SignedBlobSidecarHeader.Message.ProposerIndex,
+			Index:          curr.SignedBlobSidecarHeader.Message.Index,
+			BlockRoot_1:    bytesutil.SafeCopyBytes(prev.SignedBlobSidecarHeader.Message.BlockRoot),
+			SigningRoot_1:  bytesutil.SafeCopyBytes(prev.SigningRoot[:]),
+			Signature_1:    bytesutil.SafeCopyBytes(prev.SignedBlobSidecarHeader.Signature),
+			BlockRoot_2:    bytesutil.SafeCopyBytes(curr.SignedBlobSidecarHeader.Message.BlockRoot),
+			SigningRoot_2:  bytesutil.SafeCopyBytes(curr.SigningRoot[:]),
+			Signature_2:    bytesutil.SafeCopyBytes(curr.SignedBlobSidecarHeader.Signature),
+		})
+	case operation.SyncCommitteeEquivocationDetected:
+		if _, ok := requestedTopics[EquivocationTopic]; !ok {
+			return nil
+		}
+		equivocationData, ok := event.Data.(*operation.SyncCommitteeEquivocationDetectedData)
+		if !ok || equivocationData == nil || equivocationData.Equivocation == nil {
+			return nil
+		}
+		prev := equivocationData.Equivocation.PrevMessageWrapper
+		curr := equivocationData.Equivocation.MessageWrapper
+		return send(EquivocationTopic, &ethpb.EventEquivocation{
+			Kind:           EquivocationKindSyncCommitteeMessage,
+			Slot:           curr.SyncCommitteeMessage.Slot,
+			ValidatorIndex: curr.SyncCommitteeMessage.ValidatorIndex,
+			BlockRoot_1:    bytesutil.SafeCopyBytes(prev.SyncCommitteeMessage.BlockRoot),
+			SigningRoot_1:  bytesutil.SafeCopyBytes(prev.SigningRoot[:]),
+			Signature_1:    bytesutil.SafeCopyBytes(prev.SyncCommitteeMessage.Signature),
+			BlockRoot_2:    bytesutil.SafeCopyBytes(curr.SyncCommitteeMessage.BlockRoot),
+			SigningRoot_2:  bytesutil.SafeCopyBytes(curr.SigningRoot[:]),
+			Signature_2:    bytesutil.SafeCopyBytes(curr.SyncCommitteeMessage.Signature),
+		})
 	default:
 		return nil
 	}
 }
 
-func (s *Server) handleStateEvents(
-	stream ethpbservice.Events_StreamEventsServer, requestedTopics map[string]bool, event *feed.Event,
-) error {
+func handleBlockEvents(send sendFunc, requestedTopics map[string]bool, event *feed.Event) error {
+	switch event.Type {
+	case blockfeed.ReceivedBlock:
+		if _, ok := requestedTopics[BlockGossipTopic]; !ok {
+			return nil
+		}
+		blkData, ok := event.Data.(*blockfeed.ReceivedBlockData)
+		if !ok || blkData == nil || blkData.SignedBlock == nil {
+			return nil
+		}
+		header, err := migration.BlockIfaceToV1BlockHeader(blkData.SignedBlock)
+		if err != nil {
+			return errors.Wrap(err, "could not get block header")
+		}
+		return send(BlockGossipTopic, header)
+	default:
+		return nil
+	}
+}
+
+func (s *Server) handleStateEvents(send sendFunc, requestedTopics map[string]bool, event *feed.Event) error {
 	switch event.Type {
 	case statefeed.NewHead:
 		if _, ok := requestedTopics[HeadTopic]; ok {
@@ -201,10 +297,12 @@ func (s *Server) handleStateEvents(
 			if !ok {
 				return nil
 			}
-			return streamData(stream, HeadTopic, head)
+			if err := send(HeadTopic, head); err != nil {
+				return err
+			}
 		}
 		if _, ok := requestedTopics[PayloadAttributesTopic]; ok {
-			if err := s.streamPayloadAttributes(stream); err != nil {
+			if err := s.streamPayloadAttributes(send); err != nil {
 				log.WithError(err).Error("Unable to obtain stream payload attributes")
 			}
 			return nil
@@ -212,7 +310,7 @@ func (s *Server) handleStateEvents(
 		return nil
 	case statefeed.MissedSlot:
 		if _, ok := requestedTopics[PayloadAttributesTopic]; ok {
-			if err := s.streamPayloadAttributes(stream); err != nil {
+			if err := s.streamPayloadAttributes(send); err != nil {
 				log.WithError(err).Error("Unable to obtain stream payload attributes")
 			}
 			return nil
@@ -226,7 +324,7 @@ func (s *Server) handleStateEvents(
 		if !ok {
 			return nil
 		}
-		return streamData(stream, FinalizedCheckpointTopic, finalizedCheckpoint)
+		return send(FinalizedCheckpointTopic, finalizedCheckpoint)
 	case statefeed.Reorg:
 		if _, ok := requestedTopics[ChainReorgTopic]; !ok {
 			return nil
@@ -235,7 +333,7 @@ func (s *Server) handleStateEvents(
 		if !ok {
 			return nil
 		}
-		return streamData(stream, ChainReorgTopic, reorg)
+		return send(ChainReorgTopic, reorg)
 	case statefeed.BlockProcessed:
 		if _, ok := requestedTopics[BlockTopic]; !ok {
 			return nil
@@ -257,7 +355,25 @@ func (s *Server) handleStateEvents(
 			Block:               item[:],
 			ExecutionOptimistic: blkData.Optimistic,
 		}
-		return streamData(stream, BlockTopic, eventBlock)
+		return send(BlockTopic, eventBlock)
+	case statefeed.LightClientFinalityUpdate:
+		if _, ok := requestedTopics[LightClientFinalityUpdateTopic]; !ok {
+			return nil
+		}
+		update, ok := event.Data.(*ethpbv2.LightClientFinalityUpdateWithVersion)
+		if !ok {
+			return nil
+		}
+		return send(LightClientFinalityUpdateTopic, update)
+	case statefeed.LightClientOptimisticUpdate:
+		if _, ok := requestedTopics[LightClientOptimisticUpdateTopic]; !ok {
+			return nil
+		}
+		update, ok := event.Data.(*ethpbv2.LightClientOptimisticUpdateWithVersion)
+		if !ok {
+			return nil
+		}
+		return send(LightClientOptimisticUpdateTopic, update)
 	default:
 		return nil
 	}
@@ -266,7 +382,7 @@ func (s *Server) handleStateEvents(
 // streamPayloadAttributes on new head event.
 // This event stream is intended to be used by builders and relays.
 // parent_ fields are based on state at N_{current_slot}, while the rest of fields are based on state of N_{current_slot + 1}
-func (s *Server) streamPayloadAttributes(stream ethpbservice.Events_StreamEventsServer) error {
+func (s *Server) streamPayloadAttributes(send sendFunc) error {
 	headRoot, err := s.HeadFetcher.HeadRoot(s.Ctx)
 	if err != nil {
 		return errors.Wrap(err, "could not get head root")
@@ -308,7 +424,7 @@ func (s *Server) streamPayloadAttributes(stream ethpbservice.Events_StreamEvents
 
 	switch headState.Version() {
 	case version.Bellatrix:
-		return streamData(stream, PayloadAttributesTopic, &ethpb.EventPayloadAttributeV1{
+		return send(PayloadAttributesTopic, &ethpb.EventPayloadAttributeV1{
 			Version: version.String(headState.Version()),
 			Data: &ethpb.EventPayloadAttributeV1_BasePayloadAttribute{
 				ProposerIndex:     proposerIndex,
@@ -328,7 +444,7 @@ func (s *Server) streamPayloadAttributes(stream ethpbservice.Events_StreamEvents
 		if err != nil {
 			return err
 		}
-		return streamData(stream, PayloadAttributesTopic, &ethpb.EventPayloadAttributeV2{
+		return send(PayloadAttributesTopic, &ethpb.EventPayloadAttributeV2{
 			Version: version.String(headState.Version()),
 			Data: &ethpb.EventPayloadAttributeV2_BasePayloadAttribute{
 				ProposerIndex:     proposerIndex,
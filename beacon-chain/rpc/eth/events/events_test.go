@@ -18,11 +18,13 @@ import (
 	statefeed "github.com/prysmaticlabs/prysm/v4/beacon-chain/core/feed/state"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/core/helpers"
 	prysmtime "github.com/prysmaticlabs/prysm/v4/beacon-chain/core/time"
+	slashertypes "github.com/prysmaticlabs/prysm/v4/beacon-chain/slasher/types"
 	fieldparams "github.com/prysmaticlabs/prysm/v4/config/fieldparams"
 	"github.com/prysmaticlabs/prysm/v4/consensus-types/blocks"
 	"github.com/prysmaticlabs/prysm/v4/encoding/bytesutil"
 	enginev1 "github.com/prysmaticlabs/prysm/v4/proto/engine/v1"
 	ethpb "github.com/prysmaticlabs/prysm/v4/proto/eth/v1"
+	ethpbv2 "github.com/prysmaticlabs/prysm/v4/proto/eth/v2"
 	"github.com/prysmaticlabs/prysm/v4/proto/migration"
 	eth "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/v4/runtime/version"
@@ -281,6 +283,60 @@ func TestStreamEvents_OperationsEvents(t *testing.T) {
 			feed: srv.OperationNotifier.OperationFeed(),
 		})
 	})
+	t.Run(EquivocationTopic, func(t *testing.T) {
+		ctx := context.Background()
+		srv, ctrl, mockStream := setupServer(ctx, t)
+		defer ctrl.Finish()
+
+		message := func(blockRoot byte) *slashertypes.SyncCommitteeMessageWrapper {
+			return &slashertypes.SyncCommitteeMessageWrapper{
+				SyncCommitteeMessage: &eth.SyncCommitteeMessage{
+					Slot:           4,
+					BlockRoot:      bytesutil.PadTo([]byte{blockRoot}, fieldparams.RootLength),
+					ValidatorIndex: 9,
+					Signature:      make([]byte, 96),
+				},
+				SigningRoot: [32]byte{blockRoot},
+			}
+		}
+		prev, curr := message(1), message(2)
+		equivocationEvent := &ethpb.EventEquivocation{
+			Kind:           EquivocationKindSyncCommitteeMessage,
+			Slot:           4,
+			ValidatorIndex: 9,
+			BlockRoot_1:    prev.SyncCommitteeMessage.BlockRoot,
+			SigningRoot_1:  prev.SigningRoot[:],
+			Signature_1:    prev.SyncCommitteeMessage.Signature,
+			BlockRoot_2:    curr.SyncCommitteeMessage.BlockRoot,
+			SigningRoot_2:  curr.SigningRoot[:],
+			Signature_2:    curr.SyncCommitteeMessage.Signature,
+		}
+		genericResponse, err := anypb.New(equivocationEvent)
+		require.NoError(t, err)
+
+		wantedMessage := &gateway.EventSource{
+			Event: EquivocationTopic,
+			Data:  genericResponse,
+		}
+
+		assertFeedSendAndReceive(ctx, &assertFeedArgs{
+			t:             t,
+			srv:           srv,
+			topics:        []string{EquivocationTopic},
+			stream:        mockStream,
+			shouldReceive: wantedMessage,
+			itemToSend: &feed.Event{
+				Type: operation.SyncCommitteeEquivocationDetected,
+				Data: &operation.SyncCommitteeEquivocationDetectedData{
+					Equivocation: &slashertypes.SyncCommitteeEquivocation{
+						PrevMessageWrapper: prev,
+						MessageWrapper:     curr,
+					},
+				},
+			},
+			feed: srv.OperationNotifier.OperationFeed(),
+		})
+	})
 }
 
 func TestStreamEvents_StateEvents(t *testing.T) {
@@ -551,6 +607,47 @@ func TestStreamEvents_StateEvents(t *testing.T) {
 			feed: srv.StateNotifier.StateFeed(),
 		})
 	})
+	t.Run(LightClientOptimisticUpdateTopic, func(t *testing.T) {
+		ctx := context.Background()
+		srv, ctrl, mockStream := setupServer(ctx, t)
+		defer ctrl.Finish()
+
+		wantedUpdate := &ethpbv2.LightClientOptimisticUpdateWithVersion{
+			Version: ethpbv2.Version_CAPELLA,
+			Data: &ethpbv2.LightClientOptimisticUpdate{
+				AttestedHeader: &ethpb.BeaconBlockHeader{
+					Slot:       8,
+					ParentRoot: make([]byte, 32),
+					StateRoot:  make([]byte, 32),
+					BodyRoot:   make([]byte, 32),
+				},
+				SyncAggregate: &ethpb.SyncAggregate{
+					SyncCommitteeBits:      make([]byte, 64),
+					SyncCommitteeSignature: make([]byte, 96),
+				},
+				SignatureSlot: 9,
+			},
+		}
+		genericResponse, err := anypb.New(wantedUpdate)
+		require.NoError(t, err)
+		wantedMessage := &gateway.EventSource{
+			Event: LightClientOptimisticUpdateTopic,
+			Data:  genericResponse,
+		}
+
+		assertFeedSendAndReceive(ctx, &assertFeedArgs{
+			t:             t,
+			srv:           srv,
+			topics:        []string{LightClientOptimisticUpdateTopic},
+			stream:        mockStream,
+			shouldReceive: wantedMessage,
+			itemToSend: &feed.Event{
+				Type: statefeed.LightClientOptimisticUpdate,
+				Data: wantedUpdate,
+			},
+			feed: srv.StateNotifier.StateFeed(),
+		})
+	})
 	t.Run(ChainReorgTopic, func(t *testing.T) {
 		ctx := context.Background()
 		srv, ctrl, mockStream := setupServer(ctx, t)
@@ -698,6 +795,7 @@ func setupServer(ctx context.Context, t testing.TB) (*Server, *gomock.Controller
 	srv := &Server{
 		StateNotifier:     &mockChain.MockStateNotifier{},
 		OperationNotifier: &mockChain.MockOperationNotifier{},
+		BlockNotifier:     &mockChain.MockBlockNotifier{},
 		Ctx:               ctx,
 	}
 	ctrl := gomock.NewController(t)
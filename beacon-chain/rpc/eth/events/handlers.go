@@ -0,0 +1,180 @@
+package events
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/core/feed"
+	http2 "github.com/prysmaticlabs/prysm/v4/network/http"
+	log "github.com/sirupsen/logrus"
+	"go.opencensus.io/trace"
+	"google.golang.org/protobuf/proto"
+)
+
+// DefaultHeartbeatInterval is the default interval between heartbeat comments sent on idle event streams.
+const DefaultHeartbeatInterval = 10 * time.Second
+
+// SubscribeEvents streams the events of the requested topics as server-sent events.
+// Every event carries an ID. A client that reconnects with the Last-Event-ID header
+// first receives the events it missed that are still kept by the node.
+// When the client does not keep up with the stream, events are dropped and
+// a dropped event describing the missing range is sent once the client catches up.
+// A dropped event is also sent first when the Last-Event-ID is ahead of the node, e.g. after the node restarted.
+func (s *Server) SubscribeEvents(w http.ResponseWriter, r *http.Request) {
+	ctx, span := trace.StartSpan(r.Context(), "events.SubscribeEvents")
+	defer span.End()
+
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		http2.HandleError(w, "Streaming is not supported", http.StatusInternalServerError)
+		return
+	}
+
+	topics := make(map[string]bool)
+	for _, rawTopics := range r.URL.Query()["topics"] {
+		for _, topic := range strings.Split(rawTopics, ",") {
+			if _, ok := casesHandled[topic]; !ok {
+				http2.HandleError(w, fmt.Sprintf("Topic %s not allowed for event subscriptions", topic), http.StatusBadRequest)
+				return
+			}
+			topics[topic] = true
+		}
+	}
+	if len(topics) == 0 {
+		http2.HandleError(w, "No topics specified to subscribe to", http.StatusBadRequest)
+		return
+	}
+	var lastEventID uint64
+	if rawID := r.Header.Get("Last-Event-ID"); rawID != "" {
+		var err error
+		lastEventID, err = strconv.ParseUint(rawID, 10, 64)
+		if err != nil {
+			http2.HandleError(w, "Last-Event-ID is invalid: "+err.Error(), http.StatusBadRequest)
+			return
+		}
+	}
+
+	sub, replay := s.Broadcaster.Subscribe(topics, lastEventID)
+	defer s.Broadcaster.Unsubscribe(sub)
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.WriteHeader(http.StatusOK)
+	for _, e := range replay {
+		if err := writeEvent(w, e); err != nil {
+			log.WithError(err).Debug("Could not write event")
+			return
+		}
+	}
+	flusher.Flush()
+
+	interval := s.HeartbeatInterval
+	if interval == 0 {
+		interval = DefaultHeartbeatInterval
+	}
+	heartbeat := time.NewTicker(interval)
+	defer heartbeat.Stop()
+	for {
+		var err error
+		select {
+		case e := <-sub.Events():
+			err = writeEvent(w, e)
+		case <-heartbeat.C:
+			_, err = io.WriteString(w, ": heartbeat\n\n")
+		case <-ctx.Done():
+			return
+		case <-s.Ctx.Done():
+			return
+		}
+		if err != nil {
+			log.WithError(err).Debug("Could not write event")
+			return
+		}
+		flusher.Flush()
+		// Heartbeats are only needed when nothing else was written for a whole interval.
+		heartbeat.Reset(interval)
+	}
+}
+
+func writeEvent(w io.Writer, e *Event) error {
+	var b strings.Builder
+	if e.ID != 0 {
+		b.WriteString("id: ")
+		b.WriteString(strconv.FormatUint(e.ID, 10))
+		b.WriteString("\n")
+	}
+	b.WriteString("event: ")
+	b.WriteString(e.Topic)
+	b.WriteString("\ndata: ")
+	b.Write(e.Data)
+	b.WriteString("\n\n")
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// PublishEvents publishes the events received from the node's feeds to the broadcaster until the server's
+// context is canceled. It is meant to be run in a goroutine for the lifetime of the node, so that events
+// are kept for replay even when no client is subscribed.
+func (s *Server) PublishEvents() {
+	opsChan := make(chan *feed.Event, 1)
+	opsSub := s.OperationNotifier.OperationFeed().Subscribe(opsChan)
+	stateChan := make(chan *feed.Event, 1)
+	stateSub := s.StateNotifier.StateFeed().Subscribe(stateChan)
+	blockChan := make(chan *feed.Event, 1)
+	blockSub := s.BlockNotifier.BlockFeed().Subscribe(blockChan)
+	defer opsSub.Unsubscribe()
+	defer stateSub.Unsubscribe()
+	defer blockSub.Unsubscribe()
+
+	for {
+		select {
+		case event := <-opsChan:
+			if err := handleBlockOperationEvents(s.publish, s.publishedTopics(), event); err != nil {
+				log.WithError(err).Error("Could not publish block operations event")
+			}
+		case event := <-stateChan:
+			if err := s.handleStateEvents(s.publish, s.publishedTopics(), event); err != nil {
+				log.WithError(err).Error("Could not publish state event")
+			}
+		case event := <-blockChan:
+			if err := handleBlockEvents(s.publish, s.publishedTopics(), event); err != nil {
+				log.WithError(err).Error("Could not publish block event")
+			}
+		case <-s.Ctx.Done():
+			return
+		}
+	}
+}
+
+// publishedTopics returns all topics, except for payload attributes which are expensive
+// to compute and therefore only published while someone is subscribed to them.
+func (s *Server) publishedTopics() map[string]bool {
+	topics := make(map[string]bool, len(casesHandled))
+	for topic := range casesHandled {
+		topics[topic] = true
+	}
+	if !s.Broadcaster.HasSubscribers(PayloadAttributesTopic) {
+		delete(topics, PayloadAttributesTopic)
+	}
+	return topics
+}
+
+func (s *Server) publish(topic string, data proto.Message) error {
+	eventData, err := eventDataFromProto(data)
+	if err != nil {
+		return err
+	}
+	encoded, err := json.Marshal(eventData)
+	if err != nil {
+		return errors.Wrapf(err, "could not marshal %s event", topic)
+	}
+	s.Broadcaster.Publish(topic, encoded)
+	return nil
+}
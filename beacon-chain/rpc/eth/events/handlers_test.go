@@ -0,0 +1,347 @@
+package events
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	mockChain "github.com/prysmaticlabs/prysm/v4/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/core/feed"
+	blockfeed "github.com/prysmaticlabs/prysm/v4/beacon-chain/core/feed/block"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/core/feed/operation"
+	statefeed "github.com/prysmaticlabs/prysm/v4/beacon-chain/core/feed/state"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/rpc/eth/shared"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/blocks"
+	http2 "github.com/prysmaticlabs/prysm/v4/network/http"
+	ethpb "github.com/prysmaticlabs/prysm/v4/proto/eth/v1"
+	eth "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v4/testing/assert"
+	"github.com/prysmaticlabs/prysm/v4/testing/require"
+	"github.com/prysmaticlabs/prysm/v4/testing/util"
+)
+
+// streamWriter records a streamed response. Writes block until release is closed,
+// which simulates a client that does not read the stream.
+type streamWriter struct {
+	header  http.Header
+	started chan struct{}
+	release chan struct{}
+	once    sync.Once
+	lock    sync.Mutex
+	body    bytes.Buffer
+}
+
+func newStreamWriter() *streamWriter {
+	return &streamWriter{
+		header:  make(http.Header),
+		started: make(chan struct{}),
+		release: make(chan struct{}),
+	}
+}
+
+func (w *streamWriter) Header() http.Header {
+	return w.header
+}
+
+func (w *streamWriter) Write(b []byte) (int, error) {
+	w.once.Do(func() { close(w.started) })
+	<-w.release
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	return w.body.Write(b)
+}
+
+func (*streamWriter) WriteHeader(int) {}
+
+func (*streamWriter) Flush() {}
+
+func (w *streamWriter) String() string {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	return w.body.String()
+}
+
+// waitFor waits until the recorded stream contains s.
+func (w *streamWriter) waitFor(t *testing.T, s string) {
+	deadline := time.Now().Add(5 * time.Second)
+	for !strings.Contains(w.String(), s) {
+		if time.Now().After(deadline) {
+			t.Fatalf("Stream does not contain %q: %q", s, w.String())
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+// subscribe runs the handler until the returned function is called.
+func subscribe(t *testing.T, s *Server, w *streamWriter, topics string, lastEventID string) func() {
+	ctx, cancel := context.WithCancel(context.Background())
+	request := httptest.NewRequest(http.MethodGet, "http://example.com/eth/v1/events?topics="+topics, nil).WithContext(ctx)
+	if lastEventID != "" {
+		request.Header.Set("Last-Event-ID", lastEventID)
+	}
+	done := make(chan struct{})
+	go func() {
+		s.SubscribeEvents(w, request)
+		close(done)
+	}()
+	return func() {
+		cancel()
+		select {
+		case <-done:
+		case <-time.After(5 * time.Second):
+			t.Fatal("Handler did not return")
+		}
+	}
+}
+
+func frame(id uint64, topic, data string) string {
+	return fmt.Sprintf("id: %d\nevent: %s\ndata: %s\n\n", id, topic, data)
+}
+
+func TestSubscribeEvents_Preconditions(t *testing.T) {
+	s := &Server{Ctx: context.Background(), Broadcaster: testBroadcaster(DefaultReplaySize, DefaultSubscriberBufferSize)}
+	tests := []struct {
+		name        string
+		url         string
+		lastEventID string
+		wantErr     string
+	}{
+		{name: "no topics", url: "http://example.com/eth/v1/events", wantErr: "No topics specified to subscribe to"},
+		{name: "topic not allowed", url: "http://example.com/eth/v1/events?topics=head,foobar", wantErr: "Topic foobar not allowed for event subscriptions"},
+		{name: "invalid last event ID", url: "http://example.com/eth/v1/events?topics=head", lastEventID: "foo", wantErr: "Last-Event-ID is invalid"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			request := httptest.NewRequest(http.MethodGet, tt.url, nil)
+			if tt.lastEventID != "" {
+				request.Header.Set("Last-Event-ID", tt.lastEventID)
+			}
+			writer := httptest.NewRecorder()
+			writer.Body = &bytes.Buffer{}
+
+			s.SubscribeEvents(writer, request)
+			assert.Equal(t, http.StatusBadRequest, writer.Code)
+			e := &http2.DefaultErrorJson{}
+			require.NoError(t, json.Unmarshal(writer.Body.Bytes(), e))
+			assert.Equal(t, http.StatusBadRequest, e.Code)
+			assert.StringContains(t, tt.wantErr, e.Message)
+		})
+	}
+}
+
+func TestSubscribeEvents(t *testing.T) {
+	s := &Server{Ctx: context.Background(), Broadcaster: testBroadcaster(DefaultReplaySize, DefaultSubscriberBufferSize)}
+	w := newStreamWriter()
+	close(w.release)
+	stop := subscribe(t, s, w, "head,block", "")
+	require.NoError(t, waitForSubscriber(s.Broadcaster, HeadTopic))
+
+	s.Broadcaster.Publish(HeadTopic, []byte(`{"n":1}`))
+	s.Broadcaster.Publish(AttestationTopic, []byte(`{"n":2}`))
+	s.Broadcaster.Publish(BlockTopic, []byte(`{"n":3}`))
+	w.waitFor(t, frame(3, BlockTopic, `{"n":3}`))
+	stop()
+
+	assert.Equal(t, frame(1, HeadTopic, `{"n":1}`)+frame(3, BlockTopic, `{"n":3}`), w.String())
+	assert.Equal(t, "text/event-stream", w.Header().Get("Content-Type"))
+	assert.Equal(t, false, s.Broadcaster.HasSubscribers(HeadTopic))
+}
+
+func TestSubscribeEvents_Resume(t *testing.T) {
+	s := &Server{Ctx: context.Background(), Broadcaster: testBroadcaster(2, DefaultSubscriberBufferSize)}
+	s.Broadcaster.Publish(HeadTopic, []byte(`{"n":1}`))
+	s.Broadcaster.Publish(HeadTopic, []byte(`{"n":2}`))
+	s.Broadcaster.Publish(BlockTopic, []byte(`{"n":3}`))
+
+	t.Run("missed events are replayed", func(t *testing.T) {
+		w := newStreamWriter()
+		close(w.release)
+		stop := subscribe(t, s, w, "head,block", "1")
+		require.NoError(t, waitForSubscriber(s.Broadcaster, HeadTopic))
+		s.Broadcaster.Publish(HeadTopic, []byte(`{"n":4}`))
+		w.waitFor(t, frame(4, HeadTopic, `{"n":4}`))
+		stop()
+
+		want := frame(2, HeadTopic, `{"n":2}`) + frame(3, BlockTopic, `{"n":3}`) + frame(4, HeadTopic, `{"n":4}`)
+		assert.Equal(t, want, w.String())
+	})
+	t.Run("missed events no longer kept", func(t *testing.T) {
+		s.Broadcaster.Publish(HeadTopic, []byte(`{"n":5}`))
+		w := newStreamWriter()
+		close(w.release)
+		stop := subscribe(t, s, w, "head", "1")
+		w.waitFor(t, frame(5, HeadTopic, `{"n":5}`))
+		stop()
+
+		want := "event: dropped\ndata: {\"first_id\":\"2\",\"last_id\":\"2\"}\n\n" + frame(4, HeadTopic, `{"n":4}`) + frame(5, HeadTopic, `{"n":5}`)
+		assert.Equal(t, want, w.String())
+	})
+	t.Run("last event ID ahead after a restart", func(t *testing.T) {
+		w := newStreamWriter()
+		close(w.release)
+		stop := subscribe(t, s, w, "head", "100")
+		w.waitFor(t, frame(5, HeadTopic, `{"n":5}`))
+		stop()
+
+		want := "event: dropped\ndata: {\"first_id\":\"0\",\"last_id\":\"3\"}\n\n" + frame(4, HeadTopic, `{"n":4}`) + frame(5, HeadTopic, `{"n":5}`)
+		assert.Equal(t, want, w.String())
+	})
+}
+
+func TestSubscribeEvents_Heartbeat(t *testing.T) {
+	s := &Server{
+		Ctx:               context.Background(),
+		Broadcaster:       testBroadcaster(DefaultReplaySize, DefaultSubscriberBufferSize),
+		HeartbeatInterval: 10 * time.Millisecond,
+	}
+	w := newStreamWriter()
+	close(w.release)
+	stop := subscribe(t, s, w, "head", "")
+	w.waitFor(t, ": heartbeat\n\n: heartbeat\n\n")
+	stop()
+}
+
+func TestSubscribeEvents_NoHeartbeatWhileBusy(t *testing.T) {
+	s := &Server{
+		Ctx:               context.Background(),
+		Broadcaster:       testBroadcaster(DefaultReplaySize, DefaultSubscriberBufferSize),
+		HeartbeatInterval: 100 * time.Millisecond,
+	}
+	w := newStreamWriter()
+	close(w.release)
+	stop := subscribe(t, s, w, "head", "")
+	require.NoError(t, waitForSubscriber(s.Broadcaster, HeadTopic))
+
+	// Events are written far more often than the heartbeat interval for several intervals.
+	for i := 1; i <= 60; i++ {
+		s.Broadcaster.Publish(HeadTopic, []byte(fmt.Sprintf(`{"n":%d}`, i)))
+		time.Sleep(5 * time.Millisecond)
+	}
+	w.waitFor(t, frame(60, HeadTopic, `{"n":60}`))
+	stop()
+
+	assert.Equal(t, false, strings.Contains(w.String(), ": heartbeat"))
+}
+
+func TestSubscribeEvents_SlowReader(t *testing.T) {
+	s := &Server{Ctx: context.Background(), Broadcaster: testBroadcaster(DefaultReplaySize, 2)}
+	w := newStreamWriter()
+	stop := subscribe(t, s, w, "head", "")
+	require.NoError(t, waitForSubscriber(s.Broadcaster, HeadTopic))
+
+	s.Broadcaster.Publish(HeadTopic, []byte(`{"n":1}`))
+	// The handler is stuck writing the first event while the reader does not read.
+	<-w.started
+	for i := 2; i <= 5; i++ {
+		s.Broadcaster.Publish(HeadTopic, []byte(fmt.Sprintf(`{"n":%d}`, i)))
+	}
+	// The reader catches up.
+	close(w.release)
+	w.waitFor(t, frame(3, HeadTopic, `{"n":3}`))
+	s.Broadcaster.Publish(HeadTopic, []byte(`{"n":6}`))
+	w.waitFor(t, frame(6, HeadTopic, `{"n":6}`))
+	stop()
+
+	want := frame(1, HeadTopic, `{"n":1}`) +
+		frame(2, HeadTopic, `{"n":2}`) +
+		frame(3, HeadTopic, `{"n":3}`) +
+		"event: dropped\ndata: {\"count\":\"2\",\"first_id\":\"4\",\"last_id\":\"5\"}\n\n" +
+		frame(6, HeadTopic, `{"n":6}`)
+	assert.Equal(t, want, w.String())
+}
+
+func TestPublishEvents(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	s := &Server{
+		Ctx:               ctx,
+		StateNotifier:     &mockChain.MockStateNotifier{},
+		OperationNotifier: &mockChain.MockOperationNotifier{},
+		BlockNotifier:     &mockChain.MockBlockNotifier{},
+		Broadcaster:       testBroadcaster(DefaultReplaySize, DefaultSubscriberBufferSize),
+	}
+	sub, _ := s.Broadcaster.Subscribe(map[string]bool{AttestationTopic: true, HeadTopic: true}, 0)
+	opsFeed := s.OperationNotifier.OperationFeed()
+	stateFeed := s.StateNotifier.StateFeed()
+	go s.PublishEvents()
+
+	// Events are published once the publisher subscribed to the feeds.
+	att := util.HydrateAttestation(&eth.Attestation{Data: &eth.AttestationData{Slot: 8}})
+	for opsFeed.Send(&feed.Event{
+		Type: operation.UnaggregatedAttReceived,
+		Data: &operation.UnAggregatedAttReceivedData{Attestation: att},
+	}) == 0 {
+		time.Sleep(time.Millisecond)
+	}
+	for stateFeed.Send(&feed.Event{
+		Type: statefeed.NewHead,
+		Data: &ethpb.EventHead{Slot: 8},
+	}) == 0 {
+		time.Sleep(time.Millisecond)
+	}
+
+	e := <-sub.Events()
+	assert.Equal(t, AttestationTopic, e.Topic)
+	assert.Equal(t, uint64(1), e.ID)
+	assert.StringContains(t, `"slot":"8"`, string(e.Data))
+	e = <-sub.Events()
+	assert.Equal(t, HeadTopic, e.Topic)
+	assert.Equal(t, uint64(2), e.ID)
+	assert.StringContains(t, `"slot":"8"`, string(e.Data))
+}
+
+func TestPublishEvents_BlockGossip(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	s := &Server{
+		Ctx:               ctx,
+		StateNotifier:     &mockChain.MockStateNotifier{},
+		OperationNotifier: &mockChain.MockOperationNotifier{},
+		BlockNotifier:     &mockChain.MockBlockNotifier{},
+		Broadcaster:       testBroadcaster(DefaultReplaySize, DefaultSubscriberBufferSize),
+	}
+	sub, _ := s.Broadcaster.Subscribe(map[string]bool{BlockGossipTopic: true}, 0)
+	blockFeed := s.BlockNotifier.BlockFeed()
+	go s.PublishEvents()
+
+	b := util.NewBeaconBlock()
+	b.Block.Slot = 8
+	b.Block.ProposerIndex = 3
+	wsb, err := blocks.NewSignedBeaconBlock(b)
+	require.NoError(t, err)
+	for blockFeed.Send(&feed.Event{
+		Type: blockfeed.ReceivedBlock,
+		Data: &blockfeed.ReceivedBlockData{SignedBlock: wsb},
+	}) == 0 {
+		time.Sleep(time.Millisecond)
+	}
+
+	e := <-sub.Events()
+	assert.Equal(t, BlockGossipTopic, e.Topic)
+	header := &shared.SignedBeaconBlockHeader{}
+	require.NoError(t, json.Unmarshal(e.Data, header))
+	assert.Equal(t, "8", header.Message.Slot)
+	assert.Equal(t, "3", header.Message.ProposerIndex)
+	bodyRoot, err := b.Block.Body.HashTreeRoot()
+	require.NoError(t, err)
+	assert.Equal(t, hexutil.Encode(bodyRoot[:]), header.Message.BodyRoot)
+}
+
+func waitForSubscriber(b *Broadcaster, topic string) error {
+	deadline := time.Now().Add(5 * time.Second)
+	for !b.HasSubscribers(topic) {
+		if time.Now().After(deadline) {
+			return fmt.Errorf("no subscriber to topic %s", topic)
+		}
+		time.Sleep(time.Millisecond)
+	}
+	return nil
+}
@@ -5,8 +5,10 @@ package events
 
 import (
 	"context"
+	"time"
 
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/blockchain"
+	blockfeed "github.com/prysmaticlabs/prysm/v4/beacon-chain/core/feed/block"
 	opfeed "github.com/prysmaticlabs/prysm/v4/beacon-chain/core/feed/operation"
 	statefeed "github.com/prysmaticlabs/prysm/v4/beacon-chain/core/feed/state"
 )
@@ -17,6 +19,9 @@ type Server struct {
 	Ctx               context.Context
 	StateNotifier     statefeed.Notifier
 	OperationNotifier opfeed.Notifier
+	BlockNotifier     blockfeed.Notifier
 	HeadFetcher       blockchain.HeadFetcher
 	ChainInfoFetcher  blockchain.ChainInfoFetcher
+	Broadcaster       *Broadcaster
+	HeartbeatInterval time.Duration
 }
@@ -0,0 +1,111 @@
+package events
+
+import (
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/rpc/eth/shared"
+)
+
+type HeadEvent struct {
+	Slot                      string `json:"slot"`
+	Block                     string `json:"block"`
+	State                     string `json:"state"`
+	EpochTransition           bool   `json:"epoch_transition"`
+	ExecutionOptimistic       bool   `json:"execution_optimistic"`
+	PreviousDutyDependentRoot string `json:"previous_duty_dependent_root"`
+	CurrentDutyDependentRoot  string `json:"current_duty_dependent_root"`
+}
+
+type BlockEvent struct {
+	Slot                string `json:"slot"`
+	Block               string `json:"block"`
+	ExecutionOptimistic bool   `json:"execution_optimistic"`
+}
+
+type FinalizedCheckpointEvent struct {
+	Block               string `json:"block"`
+	State               string `json:"state"`
+	Epoch               string `json:"epoch"`
+	ExecutionOptimistic bool   `json:"execution_optimistic"`
+}
+
+type ChainReorgEvent struct {
+	Slot                string `json:"slot"`
+	Depth               string `json:"depth"`
+	OldHeadBlock        string `json:"old_head_block"`
+	NewHeadBlock        string `json:"new_head_block"`
+	OldHeadState        string `json:"old_head_state"`
+	NewHeadState        string `json:"new_head_state"`
+	Epoch               string `json:"epoch"`
+	ExecutionOptimistic bool   `json:"execution_optimistic"`
+}
+
+type BlobSidecarEvent struct {
+	BlockRoot     string `json:"block_root"`
+	Index         string `json:"index"`
+	Slot          string `json:"slot"`
+	KzgCommitment string `json:"kzg_commitment"`
+	VersionedHash string `json:"versioned_hash"`
+}
+
+type EquivocationEvent struct {
+	Kind           string `json:"kind"`
+	Slot           string `json:"slot"`
+	ValidatorIndex string `json:"validator_index"`
+	Index          string `json:"index"`
+	BlockRoot1     string `json:"block_root_1"`
+	SigningRoot1   string `json:"signing_root_1"`
+	Signature1     string `json:"signature_1"`
+	BlockRoot2     string `json:"block_root_2"`
+	SigningRoot2   string `json:"signing_root_2"`
+	Signature2     string `json:"signature_2"`
+}
+
+type PayloadAttributesEvent struct {
+	Version string                      `json:"version"`
+	Data    *PayloadAttributesEventData `json:"data"`
+}
+
+type PayloadAttributesEventData struct {
+	ProposerIndex     string      `json:"proposer_index"`
+	ProposalSlot      string      `json:"proposal_slot"`
+	ParentBlockNumber string      `json:"parent_block_number"`
+	ParentBlockRoot   string      `json:"parent_block_root"`
+	ParentBlockHash   string      `json:"parent_block_hash"`
+	PayloadAttributes interface{} `json:"payload_attributes"`
+}
+
+type PayloadAttributesV1 struct {
+	Timestamp             string `json:"timestamp"`
+	PrevRandao            string `json:"prev_randao"`
+	SuggestedFeeRecipient string `json:"suggested_fee_recipient"`
+}
+
+type PayloadAttributesV2 struct {
+	Timestamp             string               `json:"timestamp"`
+	PrevRandao            string               `json:"prev_randao"`
+	SuggestedFeeRecipient string               `json:"suggested_fee_recipient"`
+	Withdrawals           []*shared.Withdrawal `json:"withdrawals"`
+}
+
+type LightClientFinalityUpdateEvent struct {
+	Version string                     `json:"version"`
+	Data    *LightClientFinalityUpdate `json:"data"`
+}
+
+type LightClientFinalityUpdate struct {
+	AttestedHeader  *shared.BeaconBlockHeader `json:"attested_header"`
+	FinalizedHeader *shared.BeaconBlockHeader `json:"finalized_header"`
+	FinalityBranch  []string                  `json:"finality_branch"`
+	SyncAggregate   *shared.SyncAggregate     `json:"sync_aggregate"`
+	SignatureSlot   string                    `json:"signature_slot"`
+}
+
+type LightClientOptimisticUpdateEvent struct {
+	Version string                       `json:"version"`
+	Data    *LightClientOptimisticUpdate `json:"data"`
+}
+
+type LightClientOptimisticUpdate struct {
+	AttestedHeader *shared.BeaconBlockHeader `json:"attested_header"`
+	SyncAggregate  *shared.SyncAggregate     `json:"sync_aggregate"`
+	SignatureSlot  string                    `json:"signature_slot"`
+}
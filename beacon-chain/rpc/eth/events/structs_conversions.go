@@ -0,0 +1,235 @@
+package events
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/rpc/eth/shared"
+	enginev1 "github.com/prysmaticlabs/prysm/v4/proto/engine/v1"
+	ethpb "github.com/prysmaticlabs/prysm/v4/proto/eth/v1"
+	ethpbv2 "github.com/prysmaticlabs/prysm/v4/proto/eth/v2"
+	"google.golang.org/protobuf/proto"
+)
+
+// eventDataFromProto converts the protobuf message of an event into its JSON representation
+// as defined in the Ethereum Beacon API specification.
+func eventDataFromProto(msg proto.Message) (interface{}, error) {
+	switch m := msg.(type) {
+	case *ethpb.EventHead:
+		return &HeadEvent{
+			Slot:                      strconv.FormatUint(uint64(m.Slot), 10),
+			Block:                     hexutil.Encode(m.Block),
+			State:                     hexutil.Encode(m.State),
+			EpochTransition:           m.EpochTransition,
+			ExecutionOptimistic:       m.ExecutionOptimistic,
+			PreviousDutyDependentRoot: hexutil.Encode(m.PreviousDutyDependentRoot),
+			CurrentDutyDependentRoot:  hexutil.Encode(m.CurrentDutyDependentRoot),
+		}, nil
+	case *ethpb.EventBlock:
+		return &BlockEvent{
+			Slot:                strconv.FormatUint(uint64(m.Slot), 10),
+			Block:               hexutil.Encode(m.Block),
+			ExecutionOptimistic: m.ExecutionOptimistic,
+		}, nil
+	case *ethpb.EventFinalizedCheckpoint:
+		return &FinalizedCheckpointEvent{
+			Block:               hexutil.Encode(m.Block),
+			State:               hexutil.Encode(m.State),
+			Epoch:               strconv.FormatUint(uint64(m.Epoch), 10),
+			ExecutionOptimistic: m.ExecutionOptimistic,
+		}, nil
+	case *ethpb.EventChainReorg:
+		return &ChainReorgEvent{
+			Slot:                strconv.FormatUint(uint64(m.Slot), 10),
+			Depth:               strconv.FormatUint(m.Depth, 10),
+			OldHeadBlock:        hexutil.Encode(m.OldHeadBlock),
+			NewHeadBlock:        hexutil.Encode(m.NewHeadBlock),
+			OldHeadState:        hexutil.Encode(m.OldHeadState),
+			NewHeadState:        hexutil.Encode(m.NewHeadState),
+			Epoch:               strconv.FormatUint(uint64(m.Epoch), 10),
+			ExecutionOptimistic: m.ExecutionOptimistic,
+		}, nil
+	case *ethpb.Attestation:
+		return attestationFromProto(m), nil
+	case *ethpb.AggregateAttestationAndProof:
+		// The attestation topic carries the aggregate itself, regardless of how it was received.
+		return attestationFromProto(m.Aggregate), nil
+	case *ethpb.SignedVoluntaryExit:
+		return &shared.SignedVoluntaryExit{
+			Message: &shared.VoluntaryExit{
+				Epoch:          strconv.FormatUint(uint64(m.Message.Epoch), 10),
+				ValidatorIndex: strconv.FormatUint(uint64(m.Message.ValidatorIndex), 10),
+			},
+			Signature: hexutil.Encode(m.Signature),
+		}, nil
+	case *ethpbv2.SignedContributionAndProof:
+		c := m.Message.Contribution
+		return &shared.SignedContributionAndProof{
+			Message: &shared.ContributionAndProof{
+				AggregatorIndex: strconv.FormatUint(uint64(m.Message.AggregatorIndex), 10),
+				Contribution: &shared.SyncCommitteeContribution{
+					Slot:              strconv.FormatUint(uint64(c.Slot), 10),
+					BeaconBlockRoot:   hexutil.Encode(c.BeaconBlockRoot),
+					SubcommitteeIndex: strconv.FormatUint(c.SubcommitteeIndex, 10),
+					AggregationBits:   hexutil.Encode(c.AggregationBits),
+					Signature:         hexutil.Encode(c.Signature),
+				},
+				SelectionProof: hexutil.Encode(m.Message.SelectionProof),
+			},
+			Signature: hexutil.Encode(m.Signature),
+		}, nil
+	case *ethpbv2.SignedBLSToExecutionChange:
+		return &shared.SignedBlsToExecutionChange{
+			Message: &shared.BlsToExecutionChange{
+				ValidatorIndex:     strconv.FormatUint(uint64(m.Message.ValidatorIndex), 10),
+				FromBlsPubkey:      hexutil.Encode(m.Message.FromBlsPubkey),
+				ToExecutionAddress: hexutil.Encode(m.Message.ToExecutionAddress),
+			},
+			Signature: hexutil.Encode(m.Signature),
+		}, nil
+	case *ethpb.EventBlobSidecar:
+		return &BlobSidecarEvent{
+			BlockRoot:     hexutil.Encode(m.BlockRoot),
+			Index:         strconv.FormatUint(m.Index, 10),
+			Slot:          strconv.FormatUint(uint64(m.Slot), 10),
+			KzgCommitment: hexutil.Encode(m.KzgCommitment),
+			VersionedHash: hexutil.Encode(m.VersionedHash),
+		}, nil
+	case *ethpb.EventEquivocation:
+		return &EquivocationEvent{
+			Kind:           m.Kind,
+			Slot:           strconv.FormatUint(uint64(m.Slot), 10),
+			ValidatorIndex: strconv.FormatUint(uint64(m.ValidatorIndex), 10),
+			Index:          strconv.FormatUint(m.Index, 10),
+			BlockRoot1:     hexutil.Encode(m.BlockRoot_1),
+			SigningRoot1:   hexutil.Encode(m.SigningRoot_1),
+			Signature1:     hexutil.Encode(m.Signature_1),
+			BlockRoot2:     hexutil.Encode(m.BlockRoot_2),
+			SigningRoot2:   hexutil.Encode(m.SigningRoot_2),
+			Signature2:     hexutil.Encode(m.Signature_2),
+		}, nil
+	case *ethpb.SignedBeaconBlockHeader:
+		return &shared.SignedBeaconBlockHeader{
+			Message:   beaconBlockHeaderFromProto(m.Message),
+			Signature: hexutil.Encode(m.Signature),
+		}, nil
+	case *ethpb.EventPayloadAttributeV1:
+		d := m.Data
+		return &PayloadAttributesEvent{
+			Version: m.Version,
+			Data: &PayloadAttributesEventData{
+				ProposerIndex:     strconv.FormatUint(uint64(d.ProposerIndex), 10),
+				ProposalSlot:      strconv.FormatUint(uint64(d.ProposalSlot), 10),
+				ParentBlockNumber: strconv.FormatUint(d.ParentBlockNumber, 10),
+				ParentBlockRoot:   hexutil.Encode(d.ParentBlockRoot),
+				ParentBlockHash:   hexutil.Encode(d.ParentBlockHash),
+				PayloadAttributes: &PayloadAttributesV1{
+					Timestamp:             strconv.FormatUint(d.PayloadAttributes.Timestamp, 10),
+					PrevRandao:            hexutil.Encode(d.PayloadAttributes.PrevRandao),
+					SuggestedFeeRecipient: hexutil.Encode(d.PayloadAttributes.SuggestedFeeRecipient),
+				},
+			},
+		}, nil
+	case *ethpb.EventPayloadAttributeV2:
+		d := m.Data
+		return &PayloadAttributesEvent{
+			Version: m.Version,
+			Data: &PayloadAttributesEventData{
+				ProposerIndex:     strconv.FormatUint(uint64(d.ProposerIndex), 10),
+				ProposalSlot:      strconv.FormatUint(uint64(d.ProposalSlot), 10),
+				ParentBlockNumber: strconv.FormatUint(d.ParentBlockNumber, 10),
+				ParentBlockRoot:   hexutil.Encode(d.ParentBlockRoot),
+				ParentBlockHash:   hexutil.Encode(d.ParentBlockHash),
+				PayloadAttributes: &PayloadAttributesV2{
+					Timestamp:             strconv.FormatUint(d.PayloadAttributes.Timestamp, 10),
+					PrevRandao:            hexutil.Encode(d.PayloadAttributes.PrevRandao),
+					SuggestedFeeRecipient: hexutil.Encode(d.PayloadAttributes.SuggestedFeeRecipient),
+					Withdrawals:           withdrawalsFromProto(d.PayloadAttributes.Withdrawals),
+				},
+			},
+		}, nil
+	case *ethpbv2.LightClientFinalityUpdateWithVersion:
+		return &LightClientFinalityUpdateEvent{
+			Version: strings.ToLower(m.Version.String()),
+			Data: &LightClientFinalityUpdate{
+				AttestedHeader:  beaconBlockHeaderFromProto(m.Data.AttestedHeader),
+				FinalizedHeader: beaconBlockHeaderFromProto(m.Data.FinalizedHeader),
+				FinalityBranch:  hexSlice(m.Data.FinalityBranch),
+				SyncAggregate:   syncAggregateFromProto(m.Data.SyncAggregate),
+				SignatureSlot:   strconv.FormatUint(uint64(m.Data.SignatureSlot), 10),
+			},
+		}, nil
+	case *ethpbv2.LightClientOptimisticUpdateWithVersion:
+		return &LightClientOptimisticUpdateEvent{
+			Version: strings.ToLower(m.Version.String()),
+			Data: &LightClientOptimisticUpdate{
+				AttestedHeader: beaconBlockHeaderFromProto(m.Data.AttestedHeader),
+				SyncAggregate:  syncAggregateFromProto(m.Data.SyncAggregate),
+				SignatureSlot:  strconv.FormatUint(uint64(m.Data.SignatureSlot), 10),
+			},
+		}, nil
+	default:
+		return nil, fmt.Errorf("unsupported event data type %T", msg)
+	}
+}
+
+func attestationFromProto(a *ethpb.Attestation) *shared.Attestation {
+	return &shared.Attestation{
+		AggregationBits: hexutil.Encode(a.AggregationBits),
+		Data: &shared.AttestationData{
+			Slot:            strconv.FormatUint(uint64(a.Data.Slot), 10),
+			CommitteeIndex:  strconv.FormatUint(uint64(a.Data.Index), 10),
+			BeaconBlockRoot: hexutil.Encode(a.Data.BeaconBlockRoot),
+			Source:          checkpointFromProto(a.Data.Source),
+			Target:          checkpointFromProto(a.Data.Target),
+		},
+		Signature: hexutil.Encode(a.Signature),
+	}
+}
+
+func checkpointFromProto(c *ethpb.Checkpoint) *shared.Checkpoint {
+	return &shared.Checkpoint{
+		Epoch: strconv.FormatUint(uint64(c.Epoch), 10),
+		Root:  hexutil.Encode(c.Root),
+	}
+}
+
+func beaconBlockHeaderFromProto(h *ethpb.BeaconBlockHeader) *shared.BeaconBlockHeader {
+	return &shared.BeaconBlockHeader{
+		Slot:          strconv.FormatUint(uint64(h.Slot), 10),
+		ProposerIndex: strconv.FormatUint(uint64(h.ProposerIndex), 10),
+		ParentRoot:    hexutil.Encode(h.ParentRoot),
+		StateRoot:     hexutil.Encode(h.StateRoot),
+		BodyRoot:      hexutil.Encode(h.BodyRoot),
+	}
+}
+
+func syncAggregateFromProto(s *ethpb.SyncAggregate) *shared.SyncAggregate {
+	return &shared.SyncAggregate{
+		SyncCommitteeBits:      hexutil.Encode(s.SyncCommitteeBits),
+		SyncCommitteeSignature: hexutil.Encode(s.SyncCommitteeSignature),
+	}
+}
+
+func withdrawalsFromProto(src []*enginev1.Withdrawal) []*shared.Withdrawal {
+	withdrawals := make([]*shared.Withdrawal, len(src))
+	for i, w := range src {
+		withdrawals[i] = &shared.Withdrawal{
+			WithdrawalIndex:  strconv.FormatUint(w.Index, 10),
+			ValidatorIndex:   strconv.FormatUint(uint64(w.ValidatorIndex), 10),
+			ExecutionAddress: hexutil.Encode(w.Address),
+			Amount:           strconv.FormatUint(w.Amount, 10),
+		}
+	}
+	return withdrawals
+}
+
+func hexSlice(src [][]byte) []string {
+	s := make([]string, len(src))
+	for i, b := range src {
+		s[i] = hexutil.Encode(b)
+	}
+	return s
+}
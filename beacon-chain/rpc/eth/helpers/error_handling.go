@@ -1,31 +1,5 @@
 package helpers
 
-import (
-	"errors"
-
-	"github.com/prysmaticlabs/prysm/v4/beacon-chain/rpc/lookup"
-	"github.com/prysmaticlabs/prysm/v4/beacon-chain/state/stategen"
-	"github.com/prysmaticlabs/prysm/v4/consensus-types/blocks"
-	"github.com/prysmaticlabs/prysm/v4/consensus-types/interfaces"
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
-)
-
-// PrepareStateFetchGRPCError returns an appropriate gRPC error based on the supplied argument.
-// The argument error should be a result of fetching state.
-func PrepareStateFetchGRPCError(err error) error {
-	if errors.Is(err, stategen.ErrNoDataForSlot) {
-		return status.Errorf(codes.NotFound, "lacking historical data needed to fulfill request")
-	}
-	if stateNotFoundErr, ok := err.(*lookup.StateNotFoundError); ok {
-		return status.Errorf(codes.NotFound, "State not found: %v", stateNotFoundErr)
-	}
-	if parseErr, ok := err.(*lookup.StateIdParseError); ok {
-		return status.Errorf(codes.InvalidArgument, "Invalid state ID: %v", parseErr)
-	}
-	return status.Errorf(codes.Internal, "Invalid state ID: %v", err)
-}
-
 // IndexedVerificationFailure represents a collection of verification failures.
 type IndexedVerificationFailure struct {
 	Failures []*SingleIndexedVerificationFailure `json:"failures"`
@@ -36,16 +10,3 @@ type SingleIndexedVerificationFailure struct {
 	Index   int    `json:"index"`
 	Message string `json:"message"`
 }
-
-func HandleGetBlockError(blk interfaces.ReadOnlySignedBeaconBlock, err error) error {
-	if invalidBlockIdErr, ok := err.(*lookup.BlockIdParseError); ok {
-		return status.Errorf(codes.InvalidArgument, "Invalid block ID: %v", invalidBlockIdErr)
-	}
-	if err != nil {
-		return status.Errorf(codes.Internal, "Could not get block from block ID: %v", err)
-	}
-	if err := blocks.BeaconBlockIsNil(blk); err != nil {
-		return status.Errorf(codes.NotFound, "Could not find requested block: %v", err)
-	}
-	return nil
-}
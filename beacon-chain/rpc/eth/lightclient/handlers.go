@@ -0,0 +1,172 @@
+package lightclient
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/gorilla/mux"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/blockchain"
+	fieldparams "github.com/prysmaticlabs/prysm/v4/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v4/config/params"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v4/encoding/bytesutil"
+	http2 "github.com/prysmaticlabs/prysm/v4/network/http"
+	"github.com/prysmaticlabs/prysm/v4/runtime/version"
+	"github.com/prysmaticlabs/prysm/v4/time/slots"
+	"go.opencensus.io/trace"
+)
+
+// GetBootstrap is an HTTP handler for Beacon API getLightClientBootstrap.
+func (s *Server) GetBootstrap(w http.ResponseWriter, r *http.Request) {
+	ctx, span := trace.StartSpan(r.Context(), "lightclient.GetBootstrap")
+	defer span.End()
+
+	rawRoot := mux.Vars(r)["block_root"]
+	root, err := hexutil.Decode(rawRoot)
+	if err != nil || len(root) != fieldparams.RootLength {
+		http2.HandleError(w, "Invalid block root: "+rawRoot, http.StatusBadRequest)
+		return
+	}
+	blockRoot := bytesutil.ToBytes32(root)
+	if !s.BeaconDB.HasBlock(ctx, blockRoot) {
+		http2.HandleError(w, fmt.Sprintf("Block %#x not found", blockRoot), http.StatusNotFound)
+		return
+	}
+	st, err := s.StateGen.StateByRoot(ctx, blockRoot)
+	if err != nil {
+		http2.HandleError(w, errors.Wrapf(err, "could not get state of block %#x", blockRoot).Error(), http.StatusInternalServerError)
+		return
+	}
+	bootstrap, err := blockchain.NewLightClientBootstrapFromBeaconState(ctx, st)
+	if err != nil {
+		// The state is from before altair, or is the post state of a skipped slot.
+		http2.HandleError(w, errors.Wrapf(err, "light client bootstrap of block %#x is not available", blockRoot).Error(), http.StatusNotFound)
+		return
+	}
+
+	if http2.SszRequested(r) {
+		sszResp, err := bootstrap.MarshalSSZ()
+		if err != nil {
+			http2.HandleError(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		http2.WriteSsz(w, sszResp, "light_client_bootstrap.ssz")
+		return
+	}
+	http2.WriteJson(w, &BootstrapResponse{
+		Version: version.String(st.Version()),
+		Data:    bootstrapFromConsensus(bootstrap),
+	})
+}
+
+// GetUpdatesByRange is an HTTP handler for Beacon API getLightClientUpdatesByRange.
+// The best update of each requested sync committee period is returned, stopping at the first period
+// for which this node has no update.
+func (s *Server) GetUpdatesByRange(w http.ResponseWriter, r *http.Request) {
+	ctx, span := trace.StartSpan(r.Context(), "lightclient.GetUpdatesByRange")
+	defer span.End()
+
+	query := r.URL.Query()
+	startPeriod, err := strconv.ParseUint(query.Get("start_period"), 10, 64)
+	if err != nil {
+		http2.HandleError(w, "Invalid start_period: "+query.Get("start_period"), http.StatusBadRequest)
+		return
+	}
+	count, err := strconv.ParseUint(query.Get("count"), 10, 64)
+	if err != nil || count == 0 {
+		http2.HandleError(w, "Invalid count: "+query.Get("count"), http.StatusBadRequest)
+		return
+	}
+	if max := params.BeaconNetworkConfig().MaxRequestLightClientUpdates; count > max {
+		count = max
+	}
+	if startPeriod+count < startPeriod {
+		http2.HandleError(w, "Requested periods overflow", http.StatusBadRequest)
+		return
+	}
+
+	updates, err := s.BeaconDB.LightClientUpdates(ctx, startPeriod, startPeriod+count-1)
+	if err != nil {
+		http2.HandleError(w, errors.Wrap(err, "could not get light client updates").Error(), http.StatusInternalServerError)
+		return
+	}
+	resp := make([]*UpdateResponse, len(updates))
+	for i, u := range updates {
+		resp[i] = &UpdateResponse{
+			Version: version.String(versionAtSlot(u.AttestedHeader.Slot)),
+			Data:    updateFromConsensus(u),
+		}
+	}
+	http2.WriteJson(w, resp)
+}
+
+// GetFinalityUpdate is an HTTP handler for Beacon API getLightClientFinalityUpdate.
+func (s *Server) GetFinalityUpdate(w http.ResponseWriter, r *http.Request) {
+	_, span := trace.StartSpan(r.Context(), "lightclient.GetFinalityUpdate")
+	defer span.End()
+
+	update := s.LightClientUpdateFetcher.LightClientFinalityUpdate()
+	if update == nil {
+		http2.HandleError(w, "No light client finality update available", http.StatusNotFound)
+		return
+	}
+	if http2.SszRequested(r) {
+		sszResp, err := update.Data.MarshalSSZ()
+		if err != nil {
+			http2.HandleError(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		http2.WriteSsz(w, sszResp, "light_client_finality_update.ssz")
+		return
+	}
+	http2.WriteJson(w, &UpdateResponse{
+		Version: version.String(int(update.Version)),
+		Data:    finalityUpdateFromConsensus(update.Data),
+	})
+}
+
+// GetOptimisticUpdate is an HTTP handler for Beacon API getLightClientOptimisticUpdate.
+func (s *Server) GetOptimisticUpdate(w http.ResponseWriter, r *http.Request) {
+	_, span := trace.StartSpan(r.Context(), "lightclient.GetOptimisticUpdate")
+	defer span.End()
+
+	update := s.LightClientUpdateFetcher.LightClientOptimisticUpdate()
+	if update == nil {
+		http2.HandleError(w, "No light client optimistic update available", http.StatusNotFound)
+		return
+	}
+	if http2.SszRequested(r) {
+		sszResp, err := update.Data.MarshalSSZ()
+		if err != nil {
+			http2.HandleError(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		http2.WriteSsz(w, sszResp, "light_client_optimistic_update.ssz")
+		return
+	}
+	http2.WriteJson(w, &UpdateResponse{
+		Version: version.String(int(update.Version)),
+		Data:    optimisticUpdateFromConsensus(update.Data),
+	})
+}
+
+// versionAtSlot returns the fork version active at the slot.
+func versionAtSlot(slot primitives.Slot) int {
+	cfg := params.BeaconConfig()
+	epoch := slots.ToEpoch(slot)
+	switch {
+	case epoch >= cfg.DenebForkEpoch:
+		return version.Deneb
+	case epoch >= cfg.CapellaForkEpoch:
+		return version.Capella
+	case epoch >= cfg.BellatrixForkEpoch:
+		return version.Bellatrix
+	case epoch >= cfg.AltairForkEpoch:
+		return version.Altair
+	default:
+		return version.Phase0
+	}
+}
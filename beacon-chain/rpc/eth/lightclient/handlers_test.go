@@ -0,0 +1,212 @@
+package lightclient
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/gorilla/mux"
+	"github.com/prysmaticlabs/go-bitfield"
+	mockChain "github.com/prysmaticlabs/prysm/v4/beacon-chain/blockchain/testing"
+	testDB "github.com/prysmaticlabs/prysm/v4/beacon-chain/db/testing"
+	mockstategen "github.com/prysmaticlabs/prysm/v4/beacon-chain/state/stategen/mock"
+	fieldparams "github.com/prysmaticlabs/prysm/v4/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v4/config/params"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	http2 "github.com/prysmaticlabs/prysm/v4/network/http"
+	ethpbv1 "github.com/prysmaticlabs/prysm/v4/proto/eth/v1"
+	ethpbv2 "github.com/prysmaticlabs/prysm/v4/proto/eth/v2"
+	"github.com/prysmaticlabs/prysm/v4/testing/assert"
+	"github.com/prysmaticlabs/prysm/v4/testing/require"
+	"github.com/prysmaticlabs/prysm/v4/testing/util"
+)
+
+func testUpdate(slot primitives.Slot) *ethpbv2.LightClientUpdate {
+	header := &ethpbv1.BeaconBlockHeader{
+		Slot:       slot,
+		ParentRoot: make([]byte, fieldparams.RootLength),
+		StateRoot:  make([]byte, fieldparams.RootLength),
+		BodyRoot:   make([]byte, fieldparams.RootLength),
+	}
+	branch := func(n int) [][]byte {
+		b := make([][]byte, n)
+		for i := range b {
+			b[i] = make([]byte, fieldparams.RootLength)
+		}
+		return b
+	}
+	pubkeys := make([][]byte, fieldparams.SyncCommitteeLength)
+	for i := range pubkeys {
+		pubkeys[i] = make([]byte, fieldparams.BLSPubkeyLength)
+	}
+	return &ethpbv2.LightClientUpdate{
+		AttestedHeader: header,
+		NextSyncCommittee: &ethpbv2.SyncCommittee{
+			Pubkeys:         pubkeys,
+			AggregatePubkey: make([]byte, fieldparams.BLSPubkeyLength),
+		},
+		NextSyncCommitteeBranch: branch(5),
+		FinalizedHeader:         header,
+		FinalityBranch:          branch(6),
+		SyncAggregate: &ethpbv1.SyncAggregate{
+			SyncCommitteeBits:      bitfield.NewBitvector512(),
+			SyncCommitteeSignature: make([]byte, fieldparams.BLSSignatureLength),
+		},
+		SignatureSlot: slot + 1,
+	}
+}
+
+func TestGetBootstrap(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	cfg := params.BeaconConfig().Copy()
+	cfg.AltairForkEpoch = 0
+	cfg.BellatrixForkEpoch = 0
+	cfg.CapellaForkEpoch = 0
+	params.OverrideBeaconConfig(cfg)
+
+	ctx := context.Background()
+	db := testDB.SetupDB(t)
+	b := util.NewBeaconBlockCapella()
+	util.SaveBlock(t, ctx, db, b)
+	root, err := b.Block.HashTreeRoot()
+	require.NoError(t, err)
+	st, err := util.NewBeaconStateCapella()
+	require.NoError(t, err)
+	stateGen := mockstategen.NewMockService()
+	stateGen.AddStateForRoot(st, root)
+	s := &Server{BeaconDB: db, StateGen: stateGen}
+
+	t.Run("ok", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://foo.example/eth/v1/beacon/light_client/bootstrap/{block_root}", nil)
+		request = mux.SetURLVars(request, map[string]string{"block_root": hexutil.Encode(root[:])})
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetBootstrap(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		resp := &BootstrapResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		assert.Equal(t, "capella", resp.Version)
+		assert.Equal(t, "0", resp.Data.Header.Slot)
+		assert.Equal(t, fieldparams.SyncCommitteeLength, len(resp.Data.CurrentSyncCommittee.Pubkeys))
+		assert.Equal(t, 5, len(resp.Data.CurrentSyncCommitteeBranch))
+	})
+	t.Run("unknown block", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://foo.example/eth/v1/beacon/light_client/bootstrap/{block_root}", nil)
+		request = mux.SetURLVars(request, map[string]string{"block_root": hexutil.Encode(make([]byte, 32))})
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetBootstrap(writer, request)
+		assert.Equal(t, http.StatusNotFound, writer.Code)
+	})
+	t.Run("invalid root", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://foo.example/eth/v1/beacon/light_client/bootstrap/{block_root}", nil)
+		request = mux.SetURLVars(request, map[string]string{"block_root": "foo"})
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetBootstrap(writer, request)
+		assert.Equal(t, http.StatusBadRequest, writer.Code)
+		e := &http2.DefaultErrorJson{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), e))
+		assert.StringContains(t, "Invalid block root", e.Message)
+	})
+}
+
+func TestGetUpdatesByRange(t *testing.T) {
+	ctx := context.Background()
+	db := testDB.SetupDB(t)
+	slotsPerPeriod := primitives.Slot(uint64(params.BeaconConfig().EpochsPerSyncCommitteePeriod) * uint64(params.BeaconConfig().SlotsPerEpoch))
+	for period := uint64(1); period <= 3; period++ {
+		require.NoError(t, db.SaveLightClientUpdate(ctx, period, testUpdate(primitives.Slot(period)*slotsPerPeriod)))
+	}
+	s := &Server{BeaconDB: db}
+
+	t.Run("ok", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://foo.example/eth/v1/beacon/light_client/updates?start_period=2&count=1000", nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetUpdatesByRange(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		var resp []*UpdateResponse
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), &resp))
+		require.Equal(t, 2, len(resp))
+		assert.Equal(t, "phase0", resp[0].Version)
+		assert.Equal(t, "16384", resp[0].Data.AttestedHeader.Slot)
+		assert.Equal(t, "24576", resp[1].Data.AttestedHeader.Slot)
+	})
+	t.Run("zero count", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://foo.example/eth/v1/beacon/light_client/updates?start_period=2&count=0", nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetUpdatesByRange(writer, request)
+		assert.Equal(t, http.StatusBadRequest, writer.Code)
+	})
+	t.Run("missing start period", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://foo.example/eth/v1/beacon/light_client/updates?count=1", nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetUpdatesByRange(writer, request)
+		assert.Equal(t, http.StatusBadRequest, writer.Code)
+	})
+}
+
+func TestGetFinalityAndOptimisticUpdate(t *testing.T) {
+	update := testUpdate(100)
+	chain := &mockChain.ChainService{}
+	s := &Server{LightClientUpdateFetcher: chain}
+
+	request := httptest.NewRequest(http.MethodGet, "http://foo.example/eth/v1/beacon/light_client/finality_update", nil)
+	writer := httptest.NewRecorder()
+	writer.Body = &bytes.Buffer{}
+	s.GetFinalityUpdate(writer, request)
+	assert.Equal(t, http.StatusNotFound, writer.Code)
+
+	chain.LCFinalityUpdate = &ethpbv2.LightClientFinalityUpdateWithVersion{
+		Version: ethpbv2.Version_CAPELLA,
+		Data: &ethpbv2.LightClientFinalityUpdate{
+			AttestedHeader:  update.AttestedHeader,
+			FinalizedHeader: update.FinalizedHeader,
+			FinalityBranch:  update.FinalityBranch,
+			SyncAggregate:   update.SyncAggregate,
+			SignatureSlot:   update.SignatureSlot,
+		},
+	}
+	chain.LCOptimisticUpdate = &ethpbv2.LightClientOptimisticUpdateWithVersion{
+		Version: ethpbv2.Version_CAPELLA,
+		Data: &ethpbv2.LightClientOptimisticUpdate{
+			AttestedHeader: update.AttestedHeader,
+			SyncAggregate:  update.SyncAggregate,
+			SignatureSlot:  update.SignatureSlot,
+		},
+	}
+
+	writer = httptest.NewRecorder()
+	writer.Body = &bytes.Buffer{}
+	s.GetFinalityUpdate(writer, request)
+	require.Equal(t, http.StatusOK, writer.Code)
+	resp := &UpdateResponse{}
+	require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+	assert.Equal(t, "capella", resp.Version)
+	assert.Equal(t, "101", resp.Data.SignatureSlot)
+	assert.Equal(t, 6, len(resp.Data.FinalityBranch))
+	assert.Equal(t, (*SyncCommittee)(nil), resp.Data.NextSyncCommittee)
+
+	request = httptest.NewRequest(http.MethodGet, "http://foo.example/eth/v1/beacon/light_client/optimistic_update", nil)
+	request.Header.Set("Accept", "application/octet-stream")
+	writer = httptest.NewRecorder()
+	writer.Body = &bytes.Buffer{}
+	s.GetOptimisticUpdate(writer, request)
+	require.Equal(t, http.StatusOK, writer.Code)
+	decoded := &ethpbv2.LightClientOptimisticUpdate{}
+	require.NoError(t, decoded.UnmarshalSSZ(writer.Body.Bytes()))
+	assert.Equal(t, primitives.Slot(100), decoded.AttestedHeader.Slot)
+}
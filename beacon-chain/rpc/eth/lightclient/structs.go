@@ -0,0 +1,127 @@
+package lightclient
+
+import (
+	"strconv"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/rpc/eth/shared"
+	ethpbv1 "github.com/prysmaticlabs/prysm/v4/proto/eth/v1"
+	ethpbv2 "github.com/prysmaticlabs/prysm/v4/proto/eth/v2"
+)
+
+type BootstrapResponse struct {
+	Version string     `json:"version"`
+	Data    *Bootstrap `json:"data"`
+}
+
+type Bootstrap struct {
+	Header                     *shared.BeaconBlockHeader `json:"header"`
+	CurrentSyncCommittee       *SyncCommittee            `json:"current_sync_committee"`
+	CurrentSyncCommitteeBranch []string                  `json:"current_sync_committee_branch"`
+}
+
+type UpdateResponse struct {
+	Version string  `json:"version"`
+	Data    *Update `json:"data"`
+}
+
+type Update struct {
+	AttestedHeader          *shared.BeaconBlockHeader `json:"attested_header"`
+	NextSyncCommittee       *SyncCommittee            `json:"next_sync_committee,omitempty"`
+	NextSyncCommitteeBranch []string                  `json:"next_sync_committee_branch,omitempty"`
+	FinalizedHeader         *shared.BeaconBlockHeader `json:"finalized_header,omitempty"`
+	FinalityBranch          []string                  `json:"finality_branch,omitempty"`
+	SyncAggregate           *shared.SyncAggregate     `json:"sync_aggregate"`
+	SignatureSlot           string                    `json:"signature_slot"`
+}
+
+type SyncCommittee struct {
+	Pubkeys         []string `json:"pubkeys"`
+	AggregatePubkey string   `json:"aggregate_pubkey"`
+}
+
+func bootstrapFromConsensus(b *ethpbv2.LightClientBootstrap) *Bootstrap {
+	return &Bootstrap{
+		Header:                     headerFromConsensus(b.Header),
+		CurrentSyncCommittee:       syncCommitteeFromConsensus(b.CurrentSyncCommittee),
+		CurrentSyncCommitteeBranch: branchFromConsensus(b.CurrentSyncCommitteeBranch),
+	}
+}
+
+func updateFromConsensus(u *ethpbv2.LightClientUpdate) *Update {
+	return &Update{
+		AttestedHeader:          headerFromConsensus(u.AttestedHeader),
+		NextSyncCommittee:       syncCommitteeFromConsensus(u.NextSyncCommittee),
+		NextSyncCommitteeBranch: branchFromConsensus(u.NextSyncCommitteeBranch),
+		FinalizedHeader:         headerFromConsensus(u.FinalizedHeader),
+		FinalityBranch:          branchFromConsensus(u.FinalityBranch),
+		SyncAggregate:           syncAggregateFromConsensus(u.SyncAggregate),
+		SignatureSlot:           strconv.FormatUint(uint64(u.SignatureSlot), 10),
+	}
+}
+
+func finalityUpdateFromConsensus(u *ethpbv2.LightClientFinalityUpdate) *Update {
+	return &Update{
+		AttestedHeader:  headerFromConsensus(u.AttestedHeader),
+		FinalizedHeader: headerFromConsensus(u.FinalizedHeader),
+		FinalityBranch:  branchFromConsensus(u.FinalityBranch),
+		SyncAggregate:   syncAggregateFromConsensus(u.SyncAggregate),
+		SignatureSlot:   strconv.FormatUint(uint64(u.SignatureSlot), 10),
+	}
+}
+
+func optimisticUpdateFromConsensus(u *ethpbv2.LightClientOptimisticUpdate) *Update {
+	return &Update{
+		AttestedHeader: headerFromConsensus(u.AttestedHeader),
+		SyncAggregate:  syncAggregateFromConsensus(u.SyncAggregate),
+		SignatureSlot:  strconv.FormatUint(uint64(u.SignatureSlot), 10),
+	}
+}
+
+func headerFromConsensus(h *ethpbv1.BeaconBlockHeader) *shared.BeaconBlockHeader {
+	if h == nil {
+		return nil
+	}
+	return &shared.BeaconBlockHeader{
+		Slot:          strconv.FormatUint(uint64(h.Slot), 10),
+		ProposerIndex: strconv.FormatUint(uint64(h.ProposerIndex), 10),
+		ParentRoot:    hexutil.Encode(h.ParentRoot),
+		StateRoot:     hexutil.Encode(h.StateRoot),
+		BodyRoot:      hexutil.Encode(h.BodyRoot),
+	}
+}
+
+func syncCommitteeFromConsensus(c *ethpbv2.SyncCommittee) *SyncCommittee {
+	if c == nil {
+		return nil
+	}
+	pubkeys := make([]string, len(c.Pubkeys))
+	for i, pk := range c.Pubkeys {
+		pubkeys[i] = hexutil.Encode(pk)
+	}
+	return &SyncCommittee{
+		Pubkeys:         pubkeys,
+		AggregatePubkey: hexutil.Encode(c.AggregatePubkey),
+	}
+}
+
+func syncAggregateFromConsensus(a *ethpbv1.SyncAggregate) *shared.SyncAggregate {
+	if a == nil {
+		return nil
+	}
+	return &shared.SyncAggregate{
+		SyncCommitteeBits:      hexutil.Encode(a.SyncCommitteeBits),
+		SyncCommitteeSignature: hexutil.Encode(a.SyncCommitteeSignature),
+	}
+}
+
+func branchFromConsensus(branch [][]byte) []string {
+	if len(branch) == 0 {
+		return nil
+	}
+	s := make([]string, len(branch))
+	for i, b := range branch {
+		s[i] = hexutil.Encode(b)
+	}
+	return s
+}
@@ -1,4 +1,4 @@
-// Package node defines a gRPC node service implementation, providing
+// Package node defines the node API endpoints implementation, providing
 // useful endpoints for checking a node's sync status, peer info,
 // genesis data, and version information.
 package node
@@ -9,16 +9,14 @@ import (
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/execution"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/p2p"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/sync"
-	"google.golang.org/grpc"
 )
 
-// Server defines a server implementation of the gRPC Node service,
-// providing RPC endpoints for verifying a beacon node's sync status, genesis and
+// Server defines a server implementation of the node API,
+// providing endpoints for verifying a beacon node's sync status, genesis and
 // version information.
 type Server struct {
 	SyncChecker               sync.Checker
 	OptimisticModeFetcher     blockchain.OptimisticModeFetcher
-	Server                    *grpc.Server
 	BeaconDB                  db.ReadOnlyDatabase
 	PeersFetcher              p2p.PeersProvider
 	PeerManager               p2p.PeerManager
@@ -1,7 +0,0 @@
-package node
-
-import (
-	ethpbservice "github.com/prysmaticlabs/prysm/v4/proto/eth/service"
-)
-
-var _ ethpbservice.BeaconNodeServer = (*Server)(nil)
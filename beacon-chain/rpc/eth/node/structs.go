@@ -11,3 +11,60 @@ type SyncStatusResponseData struct {
 	IsOptimistic bool   `json:"is_optimistic"`
 	ElOffline    bool   `json:"el_offline"`
 }
+
+type IdentityResponse struct {
+	Data *Identity `json:"data"`
+}
+
+type Identity struct {
+	PeerId             string    `json:"peer_id"`
+	Enr                string    `json:"enr"`
+	P2PAddresses       []string  `json:"p2p_addresses"`
+	DiscoveryAddresses []string  `json:"discovery_addresses"`
+	Metadata           *Metadata `json:"metadata"`
+}
+
+type Metadata struct {
+	SeqNumber string `json:"seq_number"`
+	Attnets   string `json:"attnets"`
+}
+
+type PeerResponse struct {
+	Data *Peer `json:"data"`
+}
+
+type PeersResponse struct {
+	Data []*Peer    `json:"data"`
+	Meta *PeersMeta `json:"meta"`
+}
+
+type PeersMeta struct {
+	Count int `json:"count"`
+}
+
+type Peer struct {
+	PeerId             string `json:"peer_id"`
+	Enr                string `json:"enr"`
+	LastSeenP2PAddress string `json:"last_seen_p2p_address"`
+	State              string `json:"state"`
+	Direction          string `json:"direction"`
+}
+
+type PeerCountResponse struct {
+	Data *PeerCount `json:"data"`
+}
+
+type PeerCount struct {
+	Disconnected  string `json:"disconnected"`
+	Connecting    string `json:"connecting"`
+	Connected     string `json:"connected"`
+	Disconnecting string `json:"disconnecting"`
+}
+
+type VersionResponse struct {
+	Data *Version `json:"data"`
+}
+
+type Version struct {
+	Version string `json:"version"`
+}
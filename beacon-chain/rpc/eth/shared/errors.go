@@ -55,9 +55,11 @@ type IndexedVerificationFailure struct {
 func WriteStateFetchError(w http.ResponseWriter, err error) {
 	if stateNotFoundErr, ok := err.(*lookup.StateNotFoundError); ok {
 		http2.HandleError(w, "Could not get state: "+stateNotFoundErr.Error(), http.StatusNotFound)
+		return
 	}
 	if parseErr, ok := err.(*lookup.StateIdParseError); ok {
 		http2.HandleError(w, "Invalid state ID: "+parseErr.Error(), http.StatusBadRequest)
+		return
 	}
 	http2.HandleError(w, "Could not get state: "+err.Error(), http.StatusInternalServerError)
 }
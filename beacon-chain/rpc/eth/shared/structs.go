@@ -114,6 +114,15 @@ type Fork struct {
 	Epoch           string `json:"epoch"`
 }
 
+// DepositSnapshot is the EIP-4881 snapshot of the finalized part of the deposit tree.
+type DepositSnapshot struct {
+	Finalized            []string `json:"finalized"`
+	DepositRoot          string   `json:"deposit_root"`
+	DepositCount         string   `json:"deposit_count"`
+	ExecutionBlockHash   string   `json:"execution_block_hash"`
+	ExecutionBlockHeight string   `json:"execution_block_height"`
+}
+
 func (s *Fork) ToConsensus() (*eth.Fork, error) {
 	previousVersion, err := hexutil.Decode(s.PreviousVersion)
 	if err != nil {
@@ -549,3 +558,52 @@ type SyncDetails struct {
 type SyncDetailsContainer struct {
 	Data *SyncDetails `json:"data"`
 }
+
+func (s *DepositSnapshot) ToConsensus() (*eth.DepositSnapshot, error) {
+	finalized := make([][]byte, len(s.Finalized))
+	for i, f := range s.Finalized {
+		h, err := DecodeHexWithLength(f, fieldparams.RootLength)
+		if err != nil {
+			return nil, NewDecodeError(err, fmt.Sprintf("Finalized[%d]", i))
+		}
+		finalized[i] = h
+	}
+	depositRoot, err := DecodeHexWithLength(s.DepositRoot, fieldparams.RootLength)
+	if err != nil {
+		return nil, NewDecodeError(err, "DepositRoot")
+	}
+	depositCount, err := strconv.ParseUint(s.DepositCount, 10, 64)
+	if err != nil {
+		return nil, NewDecodeError(err, "DepositCount")
+	}
+	executionBlockHash, err := DecodeHexWithLength(s.ExecutionBlockHash, fieldparams.RootLength)
+	if err != nil {
+		return nil, NewDecodeError(err, "ExecutionBlockHash")
+	}
+	executionBlockHeight, err := strconv.ParseUint(s.ExecutionBlockHeight, 10, 64)
+	if err != nil {
+		return nil, NewDecodeError(err, "ExecutionBlockHeight")
+	}
+
+	return &eth.DepositSnapshot{
+		Finalized:      finalized,
+		DepositRoot:    depositRoot,
+		DepositCount:   depositCount,
+		ExecutionHash:  executionBlockHash,
+		ExecutionDepth: executionBlockHeight,
+	}, nil
+}
+
+func DepositSnapshotFromConsensus(s *eth.DepositSnapshot) *DepositSnapshot {
+	finalized := make([]string, len(s.Finalized))
+	for i, f := range s.Finalized {
+		finalized[i] = hexutil.Encode(f)
+	}
+	return &DepositSnapshot{
+		Finalized:            finalized,
+		DepositRoot:          hexutil.Encode(s.DepositRoot),
+		DepositCount:         strconv.FormatUint(s.DepositCount, 10),
+		ExecutionBlockHash:   hexutil.Encode(s.ExecutionHash),
+		ExecutionBlockHeight: strconv.FormatUint(s.ExecutionDepth, 10),
+	}
+}
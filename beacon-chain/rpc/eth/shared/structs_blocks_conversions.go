@@ -2762,93 +2762,100 @@ func ProposerSlashingsToConsensus(src []*ProposerSlashing) ([]*eth.ProposerSlash
 		if s == nil {
 			return nil, NewDecodeError(errNilValue, fmt.Sprintf("[%d]", i))
 		}
-		if s.SignedHeader1 == nil {
-			return nil, NewDecodeError(errNilValue, fmt.Sprintf("[%d].SignedHeader1", i))
-		}
-		if s.SignedHeader1.Message == nil {
-			return nil, NewDecodeError(errNilValue, fmt.Sprintf("[%d].SignedHeader1.Message", i))
-		}
-		if s.SignedHeader2 == nil {
-			return nil, NewDecodeError(errNilValue, fmt.Sprintf("[%d].SignedHeader2", i))
-		}
-		if s.SignedHeader2.Message == nil {
-			return nil, NewDecodeError(errNilValue, fmt.Sprintf("[%d].SignedHeader2.Message", i))
-		}
-
-		h1Sig, err := DecodeHexWithLength(s.SignedHeader1.Signature, fieldparams.BLSSignatureLength)
-		if err != nil {
-			return nil, NewDecodeError(err, fmt.Sprintf("[%d].SignedHeader1.Signature", i))
-		}
-		h1Slot, err := strconv.ParseUint(s.SignedHeader1.Message.Slot, 10, 64)
-		if err != nil {
-			return nil, NewDecodeError(err, fmt.Sprintf("[%d].SignedHeader1.Message.Slot", i))
-		}
-		h1ProposerIndex, err := strconv.ParseUint(s.SignedHeader1.Message.ProposerIndex, 10, 64)
-		if err != nil {
-			return nil, NewDecodeError(err, fmt.Sprintf("[%d].SignedHeader1.Message.ProposerIndex", i))
-		}
-		h1ParentRoot, err := DecodeHexWithLength(s.SignedHeader1.Message.ParentRoot, fieldparams.RootLength)
-		if err != nil {
-			return nil, NewDecodeError(err, fmt.Sprintf("[%d].SignedHeader1.Message.ParentRoot", i))
-		}
-		h1StateRoot, err := DecodeHexWithLength(s.SignedHeader1.Message.StateRoot, fieldparams.RootLength)
-		if err != nil {
-			return nil, NewDecodeError(err, fmt.Sprintf("[%d].SignedHeader1.Message.StateRoot", i))
-		}
-		h1BodyRoot, err := DecodeHexWithLength(s.SignedHeader1.Message.BodyRoot, fieldparams.RootLength)
-		if err != nil {
-			return nil, NewDecodeError(err, fmt.Sprintf("[%d].SignedHeader1.Message.BodyRoot", i))
-		}
-		h2Sig, err := DecodeHexWithLength(s.SignedHeader2.Signature, fieldparams.BLSSignatureLength)
-		if err != nil {
-			return nil, NewDecodeError(err, fmt.Sprintf("[%d].SignedHeader2.Signature", i))
-		}
-		h2Slot, err := strconv.ParseUint(s.SignedHeader2.Message.Slot, 10, 64)
-		if err != nil {
-			return nil, NewDecodeError(err, fmt.Sprintf("[%d].SignedHeader2.Message.Slot", i))
-		}
-		h2ProposerIndex, err := strconv.ParseUint(s.SignedHeader2.Message.ProposerIndex, 10, 64)
-		if err != nil {
-			return nil, NewDecodeError(err, fmt.Sprintf("[%d].SignedHeader2.Message.ProposerIndex", i))
-		}
-		h2ParentRoot, err := DecodeHexWithLength(s.SignedHeader2.Message.ParentRoot, fieldparams.RootLength)
-		if err != nil {
-			return nil, NewDecodeError(err, fmt.Sprintf("[%d].SignedHeader2.Message.ParentRoot", i))
-		}
-		h2StateRoot, err := DecodeHexWithLength(s.SignedHeader2.Message.StateRoot, fieldparams.RootLength)
-		if err != nil {
-			return nil, NewDecodeError(err, fmt.Sprintf("[%d].SignedHeader2.Message.StateRoot", i))
-		}
-		h2BodyRoot, err := DecodeHexWithLength(s.SignedHeader2.Message.BodyRoot, fieldparams.RootLength)
+		proposerSlashings[i], err = s.ToConsensus()
 		if err != nil {
-			return nil, NewDecodeError(err, fmt.Sprintf("[%d].SignedHeader2.Message.BodyRoot", i))
-		}
-		proposerSlashings[i] = &eth.ProposerSlashing{
-			Header_1: &eth.SignedBeaconBlockHeader{
-				Header: &eth.BeaconBlockHeader{
-					Slot:          primitives.Slot(h1Slot),
-					ProposerIndex: primitives.ValidatorIndex(h1ProposerIndex),
-					ParentRoot:    h1ParentRoot,
-					StateRoot:     h1StateRoot,
-					BodyRoot:      h1BodyRoot,
-				},
-				Signature: h1Sig,
-			},
-			Header_2: &eth.SignedBeaconBlockHeader{
-				Header: &eth.BeaconBlockHeader{
-					Slot:          primitives.Slot(h2Slot),
-					ProposerIndex: primitives.ValidatorIndex(h2ProposerIndex),
-					ParentRoot:    h2ParentRoot,
-					StateRoot:     h2StateRoot,
-					BodyRoot:      h2BodyRoot,
-				},
-				Signature: h2Sig,
-			},
+			return nil, NewDecodeError(err, fmt.Sprintf("[%d]", i))
 		}
 	}
 	return proposerSlashings, nil
 }
 
+func (s *ProposerSlashing) ToConsensus() (*eth.ProposerSlashing, error) {
+	if s.SignedHeader1 == nil {
+		return nil, NewDecodeError(errNilValue, "SignedHeader1")
+	}
+	if s.SignedHeader1.Message == nil {
+		return nil, NewDecodeError(errNilValue, "SignedHeader1.Message")
+	}
+	if s.SignedHeader2 == nil {
+		return nil, NewDecodeError(errNilValue, "SignedHeader2")
+	}
+	if s.SignedHeader2.Message == nil {
+		return nil, NewDecodeError(errNilValue, "SignedHeader2.Message")
+	}
+
+	h1Sig, err := DecodeHexWithLength(s.SignedHeader1.Signature, fieldparams.BLSSignatureLength)
+	if err != nil {
+		return nil, NewDecodeError(err, "SignedHeader1.Signature")
+	}
+	h1Slot, err := strconv.ParseUint(s.SignedHeader1.Message.Slot, 10, 64)
+	if err != nil {
+		return nil, NewDecodeError(err, "SignedHeader1.Message.Slot")
+	}
+	h1ProposerIndex, err := strconv.ParseUint(s.SignedHeader1.Message.ProposerIndex, 10, 64)
+	if err != nil {
+		return nil, NewDecodeError(err, "SignedHeader1.Message.ProposerIndex")
+	}
+	h1ParentRoot, err := DecodeHexWithLength(s.SignedHeader1.Message.ParentRoot, fieldparams.RootLength)
+	if err != nil {
+		return nil, NewDecodeError(err, "SignedHeader1.Message.ParentRoot")
+	}
+	h1StateRoot, err := DecodeHexWithLength(s.SignedHeader1.Message.StateRoot, fieldparams.RootLength)
+	if err != nil {
+		return nil, NewDecodeError(err, "SignedHeader1.Message.StateRoot")
+	}
+	h1BodyRoot, err := DecodeHexWithLength(s.SignedHeader1.Message.BodyRoot, fieldparams.RootLength)
+	if err != nil {
+		return nil, NewDecodeError(err, "SignedHeader1.Message.BodyRoot")
+	}
+	h2Sig, err := DecodeHexWithLength(s.SignedHeader2.Signature, fieldparams.BLSSignatureLength)
+	if err != nil {
+		return nil, NewDecodeError(err, "SignedHeader2.Signature")
+	}
+	h2Slot, err := strconv.ParseUint(s.SignedHeader2.Message.Slot, 10, 64)
+	if err != nil {
+		return nil, NewDecodeError(err, "SignedHeader2.Message.Slot")
+	}
+	h2ProposerIndex, err := strconv.ParseUint(s.SignedHeader2.Message.ProposerIndex, 10, 64)
+	if err != nil {
+		return nil, NewDecodeError(err, "SignedHeader2.Message.ProposerIndex")
+	}
+	h2ParentRoot, err := DecodeHexWithLength(s.SignedHeader2.Message.ParentRoot, fieldparams.RootLength)
+	if err != nil {
+		return nil, NewDecodeError(err, "SignedHeader2.Message.ParentRoot")
+	}
+	h2StateRoot, err := DecodeHexWithLength(s.SignedHeader2.Message.StateRoot, fieldparams.RootLength)
+	if err != nil {
+		return nil, NewDecodeError(err, "SignedHeader2.Message.StateRoot")
+	}
+	h2BodyRoot, err := DecodeHexWithLength(s.SignedHeader2.Message.BodyRoot, fieldparams.RootLength)
+	if err != nil {
+		return nil, NewDecodeError(err, "SignedHeader2.Message.BodyRoot")
+	}
+	return &eth.ProposerSlashing{
+		Header_1: &eth.SignedBeaconBlockHeader{
+			Header: &eth.BeaconBlockHeader{
+				Slot:          primitives.Slot(h1Slot),
+				ProposerIndex: primitives.ValidatorIndex(h1ProposerIndex),
+				ParentRoot:    h1ParentRoot,
+				StateRoot:     h1StateRoot,
+				BodyRoot:      h1BodyRoot,
+			},
+			Signature: h1Sig,
+		},
+		Header_2: &eth.SignedBeaconBlockHeader{
+			Header: &eth.BeaconBlockHeader{
+				Slot:          primitives.Slot(h2Slot),
+				ProposerIndex: primitives.ValidatorIndex(h2ProposerIndex),
+				ParentRoot:    h2ParentRoot,
+				StateRoot:     h2StateRoot,
+				BodyRoot:      h2BodyRoot,
+			},
+			Signature: h2Sig,
+		},
+	}, nil
+}
+
 func ProposerSlashingsFromConsensus(src []*eth.ProposerSlashing) ([]*ProposerSlashing, error) {
 	proposerSlashings := make([]*ProposerSlashing, len(src))
 	for i, s := range src {
@@ -2892,67 +2899,74 @@ func AttesterSlashingsToConsensus(src []*AttesterSlashing) ([]*eth.AttesterSlash
 		if s == nil {
 			return nil, NewDecodeError(errNilValue, fmt.Sprintf("[%d]", i))
 		}
-		if s.Attestation1 == nil {
-			return nil, NewDecodeError(errNilValue, fmt.Sprintf("[%d].Attestation1", i))
-		}
-		if s.Attestation2 == nil {
-			return nil, NewDecodeError(errNilValue, fmt.Sprintf("[%d].Attestation2", i))
-		}
-
-		a1Sig, err := DecodeHexWithLength(s.Attestation1.Signature, fieldparams.BLSSignatureLength)
+		attesterSlashings[i], err = s.ToConsensus()
 		if err != nil {
-			return nil, NewDecodeError(err, fmt.Sprintf("[%d].Attestation1.Signature", i))
-		}
-		err = VerifyMaxLength(s.Attestation1.AttestingIndices, 2048)
-		if err != nil {
-			return nil, NewDecodeError(err, fmt.Sprintf("[%d].Attestation1.AttestingIndices", i))
-		}
-		a1AttestingIndices := make([]uint64, len(s.Attestation1.AttestingIndices))
-		for j, ix := range s.Attestation1.AttestingIndices {
-			attestingIndex, err := strconv.ParseUint(ix, 10, 64)
-			if err != nil {
-				return nil, NewDecodeError(err, fmt.Sprintf("[%d].Attestation1.AttestingIndices[%d]", i, j))
-			}
-			a1AttestingIndices[j] = attestingIndex
-		}
-		a1Data, err := s.Attestation1.Data.ToConsensus()
-		if err != nil {
-			return nil, NewDecodeError(err, fmt.Sprintf("[%d].Attestation1.Data", i))
-		}
-		a2Sig, err := DecodeHexWithLength(s.Attestation2.Signature, fieldparams.BLSSignatureLength)
-		if err != nil {
-			return nil, NewDecodeError(err, fmt.Sprintf("[%d].Attestation2.Signature", i))
+			return nil, NewDecodeError(err, fmt.Sprintf("[%d]", i))
 		}
-		err = VerifyMaxLength(s.Attestation2.AttestingIndices, 2048)
+	}
+	return attesterSlashings, nil
+}
+
+func (s *AttesterSlashing) ToConsensus() (*eth.AttesterSlashing, error) {
+	if s.Attestation1 == nil {
+		return nil, NewDecodeError(errNilValue, "Attestation1")
+	}
+	if s.Attestation2 == nil {
+		return nil, NewDecodeError(errNilValue, "Attestation2")
+	}
+
+	a1Sig, err := DecodeHexWithLength(s.Attestation1.Signature, fieldparams.BLSSignatureLength)
+	if err != nil {
+		return nil, NewDecodeError(err, "Attestation1.Signature")
+	}
+	err = VerifyMaxLength(s.Attestation1.AttestingIndices, 2048)
+	if err != nil {
+		return nil, NewDecodeError(err, "Attestation1.AttestingIndices")
+	}
+	a1AttestingIndices := make([]uint64, len(s.Attestation1.AttestingIndices))
+	for j, ix := range s.Attestation1.AttestingIndices {
+		attestingIndex, err := strconv.ParseUint(ix, 10, 64)
 		if err != nil {
-			return nil, NewDecodeError(err, fmt.Sprintf("[%d].Attestation2.AttestingIndices", i))
+			return nil, NewDecodeError(err, fmt.Sprintf("Attestation1.AttestingIndices[%d]", j))
 		}
-		a2AttestingIndices := make([]uint64, len(s.Attestation2.AttestingIndices))
-		for j, ix := range s.Attestation2.AttestingIndices {
-			attestingIndex, err := strconv.ParseUint(ix, 10, 64)
-			if err != nil {
-				return nil, NewDecodeError(err, fmt.Sprintf("[%d].Attestation2.AttestingIndices[%d]", i, j))
-			}
-			a2AttestingIndices[j] = attestingIndex
-		}
-		a2Data, err := s.Attestation2.Data.ToConsensus()
+		a1AttestingIndices[j] = attestingIndex
+	}
+	a1Data, err := s.Attestation1.Data.ToConsensus()
+	if err != nil {
+		return nil, NewDecodeError(err, "Attestation1.Data")
+	}
+	a2Sig, err := DecodeHexWithLength(s.Attestation2.Signature, fieldparams.BLSSignatureLength)
+	if err != nil {
+		return nil, NewDecodeError(err, "Attestation2.Signature")
+	}
+	err = VerifyMaxLength(s.Attestation2.AttestingIndices, 2048)
+	if err != nil {
+		return nil, NewDecodeError(err, "Attestation2.AttestingIndices")
+	}
+	a2AttestingIndices := make([]uint64, len(s.Attestation2.AttestingIndices))
+	for j, ix := range s.Attestation2.AttestingIndices {
+		attestingIndex, err := strconv.ParseUint(ix, 10, 64)
 		if err != nil {
-			return nil, NewDecodeError(err, fmt.Sprintf("[%d].Attestation2.Data", i))
-		}
-		attesterSlashings[i] = &eth.AttesterSlashing{
-			Attestation_1: &eth.IndexedAttestation{
-				AttestingIndices: a1AttestingIndices,
-				Data:             a1Data,
-				Signature:        a1Sig,
-			},
-			Attestation_2: &eth.IndexedAttestation{
-				AttestingIndices: a2AttestingIndices,
-				Data:             a2Data,
-				Signature:        a2Sig,
-			},
+			return nil, NewDecodeError(err, fmt.Sprintf("Attestation2.AttestingIndices[%d]", j))
 		}
+		a2AttestingIndices[j] = attestingIndex
 	}
-	return attesterSlashings, nil
+	a2Data, err := s.Attestation2.Data.ToConsensus()
+	if err != nil {
+		return nil, NewDecodeError(err, "Attestation2.Data")
+	}
+	return &eth.AttesterSlashing{
+		Attestation_1: &eth.IndexedAttestation{
+			AttestingIndices: a1AttestingIndices,
+			Data:             a1Data,
+			Signature:        a1Sig,
+		},
+		Attestation_2: &eth.IndexedAttestation{
+			AttestingIndices: a2AttestingIndices,
+			Data:             a2Data,
+			Signature:        a2Sig,
+		},
+	}, nil
 }
 
 func AttesterSlashingsFromConsensus(src []*eth.AttesterSlashing) ([]*AttesterSlashing, error) {
@@ -3153,38 +3167,48 @@ func BlsChangesToConsensus(src []*SignedBlsToExecutionChange) ([]*eth.SignedBLST
 
 	changes := make([]*eth.SignedBLSToExecutionChange, len(src))
 	for i, ch := range src {
-		if ch.Message == nil {
+		if ch == nil {
 			return nil, NewDecodeError(errNilValue, fmt.Sprintf("[%d]", i))
 		}
-
-		sig, err := DecodeHexWithLength(ch.Signature, fieldparams.BLSSignatureLength)
-		if err != nil {
-			return nil, NewDecodeError(err, fmt.Sprintf("[%d].Signature", i))
-		}
-		index, err := strconv.ParseUint(ch.Message.ValidatorIndex, 10, 64)
-		if err != nil {
-			return nil, NewDecodeError(err, fmt.Sprintf("[%d].Message.ValidatorIndex", i))
-		}
-		pubkey, err := DecodeHexWithLength(ch.Message.FromBlsPubkey, fieldparams.BLSPubkeyLength)
-		if err != nil {
-			return nil, NewDecodeError(err, fmt.Sprintf("[%d].Message.FromBlsPubkey", i))
-		}
-		address, err := DecodeHexWithLength(ch.Message.ToExecutionAddress, common.AddressLength)
+		changes[i], err = ch.ToConsensus()
 		if err != nil {
-			return nil, NewDecodeError(err, fmt.Sprintf("[%d].Message.ToExecutionAddress", i))
-		}
-		changes[i] = &eth.SignedBLSToExecutionChange{
-			Message: &eth.BLSToExecutionChange{
-				ValidatorIndex:     primitives.ValidatorIndex(index),
-				FromBlsPubkey:      pubkey,
-				ToExecutionAddress: address,
-			},
-			Signature: sig,
+			return nil, NewDecodeError(err, fmt.Sprintf("[%d]", i))
 		}
 	}
 	return changes, nil
 }
 
+func (s *SignedBlsToExecutionChange) ToConsensus() (*eth.SignedBLSToExecutionChange, error) {
+	if s.Message == nil {
+		return nil, NewDecodeError(errNilValue, "Message")
+	}
+
+	sig, err := DecodeHexWithLength(s.Signature, fieldparams.BLSSignatureLength)
+	if err != nil {
+		return nil, NewDecodeError(err, "Signature")
+	}
+	index, err := strconv.ParseUint(s.Message.ValidatorIndex, 10, 64)
+	if err != nil {
+		return nil, NewDecodeError(err, "Message.ValidatorIndex")
+	}
+	pubkey, err := DecodeHexWithLength(s.Message.FromBlsPubkey, fieldparams.BLSPubkeyLength)
+	if err != nil {
+		return nil, NewDecodeError(err, "Message.FromBlsPubkey")
+	}
+	address, err := DecodeHexWithLength(s.Message.ToExecutionAddress, common.AddressLength)
+	if err != nil {
+		return nil, NewDecodeError(err, "Message.ToExecutionAddress")
+	}
+	return &eth.SignedBLSToExecutionChange{
+		Message: &eth.BLSToExecutionChange{
+			ValidatorIndex:     primitives.ValidatorIndex(index),
+			FromBlsPubkey:      pubkey,
+			ToExecutionAddress: address,
+		},
+		Signature: sig,
+	}, nil
+}
+
 func BlsChangesFromConsensus(src []*eth.SignedBLSToExecutionChange) ([]*SignedBlsToExecutionChange, error) {
 	changes := make([]*SignedBlsToExecutionChange, len(src))
 	for i, ch := range src {
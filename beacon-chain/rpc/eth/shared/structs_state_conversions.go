@@ -0,0 +1,531 @@
+package shared
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/state"
+	enginev1 "github.com/prysmaticlabs/prysm/v4/proto/engine/v1"
+	eth "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+)
+
+var errPayloadHeaderNotFound = errors.New("expected payload header")
+
+// stateFields holds the JSON representation of the fields present in every state version.
+type stateFields struct {
+	genesisTime                 string
+	genesisValidatorsRoot       string
+	slot                        string
+	fork                        *Fork
+	latestBlockHeader           *BeaconBlockHeader
+	blockRoots                  []string
+	stateRoots                  []string
+	historicalRoots             []string
+	eth1Data                    *Eth1Data
+	eth1DataVotes               []*Eth1Data
+	eth1DepositIndex            string
+	validators                  []*Validator
+	balances                    []string
+	randaoMixes                 []string
+	slashings                   []string
+	justificationBits           string
+	previousJustifiedCheckpoint *Checkpoint
+	currentJustifiedCheckpoint  *Checkpoint
+	finalizedCheckpoint         *Checkpoint
+}
+
+// altairFields holds the JSON representation of the fields introduced in Altair.
+type altairFields struct {
+	previousEpochParticipation []string
+	currentEpochParticipation  []string
+	inactivityScores           []string
+	currentSyncCommittee       *SyncCommittee
+	nextSyncCommittee          *SyncCommittee
+}
+
+// capellaFields holds the JSON representation of the fields introduced in Capella.
+type capellaFields struct {
+	nextWithdrawalIndex          string
+	nextWithdrawalValidatorIndex string
+	historicalSummaries          []*HistoricalSummary
+}
+
+func BeaconStateFromConsensus(st state.BeaconState) (*BeaconState, error) {
+	f, err := stateFieldsFromConsensus(st)
+	if err != nil {
+		return nil, err
+	}
+	srcPrevAtts, err := st.PreviousEpochAttestations()
+	if err != nil {
+		return nil, err
+	}
+	srcCurrAtts, err := st.CurrentEpochAttestations()
+	if err != nil {
+		return nil, err
+	}
+	return &BeaconState{
+		GenesisTime:                 f.genesisTime,
+		GenesisValidatorsRoot:       f.genesisValidatorsRoot,
+		Slot:                        f.slot,
+		Fork:                        f.fork,
+		LatestBlockHeader:           f.latestBlockHeader,
+		BlockRoots:                  f.blockRoots,
+		StateRoots:                  f.stateRoots,
+		HistoricalRoots:             f.historicalRoots,
+		Eth1Data:                    f.eth1Data,
+		Eth1DataVotes:               f.eth1DataVotes,
+		Eth1DepositIndex:            f.eth1DepositIndex,
+		Validators:                  f.validators,
+		Balances:                    f.balances,
+		RandaoMixes:                 f.randaoMixes,
+		Slashings:                   f.slashings,
+		PreviousEpochAttestations:   pendingAttsFromConsensus(srcPrevAtts),
+		CurrentEpochAttestations:    pendingAttsFromConsensus(srcCurrAtts),
+		JustificationBits:           f.justificationBits,
+		PreviousJustifiedCheckpoint: f.previousJustifiedCheckpoint,
+		CurrentJustifiedCheckpoint:  f.currentJustifiedCheckpoint,
+		FinalizedCheckpoint:         f.finalizedCheckpoint,
+	}, nil
+}
+
+func BeaconStateAltairFromConsensus(st state.BeaconState) (*BeaconStateAltair, error) {
+	f, err := stateFieldsFromConsensus(st)
+	if err != nil {
+		return nil, err
+	}
+	a, err := altairFieldsFromConsensus(st)
+	if err != nil {
+		return nil, err
+	}
+	return &BeaconStateAltair{
+		GenesisTime:                 f.genesisTime,
+		GenesisValidatorsRoot:       f.genesisValidatorsRoot,
+		Slot:                        f.slot,
+		Fork:                        f.fork,
+		LatestBlockHeader:           f.latestBlockHeader,
+		BlockRoots:                  f.blockRoots,
+		StateRoots:                  f.stateRoots,
+		HistoricalRoots:             f.historicalRoots,
+		Eth1Data:                    f.eth1Data,
+		Eth1DataVotes:               f.eth1DataVotes,
+		Eth1DepositIndex:            f.eth1DepositIndex,
+		Validators:                  f.validators,
+		Balances:                    f.balances,
+		RandaoMixes:                 f.randaoMixes,
+		Slashings:                   f.slashings,
+		PreviousEpochParticipation:  a.previousEpochParticipation,
+		CurrentEpochParticipation:   a.currentEpochParticipation,
+		JustificationBits:           f.justificationBits,
+		PreviousJustifiedCheckpoint: f.previousJustifiedCheckpoint,
+		CurrentJustifiedCheckpoint:  f.currentJustifiedCheckpoint,
+		FinalizedCheckpoint:         f.finalizedCheckpoint,
+		InactivityScores:            a.inactivityScores,
+		CurrentSyncCommittee:        a.currentSyncCommittee,
+		NextSyncCommittee:           a.nextSyncCommittee,
+	}, nil
+}
+
+func BeaconStateBellatrixFromConsensus(st state.BeaconState) (*BeaconStateBellatrix, error) {
+	f, err := stateFieldsFromConsensus(st)
+	if err != nil {
+		return nil, err
+	}
+	a, err := altairFieldsFromConsensus(st)
+	if err != nil {
+		return nil, err
+	}
+	execData, err := st.LatestExecutionPayloadHeader()
+	if err != nil {
+		return nil, err
+	}
+	srcPayload, ok := execData.Proto().(*enginev1.ExecutionPayloadHeader)
+	if !ok {
+		return nil, errPayloadHeaderNotFound
+	}
+	payload, err := ExecutionPayloadHeaderFromConsensus(srcPayload)
+	if err != nil {
+		return nil, err
+	}
+	return &BeaconStateBellatrix{
+		GenesisTime:                  f.genesisTime,
+		GenesisValidatorsRoot:        f.genesisValidatorsRoot,
+		Slot:                         f.slot,
+		Fork:                         f.fork,
+		LatestBlockHeader:            f.latestBlockHeader,
+		BlockRoots:                   f.blockRoots,
+		StateRoots:                   f.stateRoots,
+		HistoricalRoots:              f.historicalRoots,
+		Eth1Data:                     f.eth1Data,
+		Eth1DataVotes:                f.eth1DataVotes,
+		Eth1DepositIndex:             f.eth1DepositIndex,
+		Validators:                   f.validators,
+		Balances:                     f.balances,
+		RandaoMixes:                  f.randaoMixes,
+		Slashings:                    f.slashings,
+		PreviousEpochParticipation:   a.previousEpochParticipation,
+		CurrentEpochParticipation:    a.currentEpochParticipation,
+		JustificationBits:            f.justificationBits,
+		PreviousJustifiedCheckpoint:  f.previousJustifiedCheckpoint,
+		CurrentJustifiedCheckpoint:   f.currentJustifiedCheckpoint,
+		FinalizedCheckpoint:          f.finalizedCheckpoint,
+		InactivityScores:             a.inactivityScores,
+		CurrentSyncCommittee:         a.currentSyncCommittee,
+		NextSyncCommittee:            a.nextSyncCommittee,
+		LatestExecutionPayloadHeader: payload,
+	}, nil
+}
+
+func BeaconStateCapellaFromConsensus(st state.BeaconState) (*BeaconStateCapella, error) {
+	f, err := stateFieldsFromConsensus(st)
+	if err != nil {
+		return nil, err
+	}
+	a, err := altairFieldsFromConsensus(st)
+	if err != nil {
+		return nil, err
+	}
+	c, err := capellaFieldsFromConsensus(st)
+	if err != nil {
+		return nil, err
+	}
+	execData, err := st.LatestExecutionPayloadHeader()
+	if err != nil {
+		return nil, err
+	}
+	srcPayload, ok := execData.Proto().(*enginev1.ExecutionPayloadHeaderCapella)
+	if !ok {
+		return nil, errPayloadHeaderNotFound
+	}
+	payload, err := ExecutionPayloadHeaderCapellaFromConsensus(srcPayload)
+	if err != nil {
+		return nil, err
+	}
+	return &BeaconStateCapella{
+		GenesisTime:                  f.genesisTime,
+		GenesisValidatorsRoot:        f.genesisValidatorsRoot,
+		Slot:                         f.slot,
+		Fork:                         f.fork,
+		LatestBlockHeader:            f.latestBlockHeader,
+		BlockRoots:                   f.blockRoots,
+		StateRoots:                   f.stateRoots,
+		HistoricalRoots:              f.historicalRoots,
+		Eth1Data:                     f.eth1Data,
+		Eth1DataVotes:                f.eth1DataVotes,
+		Eth1DepositIndex:             f.eth1DepositIndex,
+		Validators:                   f.validators,
+		Balances:                     f.balances,
+		RandaoMixes:                  f.randaoMixes,
+		Slashings:                    f.slashings,
+		PreviousEpochParticipation:   a.previousEpochParticipation,
+		CurrentEpochParticipation:    a.currentEpochParticipation,
+		JustificationBits:            f.justificationBits,
+		PreviousJustifiedCheckpoint:  f.previousJustifiedCheckpoint,
+		CurrentJustifiedCheckpoint:   f.currentJustifiedCheckpoint,
+		FinalizedCheckpoint:          f.finalizedCheckpoint,
+		InactivityScores:             a.inactivityScores,
+		CurrentSyncCommittee:         a.currentSyncCommittee,
+		NextSyncCommittee:            a.nextSyncCommittee,
+		LatestExecutionPayloadHeader: payload,
+		NextWithdrawalIndex:          c.nextWithdrawalIndex,
+		NextWithdrawalValidatorIndex: c.nextWithdrawalValidatorIndex,
+		HistoricalSummaries:          c.historicalSummaries,
+	}, nil
+}
+
+func BeaconStateDenebFromConsensus(st state.BeaconState) (*BeaconStateDeneb, error) {
+	f, err := stateFieldsFromConsensus(st)
+	if err != nil {
+		return nil, err
+	}
+	a, err := altairFieldsFromConsensus(st)
+	if err != nil {
+		return nil, err
+	}
+	c, err := capellaFieldsFromConsensus(st)
+	if err != nil {
+		return nil, err
+	}
+	execData, err := st.LatestExecutionPayloadHeader()
+	if err != nil {
+		return nil, err
+	}
+	srcPayload, ok := execData.Proto().(*enginev1.ExecutionPayloadHeaderDeneb)
+	if !ok {
+		return nil, errPayloadHeaderNotFound
+	}
+	payload, err := ExecutionPayloadHeaderDenebFromConsensus(srcPayload)
+	if err != nil {
+		return nil, err
+	}
+	return &BeaconStateDeneb{
+		GenesisTime:                  f.genesisTime,
+		GenesisValidatorsRoot:        f.genesisValidatorsRoot,
+		Slot:                         f.slot,
+		Fork:                         f.fork,
+		LatestBlockHeader:            f.latestBlockHeader,
+		BlockRoots:                   f.blockRoots,
+		StateRoots:                   f.stateRoots,
+		HistoricalRoots:              f.historicalRoots,
+		Eth1Data:                     f.eth1Data,
+		Eth1DataVotes:                f.eth1DataVotes,
+		Eth1DepositIndex:             f.eth1DepositIndex,
+		Validators:                   f.validators,
+		Balances:                     f.balances,
+		RandaoMixes:                  f.randaoMixes,
+		Slashings:                    f.slashings,
+		PreviousEpochParticipation:   a.previousEpochParticipation,
+		CurrentEpochParticipation:    a.currentEpochParticipation,
+		JustificationBits:            f.justificationBits,
+		PreviousJustifiedCheckpoint:  f.previousJustifiedCheckpoint,
+		CurrentJustifiedCheckpoint:   f.currentJustifiedCheckpoint,
+		FinalizedCheckpoint:          f.finalizedCheckpoint,
+		InactivityScores:             a.inactivityScores,
+		CurrentSyncCommittee:         a.currentSyncCommittee,
+		NextSyncCommittee:            a.nextSyncCommittee,
+		LatestExecutionPayloadHeader: payload,
+		NextWithdrawalIndex:          c.nextWithdrawalIndex,
+		NextWithdrawalValidatorIndex: c.nextWithdrawalValidatorIndex,
+		HistoricalSummaries:          c.historicalSummaries,
+	}, nil
+}
+
+func ExecutionPayloadHeaderFromConsensus(h *enginev1.ExecutionPayloadHeader) (*ExecutionPayloadHeader, error) {
+	baseFeePerGas, err := sszBytesToUint256String(h.BaseFeePerGas)
+	if err != nil {
+		return nil, err
+	}
+	return &ExecutionPayloadHeader{
+		ParentHash:       hexutil.Encode(h.ParentHash),
+		FeeRecipient:     hexutil.Encode(h.FeeRecipient),
+		StateRoot:        hexutil.Encode(h.StateRoot),
+		ReceiptsRoot:     hexutil.Encode(h.ReceiptsRoot),
+		LogsBloom:        hexutil.Encode(h.LogsBloom),
+		PrevRandao:       hexutil.Encode(h.PrevRandao),
+		BlockNumber:      fmt.Sprintf("%d", h.BlockNumber),
+		GasLimit:         fmt.Sprintf("%d", h.GasLimit),
+		GasUsed:          fmt.Sprintf("%d", h.GasUsed),
+		Timestamp:        fmt.Sprintf("%d", h.Timestamp),
+		ExtraData:        hexutil.Encode(h.ExtraData),
+		BaseFeePerGas:    baseFeePerGas,
+		BlockHash:        hexutil.Encode(h.BlockHash),
+		TransactionsRoot: hexutil.Encode(h.TransactionsRoot),
+	}, nil
+}
+
+func ExecutionPayloadHeaderCapellaFromConsensus(h *enginev1.ExecutionPayloadHeaderCapella) (*ExecutionPayloadHeaderCapella, error) {
+	baseFeePerGas, err := sszBytesToUint256String(h.BaseFeePerGas)
+	if err != nil {
+		return nil, err
+	}
+	return &ExecutionPayloadHeaderCapella{
+		ParentHash:       hexutil.Encode(h.ParentHash),
+		FeeRecipient:     hexutil.Encode(h.FeeRecipient),
+		StateRoot:        hexutil.Encode(h.StateRoot),
+		ReceiptsRoot:     hexutil.Encode(h.ReceiptsRoot),
+		LogsBloom:        hexutil.Encode(h.LogsBloom),
+		PrevRandao:       hexutil.Encode(h.PrevRandao),
+		BlockNumber:      fmt.Sprintf("%d", h.BlockNumber),
+		GasLimit:         fmt.Sprintf("%d", h.GasLimit),
+		GasUsed:          fmt.Sprintf("%d", h.GasUsed),
+		Timestamp:        fmt.Sprintf("%d", h.Timestamp),
+		ExtraData:        hexutil.Encode(h.ExtraData),
+		BaseFeePerGas:    baseFeePerGas,
+		BlockHash:        hexutil.Encode(h.BlockHash),
+		TransactionsRoot: hexutil.Encode(h.TransactionsRoot),
+		WithdrawalsRoot:  hexutil.Encode(h.WithdrawalsRoot),
+	}, nil
+}
+
+func ExecutionPayloadHeaderDenebFromConsensus(h *enginev1.ExecutionPayloadHeaderDeneb) (*ExecutionPayloadHeaderDeneb, error) {
+	baseFeePerGas, err := sszBytesToUint256String(h.BaseFeePerGas)
+	if err != nil {
+		return nil, err
+	}
+	return &ExecutionPayloadHeaderDeneb{
+		ParentHash:       hexutil.Encode(h.ParentHash),
+		FeeRecipient:     hexutil.Encode(h.FeeRecipient),
+		StateRoot:        hexutil.Encode(h.StateRoot),
+		ReceiptsRoot:     hexutil.Encode(h.ReceiptsRoot),
+		LogsBloom:        hexutil.Encode(h.LogsBloom),
+		PrevRandao:       hexutil.Encode(h.PrevRandao),
+		BlockNumber:      fmt.Sprintf("%d", h.BlockNumber),
+		GasLimit:         fmt.Sprintf("%d", h.GasLimit),
+		GasUsed:          fmt.Sprintf("%d", h.GasUsed),
+		Timestamp:        fmt.Sprintf("%d", h.Timestamp),
+		ExtraData:        hexutil.Encode(h.ExtraData),
+		BaseFeePerGas:    baseFeePerGas,
+		BlobGasUsed:      fmt.Sprintf("%d", h.BlobGasUsed),
+		ExcessBlobGas:    fmt.Sprintf("%d", h.ExcessBlobGas),
+		BlockHash:        hexutil.Encode(h.BlockHash),
+		TransactionsRoot: hexutil.Encode(h.TransactionsRoot),
+		WithdrawalsRoot:  hexutil.Encode(h.WithdrawalsRoot),
+	}, nil
+}
+
+func ValidatorFromConsensus(v *eth.Validator) *Validator {
+	return &Validator{
+		Pubkey:                     hexutil.Encode(v.PublicKey),
+		WithdrawalCredentials:      hexutil.Encode(v.WithdrawalCredentials),
+		EffectiveBalance:           fmt.Sprintf("%d", v.EffectiveBalance),
+		Slashed:                    v.Slashed,
+		ActivationEligibilityEpoch: fmt.Sprintf("%d", v.ActivationEligibilityEpoch),
+		ActivationEpoch:            fmt.Sprintf("%d", v.ActivationEpoch),
+		ExitEpoch:                  fmt.Sprintf("%d", v.ExitEpoch),
+		WithdrawableEpoch:          fmt.Sprintf("%d", v.WithdrawableEpoch),
+	}
+}
+
+func SyncCommitteeFromConsensus(sc *eth.SyncCommittee) *SyncCommittee {
+	return &SyncCommittee{
+		Pubkeys:         hexSlice(sc.Pubkeys),
+		AggregatePubkey: hexutil.Encode(sc.AggregatePubkey),
+	}
+}
+
+func stateFieldsFromConsensus(st state.BeaconState) (*stateFields, error) {
+	srcHistoricalRoots, err := st.HistoricalRoots()
+	if err != nil {
+		return nil, err
+	}
+	fork := st.Fork()
+	eth1Data := st.Eth1Data()
+	srcVotes := st.Eth1DataVotes()
+	votes := make([]*Eth1Data, len(srcVotes))
+	for i, v := range srcVotes {
+		votes[i] = eth1DataFromConsensus(v)
+	}
+	srcVals := st.Validators()
+	vals := make([]*Validator, len(srcVals))
+	for i, v := range srcVals {
+		vals[i] = ValidatorFromConsensus(v)
+	}
+	return &stateFields{
+		genesisTime:           fmt.Sprintf("%d", st.GenesisTime()),
+		genesisValidatorsRoot: hexutil.Encode(st.GenesisValidatorsRoot()),
+		slot:                  fmt.Sprintf("%d", st.Slot()),
+		fork: &Fork{
+			PreviousVersion: hexutil.Encode(fork.PreviousVersion),
+			CurrentVersion:  hexutil.Encode(fork.CurrentVersion),
+			Epoch:           fmt.Sprintf("%d", fork.Epoch),
+		},
+		latestBlockHeader:           BeaconBlockHeaderFromConsensus(st.LatestBlockHeader()),
+		blockRoots:                  hexSlice(st.BlockRoots()),
+		stateRoots:                  hexSlice(st.StateRoots()),
+		historicalRoots:             hexSlice(srcHistoricalRoots),
+		eth1Data:                    eth1DataFromConsensus(eth1Data),
+		eth1DataVotes:               votes,
+		eth1DepositIndex:            fmt.Sprintf("%d", st.Eth1DepositIndex()),
+		validators:                  vals,
+		balances:                    uint64Slice(st.Balances()),
+		randaoMixes:                 hexSlice(st.RandaoMixes()),
+		slashings:                   uint64Slice(st.Slashings()),
+		justificationBits:           hexutil.Encode(st.JustificationBits()),
+		previousJustifiedCheckpoint: CheckpointFromConsensus(st.PreviousJustifiedCheckpoint()),
+		currentJustifiedCheckpoint:  CheckpointFromConsensus(st.CurrentJustifiedCheckpoint()),
+		finalizedCheckpoint:         CheckpointFromConsensus(st.FinalizedCheckpoint()),
+	}, nil
+}
+
+func altairFieldsFromConsensus(st state.BeaconState) (*altairFields, error) {
+	srcPrevPart, err := st.PreviousEpochParticipation()
+	if err != nil {
+		return nil, err
+	}
+	srcCurrPart, err := st.CurrentEpochParticipation()
+	if err != nil {
+		return nil, err
+	}
+	srcScores, err := st.InactivityScores()
+	if err != nil {
+		return nil, err
+	}
+	currSc, err := st.CurrentSyncCommittee()
+	if err != nil {
+		return nil, err
+	}
+	nextSc, err := st.NextSyncCommittee()
+	if err != nil {
+		return nil, err
+	}
+	return &altairFields{
+		previousEpochParticipation: participationFromConsensus(srcPrevPart),
+		currentEpochParticipation:  participationFromConsensus(srcCurrPart),
+		inactivityScores:           uint64Slice(srcScores),
+		currentSyncCommittee:       SyncCommitteeFromConsensus(currSc),
+		nextSyncCommittee:          SyncCommitteeFromConsensus(nextSc),
+	}, nil
+}
+
+func capellaFieldsFromConsensus(st state.BeaconState) (*capellaFields, error) {
+	nwi, err := st.NextWithdrawalIndex()
+	if err != nil {
+		return nil, err
+	}
+	nwvi, err := st.NextWithdrawalValidatorIndex()
+	if err != nil {
+		return nil, err
+	}
+	srcSummaries, err := st.HistoricalSummaries()
+	if err != nil {
+		return nil, err
+	}
+	summaries := make([]*HistoricalSummary, len(srcSummaries))
+	for i, s := range srcSummaries {
+		summaries[i] = &HistoricalSummary{
+			BlockSummaryRoot: hexutil.Encode(s.BlockSummaryRoot),
+			StateSummaryRoot: hexutil.Encode(s.StateSummaryRoot),
+		}
+	}
+	return &capellaFields{
+		nextWithdrawalIndex:          fmt.Sprintf("%d", nwi),
+		nextWithdrawalValidatorIndex: fmt.Sprintf("%d", nwvi),
+		historicalSummaries:          summaries,
+	}, nil
+}
+
+func pendingAttsFromConsensus(src []*eth.PendingAttestation) []*PendingAttestation {
+	atts := make([]*PendingAttestation, len(src))
+	for i, a := range src {
+		atts[i] = &PendingAttestation{
+			AggregationBits: hexutil.Encode(a.AggregationBits),
+			Data:            AttestationDataFromConsensus(a.Data),
+			InclusionDelay:  fmt.Sprintf("%d", a.InclusionDelay),
+			ProposerIndex:   fmt.Sprintf("%d", a.ProposerIndex),
+		}
+	}
+	return atts
+}
+
+func eth1DataFromConsensus(e *eth.Eth1Data) *Eth1Data {
+	return &Eth1Data{
+		DepositRoot:  hexutil.Encode(e.DepositRoot),
+		DepositCount: fmt.Sprintf("%d", e.DepositCount),
+		BlockHash:    hexutil.Encode(e.BlockHash),
+	}
+}
+
+func participationFromConsensus(src []byte) []string {
+	p := make([]string, len(src))
+	for i, b := range src {
+		p[i] = strconv.FormatUint(uint64(b), 10)
+	}
+	return p
+}
+
+func hexSlice(src [][]byte) []string {
+	s := make([]string, len(src))
+	for i, b := range src {
+		s[i] = hexutil.Encode(b)
+	}
+	return s
+}
+
+func uint64Slice(src []uint64) []string {
+	s := make([]string, len(src))
+	for i, v := range src {
+		s[i] = strconv.FormatUint(v, 10)
+	}
+	return s
+}
@@ -4,12 +4,15 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"math/big"
 	"net/http"
 	"strings"
 
 	"github.com/pkg/errors"
+	fssz "github.com/prysmaticlabs/fastssz"
 	"github.com/prysmaticlabs/prysm/v4/api"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/rpc/eth/shared"
+	"github.com/prysmaticlabs/prysm/v4/config/features"
 	fieldparams "github.com/prysmaticlabs/prysm/v4/config/fieldparams"
 	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
 	http2 "github.com/prysmaticlabs/prysm/v4/network/http"
@@ -34,6 +37,60 @@ func (s *Server) ProduceBlockV3(w http.ResponseWriter, r *http.Request) {
 	if shared.IsSyncing(r.Context(), w, s.SyncChecker, s.HeadFetcher, s.TimeFetcher, s.OptimisticModeFetcher) {
 		return
 	}
+	v1alpha1req, ok := blockRequestFromHttp(w, r)
+	if !ok {
+		return
+	}
+	s.produceBlockV3(ctx, w, r, v1alpha1req)
+}
+
+// ProduceBlockV2 requests the beacon node to produce a valid unsigned beacon block, which can then be signed by a proposer and submitted.
+// By definition `/eth/v2/validator/blocks/{slot}`, does not produce block using mev-boost and relayer network.
+// The following endpoint states that the returned object is a BeaconBlock, not a BlindedBeaconBlock. As such, the block must return a full ExecutionPayload:
+// https://ethereum.github.io/beacon-APIs/?urls.primaryName=v2.3.0#/Validator/produceBlockV2
+//
+// To use mev-boost and relayer network. It's recommended to use the following endpoint:
+// https://github.com/ethereum/beacon-APIs/blob/master/apis/validator/blinded_block.yaml
+func (s *Server) ProduceBlockV2(w http.ResponseWriter, r *http.Request) {
+	ctx, span := trace.StartSpan(r.Context(), "validator.ProduceBlockV2")
+	defer span.End()
+
+	if shared.IsSyncing(ctx, w, s.SyncChecker, s.HeadFetcher, s.TimeFetcher, s.OptimisticModeFetcher) {
+		return
+	}
+	v1alpha1req, ok := blockRequestFromHttp(w, r)
+	if !ok {
+		return
+	}
+	v1alpha1req.SkipMevBoost = true // Skip mev-boost and relayer network
+	s.produceBlockV2(ctx, w, r, v1alpha1req, false)
+}
+
+// ProduceBlindedBlock requests the beacon node to produce a valid unsigned blinded beacon block,
+// which can then be signed by a proposer and submitted.
+//
+// Pre-Bellatrix, this endpoint will return a regular block.
+func (s *Server) ProduceBlindedBlock(w http.ResponseWriter, r *http.Request) {
+	ctx, span := trace.StartSpan(r.Context(), "validator.ProduceBlindedBlock")
+	defer span.End()
+
+	if !s.BlockBuilder.Configured() {
+		http2.HandleError(w, "Block builder not configured", http.StatusInternalServerError)
+		return
+	}
+	if shared.IsSyncing(ctx, w, s.SyncChecker, s.HeadFetcher, s.TimeFetcher, s.OptimisticModeFetcher) {
+		return
+	}
+	v1alpha1req, ok := blockRequestFromHttp(w, r)
+	if !ok {
+		return
+	}
+	s.produceBlockV2(ctx, w, r, v1alpha1req, true)
+}
+
+// blockRequestFromHttp builds a block request out of the slot in the URL path and the randao_reveal,
+// graffiti and skip_randao_verification query parameters.
+func blockRequestFromHttp(w http.ResponseWriter, r *http.Request) (*eth.BlockRequest, bool) {
 	segments := strings.Split(r.URL.Path, "/")
 	rawSlot := segments[len(segments)-1]
 	rawRandaoReveal := r.URL.Query().Get("randao_reveal")
@@ -42,7 +99,7 @@ func (s *Server) ProduceBlockV3(w http.ResponseWriter, r *http.Request) {
 
 	slot, valid := shared.ValidateUint(w, "slot", rawSlot)
 	if !valid {
-		return
+		return nil, false
 	}
 
 	var randaoReveal []byte
@@ -52,7 +109,7 @@ func (s *Server) ProduceBlockV3(w http.ResponseWriter, r *http.Request) {
 		rr, err := shared.DecodeHexWithLength(rawRandaoReveal, fieldparams.BLSSignatureLength)
 		if err != nil {
 			http2.HandleError(w, errors.Wrap(err, "unable to decode randao reveal").Error(), http.StatusBadRequest)
-			return
+			return nil, false
 		}
 		randaoReveal = rr
 	}
@@ -61,16 +118,123 @@ func (s *Server) ProduceBlockV3(w http.ResponseWriter, r *http.Request) {
 		g, err := shared.DecodeHexWithLength(rawGraffiti, 32)
 		if err != nil {
 			http2.HandleError(w, errors.Wrap(err, "unable to decode graffiti").Error(), http.StatusBadRequest)
-			return
+			return nil, false
 		}
 		graffiti = g
 	}
 
-	s.produceBlockV3(ctx, w, r, &eth.BlockRequest{
+	return &eth.BlockRequest{
 		Slot:         primitives.Slot(slot),
 		RandaoReveal: randaoReveal,
 		Graffiti:     graffiti,
-		SkipMevBoost: false,
+	}, true
+}
+
+// produceBlockV2 writes out the block produced by the v1alpha1 server. It is shared by the full and blinded
+// endpoints, which only differ in whether a blinded block is expected after Bellatrix.
+func (s *Server) produceBlockV2(ctx context.Context, w http.ResponseWriter, r *http.Request, v1alpha1req *eth.BlockRequest, blinded bool) {
+	v1alpha1resp, err := s.V1Alpha1Server.GetBeaconBlock(ctx, v1alpha1req)
+	if err != nil {
+		http2.HandleError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	var (
+		v         int
+		isBlinded bool
+		sszBlk    fssz.Marshaler
+		jsonBlk   func() (interface{}, error)
+	)
+	switch b := v1alpha1resp.Block.(type) {
+	case *eth.GenericBeaconBlock_Phase0:
+		v, sszBlk = version.Phase0, b.Phase0
+		jsonBlk = func() (interface{}, error) {
+			return shared.BeaconBlockFromConsensus(b.Phase0)
+		}
+	case *eth.GenericBeaconBlock_Altair:
+		v, sszBlk = version.Altair, b.Altair
+		jsonBlk = func() (interface{}, error) {
+			return shared.BeaconBlockAltairFromConsensus(b.Altair)
+		}
+	case *eth.GenericBeaconBlock_Bellatrix:
+		v, sszBlk = version.Bellatrix, b.Bellatrix
+		jsonBlk = func() (interface{}, error) {
+			return shared.BeaconBlockBellatrixFromConsensus(b.Bellatrix)
+		}
+	case *eth.GenericBeaconBlock_BlindedBellatrix:
+		v, isBlinded, sszBlk = version.Bellatrix, true, b.BlindedBellatrix
+		jsonBlk = func() (interface{}, error) {
+			return shared.BlindedBeaconBlockBellatrixFromConsensus(b.BlindedBellatrix)
+		}
+	case *eth.GenericBeaconBlock_Capella:
+		v, sszBlk = version.Capella, b.Capella
+		jsonBlk = func() (interface{}, error) {
+			return shared.BeaconBlockCapellaFromConsensus(b.Capella)
+		}
+	case *eth.GenericBeaconBlock_BlindedCapella:
+		v, isBlinded, sszBlk = version.Capella, true, b.BlindedCapella
+		jsonBlk = func() (interface{}, error) {
+			return shared.BlindedBeaconBlockCapellaFromConsensus(b.BlindedCapella)
+		}
+	case *eth.GenericBeaconBlock_Deneb:
+		v, sszBlk = version.Deneb, b.Deneb
+		jsonBlk = func() (interface{}, error) {
+			return shared.BeaconBlockContentsDenebFromConsensus(b.Deneb)
+		}
+	case *eth.GenericBeaconBlock_BlindedDeneb:
+		v, isBlinded, sszBlk = version.Deneb, true, b.BlindedDeneb
+		jsonBlk = func() (interface{}, error) {
+			return shared.BlindedBeaconBlockContentsDenebFromConsensus(b.BlindedDeneb)
+		}
+	default:
+		http2.HandleError(w, fmt.Sprintf("Unsupported block type %T", v1alpha1resp.Block), http.StatusInternalServerError)
+		return
+	}
+
+	if v >= version.Bellatrix {
+		optimistic, err := s.OptimisticModeFetcher.IsOptimistic(ctx)
+		if err != nil {
+			http2.HandleError(w, errors.Wrap(err, "Could not determine if the node is a optimistic node").Error(), http.StatusInternalServerError)
+			return
+		}
+		if optimistic {
+			http2.HandleError(w, "The node is currently optimistic and cannot serve validators", http.StatusServiceUnavailable)
+			return
+		}
+		if isBlinded && !blinded {
+			http2.HandleError(w, fmt.Sprintf("Prepared %s beacon block is blinded", version.String(v)), http.StatusInternalServerError)
+			return
+		}
+		if !isBlinded && blinded {
+			http2.HandleError(w, fmt.Sprintf("Prepared %s beacon block is not blinded", version.String(v)), http.StatusInternalServerError)
+			return
+		}
+	}
+
+	w.Header().Set(api.VersionHeader, version.String(v))
+	setConsensusBlockValueHeader(w, v1alpha1resp)
+	if http2.SszRequested(r) {
+		sszResp, err := sszBlk.MarshalSSZ()
+		if err != nil {
+			http2.HandleError(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		http2.WriteSsz(w, sszResp, "block.ssz")
+		return
+	}
+	block, err := jsonBlk()
+	if err != nil {
+		http2.HandleError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	jsonBytes, err := json.Marshal(block)
+	if err != nil {
+		http2.HandleError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	http2.WriteJson(w, &ProduceBlockV2Response{
+		Version: version.String(v),
+		Data:    jsonBytes,
 	})
 }
 
@@ -86,6 +250,7 @@ func (s *Server) produceBlockV3(ctx context.Context, w http.ResponseWriter, r *h
 	}
 	w.Header().Set(api.ExecutionPayloadBlindedHeader, fmt.Sprintf("%v", v1alpha1resp.IsBlinded))
 	w.Header().Set(api.ExecutionPayloadValueHeader, fmt.Sprintf("%d", v1alpha1resp.PayloadValue))
+	setConsensusBlockValueHeader(w, v1alpha1resp)
 	phase0Block, ok := v1alpha1resp.Block.(*eth.GenericBeaconBlock_Phase0)
 	if ok {
 		handleProducePhase0V3(ctx, w, isSSZ, phase0Block, v1alpha1resp.PayloadValue)
@@ -137,6 +302,17 @@ func (s *Server) produceBlockV3(ctx context.Context, w http.ResponseWriter, r *h
 	}
 }
 
+// setConsensusBlockValueHeader reports the expected proposer reward in Wei for the attestations included in the
+// block. The reward is only computed when reward-aware attestation packing is enabled, and it does not account for
+// the other consensus rewards of the block.
+func setConsensusBlockValueHeader(w http.ResponseWriter, blk *eth.GenericBeaconBlock) {
+	if !features.Get().EnableRewardAwareAttestationPacking {
+		return
+	}
+	value := new(big.Int).Mul(new(big.Int).SetUint64(blk.AttestationReward), big.NewInt(1e9))
+	w.Header().Set(api.ConsensusBlockValueHeader, value.String())
+}
+
 func handleProducePhase0V3(
 	ctx context.Context,
 	w http.ResponseWriter,
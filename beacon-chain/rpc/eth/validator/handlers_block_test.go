@@ -12,9 +12,11 @@ import (
 	"github.com/golang/mock/gomock"
 	"github.com/prysmaticlabs/prysm/v4/api"
 	blockchainTesting "github.com/prysmaticlabs/prysm/v4/beacon-chain/blockchain/testing"
+	builderTest "github.com/prysmaticlabs/prysm/v4/beacon-chain/builder/testing"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/rpc/eth/shared"
 	rpctesting "github.com/prysmaticlabs/prysm/v4/beacon-chain/rpc/eth/shared/testing"
 	mockSync "github.com/prysmaticlabs/prysm/v4/beacon-chain/sync/initial-sync/testing"
+	"github.com/prysmaticlabs/prysm/v4/config/features"
 	eth "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/v4/testing/assert"
 	mock2 "github.com/prysmaticlabs/prysm/v4/testing/mock"
@@ -263,6 +265,36 @@ func TestProduceBlockV3(t *testing.T) {
 		require.Equal(t, writer.Header().Get(api.ExecutionPayloadBlindedHeader) == "true", true)
 		require.Equal(t, writer.Header().Get(api.ExecutionPayloadValueHeader) == "0", true)
 	})
+	t.Run("consensus block value", func(t *testing.T) {
+		var block *shared.SignedBeaconBlockAltair
+		require.NoError(t, json.Unmarshal([]byte(rpctesting.AltairBlock), &block))
+		g, err := block.Message.ToGeneric()
+		require.NoError(t, err)
+		g.AttestationReward = 3
+		v1alpha1Server := mock2.NewMockBeaconNodeValidatorServer(ctrl)
+		v1alpha1Server.EXPECT().GetBeaconBlock(gomock.Any(), gomock.Any()).Return(g, nil).Times(2)
+		server := &Server{
+			V1Alpha1Server: v1alpha1Server,
+			SyncChecker:    &mockSync.Sync{IsSyncing: false},
+		}
+		rr := "0x1b66ac1fb663c9bc59509846d6ec05345bd908eda73e670af888da41af171505cc411d61252fb6cb3fa0017b679f8bb2305b26a285fa2737f175668d0dff91cc1b66ac1fb663c9bc59509846d6ec05345bd908eda73e670af888da41af171505" +
+			"&graffiti=0xcf8e0d4e9587369b2301d0790347320302cc0943d5a1884560367e8208d920f2"
+		request := httptest.NewRequest(http.MethodGet, fmt.Sprintf("http://foo.example/eth/v3/validator/blocks/1?randao_reveal=%s", rr), nil)
+
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+		server.ProduceBlockV3(writer, request)
+		assert.Equal(t, http.StatusOK, writer.Code)
+		assert.Equal(t, "", writer.Header().Get(api.ConsensusBlockValueHeader))
+
+		resetCfg := features.InitWithReset(&features.Flags{EnableRewardAwareAttestationPacking: true})
+		defer resetCfg()
+		writer = httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+		server.ProduceBlockV3(writer, request)
+		assert.Equal(t, http.StatusOK, writer.Code)
+		assert.Equal(t, "3000000000", writer.Header().Get(api.ConsensusBlockValueHeader))
+	})
 	t.Run("invalid query parameter slot empty", func(t *testing.T) {
 		v1alpha1Server := mock2.NewMockBeaconNodeValidatorServer(ctrl)
 		server := &Server{
@@ -585,3 +617,305 @@ func TestProduceBlockV3SSZ(t *testing.T) {
 		require.Equal(t, writer.Header().Get(api.ExecutionPayloadValueHeader) == "0", true)
 	})
 }
+
+func produceBlockV2TestCases(t *testing.T) []struct {
+	name     string
+	version  string
+	blinded  bool
+	block    *eth.GenericBeaconBlock
+	jsonData []byte
+} {
+	var phase0Block *shared.SignedBeaconBlock
+	require.NoError(t, json.Unmarshal([]byte(rpctesting.Phase0Block), &phase0Block))
+	var altairBlock *shared.SignedBeaconBlockAltair
+	require.NoError(t, json.Unmarshal([]byte(rpctesting.AltairBlock), &altairBlock))
+	var bellatrixBlock *shared.SignedBeaconBlockBellatrix
+	require.NoError(t, json.Unmarshal([]byte(rpctesting.BellatrixBlock), &bellatrixBlock))
+	var blindedBellatrixBlock *shared.SignedBlindedBeaconBlockBellatrix
+	require.NoError(t, json.Unmarshal([]byte(rpctesting.BlindedBellatrixBlock), &blindedBellatrixBlock))
+	var capellaBlock *shared.SignedBeaconBlockCapella
+	require.NoError(t, json.Unmarshal([]byte(rpctesting.CapellaBlock), &capellaBlock))
+	var blindedCapellaBlock *shared.SignedBlindedBeaconBlockCapella
+	require.NoError(t, json.Unmarshal([]byte(rpctesting.BlindedCapellaBlock), &blindedCapellaBlock))
+	var denebBlockContents *shared.SignedBeaconBlockContentsDeneb
+	require.NoError(t, json.Unmarshal([]byte(rpctesting.DenebBlockContents), &denebBlockContents))
+	var blindedDenebBlockContents *shared.SignedBlindedBeaconBlockContentsDeneb
+	require.NoError(t, json.Unmarshal([]byte(rpctesting.BlindedDenebBlockContents), &blindedDenebBlockContents))
+
+	generic := func(f func() (*eth.GenericBeaconBlock, error)) *eth.GenericBeaconBlock {
+		g, err := f()
+		require.NoError(t, err)
+		return g
+	}
+	marshal := func(v interface{}) []byte {
+		b, err := json.Marshal(v)
+		require.NoError(t, err)
+		return b
+	}
+	return []struct {
+		name     string
+		version  string
+		blinded  bool
+		block    *eth.GenericBeaconBlock
+		jsonData []byte
+	}{
+		{
+			name:     "Phase 0",
+			version:  "phase0",
+			block:    generic(phase0Block.Message.ToGeneric),
+			jsonData: marshal(phase0Block.Message),
+		},
+		{
+			name:     "Altair",
+			version:  "altair",
+			block:    generic(altairBlock.Message.ToGeneric),
+			jsonData: marshal(altairBlock.Message),
+		},
+		{
+			name:     "Bellatrix",
+			version:  "bellatrix",
+			block:    generic(bellatrixBlock.Message.ToGeneric),
+			jsonData: marshal(bellatrixBlock.Message),
+		},
+		{
+			name:     "Blinded Bellatrix",
+			version:  "bellatrix",
+			blinded:  true,
+			block:    generic(blindedBellatrixBlock.Message.ToGeneric),
+			jsonData: marshal(blindedBellatrixBlock.Message),
+		},
+		{
+			name:     "Capella",
+			version:  "capella",
+			block:    generic(capellaBlock.Message.ToGeneric),
+			jsonData: marshal(capellaBlock.Message),
+		},
+		{
+			name:     "Blinded Capella",
+			version:  "capella",
+			blinded:  true,
+			block:    generic(blindedCapellaBlock.Message.ToGeneric),
+			jsonData: marshal(blindedCapellaBlock.Message),
+		},
+		{
+			name:     "Deneb",
+			version:  "deneb",
+			block:    generic(denebBlockContents.ToUnsigned().ToGeneric),
+			jsonData: marshal(denebBlockContents.ToUnsigned()),
+		},
+		{
+			name:     "Blinded Deneb",
+			version:  "deneb",
+			blinded:  true,
+			block:    generic(blindedDenebBlockContents.ToUnsigned().ToGeneric),
+			jsonData: marshal(blindedDenebBlockContents.ToUnsigned()),
+		},
+	}
+}
+
+func TestProduceBlockV2(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	rr := "0x1b66ac1fb663c9bc59509846d6ec05345bd908eda73e670af888da41af171505cc411d61252fb6cb3fa0017b679f8bb2305b26a285fa2737f175668d0dff91cc1b66ac1fb663c9bc59509846d6ec05345bd908eda73e670af888da41af171505" +
+		"&graffiti=0xcf8e0d4e9587369b2301d0790347320302cc0943d5a1884560367e8208d920f2"
+	for _, tc := range produceBlockV2TestCases(t) {
+		t.Run(tc.name, func(t *testing.T) {
+			v1alpha1Server := mock2.NewMockBeaconNodeValidatorServer(ctrl)
+			v1alpha1Server.EXPECT().GetBeaconBlock(gomock.Any(), gomock.Any()).Return(tc.block, nil)
+			server := &Server{
+				V1Alpha1Server:        v1alpha1Server,
+				SyncChecker:           &mockSync.Sync{IsSyncing: false},
+				OptimisticModeFetcher: &blockchainTesting.ChainService{},
+			}
+			request := httptest.NewRequest(http.MethodGet, fmt.Sprintf("http://foo.example/eth/v2/validator/blocks/1?randao_reveal=%s", rr), nil)
+			writer := httptest.NewRecorder()
+			writer.Body = &bytes.Buffer{}
+			server.ProduceBlockV2(writer, request)
+			if tc.blinded {
+				assert.Equal(t, http.StatusInternalServerError, writer.Code)
+				assert.Equal(t, true, strings.Contains(writer.Body.String(), fmt.Sprintf("Prepared %s beacon block is blinded", tc.version)))
+				return
+			}
+			assert.Equal(t, http.StatusOK, writer.Code)
+			want := fmt.Sprintf(`{"version":"%s","data":%s}`, tc.version, string(tc.jsonData))
+			body := strings.ReplaceAll(writer.Body.String(), "\n", "")
+			require.Equal(t, want, body)
+			assert.Equal(t, tc.version, writer.Header().Get(api.VersionHeader))
+		})
+	}
+	t.Run("SSZ", func(t *testing.T) {
+		var block *shared.SignedBeaconBlockCapella
+		require.NoError(t, json.Unmarshal([]byte(rpctesting.CapellaBlock), &block))
+		g, err := block.Message.ToGeneric()
+		require.NoError(t, err)
+		v1alpha1Server := mock2.NewMockBeaconNodeValidatorServer(ctrl)
+		v1alpha1Server.EXPECT().GetBeaconBlock(gomock.Any(), gomock.Any()).Return(g, nil)
+		server := &Server{
+			V1Alpha1Server:        v1alpha1Server,
+			SyncChecker:           &mockSync.Sync{IsSyncing: false},
+			OptimisticModeFetcher: &blockchainTesting.ChainService{},
+		}
+		request := httptest.NewRequest(http.MethodGet, fmt.Sprintf("http://foo.example/eth/v2/validator/blocks/1?randao_reveal=%s", rr), nil)
+		request.Header.Set("Accept", "application/octet-stream")
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+		server.ProduceBlockV2(writer, request)
+		assert.Equal(t, http.StatusOK, writer.Code)
+		ssz, err := g.Block.(*eth.GenericBeaconBlock_Capella).Capella.MarshalSSZ()
+		require.NoError(t, err)
+		require.Equal(t, string(ssz), writer.Body.String())
+		assert.Equal(t, "capella", writer.Header().Get(api.VersionHeader))
+	})
+	t.Run("consensus block value", func(t *testing.T) {
+		resetCfg := features.InitWithReset(&features.Flags{EnableRewardAwareAttestationPacking: true})
+		defer resetCfg()
+		var block *shared.SignedBeaconBlockCapella
+		require.NoError(t, json.Unmarshal([]byte(rpctesting.CapellaBlock), &block))
+		g, err := block.Message.ToGeneric()
+		require.NoError(t, err)
+		g.AttestationReward = 12
+		v1alpha1Server := mock2.NewMockBeaconNodeValidatorServer(ctrl)
+		v1alpha1Server.EXPECT().GetBeaconBlock(gomock.Any(), gomock.Any()).Return(g, nil)
+		server := &Server{
+			V1Alpha1Server:        v1alpha1Server,
+			SyncChecker:           &mockSync.Sync{IsSyncing: false},
+			OptimisticModeFetcher: &blockchainTesting.ChainService{},
+		}
+		request := httptest.NewRequest(http.MethodGet, fmt.Sprintf("http://foo.example/eth/v2/validator/blocks/1?randao_reveal=%s", rr), nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+		server.ProduceBlockV2(writer, request)
+		assert.Equal(t, http.StatusOK, writer.Code)
+		assert.Equal(t, "12000000000", writer.Header().Get(api.ConsensusBlockValueHeader))
+	})
+	t.Run("optimistic", func(t *testing.T) {
+		var block *shared.SignedBeaconBlockBellatrix
+		require.NoError(t, json.Unmarshal([]byte(rpctesting.BellatrixBlock), &block))
+		g, err := block.Message.ToGeneric()
+		require.NoError(t, err)
+		v1alpha1Server := mock2.NewMockBeaconNodeValidatorServer(ctrl)
+		v1alpha1Server.EXPECT().GetBeaconBlock(gomock.Any(), gomock.Any()).Return(g, nil)
+		server := &Server{
+			V1Alpha1Server:        v1alpha1Server,
+			SyncChecker:           &mockSync.Sync{IsSyncing: false},
+			OptimisticModeFetcher: &blockchainTesting.ChainService{Optimistic: true},
+		}
+		request := httptest.NewRequest(http.MethodGet, fmt.Sprintf("http://foo.example/eth/v2/validator/blocks/1?randao_reveal=%s", rr), nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+		server.ProduceBlockV2(writer, request)
+		assert.Equal(t, http.StatusServiceUnavailable, writer.Code)
+		assert.Equal(t, true, strings.Contains(writer.Body.String(), "The node is currently optimistic and cannot serve validators"))
+	})
+	t.Run("invalid query parameter slot invalid", func(t *testing.T) {
+		server := &Server{
+			SyncChecker: &mockSync.Sync{IsSyncing: false},
+		}
+		request := httptest.NewRequest(http.MethodGet, "http://foo.example/eth/v2/validator/blocks/asdfsad", nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+		server.ProduceBlockV2(writer, request)
+		assert.Equal(t, http.StatusBadRequest, writer.Code)
+		assert.Equal(t, true, strings.Contains(writer.Body.String(), "slot is invalid"))
+	})
+	t.Run("syncing", func(t *testing.T) {
+		chainService := &blockchainTesting.ChainService{}
+		server := &Server{
+			SyncChecker:           &mockSync.Sync{IsSyncing: true},
+			HeadFetcher:           chainService,
+			TimeFetcher:           chainService,
+			OptimisticModeFetcher: chainService,
+		}
+		request := httptest.NewRequest(http.MethodGet, "http://foo.example/eth/v2/validator/blocks/1", nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+		server.ProduceBlockV2(writer, request)
+		assert.Equal(t, http.StatusServiceUnavailable, writer.Code)
+		assert.Equal(t, true, strings.Contains(writer.Body.String(), "Beacon node is currently syncing and not serving request on that endpoint"))
+	})
+}
+
+func TestProduceBlindedBlock(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	rr := "0x1b66ac1fb663c9bc59509846d6ec05345bd908eda73e670af888da41af171505cc411d61252fb6cb3fa0017b679f8bb2305b26a285fa2737f175668d0dff91cc1b66ac1fb663c9bc59509846d6ec05345bd908eda73e670af888da41af171505" +
+		"&graffiti=0xcf8e0d4e9587369b2301d0790347320302cc0943d5a1884560367e8208d920f2"
+	for _, tc := range produceBlockV2TestCases(t) {
+		t.Run(tc.name, func(t *testing.T) {
+			v1alpha1Server := mock2.NewMockBeaconNodeValidatorServer(ctrl)
+			v1alpha1Server.EXPECT().GetBeaconBlock(gomock.Any(), gomock.Any()).Return(tc.block, nil)
+			server := &Server{
+				V1Alpha1Server:        v1alpha1Server,
+				SyncChecker:           &mockSync.Sync{IsSyncing: false},
+				OptimisticModeFetcher: &blockchainTesting.ChainService{},
+				BlockBuilder:          &builderTest.MockBuilderService{HasConfigured: true},
+			}
+			request := httptest.NewRequest(http.MethodGet, fmt.Sprintf("http://foo.example/eth/v1/validator/blinded_blocks/1?randao_reveal=%s", rr), nil)
+			writer := httptest.NewRecorder()
+			writer.Body = &bytes.Buffer{}
+			server.ProduceBlindedBlock(writer, request)
+			// Pre-Bellatrix blocks have no payload and are returned as they are.
+			if !tc.blinded && tc.version != "phase0" && tc.version != "altair" {
+				assert.Equal(t, http.StatusInternalServerError, writer.Code)
+				assert.Equal(t, true, strings.Contains(writer.Body.String(), fmt.Sprintf("Prepared %s beacon block is not blinded", tc.version)))
+				return
+			}
+			assert.Equal(t, http.StatusOK, writer.Code)
+			want := fmt.Sprintf(`{"version":"%s","data":%s}`, tc.version, string(tc.jsonData))
+			body := strings.ReplaceAll(writer.Body.String(), "\n", "")
+			require.Equal(t, want, body)
+			assert.Equal(t, tc.version, writer.Header().Get(api.VersionHeader))
+		})
+	}
+	t.Run("SSZ", func(t *testing.T) {
+		var block *shared.SignedBlindedBeaconBlockCapella
+		require.NoError(t, json.Unmarshal([]byte(rpctesting.BlindedCapellaBlock), &block))
+		g, err := block.Message.ToGeneric()
+		require.NoError(t, err)
+		v1alpha1Server := mock2.NewMockBeaconNodeValidatorServer(ctrl)
+		v1alpha1Server.EXPECT().GetBeaconBlock(gomock.Any(), gomock.Any()).Return(g, nil)
+		server := &Server{
+			V1Alpha1Server:        v1alpha1Server,
+			SyncChecker:           &mockSync.Sync{IsSyncing: false},
+			OptimisticModeFetcher: &blockchainTesting.ChainService{},
+			BlockBuilder:          &builderTest.MockBuilderService{HasConfigured: true},
+		}
+		request := httptest.NewRequest(http.MethodGet, fmt.Sprintf("http://foo.example/eth/v1/validator/blinded_blocks/1?randao_reveal=%s", rr), nil)
+		request.Header.Set("Accept", "application/octet-stream")
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+		server.ProduceBlindedBlock(writer, request)
+		assert.Equal(t, http.StatusOK, writer.Code)
+		ssz, err := g.Block.(*eth.GenericBeaconBlock_BlindedCapella).BlindedCapella.MarshalSSZ()
+		require.NoError(t, err)
+		require.Equal(t, string(ssz), writer.Body.String())
+		assert.Equal(t, "capella", writer.Header().Get(api.VersionHeader))
+	})
+	t.Run("builder not configured", func(t *testing.T) {
+		server := &Server{
+			BlockBuilder: &builderTest.MockBuilderService{HasConfigured: false},
+		}
+		request := httptest.NewRequest(http.MethodGet, "http://foo.example/eth/v1/validator/blinded_blocks/1", nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+		server.ProduceBlindedBlock(writer, request)
+		assert.Equal(t, http.StatusInternalServerError, writer.Code)
+		assert.Equal(t, true, strings.Contains(writer.Body.String(), "Block builder not configured"))
+	})
+	t.Run("syncing", func(t *testing.T) {
+		chainService := &blockchainTesting.ChainService{}
+		server := &Server{
+			SyncChecker:           &mockSync.Sync{IsSyncing: true},
+			HeadFetcher:           chainService,
+			TimeFetcher:           chainService,
+			OptimisticModeFetcher: chainService,
+			BlockBuilder:          &builderTest.MockBuilderService{HasConfigured: true},
+		}
+		request := httptest.NewRequest(http.MethodGet, "http://foo.example/eth/v1/validator/blinded_blocks/1", nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+		server.ProduceBlindedBlock(writer, request)
+		assert.Equal(t, http.StatusServiceUnavailable, writer.Code)
+		assert.Equal(t, true, strings.Contains(writer.Body.String(), "Beacon node is currently syncing and not serving request on that endpoint"))
+	})
+}
@@ -73,6 +73,12 @@ type SyncCommitteeDuty struct {
 	ValidatorSyncCommitteeIndices []string `json:"validator_sync_committee_indices"`
 }
 
+// ProduceBlockV2Response is a wrapper json object for the returned block from the ProduceBlockV2 and ProduceBlindedBlock endpoints
+type ProduceBlockV2Response struct {
+	Version string          `json:"version"`
+	Data    json.RawMessage `json:"data"` // represents the block values based on the version
+}
+
 // ProduceBlockV3Response is a wrapper json object for the returned block from the ProduceBlockV3 endpoint
 type ProduceBlockV3Response struct {
 	Version                 string          `json:"version"`
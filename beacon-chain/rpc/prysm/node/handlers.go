@@ -4,7 +4,9 @@ import (
 	"encoding/json"
 	"io"
 	"net/http"
+	"strconv"
 	"strings"
+	"time"
 
 	corenet "github.com/libp2p/go-libp2p/core/network"
 	"github.com/libp2p/go-libp2p/core/peer"
@@ -118,6 +120,130 @@ func (s *Server) RemoveTrustedPeer(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusOK)
 }
 
+// ListPeerAccessRules retrieves the allow and deny rules of the peer access list.
+func (s *Server) ListPeerAccessRules(w http.ResponseWriter, _ *http.Request) {
+	rules := s.PeersFetcher.Peers().AccessList().Rules()
+	response := &PeerAccessRulesResponse{Rules: make([]*PeerAccessRule, len(rules))}
+	for i, rule := range rules {
+		response.Rules[i] = httpPeerAccessRule(rule)
+	}
+	http2.WriteJson(w, response)
+}
+
+// AddPeerAccessRule allows or denies peers by peer ID, ENR or CIDR, for some time or for good.
+// Connected peers that are denied by the new rule are disconnected.
+func (s *Server) AddPeerAccessRule(w http.ResponseWriter, r *http.Request) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		errJson := &http2.DefaultErrorJson{
+			Message: errors.Wrapf(err, "Could not read request body").Error(),
+			Code:    http.StatusInternalServerError,
+		}
+		http2.WriteError(w, errJson)
+		return
+	}
+	var req *AddPeerAccessRuleRequest
+	if err := json.Unmarshal(body, &req); err != nil || req == nil {
+		if err == nil {
+			err = errors.New("empty request")
+		}
+		errJson := &http2.DefaultErrorJson{
+			Message: errors.Wrapf(err, "Could not decode request body into peer access rule").Error(),
+			Code:    http.StatusBadRequest,
+		}
+		http2.WriteError(w, errJson)
+		return
+	}
+	rule := &peers.AccessRule{
+		Action: peers.AccessAction(req.Action),
+		PeerID: req.PeerID,
+		ENR:    req.Enr,
+		CIDR:   req.CIDR,
+		Reason: req.Reason,
+	}
+	if req.Duration != "" {
+		d, err := time.ParseDuration(req.Duration)
+		if err != nil || d <= 0 {
+			errJson := &http2.DefaultErrorJson{
+				Message: "Duration must be a positive duration such as 24h",
+				Code:    http.StatusBadRequest,
+			}
+			http2.WriteError(w, errJson)
+			return
+		}
+		rule.ExpiresAt = time.Now().Add(d).Unix()
+	}
+	added, err := s.PeersFetcher.Peers().AccessList().Add(rule)
+	if err != nil {
+		code := http.StatusInternalServerError
+		if errors.Is(err, peers.ErrInvalidAccessRule) {
+			code = http.StatusBadRequest
+		}
+		errJson := &http2.DefaultErrorJson{
+			Message: errors.Wrapf(err, "Could not add peer access rule").Error(),
+			Code:    code,
+		}
+		http2.WriteError(w, errJson)
+		return
+	}
+	if added.Action == peers.AccessDeny {
+		s.disconnectDeniedPeers()
+	}
+	http2.WriteJson(w, &PeerAccessRuleResponse{Rule: httpPeerAccessRule(added)})
+}
+
+// RemovePeerAccessRule removes a rule from the peer access list.
+func (s *Server) RemovePeerAccessRule(w http.ResponseWriter, r *http.Request) {
+	segments := strings.Split(r.URL.Path, "/")
+	id := segments[len(segments)-1]
+	if err := s.PeersFetcher.Peers().AccessList().Remove(id); err != nil {
+		code := http.StatusInternalServerError
+		if errors.Is(err, peers.ErrAccessRuleNotFound) {
+			code = http.StatusNotFound
+		}
+		errJson := &http2.DefaultErrorJson{
+			Message: errors.Wrapf(err, "Could not remove peer access rule").Error(),
+			Code:    code,
+		}
+		http2.WriteError(w, errJson)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+// disconnectDeniedPeers disconnects the active peers that are denied by the peer access list.
+func (s *Server) disconnectDeniedPeers() {
+	peerStatus := s.PeersFetcher.Peers()
+	for _, pid := range peerStatus.Active() {
+		addr, err := peerStatus.Address(pid)
+		if err != nil {
+			continue
+		}
+		if !peerStatus.AccessList().IsDenied(pid, addr) {
+			continue
+		}
+		if err := s.PeerManager.Disconnect(pid); err != nil {
+			log.WithError(err).WithField("peer", pid).Error("Could not disconnect denied peer")
+		}
+	}
+}
+
+func httpPeerAccessRule(rule *peers.AccessRule) *PeerAccessRule {
+	r := &PeerAccessRule{
+		ID:        rule.ID,
+		Action:    string(rule.Action),
+		PeerID:    rule.PeerID,
+		Enr:       rule.ENR,
+		CIDR:      rule.CIDR,
+		Reason:    rule.Reason,
+		CreatedAt: strconv.FormatInt(rule.CreatedAt, 10),
+	}
+	if rule.ExpiresAt != 0 {
+		r.ExpiresAt = strconv.FormatInt(rule.ExpiresAt, 10)
+	}
+	return r
+}
+
 // httpPeerInfo does the same thing as peerInfo function in node.go but returns the
 // http peer response.
 func httpPeerInfo(peerStatus *peers.Status, id peer.ID) (*Peer, error) {
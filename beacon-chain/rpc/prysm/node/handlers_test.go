@@ -248,3 +248,96 @@ func TestRemoveTrustedPeer_EmptyParameter(t *testing.T) {
 	assert.Equal(t, http.StatusBadRequest, writer.Code)
 	assert.Equal(t, "Could not decode peer id: failed to parse peer ID: invalid cid: cid too short", e.Message)
 }
+
+type disconnectRecorder struct {
+	mockp2p.MockPeerManager
+	disconnected []peer.ID
+}
+
+func (r *disconnectRecorder) Disconnect(pid peer.ID) error {
+	r.disconnected = append(r.disconnected, pid)
+	return nil
+}
+
+func TestPeerAccessList(t *testing.T) {
+	peerFetcher := &mockp2p.MockPeersProvider{}
+	peerFetcher.ClearPeers()
+	peerManager := &disconnectRecorder{}
+	s := Server{PeersFetcher: peerFetcher, PeerManager: peerManager}
+	deniedPeer, err := peer.Decode("16Uiu2HAkyWZ4Ni1TpvDS8dPxsozmHY85KaiFjodQuV6Tz5tkHVeR")
+	require.NoError(t, err)
+	otherPeer, err := peer.Decode("16Uiu2HAm4HgJ9N1o222xK61o7LSgToYWoAy1wNTJRkh9gLZapVAy")
+	require.NoError(t, err)
+	for i, pid := range []peer.ID{deniedPeer, otherPeer} {
+		addr, err := ma.NewMultiaddr("/ip4/52.23.23." + strconv.Itoa(i) + "/tcp/13000")
+		require.NoError(t, err)
+		peerFetcher.Peers().Add(nil, pid, addr, corenet.DirOutbound)
+		peerFetcher.Peers().SetConnectionState(pid, peers.PeerConnected)
+	}
+
+	addRule := func(req *AddPeerAccessRuleRequest) *httptest.ResponseRecorder {
+		reqJson, err := json.Marshal(req)
+		require.NoError(t, err)
+		request := httptest.NewRequest("POST", "http://anything.is.fine", bytes.NewReader(reqJson))
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+		s.AddPeerAccessRule(writer, request)
+		return writer
+	}
+
+	t.Run("Add deny rule", func(t *testing.T) {
+		writer := addRule(&AddPeerAccessRuleRequest{Action: "deny", PeerID: deniedPeer.String(), Reason: "invalid blocks", Duration: "24h"})
+		require.Equal(t, http.StatusOK, writer.Code)
+		resp := &PeerAccessRuleResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		assert.Equal(t, "deny", resp.Rule.Action)
+		assert.Equal(t, "invalid blocks", resp.Rule.Reason)
+		assert.NotEqual(t, "", resp.Rule.ExpiresAt)
+		// The denied peer is disconnected.
+		assert.DeepEqual(t, []peer.ID{deniedPeer}, peerManager.disconnected)
+	})
+	t.Run("Add invalid rule", func(t *testing.T) {
+		writer := addRule(&AddPeerAccessRuleRequest{Action: "deny", CIDR: "52.23.23.0"})
+		require.Equal(t, http.StatusBadRequest, writer.Code)
+		e := &http2.DefaultErrorJson{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), e))
+		assert.StringContains(t, "could not parse CIDR", e.Message)
+
+		writer = addRule(&AddPeerAccessRuleRequest{Action: "deny", CIDR: "52.23.23.0/24", Duration: "-1h"})
+		require.Equal(t, http.StatusBadRequest, writer.Code)
+	})
+	t.Run("List and remove rules", func(t *testing.T) {
+		writer := addRule(&AddPeerAccessRuleRequest{Action: "allow", CIDR: "52.23.23.0/24"})
+		require.Equal(t, http.StatusOK, writer.Code)
+
+		request := httptest.NewRequest("GET", "http://anything.is.fine", nil)
+		writer = httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+		s.ListPeerAccessRules(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		resp := &PeerAccessRulesResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		require.Equal(t, 2, len(resp.Rules))
+		for _, rule := range resp.Rules {
+			if rule.Action == "allow" {
+				assert.Equal(t, "52.23.23.0/24", rule.CIDR)
+				assert.Equal(t, "", rule.ExpiresAt)
+			}
+		}
+
+		for _, rule := range resp.Rules {
+			request = httptest.NewRequest("DELETE", "http://anything.is.fine/"+rule.ID, nil)
+			writer = httptest.NewRecorder()
+			writer.Body = &bytes.Buffer{}
+			s.RemovePeerAccessRule(writer, request)
+			assert.Equal(t, http.StatusOK, writer.Code)
+		}
+		assert.Equal(t, 0, len(peerFetcher.Peers().AccessList().Rules()))
+
+		request = httptest.NewRequest("DELETE", "http://anything.is.fine/"+resp.Rules[0].ID, nil)
+		writer = httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+		s.RemovePeerAccessRule(writer, request)
+		assert.Equal(t, http.StatusNotFound, writer.Code)
+	})
+}
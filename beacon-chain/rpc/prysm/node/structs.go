@@ -15,3 +15,32 @@ type Peer struct {
 	State              string `json:"state"`
 	Direction          string `json:"direction"`
 }
+
+type AddPeerAccessRuleRequest struct {
+	Action string `json:"action"`
+	PeerID string `json:"peer_id"`
+	Enr    string `json:"enr"`
+	CIDR   string `json:"cidr"`
+	Reason string `json:"reason"`
+	// Duration is how long the rule applies, such as "24h". The rule never expires if it is empty.
+	Duration string `json:"duration"`
+}
+
+type PeerAccessRulesResponse struct {
+	Rules []*PeerAccessRule `json:"rules"`
+}
+
+type PeerAccessRuleResponse struct {
+	Rule *PeerAccessRule `json:"rule"`
+}
+
+type PeerAccessRule struct {
+	ID        string `json:"id"`
+	Action    string `json:"action"`
+	PeerID    string `json:"peer_id,omitempty"`
+	Enr       string `json:"enr,omitempty"`
+	CIDR      string `json:"cidr,omitempty"`
+	Reason    string `json:"reason,omitempty"`
+	CreatedAt string `json:"created_at"`
+	ExpiresAt string `json:"expires_at,omitempty"`
+}
@@ -0,0 +1,106 @@
+package slasher
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/rpc/eth/shared"
+	slashertypes "github.com/prysmaticlabs/prysm/v4/beacon-chain/slasher/types"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	http2 "github.com/prysmaticlabs/prysm/v4/network/http"
+	"go.opencensus.io/trace"
+)
+
+// BlobSidecarEquivocations retrieves the evidence of blob sidecar equivocations detected
+// by the slasher between the start_slot and end_slot query parameters, both inclusive.
+func (s *Server) BlobSidecarEquivocations(w http.ResponseWriter, r *http.Request) {
+	ctx, span := trace.StartSpan(r.Context(), "slasher.BlobSidecarEquivocations")
+	defer span.End()
+
+	startSlot, endSlot, ok := slotRangeFromQuery(w, r)
+	if !ok {
+		return
+	}
+	equivocations, err := s.SlashingChecker.BlobSidecarEquivocations(ctx, startSlot, endSlot)
+	if err != nil {
+		http2.HandleError(w, errors.Wrap(err, "Could not get blob sidecar equivocations").Error(), http.StatusInternalServerError)
+		return
+	}
+	data := make([]*BlobSidecarEquivocation, len(equivocations))
+	for i, e := range equivocations {
+		msg := e.BlobSidecarWrapper.SignedBlobSidecarHeader.Message
+		data[i] = &BlobSidecarEquivocation{
+			Slot:          fmt.Sprintf("%d", msg.Slot),
+			ProposerIndex: fmt.Sprintf("%d", msg.ProposerIndex),
+			Index:         fmt.Sprintf("%d", msg.Index),
+			Header1:       blobSidecarHeaderFromWrapper(e.PrevBlobSidecarWrapper),
+			Header2:       blobSidecarHeaderFromWrapper(e.BlobSidecarWrapper),
+		}
+	}
+	http2.WriteJson(w, &BlobSidecarEquivocationsResponse{Data: data})
+}
+
+// SyncCommitteeEquivocations retrieves the evidence of sync committee message equivocations detected
+// by the slasher between the start_slot and end_slot query parameters, both inclusive.
+func (s *Server) SyncCommitteeEquivocations(w http.ResponseWriter, r *http.Request) {
+	ctx, span := trace.StartSpan(r.Context(), "slasher.SyncCommitteeEquivocations")
+	defer span.End()
+
+	startSlot, endSlot, ok := slotRangeFromQuery(w, r)
+	if !ok {
+		return
+	}
+	equivocations, err := s.SlashingChecker.SyncCommitteeEquivocations(ctx, startSlot, endSlot)
+	if err != nil {
+		http2.HandleError(w, errors.Wrap(err, "Could not get sync committee equivocations").Error(), http.StatusInternalServerError)
+		return
+	}
+	data := make([]*SyncCommitteeEquivocation, len(equivocations))
+	for i, e := range equivocations {
+		msg := e.MessageWrapper.SyncCommitteeMessage
+		data[i] = &SyncCommitteeEquivocation{
+			Slot:           fmt.Sprintf("%d", msg.Slot),
+			ValidatorIndex: fmt.Sprintf("%d", msg.ValidatorIndex),
+			Message1:       syncCommitteeMessageFromWrapper(e.PrevMessageWrapper),
+			Message2:       syncCommitteeMessageFromWrapper(e.MessageWrapper),
+		}
+	}
+	http2.WriteJson(w, &SyncCommitteeEquivocationsResponse{Data: data})
+}
+
+func slotRangeFromQuery(w http.ResponseWriter, r *http.Request) (primitives.Slot, primitives.Slot, bool) {
+	startSlot, valid := shared.ValidateUint(w, "start_slot", r.URL.Query().Get("start_slot"))
+	if !valid {
+		return 0, 0, false
+	}
+	endSlot, valid := shared.ValidateUint(w, "end_slot", r.URL.Query().Get("end_slot"))
+	if !valid {
+		return 0, 0, false
+	}
+	if startSlot > endSlot {
+		http2.HandleError(w, fmt.Sprintf("start_slot %d is greater than end_slot %d", startSlot, endSlot), http.StatusBadRequest)
+		return 0, 0, false
+	}
+	return primitives.Slot(startSlot), primitives.Slot(endSlot), true
+}
+
+func blobSidecarHeaderFromWrapper(w *slashertypes.SignedBlobSidecarHeaderWrapper) *SignedBlobSidecarHeader {
+	msg := w.SignedBlobSidecarHeader.Message
+	return &SignedBlobSidecarHeader{
+		BlockRoot:     hexutil.Encode(msg.BlockRoot),
+		BlobRoot:      hexutil.Encode(msg.BlobRoot),
+		KzgCommitment: hexutil.Encode(msg.KzgCommitment),
+		SigningRoot:   hexutil.Encode(w.SigningRoot[:]),
+		Signature:     hexutil.Encode(w.SignedBlobSidecarHeader.Signature),
+	}
+}
+
+func syncCommitteeMessageFromWrapper(w *slashertypes.SyncCommitteeMessageWrapper) *SyncCommitteeMessage {
+	return &SyncCommitteeMessage{
+		BlockRoot:   hexutil.Encode(w.SyncCommitteeMessage.BlockRoot),
+		SigningRoot: hexutil.Encode(w.SigningRoot[:]),
+		Signature:   hexutil.Encode(w.SyncCommitteeMessage.Signature),
+	}
+}
@@ -0,0 +1,166 @@
+package slasher
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	slashertypes "github.com/prysmaticlabs/prysm/v4/beacon-chain/slasher/types"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v4/encoding/bytesutil"
+	http2 "github.com/prysmaticlabs/prysm/v4/network/http"
+	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v4/testing/assert"
+	"github.com/prysmaticlabs/prysm/v4/testing/require"
+)
+
+type mockSlashingChecker struct {
+	blobSidecarEquivocations   []*slashertypes.BlobSidecarEquivocation
+	syncCommitteeEquivocations []*slashertypes.SyncCommitteeEquivocation
+	err                        error
+	startSlot, endSlot         primitives.Slot
+}
+
+func (*mockSlashingChecker) IsSlashableBlock(_ context.Context, _ *ethpb.SignedBeaconBlockHeader) (*ethpb.ProposerSlashing, error) {
+	return nil, nil
+}
+
+func (*mockSlashingChecker) IsSlashableAttestation(_ context.Context, _ *ethpb.IndexedAttestation) ([]*ethpb.AttesterSlashing, error) {
+	return nil, nil
+}
+
+func (*mockSlashingChecker) HighestAttestations(_ context.Context, _ []primitives.ValidatorIndex) ([]*ethpb.HighestAttestation, error) {
+	return nil, nil
+}
+
+func (m *mockSlashingChecker) BlobSidecarEquivocations(
+	_ context.Context, startSlot, endSlot primitives.Slot,
+) ([]*slashertypes.BlobSidecarEquivocation, error) {
+	m.startSlot, m.endSlot = startSlot, endSlot
+	return m.blobSidecarEquivocations, m.err
+}
+
+func (m *mockSlashingChecker) SyncCommitteeEquivocations(
+	_ context.Context, startSlot, endSlot primitives.Slot,
+) ([]*slashertypes.SyncCommitteeEquivocation, error) {
+	m.startSlot, m.endSlot = startSlot, endSlot
+	return m.syncCommitteeEquivocations, m.err
+}
+
+func TestBlobSidecarEquivocations(t *testing.T) {
+	header := func(blockRoot byte) *slashertypes.SignedBlobSidecarHeaderWrapper {
+		return &slashertypes.SignedBlobSidecarHeaderWrapper{
+			SignedBlobSidecarHeader: &ethpb.SignedBlindedBlobSidecar{
+				Message: &ethpb.BlindedBlobSidecar{
+					BlockRoot:     bytesutil.PadTo([]byte{blockRoot}, 32),
+					Index:         2,
+					Slot:          10,
+					ProposerIndex: 7,
+					BlobRoot:      make([]byte, 32),
+					KzgCommitment: make([]byte, 48),
+				},
+				Signature: make([]byte, 96),
+			},
+			SigningRoot: [32]byte{blockRoot},
+		}
+	}
+	checker := &mockSlashingChecker{
+		blobSidecarEquivocations: []*slashertypes.BlobSidecarEquivocation{{
+			PrevBlobSidecarWrapper: header(1),
+			BlobSidecarWrapper:     header(2),
+		}},
+	}
+	s := &Server{SlashingChecker: checker}
+
+	t.Run("ok", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/prysm/slasher/equivocations/blob_sidecars?start_slot=5&end_slot=20", nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.BlobSidecarEquivocations(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		assert.Equal(t, primitives.Slot(5), checker.startSlot)
+		assert.Equal(t, primitives.Slot(20), checker.endSlot)
+		resp := &BlobSidecarEquivocationsResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		require.Equal(t, 1, len(resp.Data))
+		assert.Equal(t, "10", resp.Data[0].Slot)
+		assert.Equal(t, "7", resp.Data[0].ProposerIndex)
+		assert.Equal(t, "2", resp.Data[0].Index)
+		assert.Equal(t, hexutil.Encode(bytesutil.PadTo([]byte{1}, 32)), resp.Data[0].Header1.BlockRoot)
+		assert.Equal(t, hexutil.Encode(bytesutil.PadTo([]byte{2}, 32)), resp.Data[0].Header2.SigningRoot)
+	})
+	t.Run("start slot after end slot", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/prysm/slasher/equivocations/blob_sidecars?start_slot=20&end_slot=5", nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.BlobSidecarEquivocations(writer, request)
+		require.Equal(t, http.StatusBadRequest, writer.Code)
+		e := &http2.DefaultErrorJson{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), e))
+		assert.StringContains(t, "start_slot 20 is greater than end_slot 5", e.Message)
+	})
+	t.Run("missing end slot", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/prysm/slasher/equivocations/blob_sidecars?start_slot=20", nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.BlobSidecarEquivocations(writer, request)
+		require.Equal(t, http.StatusBadRequest, writer.Code)
+		e := &http2.DefaultErrorJson{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), e))
+		assert.StringContains(t, "end_slot is required", e.Message)
+	})
+}
+
+func TestSyncCommitteeEquivocations(t *testing.T) {
+	message := func(blockRoot byte) *slashertypes.SyncCommitteeMessageWrapper {
+		return &slashertypes.SyncCommitteeMessageWrapper{
+			SyncCommitteeMessage: &ethpb.SyncCommitteeMessage{
+				Slot:           3,
+				BlockRoot:      bytesutil.PadTo([]byte{blockRoot}, 32),
+				ValidatorIndex: 4,
+				Signature:      make([]byte, 96),
+			},
+			SigningRoot: [32]byte{blockRoot},
+		}
+	}
+	checker := &mockSlashingChecker{
+		syncCommitteeEquivocations: []*slashertypes.SyncCommitteeEquivocation{{
+			PrevMessageWrapper: message(1),
+			MessageWrapper:     message(2),
+		}},
+	}
+	s := &Server{SlashingChecker: checker}
+
+	t.Run("ok", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/prysm/slasher/equivocations/sync_committee?start_slot=0&end_slot=3", nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.SyncCommitteeEquivocations(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		resp := &SyncCommitteeEquivocationsResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		require.Equal(t, 1, len(resp.Data))
+		assert.Equal(t, "3", resp.Data[0].Slot)
+		assert.Equal(t, "4", resp.Data[0].ValidatorIndex)
+		assert.Equal(t, hexutil.Encode(bytesutil.PadTo([]byte{1}, 32)), resp.Data[0].Message1.BlockRoot)
+		assert.Equal(t, hexutil.Encode(bytesutil.PadTo([]byte{2}, 32)), resp.Data[0].Message2.BlockRoot)
+	})
+	t.Run("slasher error", func(t *testing.T) {
+		s := &Server{SlashingChecker: &mockSlashingChecker{err: errors.New("bad")}}
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/prysm/slasher/equivocations/sync_committee?start_slot=0&end_slot=3", nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.SyncCommitteeEquivocations(writer, request)
+		require.Equal(t, http.StatusInternalServerError, writer.Code)
+	})
+}
@@ -0,0 +1,11 @@
+package slasher
+
+import (
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/slasher"
+)
+
+// Server defines a server implementation for HTTP endpoints, providing
+// access to the evidence gathered by the slasher.
+type Server struct {
+	SlashingChecker slasher.SlashingChecker
+}
@@ -0,0 +1,38 @@
+package slasher
+
+type BlobSidecarEquivocationsResponse struct {
+	Data []*BlobSidecarEquivocation `json:"data"`
+}
+
+type BlobSidecarEquivocation struct {
+	Slot          string                   `json:"slot"`
+	ProposerIndex string                   `json:"proposer_index"`
+	Index         string                   `json:"index"`
+	Header1       *SignedBlobSidecarHeader `json:"header_1"`
+	Header2       *SignedBlobSidecarHeader `json:"header_2"`
+}
+
+type SignedBlobSidecarHeader struct {
+	BlockRoot     string `json:"block_root"`
+	BlobRoot      string `json:"blob_root"`
+	KzgCommitment string `json:"kzg_commitment"`
+	SigningRoot   string `json:"signing_root"`
+	Signature     string `json:"signature"`
+}
+
+type SyncCommitteeEquivocationsResponse struct {
+	Data []*SyncCommitteeEquivocation `json:"data"`
+}
+
+type SyncCommitteeEquivocation struct {
+	Slot           string                `json:"slot"`
+	ValidatorIndex string                `json:"validator_index"`
+	Message1       *SyncCommitteeMessage `json:"message_1"`
+	Message2       *SyncCommitteeMessage `json:"message_2"`
+}
+
+type SyncCommitteeMessage struct {
+	BlockRoot   string `json:"block_root"`
+	SigningRoot string `json:"signing_root"`
+	Signature   string `json:"signature"`
+}
@@ -20,6 +20,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/core/transition"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/db/kv"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/v4/config/features"
 	fieldparams "github.com/prysmaticlabs/prysm/v4/config/fieldparams"
 	"github.com/prysmaticlabs/prysm/v4/config/params"
 	"github.com/prysmaticlabs/prysm/v4/consensus-types/blocks"
@@ -134,7 +135,19 @@ func (vs *Server) GetBeaconBlock(ctx context.Context, req *ethpb.BlockRequest) (
 		"validator":          sBlk.Block().ProposerIndex(),
 	}).Info("Finished building block")
 
-	return vs.constructGenericBeaconBlock(sBlk, blindBlobs, fullBlobs)
+	blk, err := vs.constructGenericBeaconBlock(sBlk, blindBlobs, fullBlobs)
+	if err != nil {
+		return nil, err
+	}
+	if features.Get().EnableRewardAwareAttestationPacking && head.Version() >= version.Altair {
+		reward, err := expectedAttestationsReward(ctx, head, sBlk.Block().Body().Attestations())
+		if err != nil {
+			log.WithError(err).Error("Could not compute expected attestations reward")
+		} else {
+			blk.AttestationReward = reward
+		}
+	}
+	return blk, nil
 }
 
 func (vs *Server) BuildBlockParallel(ctx context.Context, sBlk interfaces.SignedBeaconBlock, head state.BeaconState, skipMevBoost bool) (*enginev1.BlindedBlobsBundle, *enginev1.BlobsBundle, error) {
@@ -265,8 +278,8 @@ func (vs *Server) ProposeBeaconBlock(ctx context.Context, req *ethpb.GenericSign
 			}
 			sidecars[i] = sc.Message
 		}
-		if len(scs) > 0 {
-			if err := vs.BeaconDB.SaveBlobSidecar(ctx, sidecars); err != nil {
+		if len(sidecars) > 0 {
+			if err := vs.BlobStorage.SaveSidecars(sidecars); err != nil {
 				return nil, err
 			}
 		}
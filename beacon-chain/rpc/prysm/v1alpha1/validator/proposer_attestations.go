@@ -9,11 +9,14 @@ import (
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/core/blocks"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/v4/config/features"
 	"github.com/prysmaticlabs/prysm/v4/config/params"
 	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
 	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1/attestation/aggregation"
 	attaggregation "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1/attestation/aggregation/attestations"
+	"github.com/prysmaticlabs/prysm/v4/runtime/version"
+	"github.com/sirupsen/logrus"
 	"go.opencensus.io/trace"
 )
 
@@ -67,6 +70,18 @@ func (vs *Server) packAttestations(ctx context.Context, latestState state.Beacon
 	if err != nil {
 		return nil, err
 	}
+	if features.Get().EnableRewardAwareAttestationPacking && latestState.Version() >= version.Altair {
+		selected, reward, err := deduped.selectByReward(ctx, latestState)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not select attestations by reward")
+		}
+		log.WithFields(logrus.Fields{
+			"candidates": len(deduped),
+			"selected":   len(selected),
+			"reward":     reward,
+		}).Debug("Packed attestations by reward")
+		return selected, nil
+	}
 	sorted, err := deduped.sortByProfitability()
 	if err != nil {
 		return nil, err
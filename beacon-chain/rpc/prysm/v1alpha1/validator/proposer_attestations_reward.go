@@ -0,0 +1,285 @@
+package validator
+
+import (
+	"container/heap"
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/core/altair"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/core/time"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/v4/config/params"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1/attestation"
+	"github.com/prysmaticlabs/prysm/v4/runtime/version"
+	"go.opencensus.io/trace"
+)
+
+// rewardCandidate is an attestation together with the validators and participation flags it would
+// credit if included in the block.
+type rewardCandidate struct {
+	att          *ethpb.Attestation
+	indices      []uint64
+	flags        []uint8
+	currentEpoch bool
+	// gain is the last computed proposer reward numerator of the candidate. It only decreases as
+	// other candidates are selected, so it is an upper bound of the current one.
+	gain uint64
+}
+
+// participationRewards computes the proposer reward of attestations against the epoch participation of a state,
+// as done by altair.ProcessAttestationNoVerifySignature. Including an attestation sets its flags in the tracked
+// participation, so that the following attestations are only rewarded for the flags they newly set.
+type participationRewards struct {
+	st                 state.BeaconState
+	currentEpoch       primitives.Epoch
+	currParticipation  []byte
+	prevParticipation  []byte
+	baseRewardPerInc   uint64
+	baseRewards        map[uint64]uint64
+	rewardDenominator  uint64
+	participationFlags [3]uint8
+	flagWeights        [3]uint64
+}
+
+func newParticipationRewards(st state.BeaconState) (*participationRewards, error) {
+	if st.Version() < version.Altair {
+		return nil, errors.New("attestation rewards are only tracked from Altair")
+	}
+	currParticipation, err := st.CurrentEpochParticipation()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get current epoch participation")
+	}
+	prevParticipation, err := st.PreviousEpochParticipation()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get previous epoch participation")
+	}
+	totalBalance, err := helpers.TotalActiveBalance(st)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get total active balance")
+	}
+	baseRewardPerInc, err := altair.BaseRewardPerIncrement(totalBalance)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get base reward per increment")
+	}
+	cfg := params.BeaconConfig()
+	return &participationRewards{
+		st:                 st,
+		currentEpoch:       time.CurrentEpoch(st),
+		currParticipation:  currParticipation,
+		prevParticipation:  prevParticipation,
+		baseRewardPerInc:   baseRewardPerInc,
+		baseRewards:        make(map[uint64]uint64),
+		rewardDenominator:  (cfg.WeightDenominator - cfg.ProposerWeight) * cfg.WeightDenominator / cfg.ProposerWeight,
+		participationFlags: [3]uint8{cfg.TimelySourceFlagIndex, cfg.TimelyTargetFlagIndex, cfg.TimelyHeadFlagIndex},
+		flagWeights:        [3]uint64{cfg.TimelySourceWeight, cfg.TimelyTargetWeight, cfg.TimelyHeadWeight},
+	}, nil
+}
+
+// candidate returns the attesting indices and the participation flags of the attestation.
+func (p *participationRewards) candidate(ctx context.Context, att *ethpb.Attestation) (*rewardCandidate, error) {
+	delay, err := p.st.Slot().SafeSubSlot(att.Data.Slot)
+	if err != nil {
+		return nil, errors.Wrapf(err, "attestation slot %d is greater than state slot %d", att.Data.Slot, p.st.Slot())
+	}
+	participatedFlags, err := altair.AttestationParticipationFlagIndices(p.st, att.Data, delay)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get participation flags")
+	}
+	committee, err := helpers.BeaconCommitteeFromState(ctx, p.st, att.Data.Slot, att.Data.CommitteeIndex)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get committee")
+	}
+	indices, err := attestation.AttestingIndices(att.AggregationBits, committee)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get attesting indices")
+	}
+	participation := p.prevParticipation
+	if att.Data.Target.Epoch == p.currentEpoch {
+		participation = p.currParticipation
+	}
+	for _, idx := range indices {
+		if idx >= uint64(len(participation)) {
+			return nil, errors.Errorf("index %d exceeds participation length %d", idx, len(participation))
+		}
+	}
+	c := &rewardCandidate{
+		att:          att,
+		indices:      indices,
+		currentEpoch: att.Data.Target.Epoch == p.currentEpoch,
+	}
+	for _, flag := range p.participationFlags {
+		if participatedFlags[flag] {
+			c.flags = append(c.flags, flag)
+		}
+	}
+	return c, nil
+}
+
+// gain returns the proposer reward numerator of the participation flags the candidate would newly set.
+func (p *participationRewards) gain(c *rewardCandidate) (uint64, error) {
+	return p.process(c, false)
+}
+
+// apply sets the participation flags of the candidate and returns the proposer reward numerator.
+func (p *participationRewards) apply(c *rewardCandidate) (uint64, error) {
+	return p.process(c, true)
+}
+
+func (p *participationRewards) process(c *rewardCandidate, set bool) (uint64, error) {
+	participation := p.prevParticipation
+	if c.currentEpoch {
+		participation = p.currParticipation
+	}
+	var numerator uint64
+	for _, idx := range c.indices {
+		for i, flag := range p.participationFlags {
+			if !hasFlag(c.flags, flag) || participation[idx]&(1<<flag) != 0 {
+				continue
+			}
+			br, err := p.baseReward(idx)
+			if err != nil {
+				return 0, err
+			}
+			numerator += br * p.flagWeights[i]
+			if set {
+				participation[idx] |= 1 << flag
+			}
+		}
+	}
+	return numerator, nil
+}
+
+func (p *participationRewards) baseReward(idx uint64) (uint64, error) {
+	if br, ok := p.baseRewards[idx]; ok {
+		return br, nil
+	}
+	val, err := p.st.ValidatorAtIndexReadOnly(primitives.ValidatorIndex(idx))
+	if err != nil {
+		return 0, err
+	}
+	br := val.EffectiveBalance() / params.BeaconConfig().EffectiveBalanceIncrement * p.baseRewardPerInc
+	p.baseRewards[idx] = br
+	return br, nil
+}
+
+func hasFlag(flags []uint8, flag uint8) bool {
+	for _, f := range flags {
+		if f == flag {
+			return true
+		}
+	}
+	return false
+}
+
+// rewardCandidates is a max-heap of candidates ordered by their last computed gain. Ties are broken by highest slot
+// and by highest aggregation bit count, as done when sorting by profitability.
+type rewardCandidates []*rewardCandidate
+
+func (h rewardCandidates) Len() int { return len(h) }
+func (h rewardCandidates) Less(i, j int) bool {
+	if h[i].gain != h[j].gain {
+		return h[i].gain > h[j].gain
+	}
+	if h[i].att.Data.Slot != h[j].att.Data.Slot {
+		return h[i].att.Data.Slot > h[j].att.Data.Slot
+	}
+	return h[i].att.AggregationBits.Count() > h[j].att.AggregationBits.Count()
+}
+func (h rewardCandidates) Swap(i, j int)       { h[i], h[j] = h[j], h[i] }
+func (h *rewardCandidates) Push(x interface{}) { *h = append(*h, x.(*rewardCandidate)) }
+func (h *rewardCandidates) Pop() interface{} {
+	old := *h
+	n := len(old)
+	c := old[n-1]
+	old[n-1] = nil
+	*h = old[:n-1]
+	return c
+}
+
+// selectByReward selects up to MAX_ATTESTATIONS attestations maximizing the proposer reward of the block, given the
+// participation flags already set in the state. Each (validator, participation flag) pair not yet set in the state is
+// worth its base reward times the flag weight, and is only rewarded once. This is a weighted max-coverage problem,
+// solved greedily by repeatedly picking the attestation with the highest marginal reward. As marginal rewards only
+// decrease when attestations get selected, they are lazily re-evaluated. Attestations which would not bring any reward
+// are left out. It returns the selected attestations, in inclusion order, and their proposer reward in Gwei.
+func (a proposerAtts) selectByReward(ctx context.Context, st state.BeaconState) (proposerAtts, uint64, error) {
+	ctx, span := trace.StartSpan(ctx, "ProposerServer.selectByReward")
+	defer span.End()
+
+	rewards, err := newParticipationRewards(st)
+	if err != nil {
+		return nil, 0, err
+	}
+	candidates := make(rewardCandidates, 0, len(a))
+	for _, att := range a {
+		c, err := rewards.candidate(ctx, att)
+		if err != nil {
+			log.WithError(err).Debug("Skipping attestation which can not be rewarded")
+			continue
+		}
+		if c.gain, err = rewards.gain(c); err != nil {
+			return nil, 0, err
+		}
+		if c.gain > 0 {
+			candidates = append(candidates, c)
+		}
+	}
+	heap.Init(&candidates)
+
+	maxAtts := params.BeaconConfig().MaxAttestations
+	selected := make(proposerAtts, 0, maxAtts)
+	var total uint64
+	for candidates.Len() > 0 && uint64(len(selected)) < maxAtts {
+		if ctx.Err() != nil {
+			return nil, 0, ctx.Err()
+		}
+		c := candidates[0]
+		g, err := rewards.gain(c)
+		if err != nil {
+			return nil, 0, err
+		}
+		if g == 0 {
+			heap.Pop(&candidates)
+			continue
+		}
+		if g < c.gain {
+			// Other candidates may now be worth more, put it back with its actual gain.
+			c.gain = g
+			heap.Fix(&candidates, 0)
+			continue
+		}
+		heap.Pop(&candidates)
+		numerator, err := rewards.apply(c)
+		if err != nil {
+			return nil, 0, err
+		}
+		total += numerator / rewards.rewardDenominator
+		selected = append(selected, c.att)
+	}
+	return selected, total, nil
+}
+
+// expectedAttestationsReward returns the proposer reward in Gwei of including the attestations, in order, in a block
+// on top of the given state. The state is not modified.
+func expectedAttestationsReward(ctx context.Context, st state.BeaconState, atts []*ethpb.Attestation) (uint64, error) {
+	rewards, err := newParticipationRewards(st)
+	if err != nil {
+		return 0, err
+	}
+	var total uint64
+	for _, att := range atts {
+		c, err := rewards.candidate(ctx, att)
+		if err != nil {
+			return 0, err
+		}
+		numerator, err := rewards.apply(c)
+		if err != nil {
+			return 0, err
+		}
+		total += numerator / rewards.rewardDenominator
+	}
+	return total, nil
+}
@@ -0,0 +1,193 @@
+package validator
+
+import (
+	"context"
+	"math/rand"
+	"testing"
+
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/core/altair"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/v4/config/params"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v4/testing/require"
+	"github.com/prysmaticlabs/prysm/v4/testing/util"
+	"github.com/prysmaticlabs/prysm/v4/time/slots"
+)
+
+// rewardTestState returns an Altair state at the given slot, for which attestations with zero roots match the
+// source, target and head.
+func rewardTestState(t testing.TB, numValidators uint64, slot primitives.Slot) state.BeaconState {
+	helpers.ClearCache()
+	st, _ := util.DeterministicGenesisStateAltair(t, numValidators)
+	require.NoError(t, st.SetSlot(slot))
+	return st
+}
+
+// rewardTestAttestation returns an attestation of the committee at the given slot, with the given committee positions set.
+func rewardTestAttestation(t testing.TB, st state.BeaconState, slot primitives.Slot, committeeIndex primitives.CommitteeIndex, positions ...uint64) *ethpb.Attestation {
+	committee, err := helpers.BeaconCommitteeFromState(context.Background(), st, slot, committeeIndex)
+	require.NoError(t, err)
+	bits := bitfield.NewBitlist(uint64(len(committee)))
+	for _, p := range positions {
+		bits.SetBitAt(p, true)
+	}
+	return util.HydrateAttestation(&ethpb.Attestation{
+		Data: &ethpb.AttestationData{
+			Slot:           slot,
+			CommitteeIndex: committeeIndex,
+			Target:         &ethpb.Checkpoint{Epoch: slots.ToEpoch(slot)},
+		},
+		AggregationBits: bits,
+	})
+}
+
+// randomRewardTestAttestations returns aggregates of random committees of the last slots before the state slot.
+func randomRewardTestAttestations(t testing.TB, st state.BeaconState, rnd *rand.Rand, count int) proposerAtts {
+	ctx := context.Background()
+	activeCount, err := helpers.ActiveValidatorCount(ctx, st, slots.ToEpoch(st.Slot()))
+	require.NoError(t, err)
+	committees := helpers.SlotCommitteeCount(activeCount)
+	atts := make(proposerAtts, 0, count)
+	for len(atts) < count {
+		slot := st.Slot() - primitives.Slot(1+rnd.Intn(4))
+		committeeIndex := primitives.CommitteeIndex(rnd.Uint64() % committees)
+		committee, err := helpers.BeaconCommitteeFromState(ctx, st, slot, committeeIndex)
+		require.NoError(t, err)
+		var positions []uint64
+		for i := range committee {
+			if rnd.Intn(3) == 0 {
+				positions = append(positions, uint64(i))
+			}
+		}
+		atts = append(atts, rewardTestAttestation(t, st, slot, committeeIndex, positions...))
+	}
+	return atts
+}
+
+// greedyPacking packs attestations as done when reward-aware packing is disabled.
+func greedyPacking(t testing.TB, atts proposerAtts) proposerAtts {
+	deduped, err := atts.dedup()
+	require.NoError(t, err)
+	sorted, err := deduped.sortByProfitability()
+	require.NoError(t, err)
+	return sorted.limitToMaxAttestations()
+}
+
+func TestExpectedAttestationsReward_MatchesStateTransition(t *testing.T) {
+	ctx := context.Background()
+	st := rewardTestState(t, 256, 10)
+	atts := []*ethpb.Attestation{
+		rewardTestAttestation(t, st, 9, 0, 0, 1, 2),
+		rewardTestAttestation(t, st, 9, 0, 2, 3, 4),
+		rewardTestAttestation(t, st, 7, 0, 0, 1, 2, 3),
+		rewardTestAttestation(t, st, 3, 0, 5, 6),
+	}
+
+	reward, err := expectedAttestationsReward(ctx, st, atts)
+	require.NoError(t, err)
+	require.Equal(t, true, reward > 0)
+
+	proposer, err := helpers.BeaconProposerIndex(ctx, st)
+	require.NoError(t, err)
+	before, err := st.BalanceAtIndex(proposer)
+	require.NoError(t, err)
+	post := st.Copy()
+	totalBalance, err := helpers.TotalActiveBalance(post)
+	require.NoError(t, err)
+	for _, att := range atts {
+		post, err = altair.ProcessAttestationNoVerifySignature(ctx, post, att, totalBalance)
+		require.NoError(t, err)
+	}
+	after, err := post.BalanceAtIndex(proposer)
+	require.NoError(t, err)
+	require.Equal(t, after-before, reward)
+
+	// The state given to compute the reward is left untouched.
+	participation, err := st.CurrentEpochParticipation()
+	require.NoError(t, err)
+	require.DeepEqual(t, make([]byte, len(participation)), participation)
+}
+
+func TestProposerAtts_selectByReward(t *testing.T) {
+	ctx := context.Background()
+	params.SetupTestConfigCleanup(t)
+	cfg := params.BeaconConfig().Copy()
+	cfg.MaxAttestations = 2
+	params.OverrideBeaconConfig(cfg)
+
+	st := rewardTestState(t, 256, 10)
+	// The whole committee of slot 9 was already rewarded for all its flags by a previous block.
+	participation, err := st.CurrentEpochParticipation()
+	require.NoError(t, err)
+	committee, err := helpers.BeaconCommitteeFromState(ctx, st, 9, 0)
+	require.NoError(t, err)
+	for _, idx := range committee {
+		participation[idx] = 0b111
+	}
+	require.NoError(t, st.SetCurrentParticipationBits(participation))
+
+	included := rewardTestAttestation(t, st, 9, 0, 0, 1, 2, 3, 4, 5, 6, 7)
+	small := rewardTestAttestation(t, st, 8, 0, 0, 1)
+	large := rewardTestAttestation(t, st, 8, 0, 2, 3, 4)
+	old := rewardTestAttestation(t, st, 7, 0, 0, 1, 2, 3)
+	atts := proposerAtts{included, small, large, old}
+
+	selected, reward, err := atts.selectByReward(ctx, st)
+	require.NoError(t, err)
+	require.DeepEqual(t, proposerAtts{large, old}, selected)
+	expected, err := expectedAttestationsReward(ctx, st, selected)
+	require.NoError(t, err)
+	require.Equal(t, expected, reward)
+
+	// Packing by slot and bit count picks the attestation which brings no reward.
+	greedy := greedyPacking(t, atts)
+	require.DeepEqual(t, proposerAtts{included, large}, greedy)
+	greedyReward, err := expectedAttestationsReward(ctx, st, greedy)
+	require.NoError(t, err)
+	require.Equal(t, true, reward > greedyReward)
+}
+
+func TestProposerAtts_selectByReward_NoReward(t *testing.T) {
+	ctx := context.Background()
+	st := rewardTestState(t, 256, 10)
+	participation, err := st.CurrentEpochParticipation()
+	require.NoError(t, err)
+	for i := range participation {
+		participation[i] = 0b111
+	}
+	require.NoError(t, st.SetCurrentParticipationBits(participation))
+
+	selected, reward, err := proposerAtts{rewardTestAttestation(t, st, 9, 0, 0, 1)}.selectByReward(ctx, st)
+	require.NoError(t, err)
+	require.Equal(t, 0, len(selected))
+	require.Equal(t, uint64(0), reward)
+}
+
+func TestProposerAtts_selectByReward_ComparedToGreedy(t *testing.T) {
+	ctx := context.Background()
+	params.SetupTestConfigCleanup(t)
+	cfg := params.BeaconConfig().Copy()
+	cfg.MaxAttestations = 8
+	params.OverrideBeaconConfig(cfg)
+
+	st := rewardTestState(t, 1024, 10)
+	rnd := rand.New(rand.NewSource(42))
+	var totalReward, totalGreedyReward uint64
+	for i := 0; i < 20; i++ {
+		atts := randomRewardTestAttestations(t, st, rnd, 64)
+
+		selected, reward, err := atts.selectByReward(ctx, st)
+		require.NoError(t, err)
+		require.Equal(t, true, uint64(len(selected)) <= cfg.MaxAttestations)
+
+		greedyReward, err := expectedAttestationsReward(ctx, st, greedyPacking(t, atts))
+		require.NoError(t, err)
+		require.Equal(t, true, reward >= greedyReward, "reward-aware packing earned %d, greedy packing %d", reward, greedyReward)
+		totalReward += reward
+		totalGreedyReward += greedyReward
+	}
+	require.Equal(t, true, totalReward > totalGreedyReward)
+}
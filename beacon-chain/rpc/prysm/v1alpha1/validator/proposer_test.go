@@ -21,6 +21,7 @@ import (
 	coretime "github.com/prysmaticlabs/prysm/v4/beacon-chain/core/time"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/core/transition"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/db/filesystem"
 	dbutil "github.com/prysmaticlabs/prysm/v4/beacon-chain/db/testing"
 	mockExecution "github.com/prysmaticlabs/prysm/v4/beacon-chain/execution/testing"
 	doublylinkedtree "github.com/prysmaticlabs/prysm/v4/beacon-chain/forkchoice/doubly-linked-tree"
@@ -715,10 +716,10 @@ func TestProposer_ProposeBlock_OK(t *testing.T) {
 				blk := &ethpb.GenericSignedBeaconBlock_Deneb{Deneb: &ethpb.SignedBeaconBlockAndBlobsDeneb{
 					Block: blockToPropose,
 					Blobs: []*ethpb.SignedBlobSidecar{
-						{Message: &ethpb.BlobSidecar{Index: 0, Slot: 5, BlockParentRoot: parent[:]}},
-						{Message: &ethpb.BlobSidecar{Index: 1, Slot: 5, BlockParentRoot: parent[:]}},
-						{Message: &ethpb.BlobSidecar{Index: 2, Slot: 5, BlockParentRoot: parent[:]}},
-						{Message: &ethpb.BlobSidecar{Index: 3, Slot: 5, BlockParentRoot: parent[:]}},
+						{Message: util.HydrateBlobSidecar(&ethpb.BlobSidecar{Index: 0, Slot: 5, BlockParentRoot: parent[:]})},
+						{Message: util.HydrateBlobSidecar(&ethpb.BlobSidecar{Index: 1, Slot: 5, BlockParentRoot: parent[:]})},
+						{Message: util.HydrateBlobSidecar(&ethpb.BlobSidecar{Index: 2, Slot: 5, BlockParentRoot: parent[:]})},
+						{Message: util.HydrateBlobSidecar(&ethpb.BlobSidecar{Index: 3, Slot: 5, BlockParentRoot: parent[:]})},
 					},
 				}}
 				return &ethpb.GenericSignedBeaconBlock{Block: blk}
@@ -763,15 +764,15 @@ func TestProposer_ProposeBlock_OK(t *testing.T) {
 					SignedBlindedBlobSidecars: []*ethpb.SignedBlindedBlobSidecar{
 						{
 							Message: &ethpb.BlindedBlobSidecar{
-								BlockRoot:       []byte{0x01},
+								BlockRoot:       bytesutil.PadTo([]byte{0x01}, fieldparams.RootLength),
 								Slot:            2,
-								BlockParentRoot: []byte{0x03},
+								BlockParentRoot: bytesutil.PadTo([]byte{0x03}, fieldparams.RootLength),
 								ProposerIndex:   3,
-								BlobRoot:        []byte{0x04},
-								KzgCommitment:   []byte{0x05},
-								KzgProof:        []byte{0x06},
+								BlobRoot:        bytesutil.PadTo([]byte{0x04}, fieldparams.RootLength),
+								KzgCommitment:   bytesutil.PadTo([]byte{0x05}, fieldparams.BLSPubkeyLength),
+								KzgProof:        bytesutil.PadTo([]byte{0x06}, fieldparams.BLSPubkeyLength),
 							},
-							Signature: []byte{0x07},
+							Signature: bytesutil.PadTo([]byte{0x07}, fieldparams.BLSSignatureLength),
 						},
 					},
 				}}
@@ -791,12 +792,14 @@ func TestProposer_ProposeBlock_OK(t *testing.T) {
 
 			c := &mock.ChainService{Root: bsRoot[:], State: beaconState}
 			db := dbutil.SetupDB(t)
+			bs := filesystem.NewEphemeralBlobStorage(t)
 			proposerServer := &Server{
 				BlockReceiver: c,
 				BlockNotifier: c.BlockNotifier(),
 				P2P:           mockp2p.NewTestP2P(t),
-				BlockBuilder:  &builderTest.MockBuilderService{HasConfigured: true, PayloadCapella: emptyPayloadCapella(), PayloadDeneb: emptyPayloadDeneb(), BlobBundle: &enginev1.BlobsBundle{KzgCommitments: [][]byte{{0x01}}, Proofs: [][]byte{{0x02}}, Blobs: [][]byte{{0x03}}}},
+				BlockBuilder:  &builderTest.MockBuilderService{HasConfigured: true, PayloadCapella: emptyPayloadCapella(), PayloadDeneb: emptyPayloadDeneb(), BlobBundle: &enginev1.BlobsBundle{KzgCommitments: [][]byte{{0x01}}, Proofs: [][]byte{{0x02}}, Blobs: [][]byte{bytesutil.PadTo([]byte{0x03}, fieldparams.BlobLength)}}},
 				BeaconDB:      db,
+				BlobStorage:   bs,
 			}
 			blockToPropose := tt.block(bsRoot)
 			res, err := proposerServer.ProposeBeaconBlock(context.Background(), blockToPropose)
@@ -809,7 +812,8 @@ func TestProposer_ProposeBlock_OK(t *testing.T) {
 				}
 			}
 			if tt.name == "deneb block has blobs" {
-				scs, err := db.BlobSidecarsBySlot(ctx, blockToPropose.GetDeneb().Block.Block.Slot)
+				// The sidecars in the request are hydrated with an empty block root.
+				scs, err := bs.SidecarsByRoot([32]byte{})
 				require.NoError(t, err)
 				assert.Equal(t, 4, len(scs))
 				for i, sc := range scs {
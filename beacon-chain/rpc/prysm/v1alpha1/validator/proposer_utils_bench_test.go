@@ -1,7 +1,9 @@
 package validator
 
 import (
+	"context"
 	"fmt"
+	"math/rand"
 	"testing"
 
 	"github.com/prysmaticlabs/go-bitfield"
@@ -64,3 +66,28 @@ func BenchmarkProposerAtts_sortByProfitability(b *testing.B) {
 		})
 	}
 }
+
+func BenchmarkProposerAtts_selectByReward(b *testing.B) {
+	ctx := context.Background()
+	st := rewardTestState(b, 4096, 10)
+	rnd := rand.New(rand.NewSource(1))
+
+	for _, count := range []int{128, 256, 1024} {
+		atts := randomRewardTestAttestations(b, st, rnd, count)
+		b.Run(fmt.Sprintf("greedy_%d_attestations", count), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				attsCopy := make(proposerAtts, len(atts))
+				for i, att := range atts {
+					attsCopy[i] = ethpb.CopyAttestation(att)
+				}
+				greedyPacking(b, attsCopy)
+			}
+		})
+		b.Run(fmt.Sprintf("reward-aware_%d_attestations", count), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				_, _, err := atts.selectByReward(ctx, st)
+				require.NoError(b, err)
+			}
+		})
+	}
+}
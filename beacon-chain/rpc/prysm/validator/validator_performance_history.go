@@ -0,0 +1,99 @@
+package validator
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/rpc/eth/shared"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	http2 "github.com/prysmaticlabs/prysm/v4/network/http"
+	"go.opencensus.io/trace"
+)
+
+type ValidatorPerformanceHistoryResponse struct {
+	Data []*EpochPerformance `json:"data"`
+}
+
+type EpochPerformance struct {
+	Epoch                 string        `json:"epoch"`
+	AttestationIncluded   bool          `json:"attestation_included"`
+	InclusionDistance     string        `json:"inclusion_distance"`
+	CorrectSource         bool          `json:"correct_source"`
+	CorrectTarget         bool          `json:"correct_target"`
+	CorrectHead           bool          `json:"correct_head"`
+	BlocksProposed        string        `json:"blocks_proposed"`
+	SyncCommitteeIncluded string        `json:"sync_committee_included"`
+	SyncCommitteeMissed   string        `json:"sync_committee_missed"`
+	InactivityScore       string        `json:"inactivity_score"`
+	Rewards               *EpochRewards `json:"rewards"`
+}
+
+type EpochRewards struct {
+	Head          string `json:"head"`
+	Source        string `json:"source"`
+	Target        string `json:"target"`
+	SyncCommittee string `json:"sync_committee"`
+	Total         string `json:"total"`
+}
+
+// GetValidatorPerformanceHistory is a HTTP handler that serves the GET
+// /prysm/validators/{validator_index}/performance_history endpoint. It returns the per-epoch performance of a
+// validator tracked by the validator monitor, between the start_epoch and end_epoch query parameters (inclusive).
+// Rewards and penalties are in Gwei, penalties being negative.
+//
+// Example usage:
+//
+//	GET /prysm/validators/12/performance_history?start_epoch=100&end_epoch=101
+func (vs *Server) GetValidatorPerformanceHistory(w http.ResponseWriter, r *http.Request) {
+	ctx, span := trace.StartSpan(r.Context(), "validator.GetValidatorPerformanceHistory")
+	defer span.End()
+
+	idx, ok := shared.ValidateUint(w, "validator_index", mux.Vars(r)["validator_index"])
+	if !ok {
+		return
+	}
+	query := r.URL.Query()
+	start, ok := shared.ValidateUint(w, "start_epoch", query.Get("start_epoch"))
+	if !ok {
+		return
+	}
+	end, ok := shared.ValidateUint(w, "end_epoch", query.Get("end_epoch"))
+	if !ok {
+		return
+	}
+	if start > end {
+		handleHTTPError(w, fmt.Sprintf("start_epoch %d is greater than end_epoch %d", start, end), http.StatusBadRequest)
+		return
+	}
+
+	performances, err := vs.BeaconDB.ValidatorPerformances(ctx, primitives.ValidatorIndex(idx), primitives.Epoch(start), primitives.Epoch(end))
+	if err != nil {
+		handleHTTPError(w, "Could not get validator performances: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	data := make([]*EpochPerformance, len(performances))
+	for i, p := range performances {
+		data[i] = &EpochPerformance{
+			Epoch:                 strconv.FormatUint(uint64(p.Epoch), 10),
+			AttestationIncluded:   p.AttestationIncluded,
+			InclusionDistance:     strconv.FormatUint(uint64(p.InclusionDistance), 10),
+			CorrectSource:         p.CorrectSource,
+			CorrectTarget:         p.CorrectTarget,
+			CorrectHead:           p.CorrectHead,
+			BlocksProposed:        strconv.FormatUint(p.BlocksProposed, 10),
+			SyncCommitteeIncluded: strconv.FormatUint(p.SyncCommitteeIncluded, 10),
+			SyncCommitteeMissed:   strconv.FormatUint(p.SyncCommitteeMissed, 10),
+			InactivityScore:       strconv.FormatUint(p.InactivityScore, 10),
+			Rewards: &EpochRewards{
+				Head:          strconv.FormatInt(p.HeadReward, 10),
+				Source:        strconv.FormatInt(p.SourceReward, 10),
+				Target:        strconv.FormatInt(p.TargetReward, 10),
+				SyncCommittee: strconv.FormatInt(p.SyncCommitteeReward, 10),
+				Total:         strconv.FormatInt(p.TotalReward(), 10),
+			},
+		}
+	}
+	http2.WriteJson(w, &ValidatorPerformanceHistoryResponse{Data: data})
+}
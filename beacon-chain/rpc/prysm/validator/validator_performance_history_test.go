@@ -0,0 +1,92 @@
+package validator
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	dbtest "github.com/prysmaticlabs/prysm/v4/beacon-chain/db/testing"
+	monitortypes "github.com/prysmaticlabs/prysm/v4/beacon-chain/monitor/types"
+	http2 "github.com/prysmaticlabs/prysm/v4/network/http"
+	"github.com/prysmaticlabs/prysm/v4/testing/require"
+)
+
+func TestServer_GetValidatorPerformanceHistory(t *testing.T) {
+	beaconDB := dbtest.SetupDB(t)
+	require.NoError(t, beaconDB.SaveValidatorPerformances(context.Background(), []*monitortypes.ValidatorPerformance{
+		{ValidatorIndex: 3, Epoch: 9, AttestationIncluded: true, InclusionDistance: 1, CorrectSource: true, SourceReward: 10},
+		{ValidatorIndex: 3, Epoch: 10, BlocksProposed: 1, SyncCommitteeIncluded: 30, SyncCommitteeMissed: 2, SyncCommitteeReward: 280, SourceReward: -10, TargetReward: -20},
+		{ValidatorIndex: 3, Epoch: 11, AttestationIncluded: true},
+		{ValidatorIndex: 4, Epoch: 10, AttestationIncluded: true},
+	}))
+	vs := &Server{BeaconDB: beaconDB}
+
+	t.Run("OK", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "http://example.com/prysm/validators/3/performance_history?start_epoch=9&end_epoch=10", nil)
+		req = mux.SetURLVars(req, map[string]string{"validator_index": "3"})
+		writer := httptest.NewRecorder()
+		vs.GetValidatorPerformanceHistory(writer, req)
+		require.Equal(t, http.StatusOK, writer.Code)
+
+		resp := &ValidatorPerformanceHistoryResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		require.DeepEqual(t, []*EpochPerformance{
+			{
+				Epoch:                 "9",
+				AttestationIncluded:   true,
+				InclusionDistance:     "1",
+				CorrectSource:         true,
+				BlocksProposed:        "0",
+				SyncCommitteeIncluded: "0",
+				SyncCommitteeMissed:   "0",
+				InactivityScore:       "0",
+				Rewards:               &EpochRewards{Head: "0", Source: "10", Target: "0", SyncCommittee: "0", Total: "10"},
+			},
+			{
+				Epoch:                 "10",
+				InclusionDistance:     "0",
+				BlocksProposed:        "1",
+				SyncCommitteeIncluded: "30",
+				SyncCommitteeMissed:   "2",
+				InactivityScore:       "0",
+				Rewards:               &EpochRewards{Head: "0", Source: "-10", Target: "-20", SyncCommittee: "280", Total: "250"},
+			},
+		}, resp.Data)
+	})
+	t.Run("no records", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "http://example.com/prysm/validators/5/performance_history?start_epoch=9&end_epoch=10", nil)
+		req = mux.SetURLVars(req, map[string]string{"validator_index": "5"})
+		writer := httptest.NewRecorder()
+		vs.GetValidatorPerformanceHistory(writer, req)
+		require.Equal(t, http.StatusOK, writer.Code)
+
+		resp := &ValidatorPerformanceHistoryResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		require.Equal(t, 0, len(resp.Data))
+	})
+	t.Run("invalid range", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "http://example.com/prysm/validators/3/performance_history?start_epoch=11&end_epoch=10", nil)
+		req = mux.SetURLVars(req, map[string]string{"validator_index": "3"})
+		writer := httptest.NewRecorder()
+		vs.GetValidatorPerformanceHistory(writer, req)
+		require.Equal(t, http.StatusBadRequest, writer.Code)
+
+		e := &http2.DefaultErrorJson{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), e))
+		require.StringContains(t, "start_epoch 11 is greater than end_epoch 10", e.Message)
+	})
+	t.Run("missing end epoch", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "http://example.com/prysm/validators/3/performance_history?start_epoch=11", nil)
+		req = mux.SetURLVars(req, map[string]string{"validator_index": "3"})
+		writer := httptest.NewRecorder()
+		vs.GetValidatorPerformanceHistory(writer, req)
+		require.Equal(t, http.StatusBadRequest, writer.Code)
+
+		e := &http2.DefaultErrorJson{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), e))
+		require.StringContains(t, "end_epoch is required", e.Message)
+	})
+}
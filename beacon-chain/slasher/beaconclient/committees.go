@@ -0,0 +1,97 @@
+package beaconclient
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+	"sync"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/rpc/eth/beacon"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v4/time/slots"
+)
+
+// Number of epochs for which committees are kept, which covers the epochs
+// of the attestations which can still be included on chain.
+const committeeCacheEpochs = 4
+
+type committeeKey struct {
+	slot  primitives.Slot
+	index primitives.CommitteeIndex
+}
+
+// committeeCache keeps the beacon committees of the latest epochs, as retrieved from the head
+// state of a beacon node. Attestations are received shortly after being produced, so the
+// committees of the head state are the ones of the attestations in all but deep reorgs.
+type committeeCache struct {
+	lock              sync.RWMutex
+	committeesByEpoch map[primitives.Epoch]map[committeeKey][]primitives.ValidatorIndex
+}
+
+func newCommitteeCache() *committeeCache {
+	return &committeeCache{
+		committeesByEpoch: make(map[primitives.Epoch]map[committeeKey][]primitives.ValidatorIndex),
+	}
+}
+
+func (c *committeeCache) get(epoch primitives.Epoch) (map[committeeKey][]primitives.ValidatorIndex, bool) {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	committees, ok := c.committeesByEpoch[epoch]
+	return committees, ok
+}
+
+func (c *committeeCache) add(epoch primitives.Epoch, committees map[committeeKey][]primitives.ValidatorIndex) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.committeesByEpoch[epoch] = committees
+	for e := range c.committeesByEpoch {
+		if e+committeeCacheEpochs <= epoch {
+			delete(c.committeesByEpoch, e)
+		}
+	}
+}
+
+// Returns the beacon committee at the given slot and index, retrieving the committees
+// of its epoch from the beacon node if they are not cached.
+func (s *Service) committee(
+	ctx context.Context, n *beaconNode, slot primitives.Slot, index primitives.CommitteeIndex,
+) ([]primitives.ValidatorIndex, error) {
+	epoch := slots.ToEpoch(slot)
+	committees, ok := s.committees.get(epoch)
+	if !ok {
+		resp := &beacon.GetCommitteesResponse{}
+		query := url.Values{"epoch": {strconv.FormatUint(uint64(epoch), 10)}}
+		if err := s.get(ctx, n, committeesPath, query, resp); err != nil {
+			return nil, errors.Wrapf(err, "could not get committees for epoch %d", epoch)
+		}
+		committees = make(map[committeeKey][]primitives.ValidatorIndex, len(resp.Data))
+		for _, c := range resp.Data {
+			committeeSlot, err := strconv.ParseUint(c.Slot, 10, 64)
+			if err != nil {
+				return nil, errors.Wrap(err, "could not decode committee slot")
+			}
+			committeeIndex, err := strconv.ParseUint(c.Index, 10, 64)
+			if err != nil {
+				return nil, errors.Wrap(err, "could not decode committee index")
+			}
+			validators := make([]primitives.ValidatorIndex, len(c.Validators))
+			for i, v := range c.Validators {
+				validatorIndex, err := strconv.ParseUint(v, 10, 64)
+				if err != nil {
+					return nil, errors.Wrap(err, "could not decode committee validator index")
+				}
+				validators[i] = primitives.ValidatorIndex(validatorIndex)
+			}
+			key := committeeKey{slot: primitives.Slot(committeeSlot), index: primitives.CommitteeIndex(committeeIndex)}
+			committees[key] = validators
+		}
+		s.committees.add(epoch, committees)
+	}
+	committee, ok := committees[committeeKey{slot: slot, index: index}]
+	if !ok {
+		return nil, errors.Errorf("no committee at slot %d with index %d", slot, index)
+	}
+	return committee, nil
+}
@@ -0,0 +1,230 @@
+package beaconclient
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v4/api/client"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/rpc/eth/events"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/rpc/eth/shared"
+	fieldparams "github.com/prysmaticlabs/prysm/v4/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1/attestation"
+)
+
+// Events larger than this are not expected from the followed topics.
+const maxEventSize = 1 << 20
+
+var (
+	idFieldPrefix    = []byte("id:")
+	eventFieldPrefix = []byte("event:")
+	dataFieldPrefix  = []byte("data:")
+)
+
+// Follows the event stream of a beacon node until the service is stopped,
+// reconnecting to it whenever the stream is interrupted. The stream is resumed
+// after the last received event, so that events published in the meantime are not missed.
+func (s *Service) followEvents(n *beaconNode) {
+	var lastEventID string
+	for {
+		err := s.streamEvents(n, &lastEventID)
+		n.connected.Store(false)
+		if s.ctx.Err() != nil {
+			return
+		}
+		log.WithError(err).WithFields(nodeFields(n)).Warn("Event stream of beacon node interrupted, reconnecting")
+		select {
+		case <-time.After(reconnectDelay):
+		case <-s.ctx.Done():
+			return
+		}
+	}
+}
+
+func (s *Service) streamEvents(n *beaconNode, lastEventID *string) error {
+	query := url.Values{"topics": {events.AttestationTopic + "," + events.BlockGossipTopic}}
+	u := n.BaseURL().ResolveReference(&url.URL{Path: eventsPath, RawQuery: query.Encode()})
+	req, err := http.NewRequestWithContext(s.ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "text/event-stream")
+	req.Header.Set("Cache-Control", "no-cache")
+	if *lastEventID != "" {
+		req.Header.Set("Last-Event-ID", *lastEventID)
+	}
+	resp, err := n.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := resp.Body.Close(); err != nil {
+			log.WithError(err).Debug("Could not close event stream")
+		}
+	}()
+	if resp.StatusCode != http.StatusOK {
+		return client.Non200Err(resp)
+	}
+	n.connected.Store(true)
+	log.WithFields(nodeFields(n)).Info("Following event stream of beacon node")
+
+	scanner := bufio.NewScanner(resp.Body)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxEventSize)
+	var id, topic string
+	var data []byte
+	for scanner.Scan() {
+		line := scanner.Bytes()
+		switch {
+		case len(line) == 0:
+			// An empty line ends the event.
+			if topic != "" && len(data) > 0 {
+				s.handleEvent(n, topic, data)
+			}
+			if id != "" {
+				*lastEventID = id
+			}
+			id, topic, data = "", "", nil
+		case bytes.HasPrefix(line, idFieldPrefix):
+			id = string(bytes.TrimSpace(line[len(idFieldPrefix):]))
+		case bytes.HasPrefix(line, eventFieldPrefix):
+			topic = string(bytes.TrimSpace(line[len(eventFieldPrefix):]))
+		case bytes.HasPrefix(line, dataFieldPrefix):
+			data = append(data, bytes.TrimSpace(line[len(dataFieldPrefix):])...)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return err
+	}
+	return errors.New("event stream closed by beacon node")
+}
+
+func (s *Service) handleEvent(n *beaconNode, topic string, data []byte) {
+	eventsReceivedTotal.WithLabelValues(n.NodeURL(), topic).Inc()
+	var err error
+	switch topic {
+	case events.AttestationTopic:
+		err = s.receiveAttestation(s.ctx, n, data)
+	case events.BlockGossipTopic:
+		err = s.receiveBlock(data)
+	case events.DroppedTopic:
+		log.WithFields(nodeFields(n)).WithField("range", string(data)).Warn("Beacon node dropped events of the stream")
+		return
+	default:
+		return
+	}
+	if err != nil {
+		log.WithError(err).WithFields(nodeFields(n)).WithField("topic", topic).Debug("Could not handle event")
+	}
+}
+
+// Converts a received attestation into indexed form and feeds it to the slasher.
+func (s *Service) receiveAttestation(ctx context.Context, n *beaconNode, data []byte) error {
+	a := &shared.Attestation{}
+	if err := json.Unmarshal(data, a); err != nil {
+		return errors.Wrap(err, "could not decode attestation")
+	}
+	att, err := a.ToConsensus()
+	if err != nil {
+		return errors.Wrap(err, "could not convert attestation")
+	}
+	root, err := att.HashTreeRoot()
+	if err != nil {
+		return errors.Wrap(err, "could not compute attestation root")
+	}
+	if seen, _ := s.seenAttestations.ContainsOrAdd(root, true); seen {
+		return nil
+	}
+	indexedAtt, err := s.indexedAttestation(ctx, n, att)
+	if err != nil {
+		// Allow the attestation to be received again from another beacon node.
+		s.seenAttestations.Remove(root)
+		return err
+	}
+	s.cfg.IndexedAttestationsFeed.Send(indexedAtt)
+	return nil
+}
+
+func (s *Service) indexedAttestation(
+	ctx context.Context, n *beaconNode, att *ethpb.Attestation,
+) (*ethpb.IndexedAttestation, error) {
+	committee, err := s.committee(ctx, n, att.Data.Slot, att.Data.CommitteeIndex)
+	if err != nil {
+		return nil, err
+	}
+	indexedAtt, err := attestation.ConvertToIndexed(ctx, att, committee)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not convert attestation to indexed form")
+	}
+	return indexedAtt, nil
+}
+
+// Feeds the header of a received block to the slasher. Blocks are followed as they are received
+// from gossip, before the beacon node drops other blocks of the same proposer and slot, so that
+// double proposals are seen.
+func (s *Service) receiveBlock(data []byte) error {
+	h := &shared.SignedBeaconBlockHeader{}
+	if err := json.Unmarshal(data, h); err != nil {
+		return errors.Wrap(err, "could not decode block header")
+	}
+	header, err := signedHeaderToConsensus(h)
+	if err != nil {
+		return errors.Wrap(err, "could not convert block header")
+	}
+	root, err := header.Header.HashTreeRoot()
+	if err != nil {
+		return errors.Wrap(err, "could not compute block root")
+	}
+	if seen, _ := s.seenBlocks.ContainsOrAdd(root, true); seen {
+		return nil
+	}
+	s.cfg.BeaconBlockHeadersFeed.Send(header)
+	return nil
+}
+
+func signedHeaderToConsensus(h *shared.SignedBeaconBlockHeader) (*ethpb.SignedBeaconBlockHeader, error) {
+	if h.Message == nil {
+		return nil, errors.New("nil header message")
+	}
+	sig, err := shared.DecodeHexWithLength(h.Signature, fieldparams.BLSSignatureLength)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not decode signature")
+	}
+	slot, err := strconv.ParseUint(h.Message.Slot, 10, 64)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not decode slot")
+	}
+	proposerIndex, err := strconv.ParseUint(h.Message.ProposerIndex, 10, 64)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not decode proposer index")
+	}
+	parentRoot, err := shared.DecodeHexWithLength(h.Message.ParentRoot, fieldparams.RootLength)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not decode parent root")
+	}
+	stateRoot, err := shared.DecodeHexWithLength(h.Message.StateRoot, fieldparams.RootLength)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not decode state root")
+	}
+	bodyRoot, err := shared.DecodeHexWithLength(h.Message.BodyRoot, fieldparams.RootLength)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not decode body root")
+	}
+	return &ethpb.SignedBeaconBlockHeader{
+		Header: &ethpb.BeaconBlockHeader{
+			Slot:          primitives.Slot(slot),
+			ProposerIndex: primitives.ValidatorIndex(proposerIndex),
+			ParentRoot:    parentRoot,
+			StateRoot:     stateRoot,
+			BodyRoot:      bodyRoot,
+		},
+		Signature: sig,
+	}, nil
+}
@@ -0,0 +1,17 @@
+package beaconclient
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	eventsReceivedTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "slasher_beacon_node_events_received_total",
+		Help: "The number of events received from the event stream of a followed beacon node",
+	}, []string{"endpoint", "topic"})
+	slashingsSubmittedTotal = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "slasher_slashings_submitted_total",
+		Help: "The number of slashings submitted to at least one followed beacon node",
+	})
+)
@@ -0,0 +1,171 @@
+// Package beaconclient feeds a slasher running in its own process with the indexed
+// attestations and block headers received by one or more beacon nodes, which it follows
+// through the event streams of their Beacon API. Slashings found by the slasher are
+// submitted back to all of these beacon nodes.
+package beaconclient
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strconv"
+	"sync/atomic"
+	"time"
+
+	lru "github.com/hashicorp/golang-lru"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v4/api/client"
+	"github.com/prysmaticlabs/prysm/v4/async/event"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/rpc/eth/beacon"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/rpc/eth/shared"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/startup"
+	lruwrpr "github.com/prysmaticlabs/prysm/v4/cache/lru"
+	"github.com/prysmaticlabs/prysm/v4/encoding/bytesutil"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	eventsPath            = "/eth/v1/events"
+	genesisPath           = "/eth/v1/beacon/genesis"
+	syncingPath           = "/eth/v1/node/syncing"
+	committeesPath        = "/eth/v1/beacon/states/head/committees"
+	attesterSlashingsPath = "/eth/v1/beacon/pool/attester_slashings"
+	proposerSlashingsPath = "/eth/v1/beacon/pool/proposer_slashings"
+
+	requestTimeout = 10 * time.Second
+	reconnectDelay = 5 * time.Second
+	// Attestations and blocks are received once per followed beacon node,
+	// so we keep track of the recent ones to feed the slasher only once.
+	seenCacheSize = 1 << 16
+)
+
+// Config for the beacon client service.
+type Config struct {
+	// Endpoints are the Beacon API endpoints of the beacon nodes to follow.
+	Endpoints               []string
+	IndexedAttestationsFeed *event.Feed
+	BeaconBlockHeadersFeed  *event.Feed
+}
+
+// Service following the event streams of beacon nodes to feed the slasher.
+type Service struct {
+	cfg              *Config
+	ctx              context.Context
+	cancel           context.CancelFunc
+	nodes            []*beaconNode
+	committees       *committeeCache
+	seenAttestations *lru.Cache
+	seenBlocks       *lru.Cache
+}
+
+type beaconNode struct {
+	*client.Client
+	connected atomic.Bool
+}
+
+// New instantiates a beacon client service following the beacon nodes at the given endpoints.
+func New(ctx context.Context, cfg *Config) (*Service, error) {
+	if len(cfg.Endpoints) == 0 {
+		return nil, errors.New("no beacon node endpoint provided")
+	}
+	nodes := make([]*beaconNode, len(cfg.Endpoints))
+	for i, endpoint := range cfg.Endpoints {
+		// No timeout is set on the client as event streams are long-lived requests,
+		// requests to other endpoints are given a timeout through their context.
+		c, err := client.NewClient(endpoint)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not create client for beacon node %s", endpoint)
+		}
+		nodes[i] = &beaconNode{Client: c}
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	return &Service{
+		cfg:              cfg,
+		ctx:              ctx,
+		cancel:           cancel,
+		nodes:            nodes,
+		committees:       newCommitteeCache(),
+		seenAttestations: lruwrpr.New(seenCacheSize),
+		seenBlocks:       lruwrpr.New(seenCacheSize),
+	}, nil
+}
+
+// Start following the event streams of the beacon nodes.
+func (s *Service) Start() {
+	for _, n := range s.nodes {
+		go s.followEvents(n)
+	}
+}
+
+// Stop the beacon client service.
+func (s *Service) Stop() error {
+	s.cancel()
+	return nil
+}
+
+// Status returns an error when the event stream of no beacon node is followed.
+func (s *Service) Status() error {
+	for _, n := range s.nodes {
+		if n.connected.Load() {
+			return nil
+		}
+	}
+	return errors.New("not connected to the event stream of any beacon node")
+}
+
+// Clock returns the clock of the chain followed by the beacon nodes, from the genesis
+// of the first beacon node to respond.
+func (s *Service) Clock(ctx context.Context) (*startup.Clock, error) {
+	var lastErr error
+	for _, n := range s.nodes {
+		resp := &beacon.GetGenesisResponse{}
+		if err := s.get(ctx, n, genesisPath, nil, resp); err != nil {
+			lastErr = err
+			log.WithError(err).WithField("endpoint", n.NodeURL()).Warn("Could not get genesis from beacon node")
+			continue
+		}
+		if resp.Data == nil {
+			lastErr = errors.New("empty genesis response")
+			continue
+		}
+		genesisTime, err := strconv.ParseInt(resp.Data.GenesisTime, 10, 64)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not parse genesis time")
+		}
+		gvr, err := shared.DecodeHexWithLength(resp.Data.GenesisValidatorsRoot, 32)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not decode genesis validators root")
+		}
+		return startup.NewClock(time.Unix(genesisTime, 0), bytesutil.ToBytes32(gvr)), nil
+	}
+	return nil, errors.Wrap(lastErr, "could not get genesis from any beacon node")
+}
+
+// Sends a GET request to the given path of the beacon node and decodes the JSON response into v.
+func (s *Service) get(ctx context.Context, n *beaconNode, path string, query url.Values, v interface{}) error {
+	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	defer cancel()
+	u := n.BaseURL().ResolveReference(&url.URL{Path: path, RawQuery: query.Encode()})
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return err
+	}
+	resp, err := n.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := resp.Body.Close(); err != nil {
+			log.WithError(err).Debug("Could not close response body")
+		}
+	}()
+	if resp.StatusCode != http.StatusOK {
+		return client.Non200Err(resp)
+	}
+	return json.NewDecoder(resp.Body).Decode(v)
+}
+
+func nodeFields(n *beaconNode) logrus.Fields {
+	return logrus.Fields{"endpoint": n.NodeURL()}
+}
@@ -0,0 +1,273 @@
+package beaconclient
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/prysmaticlabs/prysm/v4/async/event"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/operations/slashings"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/rpc/eth/shared"
+	chainSync "github.com/prysmaticlabs/prysm/v4/beacon-chain/sync"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v4/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v4/testing/assert"
+	"github.com/prysmaticlabs/prysm/v4/testing/require"
+	"github.com/prysmaticlabs/prysm/v4/testing/util"
+)
+
+var (
+	_ slashings.PoolInserter = (*Service)(nil)
+	_ chainSync.Checker      = (*Service)(nil)
+)
+
+// fakeBeaconNode serves the Beacon API endpoints used by the service.
+type fakeBeaconNode struct {
+	t                  *testing.T
+	events             []string
+	closeStream        bool
+	lastEventIDs       []string
+	syncing            bool
+	failSubmissions    bool
+	lock               sync.Mutex
+	committeeRequests  int
+	submittedSlashings []string
+}
+
+func (f *fakeBeaconNode) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	switch {
+	case r.URL.Path == eventsPath:
+		assert.Equal(f.t, "attestation,block_gossip", r.URL.Query().Get("topics"))
+		f.lock.Lock()
+		f.lastEventIDs = append(f.lastEventIDs, r.Header.Get("Last-Event-ID"))
+		f.lock.Unlock()
+		w.Header().Set("Content-Type", "text/event-stream")
+		for _, e := range f.events {
+			_, err := io.WriteString(w, e)
+			require.NoError(f.t, err)
+		}
+		w.(http.Flusher).Flush()
+		if !f.closeStream {
+			<-r.Context().Done()
+		}
+	case r.URL.Path == committeesPath:
+		f.lock.Lock()
+		f.committeeRequests++
+		f.lock.Unlock()
+		assert.Equal(f.t, "0", r.URL.Query().Get("epoch"))
+		writeJSON(f.t, w, `{"data":[{"index":"0","slot":"1","validators":["3","5","7"]},{"index":"1","slot":"1","validators":["2","4"]}]}`)
+	case r.URL.Path == genesisPath:
+		writeJSON(f.t, w, fmt.Sprintf(`{"data":{"genesis_time":"1606824023","genesis_validators_root":"%s","genesis_fork_version":"0x00000000"}}`, hexRoot(5)))
+	case r.URL.Path == syncingPath:
+		writeJSON(f.t, w, fmt.Sprintf(`{"data":{"head_slot":"1","sync_distance":"0","is_syncing":%t,"is_optimistic":false}}`, f.syncing))
+	case r.URL.Path == attesterSlashingsPath || r.URL.Path == proposerSlashingsPath:
+		if f.failSubmissions {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		body, err := io.ReadAll(r.Body)
+		require.NoError(f.t, err)
+		f.lock.Lock()
+		f.submittedSlashings = append(f.submittedSlashings, r.URL.Path+" "+string(body))
+		f.lock.Unlock()
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func writeJSON(t *testing.T, w http.ResponseWriter, body string) {
+	w.Header().Set("Content-Type", "application/json")
+	_, err := io.WriteString(w, body)
+	require.NoError(t, err)
+}
+
+func hexRoot(b byte) string {
+	return fmt.Sprintf("%#x", bytesutil.PadTo([]byte{b}, 32))
+}
+
+func testAttestation() *ethpb.Attestation {
+	return util.HydrateAttestation(&ethpb.Attestation{
+		// Validators at positions 0 and 2 of the committee.
+		AggregationBits: bitfield.Bitlist{0b1101},
+		Data:            &ethpb.AttestationData{Slot: 1},
+	})
+}
+
+func attestationEvent(t *testing.T, att *ethpb.Attestation) string {
+	data, err := json.Marshal(shared.AttestationFromConsensus(att))
+	require.NoError(t, err)
+	return "event: attestation\ndata: " + string(data) + "\n\n"
+}
+
+func blockGossipEventData(bodyRoot string) string {
+	return fmt.Sprintf(`{"message":{"slot":"2","proposer_index":"6","parent_root":"%s","state_root":"%s","body_root":"%s"},"signature":"%s"}`,
+		hexRoot(2), hexRoot(3), bodyRoot, "0x"+strings.Repeat("00", 96))
+}
+
+func setupService(t *testing.T, nodes ...*fakeBeaconNode) *Service {
+	endpoints := make([]string, len(nodes))
+	for i, n := range nodes {
+		srv := httptest.NewServer(n)
+		t.Cleanup(srv.Close)
+		endpoints[i] = srv.URL
+	}
+	s, err := New(context.Background(), &Config{
+		Endpoints:               endpoints,
+		IndexedAttestationsFeed: new(event.Feed),
+		BeaconBlockHeadersFeed:  new(event.Feed),
+	})
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, s.Stop())
+	})
+	return s
+}
+
+func TestNew_NoEndpoints(t *testing.T) {
+	_, err := New(context.Background(), &Config{})
+	require.ErrorContains(t, "no beacon node endpoint provided", err)
+}
+
+func TestService_FollowsEventStream(t *testing.T) {
+	node := &fakeBeaconNode{t: t}
+	node.events = []string{
+		attestationEvent(t, testAttestation()),
+		": comment lines are ignored\n\n",
+		"event: block_gossip\ndata: " + blockGossipEventData(hexRoot(4)) + "\n\n",
+	}
+	s := setupService(t, node)
+	atts := make(chan *ethpb.IndexedAttestation, 1)
+	attSub := s.cfg.IndexedAttestationsFeed.Subscribe(atts)
+	defer attSub.Unsubscribe()
+	headers := make(chan *ethpb.SignedBeaconBlockHeader, 1)
+	headerSub := s.cfg.BeaconBlockHeadersFeed.Subscribe(headers)
+	defer headerSub.Unsubscribe()
+
+	require.ErrorContains(t, "not connected", s.Status())
+	s.Start()
+
+	select {
+	case att := <-atts:
+		assert.DeepEqual(t, []uint64{3, 7}, att.AttestingIndices)
+		assert.Equal(t, primitives.Slot(1), att.Data.Slot)
+	case <-time.After(5 * time.Second):
+		t.Fatal("Did not receive indexed attestation")
+	}
+	select {
+	case header := <-headers:
+		assert.Equal(t, primitives.Slot(2), header.Header.Slot)
+		assert.Equal(t, primitives.ValidatorIndex(6), header.Header.ProposerIndex)
+		assert.DeepEqual(t, bytesutil.PadTo([]byte{4}, 32), header.Header.BodyRoot)
+	case <-time.After(5 * time.Second):
+		t.Fatal("Did not receive block header")
+	}
+	require.NoError(t, s.Status())
+}
+
+func TestService_ResumesEventStream(t *testing.T) {
+	node := &fakeBeaconNode{t: t, closeStream: true}
+	node.events = []string{
+		"id: 41\nevent: block_gossip\ndata: " + blockGossipEventData(hexRoot(4)) + "\n\n",
+		"event: dropped\ndata: {\"first_id\":\"40\",\"last_id\":\"40\"}\n\n",
+		": heartbeat\n\n",
+	}
+	s := setupService(t, node)
+
+	var lastEventID string
+	require.ErrorContains(t, "event stream closed by beacon node", s.streamEvents(s.nodes[0], &lastEventID))
+	assert.Equal(t, "41", lastEventID)
+	require.ErrorContains(t, "event stream closed by beacon node", s.streamEvents(s.nodes[0], &lastEventID))
+	assert.DeepEqual(t, []string{"", "41"}, node.lastEventIDs)
+}
+
+func TestService_ReceivesEventsOnce(t *testing.T) {
+	node1, node2 := &fakeBeaconNode{t: t}, &fakeBeaconNode{t: t}
+	s := setupService(t, node1, node2)
+	atts := make(chan *ethpb.IndexedAttestation, 2)
+	attSub := s.cfg.IndexedAttestationsFeed.Subscribe(atts)
+	defer attSub.Unsubscribe()
+	headers := make(chan *ethpb.SignedBeaconBlockHeader, 3)
+	headerSub := s.cfg.BeaconBlockHeadersFeed.Subscribe(headers)
+	defer headerSub.Unsubscribe()
+
+	data, err := json.Marshal(shared.AttestationFromConsensus(testAttestation()))
+	require.NoError(t, err)
+	for _, n := range s.nodes {
+		require.NoError(t, s.receiveAttestation(context.Background(), n, data))
+		require.NoError(t, s.receiveBlock([]byte(blockGossipEventData(hexRoot(4)))))
+	}
+	assert.Equal(t, 1, len(atts))
+	assert.Equal(t, 1, len(headers))
+	// Another block of the same proposer and slot is fed to the slasher, so that the double proposal is detected.
+	require.NoError(t, s.receiveBlock([]byte(blockGossipEventData(hexRoot(8)))))
+	assert.Equal(t, 2, len(headers))
+	// Committees are retrieved once per epoch.
+	assert.Equal(t, 1, node1.committeeRequests+node2.committeeRequests)
+
+	// An attestation which could not be converted can be received again.
+	att := testAttestation()
+	att.Data.CommitteeIndex = 2
+	data, err = json.Marshal(shared.AttestationFromConsensus(att))
+	require.NoError(t, err)
+	require.ErrorContains(t, "no committee at slot 1 with index 2", s.receiveAttestation(context.Background(), s.nodes[0], data))
+	require.ErrorContains(t, "no committee at slot 1 with index 2", s.receiveAttestation(context.Background(), s.nodes[1], data))
+}
+
+func TestService_SubmitsSlashings(t *testing.T) {
+	failing, accepting := &fakeBeaconNode{t: t, failSubmissions: true}, &fakeBeaconNode{t: t}
+	s := setupService(t, failing, accepting)
+
+	attSlashing := &ethpb.AttesterSlashing{
+		Attestation_1: util.HydrateIndexedAttestation(&ethpb.IndexedAttestation{AttestingIndices: []uint64{1}}),
+		Attestation_2: util.HydrateIndexedAttestation(&ethpb.IndexedAttestation{AttestingIndices: []uint64{1}}),
+	}
+	require.NoError(t, s.InsertAttesterSlashing(context.Background(), nil, attSlashing))
+	propSlashing := &ethpb.ProposerSlashing{
+		Header_1: util.HydrateSignedBeaconHeader(&ethpb.SignedBeaconBlockHeader{}),
+		Header_2: util.HydrateSignedBeaconHeader(&ethpb.SignedBeaconBlockHeader{}),
+	}
+	require.NoError(t, s.InsertProposerSlashing(context.Background(), nil, propSlashing))
+	require.Equal(t, 2, len(accepting.submittedSlashings))
+	assert.Equal(t, true, strings.HasPrefix(accepting.submittedSlashings[0], attesterSlashingsPath+" {\"attestation_1\""))
+	assert.Equal(t, true, strings.HasPrefix(accepting.submittedSlashings[1], proposerSlashingsPath+" {\"signed_header_1\""))
+
+	s = setupService(t, failing)
+	require.ErrorContains(t, "could not submit slashing to any beacon node", s.InsertAttesterSlashing(context.Background(), nil, attSlashing))
+}
+
+func TestService_Syncing(t *testing.T) {
+	syncing, synced := &fakeBeaconNode{t: t, syncing: true}, &fakeBeaconNode{t: t}
+	assert.Equal(t, true, setupService(t, syncing).Syncing())
+	s := setupService(t, syncing, synced)
+	assert.Equal(t, false, s.Syncing())
+	assert.Equal(t, true, s.Synced())
+}
+
+func TestService_Clock(t *testing.T) {
+	s := setupService(t, &fakeBeaconNode{t: t})
+	clock, err := s.Clock(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, int64(1606824023), clock.GenesisTime().Unix())
+	assert.Equal(t, bytesutil.ToBytes32(bytesutil.PadTo([]byte{5}, 32)), clock.GenesisValidatorsRoot())
+}
+
+func TestCommitteeCache_Prunes(t *testing.T) {
+	c := newCommitteeCache()
+	for e := primitives.Epoch(0); e < 10; e++ {
+		c.add(e, map[committeeKey][]primitives.ValidatorIndex{})
+	}
+	assert.Equal(t, committeeCacheEpochs, len(c.committeesByEpoch))
+	_, ok := c.get(9 - committeeCacheEpochs)
+	assert.Equal(t, false, ok)
+	_, ok = c.get(10 - committeeCacheEpochs)
+	assert.Equal(t, true, ok)
+}
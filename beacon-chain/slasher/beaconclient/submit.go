@@ -0,0 +1,86 @@
+package beaconclient
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v4/api/client"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/rpc/eth/shared"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/state"
+	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+)
+
+// InsertAttesterSlashing submits an attester slashing to the operations pool of every
+// followed beacon node. The beacon state is not used, the beacon nodes verify the
+// slashing against their own state.
+func (s *Service) InsertAttesterSlashing(
+	ctx context.Context, _ state.ReadOnlyBeaconState, slashing *ethpb.AttesterSlashing,
+) error {
+	slashings, err := shared.AttesterSlashingsFromConsensus([]*ethpb.AttesterSlashing{slashing})
+	if err != nil {
+		return errors.Wrap(err, "could not convert attester slashing")
+	}
+	return s.submit(ctx, attesterSlashingsPath, slashings[0])
+}
+
+// InsertProposerSlashing submits a proposer slashing to the operations pool of every
+// followed beacon node. The beacon state is not used, the beacon nodes verify the
+// slashing against their own state.
+func (s *Service) InsertProposerSlashing(
+	ctx context.Context, _ state.ReadOnlyBeaconState, slashing *ethpb.ProposerSlashing,
+) error {
+	slashings, err := shared.ProposerSlashingsFromConsensus([]*ethpb.ProposerSlashing{slashing})
+	if err != nil {
+		return errors.Wrap(err, "could not convert proposer slashing")
+	}
+	return s.submit(ctx, proposerSlashingsPath, slashings[0])
+}
+
+// Submits the slashing to all beacon nodes, failing only if no beacon node accepted it.
+func (s *Service) submit(ctx context.Context, path string, slashing interface{}) error {
+	body, err := json.Marshal(slashing)
+	if err != nil {
+		return errors.Wrap(err, "could not marshal slashing")
+	}
+	submitted := 0
+	for _, n := range s.nodes {
+		if err := s.post(ctx, n, path, body); err != nil {
+			log.WithError(err).WithFields(nodeFields(n)).Warn("Could not submit slashing to beacon node")
+			continue
+		}
+		submitted++
+	}
+	if submitted == 0 {
+		return errors.New("could not submit slashing to any beacon node")
+	}
+	slashingsSubmittedTotal.Inc()
+	return nil
+}
+
+func (s *Service) post(ctx context.Context, n *beaconNode, path string, body []byte) error {
+	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	defer cancel()
+	u := n.BaseURL().ResolveReference(&url.URL{Path: path})
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, u.String(), bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := n.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := resp.Body.Close(); err != nil {
+			log.WithError(err).Debug("Could not close response body")
+		}
+	}()
+	if resp.StatusCode != http.StatusOK {
+		return client.Non200Err(resp)
+	}
+	return nil
+}
@@ -0,0 +1,36 @@
+package beaconclient
+
+import (
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/rpc/eth/node"
+)
+
+// Initialized returns true, the slasher only waits for a followed beacon node to be synced.
+func (*Service) Initialized() bool {
+	return true
+}
+
+// Syncing returns true unless at least one of the followed beacon nodes is synced.
+func (s *Service) Syncing() bool {
+	for _, n := range s.nodes {
+		resp := &node.SyncStatusResponse{}
+		if err := s.get(s.ctx, n, syncingPath, nil, resp); err != nil {
+			log.WithError(err).WithFields(nodeFields(n)).Debug("Could not get sync status of beacon node")
+			continue
+		}
+		if resp.Data != nil && !resp.Data.IsSyncing {
+			return false
+		}
+	}
+	return true
+}
+
+// Synced returns true if at least one of the followed beacon nodes is synced.
+func (s *Service) Synced() bool {
+	return !s.Syncing()
+}
+
+// Resync is not supported, the beacon nodes sync on their own.
+func (*Service) Resync() error {
+	return errors.New("resync is not supported when following beacon nodes")
+}
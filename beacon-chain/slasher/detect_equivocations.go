@@ -0,0 +1,172 @@
+package slasher
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/core/feed"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/core/feed/operation"
+	slashertypes "github.com/prysmaticlabs/prysm/v4/beacon-chain/slasher/types"
+	"go.opencensus.io/trace"
+)
+
+// detectBlobSidecarEquivocations takes in blob sidecar header wrappers and returns the equivocations
+// found among them and with respect to the headers saved in the database. Incoming headers are saved,
+// keeping the first header seen for a given slot, proposer and blob index.
+func (s *Service) detectBlobSidecarEquivocations(
+	ctx context.Context,
+	headers []*slashertypes.SignedBlobSidecarHeaderWrapper,
+) ([]*slashertypes.BlobSidecarEquivocation, error) {
+	ctx, span := trace.StartSpan(ctx, "slasher.detectBlobSidecarEquivocations")
+	defer span.End()
+	if len(headers) == 0 {
+		return nil, nil
+	}
+	equivocations, err := s.serviceCfg.Database.CheckBlobSidecarEquivocations(ctx, headers)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not check for blob sidecar equivocations on disk")
+	}
+	// Headers conflicting with a saved header are already reported, so we only check the
+	// incoming headers with respect to each other when none is saved for their key. The same
+	// header may be received several times, in which case it is only reported once.
+	reported := make(map[string]bool, len(equivocations))
+	seen := make(map[[32]byte]bool, len(equivocations))
+	deduped := equivocations[:0]
+	for _, e := range equivocations {
+		reported[blobSidecarKey(e.BlobSidecarWrapper)] = true
+		if seen[e.BlobSidecarWrapper.SigningRoot] {
+			continue
+		}
+		seen[e.BlobSidecarWrapper.SigningRoot] = true
+		deduped = append(deduped, e)
+	}
+	equivocations = deduped
+	existingHeaders := make(map[string]*slashertypes.SignedBlobSidecarHeaderWrapper)
+	for _, header := range headers {
+		key := blobSidecarKey(header)
+		existingHeader, ok := existingHeaders[key]
+		if !ok {
+			existingHeaders[key] = header
+			continue
+		}
+		if !reported[key] && existingHeader.SigningRoot != header.SigningRoot {
+			equivocations = append(equivocations, &slashertypes.BlobSidecarEquivocation{
+				PrevBlobSidecarWrapper: existingHeader,
+				BlobSidecarWrapper:     header,
+			})
+		}
+	}
+	if err := s.serviceCfg.Database.SaveBlobSidecarHeaders(ctx, headers); err != nil {
+		return nil, errors.Wrap(err, "could not save blob sidecar headers")
+	}
+	return equivocations, nil
+}
+
+// detectSyncCommitteeEquivocations takes in sync committee message wrappers and returns the
+// equivocations found among them and with respect to the messages saved in the database. Incoming
+// messages are saved, keeping the first message seen for a given slot and validator.
+func (s *Service) detectSyncCommitteeEquivocations(
+	ctx context.Context,
+	messages []*slashertypes.SyncCommitteeMessageWrapper,
+) ([]*slashertypes.SyncCommitteeEquivocation, error) {
+	ctx, span := trace.StartSpan(ctx, "slasher.detectSyncCommitteeEquivocations")
+	defer span.End()
+	if len(messages) == 0 {
+		return nil, nil
+	}
+	equivocations, err := s.serviceCfg.Database.CheckSyncCommitteeEquivocations(ctx, messages)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not check for sync committee equivocations on disk")
+	}
+	reported := make(map[string]bool, len(equivocations))
+	seen := make(map[string]bool, len(equivocations))
+	deduped := equivocations[:0]
+	for _, e := range equivocations {
+		key := syncMessageKey(e.MessageWrapper)
+		reported[key] = true
+		// Sync committee messages are signed over the block root only, so the key is needed.
+		seenKey := key + ":" + string(e.MessageWrapper.SigningRoot[:])
+		if seen[seenKey] {
+			continue
+		}
+		seen[seenKey] = true
+		deduped = append(deduped, e)
+	}
+	equivocations = deduped
+	existingMessages := make(map[string]*slashertypes.SyncCommitteeMessageWrapper)
+	for _, msg := range messages {
+		key := syncMessageKey(msg)
+		existingMessage, ok := existingMessages[key]
+		if !ok {
+			existingMessages[key] = msg
+			continue
+		}
+		// The same message is received once per sync subcommittee of the validator.
+		if !reported[key] && existingMessage.SigningRoot != msg.SigningRoot {
+			equivocations = append(equivocations, &slashertypes.SyncCommitteeEquivocation{
+				PrevMessageWrapper: existingMessage,
+				MessageWrapper:     msg,
+			})
+		}
+	}
+	if err := s.serviceCfg.Database.SaveSyncCommitteeMessages(ctx, messages); err != nil {
+		return nil, errors.Wrap(err, "could not save sync committee messages")
+	}
+	return equivocations, nil
+}
+
+// Saves the evidence of blob sidecar equivocations, logs them, and notifies event subscribers.
+func (s *Service) processBlobSidecarEquivocations(
+	ctx context.Context, equivocations []*slashertypes.BlobSidecarEquivocation,
+) error {
+	if len(equivocations) == 0 {
+		return nil
+	}
+	if err := s.serviceCfg.Database.SaveBlobSidecarEquivocations(ctx, equivocations); err != nil {
+		return errors.Wrap(err, "could not save blob sidecar equivocations")
+	}
+	for _, e := range equivocations {
+		blobSidecarEquivocationsTotal.Inc()
+		logBlobSidecarEquivocation(e)
+		if s.serviceCfg.OperationNotifier != nil {
+			s.serviceCfg.OperationNotifier.OperationFeed().Send(&feed.Event{
+				Type: operation.BlobSidecarEquivocationDetected,
+				Data: &operation.BlobSidecarEquivocationDetectedData{Equivocation: e},
+			})
+		}
+	}
+	return nil
+}
+
+// Saves the evidence of sync committee equivocations, logs them, and notifies event subscribers.
+func (s *Service) processSyncCommitteeEquivocations(
+	ctx context.Context, equivocations []*slashertypes.SyncCommitteeEquivocation,
+) error {
+	if len(equivocations) == 0 {
+		return nil
+	}
+	if err := s.serviceCfg.Database.SaveSyncCommitteeEquivocations(ctx, equivocations); err != nil {
+		return errors.Wrap(err, "could not save sync committee equivocations")
+	}
+	for _, e := range equivocations {
+		syncCommitteeEquivocationsTotal.Inc()
+		logSyncCommitteeEquivocation(e)
+		if s.serviceCfg.OperationNotifier != nil {
+			s.serviceCfg.OperationNotifier.OperationFeed().Send(&feed.Event{
+				Type: operation.SyncCommitteeEquivocationDetected,
+				Data: &operation.SyncCommitteeEquivocationDetectedData{Equivocation: e},
+			})
+		}
+	}
+	return nil
+}
+
+func blobSidecarKey(header *slashertypes.SignedBlobSidecarHeaderWrapper) string {
+	msg := header.SignedBlobSidecarHeader.Message
+	return uintToString(uint64(msg.Slot)) + ":" + uintToString(uint64(msg.ProposerIndex)) + ":" + uintToString(msg.Index)
+}
+
+func syncMessageKey(msg *slashertypes.SyncCommitteeMessageWrapper) string {
+	m := msg.SyncCommitteeMessage
+	return uintToString(uint64(m.Slot)) + ":" + uintToString(uint64(m.ValidatorIndex))
+}
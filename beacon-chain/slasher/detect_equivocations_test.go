@@ -0,0 +1,147 @@
+package slasher
+
+import (
+	"context"
+	"testing"
+
+	mock "github.com/prysmaticlabs/prysm/v4/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/core/feed"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/core/feed/operation"
+	dbtest "github.com/prysmaticlabs/prysm/v4/beacon-chain/db/testing"
+	slashertypes "github.com/prysmaticlabs/prysm/v4/beacon-chain/slasher/types"
+	"github.com/prysmaticlabs/prysm/v4/config/params"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v4/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v4/testing/assert"
+	"github.com/prysmaticlabs/prysm/v4/testing/require"
+	logTest "github.com/sirupsen/logrus/hooks/test"
+)
+
+func Test_processQueuedEquivocations_DetectsEquivocations(t *testing.T) {
+	hook := logTest.NewGlobal()
+	slasherDB := dbtest.SetupSlasherDB(t)
+	ctx, cancel := context.WithCancel(context.Background())
+
+	notifier := &mock.MockOperationNotifier{}
+	events := make(chan *feed.Event, 10)
+	sub := notifier.OperationFeed().Subscribe(events)
+	defer sub.Unsubscribe()
+
+	s := &Service{
+		serviceCfg: &ServiceConfig{
+			Database:          slasherDB,
+			StateNotifier:     &mock.MockStateNotifier{},
+			OperationNotifier: notifier,
+		},
+		params:            DefaultParams(),
+		blobSidecarsQueue: newBlobSidecarsQueue(),
+		syncMessagesQueue: newSyncMessagesQueue(),
+	}
+
+	// A sync committee message conflicting with one saved on disk.
+	require.NoError(t, slasherDB.SaveSyncCommitteeMessages(ctx, []*slashertypes.SyncCommitteeMessageWrapper{
+		createSyncCommitteeMessageWrapper(2, 1, []byte{1}),
+	}))
+	s.syncMessagesQueue.push(createSyncCommitteeMessageWrapper(2, 1, []byte{2}))
+	// The same message received for several subcommittees is not an equivocation.
+	s.syncMessagesQueue.push(createSyncCommitteeMessageWrapper(2, 3, []byte{1}))
+	s.syncMessagesQueue.push(createSyncCommitteeMessageWrapper(2, 3, []byte{1}))
+	// Conflicting blob sidecars within the same batch.
+	s.blobSidecarsQueue.push(createBlobSidecarHeaderWrapper(t, 2, 4, 0, []byte{1}))
+	s.blobSidecarsQueue.push(createBlobSidecarHeaderWrapper(t, 2, 4, 0, []byte{2}))
+	s.blobSidecarsQueue.push(createBlobSidecarHeaderWrapper(t, 2, 4, 1, []byte{2}))
+
+	currentSlotChan := make(chan primitives.Slot)
+	exitChan := make(chan struct{})
+	go func() {
+		s.processQueuedEquivocations(ctx, currentSlotChan)
+		exitChan <- struct{}{}
+	}()
+	currentSlotChan <- 2
+	cancel()
+	<-exitChan
+
+	require.LogsContain(t, hook, "Blob sidecar equivocation detected")
+	require.LogsContain(t, hook, "Sync committee equivocation detected")
+
+	blobEquivocations, err := s.BlobSidecarEquivocations(context.Background(), 0, 2)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(blobEquivocations))
+	assert.Equal(t, uint64(0), blobEquivocations[0].BlobSidecarWrapper.SignedBlobSidecarHeader.Message.Index)
+	syncEquivocations, err := s.SyncCommitteeEquivocations(context.Background(), 0, 2)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(syncEquivocations))
+	assert.Equal(t, primitives.ValidatorIndex(1), syncEquivocations[0].MessageWrapper.SyncCommitteeMessage.ValidatorIndex)
+
+	require.Equal(t, 2, len(events))
+	assert.Equal(t, feed.EventType(operation.BlobSidecarEquivocationDetected), (<-events).Type)
+	assert.Equal(t, feed.EventType(operation.SyncCommitteeEquivocationDetected), (<-events).Type)
+}
+
+func Test_detectSyncCommitteeEquivocations_ReportsOncePerKey(t *testing.T) {
+	ctx := context.Background()
+	s := &Service{
+		serviceCfg: &ServiceConfig{
+			Database: dbtest.SetupSlasherDB(t),
+		},
+	}
+	require.NoError(t, s.serviceCfg.Database.SaveSyncCommitteeMessages(ctx, []*slashertypes.SyncCommitteeMessageWrapper{
+		createSyncCommitteeMessageWrapper(1, 1, []byte{1}),
+	}))
+	// Both incoming messages conflict with the saved one, but they are the same
+	// message so the equivocation is only reported once.
+	equivocations, err := s.detectSyncCommitteeEquivocations(ctx, []*slashertypes.SyncCommitteeMessageWrapper{
+		createSyncCommitteeMessageWrapper(1, 1, []byte{2}),
+		createSyncCommitteeMessageWrapper(1, 1, []byte{2}),
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(equivocations))
+	assert.Equal(t, bytesutil.ToBytes32([]byte{1}), equivocations[0].PrevMessageWrapper.SigningRoot)
+
+	equivocations, err = s.detectSyncCommitteeEquivocations(ctx, []*slashertypes.SyncCommitteeMessageWrapper{
+		createSyncCommitteeMessageWrapper(2, 1, []byte{1}),
+		createSyncCommitteeMessageWrapper(2, 1, []byte{2}),
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(equivocations))
+}
+
+func createBlobSidecarHeaderWrapper(
+	t *testing.T, slot primitives.Slot, proposerIndex primitives.ValidatorIndex, index uint64, blobRoot []byte,
+) *slashertypes.SignedBlobSidecarHeaderWrapper {
+	header := &ethpb.BlindedBlobSidecar{
+		BlockRoot:       bytesutil.PadTo(blobRoot, 32),
+		Index:           index,
+		Slot:            slot,
+		BlockParentRoot: params.BeaconConfig().ZeroHash[:],
+		ProposerIndex:   proposerIndex,
+		BlobRoot:        bytesutil.PadTo(blobRoot, 32),
+		KzgCommitment:   make([]byte, 48),
+		KzgProof:        make([]byte, 48),
+	}
+	signingRoot, err := header.HashTreeRoot()
+	require.NoError(t, err)
+	return &slashertypes.SignedBlobSidecarHeaderWrapper{
+		SignedBlobSidecarHeader: &ethpb.SignedBlindedBlobSidecar{
+			Message:   header,
+			Signature: params.BeaconConfig().EmptySignature[:],
+		},
+		SigningRoot: signingRoot,
+	}
+}
+
+func createSyncCommitteeMessageWrapper(
+	slot primitives.Slot, validatorIndex primitives.ValidatorIndex, blockRoot []byte,
+) *slashertypes.SyncCommitteeMessageWrapper {
+	root := bytesutil.ToBytes32(blockRoot)
+	return &slashertypes.SyncCommitteeMessageWrapper{
+		SyncCommitteeMessage: &ethpb.SyncCommitteeMessage{
+			Slot:           slot,
+			BlockRoot:      root[:],
+			ValidatorIndex: validatorIndex,
+			Signature:      params.BeaconConfig().EmptySignature[:],
+		},
+		SigningRoot: root,
+	}
+}
@@ -2,13 +2,16 @@ package slasher
 
 import (
 	"bytes"
+	"fmt"
 	"strconv"
 
+	ssz "github.com/prysmaticlabs/fastssz"
 	slashertypes "github.com/prysmaticlabs/prysm/v4/beacon-chain/slasher/types"
 	fieldparams "github.com/prysmaticlabs/prysm/v4/config/fieldparams"
 	"github.com/prysmaticlabs/prysm/v4/config/params"
 	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/v4/container/slice"
+	"github.com/prysmaticlabs/prysm/v4/encoding/bytesutil"
 	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
 	"github.com/sirupsen/logrus"
 )
@@ -119,6 +122,54 @@ func validateBlockHeaderIntegrity(header *ethpb.SignedBeaconBlockHeader) bool {
 	return true
 }
 
+// Validates the signed blob sidecar integrity, ensuring we have no nil values.
+func validateBlobSidecarIntegrity(sidecar *ethpb.SignedBlobSidecar) bool {
+	// If a signed blob sidecar is malformed, we drop it.
+	if sidecar == nil ||
+		sidecar.Message == nil ||
+		len(sidecar.Signature) != fieldparams.BLSSignatureLength ||
+		bytes.Equal(sidecar.Signature, make([]byte, fieldparams.BLSSignatureLength)) {
+		return false
+	}
+	return true
+}
+
+// Validates the sync committee message integrity, ensuring we have no nil values.
+func validateSyncCommitteeMessageIntegrity(msg *ethpb.SyncCommitteeMessage) bool {
+	// If a sync committee message is malformed, we drop it.
+	if msg == nil ||
+		len(msg.BlockRoot) != fieldparams.RootLength ||
+		len(msg.Signature) != fieldparams.BLSSignatureLength ||
+		bytes.Equal(msg.Signature, make([]byte, fieldparams.BLSSignatureLength)) {
+		return false
+	}
+	return true
+}
+
+// Converts a signed blob sidecar into a signed blob sidecar header, in which the blob is replaced by
+// its hash tree root. Both have the same hash tree root, so the proposer signature stays valid.
+func blobSidecarHeader(sidecar *ethpb.SignedBlobSidecar) (*ethpb.SignedBlindedBlobSidecar, error) {
+	hh := ssz.NewHasher()
+	hh.PutBytes(sidecar.Message.Blob)
+	blobRoot, err := hh.HashRoot()
+	if err != nil {
+		return nil, err
+	}
+	return &ethpb.SignedBlindedBlobSidecar{
+		Message: &ethpb.BlindedBlobSidecar{
+			BlockRoot:       sidecar.Message.BlockRoot,
+			Index:           sidecar.Message.Index,
+			Slot:            sidecar.Message.Slot,
+			BlockParentRoot: sidecar.Message.BlockParentRoot,
+			ProposerIndex:   sidecar.Message.ProposerIndex,
+			BlobRoot:        blobRoot[:],
+			KzgCommitment:   sidecar.Message.KzgCommitment,
+			KzgProof:        sidecar.Message.KzgProof,
+		},
+		Signature: sidecar.Signature,
+	}, nil
+}
+
 func logAttesterSlashing(slashing *ethpb.AttesterSlashing) {
 	indices := slice.IntersectionUint64(slashing.Attestation_1.AttestingIndices, slashing.Attestation_2.AttestingIndices)
 	log.WithFields(logrus.Fields{
@@ -137,6 +188,29 @@ func logProposerSlashing(slashing *ethpb.ProposerSlashing) {
 	}).Info("Proposer slashing detected")
 }
 
+func logBlobSidecarEquivocation(equivocation *slashertypes.BlobSidecarEquivocation) {
+	prev := equivocation.PrevBlobSidecarWrapper.SignedBlobSidecarHeader.Message
+	header := equivocation.BlobSidecarWrapper.SignedBlobSidecarHeader.Message
+	log.WithFields(logrus.Fields{
+		"validatorIndex": header.ProposerIndex,
+		"slot":           header.Slot,
+		"index":          header.Index,
+		"prevBlockRoot":  fmt.Sprintf("%#x", bytesutil.Trunc(prev.BlockRoot)),
+		"blockRoot":      fmt.Sprintf("%#x", bytesutil.Trunc(header.BlockRoot)),
+	}).Warn("Blob sidecar equivocation detected")
+}
+
+func logSyncCommitteeEquivocation(equivocation *slashertypes.SyncCommitteeEquivocation) {
+	prev := equivocation.PrevMessageWrapper.SyncCommitteeMessage
+	msg := equivocation.MessageWrapper.SyncCommitteeMessage
+	log.WithFields(logrus.Fields{
+		"validatorIndex": msg.ValidatorIndex,
+		"slot":           msg.Slot,
+		"prevBlockRoot":  fmt.Sprintf("%#x", bytesutil.Trunc(prev.BlockRoot)),
+		"blockRoot":      fmt.Sprintf("%#x", bytesutil.Trunc(msg.BlockRoot)),
+	}).Warn("Sync committee equivocation detected")
+}
+
 // Turns a uint64 value to a string representation.
 func uintToString(val uint64) string {
 	return strconv.FormatUint(val, 10)
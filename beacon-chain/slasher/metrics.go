@@ -37,6 +37,22 @@ var (
 		Name: "slasher_blocks_processed_total",
 		Help: "Total number of blocks successfully processed by slasher",
 	})
+	receivedBlobSidecarsTotal = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "slasher_blob_sidecars_received_total",
+		Help: "Total number of blob sidecars received by slasher",
+	})
+	receivedSyncMessagesTotal = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "slasher_sync_committee_messages_received_total",
+		Help: "Total number of sync committee messages received by slasher",
+	})
+	blobSidecarEquivocationsTotal = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "slasher_blob_sidecar_equivocations_total",
+		Help: "Total blob sidecar equivocations detected by slasher",
+	})
+	syncCommitteeEquivocationsTotal = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "slasher_sync_committee_equivocations_total",
+		Help: "Total sync committee message equivocations detected by slasher",
+	})
 	doubleProposalsTotal = promauto.NewCounter(prometheus.CounterOpts{
 		Name: "slasher_double_proposals_total",
 		Help: "Total slashable proposals successfully detected by slasher",
@@ -0,0 +1,5 @@
+package node
+
+import "github.com/sirupsen/logrus"
+
+var log = logrus.WithField("prefix", "node")
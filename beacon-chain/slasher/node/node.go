@@ -0,0 +1,243 @@
+// Package node is the main process which handles the lifecycle of
+// the runtime services in a standalone slasher process, gracefully shutting
+// everything down upon close.
+package node
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v4/async/event"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/db/kv"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/db/slasherkv"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/slasher"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/slasher/beaconclient"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/startup"
+	"github.com/prysmaticlabs/prysm/v4/cmd"
+	"github.com/prysmaticlabs/prysm/v4/cmd/slasher/flags"
+	"github.com/prysmaticlabs/prysm/v4/config/features"
+	"github.com/prysmaticlabs/prysm/v4/config/params"
+	"github.com/prysmaticlabs/prysm/v4/monitoring/prometheus"
+	"github.com/prysmaticlabs/prysm/v4/runtime"
+	"github.com/prysmaticlabs/prysm/v4/runtime/debug"
+	"github.com/prysmaticlabs/prysm/v4/runtime/version"
+	"github.com/sirupsen/logrus"
+	"github.com/urfave/cli/v2"
+)
+
+// Delay between attempts to fetch the genesis of the followed chain.
+const genesisRetryDelay = 5 * time.Second
+
+// SlasherNode defines a standalone slasher process, following the event streams of
+// beacon nodes through the Beacon API and submitting the slashings it detects back to them.
+type SlasherNode struct {
+	cliCtx            *cli.Context
+	ctx               context.Context
+	cancel            context.CancelFunc
+	services          *runtime.ServiceRegistry // Lifecycle and service store.
+	lock              sync.RWMutex
+	db                *slasherkv.Store
+	beaconClient      *beaconclient.Service
+	clockSynchronizer *startup.ClockSynchronizer
+	stop              chan struct{} // Channel to wait for termination notifications.
+}
+
+// New creates a new instance of the standalone slasher.
+func New(cliCtx *cli.Context) (*SlasherNode, error) {
+	if err := features.ConfigureSlasher(cliCtx); err != nil {
+		return nil, err
+	}
+	if err := cmd.ConfigureBeaconChain(cliCtx); err != nil {
+		return nil, err
+	}
+	if cliCtx.IsSet(cmd.ChainConfigFileFlag.Name) {
+		chainConfigFileName := cliCtx.String(cmd.ChainConfigFileFlag.Name)
+		if err := params.LoadChainConfigFile(chainConfigFileName, nil); err != nil {
+			return nil, err
+		}
+	}
+
+	ctx, cancel := context.WithCancel(cliCtx.Context)
+	node := &SlasherNode{
+		cliCtx:            cliCtx,
+		ctx:               ctx,
+		cancel:            cancel,
+		services:          runtime.NewServiceRegistry(),
+		clockSynchronizer: startup.NewClockSynchronizer(),
+		stop:              make(chan struct{}),
+	}
+
+	if !cliCtx.Bool(cmd.DisableMonitoringFlag.Name) {
+		if err := node.registerPrometheusService(); err != nil {
+			return nil, err
+		}
+	}
+	if err := node.startDB(); err != nil {
+		return nil, err
+	}
+
+	indexedAttsFeed := new(event.Feed)
+	beaconBlockHeadersFeed := new(event.Feed)
+
+	log.Debugln("Registering Beacon Client Service")
+	if err := node.registerBeaconClient(indexedAttsFeed, beaconBlockHeadersFeed); err != nil {
+		return nil, err
+	}
+	log.Debugln("Registering Slasher Service")
+	if err := node.registerSlasherService(indexedAttsFeed, beaconBlockHeadersFeed); err != nil {
+		return nil, err
+	}
+	return node, nil
+}
+
+// Start the slasher and its services, blocking until the node is closed.
+func (n *SlasherNode) Start() {
+	n.lock.Lock()
+
+	log.WithFields(logrus.Fields{
+		"version": version.Version(),
+	}).Info("Starting slasher node")
+
+	n.services.StartAll()
+	go n.waitForGenesis()
+
+	stop := n.stop
+	n.lock.Unlock()
+
+	go func() {
+		sigc := make(chan os.Signal, 1)
+		signal.Notify(sigc, syscall.SIGINT, syscall.SIGTERM)
+		defer signal.Stop(sigc)
+		<-sigc
+		log.Info("Got interrupt, shutting down...")
+		debug.Exit(n.cliCtx) // Ensure trace and CPU profile data are flushed.
+		go n.Close()
+		for i := 10; i > 0; i-- {
+			<-sigc
+			if i > 1 {
+				log.WithField("times", i-1).Info("Already shutting down, interrupt more to panic.")
+			}
+		}
+		panic("Panic closing the slasher node")
+	}()
+
+	// Wait for stop channel to be closed.
+	<-stop
+}
+
+// Close handles graceful shutdown of the system.
+func (n *SlasherNode) Close() {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+
+	log.Info("Stopping slasher node")
+	n.services.StopAll()
+	if err := n.db.Close(); err != nil {
+		log.WithError(err).Error("Failed to close database")
+	}
+	n.cancel()
+	close(n.stop)
+}
+
+// waitForGenesis fetches the genesis of the chain followed by the beacon nodes,
+// retrying until one of them responds, and starts the clock of the slasher with it.
+func (n *SlasherNode) waitForGenesis() {
+	for {
+		clock, err := n.beaconClient.Clock(n.ctx)
+		if err == nil {
+			if err := n.clockSynchronizer.SetClock(clock); err != nil {
+				log.WithError(err).Error("Could not set clock")
+			}
+			return
+		}
+		log.WithError(err).Warn("Could not get genesis from any beacon node, retrying")
+		select {
+		case <-n.ctx.Done():
+			return
+		case <-time.After(genesisRetryDelay):
+		}
+	}
+}
+
+func (n *SlasherNode) startDB() error {
+	dbPath := filepath.Join(n.cliCtx.String(cmd.DataDirFlag.Name), kv.BeaconNodeDbDirName)
+	clearDB := n.cliCtx.Bool(cmd.ClearDB.Name)
+	forceClearDB := n.cliCtx.Bool(cmd.ForceClearDB.Name)
+
+	log.WithField("database-path", dbPath).Info("Checking DB")
+
+	d, err := slasherkv.NewKVStore(n.ctx, dbPath)
+	if err != nil {
+		return err
+	}
+	clearDBConfirmed := false
+	if clearDB && !forceClearDB {
+		actionText := "This will delete your slasher database stored in your data directory. " +
+			"Do you want to proceed? (Y/N)"
+		deniedText := "Database will not be deleted. No changes have been made."
+		clearDBConfirmed, err = cmd.ConfirmAction(actionText, deniedText)
+		if err != nil {
+			return err
+		}
+	}
+	if clearDBConfirmed || forceClearDB {
+		log.Warning("Removing database")
+		if err := d.Close(); err != nil {
+			return errors.Wrap(err, "could not close db prior to clearing")
+		}
+		if err := d.ClearDB(); err != nil {
+			return errors.Wrap(err, "could not clear database")
+		}
+		d, err = slasherkv.NewKVStore(n.ctx, dbPath)
+		if err != nil {
+			return errors.Wrap(err, "could not create new database")
+		}
+	}
+
+	n.db = d
+	return nil
+}
+
+func (n *SlasherNode) registerPrometheusService() error {
+	service := prometheus.NewService(
+		fmt.Sprintf("%s:%d", n.cliCtx.String(cmd.MonitoringHostFlag.Name), n.cliCtx.Int(flags.MonitoringPortFlag.Name)),
+		n.services,
+	)
+	logrus.AddHook(prometheus.NewLogrusCollector())
+	return n.services.RegisterService(service)
+}
+
+func (n *SlasherNode) registerBeaconClient(indexedAttsFeed, beaconBlockHeadersFeed *event.Feed) error {
+	svc, err := beaconclient.New(n.ctx, &beaconclient.Config{
+		Endpoints:               n.cliCtx.StringSlice(flags.BeaconNodesFlag.Name),
+		IndexedAttestationsFeed: indexedAttsFeed,
+		BeaconBlockHeadersFeed:  beaconBlockHeadersFeed,
+	})
+	if err != nil {
+		return errors.Wrap(err, "could not create beacon client")
+	}
+	n.beaconClient = svc
+	return n.services.RegisterService(svc)
+}
+
+func (n *SlasherNode) registerSlasherService(indexedAttsFeed, beaconBlockHeadersFeed *event.Feed) error {
+	svc, err := slasher.New(n.ctx, &slasher.ServiceConfig{
+		IndexedAttestationsFeed: indexedAttsFeed,
+		BeaconBlockHeadersFeed:  beaconBlockHeadersFeed,
+		Database:                n.db,
+		SlashingPoolInserter:    n.beaconClient,
+		SyncChecker:             n.beaconClient,
+		ClockWaiter:             n.clockSynchronizer,
+	})
+	if err != nil {
+		return err
+	}
+	return n.services.RegisterService(svc)
+}
@@ -14,7 +14,7 @@ import (
 func (s *Service) processAttesterSlashings(ctx context.Context, slashings []*ethpb.AttesterSlashing) error {
 	var beaconState state.BeaconState
 	var err error
-	if len(slashings) > 0 {
+	if len(slashings) > 0 && s.serviceCfg.HeadStateFetcher != nil {
 		beaconState, err = s.serviceCfg.HeadStateFetcher.HeadState(ctx)
 		if err != nil {
 			return err
@@ -50,7 +50,7 @@ func (s *Service) processAttesterSlashings(ctx context.Context, slashings []*eth
 func (s *Service) processProposerSlashings(ctx context.Context, slashings []*ethpb.ProposerSlashing) error {
 	var beaconState state.BeaconState
 	var err error
-	if len(slashings) > 0 {
+	if len(slashings) > 0 && s.serviceCfg.HeadStateFetcher != nil {
 		beaconState, err = s.serviceCfg.HeadStateFetcher.HeadState(ctx)
 		if err != nil {
 			return err
@@ -79,6 +79,10 @@ func (s *Service) processProposerSlashings(ctx context.Context, slashings []*eth
 }
 
 func (s *Service) verifyBlockSignature(ctx context.Context, header *ethpb.SignedBeaconBlockHeader) error {
+	if s.serviceCfg.StateGen == nil {
+		// Verified by the beacon node the slashing is submitted to.
+		return nil
+	}
 	parentState, err := s.serviceCfg.StateGen.StateByRoot(ctx, bytesutil.ToBytes32(header.Header.ParentRoot))
 	if err != nil {
 		return err
@@ -87,6 +91,10 @@ func (s *Service) verifyBlockSignature(ctx context.Context, header *ethpb.Signed
 }
 
 func (s *Service) verifyAttSignature(ctx context.Context, att *ethpb.IndexedAttestation) error {
+	if s.serviceCfg.AttestationStateFetcher == nil {
+		// Verified by the beacon node the slashing is submitted to.
+		return nil
+	}
 	preState, err := s.serviceCfg.AttestationStateFetcher.AttestationTargetState(ctx, att.Data.Target)
 	if err != nil {
 		return err
@@ -81,3 +81,67 @@ func (q *blocksQueue) extend(blks []*slashertypes.SignedBlockHeaderWrapper) {
 	defer q.lock.Unlock()
 	q.items = append(q.items, blks...)
 }
+
+// Struct for handling a thread-safe list of blob sidecar header wrappers.
+type blobSidecarsQueue struct {
+	lock  sync.RWMutex
+	items []*slashertypes.SignedBlobSidecarHeaderWrapper
+}
+
+// Struct for handling a thread-safe list of sync committee message wrappers.
+type syncMessagesQueue struct {
+	lock  sync.RWMutex
+	items []*slashertypes.SyncCommitteeMessageWrapper
+}
+
+func newBlobSidecarsQueue() *blobSidecarsQueue {
+	return &blobSidecarsQueue{
+		items: make([]*slashertypes.SignedBlobSidecarHeaderWrapper, 0),
+	}
+}
+
+func newSyncMessagesQueue() *syncMessagesQueue {
+	return &syncMessagesQueue{
+		items: make([]*slashertypes.SyncCommitteeMessageWrapper, 0),
+	}
+}
+
+func (q *blobSidecarsQueue) push(header *slashertypes.SignedBlobSidecarHeaderWrapper) {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+	q.items = append(q.items, header)
+}
+
+func (q *blobSidecarsQueue) dequeue() []*slashertypes.SignedBlobSidecarHeaderWrapper {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+	items := q.items
+	q.items = make([]*slashertypes.SignedBlobSidecarHeaderWrapper, 0)
+	return items
+}
+
+func (q *blobSidecarsQueue) size() int {
+	q.lock.RLock()
+	defer q.lock.RUnlock()
+	return len(q.items)
+}
+
+func (q *syncMessagesQueue) push(msg *slashertypes.SyncCommitteeMessageWrapper) {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+	q.items = append(q.items, msg)
+}
+
+func (q *syncMessagesQueue) dequeue() []*slashertypes.SyncCommitteeMessageWrapper {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+	items := q.items
+	q.items = make([]*slashertypes.SyncCommitteeMessageWrapper, 0)
+	return items
+}
+
+func (q *syncMessagesQueue) size() int {
+	q.lock.RLock()
+	defer q.lock.RUnlock()
+	return len(q.items)
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/pkg/errors"
 	slashertypes "github.com/prysmaticlabs/prysm/v4/beacon-chain/slasher/types"
 	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v4/encoding/bytesutil"
 	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/v4/time/slots"
 	"github.com/sirupsen/logrus"
@@ -72,6 +73,65 @@ func (s *Service) receiveBlocks(ctx context.Context, beaconBlockHeadersChan chan
 	}
 }
 
+// Receive blob sidecars from some source event feed, turning them into blob sidecar headers
+// before appending them to a queue for batch processing in a separate routine.
+func (s *Service) receiveBlobSidecars(ctx context.Context, blobSidecarsChan chan *ethpb.SignedBlobSidecar) {
+	sub := s.serviceCfg.BlobSidecarsFeed.Subscribe(blobSidecarsChan)
+	defer sub.Unsubscribe()
+	for {
+		select {
+		case sidecar := <-blobSidecarsChan:
+			if !validateBlobSidecarIntegrity(sidecar) {
+				continue
+			}
+			header, err := blobSidecarHeader(sidecar)
+			if err != nil {
+				log.WithError(err).Error("Could not get header of blob sidecar")
+				continue
+			}
+			signingRoot, err := header.Message.HashTreeRoot()
+			if err != nil {
+				log.WithError(err).Error("Could not get hash tree root of blob sidecar header")
+				continue
+			}
+			s.blobSidecarsQueue.push(&slashertypes.SignedBlobSidecarHeaderWrapper{
+				SignedBlobSidecarHeader: header,
+				SigningRoot:             signingRoot,
+			})
+		case err := <-sub.Err():
+			log.WithError(err).Debug("Subscriber closed with error")
+			return
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// Receive sync committee messages from some source event feed, validating their integrity
+// before appending them to a queue for batch processing in a separate routine.
+func (s *Service) receiveSyncCommitteeMessages(ctx context.Context, syncMessagesChan chan *ethpb.SyncCommitteeMessage) {
+	sub := s.serviceCfg.SyncCommitteeMessagesFeed.Subscribe(syncMessagesChan)
+	defer sub.Unsubscribe()
+	for {
+		select {
+		case msg := <-syncMessagesChan:
+			if !validateSyncCommitteeMessageIntegrity(msg) {
+				continue
+			}
+			// Validators sign the block root they vote for.
+			s.syncMessagesQueue.push(&slashertypes.SyncCommitteeMessageWrapper{
+				SyncCommitteeMessage: msg,
+				SigningRoot:          bytesutil.ToBytes32(msg.BlockRoot),
+			})
+		case err := <-sub.Err():
+			log.WithError(err).Debug("Subscriber closed with error")
+			return
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
 // Process queued attestations every time a slot ticker fires. We retrieve
 // these attestations from a queue, then group them all by validator chunk index.
 // This grouping will allow us to perform detection on batches of attestations
@@ -170,13 +230,50 @@ func (s *Service) processQueuedBlocks(ctx context.Context, slotTicker <-chan pri
 	}
 }
 
+// Process queued blob sidecar headers and sync committee messages every time a slot ticker fires,
+// recording the evidence of any equivocation. Those are not slashable offenses, so the evidence
+// is only kept for monitoring and reporting.
+func (s *Service) processQueuedEquivocations(ctx context.Context, slotTicker <-chan primitives.Slot) {
+	for {
+		select {
+		case currentSlot := <-slotTicker:
+			headers := s.blobSidecarsQueue.dequeue()
+			messages := s.syncMessagesQueue.dequeue()
+
+			receivedBlobSidecarsTotal.Add(float64(len(headers)))
+			receivedSyncMessagesTotal.Add(float64(len(messages)))
+
+			log.WithFields(logrus.Fields{
+				"currentSlot":     currentSlot,
+				"numBlobSidecars": len(headers),
+				"numSyncMessages": len(messages),
+			}).Debug("Processing queued blob sidecars and sync committee messages for equivocation detection")
+
+			blobEquivocations, err := s.detectBlobSidecarEquivocations(ctx, headers)
+			if err != nil {
+				log.WithError(err).Error("Could not detect blob sidecar equivocations")
+			} else if err := s.processBlobSidecarEquivocations(ctx, blobEquivocations); err != nil {
+				log.WithError(err).Error("Could not process blob sidecar equivocations")
+			}
+
+			syncEquivocations, err := s.detectSyncCommitteeEquivocations(ctx, messages)
+			if err != nil {
+				log.WithError(err).Error("Could not detect sync committee equivocations")
+			} else if err := s.processSyncCommitteeEquivocations(ctx, syncEquivocations); err != nil {
+				log.WithError(err).Error("Could not process sync committee equivocations")
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
 // Prunes slasher data on each slot tick to prevent unnecessary build-up of disk space usage.
 func (s *Service) pruneSlasherData(ctx context.Context, slotTicker <-chan primitives.Slot) {
 	for {
 		select {
 		case <-slotTicker:
-			headEpoch := slots.ToEpoch(s.serviceCfg.HeadStateFetcher.HeadSlot())
-			if err := s.pruneSlasherDataWithinSlidingWindow(ctx, headEpoch); err != nil {
+			if err := s.pruneSlasherDataWithinSlidingWindow(ctx, s.headEpoch()); err != nil {
 				log.WithError(err).Error("Could not prune slasher data")
 				continue
 			}
@@ -216,6 +313,12 @@ func (s *Service) pruneSlasherDataWithinSlidingWindow(ctx context.Context, curre
 	if err != nil {
 		return errors.Wrap(err, "Could not prune proposals")
 	}
+	numPrunedEquivocationRecords, err := s.serviceCfg.Database.PruneEquivocationRecordsAtEpoch(
+		ctx, maxPruningEpoch,
+	)
+	if err != nil {
+		return errors.Wrap(err, "Could not prune equivocation records")
+	}
 	fields := logrus.Fields{}
 	if numPrunedAtts > 0 {
 		fields["numPrunedAtts"] = numPrunedAtts
@@ -223,6 +326,9 @@ func (s *Service) pruneSlasherDataWithinSlidingWindow(ctx context.Context, curre
 	if numPrunedProposals > 0 {
 		fields["numPrunedProposals"] = numPrunedProposals
 	}
+	if numPrunedEquivocationRecords > 0 {
+		fields["numPrunedEquivocationRecords"] = numPrunedEquivocationRecords
+	}
 	fields["elapsed"] = time.Since(start)
 	log.WithFields(fields).Info("Done pruning old attestations and proposals for slasher")
 	return nil
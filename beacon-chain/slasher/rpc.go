@@ -23,6 +23,30 @@ func (s *Service) HighestAttestations(
 	return atts, nil
 }
 
+// BlobSidecarEquivocations returns the evidence of blob sidecar equivocations detected for slots
+// within the inclusive range [startSlot, endSlot].
+func (s *Service) BlobSidecarEquivocations(
+	ctx context.Context, startSlot, endSlot primitives.Slot,
+) ([]*slashertypes.BlobSidecarEquivocation, error) {
+	equivocations, err := s.serviceCfg.Database.BlobSidecarEquivocations(ctx, startSlot, endSlot)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get blob sidecar equivocations from database")
+	}
+	return equivocations, nil
+}
+
+// SyncCommitteeEquivocations returns the evidence of sync committee equivocations detected for
+// slots within the inclusive range [startSlot, endSlot].
+func (s *Service) SyncCommitteeEquivocations(
+	ctx context.Context, startSlot, endSlot primitives.Slot,
+) ([]*slashertypes.SyncCommitteeEquivocation, error) {
+	equivocations, err := s.serviceCfg.Database.SyncCommitteeEquivocations(ctx, startSlot, endSlot)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get sync committee equivocations from database")
+	}
+	return equivocations, nil
+}
+
 // IsSlashableBlock checks if an input block header is slashable
 // with respect to historical block proposal data.
 func (s *Service) IsSlashableBlock(
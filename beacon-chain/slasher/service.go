@@ -1,18 +1,22 @@
 // Package slasher implements slashing detection for eth2, able to catch slashable attestations
 // and proposals that it receives via two event feeds, respectively. Any found slashings
 // are then submitted to the beacon node's slashing operations pool. See the design document
-// here https://hackmd.io/@prysmaticlabs/slasher.
+// here https://hackmd.io/@prysmaticlabs/slasher. Blob sidecars and sync committee messages
+// can also be received, to record evidence of equivocations which are not slashable.
 package slasher
 
 import (
 	"context"
 	"time"
 
+	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/v4/async/event"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/blockchain"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/core/feed/operation"
 	statefeed "github.com/prysmaticlabs/prysm/v4/beacon-chain/core/feed/state"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/db"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/operations/slashings"
+	slashertypes "github.com/prysmaticlabs/prysm/v4/beacon-chain/slasher/types"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/startup"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/state/stategen"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/sync"
@@ -29,17 +33,25 @@ const (
 // ServiceConfig for the slasher service in the beacon node.
 // This struct allows us to specify required dependencies and
 // parameters for slasher to function as needed.
+//
+// A slasher running in its own process has no access to beacon states, and leaves
+// HeadStateFetcher, StateGen and AttestationStateFetcher unset. Slashings it detects
+// are then submitted without verifying their signatures, which is left to the beacon
+// nodes they are submitted to.
 type ServiceConfig struct {
-	IndexedAttestationsFeed *event.Feed
-	BeaconBlockHeadersFeed  *event.Feed
-	Database                db.SlasherDatabase
-	StateNotifier           statefeed.Notifier
-	AttestationStateFetcher blockchain.AttestationStateFetcher
-	StateGen                stategen.StateManager
-	SlashingPoolInserter    slashings.PoolInserter
-	HeadStateFetcher        blockchain.HeadFetcher
-	SyncChecker             sync.Checker
-	ClockWaiter             startup.ClockWaiter
+	IndexedAttestationsFeed   *event.Feed
+	BeaconBlockHeadersFeed    *event.Feed
+	BlobSidecarsFeed          *event.Feed
+	SyncCommitteeMessagesFeed *event.Feed
+	Database                  db.SlasherDatabase
+	StateNotifier             statefeed.Notifier
+	OperationNotifier         operation.Notifier
+	AttestationStateFetcher   blockchain.AttestationStateFetcher
+	StateGen                  stategen.StateManager
+	SlashingPoolInserter      slashings.PoolInserter
+	HeadStateFetcher          blockchain.HeadFetcher
+	SyncChecker               sync.Checker
+	ClockWaiter               startup.ClockWaiter
 }
 
 // SlashingChecker is an interface for defining services that the beacon node may interact with to provide slashing data.
@@ -49,6 +61,12 @@ type SlashingChecker interface {
 	HighestAttestations(
 		ctx context.Context, indices []primitives.ValidatorIndex,
 	) ([]*ethpb.HighestAttestation, error)
+	BlobSidecarEquivocations(
+		ctx context.Context, startSlot, endSlot primitives.Slot,
+	) ([]*slashertypes.BlobSidecarEquivocation, error)
+	SyncCommitteeEquivocations(
+		ctx context.Context, startSlot, endSlot primitives.Slot,
+	) ([]*slashertypes.SyncCommitteeEquivocation, error)
 }
 
 // Service defining a slasher implementation as part of
@@ -60,11 +78,14 @@ type Service struct {
 	beaconBlockHeadersChan         chan *ethpb.SignedBeaconBlockHeader
 	attsQueue                      *attestationsQueue
 	blksQueue                      *blocksQueue
+	blobSidecarsQueue              *blobSidecarsQueue
+	syncMessagesQueue              *syncMessagesQueue
 	ctx                            context.Context
 	cancel                         context.CancelFunc
 	genesisTime                    time.Time
 	attsSlotTicker                 *slots.SlotTicker
 	blocksSlotTicker               *slots.SlotTicker
+	equivocationsSlotTicker        *slots.SlotTicker
 	pruningSlotTicker              *slots.SlotTicker
 	latestEpochWrittenForValidator map[primitives.ValidatorIndex]primitives.Epoch
 }
@@ -79,6 +100,8 @@ func New(ctx context.Context, srvCfg *ServiceConfig) (*Service, error) {
 		beaconBlockHeadersChan:         make(chan *ethpb.SignedBeaconBlockHeader, 1),
 		attsQueue:                      newAttestationsQueue(),
 		blksQueue:                      newBlocksQueue(),
+		blobSidecarsQueue:              newBlobSidecarsQueue(),
+		syncMessagesQueue:              newSyncMessagesQueue(),
 		ctx:                            ctx,
 		cancel:                         cancel,
 		latestEpochWrittenForValidator: make(map[primitives.ValidatorIndex]primitives.Epoch),
@@ -98,21 +121,9 @@ func (s *Service) run() {
 	log.Info("Completed chain sync, starting slashing detection")
 
 	// Get the latest epoch written for each validator from disk on startup.
-	headState, err := s.serviceCfg.HeadStateFetcher.HeadState(s.ctx)
-	if err != nil {
-		log.WithError(err).Error("Failed to fetch head state")
-		return
-	}
-	numVals := headState.NumValidators()
-	validatorIndices := make([]primitives.ValidatorIndex, numVals)
-	for i := 0; i < numVals; i++ {
-		validatorIndices[i] = primitives.ValidatorIndex(i)
-	}
 	start := time.Now()
 	log.Info("Reading last epoch written for each validator...")
-	epochsByValidator, err := s.serviceCfg.Database.LastEpochWrittenForValidators(
-		s.ctx, validatorIndices,
-	)
+	epochsByValidator, err := s.lastEpochsWrittenForValidators(s.ctx)
 	if err != nil {
 		log.Error(err)
 		return
@@ -128,13 +139,21 @@ func (s *Service) run() {
 	beaconBlockHeadersChan := make(chan *ethpb.SignedBeaconBlockHeader, 1)
 	go s.receiveAttestations(s.ctx, indexedAttsChan)
 	go s.receiveBlocks(s.ctx, beaconBlockHeadersChan)
+	if s.serviceCfg.BlobSidecarsFeed != nil {
+		go s.receiveBlobSidecars(s.ctx, make(chan *ethpb.SignedBlobSidecar, 1))
+	}
+	if s.serviceCfg.SyncCommitteeMessagesFeed != nil {
+		go s.receiveSyncCommitteeMessages(s.ctx, make(chan *ethpb.SyncCommitteeMessage, 1))
+	}
 
 	secondsPerSlot := params.BeaconConfig().SecondsPerSlot
 	s.attsSlotTicker = slots.NewSlotTicker(s.genesisTime, secondsPerSlot)
 	s.blocksSlotTicker = slots.NewSlotTicker(s.genesisTime, secondsPerSlot)
+	s.equivocationsSlotTicker = slots.NewSlotTicker(s.genesisTime, secondsPerSlot)
 	s.pruningSlotTicker = slots.NewSlotTicker(s.genesisTime, secondsPerSlot)
 	go s.processQueuedAttestations(s.ctx, s.attsSlotTicker.C())
 	go s.processQueuedBlocks(s.ctx, s.blocksSlotTicker.C())
+	go s.processQueuedEquivocations(s.ctx, s.equivocationsSlotTicker.C())
 	go s.pruneSlasherData(s.ctx, s.pruningSlotTicker.C())
 }
 
@@ -147,6 +166,9 @@ func (s *Service) Stop() error {
 	if s.blocksSlotTicker != nil {
 		s.blocksSlotTicker.Done()
 	}
+	if s.equivocationsSlotTicker != nil {
+		s.equivocationsSlotTicker.Done()
+	}
 	if s.pruningSlotTicker != nil {
 		s.pruningSlotTicker.Done()
 	}
@@ -172,6 +194,34 @@ func (*Service) Status() error {
 	return nil
 }
 
+// Reads the latest epoch written for the validators of the head state, or for all the
+// validators recorded on disk when the head state is not available.
+func (s *Service) lastEpochsWrittenForValidators(
+	ctx context.Context,
+) ([]*slashertypes.AttestedEpochForValidator, error) {
+	if s.serviceCfg.HeadStateFetcher == nil {
+		return s.serviceCfg.Database.LastEpochsWrittenForAllValidators(ctx)
+	}
+	headState, err := s.serviceCfg.HeadStateFetcher.HeadState(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to fetch head state")
+	}
+	numVals := headState.NumValidators()
+	validatorIndices := make([]primitives.ValidatorIndex, numVals)
+	for i := 0; i < numVals; i++ {
+		validatorIndices[i] = primitives.ValidatorIndex(i)
+	}
+	return s.serviceCfg.Database.LastEpochWrittenForValidators(ctx, validatorIndices)
+}
+
+// Returns the epoch of the head slot, or the current epoch when the head is not available.
+func (s *Service) headEpoch() primitives.Epoch {
+	if s.serviceCfg.HeadStateFetcher == nil {
+		return slots.ToEpoch(slots.CurrentSlot(uint64(s.genesisTime.Unix())))
+	}
+	return slots.ToEpoch(s.serviceCfg.HeadStateFetcher.HeadSlot())
+}
+
 func (s *Service) waitForChainInitialization() {
 	clock, err := s.serviceCfg.ClockWaiter.WaitForClock(s.ctx)
 	if err != nil {
@@ -9,8 +9,10 @@ import (
 	"github.com/prysmaticlabs/prysm/v4/async/event"
 	mock "github.com/prysmaticlabs/prysm/v4/beacon-chain/blockchain/testing"
 	dbtest "github.com/prysmaticlabs/prysm/v4/beacon-chain/db/testing"
+	slashertypes "github.com/prysmaticlabs/prysm/v4/beacon-chain/slasher/types"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/startup"
 	mockSync "github.com/prysmaticlabs/prysm/v4/beacon-chain/sync/initial-sync/testing"
+	"github.com/prysmaticlabs/prysm/v4/config/params"
 	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/v4/testing/require"
 	"github.com/prysmaticlabs/prysm/v4/testing/util"
@@ -62,3 +64,32 @@ func TestService_StartStop_ChainInitialized(t *testing.T) {
 	require.NoError(t, srv.Status())
 	require.LogsContain(t, hook, "received chain initialization")
 }
+
+func TestService_Standalone_EpochsWithoutHeadState(t *testing.T) {
+	ctx := context.Background()
+	slasherDB := dbtest.SetupSlasherDB(t)
+	srv, err := New(ctx, &ServiceConfig{
+		IndexedAttestationsFeed: new(event.Feed),
+		BeaconBlockHeadersFeed:  new(event.Feed),
+		Database:                slasherDB,
+		SyncChecker:             &mockSync.Sync{IsSyncing: false},
+		ClockWaiter:             startup.NewClockSynchronizer(),
+	})
+	require.NoError(t, err)
+
+	epochs := []*slashertypes.AttestedEpochForValidator{
+		{ValidatorIndex: 1, Epoch: 3},
+		{ValidatorIndex: 5, Epoch: 2},
+	}
+	require.NoError(t, slasherDB.SaveLastEpochsWrittenForValidators(ctx, map[primitives.ValidatorIndex]primitives.Epoch{
+		1: 3,
+		5: 2,
+	}))
+	written, err := srv.lastEpochsWrittenForValidators(ctx)
+	require.NoError(t, err)
+	require.DeepEqual(t, epochs, written)
+
+	secondsPerEpoch := time.Duration(params.BeaconConfig().SecondsPerSlot*uint64(params.BeaconConfig().SlotsPerEpoch)) * time.Second
+	srv.genesisTime = time.Now().Add(-4 * secondsPerEpoch)
+	require.Equal(t, primitives.Epoch(4), srv.headEpoch())
+}
@@ -51,3 +51,31 @@ type AttestedEpochForValidator struct {
 	ValidatorIndex primitives.ValidatorIndex
 	Epoch          primitives.Epoch
 }
+
+// SignedBlobSidecarHeaderWrapper contains a signed blob sidecar header, in which the blob is
+// replaced by its root, with its signing root to reduce duplicated computation.
+type SignedBlobSidecarHeaderWrapper struct {
+	SignedBlobSidecarHeader *ethpb.SignedBlindedBlobSidecar
+	SigningRoot             [32]byte
+}
+
+// SyncCommitteeMessageWrapper contains a sync committee message with its signing root, which
+// is the block root the validator signed.
+type SyncCommitteeMessageWrapper struct {
+	SyncCommitteeMessage *ethpb.SyncCommitteeMessage
+	SigningRoot          [32]byte
+}
+
+// BlobSidecarEquivocation contains two blob sidecar headers signed by the same proposer for
+// the same slot and blob index, but with different signing roots.
+type BlobSidecarEquivocation struct {
+	PrevBlobSidecarWrapper *SignedBlobSidecarHeaderWrapper
+	BlobSidecarWrapper     *SignedBlobSidecarHeaderWrapper
+}
+
+// SyncCommitteeEquivocation contains two sync committee messages signed by the same validator
+// for the same slot, but for different block roots.
+type SyncCommitteeEquivocation struct {
+	PrevMessageWrapper *SyncCommitteeMessageWrapper
+	MessageWrapper     *SyncCommitteeMessageWrapper
+}
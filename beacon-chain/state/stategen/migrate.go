@@ -98,7 +98,11 @@ func (s *State) MigrateToCold(ctx context.Context, fRoot [32]byte) error {
 				continue
 			}
 
-			if err := s.beaconDB.SaveState(ctx, aState, aRoot); err != nil {
+			if s.beaconDB.StateDiffInterval() != 0 {
+				if err := s.beaconDB.SaveStateDiff(ctx, slot, aState, aRoot); err != nil {
+					return err
+				}
+			} else if err := s.beaconDB.SaveState(ctx, aState, aRoot); err != nil {
 				return err
 			}
 			log.WithFields(
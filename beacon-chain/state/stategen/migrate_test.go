@@ -7,6 +7,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/core/blocks"
 	testDB "github.com/prysmaticlabs/prysm/v4/beacon-chain/db/testing"
 	doublylinkedtree "github.com/prysmaticlabs/prysm/v4/beacon-chain/forkchoice/doubly-linked-tree"
+	"github.com/prysmaticlabs/prysm/v4/config/features"
 	consensusblocks "github.com/prysmaticlabs/prysm/v4/consensus-types/blocks"
 	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
 	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
@@ -69,6 +70,37 @@ func TestMigrateToCold_HappyPath(t *testing.T) {
 	require.LogsContain(t, hook, "Saved state in DB")
 }
 
+func TestMigrateToCold_StateDiffs(t *testing.T) {
+	resetCfg := features.InitWithReset(&features.Flags{EnableHistoricalStateDiffs: true})
+	defer resetCfg()
+	ctx := context.Background()
+	beaconDB := testDB.SetupDB(t)
+
+	service := New(beaconDB, doublylinkedtree.New())
+	require.Equal(t, primitives.Slot(1), service.slotsPerArchivedPoint)
+	service.finalizedInfo.slot = 32
+	beaconState, _ := util.DeterministicGenesisState(t, 32)
+	require.NoError(t, beaconState.SetSlot(32))
+	b := util.NewBeaconBlock()
+	b.Block.Slot = 32
+	aRoot, err := b.Block.HashTreeRoot()
+	require.NoError(t, err)
+	util.SaveBlock(t, ctx, service.beaconDB, b)
+	require.NoError(t, service.epochBoundaryStateCache.put(aRoot, beaconState))
+	fBlock := util.NewBeaconBlock()
+	fBlock.Block.Slot = 33
+	fBlock.Block.ParentRoot = aRoot[:]
+	fRoot, err := fBlock.Block.HashTreeRoot()
+	require.NoError(t, err)
+	util.SaveBlock(t, ctx, service.beaconDB, fBlock)
+	require.NoError(t, service.MigrateToCold(ctx, fRoot))
+
+	require.Equal(t, true, service.beaconDB.HasState(ctx, aRoot))
+	gotState, err := service.beaconDB.State(ctx, aRoot)
+	require.NoError(t, err)
+	assert.DeepSSZEqual(t, beaconState.ToProtoUnsafe(), gotState.ToProtoUnsafe(), "Did not save state diff")
+}
+
 func TestMigrateToCold_RegeneratePath(t *testing.T) {
 	hook := logTest.NewGlobal()
 	ctx := context.Background()
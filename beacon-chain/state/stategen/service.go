@@ -13,7 +13,6 @@ import (
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/db"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/forkchoice"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/state"
-	"github.com/prysmaticlabs/prysm/v4/beacon-chain/sync/backfill"
 	"github.com/prysmaticlabs/prysm/v4/config/params"
 	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/v4/crypto/bls"
@@ -51,7 +50,7 @@ type State struct {
 	finalizedInfo           *finalizedInfo
 	epochBoundaryStateCache *epochBoundaryState
 	saveHotStateDB          *saveHotStateDbConfig
-	backfillStatus          *backfill.Status
+	backfillStatus          SlotCoverageChecker
 	migrationLock           *sync.Mutex
 	fc                      forkchoice.ForkChoicer
 }
@@ -75,10 +74,16 @@ type finalizedInfo struct {
 	lock  sync.RWMutex
 }
 
+// SlotCoverageChecker reports whether the block history for a slot is available in the database.
+// Nodes initialized via checkpoint sync are missing the history before the checkpoint until it is backfilled.
+type SlotCoverageChecker interface {
+	SlotCovered(primitives.Slot) bool
+}
+
 // StateGenOption is a functional option for controlling the initialization of a *State value
 type StateGenOption func(*State)
 
-func WithBackfillStatus(bfs *backfill.Status) StateGenOption {
+func WithBackfillStatus(bfs SlotCoverageChecker) StateGenOption {
 	return func(sg *State) {
 		sg.backfillStatus = bfs
 	}
@@ -101,6 +106,10 @@ func New(beaconDB db.NoHeadAccessDatabase, fc forkchoice.ForkChoicer, opts ...St
 	for _, o := range opts {
 		o(s)
 	}
+	// Databases storing historical states as diffs archive a state at every diff point.
+	if interval := beaconDB.StateDiffInterval(); interval != 0 {
+		s.slotsPerArchivedPoint = interval
+	}
 	fc.Lock()
 	defer fc.Unlock()
 	fc.SetBalancesByRooter(s.ActiveNonSlashedBalancesByRoot)
@@ -0,0 +1,35 @@
+package backfill
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	backfillRemainingSlots = promauto.NewGauge(
+		prometheus.GaugeOpts{
+			Name: "backfill_remaining_slots",
+			Help: "Number of slots between genesis and the lowest block saved by backfill.",
+		},
+	)
+	backfillBlocksSaved = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Name: "backfill_blocks_saved_total",
+			Help: "Number of blocks saved to the database by backfill.",
+		},
+	)
+	backfillBatchesFailed = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "backfill_batches_failed_total",
+			Help: "Number of backfill batches that could not be fetched or imported.",
+		},
+		[]string{"reason"},
+	)
+	backfillBatchLatency = promauto.NewHistogram(
+		prometheus.HistogramOpts{
+			Name:    "backfill_batch_import_milliseconds",
+			Help:    "Time to verify and save a backfill batch.",
+			Buckets: []float64{10, 50, 100, 250, 500, 1000, 2500, 5000},
+		},
+	)
+)
@@ -0,0 +1,362 @@
+package backfill
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/paulbellamy/ratecounter"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/p2p"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/startup"
+	prysmsync "github.com/prysmaticlabs/prysm/v4/beacon-chain/sync"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/blocks"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/interfaces"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v4/runtime"
+	"github.com/sirupsen/logrus"
+)
+
+var _ runtime.Service = (*Service)(nil)
+
+const (
+	// defaultBatchInterval is the minimum time between two rounds of backfill requests. Together with the batch
+	// size and worker count it bounds the rate at which backfill downloads blocks, so it never competes with
+	// syncing the head of the chain for bandwidth.
+	defaultBatchInterval = time.Second
+	defaultBatchSize     = 64
+	defaultWorkerCount   = 2
+	counterSeconds       = 20
+	progressLogInterval  = 30 * time.Second
+)
+
+// Config to set up the backfill service.
+type Config struct {
+	P2P                 p2p.P2P
+	DB                  db.NoHeadAccessDatabase
+	Status              *Status
+	ClockWaiter         startup.ClockWaiter
+	InitialSyncComplete chan struct{}
+	SyncChecker         prysmsync.Checker
+	BatchSize           uint64
+	WorkerCount         int
+	BatchInterval       time.Duration
+}
+
+// Service downloads the blocks that are missing from the database of a node that was initialized via checkpoint sync.
+// Starting from the origin checkpoint block it requests batches of blocks from multiple peers with BeaconBlocksByRange,
+// walking backwards towards genesis. Each batch is checked to link to the lowest block already saved by following
+// parent roots, and its proposer signatures are batch verified before the blocks are saved. Progress is recorded in the
+// backfill Status after every batch, so that backfill resumes from the lowest saved block after a restart.
+type Service struct {
+	cfg         *Config
+	ctx         context.Context
+	cancel      context.CancelFunc
+	clock       *startup.Clock
+	counter     *ratecounter.RateCounter
+	verifier    *verifier
+	genesisRoot [32]byte
+	// parent is the root that the highest block of the next batch must have.
+	parent [32]byte
+	// next is the exclusive upper bound of the slot range of the next batch to request.
+	next primitives.Slot
+	// skipped is set when a range between next and the block whose parent is s.parent was reported empty.
+	skipped    bool
+	lastLogged time.Time
+}
+
+// batch is a range of slots [begin, end) that is requested from a single peer.
+type batch struct {
+	begin  primitives.Slot
+	end    primitives.Slot
+	pid    peer.ID
+	blocks []blocks.ROBlock
+	err    error
+}
+
+// NewService configures the backfill service.
+func NewService(ctx context.Context, cfg *Config) *Service {
+	ctx, cancel := context.WithCancel(ctx)
+	if cfg.BatchSize == 0 {
+		cfg.BatchSize = defaultBatchSize
+	}
+	if cfg.WorkerCount <= 0 {
+		cfg.WorkerCount = defaultWorkerCount
+	}
+	if cfg.BatchInterval == 0 {
+		cfg.BatchInterval = defaultBatchInterval
+	}
+	return &Service{
+		cfg:     cfg,
+		ctx:     ctx,
+		cancel:  cancel,
+		counter: ratecounter.NewRateCounter(counterSeconds * time.Second),
+	}
+}
+
+// Start the backfill service. Backfill only begins once initial sync is complete.
+func (s *Service) Start() {
+	if s.cfg.Status.Complete() {
+		log.Debug("Chain history is complete, exiting backfill service")
+		return
+	}
+	clock, err := s.cfg.ClockWaiter.WaitForClock(s.ctx)
+	if err != nil {
+		log.WithError(err).Error("Backfill failed to receive startup event")
+		return
+	}
+	s.clock = clock
+	select {
+	case <-s.cfg.InitialSyncComplete:
+	case <-s.ctx.Done():
+		return
+	}
+	if err := s.initialize(s.ctx); err != nil {
+		log.WithError(err).Error("Could not initialize backfill")
+		return
+	}
+	log.WithFields(logrus.Fields{
+		"lowestSlot": s.cfg.Status.EndGap(),
+		"batchSize":  s.cfg.BatchSize,
+		"workers":    s.cfg.WorkerCount,
+	}).Info("Starting backfill of blocks before the checkpoint sync origin")
+	s.run()
+}
+
+// Stop the backfill service.
+func (s *Service) Stop() error {
+	s.cancel()
+	return nil
+}
+
+// Status of the backfill service.
+func (s *Service) Status() error {
+	return nil
+}
+
+// initialize loads the origin state used to verify proposer signatures and the lowest block saved so far,
+// which is where backfill resumes.
+func (s *Service) initialize(ctx context.Context) error {
+	originRoot, err := s.cfg.DB.OriginCheckpointBlockRoot(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not retrieve origin checkpoint root")
+	}
+	st, err := s.cfg.DB.State(ctx, originRoot)
+	if err != nil {
+		return errors.Wrapf(err, "could not retrieve origin state for root %#x", originRoot)
+	}
+	if st == nil || st.IsNil() {
+		return errors.Errorf("origin state not found for root %#x", originRoot)
+	}
+	s.verifier = newVerifier(st, s.clock.GenesisValidatorsRoot())
+	s.genesisRoot, err = s.cfg.DB.GenesisBlockRoot(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not retrieve genesis block root")
+	}
+	lowRoot, err := s.cfg.DB.BackfillBlockRoot(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not retrieve backfill block root")
+	}
+	// Checkpoint sync sets the backfill root to genesis, which means no blocks have been backfilled yet.
+	if lowRoot == s.genesisRoot {
+		lowRoot = originRoot
+	}
+	low, err := s.cfg.DB.Block(ctx, lowRoot)
+	if err != nil {
+		return errors.Wrapf(err, "could not retrieve lowest backfilled block %#x", lowRoot)
+	}
+	if err := blocks.BeaconBlockIsNil(low); err != nil {
+		return err
+	}
+	s.parent = low.Block().ParentRoot()
+	s.next = low.Block().Slot()
+	return nil
+}
+
+func (s *Service) run() {
+	ticker := time.NewTicker(s.cfg.BatchInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-s.ctx.Done():
+			log.Debug("Context closed, exiting backfill service")
+			return
+		case <-ticker.C:
+		}
+		if s.cfg.SyncChecker != nil && s.cfg.SyncChecker.Syncing() {
+			continue
+		}
+		if err := s.round(s.ctx); err != nil {
+			if errors.Is(s.ctx.Err(), context.Canceled) {
+				return
+			}
+			log.WithError(err).Debug("Backfill round failed")
+		}
+		if s.cfg.Status.Complete() {
+			log.Info("Backfill complete, chain history is available back to genesis")
+			return
+		}
+	}
+}
+
+// round requests the next batches concurrently, one per peer, and then imports them from the highest slot downwards.
+// Importing stops at the first batch that fails, and the range is requested again in a later round.
+func (s *Service) round(ctx context.Context) error {
+	_, peers := s.cfg.P2P.Peers().BestFinalized(s.cfg.WorkerCount, 0)
+	if len(peers) == 0 {
+		log.Debug("No suitable peers to backfill from")
+		return nil
+	}
+	batches := s.nextBatches(len(peers))
+	if len(batches) == 0 {
+		// The range down to genesis was exhausted without finding the block that links to genesis,
+		// which means a peer withheld blocks. Start over from the lowest verified block.
+		s.next = s.cfg.Status.EndGap()
+		return errors.Wrap(errChainBroken, "reached genesis without linking to the genesis block")
+	}
+	var wg sync.WaitGroup
+	for i := range batches {
+		batches[i].pid = peers[i]
+		wg.Add(1)
+		go func(b *batch) {
+			defer wg.Done()
+			b.blocks, b.err = s.fetch(ctx, b)
+		}(batches[i])
+	}
+	wg.Wait()
+
+	for _, b := range batches {
+		if b.err != nil {
+			backfillBatchesFailed.WithLabelValues("request").Inc()
+			return errors.Wrapf(b.err, "could not request blocks %d-%d from peer %s", b.begin, b.end, b.pid)
+		}
+		if err := s.importBatch(ctx, b); err != nil {
+			backfillBatchesFailed.WithLabelValues("import").Inc()
+			if s.servedBadBlocks(b, err) {
+				s.cfg.P2P.Peers().Scorers().BadResponsesScorer().Increment(b.pid)
+			}
+			s.next = s.cfg.Status.EndGap()
+			s.skipped = false
+			return errors.Wrapf(err, "could not import blocks %d-%d from peer %s", b.begin, b.end, b.pid)
+		}
+	}
+	s.logProgress(len(peers))
+	return nil
+}
+
+// nextBatches returns up to n consecutive batches below the next slot to request, highest first.
+// Batches never include the genesis slot, since the genesis block is always present.
+func (s *Service) nextBatches(n int) []*batch {
+	start := s.cfg.Status.StartGap()
+	batches := make([]*batch, 0, n)
+	end := s.next
+	for len(batches) < n && end > start+1 {
+		begin := start + 1
+		if end > begin+primitives.Slot(s.cfg.BatchSize) {
+			begin = end - primitives.Slot(s.cfg.BatchSize)
+		}
+		batches = append(batches, &batch{begin: begin, end: end})
+		end = begin
+	}
+	return batches
+}
+
+func (s *Service) fetch(ctx context.Context, b *batch) ([]blocks.ROBlock, error) {
+	req := &ethpb.BeaconBlocksByRangeRequest{
+		StartSlot: b.begin,
+		Count:     uint64(b.end - b.begin),
+		Step:      1,
+	}
+	blks, err := prysmsync.SendBeaconBlocksByRangeRequest(ctx, s.clock, s.cfg.P2P, b.pid, req, nil)
+	if err != nil {
+		return nil, err
+	}
+	ro := make([]blocks.ROBlock, len(blks))
+	for i := range blks {
+		ro[i], err = blocks.NewROBlock(blks[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return ro, nil
+}
+
+// importBatch checks that the blocks of a batch link to the lowest saved block and have valid proposer signatures,
+// then saves them and advances the backfill status to the lowest block of the batch.
+func (s *Service) importBatch(ctx context.Context, b *batch) error {
+	if len(b.blocks) == 0 {
+		// Only skipped slots in this range. If a peer withheld blocks instead, the next
+		// batch will fail to link to s.parent and the range will be requested again.
+		s.next = b.begin
+		s.skipped = true
+		return nil
+	}
+	start := time.Now()
+	if err := verifyChain(b.blocks, s.parent); err != nil {
+		return err
+	}
+	if err := s.verifier.verify(b.blocks); err != nil {
+		return err
+	}
+	blks := make([]interfaces.ReadOnlySignedBeaconBlock, len(b.blocks))
+	for i := range b.blocks {
+		blks[i] = b.blocks[i].ReadOnlySignedBeaconBlock
+	}
+	if err := s.cfg.DB.SaveBlocks(ctx, blks); err != nil {
+		return errors.Wrap(err, "could not save backfilled blocks")
+	}
+	lowest := b.blocks[0]
+	parent := lowest.Block().ParentRoot()
+	downTo := lowest.Block().Slot()
+	if parent == s.genesisRoot {
+		downTo = s.cfg.Status.StartGap()
+	}
+	if err := s.cfg.Status.FillBack(ctx, downTo, lowest.Root()); err != nil {
+		return errors.Wrap(err, "could not advance backfill status")
+	}
+	s.parent = parent
+	s.next = b.begin
+	s.skipped = false
+	s.counter.Incr(int64(len(blks)))
+	backfillBlocksSaved.Add(float64(len(blks)))
+	backfillBatchLatency.Observe(float64(time.Since(start).Milliseconds()))
+	return nil
+}
+
+// servedBadBlocks reports whether an import error was caused by the blocks the peer of the batch served.
+// When a range above the batch was reported empty, the highest block of the batch not linking to s.parent
+// may be the fault of the peer that withheld the blocks of that range, so the peer of the batch is not blamed.
+// Errors saving the batch are never the fault of the peer.
+func (s *Service) servedBadBlocks(b *batch, err error) bool {
+	switch {
+	case errors.Is(err, errInvalidSignature), errors.Is(err, errUnknownProposer):
+		return true
+	case errors.Is(err, errChainBroken):
+		return !s.skipped || b.blocks[len(b.blocks)-1].Root() == s.parent
+	default:
+		return false
+	}
+}
+
+func (s *Service) logProgress(peers int) {
+	lowest := s.cfg.Status.EndGap()
+	remaining := lowest - s.cfg.Status.StartGap()
+	backfillRemainingSlots.Set(float64(remaining))
+	if time.Since(s.lastLogged) < progressLogInterval {
+		return
+	}
+	s.lastLogged = time.Now()
+	rate := float64(s.counter.Rate()) / counterSeconds
+	if rate == 0 {
+		rate = 1
+	}
+	timeRemaining := time.Duration(float64(remaining)/rate) * time.Second
+	log.WithFields(logrus.Fields{
+		"peers":           peers,
+		"blocksPerSecond": fmt.Sprintf("%.1f", rate),
+	}).Infof("Backfilled blocks down to slot %d - estimated time remaining %s", lowest, timeRemaining)
+}
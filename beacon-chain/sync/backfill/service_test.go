@@ -0,0 +1,155 @@
+package backfill
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pkg/errors"
+	dbtest "github.com/prysmaticlabs/prysm/v4/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/blocks"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v4/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/v4/testing/require"
+	"github.com/prysmaticlabs/prysm/v4/testing/util"
+)
+
+func TestNextBatches(t *testing.T) {
+	cases := []struct {
+		name     string
+		start    primitives.Slot
+		next     primitives.Slot
+		n        int
+		expected [][2]primitives.Slot
+	}{
+		{
+			name:     "full batches",
+			next:     200,
+			n:        2,
+			expected: [][2]primitives.Slot{{136, 200}, {72, 136}},
+		},
+		{
+			name:     "genesis slot is never requested",
+			next:     100,
+			n:        3,
+			expected: [][2]primitives.Slot{{36, 100}, {1, 36}},
+		},
+		{
+			name:     "non-zero start",
+			start:    10,
+			next:     20,
+			n:        2,
+			expected: [][2]primitives.Slot{{11, 20}},
+		},
+		{
+			name: "nothing left",
+			next: 1,
+			n:    2,
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			s := NewService(context.Background(), &Config{Status: &Status{start: c.start, end: c.next}})
+			s.next = c.next
+			batches := s.nextBatches(c.n)
+			require.Equal(t, len(c.expected), len(batches))
+			for i := range batches {
+				require.Equal(t, c.expected[i][0], batches[i].begin)
+				require.Equal(t, c.expected[i][1], batches[i].end)
+			}
+		})
+	}
+}
+
+func TestImportBatch(t *testing.T) {
+	ctx := context.Background()
+	st, keys := util.DeterministicGenesisState(t, 8)
+	gvr := bytesutil.ToBytes32(st.GenesisValidatorsRoot())
+	genesisRoot := [32]byte{0xaa}
+	chain := signedChain(t, st, keys, genesisRoot, 2, 3, 5, 8, 9, 12)
+	origin := chain[len(chain)-1]
+
+	setup := func(t *testing.T) *Service {
+		beaconDB := dbtest.SetupDB(t)
+		s := NewService(ctx, &Config{
+			DB:     beaconDB,
+			Status: &Status{start: 0, end: origin.Block().Slot(), store: beaconDB},
+		})
+		s.verifier = newVerifier(st, gvr)
+		s.genesisRoot = genesisRoot
+		s.parent = origin.Block().ParentRoot()
+		s.next = origin.Block().Slot()
+		return s
+	}
+
+	t.Run("saves blocks and advances", func(t *testing.T) {
+		s := setup(t)
+		b := &batch{begin: 8, end: 12, blocks: chain[3:5]}
+		require.NoError(t, s.importBatch(ctx, b))
+		require.Equal(t, true, s.cfg.DB.HasBlock(ctx, chain[3].Root()))
+		require.Equal(t, true, s.cfg.DB.HasBlock(ctx, chain[4].Root()))
+		require.Equal(t, primitives.Slot(8), s.cfg.Status.EndGap())
+		require.Equal(t, primitives.Slot(8), s.next)
+		require.Equal(t, chain[3].Block().ParentRoot(), s.parent)
+		bfRoot, err := s.cfg.DB.BackfillBlockRoot(ctx)
+		require.NoError(t, err)
+		require.Equal(t, chain[3].Root(), bfRoot)
+		require.Equal(t, false, s.cfg.Status.Complete())
+
+		// a range of skipped slots only moves the next batch down
+		require.NoError(t, s.importBatch(ctx, &batch{begin: 6, end: 8}))
+		require.Equal(t, primitives.Slot(6), s.next)
+		require.Equal(t, primitives.Slot(8), s.cfg.Status.EndGap())
+
+		// reaching the child of the genesis block closes the gap
+		require.ErrorIs(t, s.importBatch(ctx, &batch{begin: 1, end: 6, blocks: chain[:2]}), errChainBroken)
+		require.Equal(t, false, s.cfg.Status.Complete())
+		require.NoError(t, s.importBatch(ctx, &batch{begin: 1, end: 6, blocks: chain[:3]}))
+		require.Equal(t, true, s.cfg.Status.Complete())
+		require.Equal(t, true, s.cfg.Status.SlotCovered(4))
+	})
+	t.Run("batch does not link", func(t *testing.T) {
+		s := setup(t)
+		b := &batch{begin: 1, end: 12, blocks: chain[:4]}
+		require.ErrorIs(t, s.importBatch(ctx, b), errChainBroken)
+		require.Equal(t, false, s.cfg.DB.HasBlock(ctx, chain[0].Root()))
+		require.Equal(t, origin.Block().Slot(), s.cfg.Status.EndGap())
+	})
+	t.Run("invalid signature", func(t *testing.T) {
+		s := setup(t)
+		s.verifier = newVerifier(st, [32]byte{0x01})
+		b := &batch{begin: 1, end: 12, blocks: chain[:5]}
+		require.ErrorIs(t, s.importBatch(ctx, b), errInvalidSignature)
+		require.Equal(t, false, s.cfg.DB.HasBlock(ctx, chain[0].Root()))
+		require.Equal(t, origin.Block().Slot(), s.cfg.Status.EndGap())
+	})
+}
+
+func TestServedBadBlocks(t *testing.T) {
+	ctx := context.Background()
+	st, keys := util.DeterministicGenesisState(t, 8)
+	genesisRoot := [32]byte{0xaa}
+	chain := signedChain(t, st, keys, genesisRoot, 2, 3, 5, 8, 9, 12)
+
+	s := NewService(ctx, &Config{Status: &Status{end: 12}})
+	s.parent = chain[4].Root()
+	t.Run("invalid signature or unknown proposer", func(t *testing.T) {
+		b := &batch{blocks: chain[:5]}
+		require.Equal(t, true, s.servedBadBlocks(b, errors.Wrap(errInvalidSignature, "slot=2")))
+		require.Equal(t, true, s.servedBadBlocks(b, errors.Wrap(errUnknownProposer, "slot=2")))
+	})
+	t.Run("save failure", func(t *testing.T) {
+		require.Equal(t, false, s.servedBadBlocks(&batch{blocks: chain[:5]}, errors.New("could not save backfilled blocks")))
+	})
+	t.Run("does not link to the block above", func(t *testing.T) {
+		s.skipped = false
+		require.Equal(t, true, s.servedBadBlocks(&batch{blocks: chain[:4]}, errChainBroken))
+	})
+	t.Run("does not link after a range reported empty", func(t *testing.T) {
+		// the peer that reported the range above as empty may have withheld its blocks
+		s.skipped = true
+		require.Equal(t, false, s.servedBadBlocks(&batch{blocks: chain[:4]}, errChainBroken))
+		// a break between the blocks of the batch is always the fault of its peer
+		broken := []blocks.ROBlock{chain[0], chain[2], chain[3], chain[4]}
+		require.Equal(t, true, s.servedBadBlocks(&batch{blocks: broken}, errChainBroken))
+	})
+}
@@ -2,9 +2,11 @@ package backfill
 
 import (
 	"context"
+	"sync"
 
 	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/v4/config/params"
 	"github.com/prysmaticlabs/prysm/v4/consensus-types/blocks"
 	"github.com/prysmaticlabs/prysm/v4/consensus-types/interfaces"
 	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
@@ -21,8 +23,11 @@ func NewStatus(store BackfillDB) *Status {
 // a node was initialized via checkpoint sync. With checkpoint sync, there will be a gap in node history from genesis
 // until the checkpoint sync origin block. Status provides the means to update the value keeping track of the lower
 // end of the missing block range via the Advance() method, to check whether a Slot is missing from the database
-// via the SlotCovered() method, and to see the current StartGap() and EndGap().
+// via the SlotCovered() method, and to see the current StartGap() and EndGap(). The backfill service walks backwards
+// from the origin block instead, so it keeps the lower end of the gap at genesis and moves the upper end down via
+// the FillBack() method; its status is loaded with ReloadFillBack().
 type Status struct {
+	sync.RWMutex
 	start       primitives.Slot
 	end         primitives.Slot
 	store       BackfillDB
@@ -33,11 +38,13 @@ type Status struct {
 // If the slot is <= StartGap(), or >= EndGap(), the result is true.
 // If the slot is between StartGap() and EndGap(), the result is false.
 func (s *Status) SlotCovered(sl primitives.Slot) bool {
+	s.RLock()
+	defer s.RUnlock()
 	// short circuit if the node was synced from genesis
 	if s.genesisSync {
 		return true
 	}
-	if s.StartGap() < sl && sl < s.EndGap() {
+	if s.start < sl && sl < s.end {
 		return false
 	}
 	return true
@@ -45,20 +52,37 @@ func (s *Status) SlotCovered(sl primitives.Slot) bool {
 
 // StartGap returns the slot at the beginning of the range that needs to be backfilled.
 func (s *Status) StartGap() primitives.Slot {
+	s.RLock()
+	defer s.RUnlock()
 	return s.start
 }
 
 // EndGap returns the slot at the end of the range that needs to be backfilled.
 func (s *Status) EndGap() primitives.Slot {
+	s.RLock()
+	defer s.RUnlock()
 	return s.end
 }
 
+// Complete returns true if there is nothing left to backfill, either because the node was synced from genesis
+// or because the chain history has been filled in all the way back to the genesis block.
+func (s *Status) Complete() bool {
+	s.RLock()
+	defer s.RUnlock()
+	return s.genesisSync || s.end <= s.start
+}
+
 var ErrAdvancePastOrigin = errors.New("cannot advance backfill Status beyond the origin checkpoint slot")
 
+// ErrFillBackPastGenesis is returned when FillBack is called with a slot below the start of the gap.
+var ErrFillBackPastGenesis = errors.New("cannot fill backfill Status below the genesis slot")
+
 // Advance advances the backfill position to the given slot & root.
 // It updates the backfill block root entry in the database,
 // and also updates the Status value's copy of the backfill position slot.
 func (s *Status) Advance(ctx context.Context, upTo primitives.Slot, root [32]byte) error {
+	s.Lock()
+	defer s.Unlock()
 	if upTo > s.end {
 		return errors.Wrapf(ErrAdvancePastOrigin, "advance slot=%d, origin slot=%d", upTo, s.end)
 	}
@@ -66,8 +90,31 @@ func (s *Status) Advance(ctx context.Context, upTo primitives.Slot, root [32]byt
 	return s.store.SaveBackfillBlockRoot(ctx, root)
 }
 
+// FillBack moves the end of the gap down to the given slot, recording that the chain history between that slot
+// and the origin checkpoint is now in the database. This is the counterpart of Advance for a backfill walking
+// backwards from the origin block. The given root must be the lowest block saved so far; it updates the backfill
+// block root entry in the database so that ReloadFillBack can resume from it after a restart.
+// Once the block whose parent is the genesis block has been saved, calling FillBack with StartGap() closes the gap.
+func (s *Status) FillBack(ctx context.Context, downTo primitives.Slot, root [32]byte) error {
+	s.Lock()
+	defer s.Unlock()
+	if downTo > s.end {
+		return errors.Wrapf(ErrAdvancePastOrigin, "fill back slot=%d, backfill end slot=%d", downTo, s.end)
+	}
+	if downTo < s.start {
+		return errors.Wrapf(ErrFillBackPastGenesis, "fill back slot=%d, backfill start slot=%d", downTo, s.start)
+	}
+	if err := s.store.SaveBackfillBlockRoot(ctx, root); err != nil {
+		return err
+	}
+	s.end = downTo
+	return nil
+}
+
 // Reload queries the database for backfill status, initializing the internal data and validating the database state.
 func (s *Status) Reload(ctx context.Context) error {
+	s.Lock()
+	defer s.Unlock()
 	cpRoot, err := s.store.OriginCheckpointBlockRoot(ctx)
 	if err != nil {
 		// mark genesis sync and short circuit further lookups
@@ -112,6 +159,65 @@ func (s *Status) Reload(ctx context.Context) error {
 	return nil
 }
 
+// ReloadFillBack is the counterpart of Reload for a backfill walking backwards from the origin block, as recorded
+// by FillBack. Checkpoint sync initializes the backfill block root to the genesis root. Any other value is the
+// lowest block saved by backfill, and the gap is closed once the parent of that block is the genesis block.
+func (s *Status) ReloadFillBack(ctx context.Context) error {
+	s.Lock()
+	defer s.Unlock()
+	cpRoot, err := s.store.OriginCheckpointBlockRoot(ctx)
+	if err != nil {
+		// mark genesis sync and short circuit further lookups
+		if errors.Is(err, db.ErrNotFoundOriginBlockRoot) {
+			s.genesisSync = true
+			return nil
+		}
+		return err
+	}
+	cpBlock, err := s.store.Block(ctx, cpRoot)
+	if err != nil {
+		return errors.Wrapf(err, "error retrieving block for origin checkpoint root=%#x", cpRoot)
+	}
+	if err := blocks.BeaconBlockIsNil(cpBlock); err != nil {
+		return err
+	}
+	s.start = params.BeaconConfig().GenesisSlot
+	s.end = cpBlock.Block().Slot()
+
+	genesisRoot, err := s.store.GenesisBlockRoot(ctx)
+	if err != nil {
+		if errors.Is(err, db.ErrNotFoundGenesisBlockRoot) {
+			return errors.Wrap(err, "genesis block root required for checkpoint sync")
+		}
+		return err
+	}
+
+	bfRoot, err := s.store.BackfillBlockRoot(ctx)
+	if err != nil {
+		if errors.Is(err, db.ErrNotFoundBackfillBlockRoot) {
+			return errors.Wrap(err, "found origin checkpoint block root, but no backfill block root")
+		}
+		return err
+	}
+	// backfill has not saved any blocks yet
+	if bfRoot == genesisRoot {
+		return nil
+	}
+	bfBlock, err := s.store.Block(ctx, bfRoot)
+	if err != nil {
+		return errors.Wrapf(err, "error retrieving block for backfill root=%#x", bfRoot)
+	}
+	if err := blocks.BeaconBlockIsNil(bfBlock); err != nil {
+		return err
+	}
+	if bfBlock.Block().ParentRoot() == genesisRoot {
+		s.end = s.start
+		return nil
+	}
+	s.end = bfBlock.Block().Slot()
+	return nil
+}
+
 // BackfillDB describes the set of DB methods that the Status type needs to function.
 type BackfillDB interface {
 	SaveBackfillBlockRoot(ctx context.Context, blockRoot [32]byte) error
@@ -356,3 +356,104 @@ func TestReload(t *testing.T) {
 		require.Equal(t, c.expected.end, s.end)
 	}
 }
+
+func TestFillBack(t *testing.T) {
+	ctx := context.Background()
+	saveBackfillBuf := make([][32]byte, 0)
+	mdb := &mockBackfillDB{
+		saveBackfillBlockRoot: func(ctx context.Context, root [32]byte) error {
+			saveBackfillBuf = append(saveBackfillBuf, root)
+			return nil
+		},
+	}
+	s := &Status{end: 100, store: mdb}
+	var root [32]byte
+	copy(root[:], []byte{0x23, 0x23})
+	require.NoError(t, s.FillBack(ctx, 90, root))
+	require.Equal(t, root, saveBackfillBuf[0])
+	require.Equal(t, primitives.Slot(90), s.EndGap())
+	require.Equal(t, false, s.SlotCovered(85))
+	require.Equal(t, true, s.SlotCovered(95))
+	require.Equal(t, false, s.Complete())
+
+	require.ErrorIs(t, s.FillBack(ctx, s.end+1, root), ErrAdvancePastOrigin)
+	require.Equal(t, 1, len(saveBackfillBuf))
+
+	s.start = 10
+	require.ErrorIs(t, s.FillBack(ctx, s.start-1, root), ErrFillBackPastGenesis)
+	require.Equal(t, 1, len(saveBackfillBuf))
+
+	// filling back to the start of the gap closes it
+	require.NoError(t, s.FillBack(ctx, s.start, root))
+	require.Equal(t, true, s.Complete())
+	require.Equal(t, true, s.SlotCovered(50))
+}
+
+func TestReloadFillBack(t *testing.T) {
+	ctx := context.Background()
+	genesisRoot := params.BeaconConfig().ZeroHash
+
+	originSlot := primitives.Slot(100)
+	originRoot := [32]byte{0x01}
+	originBlock, err := setupTestBlock(originSlot)
+	require.NoError(t, err)
+
+	backfillRoot := [32]byte{0x02}
+	backfillBlock, err := setupTestBlock(50)
+	require.NoError(t, err)
+	backfillBlock, err = blocktest.SetBlockParentRoot(backfillBlock.(interfaces.SignedBeaconBlock), [32]byte{0x03})
+	require.NoError(t, err)
+
+	lastRoot := [32]byte{0x04}
+	lastBlock, err := setupTestBlock(1)
+	require.NoError(t, err)
+	lastBlock, err = blocktest.SetBlockParentRoot(lastBlock.(interfaces.SignedBeaconBlock), genesisRoot)
+	require.NoError(t, err)
+
+	blockDB := func(ctx context.Context, root [32]byte) (interfaces.ReadOnlySignedBeaconBlock, error) {
+		switch root {
+		case originRoot:
+			return originBlock, nil
+		case backfillRoot:
+			return backfillBlock, nil
+		case lastRoot:
+			return lastBlock, nil
+		}
+		return nil, nil
+	}
+	cases := []struct {
+		name     string
+		bfRoot   [32]byte
+		expected *Status
+	}{
+		{
+			name:     "backfill not started",
+			bfRoot:   genesisRoot,
+			expected: &Status{start: 0, end: originSlot},
+		},
+		{
+			name:     "backfill in progress",
+			bfRoot:   backfillRoot,
+			expected: &Status{start: 0, end: 50},
+		},
+		{
+			name:     "backfill reached genesis",
+			bfRoot:   lastRoot,
+			expected: &Status{start: 0, end: 0},
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			s := &Status{store: &mockBackfillDB{
+				genesisBlockRoot:          goodBlockRoot(genesisRoot),
+				originCheckpointBlockRoot: goodBlockRoot(originRoot),
+				backfillBlockRoot:         goodBlockRoot(c.bfRoot),
+				block:                     blockDB,
+			}}
+			require.NoError(t, s.ReloadFillBack(ctx))
+			require.Equal(t, c.expected.StartGap(), s.StartGap())
+			require.Equal(t, c.expected.EndGap(), s.EndGap())
+			require.Equal(t, c.expected.StartGap() == c.expected.EndGap(), s.Complete())
+		})
+	}
+}
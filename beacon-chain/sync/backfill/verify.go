@@ -0,0 +1,95 @@
+package backfill
+
+import (
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/core/signing"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/v4/config/params"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/blocks"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v4/crypto/bls"
+	"github.com/prysmaticlabs/prysm/v4/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/v4/network/forks"
+	"github.com/prysmaticlabs/prysm/v4/time/slots"
+)
+
+var (
+	errChainBroken      = errors.New("backfill batch does not link to the lowest verified block")
+	errUnknownProposer  = errors.New("proposer index not found in the origin state validator registry")
+	errInvalidSignature = errors.New("backfill batch contains an invalid proposer signature")
+)
+
+// verifyChain checks that blks, sorted by increasing slot, form an unbroken chain of parent roots
+// ending with the block whose root is expected.
+func verifyChain(blks []blocks.ROBlock, expected [32]byte) error {
+	for i := len(blks) - 1; i >= 0; i-- {
+		if blks[i].Root() != expected {
+			return errors.Wrapf(errChainBroken, "slot=%d, root=%#x, expected=%#x", blks[i].Block().Slot(), blks[i].Root(), expected)
+		}
+		expected = blks[i].Block().ParentRoot()
+	}
+	return nil
+}
+
+// verifier checks the proposer signatures of backfilled blocks. Public keys are looked up in the origin checkpoint
+// state. The validator registry only ever grows, so the proposer of any block before the origin is found in it.
+type verifier struct {
+	st      state.ReadOnlyBeaconState
+	gvr     [32]byte
+	domains map[[4]byte][]byte
+}
+
+func newVerifier(st state.ReadOnlyBeaconState, gvr [32]byte) *verifier {
+	return &verifier{st: st, gvr: gvr, domains: make(map[[4]byte][]byte)}
+}
+
+// verify batch verifies the proposer signatures of all the given blocks at once.
+func (v *verifier) verify(blks []blocks.ROBlock) error {
+	set := bls.NewSet()
+	for _, b := range blks {
+		blk := b.Block()
+		if uint64(blk.ProposerIndex()) >= uint64(v.st.NumValidators()) {
+			return errors.Wrapf(errUnknownProposer, "slot=%d, proposer=%d", blk.Slot(), blk.ProposerIndex())
+		}
+		pub := v.st.PubkeyAtIndex(blk.ProposerIndex())
+		domain, err := v.domain(slots.ToEpoch(blk.Slot()))
+		if err != nil {
+			return err
+		}
+		sig := b.Signature()
+		bs, err := signing.BlockSignatureBatch(pub[:], sig[:], domain, blk.HashTreeRoot)
+		if err != nil {
+			return errors.Wrapf(err, "could not build signature batch for block at slot %d", blk.Slot())
+		}
+		set.Join(bs)
+	}
+	if len(set.Signatures) == 0 {
+		return nil
+	}
+	verified, err := set.Verify()
+	if err != nil {
+		return errors.Wrap(err, "could not verify proposer signatures")
+	}
+	if !verified {
+		return errInvalidSignature
+	}
+	return nil
+}
+
+// domain returns the proposer signing domain for the fork that was active during the given epoch.
+func (v *verifier) domain(epoch primitives.Epoch) ([]byte, error) {
+	fork, err := forks.Fork(epoch)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not determine fork for epoch %d", epoch)
+	}
+	version := bytesutil.ToBytes4(fork.CurrentVersion)
+	if d, ok := v.domains[version]; ok {
+		return d, nil
+	}
+	d, err := signing.ComputeDomain(params.BeaconConfig().DomainBeaconProposer, fork.CurrentVersion, v.gvr[:])
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not compute proposer domain for fork version %#x", version)
+	}
+	v.domains[version] = d
+	return d, nil
+}
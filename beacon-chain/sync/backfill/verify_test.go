@@ -0,0 +1,92 @@
+package backfill
+
+import (
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/core/signing"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/v4/config/params"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/blocks"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v4/crypto/bls"
+	"github.com/prysmaticlabs/prysm/v4/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/v4/testing/require"
+	"github.com/prysmaticlabs/prysm/v4/testing/util"
+	"github.com/prysmaticlabs/prysm/v4/time/slots"
+)
+
+// signedChain builds a chain of signed blocks at the given increasing slots, starting from the given parent root.
+func signedChain(t *testing.T, st state.BeaconState, keys []bls.SecretKey, parent [32]byte, sl ...primitives.Slot) []blocks.ROBlock {
+	chain := make([]blocks.ROBlock, 0, len(sl))
+	for _, s := range sl {
+		b := util.NewBeaconBlock()
+		b.Block.Slot = s
+		b.Block.ProposerIndex = primitives.ValidatorIndex(uint64(s) % uint64(len(keys)))
+		b.Block.ParentRoot = bytesutil.SafeCopyBytes(parent[:])
+		sig, err := signing.ComputeDomainAndSign(st, slots.ToEpoch(s), b.Block, params.BeaconConfig().DomainBeaconProposer, keys[b.Block.ProposerIndex])
+		require.NoError(t, err)
+		b.Signature = sig
+		sb, err := blocks.NewSignedBeaconBlock(b)
+		require.NoError(t, err)
+		rb, err := blocks.NewROBlock(sb)
+		require.NoError(t, err)
+		chain = append(chain, rb)
+		parent = rb.Root()
+	}
+	return chain
+}
+
+func TestVerifyChain(t *testing.T) {
+	st, keys := util.DeterministicGenesisState(t, 8)
+	chain := signedChain(t, st, keys, [32]byte{0x01}, 1, 2, 4, 5)
+	top := chain[len(chain)-1].Root()
+
+	require.NoError(t, verifyChain(chain, top))
+	require.NoError(t, verifyChain(nil, top))
+	require.ErrorIs(t, verifyChain(chain, [32]byte{0x02}), errChainBroken)
+	// a block missing from the middle of the batch breaks the chain
+	gap := append([]blocks.ROBlock{}, chain[:1]...)
+	gap = append(gap, chain[2:]...)
+	require.ErrorIs(t, verifyChain(gap, top), errChainBroken)
+}
+
+func TestVerifier(t *testing.T) {
+	st, keys := util.DeterministicGenesisState(t, 8)
+	gvr := bytesutil.ToBytes32(st.GenesisValidatorsRoot())
+	chain := signedChain(t, st, keys, [32]byte{0x01}, 1, 2, 3, 10)
+
+	t.Run("valid", func(t *testing.T) {
+		v := newVerifier(st, gvr)
+		require.NoError(t, v.verify(chain))
+		require.NoError(t, v.verify(nil))
+	})
+	t.Run("wrong genesis validators root", func(t *testing.T) {
+		v := newVerifier(st, [32]byte{0x01})
+		require.ErrorIs(t, v.verify(chain), errInvalidSignature)
+	})
+	t.Run("invalid signature", func(t *testing.T) {
+		// sign the same block with the key of another validator
+		b := util.NewBeaconBlock()
+		b.Block.Slot = 11
+		b.Block.ProposerIndex = 3
+		sig, err := signing.ComputeDomainAndSign(st, 0, b.Block, params.BeaconConfig().DomainBeaconProposer, keys[4])
+		require.NoError(t, err)
+		b.Signature = sig
+		sb, err := blocks.NewSignedBeaconBlock(b)
+		require.NoError(t, err)
+		rb, err := blocks.NewROBlock(sb)
+		require.NoError(t, err)
+		v := newVerifier(st, gvr)
+		require.ErrorIs(t, v.verify(append(chain, rb)), errInvalidSignature)
+	})
+	t.Run("unknown proposer", func(t *testing.T) {
+		b := util.NewBeaconBlock()
+		b.Block.ProposerIndex = 8
+		sb, err := blocks.NewSignedBeaconBlock(b)
+		require.NoError(t, err)
+		rb, err := blocks.NewROBlock(sb)
+		require.NoError(t, err)
+		v := newVerifier(st, gvr)
+		require.ErrorIs(t, v.verify([]blocks.ROBlock{rb}), errUnknownProposer)
+	})
+}
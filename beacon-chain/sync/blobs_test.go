@@ -13,6 +13,7 @@ import (
 	"github.com/libp2p/go-libp2p/core/network"
 	"github.com/libp2p/go-libp2p/core/protocol"
 	mock "github.com/prysmaticlabs/prysm/v4/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/db/filesystem"
 	db "github.com/prysmaticlabs/prysm/v4/beacon-chain/db/testing"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/p2p"
 	p2ptest "github.com/prysmaticlabs/prysm/v4/beacon-chain/p2p/testing"
@@ -198,7 +199,7 @@ func (c *blobsTestCase) setup(t *testing.T) (*Service, []*ethpb.BlobSidecar, fun
 
 	client := p2ptest.NewTestP2P(t)
 	s := &Service{
-		cfg:         &config{p2p: client, chain: c.chain, clock: clock, beaconDB: d},
+		cfg:         &config{p2p: client, chain: c.chain, clock: clock, beaconDB: d, blobStorage: filesystem.NewEphemeralBlobStorage(t)},
 		rateLimiter: newRateLimiter(client),
 	}
 
@@ -223,18 +224,14 @@ func (c *blobsTestCase) run(t *testing.T) {
 	defer cleanup()
 	req := c.requestFromSidecars(sidecars)
 	expect := c.defineExpected(t, sidecars, req)
-	m := map[types.Slot][]*ethpb.BlobSidecar{}
 	for _, sc := range expect {
 		// If define expected omits a sidecar from an expected result, we don't need to save it.
 		// This can happen in particular when there are no expected results, because the nth part of the
 		// response is an error (or none at all when the whole request is invalid).
 		if sc.sidecar != nil {
-			m[sc.sidecar.Slot] = append(m[sc.sidecar.Slot], sc.sidecar)
+			require.NoError(t, s.cfg.blobStorage.Save(sc.sidecar))
 		}
 	}
-	for _, blobSidecars := range m {
-		require.NoError(t, s.cfg.beaconDB.SaveBlobSidecar(context.Background(), blobSidecars))
-	}
 	if c.total != nil {
 		require.Equal(t, *c.total, len(expect))
 	}
@@ -27,7 +27,8 @@ func NewAPIInitializer(beaconNodeHost string) (*APIInitializer, error) {
 }
 
 // Initialize downloads origin state and block for checkpoint sync and initializes database records to
-// prepare the node to begin syncing from that point.
+// prepare the node to begin syncing from that point. The deposit snapshot of the remote beacon node is
+// saved as well when available, so that deposit logs are processed from the snapshot's execution block.
 func (dl *APIInitializer) Initialize(ctx context.Context, d db.Database) error {
 	origin, err := d.OriginCheckpointBlockRoot(ctx)
 	if err == nil && origin != params.BeaconConfig().ZeroHash {
@@ -42,5 +43,12 @@ func (dl *APIInitializer) Initialize(ctx context.Context, d db.Database) error {
 	if err != nil {
 		return errors.Wrap(err, "Error retrieving checkpoint origin state and block")
 	}
-	return d.SaveOrigin(ctx, od.StateBytes(), od.BlockBytes())
+	snapshot, err := usableDepositSnapshot(od.DepositSnapshot(), od.State())
+	if err != nil {
+		return err
+	}
+	if err := d.SaveOrigin(ctx, od.StateBytes(), od.BlockBytes()); err != nil {
+		return err
+	}
+	return saveDepositSnapshot(ctx, d, snapshot)
 }
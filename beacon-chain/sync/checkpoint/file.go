@@ -6,9 +6,13 @@ import (
 	"os"
 
 	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v4/api/client/beacon"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/state"
 	"github.com/prysmaticlabs/prysm/v4/config/params"
+	"github.com/prysmaticlabs/prysm/v4/encoding/ssz/detect"
 	"github.com/prysmaticlabs/prysm/v4/io/file"
+	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
 	log "github.com/sirupsen/logrus"
 )
 
@@ -20,8 +24,9 @@ type Initializer interface {
 }
 
 // NewFileInitializer validates the given path information and creates an Initializer which will
-// use the provided state and block files to prepare the node for checkpoint sync.
-func NewFileInitializer(blockPath string, statePath string) (*FileInitializer, error) {
+// use the provided state and block files to prepare the node for checkpoint sync. The deposit snapshot
+// file is optional and ignored if depositSnapshotPath is empty.
+func NewFileInitializer(blockPath string, statePath string, depositSnapshotPath string) (*FileInitializer, error) {
 	var err error
 	if err = existsAndIsFile(blockPath); err != nil {
 		return nil, err
@@ -29,15 +34,21 @@ func NewFileInitializer(blockPath string, statePath string) (*FileInitializer, e
 	if err = existsAndIsFile(statePath); err != nil {
 		return nil, err
 	}
+	if depositSnapshotPath != "" {
+		if err = existsAndIsFile(depositSnapshotPath); err != nil {
+			return nil, err
+		}
+	}
 	// stat just to make sure it actually exists and is a file
-	return &FileInitializer{blockPath: blockPath, statePath: statePath}, nil
+	return &FileInitializer{blockPath: blockPath, statePath: statePath, depositSnapshotPath: depositSnapshotPath}, nil
 }
 
 // FileInitializer initializes a beacon-node database to use checkpoint sync,
 // using ssz-encoded block and state data stored in files on the local filesystem.
 type FileInitializer struct {
-	blockPath string
-	statePath string
+	blockPath           string
+	statePath           string
+	depositSnapshotPath string
 }
 
 // Initialize is called in the BeaconNode db startup code if an Initializer is present.
@@ -60,11 +71,81 @@ func (fi *FileInitializer) Initialize(ctx context.Context, d db.Database) error
 	if err != nil {
 		return errors.Wrapf(err, "error reading state file %s for checkpoint sync init", fi.blockPath)
 	}
-	return d.SaveOrigin(ctx, serState, serBlock)
+	var snapshot *ethpb.DepositSnapshot
+	if fi.depositSnapshotPath != "" {
+		serSnapshot, err := file.ReadFileAsBytes(fi.depositSnapshotPath)
+		if err != nil {
+			return errors.Wrapf(err, "error reading deposit snapshot file %s for checkpoint sync init", fi.depositSnapshotPath)
+		}
+		snapshot, err = beacon.UnmarshalDepositSnapshot(serSnapshot)
+		if err != nil {
+			return errors.Wrapf(err, "error decoding deposit snapshot file %s for checkpoint sync init", fi.depositSnapshotPath)
+		}
+		cf, err := detect.FromState(serState)
+		if err != nil {
+			return errors.Wrapf(err, "could not detect config and fork for state file %s", fi.statePath)
+		}
+		st, err := cf.UnmarshalBeaconState(serState)
+		if err != nil {
+			return errors.Wrapf(err, "error decoding state file %s for checkpoint sync init", fi.statePath)
+		}
+		snapshot, err = usableDepositSnapshot(snapshot, st)
+		if err != nil {
+			return err
+		}
+	}
+	if err := d.SaveOrigin(ctx, serState, serBlock); err != nil {
+		return err
+	}
+	return saveDepositSnapshot(ctx, d, snapshot)
 }
 
 var _ Initializer = &FileInitializer{}
 
+// usableDepositSnapshot checks the deposit snapshot obtained along with the checkpoint sync data against the origin
+// state, with the same rules for every source of the snapshot. A snapshot ahead of the origin state is dropped.
+func usableDepositSnapshot(snapshot *ethpb.DepositSnapshot, st state.ReadOnlyBeaconState) (*ethpb.DepositSnapshot, error) {
+	if snapshot == nil {
+		return nil, nil
+	}
+	if err := beacon.VerifyDepositSnapshot(snapshot, st); err != nil {
+		if errors.Is(err, beacon.ErrDepositSnapshotAhead) {
+			log.WithError(err).Warn("Ignoring deposit snapshot")
+			return nil, nil
+		}
+		return nil, err
+	}
+	return snapshot, nil
+}
+
+// saveDepositSnapshot saves the deposit snapshot obtained along with the checkpoint sync data, if any, for the
+// execution service to initialize its deposit tree from it. The snapshot is saved without chain start data,
+// which the execution service fills in from the genesis state. It must have been checked with usableDepositSnapshot.
+func saveDepositSnapshot(ctx context.Context, d db.Database, snapshot *ethpb.DepositSnapshot) error {
+	if snapshot == nil {
+		return nil
+	}
+	eth1Data, err := d.ExecutionChainData(ctx)
+	if err != nil {
+		return errors.Wrap(err, "error while checking database for execution chain data")
+	}
+	if eth1Data != nil {
+		log.Warn("execution chain data found in db, ignoring deposit snapshot")
+		return nil
+	}
+	if err := d.SaveExecutionChainData(ctx, &ethpb.ETH1ChainData{
+		CurrentEth1Data: &ethpb.LatestETH1Data{},
+		ChainstartData:  &ethpb.ChainStartData{},
+		DepositSnapshot: snapshot,
+	}); err != nil {
+		return errors.Wrap(err, "could not save deposit snapshot")
+	}
+	log.WithField("deposit_count", snapshot.DepositCount).
+		WithField("execution_block_height", snapshot.ExecutionDepth).
+		Info("Saved deposit snapshot for checkpoint sync")
+	return nil
+}
+
 func existsAndIsFile(path string) error {
 	info, err := os.Stat(path)
 	if err != nil {
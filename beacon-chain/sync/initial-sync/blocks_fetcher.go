@@ -51,6 +51,14 @@ const (
 	// backtrackingMaxHops how many hops (during search for common ancestor in backtracking) to do
 	// before giving up.
 	backtrackingMaxHops = 128
+	// minBlocksPerSubRange is the smallest number of slots a request is split into, when
+	// request is served by several peers.
+	minBlocksPerSubRange = 16
+	// maxSubRangesPerRequest caps the number of peers a single request is split across.
+	maxSubRangesPerRequest = 4
+	// minSubRangeThroughputScore is the lowest relative throughput (see peerBatchStats) peer must
+	// have to be assigned a sub-range.
+	minSubRangeThroughputScore = 0.5
 )
 
 var (
@@ -60,6 +68,7 @@ var (
 	errBlockAlreadyProcessed = errors.New("block is already processed")
 	errParentDoesNotExist    = errors.New("beacon node doesn't have a parent in db with root")
 	errNoPeersWithAltBlocks  = errors.New("no peers with alternative blocks found")
+	errSubRangesNotLinked    = errors.New("blocks from adjacent sub-ranges do not form a chain")
 )
 
 // Period to calculate expected limit for a single peer.
@@ -78,7 +87,8 @@ type blocksFetcherConfig struct {
 
 // blocksFetcher is a service to fetch chain data from peers.
 // On an incoming requests, requested block range is evenly divided
-// among available peers (for fair network load distribution).
+// among available peers (for fair network load distribution), while
+// blob sidecars for the range are downloaded independently.
 type blocksFetcher struct {
 	sync.Mutex
 	ctx             context.Context
@@ -92,6 +102,7 @@ type blocksFetcher struct {
 	blocksPerPeriod uint64
 	rateLimiter     *leakybucket.Collector
 	peerLocks       map[peer.ID]*peerLock
+	peerStats       *peerBatchStats
 	fetchRequests   chan *fetchRequestParams
 	fetchResponses  chan *fetchRequestResponse
 	capacityWeight  float64       // how remaining capacity affects peer selection
@@ -114,6 +125,7 @@ type fetchRequestParams struct {
 
 // fetchRequestResponse is a combined type to hold results of both successful executions and errors.
 // Valid usage pattern will be to check whether result's `err` is nil, before using `blocks`.
+// When blocks are served by several peers, `pid` is the peer that served the lowest sub-range.
 type fetchRequestResponse struct {
 	pid   peer.ID
 	start primitives.Slot
@@ -149,6 +161,7 @@ func newBlocksFetcher(ctx context.Context, cfg *blocksFetcherConfig) *blocksFetc
 		blocksPerPeriod: uint64(blocksPerPeriod),
 		rateLimiter:     rateLimiter,
 		peerLocks:       make(map[peer.ID]*peerLock),
+		peerStats:       newPeerBatchStats(),
 		fetchRequests:   make(chan *fetchRequestParams, maxPendingRequests),
 		fetchResponses:  make(chan *fetchRequestResponse, maxPendingRequests),
 		capacityWeight:  capacityWeight,
@@ -286,9 +299,16 @@ func (f *blocksFetcher) handleRequest(ctx context.Context, start primitives.Slot
 		}
 	}
 
-	response.bwb, response.pid, response.err = f.fetchBlocksFromPeer(ctx, start, count, peers)
+	// Blob sidecars are requested from their own peer, without waiting for the blocks to arrive.
+	prefetch := f.prefetchBlobs(ctx, start, count, peers)
+	if f.mode == modeStopOnFinalizedEpoch {
+		// Finalized blocks are the same on all the suitable peers, so range can be split among them.
+		response.bwb, response.pid, response.err = f.fetchBlocksFromPeers(ctx, start, count, peers)
+	} else {
+		response.bwb, response.pid, response.err = f.fetchBlocksFromPeer(ctx, start, count, peers)
+	}
 	if response.err == nil {
-		bwb, err := f.fetchBlobsFromPeer(ctx, response.bwb, response.pid)
+		bwb, err := f.fetchBlobsFromPeers(ctx, response.bwb, peers, prefetch)
 		if err != nil {
 			response.err = err
 		}
@@ -314,11 +334,13 @@ func (f *blocksFetcher) fetchBlocksFromPeer(
 	}
 	for i := 0; i < len(peers); i++ {
 		p := peers[i]
+		requested := time.Now()
 		blocks, err := f.requestBlocks(ctx, req, p)
 		if err != nil {
 			log.WithField("peer", p).WithError(err).Debug("Could not request blocks by range from peer")
 			continue
 		}
+		f.peerStats.recordBatch(p, len(blocks), time.Since(requested))
 		f.p2p.Peers().Scorers().BlockProviderScorer().Touch(p)
 		robs, err := sortedBlockWithVerifiedBlobSlice(blocks)
 		if err != nil {
@@ -330,6 +352,117 @@ func (f *blocksFetcher) fetchBlocksFromPeer(
 	return nil, "", errNoPeersAvailable
 }
 
+// fetchBlocksFromPeers splits requested range into contiguous sub-ranges, and fetches them concurrently,
+// each from a different peer. Sub-range that a peer fails to serve is retried with the next peer on the list.
+func (f *blocksFetcher) fetchBlocksFromPeers(
+	ctx context.Context,
+	start primitives.Slot, count uint64,
+	peers []peer.ID,
+) ([]blocks2.BlockWithVerifiedBlobs, peer.ID, error) {
+	ctx, span := trace.StartSpan(ctx, "initialsync.fetchBlocksFromPeers")
+	defer span.End()
+
+	filtered := f.filterPeers(ctx, peers, peersPercentagePerRequest)
+	// The whole batch is only as fast as its slowest sub-range, so sub-ranges are only given
+	// to peers which are not much slower than the fastest known peer.
+	fast := make([]peer.ID, 0, len(filtered))
+	slow := make([]peer.ID, 0, len(filtered))
+	for _, p := range filtered {
+		if f.peerStats.throughputScore(p) >= minSubRangeThroughputScore {
+			fast = append(fast, p)
+		} else {
+			slow = append(slow, p)
+		}
+	}
+	filtered = append(fast, slow...)
+	ranges := subRanges(start, count, len(fast))
+	if len(ranges) < 2 {
+		return f.fetchBlocksFromPeer(ctx, start, count, peers)
+	}
+
+	type subRangeResult struct {
+		pid peer.ID
+		bwb []blocks2.BlockWithVerifiedBlobs
+		err error
+	}
+	results := make([]subRangeResult, len(ranges))
+	var wg sync.WaitGroup
+	for i := range ranges {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			// Rotate peers, so that every sub-range starts with a different peer.
+			rotated := append(append([]peer.ID{}, filtered[i:]...), filtered[:i]...)
+			results[i].err = errNoPeersAvailable
+			for _, p := range rotated {
+				requested := time.Now()
+				blks, err := f.requestBlocks(ctx, ranges[i], p)
+				if err != nil {
+					log.WithField("peer", p).WithError(err).Debug("Could not request blocks by range from peer")
+					continue
+				}
+				bwb, err := sortedBlockWithVerifiedBlobSlice(blks)
+				if err != nil {
+					log.WithField("peer", p).WithError(err).Debug("invalid BeaconBlocksByRange response")
+					continue
+				}
+				f.peerStats.recordBatch(p, len(blks), time.Since(requested))
+				f.p2p.Peers().Scorers().BlockProviderScorer().Touch(p)
+				results[i] = subRangeResult{pid: p, bwb: bwb}
+				return
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	var bwb []blocks2.BlockWithVerifiedBlobs
+	for _, res := range results {
+		if res.err != nil {
+			return nil, "", res.err
+		}
+		if len(bwb) > 0 && len(res.bwb) > 0 {
+			if bwb[len(bwb)-1].Block.Root() != res.bwb[0].Block.Block().ParentRoot() {
+				// Sub-ranges disagree, it is impossible to tell which peer is at fault, so fall back to
+				// fetching the whole range from a single peer.
+				log.WithFields(logrus.Fields{
+					"start": start,
+					"count": count,
+					"peer":  res.pid,
+				}).WithError(errSubRangesNotLinked).Debug("Re-requesting range from a single peer")
+				return f.fetchBlocksFromPeer(ctx, start, count, peers)
+			}
+		}
+		bwb = append(bwb, res.bwb...)
+	}
+	return bwb, results[0].pid, nil
+}
+
+// subRanges splits a range into up to the given number of contiguous requests, none of which is
+// smaller than minBlocksPerSubRange.
+func subRanges(start primitives.Slot, count uint64, peersCount int) []*p2ppb.BeaconBlocksByRangeRequest {
+	n := count / minBlocksPerSubRange
+	n = math.Min(n, uint64(peersCount))
+	n = math.Min(n, maxSubRangesPerRequest)
+	if n == 0 {
+		n = 1
+	}
+	size := count / n
+	reqs := make([]*p2ppb.BeaconBlocksByRangeRequest, 0, n)
+	for i := uint64(0); i < n; i++ {
+		reqCount := size
+		if i == n-1 {
+			// The last sub-range takes the remainder.
+			reqCount = count - size*(n-1)
+		}
+		reqs = append(reqs, &p2ppb.BeaconBlocksByRangeRequest{
+			StartSlot: start.Add(size * i),
+			Count:     reqCount,
+			Step:      1,
+		})
+	}
+	return reqs
+}
+
 func sortedBlockWithVerifiedBlobSlice(blocks []interfaces.ReadOnlySignedBeaconBlock) ([]blocks2.BlockWithVerifiedBlobs, error) {
 	rb := make([]blocks2.BlockWithVerifiedBlobs, len(blocks))
 	for i, b := range blocks {
@@ -465,29 +598,130 @@ func missingCommitError(root [32]byte, slot primitives.Slot, missing [][]byte) e
 		"block root %#x at slot %d missing %d commitments %s", root, slot, len(missing), strings.Join(missStr, ","))
 }
 
-// fetchBlobsFromPeer fetches blocks from a single randomly selected peer.
-func (f *blocksFetcher) fetchBlobsFromPeer(ctx context.Context, bwb []blocks2.BlockWithVerifiedBlobs, pid peer.ID) ([]blocks2.BlockWithVerifiedBlobs, error) {
-	ctx, span := trace.StartSpan(ctx, "initialsync.fetchBlobsFromPeer")
-	defer span.End()
+// blobsPrefetch holds the result of a blob sidecars download, started before blocks of the range are known.
+type blobsPrefetch struct {
+	pid   peer.ID
+	blobs []*p2ppb.BlobSidecar
+	err   error
+	done  chan struct{}
+}
+
+// blobWindowStart returns the lowest slot for which blob sidecars should be fetched. Boolean result
+// is false when blobs are not needed at all i.e. before Deneb.
+func (f *blocksFetcher) blobWindowStart() (primitives.Slot, bool, error) {
 	if slots.ToEpoch(f.clock.CurrentSlot()) < params.BeaconConfig().DenebForkEpoch {
-		return bwb, nil
+		return 0, false, nil
 	}
-	blobWindowStart, err := prysmsync.BlobsByRangeMinStartSlot(f.clock.CurrentSlot())
+	start, err := prysmsync.BlobsByRangeMinStartSlot(f.clock.CurrentSlot())
+	if err != nil {
+		return 0, false, err
+	}
+	return start, true, nil
+}
+
+// prefetchBlobs starts downloading blob sidecars for a given slot range, in parallel with the blocks
+// of the same range. Returns nil if range is outside of the blob retention window.
+func (f *blocksFetcher) prefetchBlobs(ctx context.Context, start primitives.Slot, count uint64, peers []peer.ID) *blobsPrefetch {
+	windowStart, ok, err := f.blobWindowStart()
+	if err != nil || !ok || count == 0 {
+		return nil
+	}
+	end := start.Add(count - 1)
+	if end < windowStart {
+		return nil
+	}
+	if start < windowStart {
+		start = windowStart
+	}
+	peers = f.filterBlobPeers(ctx, peers)
+	if len(peers) == 0 {
+		return nil
+	}
+	req := &p2ppb.BlobSidecarsByRangeRequest{
+		StartSlot: start,
+		Count:     uint64(end.SubSlot(start)) + 1,
+	}
+	prefetch := &blobsPrefetch{
+		pid:  peers[0],
+		done: make(chan struct{}),
+	}
+	go func() {
+		defer close(prefetch.done)
+		prefetch.blobs, prefetch.err = f.requestBlobs(ctx, req, prefetch.pid)
+	}()
+	return prefetch
+}
+
+// fetchBlobsFromPeers populates blocks with their blob sidecars. Result of the prefetch request, if any,
+// is used first. Whenever a peer fails to return a complete and valid set of sidecars, the request is
+// retried with another peer, so that only the blob part of the batch needs to be re-downloaded.
+func (f *blocksFetcher) fetchBlobsFromPeers(
+	ctx context.Context,
+	bwb []blocks2.BlockWithVerifiedBlobs,
+	peers []peer.ID,
+	prefetch *blobsPrefetch,
+) ([]blocks2.BlockWithVerifiedBlobs, error) {
+	ctx, span := trace.StartSpan(ctx, "initialsync.fetchBlobsFromPeers")
+	defer span.End()
+	windowStart, ok, err := f.blobWindowStart()
 	if err != nil {
 		return nil, err
 	}
+	if !ok {
+		return bwb, nil
+	}
 	// Construct request message based on observed interval of blocks in need of blobs.
-	req := blobRequest(bwb, blobWindowStart)
+	req := blobRequest(bwb, windowStart)
 	if req == nil {
 		return bwb, nil
 	}
-	// Request blobs from the same peer that gave us the blob batch.
-	blobs, err := f.requestBlobs(ctx, req, pid)
-	if err != nil {
-		return nil, errors.Wrap(err, "could not request blobs by range")
+
+	var tried peer.ID
+	lastErr := errNoPeersAvailable
+	if prefetch != nil {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-prefetch.done:
+		}
+		tried = prefetch.pid
+		if prefetch.err != nil {
+			f.peerStats.recordBlobs(prefetch.pid, false)
+			lastErr = errors.Wrap(prefetch.err, "could not request blobs by range")
+		} else {
+			populated, err := verifyAndPopulateBlobs(bwb, prefetch.blobs, windowStart)
+			f.peerStats.recordBlobs(prefetch.pid, err == nil)
+			if err == nil {
+				f.p2p.Peers().Scorers().BlockProviderScorer().Touch(prefetch.pid)
+				return populated, nil
+			}
+			lastErr = err
+		}
+		log.WithField("peer", prefetch.pid).WithError(lastErr).Debug("Could not use prefetched blob sidecars")
+	}
+
+	for _, p := range f.filterBlobPeers(ctx, peers) {
+		if p == tried {
+			continue
+		}
+		blobs, err := f.requestBlobs(ctx, req, p)
+		if err != nil {
+			f.peerStats.recordBlobs(p, false)
+			lastErr = errors.Wrap(err, "could not request blobs by range")
+			log.WithField("peer", p).WithError(err).Debug("Could not request blobs by range from peer")
+			continue
+		}
+		populated, err := verifyAndPopulateBlobs(bwb, blobs, windowStart)
+		f.peerStats.recordBlobs(p, err == nil)
+		if err != nil {
+			lastErr = err
+			log.WithField("peer", p).WithError(err).Debug("Invalid BlobSidecarsByRange response")
+			continue
+		}
+		f.p2p.Peers().Scorers().BlockProviderScorer().Touch(p)
+		return populated, nil
 	}
-	f.p2p.Peers().Scorers().BlockProviderScorer().Touch(pid)
-	return verifyAndPopulateBlobs(bwb, blobs, blobWindowStart)
+	return nil, lastErr
 }
 
 // requestBlocks is a wrapper for handling BeaconBlocksByRangeRequest requests/streams.
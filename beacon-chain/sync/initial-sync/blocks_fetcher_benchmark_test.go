@@ -0,0 +1,141 @@
+package initialsync
+
+import (
+	"context"
+	"flag"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/startup"
+	"github.com/prysmaticlabs/prysm/v4/cmd/beacon-chain/flags"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v4/testing/require"
+)
+
+var rangeSyncBenchmark = flag.Bool("range-sync-benchmark", false, "Benchmark range sync against test peers")
+
+// rangeSyncScenario describes a set of test peers a range is fetched from.
+type rangeSyncScenario struct {
+	name    string
+	mode    syncMode
+	batches uint64
+	peers   []*peerData
+}
+
+func rangeSyncPeers(n int, headSlot primitives.Slot, delays ...time.Duration) []*peerData {
+	peers := make([]*peerData, n)
+	for i := range peers {
+		peers[i] = &peerData{
+			blocks:         makeSequence(1, headSlot),
+			finalizedEpoch: primitives.Epoch(headSlot / 32),
+			headSlot:       headSlot,
+		}
+		if i < len(delays) {
+			peers[i].responseDelay = delays[i]
+		}
+	}
+	return peers
+}
+
+// fetchRange requests a given number of consecutive batches concurrently (as the blocks queue does
+// with its lookahead state machines), and returns the number of blocks received.
+func fetchRange(ctx context.Context, fetcher *blocksFetcher, start primitives.Slot, batches uint64) (int, error) {
+	var wg sync.WaitGroup
+	responses := make([]*fetchRequestResponse, batches)
+	for i := uint64(0); i < batches; i++ {
+		wg.Add(1)
+		go func(i uint64) {
+			defer wg.Done()
+			responses[i] = fetcher.handleRequest(ctx, start.Add(i*fetcher.blocksPerPeriod), fetcher.blocksPerPeriod)
+		}(i)
+	}
+	wg.Wait()
+	received := 0
+	for _, resp := range responses {
+		if resp.err != nil {
+			return received, errors.Wrapf(resp.err, "could not fetch batch starting at slot %d", resp.start)
+		}
+		received += len(resp.bwb)
+	}
+	return received, nil
+}
+
+// TestBlocksFetcher_RangeSyncBenchmark is a benchmark harness for range sync, built on the test peers.
+// Test peers can only be created with *testing.T, so benchmarks are driven using testing.Benchmark.
+// Run with -range-sync-benchmark to get the results, otherwise every scenario is only run once.
+func TestBlocksFetcher_RangeSyncBenchmark(t *testing.T) {
+	batchSize := primitives.Slot(flags.Get().BlockBatchLimit)
+	headSlot := 8 * batchSize
+	scenarios := []*rangeSyncScenario{
+		{
+			name:    "multiple peers, batch per peer",
+			mode:    modeNonConstrained,
+			batches: 4,
+			peers:   rangeSyncPeers(4, headSlot),
+		},
+		{
+			name:    "multiple peers, batches split among peers",
+			mode:    modeStopOnFinalizedEpoch,
+			batches: 4,
+			peers:   rangeSyncPeers(4, headSlot),
+		},
+		{
+			name:    "multiple peers with latency, batch per peer",
+			mode:    modeNonConstrained,
+			batches: 4,
+			peers:   rangeSyncPeers(4, headSlot, 50*time.Millisecond, 20*time.Millisecond, 5*time.Millisecond),
+		},
+		{
+			name:    "multiple peers with latency, batches split among peers",
+			mode:    modeStopOnFinalizedEpoch,
+			batches: 4,
+			peers:   rangeSyncPeers(4, headSlot, 50*time.Millisecond, 20*time.Millisecond, 5*time.Millisecond),
+		},
+	}
+
+	for _, sc := range scenarios {
+		t.Run(sc.name, func(t *testing.T) {
+			mc, p2p, _ := initializeTestServices(t, makeSequence(1, headSlot), sc.peers)
+			// Peer stats are carried over between fetchers, as they would be in a long-running sync.
+			stats := newPeerBatchStats()
+			newFetcher := func(ctx context.Context) *blocksFetcher {
+				fetcher := newBlocksFetcher(ctx, &blocksFetcherConfig{
+					chain: mc,
+					p2p:   p2p,
+					clock: startup.NewClock(mc.Genesis, mc.ValidatorsRoot),
+					mode:  sc.mode,
+				})
+				fetcher.peerStats = stats
+				return fetcher
+			}
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			if !*rangeSyncBenchmark {
+				received, err := fetchRange(ctx, newFetcher(ctx), 1, sc.batches)
+				require.NoError(t, err)
+				require.Equal(t, int(sc.batches*uint64(batchSize)), received)
+				return
+			}
+
+			res := testing.Benchmark(func(b *testing.B) {
+				received := 0
+				for i := 0; i < b.N; i++ {
+					// Use a fresh fetcher, so that rate limiter doesn't affect results.
+					b.StopTimer()
+					fetcher := newFetcher(ctx)
+					b.StartTimer()
+					n, err := fetchRange(ctx, fetcher, 1, sc.batches)
+					if err != nil {
+						b.Fatal(err)
+					}
+					received += n
+				}
+				b.ReportMetric(float64(received)/b.Elapsed().Seconds(), "blocks/s")
+			})
+			t.Logf("%s: %s", sc.name, res.String())
+		})
+	}
+}
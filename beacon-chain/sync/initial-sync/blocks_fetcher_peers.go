@@ -40,6 +40,7 @@ func (f *blocksFetcher) removeStalePeerLocks(age time.Duration) {
 		if time.Since(lock.accessed) >= age {
 			lock.Lock()
 			delete(f.peerLocks, peerID)
+			f.peerStats.remove(peerID)
 			lock.Unlock()
 		}
 	}
@@ -90,7 +91,8 @@ func (f *blocksFetcher) waitForMinimumPeers(ctx context.Context) ([]peer.ID, err
 	}
 }
 
-// filterPeers returns transformed list of peers, weight sorted by scores and capacity remaining.
+// filterPeers returns transformed list of peers, weight sorted by scores, capacity remaining and
+// observed per batch throughput.
 // List can be further constrained using peersPercentage, where only percentage of peers are returned.
 func (f *blocksFetcher) filterPeers(ctx context.Context, peers []peer.ID, peersPercentage float64) []peer.ID {
 	ctx, span := trace.StartSpan(ctx, "initialsync.filterPeers")
@@ -115,12 +117,36 @@ func (f *blocksFetcher) filterPeers(ctx context.Context, peers []peer.ID, peersP
 		}
 		capScore := remaining / capacity
 		overallScore := blockProviderScore*(1.0-f.capacityWeight) + capScore*f.capacityWeight
+		// Peers that were slow to serve previous batches get proportionally smaller weight.
+		overallScore *= 1.0 - peerFilterThroughputWeight + f.peerStats.throughputScore(peerID)*peerFilterThroughputWeight
 		return math.Round(overallScore*scorers.ScoreRoundingFactor) / scorers.ScoreRoundingFactor
 	})
 
 	return trimPeers(peers, peersPercentage)
 }
 
+// filterBlobPeers returns list of peers, weight sorted by how completely they served previous blob
+// requests and by capacity remaining.
+func (f *blocksFetcher) filterBlobPeers(ctx context.Context, peers []peer.ID) []peer.ID {
+	_, span := trace.StartSpan(ctx, "initialsync.filterBlobPeers")
+	defer span.End()
+
+	if len(peers) == 0 {
+		return peers
+	}
+
+	scorer := f.p2p.Peers().Scorers().BlockProviderScorer()
+	return scorer.WeightSorted(f.rand, peers, func(peerID peer.ID, _ float64) float64 {
+		remaining, capacity := float64(f.rateLimiter.Remaining(peerID.String())), float64(f.rateLimiter.Capacity())
+		if remaining < float64(f.blocksPerPeriod) {
+			return 0.0
+		}
+		capScore := remaining / capacity
+		overallScore := f.peerStats.blobScore(peerID)*(1.0-f.capacityWeight) + capScore*f.capacityWeight
+		return math.Round(overallScore*scorers.ScoreRoundingFactor) / scorers.ScoreRoundingFactor
+	})
+}
+
 // trimPeers limits peer list, returning only specified percentage of peers.
 // Takes system constraints into account (min/max peers to sync).
 func trimPeers(peers []peer.ID, peersPercentage float64) []peer.ID {
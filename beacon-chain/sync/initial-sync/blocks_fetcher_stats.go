@@ -0,0 +1,113 @@
+package initialsync
+
+import (
+	"sync"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+)
+
+const (
+	// batchThroughputDecay defines how much the latest batch affects peer's throughput average. Provided
+	// as percentage, i.e. 0.3 means the latest batch determines 30% of the new average.
+	batchThroughputDecay = 0.3
+	// peerFilterThroughputWeight defines how peer's relative throughput affects peer's score.
+	peerFilterThroughputWeight = 0.5
+)
+
+// peerBatchStats keeps track of how well peers serve range requests, on a per batch basis. Unlike
+// scores kept by the p2p scorers, these stats are local to the fetcher and are only used to steer
+// block and blob requests towards the peers that serve them quickly and completely.
+type peerBatchStats struct {
+	sync.RWMutex
+	peers map[peer.ID]*peerBatchStat
+}
+
+// peerBatchStat holds download stats of a single peer.
+type peerBatchStat struct {
+	throughput    float64 // moving average of blocks per second
+	blobRequests  uint64
+	blobsComplete uint64
+}
+
+// newPeerBatchStats creates an empty stats tracker.
+func newPeerBatchStats() *peerBatchStats {
+	return &peerBatchStats{
+		peers: make(map[peer.ID]*peerBatchStat),
+	}
+}
+
+// stat returns stats record of a given peer, creating it if necessary. Caller must hold the lock.
+func (s *peerBatchStats) stat(pid peer.ID) *peerBatchStat {
+	st, ok := s.peers[pid]
+	if !ok {
+		st = &peerBatchStat{}
+		s.peers[pid] = st
+	}
+	return st
+}
+
+// recordBatch updates peer's throughput average using the number of blocks served within a given time.
+func (s *peerBatchStats) recordBatch(pid peer.ID, count int, elapsed time.Duration) {
+	if elapsed <= 0 {
+		return
+	}
+	s.Lock()
+	defer s.Unlock()
+	st := s.stat(pid)
+	sample := float64(count) / elapsed.Seconds()
+	if st.throughput == 0 {
+		st.throughput = sample
+		return
+	}
+	st.throughput = st.throughput*(1-batchThroughputDecay) + sample*batchThroughputDecay
+}
+
+// recordBlobs updates peer's blob completeness stats, complete must be true only when peer returned
+// all the sidecars committed to by the requested blocks.
+func (s *peerBatchStats) recordBlobs(pid peer.ID, complete bool) {
+	s.Lock()
+	defer s.Unlock()
+	st := s.stat(pid)
+	st.blobRequests++
+	if complete {
+		st.blobsComplete++
+	}
+}
+
+// throughputScore returns peer's throughput relative to the fastest known peer, in [0, 1] range.
+// Peers with no recorded batches are given the maximum score, so that they are probed.
+func (s *peerBatchStats) throughputScore(pid peer.ID) float64 {
+	s.RLock()
+	defer s.RUnlock()
+	st, ok := s.peers[pid]
+	if !ok || st.throughput == 0 {
+		return 1.0
+	}
+	highest := 0.0
+	for _, other := range s.peers {
+		if other.throughput > highest {
+			highest = other.throughput
+		}
+	}
+	return st.throughput / highest
+}
+
+// blobScore returns a share of blob requests peer has served completely, in (0, 1] range.
+// Score is smoothed, so that a single failure doesn't exclude a peer permanently.
+func (s *peerBatchStats) blobScore(pid peer.ID) float64 {
+	s.RLock()
+	defer s.RUnlock()
+	st, ok := s.peers[pid]
+	if !ok {
+		return 1.0
+	}
+	return float64(st.blobsComplete+1) / float64(st.blobRequests+1)
+}
+
+// remove deletes stats of a given peer.
+func (s *peerBatchStats) remove(pid peer.ID) {
+	s.Lock()
+	defer s.Unlock()
+	delete(s.peers, pid)
+}
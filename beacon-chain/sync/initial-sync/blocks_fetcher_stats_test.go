@@ -0,0 +1,49 @@
+package initialsync
+
+import (
+	"testing"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/v4/testing/assert"
+	"github.com/prysmaticlabs/prysm/v4/testing/require"
+)
+
+func TestPeerBatchStats_throughputScore(t *testing.T) {
+	stats := newPeerBatchStats()
+	assert.Equal(t, 1.0, stats.throughputScore("a"), "Unknown peer must have max score")
+
+	stats.recordBatch("a", 64, time.Second)
+	stats.recordBatch("b", 32, time.Second)
+	stats.recordBatch("c", 64, 0)
+	assert.Equal(t, 1.0, stats.throughputScore("a"))
+	assert.Equal(t, 0.5, stats.throughputScore("b"))
+	assert.Equal(t, 1.0, stats.throughputScore("c"), "Batches with no duration must be ignored")
+
+	// Moving average, the latest batch is weighted by batchThroughputDecay.
+	stats.recordBatch("b", 128, time.Second)
+	want := 32*(1-batchThroughputDecay) + 128*batchThroughputDecay
+	require.Equal(t, want, stats.peers["b"].throughput)
+	assert.Equal(t, want/64, stats.throughputScore("b"))
+	stats.recordBatch("b", 128, time.Second)
+	assert.Equal(t, 1.0, stats.throughputScore("b"))
+	assert.Equal(t, 64/stats.peers["b"].throughput, stats.throughputScore("a"))
+
+	stats.remove("b")
+	assert.Equal(t, 1.0, stats.throughputScore("a"))
+	assert.Equal(t, 1.0, stats.throughputScore("b"))
+}
+
+func TestPeerBatchStats_blobScore(t *testing.T) {
+	stats := newPeerBatchStats()
+	assert.Equal(t, 1.0, stats.blobScore("a"), "Unknown peer must have max score")
+
+	stats.recordBlobs("a", true)
+	assert.Equal(t, 1.0, stats.blobScore("a"))
+
+	stats.recordBlobs("b", false)
+	assert.Equal(t, 0.5, stats.blobScore("b"))
+	stats.recordBlobs("b", false)
+	stats.recordBlobs("b", true)
+	assert.Equal(t, 0.5, stats.blobScore("b"))
+	assert.Equal(t, true, stats.blobScore("b") < stats.blobScore("a"))
+}
@@ -479,6 +479,114 @@ func TestBlocksFetcher_handleRequest(t *testing.T) {
 	})
 }
 
+func TestBlocksFetcher_fetchBlocksFromPeers(t *testing.T) {
+	blockBatchLimit := uint64(flags.Get().BlockBatchLimit)
+	expectedBlockSlots := makeSequence(1, primitives.Slot(blockBatchLimit))
+	peers := []*peerData{
+		{
+			blocks:         makeSequence(1, 320),
+			finalizedEpoch: 8,
+			headSlot:       320,
+		},
+		{
+			blocks:         makeSequence(1, 320),
+			finalizedEpoch: 8,
+			headSlot:       320,
+			failureSlots:   makeSequence(1, 32),
+		},
+		{
+			blocks:         makeSequence(1, 320),
+			finalizedEpoch: 8,
+			headSlot:       320,
+		},
+		{
+			blocks:         makeSequence(1, 320),
+			finalizedEpoch: 8,
+			headSlot:       320,
+		},
+	}
+	mc, p2p, _ := initializeTestServices(t, expectedBlockSlots, peers)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	fetcher := newBlocksFetcher(ctx, &blocksFetcherConfig{
+		chain: mc,
+		p2p:   p2p,
+		clock: startup.NewClock(mc.Genesis, mc.ValidatorsRoot),
+	})
+
+	_, _, pids := fetcher.calculateHeadAndTargetEpochs()
+	require.Equal(t, len(peers), len(pids))
+	bwb, pid, err := fetcher.fetchBlocksFromPeers(ctx, 1, blockBatchLimit, pids)
+	require.NoError(t, err)
+	assert.NotEqual(t, "", pid)
+	require.Equal(t, len(expectedBlockSlots), len(bwb))
+	for i := range bwb {
+		require.Equal(t, expectedBlockSlots[i], bwb[i].Block.Block().Slot())
+		if i > 0 {
+			require.Equal(t, bwb[i-1].Block.Root(), bwb[i].Block.Block().ParentRoot())
+		}
+	}
+	// Peers that served sub-ranges have their throughput recorded.
+	assert.NotEqual(t, 0, len(fetcher.peerStats.peers))
+}
+
+func TestSubRanges(t *testing.T) {
+	tests := []struct {
+		name       string
+		start      primitives.Slot
+		count      uint64
+		peersCount int
+		want       [][2]uint64
+	}{
+		{
+			name:       "no peers",
+			start:      10,
+			count:      64,
+			peersCount: 0,
+			want:       [][2]uint64{{10, 64}},
+		},
+		{
+			name:       "too small to split",
+			start:      10,
+			count:      minBlocksPerSubRange*2 - 1,
+			peersCount: 4,
+			want:       [][2]uint64{{10, minBlocksPerSubRange*2 - 1}},
+		},
+		{
+			name:       "split across peers",
+			start:      64,
+			count:      64,
+			peersCount: 2,
+			want:       [][2]uint64{{64, 32}, {96, 32}},
+		},
+		{
+			name:       "capped by number of sub-ranges",
+			start:      0,
+			count:      64,
+			peersCount: 10,
+			want:       [][2]uint64{{0, 16}, {16, 16}, {32, 16}, {48, 16}},
+		},
+		{
+			name:       "remainder goes to the last sub-range",
+			start:      1,
+			count:      50,
+			peersCount: 3,
+			want:       [][2]uint64{{1, 16}, {17, 16}, {33, 18}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reqs := subRanges(tt.start, tt.count, tt.peersCount)
+			require.Equal(t, len(tt.want), len(reqs))
+			for i, req := range reqs {
+				assert.Equal(t, primitives.Slot(tt.want[i][0]), req.StartSlot)
+				assert.Equal(t, tt.want[i][1], req.Count)
+				assert.Equal(t, uint64(1), req.Step)
+			}
+		})
+	}
+}
+
 func TestBlocksFetcher_requestBeaconBlocksByRange(t *testing.T) {
 	blockBatchLimit := flags.Get().BlockBatchLimit
 	chainConfig := struct {
@@ -50,6 +50,7 @@ type peerData struct {
 	headSlot       primitives.Slot
 	failureSlots   []primitives.Slot // slots at which the peer will return an error
 	forkedPeer     bool
+	responseDelay  time.Duration // how long the peer waits before serving a request
 }
 
 func TestMain(m *testing.M) {
@@ -175,6 +176,9 @@ func connectPeer(t *testing.T, host *p2pt.TestP2P, datum *peerData, peerStatus *
 
 		req := &ethpb.BeaconBlocksByRangeRequest{}
 		assert.NoError(t, p.Encoding().DecodeWithMaxLength(stream, req))
+		if datum.responseDelay > 0 {
+			time.Sleep(datum.responseDelay)
+		}
 
 		requestedBlocks := makeSequence(req.StartSlot, req.StartSlot.Add((req.Count-1)*req.Step))
 
@@ -10,6 +10,7 @@ import (
 	"github.com/paulbellamy/ratecounter"
 	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/core/transition"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/state"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/sync"
 	"github.com/prysmaticlabs/prysm/v4/consensus-types/blocks"
 	"github.com/prysmaticlabs/prysm/v4/consensus-types/interfaces"
@@ -21,6 +22,8 @@ import (
 const (
 	// counterSeconds is an interval over which an average rate will be calculated.
 	counterSeconds = 20
+	// verifiedBatchesBuffer is a number of batches that can be verified ahead of the batch being imported.
+	verifiedBatchesBuffer = 2
 )
 
 // blockReceiverFn defines block receiving function.
@@ -90,11 +93,10 @@ func (s *Service) syncToFinalizedEpoch(ctx context.Context, genesis time.Time) e
 		return err
 	}
 
-	for data := range queue.fetchedData {
-		// If blobs are available. Verify blobs and blocks are consistence.
-		// We can't import a block if there's no associated blob within DA bound.
-		// The blob has to pass aggregated proof check.
-		s.processFetchedData(ctx, genesis, s.cfg.Chain.HeadSlot(), data)
+	// Download, verification and import are pipelined: while a batch is imported, the next one is
+	// verified and the queue keeps fetching the following ones.
+	for data := range s.verifyFetchedData(ctx, queue.fetchedData) {
+		s.importFetchedData(ctx, genesis, s.cfg.Chain.HeadSlot(), data)
 	}
 
 	log.WithFields(logrus.Fields{
@@ -142,13 +144,51 @@ func (s *Service) syncToNonFinalizedEpoch(ctx context.Context, genesis time.Time
 	return nil
 }
 
-// processFetchedData processes data received from queue.
-func (s *Service) processFetchedData(
+// verifyFetchedData verifies batches received from queue in a separate goroutine, and forwards the
+// ones that can be imported. This way queue is never blocked by the import of a batch. Consecutive
+// batches are verified on top of each other, without waiting for the previous one to be imported.
+func (s *Service) verifyFetchedData(
+	ctx context.Context, fetched <-chan *blocksQueueFetchedData) <-chan *blocksQueueFetchedData {
+	verified := make(chan *blocksQueueFetchedData, verifiedBatchesBuffer)
+	go func() {
+		defer close(verified)
+		var (
+			postState state.BeaconState
+			postRoot  [32]byte
+		)
+		for data := range fetched {
+			bwb, err := s.verifyBatch(ctx, data.bwb)
+			if err != nil {
+				log.WithError(err).Warn("Skip processing batched blocks")
+				continue
+			}
+			var preState state.BeaconState
+			if postState != nil && bwb[0].Block.Block().ParentRoot() == postRoot {
+				preState = postState
+			}
+			postState, err = s.cfg.Chain.VerifyBlockBatch(ctx, preState, bwb)
+			if err != nil {
+				log.WithError(err).Warn("Skip processing batched blocks")
+				continue
+			}
+			postRoot = bwb[len(bwb)-1].Block.Root()
+			select {
+			case <-ctx.Done():
+				return
+			case verified <- &blocksQueueFetchedData{pid: data.pid, bwb: bwb}:
+			}
+		}
+	}()
+	return verified
+}
+
+// importFetchedData imports a batch that has already been verified by verifyFetchedData.
+func (s *Service) importFetchedData(
 	ctx context.Context, genesis time.Time, startSlot primitives.Slot, data *blocksQueueFetchedData) {
 	defer s.updatePeerScorerStats(data.pid, startSlot)
 
 	// Use Batch Block Verify to process and verify batches directly.
-	if err := s.processBatchedBlocks(ctx, genesis, data.bwb, s.cfg.Chain.ReceiveBlockBatch); err != nil {
+	if err := s.importBatch(ctx, genesis, data.bwb, s.cfg.Chain.ReceiveBlockBatch); err != nil {
 		log.WithError(err).Warn("Skip processing batched blocks")
 	}
 }
@@ -163,8 +203,8 @@ func (s *Service) processFetchedDataRegSync(
 	blksWithoutParentCount := 0
 	for _, b := range data.bwb {
 		if len(b.Blobs) > 0 {
-			if err := s.cfg.DB.SaveBlobSidecar(ctx, b.Blobs); err != nil {
-				log.WithError(err).Warn("Failed to save blob sidecar")
+			if err := s.cfg.BlobStorage.SaveSidecars(b.Blobs); err != nil {
+				log.WithError(err).Warn("Failed to save blob sidecars")
 			}
 		}
 
@@ -300,33 +340,68 @@ func validUnprocessed(ctx context.Context, bwb []blocks.BlockWithVerifiedBlobs,
 	return bwb[nonProcessedIdx:], nil
 }
 
+// processBatchedBlocks verifies and imports a batch of blocks.
 func (s *Service) processBatchedBlocks(ctx context.Context, genesis time.Time,
 	bwb []blocks.BlockWithVerifiedBlobs, bFunc batchBlockReceiverFn) error {
-	if len(bwb) == 0 {
-		return errors.New("0 blocks provided into method")
-	}
-	headSlot := s.cfg.Chain.HeadSlot()
-	var err error
-	bwb, err = validUnprocessed(ctx, bwb, headSlot, s.isProcessedBlock)
+	bwb, err := s.verifyBatch(ctx, bwb)
 	if err != nil {
 		return err
 	}
+	if _, err := s.cfg.Chain.VerifyBlockBatch(ctx, nil, bwb); err != nil {
+		return err
+	}
+	return s.importBatch(ctx, genesis, bwb, bFunc)
+}
+
+// verifyBatch runs the checks that do not depend on the chain service, i.e. that blocks form a chain.
+// Blocks that are already processed are dropped from the returned batch.
+func (s *Service) verifyBatch(ctx context.Context, bwb []blocks.BlockWithVerifiedBlobs) ([]blocks.BlockWithVerifiedBlobs, error) {
+	if len(bwb) == 0 {
+		return nil, errors.New("0 blocks provided into method")
+	}
+	return validUnprocessed(ctx, bwb, s.cfg.Chain.HeadSlot(), s.isProcessedBlock)
+}
+
+// importBatch hands over a batch of blocks verified by the chain service to the receiver function,
+// and persists the blob sidecars of the batch once it is imported.
+func (s *Service) importBatch(ctx context.Context, genesis time.Time,
+	bwb []blocks.BlockWithVerifiedBlobs, bFunc batchBlockReceiverFn) error {
 	if len(bwb) == 0 {
 		return nil
 	}
+	verifiedCount := len(bwb)
+	// Head might have moved since the batch was verified.
+	bwb, err := validUnprocessed(ctx, bwb, s.cfg.Chain.HeadSlot(), s.isProcessedBlock)
+	if err != nil {
+		return err
+	}
 
 	first := bwb[0].Block
 	if !s.cfg.Chain.HasBlock(ctx, first.Block().ParentRoot()) {
 		return fmt.Errorf("%w: %#x (in processBatchedBlocks, slot=%d)",
 			errParentDoesNotExist, first.Block().ParentRoot(), first.Block().Slot())
 	}
+	if len(bwb) != verifiedCount {
+		// The verified batch no longer matches the blocks left to import, verify these on their own.
+		if _, err := s.cfg.Chain.VerifyBlockBatch(ctx, nil, bwb); err != nil {
+			return err
+		}
+	}
 	s.logBatchSyncStatus(genesis, first, len(bwb))
+	if err := bFunc(ctx, blocks.BlockWithVerifiedBlobsSlice(bwb).ROBlocks()); err != nil {
+		return err
+	}
+	return s.saveBlobSidecars(bwb)
+}
+
+// saveBlobSidecars persists the blob sidecars of an imported batch.
+func (s *Service) saveBlobSidecars(bwb []blocks.BlockWithVerifiedBlobs) error {
 	blobCount := 0
 	for _, bb := range bwb {
 		if len(bb.Blobs) == 0 {
 			continue
 		}
-		if err := s.cfg.DB.SaveBlobSidecar(ctx, bb.Blobs); err != nil {
+		if err := s.cfg.BlobStorage.SaveSidecars(bb.Blobs); err != nil {
 			return errors.Wrapf(err, "failed to save blobs for block %#x", bb.Block.Root())
 		}
 		blobCount += len(bb.Blobs)
@@ -338,8 +413,7 @@ func (s *Service) processBatchedBlocks(ctx context.Context, genesis time.Time,
 			"count":     blobCount,
 		}).Info("Processed blob sidecars")
 	}
-
-	return bFunc(ctx, blocks.BlockWithVerifiedBlobsSlice(bwb).ROBlocks())
+	return nil
 }
 
 // updatePeerScorerStats adjusts monitored metrics for a peer.
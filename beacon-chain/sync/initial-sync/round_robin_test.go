@@ -6,8 +6,10 @@ import (
 	"time"
 
 	"github.com/paulbellamy/ratecounter"
+	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/v4/async/abool"
 	mock "github.com/prysmaticlabs/prysm/v4/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/db/filesystem"
 	dbtest "github.com/prysmaticlabs/prysm/v4/beacon-chain/db/testing"
 	p2pt "github.com/prysmaticlabs/prysm/v4/beacon-chain/p2p/testing"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/startup"
@@ -501,6 +503,56 @@ func TestService_processBlockBatch(t *testing.T) {
 	})
 }
 
+func TestService_importBatch_SavesBlobsOnceImported(t *testing.T) {
+	beaconDB := dbtest.SetupDB(t)
+	genesisBlk := util.NewBeaconBlock()
+	genesisBlkRoot, err := genesisBlk.Block.HashTreeRoot()
+	require.NoError(t, err)
+	util.SaveBlock(t, context.Background(), beaconDB, genesisBlk)
+	st, err := util.NewBeaconState()
+	require.NoError(t, err)
+	blobStorage := filesystem.NewEphemeralBlobStorage(t)
+	s := NewService(context.Background(), &Config{
+		P2P: p2pt.NewTestP2P(t),
+		DB:  beaconDB,
+		Chain: &mock.ChainService{
+			State: st,
+			Root:  genesisBlkRoot[:],
+			DB:    beaconDB,
+			FinalizedCheckPoint: &eth.Checkpoint{
+				Epoch: 0,
+			},
+		},
+		StateNotifier: &mock.MockStateNotifier{},
+		BlobStorage:   blobStorage,
+	})
+	ctx := context.Background()
+	genesis := makeGenesisTime(32)
+
+	blk, sidecars := util.GenerateTestDenebBlockWithSidecar(t, genesisBlkRoot, 1, 2)
+	bwb := []blocks.BlockWithVerifiedBlobs{{Block: blk, Blobs: sidecars}}
+
+	importErr := errors.New("import failed")
+	err = s.importBatch(ctx, genesis, bwb, func(context.Context, []blocks.ROBlock) error {
+		return importErr
+	})
+	require.ErrorIs(t, err, importErr)
+	indices, err := blobStorage.Indices(blk.Root())
+	require.NoError(t, err)
+	for i := range sidecars {
+		assert.Equal(t, false, indices[i], "Blob %d saved for a block that was not imported", i)
+	}
+
+	require.NoError(t, s.importBatch(ctx, genesis, bwb, func(context.Context, []blocks.ROBlock) error {
+		return nil
+	}))
+	indices, err = blobStorage.Indices(blk.Root())
+	require.NoError(t, err)
+	for i := range sidecars {
+		assert.Equal(t, true, indices[i], "Blob %d not saved for an imported block", i)
+	}
+}
+
 func TestService_blockProviderScoring(t *testing.T) {
 	currentPeriod := blockLimiterPeriod
 	blockLimiterPeriod = 1 * time.Second
@@ -56,6 +56,21 @@ var (
 		},
 		[]string{"topic"},
 	)
+	gossipValidationStepLatency = promauto.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "gossip_validation_step_latency_milliseconds",
+			Help:    "Time taken by each step of the gossip validation pipeline of a topic, in milliseconds.",
+			Buckets: []float64{0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 25, 50, 100, 250, 500, 1000},
+		},
+		[]string{"topic", "step"},
+	)
+	gossipValidationStepVerdicts = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "gossip_validation_step_verdicts_total",
+			Help: "Count of gossip messages ignored or rejected by each step of the validation pipeline of a topic.",
+		},
+		[]string{"topic", "step", "verdict"},
+	)
 	numberOfTimesResyncedCounter = promauto.NewCounter(
 		prometheus.CounterOpts{
 			Name: "number_of_times_resynced",
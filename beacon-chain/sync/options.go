@@ -2,9 +2,11 @@ package sync
 
 import (
 	"github.com/prysmaticlabs/prysm/v4/async/event"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/cache"
 	blockfeed "github.com/prysmaticlabs/prysm/v4/beacon-chain/core/feed/block"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/core/feed/operation"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/db/filesystem"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/execution"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/operations/attestations"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/operations/blstoexec"
@@ -39,6 +41,22 @@ func WithDatabase(db db.NoHeadAccessDatabase) Option {
 	}
 }
 
+// WithBlobStorage gives the sync package direct access to BlobStorage.
+func WithBlobStorage(b *filesystem.BlobStorage) Option {
+	return func(s *Service) error {
+		s.cfg.blobStorage = b
+		return nil
+	}
+}
+
+// WithBlobArrivalsCache for recording when and from which peer blob sidecars were received over gossip.
+func WithBlobArrivalsCache(c *cache.BlobArrivalsCache) Option {
+	return func(s *Service) error {
+		s.cfg.blobArrivals = c
+		return nil
+	}
+}
+
 func WithAttestationPool(attPool attestations.Pool) Option {
 	return func(s *Service) error {
 		s.cfg.attPool = attPool
@@ -123,6 +141,22 @@ func WithSlasherBlockHeadersFeed(slasherBlockHeadersFeed *event.Feed) Option {
 	}
 }
 
+// WithSlasherBlobSidecarsFeed sets the feed used to send blob sidecars with a valid signature to slasher.
+func WithSlasherBlobSidecarsFeed(slasherBlobSidecarsFeed *event.Feed) Option {
+	return func(s *Service) error {
+		s.cfg.slasherBlobSidecarsFeed = slasherBlobSidecarsFeed
+		return nil
+	}
+}
+
+// WithSlasherSyncCommitteeMessagesFeed sets the feed used to send valid sync committee messages to slasher.
+func WithSlasherSyncCommitteeMessagesFeed(slasherSyncMessagesFeed *event.Feed) Option {
+	return func(s *Service) error {
+		s.cfg.slasherSyncMessagesFeed = slasherSyncMessagesFeed
+		return nil
+	}
+}
+
 func WithExecutionPayloadReconstructor(r execution.ExecutionPayloadReconstructor) Option {
 	return func(s *Service) error {
 		s.cfg.executionPayloadReconstructor = r
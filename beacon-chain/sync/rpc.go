@@ -11,6 +11,7 @@ import (
 	ssz "github.com/prysmaticlabs/fastssz"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/p2p"
 	p2ptypes "github.com/prysmaticlabs/prysm/v4/beacon-chain/p2p/types"
+	"github.com/prysmaticlabs/prysm/v4/config/features"
 	"github.com/prysmaticlabs/prysm/v4/config/params"
 	"github.com/prysmaticlabs/prysm/v4/monitoring/tracing"
 	"github.com/prysmaticlabs/prysm/v4/time"
@@ -94,6 +95,16 @@ func (s *Service) registerRPCHandlersAltair() {
 		p2p.RPCMetaDataTopicV2,
 		s.metaDataHandler,
 	)
+	if features.Get().EnableLightClient {
+		s.registerRPC(
+			p2p.RPCLightClientBootstrapTopicV1,
+			s.lightClientBootstrapRPCHandler,
+		)
+		s.registerRPC(
+			p2p.RPCLightClientUpdatesByRangeTopicV1,
+			s.lightClientUpdatesByRangeRPCHandler,
+		)
+	}
 }
 
 func (s *Service) registerRPCHandlersDeneb() {
@@ -149,6 +149,5 @@ func (s *Service) requestPendingBlobs(ctx context.Context, b interfaces.ReadOnly
 	for _, sidecar := range blobSidecars {
 		log.WithFields(blobFields(sidecar)).Debug("Received blob sidecar gossip RPC")
 	}
-
-	return s.cfg.beaconDB.SaveBlobSidecar(ctx, blobSidecars)
+	return s.cfg.blobStorage.SaveSidecars(blobSidecars)
 }
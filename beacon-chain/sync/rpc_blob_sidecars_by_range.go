@@ -7,7 +7,7 @@ import (
 
 	libp2pcore "github.com/libp2p/go-libp2p/core"
 	"github.com/pkg/errors"
-	"github.com/prysmaticlabs/prysm/v4/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/db/filesystem"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/p2p"
 	p2ptypes "github.com/prysmaticlabs/prysm/v4/beacon-chain/p2p/types"
 	"github.com/prysmaticlabs/prysm/v4/cmd/beacon-chain/flags"
@@ -25,12 +25,12 @@ func (s *Service) streamBlobBatch(ctx context.Context, batch blockBatch, wQuota
 	if wQuota == 0 {
 		return 0, nil
 	}
-	ctx, span := trace.StartSpan(ctx, "sync.streamBlobBatch")
+	_, span := trace.StartSpan(ctx, "sync.streamBlobBatch")
 	defer span.End()
 	for _, b := range batch.canonical() {
 		root := b.Root()
-		scs, err := s.cfg.beaconDB.BlobSidecarsByRoot(ctx, b.Root())
-		if errors.Is(err, db.ErrNotFound) {
+		scs, err := s.cfg.blobStorage.SidecarsByRoot(root)
+		if errors.Is(err, filesystem.ErrNotFound) {
 			continue
 		}
 		if err != nil {
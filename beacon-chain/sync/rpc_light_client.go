@@ -0,0 +1,124 @@
+package sync
+
+import (
+	"context"
+
+	libp2pcore "github.com/libp2p/go-libp2p/core"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/blockchain"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/p2p"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/p2p/types"
+	"github.com/prysmaticlabs/prysm/v4/config/params"
+	"github.com/prysmaticlabs/prysm/v4/monitoring/tracing"
+	"go.opencensus.io/trace"
+)
+
+// lightClientBootstrapRPCHandler handles the /eth2/beacon_chain/req/light_client_bootstrap/1/ RPC request.
+// spec: https://github.com/ethereum/consensus-specs/blob/3d235740e5f1e641d3b160c8688f26e7dc5a1894/specs/altair/light-client/p2p-interface.md#getlightclientbootstrap
+func (s *Service) lightClientBootstrapRPCHandler(ctx context.Context, msg interface{}, stream libp2pcore.Stream) error {
+	ctx, span := trace.StartSpan(ctx, "sync.lightClientBootstrapRPCHandler")
+	defer span.End()
+	ctx, cancel := context.WithTimeout(ctx, respTimeout)
+	defer cancel()
+	SetRPCStreamDeadlines(stream)
+	log := log.WithField("handler", p2p.LightClientBootstrapName[1:]) // slice the leading slash off the name var
+
+	req, ok := msg.(*types.LightClientBootstrapReq)
+	if !ok {
+		return errors.New("message is not type LightClientBootstrapReq")
+	}
+	if err := s.rateLimiter.validateRequest(stream, 1); err != nil {
+		return err
+	}
+	s.rateLimiter.add(stream, 1)
+
+	root := [32]byte(*req)
+	if !s.cfg.beaconDB.HasBlock(ctx, root) {
+		s.writeErrorResponseToStream(responseCodeResourceUnavailable, types.ErrLightClientUnavailable.Error(), stream)
+		return types.ErrLightClientUnavailable
+	}
+	st, err := s.cfg.stateGen.StateByRoot(ctx, root)
+	if err != nil {
+		s.writeErrorResponseToStream(responseCodeServerError, types.ErrGeneric.Error(), stream)
+		tracing.AnnotateError(span, err)
+		return errors.Wrapf(err, "could not get state of block root %#x", root)
+	}
+	bootstrap, err := blockchain.NewLightClientBootstrapFromBeaconState(ctx, st)
+	if err != nil {
+		// The state is from before altair, or is the post state of a skipped slot.
+		log.WithError(err).Debugf("Could not create light client bootstrap of block root %#x", root)
+		s.writeErrorResponseToStream(responseCodeResourceUnavailable, types.ErrLightClientUnavailable.Error(), stream)
+		return types.ErrLightClientUnavailable
+	}
+	SetStreamWriteDeadline(stream, defaultWriteDuration)
+	if err := WriteLightClientChunk(stream, s.cfg.chain, s.cfg.p2p.Encoding(), bootstrap.Header.Slot, bootstrap); err != nil {
+		log.WithError(err).Debug("Could not send a chunked response")
+		s.writeErrorResponseToStream(responseCodeServerError, types.ErrGeneric.Error(), stream)
+		tracing.AnnotateError(span, err)
+		return err
+	}
+	closeStream(stream, log)
+	return nil
+}
+
+// lightClientUpdatesByRangeRPCHandler handles the /eth2/beacon_chain/req/light_client_updates_by_range/1/ RPC request.
+// spec: https://github.com/ethereum/consensus-specs/blob/3d235740e5f1e641d3b160c8688f26e7dc5a1894/specs/altair/light-client/p2p-interface.md#lightclientupdatesbyrange
+func (s *Service) lightClientUpdatesByRangeRPCHandler(ctx context.Context, msg interface{}, stream libp2pcore.Stream) error {
+	ctx, span := trace.StartSpan(ctx, "sync.lightClientUpdatesByRangeRPCHandler")
+	defer span.End()
+	ctx, cancel := context.WithTimeout(ctx, respTimeout)
+	defer cancel()
+	SetRPCStreamDeadlines(stream)
+	log := log.WithField("handler", p2p.LightClientUpdatesByRangeName[1:]) // slice the leading slash off the name var
+
+	req, ok := msg.(*types.LightClientUpdatesByRangeReq)
+	if !ok {
+		return errors.New("message is not type LightClientUpdatesByRangeReq")
+	}
+	count, err := validateLightClientUpdatesByRange(req)
+	if err != nil {
+		s.cfg.p2p.Peers().Scorers().BadResponsesScorer().Increment(stream.Conn().RemotePeer())
+		s.writeErrorResponseToStream(responseCodeInvalidRequest, err.Error(), stream)
+		tracing.AnnotateError(span, err)
+		return err
+	}
+	if err := s.rateLimiter.validateRequest(stream, count); err != nil {
+		return err
+	}
+
+	// The response stops at the first period without an update, so that it is consecutive.
+	updates, err := s.cfg.beaconDB.LightClientUpdates(ctx, req.StartPeriod, req.StartPeriod+count-1)
+	if err != nil {
+		s.writeErrorResponseToStream(responseCodeServerError, types.ErrGeneric.Error(), stream)
+		tracing.AnnotateError(span, err)
+		return errors.Wrap(err, "could not get light client updates")
+	}
+	for _, u := range updates {
+		SetStreamWriteDeadline(stream, defaultWriteDuration)
+		if err := WriteLightClientChunk(stream, s.cfg.chain, s.cfg.p2p.Encoding(), u.AttestedHeader.Slot, u); err != nil {
+			log.WithError(err).Debug("Could not send a chunked response")
+			s.writeErrorResponseToStream(responseCodeServerError, types.ErrGeneric.Error(), stream)
+			tracing.AnnotateError(span, err)
+			return err
+		}
+		s.rateLimiter.add(stream, 1)
+	}
+	closeStream(stream, log)
+	return nil
+}
+
+// validateLightClientUpdatesByRange returns the number of periods to respond with, which is capped to
+// MAX_REQUEST_LIGHT_CLIENT_UPDATES.
+func validateLightClientUpdatesByRange(req *types.LightClientUpdatesByRangeReq) (uint64, error) {
+	if req.Count == 0 {
+		return 0, types.ErrInvalidRequest
+	}
+	count := req.Count
+	if max := params.BeaconNetworkConfig().MaxRequestLightClientUpdates; count > max {
+		count = max
+	}
+	if req.StartPeriod+count < req.StartPeriod {
+		return 0, types.ErrInvalidRequest
+	}
+	return count, nil
+}
@@ -0,0 +1,135 @@
+package sync
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/network"
+	"github.com/libp2p/go-libp2p/core/protocol"
+	"github.com/prysmaticlabs/go-bitfield"
+	mock "github.com/prysmaticlabs/prysm/v4/beacon-chain/blockchain/testing"
+	db "github.com/prysmaticlabs/prysm/v4/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/p2p"
+	p2ptest "github.com/prysmaticlabs/prysm/v4/beacon-chain/p2p/testing"
+	p2ptypes "github.com/prysmaticlabs/prysm/v4/beacon-chain/p2p/types"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/startup"
+	fieldparams "github.com/prysmaticlabs/prysm/v4/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v4/config/params"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	leakybucket "github.com/prysmaticlabs/prysm/v4/container/leaky-bucket"
+	"github.com/prysmaticlabs/prysm/v4/network/forks"
+	ethpbv1 "github.com/prysmaticlabs/prysm/v4/proto/eth/v1"
+	ethpbv2 "github.com/prysmaticlabs/prysm/v4/proto/eth/v2"
+	"github.com/prysmaticlabs/prysm/v4/testing/assert"
+	"github.com/prysmaticlabs/prysm/v4/testing/require"
+	"github.com/prysmaticlabs/prysm/v4/testing/util"
+	"github.com/prysmaticlabs/prysm/v4/time/slots"
+)
+
+func testLightClientUpdate(slot primitives.Slot) *ethpbv2.LightClientUpdate {
+	header := &ethpbv1.BeaconBlockHeader{
+		Slot:       slot,
+		ParentRoot: make([]byte, fieldparams.RootLength),
+		StateRoot:  make([]byte, fieldparams.RootLength),
+		BodyRoot:   make([]byte, fieldparams.RootLength),
+	}
+	branch := func(n int) [][]byte {
+		b := make([][]byte, n)
+		for i := range b {
+			b[i] = make([]byte, fieldparams.RootLength)
+		}
+		return b
+	}
+	pubkeys := make([][]byte, fieldparams.SyncCommitteeLength)
+	for i := range pubkeys {
+		pubkeys[i] = make([]byte, fieldparams.BLSPubkeyLength)
+	}
+	return &ethpbv2.LightClientUpdate{
+		AttestedHeader: header,
+		NextSyncCommittee: &ethpbv2.SyncCommittee{
+			Pubkeys:         pubkeys,
+			AggregatePubkey: make([]byte, fieldparams.BLSPubkeyLength),
+		},
+		NextSyncCommitteeBranch: branch(5),
+		FinalizedHeader:         header,
+		FinalityBranch:          branch(6),
+		SyncAggregate: &ethpbv1.SyncAggregate{
+			SyncCommitteeBits:      bitfield.NewBitvector512(),
+			SyncCommitteeSignature: make([]byte, fieldparams.BLSSignatureLength),
+		},
+		SignatureSlot: slot + 1,
+	}
+}
+
+func TestLightClientUpdatesByRangeRPCHandler(t *testing.T) {
+	p1 := p2ptest.NewTestP2P(t)
+	p2 := p2ptest.NewTestP2P(t)
+	p1.Connect(p2)
+	assert.Equal(t, 1, len(p1.BHost.Network().Peers()), "Expected peers to be connected")
+	d := db.SetupDB(t)
+	ctx := context.Background()
+
+	slotsPerPeriod := primitives.Slot(uint64(params.BeaconConfig().EpochsPerSyncCommitteePeriod) * uint64(params.BeaconConfig().SlotsPerEpoch))
+	for period := uint64(1); period <= 3; period++ {
+		require.NoError(t, d.SaveLightClientUpdate(ctx, period, testLightClientUpdate(primitives.Slot(period)*slotsPerPeriod)))
+	}
+
+	r := &Service{cfg: &config{p2p: p1, beaconDB: d, clock: startup.NewClock(time.Unix(0, 0), [32]byte{})}, rateLimiter: newRateLimiter(p1)}
+	r.cfg.chain = &mock.ChainService{ValidatorsRoot: [32]byte{}}
+	pcl := protocol.ID(p2p.RPCLightClientUpdatesByRangeTopicV1)
+	r.rateLimiter.limiterMap[string(pcl)] = leakybucket.NewCollector(10000, 10000, time.Second, false)
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	p2.BHost.SetStreamHandler(pcl, func(stream network.Stream) {
+		defer wg.Done()
+		for period := uint64(2); period <= 3; period++ {
+			expectSuccess(t, stream)
+			slot := primitives.Slot(period) * slotsPerPeriod
+			digest, err := readContextFromStream(stream)
+			require.NoError(t, err)
+			want, err := forks.ForkDigestFromEpoch(slots.ToEpoch(slot), make([]byte, 32))
+			require.NoError(t, err)
+			assert.DeepEqual(t, want[:], digest)
+			res := &ethpbv2.LightClientUpdate{}
+			assert.NoError(t, r.cfg.p2p.Encoding().DecodeWithMaxLength(stream, res))
+			assert.Equal(t, slot, res.AttestedHeader.Slot)
+		}
+	})
+
+	stream, err := p1.BHost.NewStream(ctx, p2.BHost.ID(), pcl)
+	require.NoError(t, err)
+	require.NoError(t, r.lightClientUpdatesByRangeRPCHandler(ctx, &p2ptypes.LightClientUpdatesByRangeReq{StartPeriod: 2, Count: 1000}, stream))
+
+	if util.WaitTimeout(&wg, 1*time.Second) {
+		t.Fatal("Did not receive stream within 1 sec")
+	}
+}
+
+func TestValidateLightClientUpdatesByRange(t *testing.T) {
+	max := params.BeaconNetworkConfig().MaxRequestLightClientUpdates
+	tests := []struct {
+		name    string
+		req     *p2ptypes.LightClientUpdatesByRangeReq
+		want    uint64
+		wantErr error
+	}{
+		{name: "zero count", req: &p2ptypes.LightClientUpdatesByRangeReq{StartPeriod: 1}, wantErr: p2ptypes.ErrInvalidRequest},
+		{name: "count within limit", req: &p2ptypes.LightClientUpdatesByRangeReq{StartPeriod: 1, Count: 5}, want: 5},
+		{name: "count capped", req: &p2ptypes.LightClientUpdatesByRangeReq{StartPeriod: 1, Count: max + 1}, want: max},
+		{name: "overflow", req: &p2ptypes.LightClientUpdatesByRangeReq{StartPeriod: ^uint64(0), Count: 2}, wantErr: p2ptypes.ErrInvalidRequest},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := validateLightClientUpdatesByRange(tt.req)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
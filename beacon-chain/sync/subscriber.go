@@ -123,6 +123,20 @@ func (s *Service) registerSubscribers(epoch primitives.Epoch, digest [4]byte) {
 				digest,
 			)
 		}
+		if features.Get().EnableLightClient {
+			s.subscribe(
+				p2p.LightClientFinalityUpdateTopicFormat,
+				s.singleStepValidation(p2p.GossipLightClientFinalityUpdateMessage, s.validateLightClientFinalityUpdate),
+				s.lightClientUpdateSubscriber,
+				digest,
+			)
+			s.subscribe(
+				p2p.LightClientOptimisticUpdateTopicFormat,
+				s.singleStepValidation(p2p.GossipLightClientOptimisticUpdateMessage, s.validateLightClientOptimisticUpdate),
+				s.lightClientUpdateSubscriber,
+				digest,
+			)
+		}
 	}
 
 	// New Gossip Topic in Capella
@@ -0,0 +1,13 @@
+package sync
+
+import (
+	"context"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// lightClientUpdateSubscriber is a no-op, as light client updates only pass validation when they match
+// the updates this node computed from its own chain.
+func (_ *Service) lightClientUpdateSubscriber(_ context.Context, _ proto.Message) error {
+	return nil
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/core/feed/operation"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/core/signing"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/p2p"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/state"
 	"github.com/prysmaticlabs/prysm/v4/config/params"
 	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
@@ -36,84 +37,93 @@ func (s *Service) validateAggregateAndProof(ctx context.Context, pid peer.ID, ms
 	ctx, span := trace.StartSpan(ctx, "sync.validateAggregateAndProof")
 	defer span.End()
 
-	// To process the following it requires the recent blocks to be present in the database, so we'll skip
-	// validating or processing aggregated attestations until fully synced.
-	if s.cfg.initialSync.Syncing() {
-		return pubsub.ValidationIgnore, nil
-	}
-
-	raw, err := s.decodePubsubMessage(msg)
-	if err != nil {
+	var m *ethpb.SignedAggregateAttestationAndProof
+	p := s.newValidationPipeline(p2p.GossipAggregateAndProofMessage, pid)
+	if res, err := p.run(ctx,
+		// To process the following it requires the recent blocks to be present in the database, so we'll skip
+		// validating or processing aggregated attestations until fully synced.
+		namedStep(stepSyncing, s.ignoreWhileSyncing),
+		namedStep(stepDecode, func(ctx context.Context) (pubsub.ValidationResult, error) {
+			raw, err := s.decodePubsubMessage(msg)
+			if err != nil {
+				return pubsub.ValidationReject, err
+			}
+			var ok bool
+			m, ok = raw.(*ethpb.SignedAggregateAttestationAndProof)
+			if !ok {
+				return pubsub.ValidationReject, errors.Errorf("invalid message type: %T", raw)
+			}
+			if m.Message == nil {
+				return pubsub.ValidationReject, errNilMessage
+			}
+			if err := helpers.ValidateNilAttestation(m.Message.Aggregate); err != nil {
+				return pubsub.ValidationReject, err
+			}
+			// Do not process slot 0 aggregates.
+			if m.Message.Aggregate.Data.Slot == 0 {
+				return pubsub.ValidationIgnore, nil
+			}
+
+			// Broadcast the aggregated attestation on a feed to notify other services in the beacon node
+			// of a received aggregated attestation.
+			s.cfg.attestationNotifier.OperationFeed().Send(&feed.Event{
+				Type: operation.AggregatedAttReceived,
+				Data: &operation.AggregatedAttReceivedData{
+					Attestation: m.Message,
+				},
+			})
+			return pubsub.ValidationAccept, nil
+		}),
+		namedStep(stepTiming, func(ctx context.Context) (pubsub.ValidationResult, error) {
+			if err := helpers.ValidateSlotTargetEpoch(m.Message.Aggregate.Data); err != nil {
+				return pubsub.ValidationReject, err
+			}
+			// Attestation's slot is within ATTESTATION_PROPAGATION_SLOT_RANGE and early attestation
+			// processing tolerance.
+			if err := helpers.ValidateAttestationTime(
+				m.Message.Aggregate.Data.Slot,
+				s.cfg.clock.GenesisTime(),
+				earlyAttestationProcessingTolerance,
+			); err != nil {
+				return pubsub.ValidationIgnore, err
+			}
+			return pubsub.ValidationAccept, nil
+		}),
+		namedStep(stepDedup, func(ctx context.Context) (pubsub.ValidationResult, error) {
+			// Verify this is the first aggregate received from the aggregator with index and slot.
+			if s.hasSeenAggregatorIndexEpoch(m.Message.Aggregate.Data.Target.Epoch, m.Message.AggregatorIndex) {
+				return pubsub.ValidationIgnore, nil
+			}
+			// Verify aggregate attestation has not already been seen via aggregate gossip, within a block, or through the creation locally.
+			seen, err := s.cfg.attPool.HasAggregatedAttestation(m.Message.Aggregate)
+			if err != nil {
+				return pubsub.ValidationIgnore, err
+			}
+			if seen {
+				return pubsub.ValidationIgnore, nil
+			}
+			return pubsub.ValidationAccept, nil
+		}),
+		namedStep(stepBlock, func(ctx context.Context) (pubsub.ValidationResult, error) {
+			// Check that the block being voted on isn't invalid.
+			if s.hasBadBlock(bytesutil.ToBytes32(m.Message.Aggregate.Data.BeaconBlockRoot)) ||
+				s.hasBadBlock(bytesutil.ToBytes32(m.Message.Aggregate.Data.Target.Root)) ||
+				s.hasBadBlock(bytesutil.ToBytes32(m.Message.Aggregate.Data.Source.Root)) {
+				attBadBlockCount.Inc()
+				return pubsub.ValidationReject, errors.New("bad block referenced in attestation data")
+			}
+			if !s.validateBlockInAttestation(ctx, m) {
+				return pubsub.ValidationIgnore, nil
+			}
+			return pubsub.ValidationAccept, nil
+		}),
+	); res != pubsub.ValidationAccept {
 		tracing.AnnotateError(span, err)
-		return pubsub.ValidationReject, err
-	}
-	m, ok := raw.(*ethpb.SignedAggregateAttestationAndProof)
-	if !ok {
-		return pubsub.ValidationReject, errors.Errorf("invalid message type: %T", raw)
-	}
-	if m.Message == nil {
-		return pubsub.ValidationReject, errNilMessage
-	}
-	if err := helpers.ValidateNilAttestation(m.Message.Aggregate); err != nil {
-		return pubsub.ValidationReject, err
+		return res, err
 	}
-	// Do not process slot 0 aggregates.
-	if m.Message.Aggregate.Data.Slot == 0 {
-		return pubsub.ValidationIgnore, nil
-	}
-
-	// Broadcast the aggregated attestation on a feed to notify other services in the beacon node
-	// of a received aggregated attestation.
-	s.cfg.attestationNotifier.OperationFeed().Send(&feed.Event{
-		Type: operation.AggregatedAttReceived,
-		Data: &operation.AggregatedAttReceivedData{
-			Attestation: m.Message,
-		},
-	})
-
-	if err := helpers.ValidateSlotTargetEpoch(m.Message.Aggregate.Data); err != nil {
-		return pubsub.ValidationReject, err
-	}
-
-	// Attestation's slot is within ATTESTATION_PROPAGATION_SLOT_RANGE and early attestation
-	// processing tolerance.
-	if err := helpers.ValidateAttestationTime(
-		m.Message.Aggregate.Data.Slot,
-		s.cfg.clock.GenesisTime(),
-		earlyAttestationProcessingTolerance,
-	); err != nil {
+	if res, err := p.run(ctx, s.aggregatedAttSteps(m)...); res != pubsub.ValidationAccept {
 		tracing.AnnotateError(span, err)
-		return pubsub.ValidationIgnore, err
-	}
-
-	// Verify this is the first aggregate received from the aggregator with index and slot.
-	if s.hasSeenAggregatorIndexEpoch(m.Message.Aggregate.Data.Target.Epoch, m.Message.AggregatorIndex) {
-		return pubsub.ValidationIgnore, nil
-	}
-	// Check that the block being voted on isn't invalid.
-	if s.hasBadBlock(bytesutil.ToBytes32(m.Message.Aggregate.Data.BeaconBlockRoot)) ||
-		s.hasBadBlock(bytesutil.ToBytes32(m.Message.Aggregate.Data.Target.Root)) ||
-		s.hasBadBlock(bytesutil.ToBytes32(m.Message.Aggregate.Data.Source.Root)) {
-		attBadBlockCount.Inc()
-		return pubsub.ValidationReject, errors.New("bad block referenced in attestation data")
-	}
-
-	// Verify aggregate attestation has not already been seen via aggregate gossip, within a block, or through the creation locally.
-	seen, err := s.cfg.attPool.HasAggregatedAttestation(m.Message.Aggregate)
-	if err != nil {
-		tracing.AnnotateError(span, err)
-		return pubsub.ValidationIgnore, err
-	}
-	if seen {
-		return pubsub.ValidationIgnore, nil
-	}
-	if !s.validateBlockInAttestation(ctx, m) {
-		return pubsub.ValidationIgnore, nil
-	}
-
-	validationRes, err := s.validateAggregatedAtt(ctx, m)
-	if validationRes != pubsub.ValidationAccept {
-		return validationRes, err
+		return res, err
 	}
 
 	s.setAggregatorIndexEpochSeen(m.Message.Aggregate.Data.Target.Epoch, m.Message.AggregatorIndex)
@@ -125,66 +135,79 @@ func (s *Service) validateAggregateAndProof(ctx context.Context, pid peer.ID, ms
 	return pubsub.ValidationAccept, nil
 }
 
+// validateAggregatedAtt runs the validation steps of an aggregate which depend on the state of its target.
 func (s *Service) validateAggregatedAtt(ctx context.Context, signed *ethpb.SignedAggregateAttestationAndProof) (pubsub.ValidationResult, error) {
 	ctx, span := trace.StartSpan(ctx, "sync.validateAggregatedAtt")
 	defer span.End()
 
-	// Verify attestation target root is consistent with the head root.
-	// This verification is not in the spec, however we guard against it as it opens us up
-	// to weird edge cases during verification. The attestation technically could be used to add value to a block,
-	// but it's invalid in the spirit of the protocol. Here we choose safety over profit.
-	if err := s.cfg.chain.VerifyLmdFfgConsistency(ctx, signed.Message.Aggregate); err != nil {
-		tracing.AnnotateError(span, err)
-		attBadLmdConsistencyCount.Inc()
-		return pubsub.ValidationReject, err
-	}
-
-	// Verify current finalized checkpoint is an ancestor of the block defined by the attestation's beacon block root.
-	if !s.cfg.chain.InForkchoice(bytesutil.ToBytes32(signed.Message.Aggregate.Data.BeaconBlockRoot)) {
-		tracing.AnnotateError(span, blockchain.ErrNotDescendantOfFinalized)
-		return pubsub.ValidationIgnore, blockchain.ErrNotDescendantOfFinalized
-	}
-
-	bs, err := s.cfg.chain.AttestationTargetState(ctx, signed.Message.Aggregate.Data.Target)
-	if err != nil {
-		tracing.AnnotateError(span, err)
-		return pubsub.ValidationIgnore, err
-	}
-
-	// Verify validator index is within the beacon committee.
-	if err := validateIndexInCommittee(ctx, bs, signed.Message.Aggregate, signed.Message.AggregatorIndex); err != nil {
-		wrappedErr := errors.Wrapf(err, "Could not validate index in committee")
-		tracing.AnnotateError(span, wrappedErr)
-		return pubsub.ValidationReject, wrappedErr
-	}
-
-	// Verify selection proof reflects to the right validator.
-	selectionSigSet, err := validateSelectionIndex(ctx, bs, signed.Message.Aggregate.Data, signed.Message.AggregatorIndex, signed.Message.SelectionProof)
-	if err != nil {
-		wrappedErr := errors.Wrapf(err, "Could not validate selection for validator %d", signed.Message.AggregatorIndex)
-		tracing.AnnotateError(span, wrappedErr)
-		attBadSelectionProofCount.Inc()
-		return pubsub.ValidationReject, wrappedErr
+	for _, step := range s.aggregatedAttSteps(signed) {
+		if res, err := step.fn(ctx); res != pubsub.ValidationAccept {
+			tracing.AnnotateError(span, err)
+			return res, err
+		}
 	}
+	return pubsub.ValidationAccept, nil
+}
 
-	// Verify selection signature, aggregator signature and attestation signature are valid.
-	// We use batch verify here to save compute.
-	aggregatorSigSet, err := aggSigSet(bs, signed)
-	if err != nil {
-		wrappedErr := errors.Wrapf(err, "Could not get aggregator sig set %d", signed.Message.AggregatorIndex)
-		tracing.AnnotateError(span, wrappedErr)
-		return pubsub.ValidationIgnore, wrappedErr
+// aggregatedAttSteps returns the validation steps of an aggregate which depend on the state of its target,
+// shared by aggregates received over gossip and aggregates waiting for their block in the pending queue.
+func (s *Service) aggregatedAttSteps(signed *ethpb.SignedAggregateAttestationAndProof) []validationStep {
+	var bs state.ReadOnlyBeaconState
+	var selectionSigSet *bls.SignatureBatch
+	return []validationStep{
+		namedStep(stepForkchoice, func(ctx context.Context) (pubsub.ValidationResult, error) {
+			// Verify attestation target root is consistent with the head root.
+			// This verification is not in the spec, however we guard against it as it opens us up
+			// to weird edge cases during verification. The attestation technically could be used to add value to a block,
+			// but it's invalid in the spirit of the protocol. Here we choose safety over profit.
+			if err := s.cfg.chain.VerifyLmdFfgConsistency(ctx, signed.Message.Aggregate); err != nil {
+				attBadLmdConsistencyCount.Inc()
+				return pubsub.ValidationReject, err
+			}
+			// Verify current finalized checkpoint is an ancestor of the block defined by the attestation's beacon block root.
+			if !s.cfg.chain.InForkchoice(bytesutil.ToBytes32(signed.Message.Aggregate.Data.BeaconBlockRoot)) {
+				return pubsub.ValidationIgnore, blockchain.ErrNotDescendantOfFinalized
+			}
+			return pubsub.ValidationAccept, nil
+		}),
+		namedStep(stepState, func(ctx context.Context) (pubsub.ValidationResult, error) {
+			var err error
+			bs, err = s.cfg.chain.AttestationTargetState(ctx, signed.Message.Aggregate.Data.Target)
+			if err != nil {
+				return pubsub.ValidationIgnore, err
+			}
+			return pubsub.ValidationAccept, nil
+		}),
+		namedStep(stepCommittee, func(ctx context.Context) (pubsub.ValidationResult, error) {
+			// Verify validator index is within the beacon committee.
+			if err := validateIndexInCommittee(ctx, bs, signed.Message.Aggregate, signed.Message.AggregatorIndex); err != nil {
+				return pubsub.ValidationReject, errors.Wrapf(err, "Could not validate index in committee")
+			}
+			// Verify selection proof reflects to the right validator.
+			var err error
+			selectionSigSet, err = validateSelectionIndex(ctx, bs, signed.Message.Aggregate.Data, signed.Message.AggregatorIndex, signed.Message.SelectionProof)
+			if err != nil {
+				attBadSelectionProofCount.Inc()
+				return pubsub.ValidationReject, errors.Wrapf(err, "Could not validate selection for validator %d", signed.Message.AggregatorIndex)
+			}
+			return pubsub.ValidationAccept, nil
+		}),
+		namedStep(stepSignature, func(ctx context.Context) (pubsub.ValidationResult, error) {
+			// Verify selection signature, aggregator signature and attestation signature are valid.
+			// We use batch verify here to save compute.
+			aggregatorSigSet, err := aggSigSet(bs, signed)
+			if err != nil {
+				return pubsub.ValidationIgnore, errors.Wrapf(err, "Could not get aggregator sig set %d", signed.Message.AggregatorIndex)
+			}
+			attSigSet, err := blocks.AttestationSignatureBatch(ctx, bs, []*ethpb.Attestation{signed.Message.Aggregate})
+			if err != nil {
+				return pubsub.ValidationIgnore, errors.Wrapf(err, "Could not verify aggregator signature %d", signed.Message.AggregatorIndex)
+			}
+			set := bls.NewSet()
+			set.Join(selectionSigSet).Join(aggregatorSigSet).Join(attSigSet)
+			return s.validateWithBatchVerifier(ctx, "aggregate", set)
+		}),
 	}
-	attSigSet, err := blocks.AttestationSignatureBatch(ctx, bs, []*ethpb.Attestation{signed.Message.Aggregate})
-	if err != nil {
-		wrappedErr := errors.Wrapf(err, "Could not verify aggregator signature %d", signed.Message.AggregatorIndex)
-		tracing.AnnotateError(span, wrappedErr)
-		return pubsub.ValidationIgnore, wrappedErr
-	}
-	set := bls.NewSet()
-	set.Join(selectionSigSet).Join(aggregatorSigSet).Join(attSigSet)
-
-	return s.validateWithBatchVerifier(ctx, "aggregate", set)
 }
 
 func (s *Service) validateBlockInAttestation(ctx context.Context, satt *ethpb.SignedAggregateAttestationAndProof) bool {
@@ -8,6 +8,8 @@ import (
 	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/core/blocks"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/p2p"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/state"
 	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/v4/container/slice"
 	"github.com/prysmaticlabs/prysm/v4/monitoring/tracing"
@@ -25,53 +27,65 @@ func (s *Service) validateAttesterSlashing(ctx context.Context, pid peer.ID, msg
 		return pubsub.ValidationAccept, nil
 	}
 
-	// The head state will be too far away to validate any slashing.
-	if s.cfg.initialSync.Syncing() {
-		return pubsub.ValidationIgnore, nil
-	}
-
 	ctx, span := trace.StartSpan(ctx, "sync.validateAttesterSlashing")
 	defer span.End()
 
-	m, err := s.decodePubsubMessage(msg)
-	if err != nil {
+	var slashing *ethpb.AttesterSlashing
+	var slashedVals []uint64
+	var headState state.BeaconState
+	if res, err := s.newValidationPipeline(p2p.GossipAttesterSlashingMessage, pid).run(ctx,
+		// The head state will be too far away to validate any slashing.
+		namedStep(stepSyncing, s.ignoreWhileSyncing),
+		namedStep(stepDecode, func(ctx context.Context) (pubsub.ValidationResult, error) {
+			m, err := s.decodePubsubMessage(msg)
+			if err != nil {
+				return pubsub.ValidationReject, err
+			}
+			var ok bool
+			slashing, ok = m.(*ethpb.AttesterSlashing)
+			if !ok {
+				return pubsub.ValidationReject, errWrongMessage
+			}
+			slashedVals = blocks.SlashableAttesterIndices(slashing)
+			if slashedVals == nil {
+				return pubsub.ValidationReject, errNilMessage
+			}
+			return pubsub.ValidationAccept, nil
+		}),
+		namedStep(stepDedup, func(ctx context.Context) (pubsub.ValidationResult, error) {
+			if s.hasSeenAttesterSlashingIndices(slashedVals) {
+				return pubsub.ValidationIgnore, nil
+			}
+			return pubsub.ValidationAccept, nil
+		}),
+		namedStep(stepSignature, func(ctx context.Context) (pubsub.ValidationResult, error) {
+			var err error
+			headState, err = s.cfg.chain.HeadState(ctx)
+			if err != nil {
+				return pubsub.ValidationIgnore, err
+			}
+			if err := blocks.VerifyAttesterSlashing(ctx, headState, slashing); err != nil {
+				return pubsub.ValidationReject, err
+			}
+			return pubsub.ValidationAccept, nil
+		}),
+		namedStep(stepState, func(ctx context.Context) (pubsub.ValidationResult, error) {
+			for _, v := range slashedVals {
+				val, err := headState.ValidatorAtIndexReadOnly(primitives.ValidatorIndex(v))
+				if err != nil {
+					return pubsub.ValidationIgnore, err
+				}
+				if helpers.IsSlashableValidator(val.ActivationEpoch(), val.WithdrawableEpoch(), val.Slashed(), slots.ToEpoch(headState.Slot())) {
+					return pubsub.ValidationAccept, nil
+				}
+			}
+			return pubsub.ValidationReject, errors.Errorf("none of the validators are slashable: %v", slashedVals)
+		}),
+	); res != pubsub.ValidationAccept {
 		tracing.AnnotateError(span, err)
-		return pubsub.ValidationReject, err
-	}
-	slashing, ok := m.(*ethpb.AttesterSlashing)
-	if !ok {
-		return pubsub.ValidationReject, errWrongMessage
-	}
-
-	slashedVals := blocks.SlashableAttesterIndices(slashing)
-	if slashedVals == nil {
-		return pubsub.ValidationReject, errNilMessage
-	}
-	if s.hasSeenAttesterSlashingIndices(slashedVals) {
-		return pubsub.ValidationIgnore, nil
+		return res, err
 	}
 
-	headState, err := s.cfg.chain.HeadState(ctx)
-	if err != nil {
-		return pubsub.ValidationIgnore, err
-	}
-	if err := blocks.VerifyAttesterSlashing(ctx, headState, slashing); err != nil {
-		return pubsub.ValidationReject, err
-	}
-	isSlashable := false
-	for _, v := range slashedVals {
-		val, err := headState.ValidatorAtIndexReadOnly(primitives.ValidatorIndex(v))
-		if err != nil {
-			return pubsub.ValidationIgnore, err
-		}
-		if helpers.IsSlashableValidator(val.ActivationEpoch(), val.WithdrawableEpoch(), val.Slashed(), slots.ToEpoch(headState.Slot())) {
-			isSlashable = true
-			break
-		}
-	}
-	if !isSlashable {
-		return pubsub.ValidationReject, errors.Errorf("none of the validators are slashable: %v", slashedVals)
-	}
 	s.cfg.chain.ReceiveAttesterSlashing(ctx, slashing)
 
 	msg.ValidatorData = slashing // Used in downstream subscriber
@@ -36,131 +36,110 @@ func (s *Service) validateCommitteeIndexBeaconAttestation(ctx context.Context, p
 	if pid == s.cfg.p2p.PeerID() {
 		return pubsub.ValidationAccept, nil
 	}
-	// Attestation processing requires the target block to be present in the database, so we'll skip
-	// validating or processing attestations until fully synced.
-	if s.cfg.initialSync.Syncing() {
-		return pubsub.ValidationIgnore, nil
-	}
 
 	ctx, span := trace.StartSpan(ctx, "sync.validateCommitteeIndexBeaconAttestation")
 	defer span.End()
 
-	if msg.Topic == nil {
-		return pubsub.ValidationReject, errInvalidTopic
-	}
-
-	m, err := s.decodePubsubMessage(msg)
-	if err != nil {
-		tracing.AnnotateError(span, err)
-		return pubsub.ValidationReject, err
-	}
-
-	att, ok := m.(*eth.Attestation)
-	if !ok {
-		return pubsub.ValidationReject, errWrongMessage
-	}
-
-	if err := helpers.ValidateNilAttestation(att); err != nil {
-		return pubsub.ValidationReject, err
-	}
-	// Do not process slot 0 attestations.
-	if att.Data.Slot == 0 {
-		return pubsub.ValidationIgnore, nil
-	}
-	// Broadcast the unaggregated attestation on a feed to notify other services in the beacon node
-	// of a received unaggregated attestation.
-	s.cfg.attestationNotifier.OperationFeed().Send(&feed.Event{
-		Type: operation.UnaggregatedAttReceived,
-		Data: &operation.UnAggregatedAttReceivedData{
-			Attestation: att,
-		},
-	})
-
-	// Attestation's slot is within ATTESTATION_PROPAGATION_SLOT_RANGE and early attestation
-	// processing tolerance.
-	if err := helpers.ValidateAttestationTime(att.Data.Slot, s.cfg.clock.GenesisTime(),
-		earlyAttestationProcessingTolerance); err != nil {
-		tracing.AnnotateError(span, err)
-		return pubsub.ValidationIgnore, err
-	}
-	if err := helpers.ValidateSlotTargetEpoch(att.Data); err != nil {
-		return pubsub.ValidationReject, err
-	}
-
-	if features.Get().EnableSlasher {
-		// Feed the indexed attestation to slasher if enabled. This action
-		// is done in the background to avoid adding more load to this critical code path.
-		go func() {
-			// Using a different context to prevent timeouts as this operation can be expensive
-			// and we want to avoid affecting the critical code path.
-			ctx := context.TODO()
-			preState, err := s.cfg.chain.AttestationTargetState(ctx, att.Data.Target)
-			if err != nil {
-				log.WithError(err).Error("Could not retrieve pre state")
-				tracing.AnnotateError(span, err)
-				return
+	var att *eth.Attestation
+	var preState state.ReadOnlyBeaconState
+	if res, err := s.newValidationPipeline(p2p.GossipAttestationMessage, pid).run(ctx,
+		// Attestation processing requires the target block to be present in the database, so we'll skip
+		// validating or processing attestations until fully synced.
+		namedStep(stepSyncing, s.ignoreWhileSyncing),
+		namedStep(stepDecode, func(ctx context.Context) (pubsub.ValidationResult, error) {
+			if msg.Topic == nil {
+				return pubsub.ValidationReject, errInvalidTopic
 			}
-			committee, err := helpers.BeaconCommitteeFromState(ctx, preState, att.Data.Slot, att.Data.CommitteeIndex)
+			m, err := s.decodePubsubMessage(msg)
 			if err != nil {
-				log.WithError(err).Error("Could not get attestation committee")
-				tracing.AnnotateError(span, err)
-				return
+				return pubsub.ValidationReject, err
+			}
+			var ok bool
+			att, ok = m.(*eth.Attestation)
+			if !ok {
+				return pubsub.ValidationReject, errWrongMessage
+			}
+			if err := helpers.ValidateNilAttestation(att); err != nil {
+				return pubsub.ValidationReject, err
+			}
+			// Do not process slot 0 attestations.
+			if att.Data.Slot == 0 {
+				return pubsub.ValidationIgnore, nil
+			}
+			// Broadcast the unaggregated attestation on a feed to notify other services in the beacon node
+			// of a received unaggregated attestation.
+			s.cfg.attestationNotifier.OperationFeed().Send(&feed.Event{
+				Type: operation.UnaggregatedAttReceived,
+				Data: &operation.UnAggregatedAttReceivedData{
+					Attestation: att,
+				},
+			})
+			return pubsub.ValidationAccept, nil
+		}),
+		namedStep(stepTiming, func(ctx context.Context) (pubsub.ValidationResult, error) {
+			// Attestation's slot is within ATTESTATION_PROPAGATION_SLOT_RANGE and early attestation
+			// processing tolerance.
+			if err := helpers.ValidateAttestationTime(att.Data.Slot, s.cfg.clock.GenesisTime(),
+				earlyAttestationProcessingTolerance); err != nil {
+				return pubsub.ValidationIgnore, err
+			}
+			if err := helpers.ValidateSlotTargetEpoch(att.Data); err != nil {
+				return pubsub.ValidationReject, err
+			}
+			// The slasher is fed before deduplication so that it sees every attestation in time.
+			s.feedAttestationToSlasher(att)
+			return pubsub.ValidationAccept, nil
+		}),
+		namedStep(stepDedup, func(ctx context.Context) (pubsub.ValidationResult, error) {
+			// Verify this the first attestation received for the participating validator for the slot.
+			if s.hasSeenCommitteeIndicesSlot(att.Data.Slot, att.Data.CommitteeIndex, att.AggregationBits) {
+				return pubsub.ValidationIgnore, nil
 			}
-			indexedAtt, err := attestation.ConvertToIndexed(ctx, att, committee)
+			return pubsub.ValidationAccept, nil
+		}),
+		namedStep(stepBlock, func(ctx context.Context) (pubsub.ValidationResult, error) {
+			// Reject an attestation if it references an invalid block.
+			if s.hasBadBlock(bytesutil.ToBytes32(att.Data.BeaconBlockRoot)) ||
+				s.hasBadBlock(bytesutil.ToBytes32(att.Data.Target.Root)) ||
+				s.hasBadBlock(bytesutil.ToBytes32(att.Data.Source.Root)) {
+				attBadBlockCount.Inc()
+				return pubsub.ValidationReject, errors.New("attestation data references bad block root")
+			}
+			// Verify the block being voted and the processed state is in beaconDB and the block has passed validation if it's in the beaconDB.
+			if !s.hasBlockAndState(ctx, bytesutil.ToBytes32(att.Data.BeaconBlockRoot)) {
+				// A node doesn't have the block, it'll request from peer while saving the pending attestation to a queue.
+				s.savePendingAtt(&eth.SignedAggregateAttestationAndProof{Message: &eth.AggregateAttestationAndProof{Aggregate: att}})
+				return pubsub.ValidationIgnore, nil
+			}
+			return pubsub.ValidationAccept, nil
+		}),
+		namedStep(stepForkchoice, func(ctx context.Context) (pubsub.ValidationResult, error) {
+			if !s.cfg.chain.InForkchoice(bytesutil.ToBytes32(att.Data.BeaconBlockRoot)) {
+				return pubsub.ValidationIgnore, blockchain.ErrNotDescendantOfFinalized
+			}
+			if err := s.cfg.chain.VerifyLmdFfgConsistency(ctx, att); err != nil {
+				attBadLmdConsistencyCount.Inc()
+				return pubsub.ValidationReject, err
+			}
+			return pubsub.ValidationAccept, nil
+		}),
+		namedStep(stepState, func(ctx context.Context) (pubsub.ValidationResult, error) {
+			var err error
+			preState, err = s.cfg.chain.AttestationTargetState(ctx, att.Data.Target)
 			if err != nil {
-				log.WithError(err).Error("Could not convert to indexed attestation")
-				tracing.AnnotateError(span, err)
-				return
+				return pubsub.ValidationIgnore, err
 			}
-			s.cfg.slasherAttestationsFeed.Send(indexedAtt)
-		}()
-	}
-
-	// Verify this the first attestation received for the participating validator for the slot.
-	if s.hasSeenCommitteeIndicesSlot(att.Data.Slot, att.Data.CommitteeIndex, att.AggregationBits) {
-		return pubsub.ValidationIgnore, nil
-	}
-
-	// Reject an attestation if it references an invalid block.
-	if s.hasBadBlock(bytesutil.ToBytes32(att.Data.BeaconBlockRoot)) ||
-		s.hasBadBlock(bytesutil.ToBytes32(att.Data.Target.Root)) ||
-		s.hasBadBlock(bytesutil.ToBytes32(att.Data.Source.Root)) {
-		attBadBlockCount.Inc()
-		return pubsub.ValidationReject, errors.New("attestation data references bad block root")
-	}
-
-	// Verify the block being voted and the processed state is in beaconDB and the block has passed validation if it's in the beaconDB.
-	blockRoot := bytesutil.ToBytes32(att.Data.BeaconBlockRoot)
-	if !s.hasBlockAndState(ctx, blockRoot) {
-		// A node doesn't have the block, it'll request from peer while saving the pending attestation to a queue.
-		s.savePendingAtt(&eth.SignedAggregateAttestationAndProof{Message: &eth.AggregateAttestationAndProof{Aggregate: att}})
-		return pubsub.ValidationIgnore, nil
-	}
-
-	if !s.cfg.chain.InForkchoice(bytesutil.ToBytes32(att.Data.BeaconBlockRoot)) {
-		tracing.AnnotateError(span, blockchain.ErrNotDescendantOfFinalized)
-		return pubsub.ValidationIgnore, blockchain.ErrNotDescendantOfFinalized
-	}
-	if err := s.cfg.chain.VerifyLmdFfgConsistency(ctx, att); err != nil {
+			return pubsub.ValidationAccept, nil
+		}),
+		namedStep(stepCommittee, func(ctx context.Context) (pubsub.ValidationResult, error) {
+			return s.validateUnaggregatedAttTopic(ctx, att, preState, *msg.Topic)
+		}),
+		namedStep(stepSignature, func(ctx context.Context) (pubsub.ValidationResult, error) {
+			return s.validateUnaggregatedAttWithState(ctx, att, preState)
+		}),
+	); res != pubsub.ValidationAccept {
 		tracing.AnnotateError(span, err)
-		attBadLmdConsistencyCount.Inc()
-		return pubsub.ValidationReject, err
-	}
-
-	preState, err := s.cfg.chain.AttestationTargetState(ctx, att.Data.Target)
-	if err != nil {
-		tracing.AnnotateError(span, err)
-		return pubsub.ValidationIgnore, err
-	}
-
-	validationRes, err := s.validateUnaggregatedAttTopic(ctx, att, preState, *msg.Topic)
-	if validationRes != pubsub.ValidationAccept {
-		return validationRes, err
-	}
-
-	validationRes, err = s.validateUnaggregatedAttWithState(ctx, att, preState)
-	if validationRes != pubsub.ValidationAccept {
-		return validationRes, err
+		return res, err
 	}
 
 	s.setSeenCommitteeIndicesSlot(att.Data.Slot, att.Data.CommitteeIndex, att.AggregationBits)
@@ -170,6 +149,36 @@ func (s *Service) validateCommitteeIndexBeaconAttestation(ctx context.Context, p
 	return pubsub.ValidationAccept, nil
 }
 
+// feedAttestationToSlasher sends the indexed form of the attestation to the slasher if it is enabled.
+func (s *Service) feedAttestationToSlasher(att *eth.Attestation) {
+	if !features.Get().EnableSlasher {
+		return
+	}
+	// Feed the indexed attestation to slasher if enabled. This action
+	// is done in the background to avoid adding more load to this critical code path.
+	go func() {
+		// Using a different context to prevent timeouts as this operation can be expensive
+		// and we want to avoid affecting the critical code path.
+		ctx := context.TODO()
+		preState, err := s.cfg.chain.AttestationTargetState(ctx, att.Data.Target)
+		if err != nil {
+			log.WithError(err).Error("Could not retrieve pre state")
+			return
+		}
+		committee, err := helpers.BeaconCommitteeFromState(ctx, preState, att.Data.Slot, att.Data.CommitteeIndex)
+		if err != nil {
+			log.WithError(err).Error("Could not get attestation committee")
+			return
+		}
+		indexedAtt, err := attestation.ConvertToIndexed(ctx, att, committee)
+		if err != nil {
+			log.WithError(err).Error("Could not convert to indexed attestation")
+			return
+		}
+		s.cfg.slasherAttestationsFeed.Send(indexedAtt)
+	}()
+}
+
 // This validates beacon unaggregated attestation has correct topic string.
 func (s *Service) validateUnaggregatedAttTopic(ctx context.Context, a *eth.Attestation, bs state.ReadOnlyBeaconState, t string) (pubsub.ValidationResult, error) {
 	ctx, span := trace.StartSpan(ctx, "sync.validateUnaggregatedAttTopic")
@@ -14,6 +14,7 @@ import (
 	blockfeed "github.com/prysmaticlabs/prysm/v4/beacon-chain/core/feed/block"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/core/transition"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/p2p"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/state"
 	"github.com/prysmaticlabs/prysm/v4/config/features"
 	fieldparams "github.com/prysmaticlabs/prysm/v4/config/fieldparams"
@@ -46,150 +47,175 @@ func (s *Service) validateBeaconBlockPubSub(ctx context.Context, pid peer.ID, ms
 		return pubsub.ValidationAccept, nil
 	}
 
-	// We should not attempt to process blocks until fully synced, but propagation is OK.
-	if s.cfg.initialSync.Syncing() {
-		return pubsub.ValidationIgnore, nil
-	}
-
 	ctx, span := trace.StartSpan(ctx, "sync.validateBeaconBlockPubSub")
 	defer span.End()
 
-	m, err := s.decodePubsubMessage(msg)
-	if err != nil {
-		tracing.AnnotateError(span, err)
-		return pubsub.ValidationReject, errors.Wrap(err, "Could not decode message")
-	}
-
-	s.validateBlockLock.Lock()
-	defer s.validateBlockLock.Unlock()
-
-	blk, ok := m.(interfaces.ReadOnlySignedBeaconBlock)
-	if !ok {
-		return pubsub.ValidationReject, errors.New("msg is not ethpb.ReadOnlySignedBeaconBlock")
-	}
-
-	if blk.IsNil() || blk.Block().IsNil() {
-		return pubsub.ValidationReject, errors.New("block.Block is nil")
-	}
-
-	// Broadcast the block on a feed to notify other services in the beacon node
-	// of a received block (even if it does not process correctly through a state transition).
-	s.cfg.blockNotifier.BlockFeed().Send(&feed.Event{
-		Type: blockfeed.ReceivedBlock,
-		Data: &blockfeed.ReceivedBlockData{
-			SignedBlock: blk,
-		},
-	})
-
-	if features.Get().EnableSlasher {
-		// Feed the block header to slasher if enabled. This action
-		// is done in the background to avoid adding more load to this critical code path.
-		go func() {
-			blockHeader, err := interfaces.SignedBeaconBlockHeaderFromBlockInterface(blk)
+	var blk interfaces.ReadOnlySignedBeaconBlock
+	var blockRoot [32]byte
+	var genesisTime uint64
+	locked := false
+	defer func() {
+		if locked {
+			s.validateBlockLock.Unlock()
+		}
+	}()
+	if res, err := s.newValidationPipeline(p2p.GossipBlockMessage, pid).run(ctx,
+		// We should not attempt to process blocks until fully synced, but propagation is OK.
+		namedStep(stepSyncing, s.ignoreWhileSyncing),
+		namedStep(stepDecode, func(ctx context.Context) (pubsub.ValidationResult, error) {
+			m, err := s.decodePubsubMessage(msg)
 			if err != nil {
-				log.WithError(err).WithField("blockSlot", blk.Block().Slot()).Warn("Could not extract block header")
-				return
+				return pubsub.ValidationReject, errors.Wrap(err, "Could not decode message")
 			}
-			s.cfg.slasherBlockHeadersFeed.Send(blockHeader)
-		}()
-	}
 
-	if err := validateDenebBeaconBlock(blk.Block()); err != nil {
-		return pubsub.ValidationReject, err
-	}
+			s.validateBlockLock.Lock()
+			locked = true
 
-	// Verify the block is the first block received for the proposer for the slot.
-	if s.hasSeenBlockIndexSlot(blk.Block().Slot(), blk.Block().ProposerIndex()) {
-		return pubsub.ValidationIgnore, nil
-	}
-
-	blockRoot, err := blk.Block().HashTreeRoot()
-	if err != nil {
-		log.WithError(err).WithFields(getBlockFields(blk)).Debug("Ignored block")
-		return pubsub.ValidationIgnore, nil
-	}
-	if s.cfg.beaconDB.HasBlock(ctx, blockRoot) {
-		return pubsub.ValidationIgnore, nil
-	}
-	// Check if parent is a bad block and then reject the block.
-	if s.hasBadBlock(blk.Block().ParentRoot()) {
-		s.setBadBlock(ctx, blockRoot)
-		err := fmt.Errorf("received block with root %#x that has an invalid parent %#x", blockRoot, blk.Block().ParentRoot())
-		log.WithError(err).WithFields(getBlockFields(blk)).Debug("Received block with an invalid parent")
-		return pubsub.ValidationReject, err
-	}
-
-	s.pendingQueueLock.RLock()
-	if s.seenPendingBlocks[blockRoot] {
-		s.pendingQueueLock.RUnlock()
-		return pubsub.ValidationIgnore, nil
-	}
-	s.pendingQueueLock.RUnlock()
-
-	// Be lenient in handling early blocks. Instead of discarding blocks arriving later than
-	// MAXIMUM_GOSSIP_CLOCK_DISPARITY in future, we tolerate blocks arriving at max two slots
-	// earlier (SECONDS_PER_SLOT * 2 seconds). Queue such blocks and process them at the right slot.
-	genesisTime := uint64(s.cfg.clock.GenesisTime().Unix())
-	if err := slots.VerifyTime(genesisTime, blk.Block().Slot(), earlyBlockProcessingTolerance); err != nil {
-		log.WithError(err).WithFields(getBlockFields(blk)).Debug("Ignored block: could not verify slot time")
-		return pubsub.ValidationIgnore, nil
-	}
+			var ok bool
+			blk, ok = m.(interfaces.ReadOnlySignedBeaconBlock)
+			if !ok {
+				return pubsub.ValidationReject, errors.New("msg is not ethpb.ReadOnlySignedBeaconBlock")
+			}
+			if blk.IsNil() || blk.Block().IsNil() {
+				return pubsub.ValidationReject, errors.New("block.Block is nil")
+			}
 
-	// Add metrics for block arrival time subtracts slot start time.
-	if err := captureArrivalTimeMetric(genesisTime, blk.Block().Slot()); err != nil {
-		log.WithError(err).WithFields(getBlockFields(blk)).Debug("Ignored block: could not capture arrival time metric")
-		return pubsub.ValidationIgnore, nil
-	}
+			// Broadcast the block on a feed to notify other services in the beacon node
+			// of a received block (even if it does not process correctly through a state transition).
+			s.cfg.blockNotifier.BlockFeed().Send(&feed.Event{
+				Type: blockfeed.ReceivedBlock,
+				Data: &blockfeed.ReceivedBlockData{
+					SignedBlock: blk,
+				},
+			})
+
+			if features.Get().EnableSlasher {
+				// Feed the block header to slasher if enabled. This action
+				// is done in the background to avoid adding more load to this critical code path.
+				go func() {
+					blockHeader, err := interfaces.SignedBeaconBlockHeaderFromBlockInterface(blk)
+					if err != nil {
+						log.WithError(err).WithField("blockSlot", blk.Block().Slot()).Warn("Could not extract block header")
+						return
+					}
+					s.cfg.slasherBlockHeadersFeed.Send(blockHeader)
+				}()
+			}
 
-	cp := s.cfg.chain.FinalizedCheckpt()
-	startSlot, err := slots.EpochStart(cp.Epoch)
-	if err != nil {
-		log.WithError(err).WithFields(getBlockFields(blk)).Debug("Ignored block: could not calculate epoch start slot")
-		return pubsub.ValidationIgnore, nil
-	}
-	if startSlot >= blk.Block().Slot() {
-		err := fmt.Errorf("finalized slot %d greater or equal to block slot %d", startSlot, blk.Block().Slot())
-		log.WithFields(getBlockFields(blk)).Debug(err)
-		return pubsub.ValidationIgnore, err
-	}
+			if err := validateDenebBeaconBlock(blk.Block()); err != nil {
+				return pubsub.ValidationReject, err
+			}
+			return pubsub.ValidationAccept, nil
+		}),
+		namedStep(stepDedup, func(ctx context.Context) (pubsub.ValidationResult, error) {
+			// Verify the block is the first block received for the proposer for the slot.
+			if s.hasSeenBlockIndexSlot(blk.Block().Slot(), blk.Block().ProposerIndex()) {
+				return pubsub.ValidationIgnore, nil
+			}
+			var err error
+			blockRoot, err = blk.Block().HashTreeRoot()
+			if err != nil {
+				log.WithError(err).WithFields(getBlockFields(blk)).Debug("Ignored block")
+				return pubsub.ValidationIgnore, nil
+			}
+			if s.cfg.beaconDB.HasBlock(ctx, blockRoot) {
+				return pubsub.ValidationIgnore, nil
+			}
+			return pubsub.ValidationAccept, nil
+		}),
+		namedStep(stepParent, func(ctx context.Context) (pubsub.ValidationResult, error) {
+			// Check if parent is a bad block and then reject the block.
+			if s.hasBadBlock(blk.Block().ParentRoot()) {
+				s.setBadBlock(ctx, blockRoot)
+				err := fmt.Errorf("received block with root %#x that has an invalid parent %#x", blockRoot, blk.Block().ParentRoot())
+				log.WithError(err).WithFields(getBlockFields(blk)).Debug("Received block with an invalid parent")
+				return pubsub.ValidationReject, err
+			}
+			return pubsub.ValidationAccept, nil
+		}),
+		namedStep(stepPending, func(ctx context.Context) (pubsub.ValidationResult, error) {
+			s.pendingQueueLock.RLock()
+			defer s.pendingQueueLock.RUnlock()
+			if s.seenPendingBlocks[blockRoot] {
+				return pubsub.ValidationIgnore, nil
+			}
+			return pubsub.ValidationAccept, nil
+		}),
+		namedStep(stepTiming, func(ctx context.Context) (pubsub.ValidationResult, error) {
+			// Be lenient in handling early blocks. Instead of discarding blocks arriving later than
+			// MAXIMUM_GOSSIP_CLOCK_DISPARITY in future, we tolerate blocks arriving at max two slots
+			// earlier (SECONDS_PER_SLOT * 2 seconds). Queue such blocks and process them at the right slot.
+			genesisTime = uint64(s.cfg.clock.GenesisTime().Unix())
+			if err := slots.VerifyTime(genesisTime, blk.Block().Slot(), earlyBlockProcessingTolerance); err != nil {
+				log.WithError(err).WithFields(getBlockFields(blk)).Debug("Ignored block: could not verify slot time")
+				return pubsub.ValidationIgnore, nil
+			}
 
-	// Process the block if the clock jitter is less than MAXIMUM_GOSSIP_CLOCK_DISPARITY.
-	// Otherwise queue it for processing in the right slot.
-	if isBlockQueueable(genesisTime, blk.Block().Slot(), receivedTime) {
-		s.pendingQueueLock.Lock()
-		if err := s.insertBlockToPendingQueue(blk.Block().Slot(), blk, blockRoot); err != nil {
-			s.pendingQueueLock.Unlock()
-			log.WithError(err).WithFields(getBlockFields(blk)).Debug("Could not insert block to pending queue")
-			return pubsub.ValidationIgnore, err
-		}
-		s.pendingQueueLock.Unlock()
-		err := fmt.Errorf("early block, with current slot %d < block slot %d", s.cfg.clock.CurrentSlot(), blk.Block().Slot())
-		log.WithError(err).WithFields(getBlockFields(blk)).Debug("Could not process early block")
-		return pubsub.ValidationIgnore, err
-	}
+			// Add metrics for block arrival time subtracts slot start time.
+			if err := captureArrivalTimeMetric(genesisTime, blk.Block().Slot()); err != nil {
+				log.WithError(err).WithFields(getBlockFields(blk)).Debug("Ignored block: could not capture arrival time metric")
+				return pubsub.ValidationIgnore, nil
+			}
 
-	// Handle block when the parent is unknown.
-	if !s.cfg.chain.HasBlock(ctx, blk.Block().ParentRoot()) {
-		s.pendingQueueLock.Lock()
-		if err := s.insertBlockToPendingQueue(blk.Block().Slot(), blk, blockRoot); err != nil {
-			s.pendingQueueLock.Unlock()
-			log.WithError(err).WithFields(getBlockFields(blk)).Debug("Could not insert block to pending queue")
-			return pubsub.ValidationIgnore, err
-		}
-		s.pendingQueueLock.Unlock()
-		err := errors.Errorf("unknown parent for block with slot %d and parent root %#x", blk.Block().Slot(), blk.Block().ParentRoot())
-		log.WithError(err).WithFields(getBlockFields(blk)).Debug("Could not identify parent for block")
-		return pubsub.ValidationIgnore, err
-	}
+			cp := s.cfg.chain.FinalizedCheckpt()
+			startSlot, err := slots.EpochStart(cp.Epoch)
+			if err != nil {
+				log.WithError(err).WithFields(getBlockFields(blk)).Debug("Ignored block: could not calculate epoch start slot")
+				return pubsub.ValidationIgnore, nil
+			}
+			if startSlot >= blk.Block().Slot() {
+				err := fmt.Errorf("finalized slot %d greater or equal to block slot %d", startSlot, blk.Block().Slot())
+				log.WithFields(getBlockFields(blk)).Debug(err)
+				return pubsub.ValidationIgnore, err
+			}
+			return pubsub.ValidationAccept, nil
+		}),
+		namedStep(stepQueue, func(ctx context.Context) (pubsub.ValidationResult, error) {
+			// Process the block if the clock jitter is less than MAXIMUM_GOSSIP_CLOCK_DISPARITY.
+			// Otherwise queue it for processing in the right slot.
+			if isBlockQueueable(genesisTime, blk.Block().Slot(), receivedTime) {
+				s.pendingQueueLock.Lock()
+				if err := s.insertBlockToPendingQueue(blk.Block().Slot(), blk, blockRoot); err != nil {
+					s.pendingQueueLock.Unlock()
+					log.WithError(err).WithFields(getBlockFields(blk)).Debug("Could not insert block to pending queue")
+					return pubsub.ValidationIgnore, err
+				}
+				s.pendingQueueLock.Unlock()
+				err := fmt.Errorf("early block, with current slot %d < block slot %d", s.cfg.clock.CurrentSlot(), blk.Block().Slot())
+				log.WithError(err).WithFields(getBlockFields(blk)).Debug("Could not process early block")
+				return pubsub.ValidationIgnore, err
+			}
 
-	err = s.validateBeaconBlock(ctx, blk, blockRoot)
-	if err != nil {
-		// If the parent is optimistic, process the block as usual
-		// This also does not penalize a peer which sends optimistic blocks
-		if !errors.Is(ErrOptimisticParent, err) {
-			log.WithError(err).WithFields(getBlockFields(blk)).Debug("Could not validate beacon block")
-			return pubsub.ValidationReject, err
-		}
+			// Handle block when the parent is unknown.
+			if !s.cfg.chain.HasBlock(ctx, blk.Block().ParentRoot()) {
+				s.pendingQueueLock.Lock()
+				if err := s.insertBlockToPendingQueue(blk.Block().Slot(), blk, blockRoot); err != nil {
+					s.pendingQueueLock.Unlock()
+					log.WithError(err).WithFields(getBlockFields(blk)).Debug("Could not insert block to pending queue")
+					return pubsub.ValidationIgnore, err
+				}
+				s.pendingQueueLock.Unlock()
+				err := errors.Errorf("unknown parent for block with slot %d and parent root %#x", blk.Block().Slot(), blk.Block().ParentRoot())
+				log.WithError(err).WithFields(getBlockFields(blk)).Debug("Could not identify parent for block")
+				return pubsub.ValidationIgnore, err
+			}
+			return pubsub.ValidationAccept, nil
+		}),
+		// The signature, proposer and payload of the block are checked against the parent state.
+		namedStep(stepState, func(ctx context.Context) (pubsub.ValidationResult, error) {
+			if err := s.validateBeaconBlock(ctx, blk, blockRoot); err != nil {
+				// If the parent is optimistic, process the block as usual
+				// This also does not penalize a peer which sends optimistic blocks
+				if !errors.Is(ErrOptimisticParent, err) {
+					log.WithError(err).WithFields(getBlockFields(blk)).Debug("Could not validate beacon block")
+					return pubsub.ValidationReject, err
+				}
+			}
+			return pubsub.ValidationAccept, nil
+		}),
+	); res != pubsub.ValidationAccept {
+		tracing.AnnotateError(span, err)
+		return res, err
 	}
 
 	// Record attribute of valid block.
@@ -11,7 +11,9 @@ import (
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/core/signing"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/core/transition"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/p2p"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/v4/config/features"
 	"github.com/prysmaticlabs/prysm/v4/config/params"
 	"github.com/prysmaticlabs/prysm/v4/crypto/bls"
 	"github.com/prysmaticlabs/prysm/v4/encoding/bytesutil"
@@ -28,103 +30,130 @@ func (s *Service) validateBlob(ctx context.Context, pid peer.ID, msg *pubsub.Mes
 	if pid == s.cfg.p2p.PeerID() {
 		return pubsub.ValidationAccept, nil
 	}
-	if s.cfg.initialSync.Syncing() {
-		return pubsub.ValidationIgnore, nil
-	}
-	if msg.Topic == nil {
-		return pubsub.ValidationReject, errInvalidTopic
-	}
-	m, err := s.decodePubsubMessage(msg)
-	if err != nil {
-		log.WithError(err).Error("Failed to decode message")
-		return pubsub.ValidationReject, err
-	}
-
-	sBlob, ok := m.(*eth.SignedBlobSidecar)
-	if !ok {
-		log.WithField("message", m).Error("Message is not of type *eth.SignedBlobSidecar")
-		return pubsub.ValidationReject, errWrongMessage
-	}
-	blob := sBlob.Message
-
-	// [REJECT] The sidecar is for the correct topic -- i.e. sidecar.index matches the topic {index}.
-	want := fmt.Sprintf("blob_sidecar_%d", blob.Index)
-	if !strings.Contains(*msg.Topic, want) {
-		log.WithFields(blobFields(blob)).Debug("Sidecar blob does not match topic")
-		return pubsub.ValidationReject, fmt.Errorf("wrong topic name: %s", *msg.Topic)
-	}
-
-	// [IGNORE] The sidecar is not from a future slot (with a MAXIMUM_GOSSIP_CLOCK_DISPARITY allowance) --
-	// i.e. validate that sidecar.slot <= current_slot (a client MAY queue future blocks for processing at the appropriate slot).
-	genesisTime := uint64(s.cfg.chain.GenesisTime().Unix())
-	if err := slots.VerifyTime(genesisTime, blob.Slot, earlyBlockProcessingTolerance); err != nil {
-		log.WithError(err).WithFields(blobFields(blob)).Debug("Ignored blob: too far into future")
-		return pubsub.ValidationIgnore, errors.Wrap(err, "blob too far into future")
-	}
-
-	// [IGNORE] The sidecar is from a slot greater than the latest finalized slot --
-	// i.e. validate that sidecar.slot > compute_start_slot_at_epoch(state.finalized_checkpoint.epoch)
-	startSlot, err := slots.EpochStart(s.cfg.chain.FinalizedCheckpt().Epoch)
-	if err != nil {
-		return pubsub.ValidationIgnore, err
-	}
-	if startSlot >= blob.Slot {
-		err := fmt.Errorf("finalized slot %d greater or equal to blob slot %d", startSlot, blob.Slot)
-		log.WithFields(blobFields(blob)).Debug(err)
-		return pubsub.ValidationIgnore, err
-	}
-
-	// [IGNORE] The sidecar's block's parent (defined by sidecar.block_parent_root) has been seen (via both gossip and non-gossip sources)
-	parentRoot := bytesutil.ToBytes32(blob.BlockParentRoot)
-	if !s.cfg.chain.HasBlock(ctx, parentRoot) {
-		log.WithFields(blobFields(blob)).Debug("Ignored blob: parent block not found")
-		return pubsub.ValidationIgnore, nil
-	}
-
-	// [REJECT] The sidecar's block's parent (defined by sidecar.block_parent_root) passes validation.
-	parentSlot, err := s.cfg.chain.RecentBlockSlot(parentRoot)
-	if err != nil {
-		return pubsub.ValidationIgnore, err
-	}
-	// [REJECT] The sidecar is from a higher slot than the sidecar's block's parent (defined by sidecar.block_parent_root).
-	if parentSlot >= blob.Slot {
-		err := fmt.Errorf("parent block slot %d greater or equal to blob slot %d", parentSlot, blob.Slot)
-		log.WithFields(blobFields(blob)).Debug(err)
-		return pubsub.ValidationReject, err
-	}
-
-	// [REJECT] The proposer signature, signed_blob_sidecar.signature,
-	// is valid with respect to the sidecar.proposer_index pubkey.
-	parentState, err := s.cfg.stateGen.StateByRoot(ctx, parentRoot)
-	if err != nil {
-		return pubsub.ValidationIgnore, err
-	}
-	if err := verifyBlobSignature(parentState, sBlob); err != nil {
-		log.WithError(err).WithFields(blobFields(blob)).Debug("Failed to verify blob signature")
-		return pubsub.ValidationReject, err
-	}
-
-	// [IGNORE] The sidecar is the only sidecar with valid signature received for the tuple (sidecar.block_root, sidecar.index).
-	if s.hasSeenBlobIndex(blob.BlockRoot, blob.Index) {
-		return pubsub.ValidationIgnore, nil
-	}
-
-	// [REJECT] The sidecar is proposed by the expected proposer_index for the block's slot in the context of the current shuffling (defined by block_parent_root/slot)
-	parentState, err = transition.ProcessSlotsUsingNextSlotCache(ctx, parentState, parentRoot[:], blob.Slot)
-	if err != nil {
-		return pubsub.ValidationIgnore, err
-	}
-	idx, err := helpers.BeaconProposerIndex(ctx, parentState)
-	if err != nil {
-		return pubsub.ValidationIgnore, err
-	}
-	if blob.ProposerIndex != idx {
-		err := fmt.Errorf("expected proposer index %d, got %d", idx, blob.ProposerIndex)
-		log.WithFields(blobFields(blob)).Debug(err)
-		return pubsub.ValidationReject, err
-	}
-
-	startTime, err := slots.ToTime(genesisTime, blob.Slot)
+	var sBlob *eth.SignedBlobSidecar
+	var blob *eth.BlobSidecar
+	var parentRoot [32]byte
+	var parentState state.BeaconState
+	if res, err := s.newValidationPipeline(p2p.GossipBlobSidecarMessage, pid).run(ctx,
+		namedStep(stepSyncing, s.ignoreWhileSyncing),
+		namedStep(stepDecode, func(ctx context.Context) (pubsub.ValidationResult, error) {
+			if msg.Topic == nil {
+				return pubsub.ValidationReject, errInvalidTopic
+			}
+			m, err := s.decodePubsubMessage(msg)
+			if err != nil {
+				log.WithError(err).Error("Failed to decode message")
+				return pubsub.ValidationReject, err
+			}
+			var ok bool
+			sBlob, ok = m.(*eth.SignedBlobSidecar)
+			if !ok {
+				log.WithField("message", m).Error("Message is not of type *eth.SignedBlobSidecar")
+				return pubsub.ValidationReject, errWrongMessage
+			}
+			blob = sBlob.Message
+
+			// [REJECT] The sidecar is for the correct topic -- i.e. sidecar.index matches the topic {index}.
+			want := fmt.Sprintf("blob_sidecar_%d", blob.Index)
+			if !strings.Contains(*msg.Topic, want) {
+				log.WithFields(blobFields(blob)).Debug("Sidecar blob does not match topic")
+				return pubsub.ValidationReject, fmt.Errorf("wrong topic name: %s", *msg.Topic)
+			}
+			return pubsub.ValidationAccept, nil
+		}),
+		namedStep(stepTiming, func(ctx context.Context) (pubsub.ValidationResult, error) {
+			// [IGNORE] The sidecar is not from a future slot (with a MAXIMUM_GOSSIP_CLOCK_DISPARITY allowance) --
+			// i.e. validate that sidecar.slot <= current_slot (a client MAY queue future blocks for processing at the appropriate slot).
+			genesisTime := uint64(s.cfg.chain.GenesisTime().Unix())
+			if err := slots.VerifyTime(genesisTime, blob.Slot, earlyBlockProcessingTolerance); err != nil {
+				log.WithError(err).WithFields(blobFields(blob)).Debug("Ignored blob: too far into future")
+				return pubsub.ValidationIgnore, errors.Wrap(err, "blob too far into future")
+			}
+
+			// [IGNORE] The sidecar is from a slot greater than the latest finalized slot --
+			// i.e. validate that sidecar.slot > compute_start_slot_at_epoch(state.finalized_checkpoint.epoch)
+			startSlot, err := slots.EpochStart(s.cfg.chain.FinalizedCheckpt().Epoch)
+			if err != nil {
+				return pubsub.ValidationIgnore, err
+			}
+			if startSlot >= blob.Slot {
+				err := fmt.Errorf("finalized slot %d greater or equal to blob slot %d", startSlot, blob.Slot)
+				log.WithFields(blobFields(blob)).Debug(err)
+				return pubsub.ValidationIgnore, err
+			}
+			return pubsub.ValidationAccept, nil
+		}),
+		namedStep(stepParent, func(ctx context.Context) (pubsub.ValidationResult, error) {
+			// [IGNORE] The sidecar's block's parent (defined by sidecar.block_parent_root) has been seen (via both gossip and non-gossip sources)
+			parentRoot = bytesutil.ToBytes32(blob.BlockParentRoot)
+			if !s.cfg.chain.HasBlock(ctx, parentRoot) {
+				log.WithFields(blobFields(blob)).Debug("Ignored blob: parent block not found")
+				return pubsub.ValidationIgnore, nil
+			}
+
+			// [REJECT] The sidecar's block's parent (defined by sidecar.block_parent_root) passes validation.
+			parentSlot, err := s.cfg.chain.RecentBlockSlot(parentRoot)
+			if err != nil {
+				return pubsub.ValidationIgnore, err
+			}
+			// [REJECT] The sidecar is from a higher slot than the sidecar's block's parent (defined by sidecar.block_parent_root).
+			if parentSlot >= blob.Slot {
+				err := fmt.Errorf("parent block slot %d greater or equal to blob slot %d", parentSlot, blob.Slot)
+				log.WithFields(blobFields(blob)).Debug(err)
+				return pubsub.ValidationReject, err
+			}
+			return pubsub.ValidationAccept, nil
+		}),
+		namedStep(stepSignature, func(ctx context.Context) (pubsub.ValidationResult, error) {
+			// [REJECT] The proposer signature, signed_blob_sidecar.signature,
+			// is valid with respect to the sidecar.proposer_index pubkey.
+			var err error
+			parentState, err = s.cfg.stateGen.StateByRoot(ctx, parentRoot)
+			if err != nil {
+				return pubsub.ValidationIgnore, err
+			}
+			if err := verifyBlobSignature(parentState, sBlob); err != nil {
+				log.WithError(err).WithFields(blobFields(blob)).Debug("Failed to verify blob signature")
+				return pubsub.ValidationReject, err
+			}
+			if features.Get().EnableSlasher {
+				// Feed the signed blob sidecar to slasher in the background, before it can be ignored
+				// as a duplicate, as conflicting sidecars for the same block and index are what slasher
+				// looks for.
+				go s.cfg.slasherBlobSidecarsFeed.Send(sBlob)
+			}
+			return pubsub.ValidationAccept, nil
+		}),
+		namedStep(stepDedup, func(ctx context.Context) (pubsub.ValidationResult, error) {
+			// [IGNORE] The sidecar is the only sidecar with valid signature received for the tuple (sidecar.block_root, sidecar.index).
+			if s.hasSeenBlobIndex(blob.BlockRoot, blob.Index) {
+				return pubsub.ValidationIgnore, nil
+			}
+			return pubsub.ValidationAccept, nil
+		}),
+		namedStep(stepProposer, func(ctx context.Context) (pubsub.ValidationResult, error) {
+			// [REJECT] The sidecar is proposed by the expected proposer_index for the block's slot in the context of the current shuffling (defined by block_parent_root/slot)
+			var err error
+			parentState, err = transition.ProcessSlotsUsingNextSlotCache(ctx, parentState, parentRoot[:], blob.Slot)
+			if err != nil {
+				return pubsub.ValidationIgnore, err
+			}
+			idx, err := helpers.BeaconProposerIndex(ctx, parentState)
+			if err != nil {
+				return pubsub.ValidationIgnore, err
+			}
+			if blob.ProposerIndex != idx {
+				err := fmt.Errorf("expected proposer index %d, got %d", idx, blob.ProposerIndex)
+				log.WithFields(blobFields(blob)).Debug(err)
+				return pubsub.ValidationReject, err
+			}
+			return pubsub.ValidationAccept, nil
+		}),
+	); res != pubsub.ValidationAccept {
+		return res, err
+	}
+
+	startTime, err := slots.ToTime(uint64(s.cfg.chain.GenesisTime().Unix()), blob.Slot)
 	if err != nil {
 		return pubsub.ValidationIgnore, err
 	}
@@ -135,6 +164,9 @@ func (s *Service) validateBlob(ctx context.Context, pid peer.ID, msg *pubsub.Mes
 	log.WithFields(fields).Debug("Received blob sidecar gossip")
 
 	blobSidecarArrivalGossipSummary.Observe(float64(sinceSlotStartTime.Milliseconds()))
+	if s.cfg.blobArrivals != nil {
+		s.cfg.blobArrivals.Add(bytesutil.ToBytes32(blob.BlockRoot), blob.Slot, blob.Index, pid.String(), receivedTime)
+	}
 
 	msg.ValidatorData = sBlob
 
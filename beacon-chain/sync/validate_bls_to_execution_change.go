@@ -6,6 +6,8 @@ import (
 	pubsub "github.com/libp2p/go-libp2p-pubsub"
 	"github.com/libp2p/go-libp2p/core/peer"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/core/blocks"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/p2p"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/state"
 	"github.com/prysmaticlabs/prysm/v4/monitoring/tracing"
 	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
 	"go.opencensus.io/trace"
@@ -18,47 +20,58 @@ func (s *Service) validateBlsToExecutionChange(ctx context.Context, pid peer.ID,
 		return pubsub.ValidationAccept, nil
 	}
 
-	// The head state will be too far away to validate any execution change.
-	if s.cfg.initialSync.Syncing() {
-		return pubsub.ValidationIgnore, nil
-	}
-
 	ctx, span := trace.StartSpan(ctx, "sync.validateBlsToExecutionChange")
 	defer span.End()
 
-	m, err := s.decodePubsubMessage(msg)
-	if err != nil {
+	var blsChange *ethpb.SignedBLSToExecutionChange
+	var st state.ReadOnlyBeaconState
+	if res, err := s.newValidationPipeline(p2p.GossipBlsToExecutionChangeMessage, pid).run(ctx,
+		// The head state will be too far away to validate any execution change.
+		namedStep(stepSyncing, s.ignoreWhileSyncing),
+		namedStep(stepDecode, func(ctx context.Context) (pubsub.ValidationResult, error) {
+			m, err := s.decodePubsubMessage(msg)
+			if err != nil {
+				return pubsub.ValidationReject, err
+			}
+			var ok bool
+			blsChange, ok = m.(*ethpb.SignedBLSToExecutionChange)
+			if !ok {
+				return pubsub.ValidationReject, errWrongMessage
+			}
+			return pubsub.ValidationAccept, nil
+		}),
+		// Check that the validator hasn't submitted a previous execution change.
+		namedStep(stepDedup, func(ctx context.Context) (pubsub.ValidationResult, error) {
+			if s.cfg.blsToExecPool.ValidatorExists(blsChange.Message.ValidatorIndex) {
+				return pubsub.ValidationIgnore, nil
+			}
+			return pubsub.ValidationAccept, nil
+		}),
+		// Validate that the execution change object is valid.
+		namedStep(stepState, func(ctx context.Context) (pubsub.ValidationResult, error) {
+			var err error
+			st, err = s.cfg.chain.HeadStateReadOnly(ctx)
+			if err != nil {
+				return pubsub.ValidationIgnore, err
+			}
+			if _, err := blocks.ValidateBLSToExecutionChange(st, blsChange); err != nil {
+				return pubsub.ValidationReject, err
+			}
+			return pubsub.ValidationAccept, nil
+		}),
+		// Validate the signature of the message using our batch gossip verifier.
+		namedStep(stepSignature, func(ctx context.Context) (pubsub.ValidationResult, error) {
+			sigBatch, err := blocks.BLSChangesSignatureBatch(st, []*ethpb.SignedBLSToExecutionChange{blsChange})
+			if err != nil {
+				return pubsub.ValidationReject, err
+			}
+			return s.validateWithBatchVerifier(ctx, "bls to execution change", sigBatch)
+		}),
+	); res != pubsub.ValidationAccept {
 		tracing.AnnotateError(span, err)
-		return pubsub.ValidationReject, err
-	}
-
-	blsChange, ok := m.(*ethpb.SignedBLSToExecutionChange)
-	if !ok {
-		return pubsub.ValidationReject, errWrongMessage
-	}
-
-	// Check that the validator hasn't submitted a previous execution change.
-	if s.cfg.blsToExecPool.ValidatorExists(blsChange.Message.ValidatorIndex) {
-		return pubsub.ValidationIgnore, nil
-	}
-	st, err := s.cfg.chain.HeadStateReadOnly(ctx)
-	if err != nil {
-		return pubsub.ValidationIgnore, err
-	}
-	// Validate that the execution change object is valid.
-	_, err = blocks.ValidateBLSToExecutionChange(st, blsChange)
-	if err != nil {
-		return pubsub.ValidationReject, err
-	}
-	// Validate the signature of the message using our batch gossip verifier.
-	sigBatch, err := blocks.BLSChangesSignatureBatch(st, []*ethpb.SignedBLSToExecutionChange{blsChange})
-	if err != nil {
-		return pubsub.ValidationReject, err
-	}
-	res, err := s.validateWithBatchVerifier(ctx, "bls to execution change", sigBatch)
-	if res != pubsub.ValidationAccept {
 		return res, err
 	}
+
 	msg.ValidatorData = blsChange // Used in downstream subscriber
 	return pubsub.ValidationAccept, nil
 }
@@ -0,0 +1,104 @@
+package sync
+
+import (
+	"context"
+	"time"
+
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/prysmaticlabs/prysm/v4/config/params"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v4/monitoring/tracing"
+	ethpbv2 "github.com/prysmaticlabs/prysm/v4/proto/eth/v2"
+	prysmTime "github.com/prysmaticlabs/prysm/v4/time"
+	"github.com/prysmaticlabs/prysm/v4/time/slots"
+	"go.opencensus.io/trace"
+	"google.golang.org/protobuf/proto"
+)
+
+// validateLightClientFinalityUpdate only forwards a finality update that matches the one computed locally
+// from the canonical chain, which is therefore also the newest one this node knows of.
+// spec: https://github.com/ethereum/consensus-specs/blob/3d235740e5f1e641d3b160c8688f26e7dc5a1894/specs/altair/light-client/p2p-interface.md#light_client_finality_update
+func (s *Service) validateLightClientFinalityUpdate(ctx context.Context, pid peer.ID, msg *pubsub.Message) (pubsub.ValidationResult, error) {
+	// Validation runs on publish (not just subscriptions), so we should approve any message from
+	// ourselves.
+	if pid == s.cfg.p2p.PeerID() {
+		return pubsub.ValidationAccept, nil
+	}
+
+	// We cannot compute light client updates while syncing.
+	if s.cfg.initialSync.Syncing() {
+		return pubsub.ValidationIgnore, nil
+	}
+
+	_, span := trace.StartSpan(ctx, "sync.validateLightClientFinalityUpdate")
+	defer span.End()
+
+	m, err := s.decodePubsubMessage(msg)
+	if err != nil {
+		tracing.AnnotateError(span, err)
+		return pubsub.ValidationReject, err
+	}
+	update, ok := m.(*ethpbv2.LightClientFinalityUpdate)
+	if !ok {
+		return pubsub.ValidationReject, errWrongMessage
+	}
+
+	if !s.lightClientUpdatePropagated(update.SignatureSlot) {
+		return pubsub.ValidationIgnore, nil
+	}
+	local := s.cfg.chain.LightClientFinalityUpdate()
+	if local == nil || !proto.Equal(local.Data, update) {
+		return pubsub.ValidationIgnore, nil
+	}
+	msg.ValidatorData = update
+	return pubsub.ValidationAccept, nil
+}
+
+// validateLightClientOptimisticUpdate only forwards an optimistic update that matches the one computed locally
+// from the canonical chain, which is therefore also the newest one this node knows of.
+// spec: https://github.com/ethereum/consensus-specs/blob/3d235740e5f1e641d3b160c8688f26e7dc5a1894/specs/altair/light-client/p2p-interface.md#light_client_optimistic_update
+func (s *Service) validateLightClientOptimisticUpdate(ctx context.Context, pid peer.ID, msg *pubsub.Message) (pubsub.ValidationResult, error) {
+	// Validation runs on publish (not just subscriptions), so we should approve any message from
+	// ourselves.
+	if pid == s.cfg.p2p.PeerID() {
+		return pubsub.ValidationAccept, nil
+	}
+
+	// We cannot compute light client updates while syncing.
+	if s.cfg.initialSync.Syncing() {
+		return pubsub.ValidationIgnore, nil
+	}
+
+	_, span := trace.StartSpan(ctx, "sync.validateLightClientOptimisticUpdate")
+	defer span.End()
+
+	m, err := s.decodePubsubMessage(msg)
+	if err != nil {
+		tracing.AnnotateError(span, err)
+		return pubsub.ValidationReject, err
+	}
+	update, ok := m.(*ethpbv2.LightClientOptimisticUpdate)
+	if !ok {
+		return pubsub.ValidationReject, errWrongMessage
+	}
+
+	if !s.lightClientUpdatePropagated(update.SignatureSlot) {
+		return pubsub.ValidationIgnore, nil
+	}
+	local := s.cfg.chain.LightClientOptimisticUpdate()
+	if local == nil || !proto.Equal(local.Data, update) {
+		return pubsub.ValidationIgnore, nil
+	}
+	msg.ValidatorData = update
+	return pubsub.ValidationAccept, nil
+}
+
+// lightClientUpdatePropagated reports whether the block at the signature slot was given enough time to
+// propagate through the network, that is one third of the slot has transpired.
+func (s *Service) lightClientUpdatePropagated(signatureSlot primitives.Slot) bool {
+	due := slots.StartTime(uint64(s.cfg.clock.GenesisTime().Unix()), signatureSlot).
+		Add(time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second / 3).
+		Add(-params.BeaconNetworkConfig().MaximumGossipClockDisparity)
+	return !prysmTime.Now().Before(due)
+}
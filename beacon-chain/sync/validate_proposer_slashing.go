@@ -6,6 +6,7 @@ import (
 	pubsub "github.com/libp2p/go-libp2p-pubsub"
 	"github.com/libp2p/go-libp2p/core/peer"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/core/blocks"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/p2p"
 	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/v4/monitoring/tracing"
 	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
@@ -21,38 +22,47 @@ func (s *Service) validateProposerSlashing(ctx context.Context, pid peer.ID, msg
 		return pubsub.ValidationAccept, nil
 	}
 
-	// The head state will be too far away to validate any slashing.
-	if s.cfg.initialSync.Syncing() {
-		return pubsub.ValidationIgnore, nil
-	}
-
 	ctx, span := trace.StartSpan(ctx, "sync.validateProposerSlashing")
 	defer span.End()
 
-	m, err := s.decodePubsubMessage(msg)
-	if err != nil {
+	var slashing *ethpb.ProposerSlashing
+	if res, err := s.newValidationPipeline(p2p.GossipProposerSlashingMessage, pid).run(ctx,
+		// The head state will be too far away to validate any slashing.
+		namedStep(stepSyncing, s.ignoreWhileSyncing),
+		namedStep(stepDecode, func(ctx context.Context) (pubsub.ValidationResult, error) {
+			m, err := s.decodePubsubMessage(msg)
+			if err != nil {
+				return pubsub.ValidationReject, err
+			}
+			var ok bool
+			slashing, ok = m.(*ethpb.ProposerSlashing)
+			if !ok {
+				return pubsub.ValidationReject, errWrongMessage
+			}
+			if slashing.Header_1 == nil || slashing.Header_1.Header == nil {
+				return pubsub.ValidationReject, errNilMessage
+			}
+			return pubsub.ValidationAccept, nil
+		}),
+		namedStep(stepDedup, func(ctx context.Context) (pubsub.ValidationResult, error) {
+			if s.hasSeenProposerSlashingIndex(slashing.Header_1.Header.ProposerIndex) {
+				return pubsub.ValidationIgnore, nil
+			}
+			return pubsub.ValidationAccept, nil
+		}),
+		namedStep(stepSignature, func(ctx context.Context) (pubsub.ValidationResult, error) {
+			headState, err := s.cfg.chain.HeadState(ctx)
+			if err != nil {
+				return pubsub.ValidationIgnore, err
+			}
+			if err := blocks.VerifyProposerSlashing(headState, slashing); err != nil {
+				return pubsub.ValidationReject, err
+			}
+			return pubsub.ValidationAccept, nil
+		}),
+	); res != pubsub.ValidationAccept {
 		tracing.AnnotateError(span, err)
-		return pubsub.ValidationReject, err
-	}
-
-	slashing, ok := m.(*ethpb.ProposerSlashing)
-	if !ok {
-		return pubsub.ValidationReject, errWrongMessage
-	}
-
-	if slashing.Header_1 == nil || slashing.Header_1.Header == nil {
-		return pubsub.ValidationReject, errNilMessage
-	}
-	if s.hasSeenProposerSlashingIndex(slashing.Header_1.Header.ProposerIndex) {
-		return pubsub.ValidationIgnore, nil
-	}
-
-	headState, err := s.cfg.chain.HeadState(ctx)
-	if err != nil {
-		return pubsub.ValidationIgnore, err
-	}
-	if err := blocks.VerifyProposerSlashing(headState, slashing); err != nil {
-		return pubsub.ValidationReject, err
+		return res, err
 	}
 
 	msg.ValidatorData = slashing // Used in downstream subscriber
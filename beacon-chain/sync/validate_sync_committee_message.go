@@ -13,6 +13,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/core/signing"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/p2p"
 	p2ptypes "github.com/prysmaticlabs/prysm/v4/beacon-chain/p2p/types"
+	"github.com/prysmaticlabs/prysm/v4/config/features"
 	"github.com/prysmaticlabs/prysm/v4/config/params"
 	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/v4/crypto/bls"
@@ -52,46 +53,68 @@ func (s *Service) validateSyncCommitteeMessage(
 		return pubsub.ValidationAccept, nil
 	}
 
-	// Basic validations before proceeding.
-	if s.cfg.initialSync.Syncing() {
-		return pubsub.ValidationIgnore, nil
-	}
-
-	if msg.Topic == nil {
-		return pubsub.ValidationReject, errInvalidTopic
-	}
-
-	// Read the data from the pubsub message, and reject if there is an error.
-	m, err := s.readSyncCommitteeMessage(msg)
-	if err != nil {
-		tracing.AnnotateError(span, err)
-		return pubsub.ValidationReject, err
-	}
-
-	// Validate sync message times before proceeding.
-	// The message's `slot` is for the current slot (with a MAXIMUM_GOSSIP_CLOCK_DISPARITY allowance).
-	if err := altair.ValidateSyncMessageTime(
-		m.Slot,
-		s.cfg.clock.GenesisTime(),
-		params.BeaconNetworkConfig().MaximumGossipClockDisparity,
-	); err != nil {
-		tracing.AnnotateError(span, err)
-		return pubsub.ValidationIgnore, err
-	}
-
-	committeeIndices, err := s.cfg.chain.HeadSyncCommitteeIndices(ctx, m.ValidatorIndex, m.Slot)
-	if err != nil {
+	var m *ethpb.SyncCommitteeMessage
+	var committeeIndices []primitives.CommitteeIndex
+	pipeline := s.newValidationPipeline(p2p.GossipSyncCommitteeMessage, pid)
+	if result, err := pipeline.run(
+		ctx,
+		// Basic validations before proceeding.
+		namedStep(stepSyncing, s.ignoreWhileSyncing),
+		namedStep(stepDecode, func(ctx context.Context) (pubsub.ValidationResult, error) {
+			if msg.Topic == nil {
+				return pubsub.ValidationReject, errInvalidTopic
+			}
+			// Read the data from the pubsub message, and reject if there is an error.
+			var err error
+			m, err = s.readSyncCommitteeMessage(msg)
+			if err != nil {
+				return pubsub.ValidationReject, err
+			}
+			return pubsub.ValidationAccept, nil
+		}),
+		// Validate sync message times before proceeding.
+		// The message's `slot` is for the current slot (with a MAXIMUM_GOSSIP_CLOCK_DISPARITY allowance).
+		namedStep(stepTiming, func(ctx context.Context) (pubsub.ValidationResult, error) {
+			if err := altair.ValidateSyncMessageTime(
+				m.Slot,
+				s.cfg.clock.GenesisTime(),
+				params.BeaconNetworkConfig().MaximumGossipClockDisparity,
+			); err != nil {
+				return pubsub.ValidationIgnore, err
+			}
+			return pubsub.ValidationAccept, nil
+		}),
+		namedStep(stepState, func(ctx context.Context) (pubsub.ValidationResult, error) {
+			var err error
+			committeeIndices, err = s.cfg.chain.HeadSyncCommitteeIndices(ctx, m.ValidatorIndex, m.Slot)
+			if err != nil {
+				return pubsub.ValidationIgnore, err
+			}
+			return pubsub.ValidationAccept, nil
+		}),
+	); result != pubsub.ValidationAccept {
 		tracing.AnnotateError(span, err)
-		return pubsub.ValidationIgnore, err
+		return result, err
 	}
 
 	// Validate the message's data according to the p2p specification.
-	if result, err := validationPipeline(
+	if result, err := pipeline.run(
 		ctx,
-		ignoreEmptyCommittee(committeeIndices),
-		s.rejectIncorrectSyncCommittee(committeeIndices, *msg.Topic),
-		s.ignoreHasSeenSyncMsg(ctx, m, committeeIndices),
-		s.rejectInvalidSyncCommitteeSignature(m),
+		namedStep(stepCommittee, ignoreEmptyCommittee(committeeIndices)),
+		namedStep(stepSubnet, s.rejectIncorrectSyncCommittee(committeeIndices, *msg.Topic)),
+		namedStep(stepSignature, func(ctx context.Context) (pubsub.ValidationResult, error) {
+			if result, err := s.rejectInvalidSyncCommitteeSignature(m)(ctx); result != pubsub.ValidationAccept {
+				return result, err
+			}
+			if features.Get().EnableSlasher {
+				// Feed the sync committee message to slasher in the background, before it can be ignored
+				// as a duplicate, as conflicting messages of a validator for the same slot are what slasher
+				// looks for.
+				go s.cfg.slasherSyncMessagesFeed.Send(m)
+			}
+			return pubsub.ValidationAccept, nil
+		}),
+		namedStep(stepDedup, s.ignoreHasSeenSyncMsg(ctx, m, committeeIndices)),
 	); result != pubsub.ValidationAccept {
 		return result, err
 	}
@@ -287,12 +310,3 @@ func seenSyncCommitteeKey(slot primitives.Slot, valIndex primitives.ValidatorInd
 	b = append(b, bytesutil.Bytes32(subCommitteeIndex)...)
 	return string(b)
 }
-
-func validationPipeline(ctx context.Context, fns ...validationFn) (pubsub.ValidationResult, error) {
-	for _, fn := range fns {
-		if result, err := fn(ctx); result != pubsub.ValidationAccept {
-			return result, err
-		}
-	}
-	return pubsub.ValidationAccept, nil
-}
@@ -10,6 +10,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/core/feed"
 	opfeed "github.com/prysmaticlabs/prysm/v4/beacon-chain/core/feed/operation"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/core/signing"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/p2p"
 	p2ptypes "github.com/prysmaticlabs/prysm/v4/beacon-chain/p2p/types"
 	"github.com/prysmaticlabs/prysm/v4/config/params"
 	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
@@ -47,33 +48,42 @@ func (s *Service) validateSyncContributionAndProof(ctx context.Context, pid peer
 		return pubsub.ValidationAccept, nil
 	}
 
-	// Ignore the sync committee contribution if the beacon node is syncing.
-	if s.cfg.initialSync.Syncing() {
-		return pubsub.ValidationIgnore, nil
-	}
-
-	m, err := s.readSyncContributionMessage(msg)
-	if err != nil {
-		tracing.AnnotateError(span, err)
-		return pubsub.ValidationReject, err
-	}
-
-	// The contribution's slot is for the current slot (with a `MAXIMUM_GOSSIP_CLOCK_DISPARITY` allowance).
-	if err := altair.ValidateSyncMessageTime(m.Message.Contribution.Slot, s.cfg.clock.GenesisTime(), params.BeaconNetworkConfig().MaximumGossipClockDisparity); err != nil {
+	var m *ethpb.SignedContributionAndProof
+	pipeline := s.newValidationPipeline(p2p.GossipContributionAndProofMessage, pid)
+	if result, err := pipeline.run(
+		ctx,
+		// Ignore the sync committee contribution if the beacon node is syncing.
+		namedStep(stepSyncing, s.ignoreWhileSyncing),
+		namedStep(stepDecode, func(ctx context.Context) (pubsub.ValidationResult, error) {
+			var err error
+			m, err = s.readSyncContributionMessage(msg)
+			if err != nil {
+				return pubsub.ValidationReject, err
+			}
+			return pubsub.ValidationAccept, nil
+		}),
+		// The contribution's slot is for the current slot (with a `MAXIMUM_GOSSIP_CLOCK_DISPARITY` allowance).
+		namedStep(stepTiming, func(ctx context.Context) (pubsub.ValidationResult, error) {
+			if err := altair.ValidateSyncMessageTime(m.Message.Contribution.Slot, s.cfg.clock.GenesisTime(), params.BeaconNetworkConfig().MaximumGossipClockDisparity); err != nil {
+				return pubsub.ValidationIgnore, err
+			}
+			return pubsub.ValidationAccept, nil
+		}),
+	); result != pubsub.ValidationAccept {
 		tracing.AnnotateError(span, err)
-		return pubsub.ValidationIgnore, err
+		return result, err
 	}
 	// Validate the message's data according to the p2p specification.
-	if result, err := validationPipeline(
+	if result, err := pipeline.run(
 		ctx,
-		rejectIncorrectSubcommitteeIndex(m),
-		rejectEmptyContribution(m),
-		s.ignoreSeenSyncContribution(m),
-		rejectInvalidAggregator(m),
-		s.rejectInvalidIndexInSubCommittee(m),
-		s.rejectInvalidSelectionProof(m),
-		s.rejectInvalidContributionSignature(m),
-		s.rejectInvalidSyncAggregateSignature(m),
+		namedStep(stepSubcommitteeIndex, rejectIncorrectSubcommitteeIndex(m)),
+		namedStep(stepParticipants, rejectEmptyContribution(m)),
+		namedStep(stepDedup, s.ignoreSeenSyncContribution(m)),
+		namedStep(stepAggregator, rejectInvalidAggregator(m)),
+		namedStep(stepSubcommittee, s.rejectInvalidIndexInSubCommittee(m)),
+		namedStep(stepSelectionProof, s.rejectInvalidSelectionProof(m)),
+		namedStep(stepSignature, s.rejectInvalidContributionSignature(m)),
+		namedStep(stepAggregateSignature, s.rejectInvalidSyncAggregateSignature(m)),
 	); result != pubsub.ValidationAccept {
 		return result, err
 	}
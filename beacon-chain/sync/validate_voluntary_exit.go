@@ -9,6 +9,8 @@ import (
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/core/blocks"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/core/feed"
 	opfeed "github.com/prysmaticlabs/prysm/v4/beacon-chain/core/feed/operation"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/p2p"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/state"
 	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/v4/monitoring/tracing"
 	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
@@ -24,46 +26,59 @@ func (s *Service) validateVoluntaryExit(ctx context.Context, pid peer.ID, msg *p
 		return pubsub.ValidationAccept, nil
 	}
 
-	// The head state will be too far away to validate any voluntary exit.
-	if s.cfg.initialSync.Syncing() {
-		return pubsub.ValidationIgnore, nil
-	}
-
 	ctx, span := trace.StartSpan(ctx, "sync.validateVoluntaryExit")
 	defer span.End()
 
-	m, err := s.decodePubsubMessage(msg)
-	if err != nil {
+	var exit *ethpb.SignedVoluntaryExit
+	var headState state.BeaconState
+	if res, err := s.newValidationPipeline(p2p.GossipExitMessage, pid).run(ctx,
+		// The head state will be too far away to validate any voluntary exit.
+		namedStep(stepSyncing, s.ignoreWhileSyncing),
+		namedStep(stepDecode, func(ctx context.Context) (pubsub.ValidationResult, error) {
+			m, err := s.decodePubsubMessage(msg)
+			if err != nil {
+				return pubsub.ValidationReject, err
+			}
+			var ok bool
+			exit, ok = m.(*ethpb.SignedVoluntaryExit)
+			if !ok {
+				return pubsub.ValidationReject, errWrongMessage
+			}
+			if exit.Exit == nil {
+				return pubsub.ValidationReject, errNilMessage
+			}
+			return pubsub.ValidationAccept, nil
+		}),
+		namedStep(stepDedup, func(ctx context.Context) (pubsub.ValidationResult, error) {
+			if s.hasSeenExitIndex(exit.Exit.ValidatorIndex) {
+				return pubsub.ValidationIgnore, nil
+			}
+			return pubsub.ValidationAccept, nil
+		}),
+		namedStep(stepState, func(ctx context.Context) (pubsub.ValidationResult, error) {
+			var err error
+			headState, err = s.cfg.chain.HeadState(ctx)
+			if err != nil {
+				return pubsub.ValidationIgnore, err
+			}
+			if uint64(exit.Exit.ValidatorIndex) >= uint64(headState.NumValidators()) {
+				return pubsub.ValidationReject, errors.New("validator index is invalid")
+			}
+			return pubsub.ValidationAccept, nil
+		}),
+		namedStep(stepSignature, func(ctx context.Context) (pubsub.ValidationResult, error) {
+			val, err := headState.ValidatorAtIndexReadOnly(exit.Exit.ValidatorIndex)
+			if err != nil {
+				return pubsub.ValidationIgnore, err
+			}
+			if err := blocks.VerifyExitAndSignature(val, headState, exit); err != nil {
+				return pubsub.ValidationReject, err
+			}
+			return pubsub.ValidationAccept, nil
+		}),
+	); res != pubsub.ValidationAccept {
 		tracing.AnnotateError(span, err)
-		return pubsub.ValidationReject, err
-	}
-
-	exit, ok := m.(*ethpb.SignedVoluntaryExit)
-	if !ok {
-		return pubsub.ValidationReject, errWrongMessage
-	}
-
-	if exit.Exit == nil {
-		return pubsub.ValidationReject, errNilMessage
-	}
-	if s.hasSeenExitIndex(exit.Exit.ValidatorIndex) {
-		return pubsub.ValidationIgnore, nil
-	}
-
-	headState, err := s.cfg.chain.HeadState(ctx)
-	if err != nil {
-		return pubsub.ValidationIgnore, err
-	}
-
-	if uint64(exit.Exit.ValidatorIndex) >= uint64(headState.NumValidators()) {
-		return pubsub.ValidationReject, errors.New("validator index is invalid")
-	}
-	val, err := headState.ValidatorAtIndexReadOnly(exit.Exit.ValidatorIndex)
-	if err != nil {
-		return pubsub.ValidationIgnore, err
-	}
-	if err := blocks.VerifyExitAndSignature(val, headState, exit); err != nil {
-		return pubsub.ValidationReject, err
+		return res, err
 	}
 
 	msg.ValidatorData = exit // Used in downstream subscriber
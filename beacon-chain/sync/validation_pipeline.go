@@ -0,0 +1,136 @@
+package sync
+
+import (
+	"context"
+	"time"
+
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/prysmaticlabs/prysm/v4/config/features"
+	"github.com/prysmaticlabs/prysm/v4/monitoring/tracing"
+	"github.com/sirupsen/logrus"
+	"go.opencensus.io/trace"
+)
+
+// Names of the validation steps, used as labels of the step metrics. The second group is specific
+// to sync committee contributions.
+const (
+	stepSyncing    = "syncing"
+	stepDecode     = "decode"
+	stepTiming     = "timing"
+	stepDedup      = "dedup"
+	stepState      = "state"
+	stepSignature  = "signature"
+	stepValidate   = "validate"
+	stepParent     = "parent"
+	stepProposer   = "proposer"
+	stepPending    = "pending"
+	stepQueue      = "queue"
+	stepBlock      = "block"
+	stepForkchoice = "forkchoice"
+	stepCommittee  = "committee"
+	stepSubnet     = "subnet"
+
+	stepSubcommitteeIndex  = "subcommittee_index"
+	stepSubcommittee       = "subcommittee"
+	stepParticipants       = "participants"
+	stepAggregator         = "aggregator"
+	stepSelectionProof     = "selection_proof"
+	stepAggregateSignature = "aggregate_signature"
+)
+
+// validationStep is a named step of a gossip validation pipeline.
+type validationStep struct {
+	name string
+	fn   validationFn
+}
+
+func namedStep(name string, fn validationFn) validationStep {
+	return validationStep{name: name, fn: fn}
+}
+
+// validationPipeline validates a gossip message of a topic as a list of named steps, such as
+// decoding, deduplication, timing, signature and state-dependent checks. It records how long each
+// step took and which step ignored or rejected the message, so that checks can be added to a topic
+// without repeating any of this bookkeeping.
+type validationPipeline struct {
+	s     *Service
+	topic string
+	pid   peer.ID
+}
+
+func (s *Service) newValidationPipeline(topic string, pid peer.ID) *validationPipeline {
+	return &validationPipeline{s: s, topic: topic, pid: pid}
+}
+
+// run runs the steps in order and stops at the first one which does not accept the message. Steps
+// share values, such as the decoded message, through the variables their closures capture. A
+// pipeline may be run several times for the same message, for instance when later steps can only
+// be built once the message is decoded.
+func (p *validationPipeline) run(ctx context.Context, steps ...validationStep) (pubsub.ValidationResult, error) {
+	for _, step := range steps {
+		stepCtx, span := trace.StartSpan(ctx, "sync.validation."+step.name)
+		span.AddAttributes(trace.StringAttribute("topic", p.topic))
+		start := time.Now()
+		res, err := step.fn(stepCtx)
+		elapsed := time.Since(start)
+		tracing.AnnotateError(span, err)
+		span.End()
+		gossipValidationStepLatency.WithLabelValues(p.topic, step.name).Observe(float64(elapsed.Microseconds()) / 1000)
+		if res == pubsub.ValidationAccept {
+			continue
+		}
+		verdict := validationVerdict(res)
+		gossipValidationStepVerdicts.WithLabelValues(p.topic, step.name, verdict).Inc()
+		if features.Get().EnableGossipRejectionLog {
+			l := log.WithError(err).WithFields(logrus.Fields{
+				"topic":   p.topic,
+				"step":    step.name,
+				"verdict": verdict,
+				"peer id": p.pid.String(),
+				"agent":   agentString(p.pid, p.s.cfg.p2p.Host()),
+				"latency": elapsed,
+			})
+			// Ignored messages are routine, for instance duplicates, so only rejections are logged at info.
+			if res == pubsub.ValidationIgnore {
+				l.Debug("Gossip message did not pass validation")
+			} else {
+				l.Info("Gossip message did not pass validation")
+			}
+		}
+		return res, err
+	}
+	return pubsub.ValidationAccept, nil
+}
+
+// singleStepValidation runs a validator which is not split into steps as a pipeline with a single
+// step, so that its latency and verdicts are recorded like those of any other topic.
+func (s *Service) singleStepValidation(topic string, v wrappedVal) wrappedVal {
+	return func(ctx context.Context, pid peer.ID, msg *pubsub.Message) (pubsub.ValidationResult, error) {
+		return s.newValidationPipeline(topic, pid).run(ctx, namedStep(stepValidate, func(ctx context.Context) (pubsub.ValidationResult, error) {
+			return v(ctx, pid, msg)
+		}))
+	}
+}
+
+// ignoreWhileSyncing ignores messages while the node is syncing, as the head state is then too
+// far behind to validate them.
+func (s *Service) ignoreWhileSyncing(_ context.Context) (pubsub.ValidationResult, error) {
+	if s.cfg.initialSync.Syncing() {
+		return pubsub.ValidationIgnore, nil
+	}
+	return pubsub.ValidationAccept, nil
+}
+
+func validationVerdict(res pubsub.ValidationResult) string {
+	switch res {
+	case pubsub.ValidationAccept:
+		return "accept"
+	case pubsub.ValidationReject:
+		return "reject"
+	case pubsub.ValidationIgnore:
+		return "ignore"
+	default:
+		return "unknown"
+	}
+}
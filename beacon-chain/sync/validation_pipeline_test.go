@@ -0,0 +1,103 @@
+package sync
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/p2p"
+	p2ptest "github.com/prysmaticlabs/prysm/v4/beacon-chain/p2p/testing"
+	mockSync "github.com/prysmaticlabs/prysm/v4/beacon-chain/sync/initial-sync/testing"
+	"github.com/prysmaticlabs/prysm/v4/config/features"
+	"github.com/prysmaticlabs/prysm/v4/testing/assert"
+	"github.com/prysmaticlabs/prysm/v4/testing/require"
+	"github.com/sirupsen/logrus"
+	logTest "github.com/sirupsen/logrus/hooks/test"
+)
+
+func TestValidationPipeline_RunsStepsInOrder(t *testing.T) {
+	s := &Service{cfg: &config{p2p: p2ptest.NewTestP2P(t), initialSync: &mockSync.Sync{IsSyncing: false}}}
+	var steps []string
+	step := func(name string) validationStep {
+		return namedStep(name, func(context.Context) (pubsub.ValidationResult, error) {
+			steps = append(steps, name)
+			return pubsub.ValidationAccept, nil
+		})
+	}
+	res, err := s.newValidationPipeline(p2p.GossipExitMessage, "").run(context.Background(),
+		namedStep(stepSyncing, s.ignoreWhileSyncing),
+		step(stepDecode),
+		step(stepDedup),
+		step(stepSignature),
+	)
+	require.NoError(t, err)
+	assert.Equal(t, pubsub.ValidationAccept, res)
+	assert.DeepEqual(t, []string{stepDecode, stepDedup, stepSignature}, steps)
+}
+
+func TestValidationPipeline_StopsAtFirstVerdict(t *testing.T) {
+	resetCfg := features.InitWithReset(&features.Flags{EnableGossipRejectionLog: true})
+	defer resetCfg()
+	hook := logTest.NewGlobal()
+
+	s := &Service{cfg: &config{p2p: p2ptest.NewTestP2P(t), initialSync: &mockSync.Sync{IsSyncing: false}}}
+	wantErr := errors.New("bad signature")
+	var ranState bool
+	res, err := s.newValidationPipeline(p2p.GossipExitMessage, peer.ID("peer")).run(context.Background(),
+		namedStep(stepDecode, func(context.Context) (pubsub.ValidationResult, error) {
+			return pubsub.ValidationAccept, nil
+		}),
+		namedStep(stepSignature, func(context.Context) (pubsub.ValidationResult, error) {
+			return pubsub.ValidationReject, wantErr
+		}),
+		namedStep(stepState, func(context.Context) (pubsub.ValidationResult, error) {
+			ranState = true
+			return pubsub.ValidationAccept, nil
+		}),
+	)
+	require.ErrorIs(t, err, wantErr)
+	assert.Equal(t, pubsub.ValidationReject, res)
+	assert.Equal(t, false, ranState)
+	require.LogsContain(t, hook, "Gossip message did not pass validation")
+	require.LogsContain(t, hook, "step=signature")
+	require.LogsContain(t, hook, "verdict=reject")
+}
+
+func TestValidationPipeline_LogsIgnoresAtDebug(t *testing.T) {
+	resetCfg := features.InitWithReset(&features.Flags{EnableGossipRejectionLog: true})
+	defer resetCfg()
+	level := logrus.GetLevel()
+	logrus.SetLevel(logrus.DebugLevel)
+	defer logrus.SetLevel(level)
+	hook := logTest.NewGlobal()
+
+	s := &Service{cfg: &config{p2p: p2ptest.NewTestP2P(t), initialSync: &mockSync.Sync{IsSyncing: false}}}
+	res, err := s.newValidationPipeline(p2p.GossipExitMessage, peer.ID("peer")).run(context.Background(),
+		namedStep(stepDedup, func(context.Context) (pubsub.ValidationResult, error) {
+			return pubsub.ValidationIgnore, nil
+		}),
+	)
+	require.NoError(t, err)
+	assert.Equal(t, pubsub.ValidationIgnore, res)
+	require.LogsContain(t, hook, "verdict=ignore")
+	assert.Equal(t, logrus.DebugLevel, hook.LastEntry().Level)
+}
+
+func TestValidationPipeline_IgnoreWhileSyncing(t *testing.T) {
+	hook := logTest.NewGlobal()
+	s := &Service{cfg: &config{p2p: p2ptest.NewTestP2P(t), initialSync: &mockSync.Sync{IsSyncing: true}}}
+	v := s.singleStepValidation(p2p.GossipBlockMessage, func(context.Context, peer.ID, *pubsub.Message) (pubsub.ValidationResult, error) {
+		return pubsub.ValidationAccept, nil
+	})
+	res, err := v(context.Background(), "", &pubsub.Message{})
+	require.NoError(t, err)
+	assert.Equal(t, pubsub.ValidationAccept, res)
+
+	res, err = s.newValidationPipeline(p2p.GossipBlockMessage, "").run(context.Background(), namedStep(stepSyncing, s.ignoreWhileSyncing))
+	require.NoError(t, err)
+	assert.Equal(t, pubsub.ValidationIgnore, res)
+	// The rejection log is disabled by default.
+	require.LogsDoNotContain(t, hook, "Gossip message did not pass validation")
+}
@@ -24,13 +24,18 @@ func FlagOptions(c *cli.Context) ([]execution.Option, error) {
 		return nil, errors.Wrap(err, "could not read JWT secret file for authenticating execution API")
 	}
 	headers := strings.Split(c.String(flags.ExecutionEngineHeaders.Name), ",")
+	fallbackEndpoints := c.StringSlice(flags.FallbackExecutionEndpoints.Name)
 	opts := []execution.Option{
 		execution.WithHttpEndpoint(endpoint),
+		execution.WithFallbackHttpEndpoints(fallbackEndpoints),
 		execution.WithEth1HeaderRequestLimit(c.Uint64(flags.Eth1HeaderReqLimit.Name)),
 		execution.WithHeaders(headers),
 	}
 	if len(jwtSecret) > 0 {
-		opts = append(opts, execution.WithHttpEndpointAndJWTSecret(endpoint, jwtSecret))
+		opts = append(opts,
+			execution.WithHttpEndpointAndJWTSecret(endpoint, jwtSecret),
+			execution.WithFallbackHttpEndpointsAndJWTSecret(fallbackEndpoints, jwtSecret),
+		)
 	}
 	return opts, nil
 }
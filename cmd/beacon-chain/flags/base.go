@@ -9,10 +9,11 @@ import (
 )
 
 var (
-	// MevRelayEndpoint provides an HTTP access endpoint to a MEV builder network.
+	// MevRelayEndpoint provides HTTP access endpoints to a MEV builder network.
 	MevRelayEndpoint = &cli.StringFlag{
-		Name:  "http-mev-relay",
-		Usage: "A MEV builder relay string http endpoint, this wil be used to interact MEV builder network using API defined in: https://ethereum.github.io/builder-specs/#/Builder",
+		Name: "http-mev-relay",
+		Usage: "A MEV builder relay string http endpoint, this wil be used to interact MEV builder network using API defined in: https://ethereum.github.io/builder-specs/#/Builder. " +
+			"Several relays can be given as a comma separated list, the highest valid bid among them is then used",
 		Value: "",
 	}
 	MaxBuilderConsecutiveMissedSlots = &cli.IntFlag{
@@ -37,6 +38,14 @@ var (
 		Usage: "An execution client http endpoint. Can contain auth header as well in the format",
 		Value: "http://localhost:8551",
 	}
+	// FallbackExecutionEndpoints provides additional HTTP access endpoints of execution clients, to fail over to when
+	// the execution endpoint is unavailable, and to cross check deposit logs and block headers against.
+	FallbackExecutionEndpoints = &cli.StringSliceFlag{
+		Name: "fallback-execution-endpoint",
+		Usage: "An additional execution client http endpoint, to fail over to when the execution endpoint is unavailable. " +
+			"Deposit logs and block headers received from the active endpoint are cross checked against all other endpoints, " +
+			"and any disagreement is reported. The --jwt-secret is used for all endpoints. This flag can be used multiple times.",
+	}
 	// ExecutionEngineHeaders defines a list of HTTP headers to send with all execution client requests.
 	ExecutionEngineHeaders = &cli.StringFlag{
 		Name: "execution-headers",
@@ -261,9 +270,40 @@ var (
 		Usage: "Directory for the slasher database",
 		Value: cmd.DefaultDataDir(),
 	}
+	// BlobStoragePathFlag defines a path on disk where blob sidecars are stored.
+	BlobStoragePathFlag = &cli.StringFlag{
+		Name:  "blob-path",
+		Usage: "Location for blob storage. Default location will be a 'blobs' directory next to the beacon db.",
+	}
+	// BlobRetentionEpoch defines the number of epochs blob sidecars are kept before they are pruned.
 	BlobRetentionEpoch = &cli.Uint64Flag{
 		Name:  "extend-blob-retention-epoch",
 		Usage: "Extend blob retention epoch period to beyond default 4096 epochs (~18 days). The node will error at start if input value is less than 4096 epochs.",
 		Value: uint64(params.BeaconNetworkConfig().MinEpochsForBlobsSidecarsRequest),
 	}
+	// EnableExperimentalBackfill enables fetching the block history before the checkpoint sync origin.
+	EnableExperimentalBackfill = &cli.BoolFlag{
+		Name: "enable-experimental-backfill",
+		Usage: "Backfill is still experimental at this time. " +
+			"It will only be enabled if this flag is specified and the node was started using checkpoint sync.",
+	}
+	// BackfillBatchSize specifies the number of blocks requested from a single peer in one backfill request.
+	BackfillBatchSize = &cli.Uint64Flag{
+		Name:  "backfill-batch-size",
+		Usage: "Number of blocks per backfill batch. A larger number will request more blocks at once from peers.",
+		Value: 64,
+	}
+	// BackfillWorkerCount specifies the number of backfill batches that are requested from peers concurrently.
+	BackfillWorkerCount = &cli.IntFlag{
+		Name:  "backfill-worker-count",
+		Usage: "Number of concurrent backfill batch requests. Each request is sent to a different peer.",
+		Value: 2,
+	}
+	// ForkChoiceTraceFile specifies the file to which the events processed by fork choice are recorded for offline replay.
+	ForkChoiceTraceFile = &cli.StringFlag{
+		Name: "forkchoice-trace-file",
+		Usage: "Records the blocks, votes, checkpoint changes and ticks processed by fork choice to the given file, " +
+			"so that they can be replayed offline with `prysmctl forkchoice replay`. A new file is created on every start, " +
+			"named after the given path with the start time appended.",
+	}
 )
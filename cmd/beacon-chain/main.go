@@ -37,6 +37,7 @@ import (
 var appFlags = []cli.Flag{
 	flags.DepositContractFlag,
 	flags.ExecutionEngineEndpoint,
+	flags.FallbackExecutionEndpoints,
 	flags.ExecutionEngineHeaders,
 	flags.ExecutionJWTSecretFlag,
 	flags.RPCHost,
@@ -76,7 +77,12 @@ var appFlags = []cli.Flag{
 	flags.MaxBuilderConsecutiveMissedSlots,
 	flags.EngineEndpointTimeoutSeconds,
 	flags.LocalBlockValueBoost,
+	flags.BlobStoragePathFlag,
 	flags.BlobRetentionEpoch,
+	flags.EnableExperimentalBackfill,
+	flags.BackfillBatchSize,
+	flags.BackfillWorkerCount,
+	flags.ForkChoiceTraceFile,
 	cmd.BackupWebhookOutputDir,
 	cmd.MinimalConfigFlag,
 	cmd.E2EConfigFlag,
@@ -129,6 +135,7 @@ var appFlags = []cli.Flag{
 	cmd.ApiTimeoutFlag,
 	checkpoint.BlockPath,
 	checkpoint.StatePath,
+	checkpoint.DepositSnapshotPath,
 	checkpoint.RemoteURL,
 	genesis.StatePath,
 	genesis.BeaconAPIURL,
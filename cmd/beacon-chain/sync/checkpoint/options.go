@@ -22,6 +22,13 @@ var (
 		Usage: "Rather than syncing from genesis, you can start processing from a ssz-serialized BeaconState+Block." +
 			" This flag allows you to specify a local file containing the checkpoint Block to load.",
 	}
+	// DepositSnapshotPath optionally provides the EIP-4881 deposit snapshot along with StatePath and BlockPath.
+	DepositSnapshotPath = &cli.PathFlag{
+		Name: "checkpoint-deposit-snapshot",
+		Usage: "Rather than processing all deposit logs, you can start processing them from a json encoded deposit snapshot " +
+			"when starting from a checkpoint BeaconState+Block. This flag allows you to specify a local file containing " +
+			"the deposit snapshot to load.",
+	}
 	RemoteURL = &cli.StringFlag{
 		Name: "checkpoint-sync-url",
 		Usage: "URL of a synced beacon node to trust in obtaining checkpoint sync data. " +
@@ -36,6 +43,7 @@ var (
 func BeaconNodeOptions(c *cli.Context) (node.Option, error) {
 	blockPath := c.Path(BlockPath.Name)
 	statePath := c.Path(StatePath.Name)
+	depositSnapshotPath := c.Path(DepositSnapshotPath.Name)
 	remoteURL := c.String(RemoteURL.Name)
 	if remoteURL != "" {
 		return func(node *node.BeaconNode) error {
@@ -49,6 +57,9 @@ func BeaconNodeOptions(c *cli.Context) (node.Option, error) {
 	}
 
 	if blockPath == "" && statePath == "" {
+		if depositSnapshotPath != "" {
+			return nil, fmt.Errorf("--checkpoint-deposit-snapshot specified, but not --checkpoint-state and --checkpoint-block")
+		}
 		return nil, nil
 	}
 	if blockPath != "" && statePath == "" {
@@ -59,7 +70,7 @@ func BeaconNodeOptions(c *cli.Context) (node.Option, error) {
 	}
 
 	return func(node *node.BeaconNode) (err error) {
-		node.CheckpointInitializer, err = checkpoint.NewFileInitializer(blockPath, statePath)
+		node.CheckpointInitializer, err = checkpoint.NewFileInitializer(blockPath, statePath, depositSnapshotPath)
 		if err != nil {
 			return errors.Wrap(err, "error preparing to initialize checkpoint from local ssz files")
 		}
@@ -106,6 +106,7 @@ var appHelpFlagGroups = []flagGroup{
 			flags.GRPCGatewayPort,
 			flags.GPRCGatewayCorsDomain,
 			flags.ExecutionEngineEndpoint,
+			flags.FallbackExecutionEndpoints,
 			flags.ExecutionEngineHeaders,
 			flags.ExecutionJWTSecretFlag,
 			flags.SetGCPercent,
@@ -128,9 +129,15 @@ var appHelpFlagGroups = []flagGroup{
 			flags.EngineEndpointTimeoutSeconds,
 			flags.SlasherDirFlag,
 			flags.LocalBlockValueBoost,
+			flags.BlobStoragePathFlag,
 			flags.BlobRetentionEpoch,
+			flags.EnableExperimentalBackfill,
+			flags.BackfillBatchSize,
+			flags.BackfillWorkerCount,
+			flags.ForkChoiceTraceFile,
 			checkpoint.BlockPath,
 			checkpoint.StatePath,
+			checkpoint.DepositSnapshotPath,
 			checkpoint.RemoteURL,
 			genesis.StatePath,
 			genesis.BeaconAPIURL,
@@ -19,7 +19,7 @@ var downloadFlags = struct {
 var downloadCmd = &cli.Command{
 	Name:    "download",
 	Aliases: []string{"dl"},
-	Usage:   "Download the latest finalized state and the most recent block it integrates. To be used for checkpoint sync.",
+	Usage:   "Download the latest finalized state, the most recent block it integrates and the deposit snapshot if available. To be used for checkpoint sync.",
 	Action: func(cliCtx *cli.Context) error {
 		if err := cliActionDownload(cliCtx); err != nil {
 			log.WithError(err).Fatal("Could not download checkpoint-sync data")
@@ -74,5 +74,13 @@ func cliActionDownload(_ *cli.Context) error {
 	}
 	log.Printf("saved ssz-encoded state to %s", statePath)
 
+	if od.DepositSnapshot() != nil {
+		snapshotPath, err := od.SaveDepositSnapshot(cwd)
+		if err != nil {
+			return err
+		}
+		log.Printf("saved json-encoded deposit snapshot to %s", snapshotPath)
+	}
+
 	return nil
 }
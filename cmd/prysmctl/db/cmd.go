@@ -9,6 +9,9 @@ var Commands = []*cli.Command{
 		Subcommands: []*cli.Command{
 			queryCmd,
 			bucketsCmd,
+			convertStateDiffsCmd,
+			exportEraCmd,
+			importEraCmd,
 		},
 	},
 }
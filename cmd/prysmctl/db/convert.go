@@ -0,0 +1,49 @@
+package db
+
+import (
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/db/kv"
+	log "github.com/sirupsen/logrus"
+	"github.com/urfave/cli/v2"
+)
+
+var convertStateDiffsFlags = struct {
+	Path string
+}{}
+
+var convertStateDiffsCmd = &cli.Command{
+	Name:  "convert-state-diffs",
+	Usage: "enables historical state diffs for an existing beacon db and converts its archived states",
+	Action: func(cliCtx *cli.Context) error {
+		if err := convertStateDiffsAction(cliCtx); err != nil {
+			log.WithError(err).Fatal("Could not convert db to state diffs")
+		}
+		return nil
+	},
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:        "path",
+			Usage:       "path to directory containing beaconchain.db",
+			Destination: &convertStateDiffsFlags.Path,
+			Required:    true,
+		},
+	},
+}
+
+func convertStateDiffsAction(cliCtx *cli.Context) error {
+	d, err := kv.NewKVStore(cliCtx.Context, convertStateDiffsFlags.Path)
+	if err != nil {
+		return errors.Wrap(err, "could not open db")
+	}
+	defer func() {
+		if err := d.Close(); err != nil {
+			log.WithError(err).Error("Could not close db")
+		}
+	}()
+	n, err := d.ConvertToStateDiffs(cliCtx.Context)
+	if err != nil {
+		return err
+	}
+	log.WithField("states", n).WithField("interval", d.StateDiffInterval()).Info("Converted archived states to state diffs")
+	return nil
+}
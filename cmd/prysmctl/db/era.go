@@ -0,0 +1,162 @@
+package db
+
+import (
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/db/era"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/db/kv"
+	doublylinkedtree "github.com/prysmaticlabs/prysm/v4/beacon-chain/forkchoice/doubly-linked-tree"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/state/stategen"
+	"github.com/prysmaticlabs/prysm/v4/config/params"
+	log "github.com/sirupsen/logrus"
+	"github.com/urfave/cli/v2"
+)
+
+var eraFlags = struct {
+	Path            string
+	EraDir          string
+	StartEra        uint64
+	EndEra          uint64
+	ChainConfigFile string
+	ConfigName      string
+}{}
+
+var (
+	eraPathFlag = &cli.StringFlag{
+		Name:        "path",
+		Usage:       "path to directory containing beaconchain.db",
+		Destination: &eraFlags.Path,
+		Required:    true,
+	}
+	eraDirFlag = &cli.StringFlag{
+		Name:        "era-dir",
+		Usage:       "directory of the era files",
+		Destination: &eraFlags.EraDir,
+		Required:    true,
+	}
+	eraChainConfigFileFlag = &cli.StringFlag{
+		Name:        "chain-config-file",
+		Usage:       "The path to a YAML file with chain config values",
+		Destination: &eraFlags.ChainConfigFile,
+	}
+	eraConfigNameFlag = &cli.StringFlag{
+		Name:        "config-name",
+		Usage:       "Config kind of the network of the era files. Default: mainnet. Options include mainnet, minimal, prater, sepolia. --chain-config-file will override this flag.",
+		Destination: &eraFlags.ConfigName,
+		Value:       params.MainnetName,
+	}
+)
+
+var exportEraCmd = &cli.Command{
+	Name:  "export-era",
+	Usage: "writes the finalized history of the beacon db to era files",
+	Action: func(cliCtx *cli.Context) error {
+		if err := exportEraAction(cliCtx); err != nil {
+			log.WithError(err).Fatal("Could not export era files")
+		}
+		return nil
+	},
+	Flags: []cli.Flag{
+		eraPathFlag,
+		eraDirFlag,
+		&cli.Uint64Flag{
+			Name:        "start-era",
+			Usage:       "first era to export",
+			Destination: &eraFlags.StartEra,
+		},
+		&cli.Uint64Flag{
+			Name:        "end-era",
+			Usage:       "last era to export, defaults to the last finalized era",
+			Destination: &eraFlags.EndEra,
+		},
+		eraChainConfigFileFlag,
+		eraConfigNameFlag,
+	},
+}
+
+var importEraCmd = &cli.Command{
+	Name:  "import-era",
+	Usage: "seeds a new beacon db with the finalized history of era files",
+	Action: func(cliCtx *cli.Context) error {
+		if err := importEraAction(cliCtx); err != nil {
+			log.WithError(err).Fatal("Could not import era files")
+		}
+		return nil
+	},
+	Flags: []cli.Flag{
+		eraPathFlag,
+		eraDirFlag,
+		eraChainConfigFileFlag,
+		eraConfigNameFlag,
+	},
+}
+
+func setEraParams() error {
+	if eraFlags.ChainConfigFile != "" {
+		log.Infof("Specified a chain config file: %s", eraFlags.ChainConfigFile)
+		return params.LoadChainConfigFile(eraFlags.ChainConfigFile, nil)
+	}
+	cfg, err := params.ByName(eraFlags.ConfigName)
+	if err != nil {
+		return fmt.Errorf("unable to find config using name %s: %v", eraFlags.ConfigName, err)
+	}
+	return params.SetActive(cfg.Copy())
+}
+
+func exportEraAction(cliCtx *cli.Context) error {
+	if err := setEraParams(); err != nil {
+		return err
+	}
+	d, err := kv.NewKVStore(cliCtx.Context, eraFlags.Path)
+	if err != nil {
+		return errors.Wrap(err, "could not open db")
+	}
+	defer func() {
+		if err := d.Close(); err != nil {
+			log.WithError(err).Error("Could not close db")
+		}
+	}()
+	e := era.NewExporter(d, stategen.New(d, doublylinkedtree.New()))
+	end, err := e.LastFinalizedEra(cliCtx.Context)
+	if err != nil {
+		return err
+	}
+	if cliCtx.IsSet("end-era") {
+		if eraFlags.EndEra > end {
+			return fmt.Errorf("end era %d is not finalized, the last finalized era is %d", eraFlags.EndEra, end)
+		}
+		end = eraFlags.EndEra
+	}
+	for i := eraFlags.StartEra; i <= end; i++ {
+		if _, err := e.Export(cliCtx.Context, i, eraFlags.EraDir); err != nil {
+			return errors.Wrapf(err, "could not export era %d", i)
+		}
+	}
+	return nil
+}
+
+func importEraAction(cliCtx *cli.Context) error {
+	if err := setEraParams(); err != nil {
+		return err
+	}
+	d, err := kv.NewKVStore(cliCtx.Context, eraFlags.Path)
+	if err != nil {
+		return errors.Wrap(err, "could not open db")
+	}
+	defer func() {
+		if err := d.Close(); err != nil {
+			log.WithError(err).Error("Could not close db")
+		}
+	}()
+	// Era files of networks with an embedded genesis state do not need to include the genesis era.
+	if err := d.EnsureEmbeddedGenesis(cliCtx.Context); err != nil {
+		return errors.Wrap(err, "could not save embedded genesis state")
+	}
+	last, err := era.Import(cliCtx.Context, d, eraFlags.EraDir)
+	if err != nil {
+		return err
+	}
+	log.WithField("era", last).Info("Imported era files, the beacon node can now be started with this db")
+	return nil
+}
@@ -0,0 +1,13 @@
+package forkchoice
+
+import "github.com/urfave/cli/v2"
+
+var Commands = []*cli.Command{
+	{
+		Name:  "forkchoice",
+		Usage: "commands to debug fork choice",
+		Subcommands: []*cli.Command{
+			replayCmd,
+		},
+	},
+}
@@ -0,0 +1,142 @@
+package forkchoice
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/pkg/errors"
+	doublylinkedtree "github.com/prysmaticlabs/prysm/v4/beacon-chain/forkchoice/doubly-linked-tree"
+	"github.com/prysmaticlabs/prysm/v4/config/params"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	log "github.com/sirupsen/logrus"
+	"github.com/urfave/cli/v2"
+)
+
+var replayFlags = struct {
+	TracePath                       string
+	ChainConfigFile                 string
+	ProposerScoreBoost              uint64
+	ReorgWeightThreshold            uint64
+	ReorgParentWeightThreshold      uint64
+	ReorgMaxEpochsSinceFinalization uint64
+}{}
+
+var replayCmd = &cli.Command{
+	Name:  "replay",
+	Usage: "replays a fork choice trace recorded with --forkchoice-trace-file, and reports the head at each slot",
+	Action: func(cliCtx *cli.Context) error {
+		if err := replayAction(cliCtx); err != nil {
+			log.WithError(err).Fatal("Could not replay fork choice trace")
+		}
+		return nil
+	},
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:        "trace",
+			Usage:       "path to the fork choice trace",
+			Destination: &replayFlags.TracePath,
+			Required:    true,
+		},
+		&cli.StringFlag{
+			Name:        "chain-config-file",
+			Usage:       "The path to a YAML file with chain config values, needed when the trace wasn't recorded on a known network",
+			Destination: &replayFlags.ChainConfigFile,
+		},
+		&cli.Uint64Flag{
+			Name:        "proposer-score-boost",
+			Usage:       "overrides PROPOSER_SCORE_BOOST, a % of the committee weight given to timely blocks",
+			Destination: &replayFlags.ProposerScoreBoost,
+		},
+		&cli.Uint64Flag{
+			Name:        "reorg-weight-threshold",
+			Usage:       "overrides REORG_WEIGHT_THRESHOLD, a % of the committee weight below which a late head may be orphaned",
+			Destination: &replayFlags.ReorgWeightThreshold,
+		},
+		&cli.Uint64Flag{
+			Name:        "reorg-parent-weight-threshold",
+			Usage:       "overrides REORG_PARENT_WEIGHT_THRESHOLD, a % of the committee weight the parent of an orphaned head must have",
+			Destination: &replayFlags.ReorgParentWeightThreshold,
+		},
+		&cli.Uint64Flag{
+			Name:        "reorg-max-epochs-since-finalization",
+			Usage:       "overrides REORG_MAX_EPOCHS_SINCE_FINALIZATION, late heads are not orphaned when the chain hasn't finalized for longer",
+			Destination: &replayFlags.ReorgMaxEpochsSinceFinalization,
+		},
+	},
+}
+
+// setReplayParams activates the config the trace was recorded with, with the fork choice parameters
+// overridden by the flags.
+func setReplayParams(cliCtx *cli.Context, configName string) error {
+	var cfg *params.BeaconChainConfig
+	if replayFlags.ChainConfigFile != "" {
+		log.Infof("Specified a chain config file: %s", replayFlags.ChainConfigFile)
+		c, err := params.UnmarshalConfigFile(replayFlags.ChainConfigFile, nil)
+		if err != nil {
+			return err
+		}
+		cfg = c
+	} else {
+		c, err := params.ByName(configName)
+		if err != nil {
+			return fmt.Errorf("unable to find config using name %s, use --chain-config-file: %v", configName, err)
+		}
+		cfg = c.Copy()
+	}
+	if cliCtx.IsSet("proposer-score-boost") {
+		cfg.ProposerScoreBoost = replayFlags.ProposerScoreBoost
+	}
+	if cliCtx.IsSet("reorg-weight-threshold") {
+		cfg.ReorgWeightThreshold = replayFlags.ReorgWeightThreshold
+	}
+	if cliCtx.IsSet("reorg-parent-weight-threshold") {
+		cfg.ReorgParentWeightThreshold = replayFlags.ReorgParentWeightThreshold
+	}
+	if cliCtx.IsSet("reorg-max-epochs-since-finalization") {
+		cfg.ReorgMaxEpochsSinceFinalization = primitives.Epoch(replayFlags.ReorgMaxEpochsSinceFinalization)
+	}
+	return params.SetActive(cfg)
+}
+
+func replayAction(cliCtx *cli.Context) error {
+	f, err := os.Open(replayFlags.TracePath)
+	if err != nil {
+		return errors.Wrap(err, "could not open trace")
+	}
+	defer func() {
+		if err := f.Close(); err != nil {
+			log.WithError(err).Error("Could not close trace")
+		}
+	}()
+	tr, err := doublylinkedtree.NewTraceReader(f)
+	if err != nil {
+		return err
+	}
+	if err := setReplayParams(cliCtx, tr.ConfigName()); err != nil {
+		return err
+	}
+	res, err := doublylinkedtree.Replay(cliCtx.Context, tr)
+	if err != nil {
+		return err
+	}
+
+	changedHeads := 0
+	for _, tick := range res.Ticks {
+		if tick.Head == tick.RecordedHead {
+			fmt.Printf("slot %d\thead %#x\n", tick.Slot, tick.Head)
+			continue
+		}
+		changedHeads++
+		fmt.Printf("slot %d\thead %#x\trecorded head %#x\n", tick.Slot, tick.Head, tick.RecordedHead)
+	}
+	for _, d := range res.Diffs {
+		fmt.Printf("slot %d\t%s differs at %s: recorded %s, replayed %s\n", d.Slot, d.Kind, d.Time.UTC().Format("15:04:05.000"), d.Recorded, d.Replayed)
+	}
+	log.WithFields(log.Fields{
+		"events":       res.Events,
+		"slots":        len(res.Ticks),
+		"changedHeads": changedHeads,
+		"differences":  len(res.Diffs),
+	}).Info("Replayed fork choice trace")
+	return nil
+}
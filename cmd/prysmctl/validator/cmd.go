@@ -156,6 +156,10 @@ var Commands = []*cli.Command{
 					flags.BeaconRPCProviderFlag,
 					flags.Web3SignerURLFlag,
 					flags.Web3SignerPublicValidatorKeysFlag,
+					flags.Web3SignerFallbackURLsFlag,
+					flags.Web3SignerTLSCACertFlag,
+					flags.Web3SignerTLSClientCertFlag,
+					flags.Web3SignerTLSClientKeyFlag,
 					flags.InteropNumValidators,
 					flags.InteropStartIndex,
 					cmd.GrpcMaxCallRecvMsgSizeFlag,
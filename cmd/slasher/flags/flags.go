@@ -0,0 +1,21 @@
+// Package flags contains all configuration runtime flags for
+// the standalone slasher.
+package flags
+
+import "github.com/urfave/cli/v2"
+
+var (
+	// BeaconNodesFlag defines the Beacon API endpoints of the beacon nodes followed by the slasher.
+	BeaconNodesFlag = &cli.StringSliceFlag{
+		Name: "beacon-rest-api-provider",
+		Usage: "Beacon API endpoint of a beacon node to follow, eg http://localhost:3500. " +
+			"Can be given multiple times, slashings are then submitted to every beacon node.",
+		Value: cli.NewStringSlice("http://127.0.0.1:3500"),
+	}
+	// MonitoringPortFlag defines the http port used to serve prometheus metrics.
+	MonitoringPortFlag = &cli.IntFlag{
+		Name:  "monitoring-port",
+		Usage: "Port used to listening and respond metrics for prometheus.",
+		Value: 8082,
+	}
+)
@@ -0,0 +1,144 @@
+// Package main defines a standalone slasher, following the blocks and attestations
+// seen by one or more beacon nodes through the Beacon API and submitting the
+// slashings it detects back to them.
+package main
+
+import (
+	"fmt"
+	"os"
+	runtimeDebug "runtime/debug"
+
+	joonix "github.com/joonix/log"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/slasher/node"
+	"github.com/prysmaticlabs/prysm/v4/cmd"
+	"github.com/prysmaticlabs/prysm/v4/cmd/slasher/flags"
+	"github.com/prysmaticlabs/prysm/v4/config/features"
+	"github.com/prysmaticlabs/prysm/v4/io/logs"
+	"github.com/prysmaticlabs/prysm/v4/monitoring/journald"
+	"github.com/prysmaticlabs/prysm/v4/runtime/debug"
+	prefixed "github.com/prysmaticlabs/prysm/v4/runtime/logging/logrus-prefixed-formatter"
+	"github.com/prysmaticlabs/prysm/v4/runtime/version"
+	"github.com/sirupsen/logrus"
+	"github.com/urfave/cli/v2"
+)
+
+var appFlags = []cli.Flag{
+	flags.BeaconNodesFlag,
+	flags.MonitoringPortFlag,
+	cmd.MinimalConfigFlag,
+	cmd.E2EConfigFlag,
+	cmd.VerbosityFlag,
+	cmd.DataDirFlag,
+	cmd.ClearDB,
+	cmd.ForceClearDB,
+	cmd.ChainConfigFileFlag,
+	cmd.ConfigFileFlag,
+	cmd.LogFormat,
+	cmd.LogFileName,
+	cmd.MonitoringHostFlag,
+	cmd.DisableMonitoringFlag,
+	debug.PProfFlag,
+	debug.PProfAddrFlag,
+	debug.PProfPortFlag,
+	debug.MemProfileRateFlag,
+	debug.CPUProfileFlag,
+	debug.TraceFlag,
+	debug.BlockProfileRateFlag,
+	debug.MutexProfileFractionFlag,
+}
+
+func init() {
+	appFlags = cmd.WrapFlags(append(appFlags, features.NetworkFlags...))
+}
+
+func main() {
+	app := cli.App{}
+	app.Name = "slasher"
+	app.Usage = "runs a slasher on its own, detecting slashable offenses in the blocks and attestations seen by beacon nodes"
+	app.Version = version.Version()
+	app.Action = func(ctx *cli.Context) error {
+		if err := startNode(ctx); err != nil {
+			return cli.Exit(err.Error(), 1)
+		}
+		return nil
+	}
+
+	app.Flags = appFlags
+
+	app.Before = func(ctx *cli.Context) error {
+		// Load flags from config file, if specified.
+		if err := cmd.LoadFlagsFromConfig(ctx, app.Flags); err != nil {
+			return err
+		}
+
+		verbosity := ctx.String(cmd.VerbosityFlag.Name)
+		level, err := logrus.ParseLevel(verbosity)
+		if err != nil {
+			return err
+		}
+		logrus.SetLevel(level)
+
+		format := ctx.String(cmd.LogFormat.Name)
+		switch format {
+		case "text":
+			formatter := new(prefixed.TextFormatter)
+			formatter.TimestampFormat = "2006-01-02 15:04:05"
+			formatter.FullTimestamp = true
+			// If persistent log files are written - we disable the log messages coloring because
+			// the colors are ANSI codes and seen as gibberish in the log files.
+			formatter.DisableColors = ctx.String(cmd.LogFileName.Name) != ""
+			logrus.SetFormatter(formatter)
+		case "fluentd":
+			f := joonix.NewFormatter()
+			if err := joonix.DisableTimestampFormat(f); err != nil {
+				panic(err)
+			}
+			logrus.SetFormatter(f)
+		case "json":
+			logrus.SetFormatter(&logrus.JSONFormatter{})
+		case "journald":
+			if err := journald.Enable(); err != nil {
+				return err
+			}
+		default:
+			return fmt.Errorf("unknown log format %s", format)
+		}
+
+		logFileName := ctx.String(cmd.LogFileName.Name)
+		if logFileName != "" {
+			if err := logs.ConfigurePersistentLogging(logFileName); err != nil {
+				log.WithError(err).Error("Failed to configuring logging to disk.")
+			}
+		}
+
+		if err := debug.Setup(ctx); err != nil {
+			return err
+		}
+		return cmd.ValidateNoArgs(ctx)
+	}
+
+	app.After = func(ctx *cli.Context) error {
+		debug.Exit(ctx)
+		return nil
+	}
+
+	defer func() {
+		if x := recover(); x != nil {
+			log.Errorf("Runtime panic: %v\n%v", x, string(runtimeDebug.Stack()))
+			panic(x)
+		}
+	}()
+
+	if err := app.Run(os.Args); err != nil {
+		log.Error(err.Error())
+	}
+}
+
+func startNode(ctx *cli.Context) error {
+	slasherNode, err := node.New(ctx)
+	if err != nil {
+		return err
+	}
+	slasherNode.Start()
+	return nil
+}
@@ -64,6 +64,34 @@ var Commands = &cli.Command{
 						return nil
 					},
 				},
+				{
+					Name:  "minimal",
+					Usage: "Converts the slashing protection history to the minimal schema, keeping only the highest signed epochs and slot of each key",
+					Flags: cmd.WrapFlags([]cli.Flag{
+						cmd.DataDirFlag,
+					}),
+					Before: tos.VerifyTosAcceptedOrPrompt,
+					Action: func(cliCtx *cli.Context) error {
+						if err := validatordb.MigrateToMinimal(cliCtx); err != nil {
+							log.WithError(err).Fatal("Could not migrate slashing protection history")
+						}
+						return nil
+					},
+				},
+				{
+					Name:  "complete",
+					Usage: "Converts the slashing protection history from the minimal schema back to the complete schema",
+					Flags: cmd.WrapFlags([]cli.Flag{
+						cmd.DataDirFlag,
+					}),
+					Before: tos.VerifyTosAcceptedOrPrompt,
+					Action: func(cliCtx *cli.Context) error {
+						if err := validatordb.MigrateToComplete(cliCtx); err != nil {
+							log.WithError(err).Fatal("Could not migrate slashing protection history")
+						}
+						return nil
+					},
+				},
 			},
 		},
 	},
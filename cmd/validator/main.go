@@ -70,10 +70,18 @@ var appFlags = []cli.Flag{
 	flags.WalletPasswordFileFlag,
 	flags.WalletDirFlag,
 	flags.EnableWebFlag,
+	flags.SlashingProtectionDBURLFlag,
 	flags.GraffitiFileFlag,
 	// Consensys' Web3Signer flags
 	flags.Web3SignerURLFlag,
 	flags.Web3SignerPublicValidatorKeysFlag,
+	flags.Web3SignerPublicKeysPollIntervalFlag,
+	flags.Web3SignerFallbackURLsFlag,
+	flags.Web3SignerTLSCACertFlag,
+	flags.Web3SignerTLSClientCertFlag,
+	flags.Web3SignerTLSClientKeyFlag,
+	flags.ThresholdSignerConfigFlag,
+	flags.SecretStoreConfigFlag,
 	flags.SuggestedFeeRecipientFlag,
 	flags.ProposerSettingsURLFlag,
 	flags.ProposerSettingsFlag,
@@ -89,6 +89,7 @@ var appHelpFlagGroups = []flagGroup{
 			flags.BeaconRESTApiProviderFlag,
 			flags.CertFlag,
 			flags.EnableWebFlag,
+			flags.SlashingProtectionDBURLFlag,
 			flags.DisablePenaltyRewardLogFlag,
 			flags.GraffitiFlag,
 			flags.EnableRPCFlag,
@@ -108,6 +109,13 @@ var appHelpFlagGroups = []flagGroup{
 			flags.GraffitiFileFlag,
 			flags.Web3SignerURLFlag,
 			flags.Web3SignerPublicValidatorKeysFlag,
+			flags.Web3SignerPublicKeysPollIntervalFlag,
+			flags.Web3SignerFallbackURLsFlag,
+			flags.Web3SignerTLSCACertFlag,
+			flags.Web3SignerTLSClientCertFlag,
+			flags.Web3SignerTLSClientKeyFlag,
+			flags.ThresholdSignerConfigFlag,
+			flags.SecretStoreConfigFlag,
 			flags.ProposerSettingsFlag,
 			flags.ProposerSettingsURLFlag,
 			flags.SuggestedFeeRecipientFlag,
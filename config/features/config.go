@@ -44,10 +44,13 @@ type Flags struct {
 	WriteWalletPasswordOnWebOnboarding  bool // WriteWalletPasswordOnWebOnboarding writes the password to disk after Prysm web signup.
 	EnableDoppelGanger                  bool // EnableDoppelGanger enables doppelganger protection on startup for the validator.
 	EnableHistoricalSpaceRepresentation bool // EnableHistoricalSpaceRepresentation enables the saving of registry validators in separate buckets to save space
+	EnableHistoricalStateDiffs          bool // EnableHistoricalStateDiffs stores historical states as snapshots and diffs, when creating a new database.
+	EnableLightClient                   bool // EnableLightClient enables the light client server, which computes and serves light client updates.
 	EnableBeaconRESTApi                 bool // EnableBeaconRESTApi enables experimental usage of the beacon REST API by the validator when querying a beacon node
 	// Logging related toggles.
 	DisableGRPCConnectionLogs bool // Disables logging when a new grpc client has connected.
 	EnableFullSSZDataLogging  bool // Enables logging for full ssz data on rejected gossip messages
+	EnableGossipRejectionLog  bool // Enables a structured log of the gossip validation step rejecting or ignoring each message.
 
 	// Slasher toggles.
 	DisableBroadcastSlashings bool // DisableBroadcastSlashings disables p2p broadcasting of proposer and attester slashings.
@@ -57,6 +60,7 @@ type Flags struct {
 
 	EnableSlasher                   bool // Enable slasher in the beacon node runtime.
 	EnableSlashingProtectionPruning bool // EnableSlashingProtectionPruning for the validator client.
+	EnableMinimalSlashingProtection bool // EnableMinimalSlashingProtection creates new validator databases with the minimal slashing protection schema.
 
 	SaveFullExecutionPayloads bool // Save full beacon blocks with execution payloads in the database.
 	EnableStartOptimistic     bool // EnableStartOptimistic treats every block as optimistic at startup.
@@ -72,6 +76,8 @@ type Flags struct {
 
 	AggregateParallel bool // AggregateParallel aggregates attestations in parallel.
 
+	EnableRewardAwareAttestationPacking bool // EnableRewardAwareAttestationPacking packs block attestations by their marginal proposer reward.
+
 	// KeystoreImportDebounceInterval specifies the time duration the validator waits to reload new keys if they have
 	// changed on disk. This feature is for advanced use cases only.
 	KeystoreImportDebounceInterval time.Duration
@@ -213,6 +219,14 @@ func ConfigureBeaconChain(ctx *cli.Context) error {
 		log.WithField(enableHistoricalSpaceRepresentation.Name, enableHistoricalSpaceRepresentation.Usage).Warn(enabledFeatureFlag)
 		cfg.EnableHistoricalSpaceRepresentation = true
 	}
+	if ctx.Bool(enableHistoricalStateDiffs.Name) {
+		logEnabled(enableHistoricalStateDiffs)
+		cfg.EnableHistoricalStateDiffs = true
+	}
+	if ctx.Bool(enableLightClient.Name) {
+		logEnabled(enableLightClient)
+		cfg.EnableLightClient = true
+	}
 	if ctx.Bool(disableStakinContractCheck.Name) {
 		logEnabled(disableStakinContractCheck)
 		cfg.DisableStakinContractCheck = true
@@ -229,6 +243,10 @@ func ConfigureBeaconChain(ctx *cli.Context) error {
 		logEnabled(enableFullSSZDataLogging)
 		cfg.EnableFullSSZDataLogging = true
 	}
+	if ctx.IsSet(enableGossipRejectionLog.Name) {
+		logEnabled(enableGossipRejectionLog)
+		cfg.EnableGossipRejectionLog = true
+	}
 	if ctx.IsSet(enableVerboseSigVerification.Name) {
 		logEnabled(enableVerboseSigVerification)
 		cfg.EnableVerboseSigVerification = true
@@ -247,6 +265,10 @@ func ConfigureBeaconChain(ctx *cli.Context) error {
 		logEnabled(disableAggregateParallel)
 		cfg.AggregateParallel = false
 	}
+	if ctx.Bool(enableRewardAwareAttestationPacking.Name) {
+		logEnabled(enableRewardAwareAttestationPacking)
+		cfg.EnableRewardAwareAttestationPacking = true
+	}
 	if ctx.IsSet(disableResourceManager.Name) {
 		logEnabled(disableResourceManager)
 		cfg.DisableResourceManager = true
@@ -280,6 +302,10 @@ func ConfigureValidator(ctx *cli.Context) error {
 		logEnabled(enableSlashingProtectionPruning)
 		cfg.EnableSlashingProtectionPruning = true
 	}
+	if ctx.Bool(enableMinimalSlashingProtection.Name) {
+		logEnabled(enableMinimalSlashingProtection)
+		cfg.EnableMinimalSlashingProtection = true
+	}
 	if ctx.Bool(enableDoppelGangerProtection.Name) {
 		logEnabled(enableDoppelGangerProtection)
 		cfg.EnableDoppelGanger = true
@@ -293,6 +319,17 @@ func ConfigureValidator(ctx *cli.Context) error {
 	return nil
 }
 
+// ConfigureSlasher sets the global config based
+// on what flags are enabled for the standalone slasher.
+func ConfigureSlasher(ctx *cli.Context) error {
+	complainOnDeprecatedFlags(ctx)
+	if err := configureTestnet(ctx); err != nil {
+		return err
+	}
+	Init(&Flags{})
+	return nil
+}
+
 // enableDevModeFlags switches development mode features on.
 func enableDevModeFlags(ctx *cli.Context) {
 	log.Warn("Enabling development mode flags")
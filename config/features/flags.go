@@ -98,6 +98,12 @@ var (
 		Name:  "enable-slashing-protection-history-pruning",
 		Usage: "Enables the pruning of the validator client's slashing protection database",
 	}
+	enableMinimalSlashingProtection = &cli.BoolFlag{
+		Name: "enable-minimal-slashing-protection",
+		Usage: "Creates new slashing protection databases with the minimal schema, which only keeps the highest " +
+			"signed source and target epochs and the highest proposed slot of each key (EIP-3076 low watermarks). " +
+			"Existing databases must be converted with `validator db migrate minimal`.",
+	}
 	enableDoppelGangerProtection = &cli.BoolFlag{
 		Name: "enable-doppelganger",
 		Usage: "Enables the validator to perform a doppelganger check. (Warning): This is not " +
@@ -114,6 +120,17 @@ var (
 			" (Warning): Once enabled, this feature migrates your database in to a new schema and " +
 			"there is no going back. At worst, your entire database might get corrupted.",
 	}
+	enableHistoricalStateDiffs = &cli.BoolFlag{
+		Name: "enable-historical-state-diffs",
+		Usage: "Stores historical states as full snapshots at sparse intervals and compact diffs in between, " +
+			"so that any archived state can be rebuilt without replaying blocks. Can only be set when creating " +
+			"a new database. Use `prysmctl db convert-state-diffs` to convert an existing database.",
+	}
+	enableLightClient = &cli.BoolFlag{
+		Name: "enable-lightclient",
+		Usage: "Enables the light client server. The beacon node keeps the best light client update of every " +
+			"sync committee period and serves light client data over the Beacon API, req/resp and gossip.",
+	}
 	enableStartupOptimistic = &cli.BoolFlag{
 		Name:   "startup-optimistic",
 		Usage:  "Treats every block as optimistically synced at launch. Use with caution",
@@ -124,6 +141,10 @@ var (
 		Name:  "enable-full-ssz-data-logging",
 		Usage: "Enables displaying logs for full ssz data on rejected gossip messages",
 	}
+	enableGossipRejectionLog = &cli.BoolFlag{
+		Name:  "enable-gossip-rejection-log",
+		Usage: "Logs the validation step, peer and error of every gossip message which is rejected or ignored",
+	}
 	SaveFullExecutionPayloads = &cli.BoolFlag{
 		Name:  "save-full-execution-payloads",
 		Usage: "Saves beacon blocks with full execution payloads instead of execution payload headers in the database",
@@ -163,6 +184,11 @@ var (
 		Name:  "disable-aggregate-parallel",
 		Usage: "Disables parallel aggregation of attestations",
 	}
+	enableRewardAwareAttestationPacking = &cli.BoolFlag{
+		Name: "enable-reward-aware-attestation-packing",
+		Usage: "Packs the attestations of proposed blocks to maximize the proposer reward, by selecting the aggregates " +
+			"which set the most valuable participation flags not yet set in the head state",
+	}
 )
 
 // devModeFlags holds list of flags that are set when development mode is on.
@@ -182,6 +208,7 @@ var ValidatorFlags = append(deprecatedFlags, []cli.Flag{
 	dynamicKeyReloadDebounceInterval,
 	attestTimely,
 	enableSlashingProtectionPruning,
+	enableMinimalSlashingProtection,
 	enableDoppelGangerProtection,
 	EnableBeaconRESTApi,
 }...)
@@ -205,11 +232,14 @@ var BeaconChainFlags = append(deprecatedBeaconFlags, append(deprecatedFlags, []c
 	disableBroadcastSlashingFlag,
 	enableSlasherFlag,
 	enableHistoricalSpaceRepresentation,
+	enableHistoricalStateDiffs,
+	enableLightClient,
 	disableStakinContractCheck,
 	disableReorgLateBlocks,
 	SaveFullExecutionPayloads,
 	enableStartupOptimistic,
 	enableFullSSZDataLogging,
+	enableGossipRejectionLog,
 	enableVerboseSigVerification,
 	disableOptionalEngineMethods,
 	prepareAllPayloads,
@@ -220,6 +250,7 @@ var BeaconChainFlags = append(deprecatedBeaconFlags, append(deprecatedFlags, []c
 	disableResourceManager,
 	DisableRegistrationCache,
 	disableAggregateParallel,
+	enableRewardAwareAttestationPacking,
 }...)...)
 
 // E2EBeaconChainFlags contains a list of the beacon chain feature flags to be tested in E2E.
@@ -24,6 +24,7 @@ type NetworkConfig struct {
 	MinEpochsForBlobsSidecarsRequest primitives.Epoch `yaml:"MIN_EPOCHS_FOR_BLOBS_SIDECARS_REQUEST"` // MinEpochsForBlobsSidecarsRequest is the minimum number of epochs the node will keep the blobs for.
 	MaxRequestBlobSidecars           uint64           `yaml:"MAX_REQUEST_BLOB_SIDECARS"`             // MaxRequestBlobSidecars is the maximum number of blobs to request in a single request.
 	MaxRequestBlocksDeneb            uint64           `yaml:"MAX_REQUEST_BLOCKS_DENEB"`              // MaxRequestBlocksDeneb is the maximum number of blocks in a single request after the deneb epoch.
+	MaxRequestLightClientUpdates     uint64           `yaml:"MAX_REQUEST_LIGHT_CLIENT_UPDATES"`      // MaxRequestLightClientUpdates is the maximum number of light client updates in a single request.
 
 	// DiscoveryV5 Config
 	ETH2Key                    string // ETH2Key is the ENR key of the Ethereum consensus object in an enr.
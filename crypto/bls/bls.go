@@ -76,6 +76,23 @@ func NewAggregateSignature() common.Signature {
 	return blst.NewAggregateSignature()
 }
 
+// SplitSecretKey splits a secret key into n shares, any threshold of which can sign on its behalf.
+func SplitSecretKey(secretKey SecretKey, threshold, n uint64) ([]SecretKey, error) {
+	return blst.SplitSecretKey(secretKey, threshold, n)
+}
+
+// RecoverSignature combines signatures by shares of a secret key, with the given share indices,
+// into the signature of the secret key.
+func RecoverSignature(sigs []common.Signature, indices []uint64) (common.Signature, error) {
+	return blst.RecoverSignature(sigs, indices)
+}
+
+// RecoverPublicKey combines public keys of shares of a secret key, with the given share indices,
+// into the public key of the secret key.
+func RecoverPublicKey(pubKeys []PublicKey, indices []uint64) (PublicKey, error) {
+	return blst.RecoverPublicKey(pubKeys, indices)
+}
+
 // RandKey creates a new private key using a random input.
 func RandKey() (common.SecretKey, error) {
 	return blst.RandKey()
@@ -152,3 +152,18 @@ func RandKey() (common.SecretKey, error) {
 func VerifyCompressed(_, _, _ []byte) bool {
 	panic(err)
 }
+
+// SplitSecretKey -- stub
+func SplitSecretKey(_ common.SecretKey, _, _ uint64) ([]common.SecretKey, error) {
+	panic(err)
+}
+
+// RecoverSignature -- stub
+func RecoverSignature(_ []common.Signature, _ []uint64) (common.Signature, error) {
+	panic(err)
+}
+
+// RecoverPublicKey -- stub
+func RecoverPublicKey(_ []common.PublicKey, _ []uint64) (common.PublicKey, error) {
+	panic(err)
+}
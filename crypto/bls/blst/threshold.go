@@ -0,0 +1,137 @@
+//go:build ((linux && amd64) || (linux && arm64) || (darwin && amd64) || (darwin && arm64) || (windows && amd64)) && !blst_disabled
+
+package blst
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v4/crypto/bls/common"
+	"github.com/prysmaticlabs/prysm/v4/crypto/rand"
+	blst "github.com/supranational/blst/bindings/go"
+)
+
+// curveOrder is the order r of the BLS12-381 subgroups, which secret keys are reduced modulo.
+var curveOrder, _ = new(big.Int).SetString("73eda753299d7d483339d80809a1d80553bda402fffe5bfeffffffff00000001", 16)
+
+// SplitSecretKey splits a secret key into n Shamir shares, any threshold of which can be
+// combined to sign on behalf of the secret key. The share at position i is the evaluation
+// at x = i+1 of a random polynomial of degree threshold-1 whose constant term is the secret
+// key, so i+1 is the share index expected by RecoverSignature and RecoverPublicKey.
+func SplitSecretKey(secretKey common.SecretKey, threshold, n uint64) ([]common.SecretKey, error) {
+	if threshold == 0 || threshold > n {
+		return nil, fmt.Errorf("threshold %d must be between 1 and the number of shares %d", threshold, n)
+	}
+	coefficients := make([]*big.Int, threshold)
+	coefficients[0] = new(big.Int).SetBytes(secretKey.Marshal())
+	randGen := rand.NewGenerator()
+	for i := 1; i < len(coefficients); i++ {
+		// 64 bytes of randomness reduced modulo r are indistinguishable from a uniform scalar.
+		var b [64]byte
+		if _, err := randGen.Read(b[:]); err != nil {
+			return nil, err
+		}
+		coefficients[i] = new(big.Int).Mod(new(big.Int).SetBytes(b[:]), curveOrder)
+	}
+	shares := make([]common.SecretKey, n)
+	for i := uint64(1); i <= n; i++ {
+		x := new(big.Int).SetUint64(i)
+		y := new(big.Int)
+		for j := len(coefficients) - 1; j >= 0; j-- {
+			y.Mul(y, x)
+			y.Add(y, coefficients[j])
+			y.Mod(y, curveOrder)
+		}
+		share, err := SecretKeyFromBytes(y.FillBytes(make([]byte, scalarBytes)))
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not create share %d", i)
+		}
+		shares[i-1] = share
+	}
+	return shares, nil
+}
+
+// RecoverSignature combines signatures of the same message by shares of a secret key into the
+// signature of the secret key, using Lagrange interpolation at zero. Indices are the share indices
+// of the signers, and at least as many signatures as the threshold the key was split with are
+// needed for the result to be valid.
+func RecoverSignature(sigs []common.Signature, indices []uint64) (common.Signature, error) {
+	if len(sigs) == 0 || len(sigs) != len(indices) {
+		return nil, fmt.Errorf("got %d signatures for %d indices", len(sigs), len(indices))
+	}
+	scalars, err := lagrangeCoefficients(indices)
+	if err != nil {
+		return nil, err
+	}
+	points := make([]*blstSignature, len(sigs))
+	for i, sig := range sigs {
+		s, ok := sig.(*Signature)
+		if !ok {
+			return nil, errors.New("could not convert signature to blst signature")
+		}
+		points[i] = s.s
+	}
+	return &Signature{s: blst.P2AffinesMult(points, scalars, 255).ToAffine()}, nil
+}
+
+// RecoverPublicKey combines public keys of shares of a secret key into the public key of the
+// secret key, using Lagrange interpolation at zero.
+func RecoverPublicKey(pubKeys []common.PublicKey, indices []uint64) (common.PublicKey, error) {
+	if len(pubKeys) == 0 || len(pubKeys) != len(indices) {
+		return nil, fmt.Errorf("got %d public keys for %d indices", len(pubKeys), len(indices))
+	}
+	scalars, err := lagrangeCoefficients(indices)
+	if err != nil {
+		return nil, err
+	}
+	points := make([]*blstPublicKey, len(pubKeys))
+	for i, pubKey := range pubKeys {
+		p, ok := pubKey.(*PublicKey)
+		if !ok {
+			return nil, errors.New("could not convert public key to blst public key")
+		}
+		points[i] = p.p
+	}
+	return &PublicKey{p: blst.P1AffinesMult(points, scalars, 255).ToAffine()}, nil
+}
+
+// lagrangeCoefficients returns, as little-endian scalars, the Lagrange basis polynomials of the
+// given share indices evaluated at zero: the product of x_j / (x_j - x_i) for every j != i.
+func lagrangeCoefficients(indices []uint64) ([][]byte, error) {
+	xs := make([]*big.Int, len(indices))
+	seen := make(map[uint64]bool, len(indices))
+	for i, index := range indices {
+		if index == 0 {
+			return nil, errors.New("share index 0 is the secret key itself")
+		}
+		if seen[index] {
+			return nil, fmt.Errorf("duplicate share index %d", index)
+		}
+		seen[index] = true
+		xs[i] = new(big.Int).SetUint64(index)
+	}
+	scalars := make([][]byte, len(xs))
+	for i := range xs {
+		num, den := big.NewInt(1), big.NewInt(1)
+		for j := range xs {
+			if i == j {
+				continue
+			}
+			num.Mul(num, xs[j])
+			num.Mod(num, curveOrder)
+			diff := new(big.Int).Sub(xs[j], xs[i])
+			den.Mul(den, diff.Mod(diff, curveOrder))
+			den.Mod(den, curveOrder)
+		}
+		coefficient := num.Mul(num, den.ModInverse(den, curveOrder))
+		coefficient.Mod(coefficient, curveOrder)
+		be := coefficient.FillBytes(make([]byte, scalarBytes))
+		le := make([]byte, scalarBytes)
+		for k := range be {
+			le[k] = be[scalarBytes-1-k]
+		}
+		scalars[i] = le
+	}
+	return scalars, nil
+}
@@ -0,0 +1,68 @@
+//go:build ((linux && amd64) || (linux && arm64) || (darwin && amd64) || (darwin && arm64) || (windows && amd64)) && !blst_disabled
+
+package blst
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v4/crypto/bls/common"
+	"github.com/prysmaticlabs/prysm/v4/testing/assert"
+	"github.com/prysmaticlabs/prysm/v4/testing/require"
+)
+
+func TestSplitSecretKey_RecoverSignature(t *testing.T) {
+	priv, err := RandKey()
+	require.NoError(t, err)
+	shares, err := SplitSecretKey(priv, 3, 5)
+	require.NoError(t, err)
+	require.Equal(t, 5, len(shares))
+
+	msg := []byte("hello")
+	want := priv.Sign(msg).Marshal()
+	for _, indices := range [][]uint64{{1, 2, 3}, {5, 3, 1}, {2, 4, 5}, {1, 2, 3, 4, 5}} {
+		sigs := make([]common.Signature, len(indices))
+		pubKeys := make([]common.PublicKey, len(indices))
+		for i, index := range indices {
+			sigs[i] = shares[index-1].Sign(msg)
+			pubKeys[i] = shares[index-1].PublicKey()
+		}
+		sig, err := RecoverSignature(sigs, indices)
+		require.NoError(t, err)
+		assert.DeepEqual(t, want, sig.Marshal(), "Wrong signature for shares %v", indices)
+		pub, err := RecoverPublicKey(pubKeys, indices)
+		require.NoError(t, err)
+		assert.DeepEqual(t, priv.PublicKey().Marshal(), pub.Marshal(), "Wrong public key for shares %v", indices)
+	}
+
+	// Fewer shares than the threshold do not recover the signature.
+	sig, err := RecoverSignature([]common.Signature{shares[0].Sign(msg), shares[1].Sign(msg)}, []uint64{1, 2})
+	require.NoError(t, err)
+	assert.Equal(t, false, bytes.Equal(want, sig.Marshal()))
+}
+
+func TestSplitSecretKey_InvalidThreshold(t *testing.T) {
+	priv, err := RandKey()
+	require.NoError(t, err)
+	_, err = SplitSecretKey(priv, 0, 3)
+	require.ErrorContains(t, "threshold 0 must be between 1", err)
+	_, err = SplitSecretKey(priv, 4, 3)
+	require.ErrorContains(t, "threshold 4 must be between 1", err)
+
+	// A threshold of one gives every participant the secret key itself.
+	shares, err := SplitSecretKey(priv, 1, 2)
+	require.NoError(t, err)
+	assert.DeepEqual(t, priv.Marshal(), shares[1].Marshal())
+}
+
+func TestRecoverSignature_InvalidIndices(t *testing.T) {
+	priv, err := RandKey()
+	require.NoError(t, err)
+	sig := priv.Sign([]byte("hello"))
+	_, err = RecoverSignature([]common.Signature{sig, sig}, []uint64{1, 1})
+	require.ErrorContains(t, "duplicate share index 1", err)
+	_, err = RecoverSignature([]common.Signature{sig}, []uint64{0})
+	require.ErrorContains(t, "share index 0", err)
+	_, err = RecoverSignature([]common.Signature{sig}, []uint64{1, 2})
+	require.ErrorContains(t, "got 1 signatures for 2 indices", err)
+}
@@ -49,6 +49,20 @@ func FromState(marshaled []byte) (*VersionedUnmarshaler, error) {
 	return FromForkVersion(cv)
 }
 
+// FromBlock uses the slot of a marshaled SignedBeaconBlock to look up the version of the fork the block belongs to
+// in the fork schedule of the active config, so that blocks can be unmarshaled without knowing their fork upfront.
+func FromBlock(marshaled []byte) (*VersionedUnmarshaler, error) {
+	slot, err := slotFromBlock(marshaled)
+	if err != nil {
+		return nil, err
+	}
+	cv, err := forks.NewOrderedSchedule(params.BeaconConfig()).VersionForEpoch(slots.ToEpoch(slot))
+	if err != nil {
+		return nil, err
+	}
+	return FromForkVersion(cv)
+}
+
 var ErrForkNotFound = errors.New("version found in fork schedule but can't be matched to a named fork")
 
 // FromForkVersion uses a lookup table to resolve a Version (from a beacon node api for instance, or obtained by peeking at
@@ -338,6 +338,59 @@ func TestUnmarshalBlock(t *testing.T) {
 	}
 }
 
+func TestFromBlock(t *testing.T) {
+	undo, err := hackDenebMaxuint()
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, undo())
+	}()
+	altairS, err := slots.EpochStart(params.BeaconConfig().AltairForkEpoch)
+	require.NoError(t, err)
+	capellaS, err := slots.EpochStart(params.BeaconConfig().CapellaForkEpoch)
+	require.NoError(t, err)
+	cases := []struct {
+		b       func(*testing.T, primitives.Slot) interfaces.ReadOnlySignedBeaconBlock
+		name    string
+		version []byte
+		slot    primitives.Slot
+	}{
+		{
+			name:    "genesis - slot 0",
+			b:       signedTestBlockGenesis,
+			version: params.BeaconConfig().GenesisForkVersion,
+		},
+		{
+			name:    "last slot of phase 0",
+			b:       signedTestBlockGenesis,
+			version: params.BeaconConfig().GenesisForkVersion,
+			slot:    altairS - 1,
+		},
+		{
+			name:    "first slot of altair",
+			b:       signedTestBlockAltair,
+			version: params.BeaconConfig().AltairForkVersion,
+			slot:    altairS,
+		},
+		{
+			name:    "first slot of capella",
+			b:       signedTestBlockCapella,
+			version: params.BeaconConfig().CapellaForkVersion,
+			slot:    capellaS,
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			marshaled, err := c.b(t, c.slot).MarshalSSZ()
+			require.NoError(t, err)
+			cf, err := FromBlock(marshaled)
+			require.NoError(t, err)
+			require.Equal(t, bytesutil.ToBytes4(c.version), cf.Version)
+			_, err = cf.UnmarshalBeaconBlock(marshaled)
+			require.NoError(t, err)
+		})
+	}
+}
+
 func TestUnmarshalBlindedBlock(t *testing.T) {
 	undo, err := hackDenebMaxuint()
 	require.NoError(t, err)
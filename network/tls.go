@@ -0,0 +1,38 @@
+package network
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"os"
+
+	"github.com/pkg/errors"
+)
+
+// NewTLSConfig returns the TLS configuration of a client. The certificate authority, if set,
+// verifies the certificates of servers and, if both paths are set, the client certificate is
+// presented to servers for mutual TLS.
+func NewTLSConfig(caCertPath, clientCertPath, clientKeyPath string) (*tls.Config, error) {
+	cfg := &tls.Config{MinVersion: tls.VersionTLS12}
+	if caCertPath != "" {
+		caCert, err := os.ReadFile(caCertPath) // #nosec G304 -- path is provided by the user
+		if err != nil {
+			return nil, errors.Wrap(err, "could not read CA certificate")
+		}
+		cfg.RootCAs = x509.NewCertPool()
+		if !cfg.RootCAs.AppendCertsFromPEM(caCert) {
+			return nil, fmt.Errorf("no valid certificate found in %s", caCertPath)
+		}
+	}
+	if (clientCertPath == "") != (clientKeyPath == "") {
+		return nil, errors.New("both a client certificate and a client key are needed for mutual TLS")
+	}
+	if clientCertPath != "" {
+		cert, err := tls.LoadX509KeyPair(clientCertPath, clientKeyPath)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not load client certificate")
+		}
+		cfg.Certificates = []tls.Certificate{cert}
+	}
+	return cfg, nil
+}
@@ -593,6 +593,125 @@ func (x *EventBlobSidecar) GetKzgCommitment() []byte {
 	return nil
 }
 
+type EventEquivocation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Kind           string                                                                      `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	Slot           github_com_prysmaticlabs_prysm_v4_consensus_types_primitives.Slot           `protobuf:"varint,2,opt,name=slot,proto3" json:"slot,omitempty" cast-type:"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives.Slot"`
+	ValidatorIndex github_com_prysmaticlabs_prysm_v4_consensus_types_primitives.ValidatorIndex `protobuf:"varint,3,opt,name=validator_index,json=validatorIndex,proto3" json:"validator_index,omitempty" cast-type:"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives.ValidatorIndex"`
+	Index          uint64                                                                      `protobuf:"varint,4,opt,name=index,proto3" json:"index,omitempty"`
+	BlockRoot_1    []byte                                                                      `protobuf:"bytes,5,opt,name=block_root_1,json=blockRoot1,proto3" json:"block_root_1,omitempty" ssz-size:"32"`
+	SigningRoot_1  []byte                                                                      `protobuf:"bytes,6,opt,name=signing_root_1,json=signingRoot1,proto3" json:"signing_root_1,omitempty" ssz-size:"32"`
+	Signature_1    []byte                                                                      `protobuf:"bytes,7,opt,name=signature_1,json=signature1,proto3" json:"signature_1,omitempty" ssz-size:"96"`
+	BlockRoot_2    []byte                                                                      `protobuf:"bytes,8,opt,name=block_root_2,json=blockRoot2,proto3" json:"block_root_2,omitempty" ssz-size:"32"`
+	SigningRoot_2  []byte                                                                      `protobuf:"bytes,9,opt,name=signing_root_2,json=signingRoot2,proto3" json:"signing_root_2,omitempty" ssz-size:"32"`
+	Signature_2    []byte                                                                      `protobuf:"bytes,10,opt,name=signature_2,json=signature2,proto3" json:"signature_2,omitempty" ssz-size:"96"`
+}
+
+func (x *EventEquivocation) Reset() {
+	*x = EventEquivocation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_eth_v1_events_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventEquivocation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventEquivocation) ProtoMessage() {}
+
+func (x *EventEquivocation) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_eth_v1_events_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventEquivocation.ProtoReflect.Descriptor instead.
+func (*EventEquivocation) Descriptor() ([]byte, []int) {
+	return file_proto_eth_v1_events_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *EventEquivocation) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *EventEquivocation) GetSlot() github_com_prysmaticlabs_prysm_v4_consensus_types_primitives.Slot {
+	if x != nil {
+		return x.Slot
+	}
+	return github_com_prysmaticlabs_prysm_v4_consensus_types_primitives.Slot(0)
+}
+
+func (x *EventEquivocation) GetValidatorIndex() github_com_prysmaticlabs_prysm_v4_consensus_types_primitives.ValidatorIndex {
+	if x != nil {
+		return x.ValidatorIndex
+	}
+	return github_com_prysmaticlabs_prysm_v4_consensus_types_primitives.ValidatorIndex(0)
+}
+
+func (x *EventEquivocation) GetIndex() uint64 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *EventEquivocation) GetBlockRoot_1() []byte {
+	if x != nil {
+		return x.BlockRoot_1
+	}
+	return nil
+}
+
+func (x *EventEquivocation) GetSigningRoot_1() []byte {
+	if x != nil {
+		return x.SigningRoot_1
+	}
+	return nil
+}
+
+func (x *EventEquivocation) GetSignature_1() []byte {
+	if x != nil {
+		return x.Signature_1
+	}
+	return nil
+}
+
+func (x *EventEquivocation) GetBlockRoot_2() []byte {
+	if x != nil {
+		return x.BlockRoot_2
+	}
+	return nil
+}
+
+func (x *EventEquivocation) GetSigningRoot_2() []byte {
+	if x != nil {
+		return x.SigningRoot_2
+	}
+	return nil
+}
+
+func (x *EventEquivocation) GetSignature_2() []byte {
+	if x != nil {
+		return x.Signature_2
+	}
+	return nil
+}
+
 type EventPayloadAttributeV1_BasePayloadAttribute struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -609,7 +728,7 @@ type EventPayloadAttributeV1_BasePayloadAttribute struct {
 func (x *EventPayloadAttributeV1_BasePayloadAttribute) Reset() {
 	*x = EventPayloadAttributeV1_BasePayloadAttribute{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_eth_v1_events_proto_msgTypes[8]
+		mi := &file_proto_eth_v1_events_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -622,7 +741,7 @@ func (x *EventPayloadAttributeV1_BasePayloadAttribute) String() string {
 func (*EventPayloadAttributeV1_BasePayloadAttribute) ProtoMessage() {}
 
 func (x *EventPayloadAttributeV1_BasePayloadAttribute) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_eth_v1_events_proto_msgTypes[8]
+	mi := &file_proto_eth_v1_events_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -696,7 +815,7 @@ type EventPayloadAttributeV2_BasePayloadAttribute struct {
 func (x *EventPayloadAttributeV2_BasePayloadAttribute) Reset() {
 	*x = EventPayloadAttributeV2_BasePayloadAttribute{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_eth_v1_events_proto_msgTypes[9]
+		mi := &file_proto_eth_v1_events_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -709,7 +828,7 @@ func (x *EventPayloadAttributeV2_BasePayloadAttribute) String() string {
 func (*EventPayloadAttributeV2_BasePayloadAttribute) ProtoMessage() {}
 
 func (x *EventPayloadAttributeV2_BasePayloadAttribute) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_eth_v1_events_proto_msgTypes[9]
+	mi := &file_proto_eth_v1_events_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -957,16 +1076,49 @@ var file_proto_eth_v1_events_proto_rawDesc = []byte{
 	0x0d, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x65, 0x64, 0x48, 0x61, 0x73, 0x68, 0x12, 0x2d,
 	0x0a, 0x0e, 0x6b, 0x7a, 0x67, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74,
 	0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x06, 0x8a, 0xb5, 0x18, 0x02, 0x34, 0x38, 0x52, 0x0d,
-	0x6b, 0x7a, 0x67, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x42, 0x7e, 0x0a,
-	0x13, 0x6f, 0x72, 0x67, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x65, 0x74,
-	0x68, 0x2e, 0x76, 0x31, 0x42, 0x11, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x45, 0x76, 0x65, 0x6e,
-	0x74, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x6b, 0x7a, 0x67, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x94, 0x04,
+	0x0a, 0x11, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x45, 0x71, 0x75, 0x69, 0x76, 0x6f, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x59, 0x0a, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x04, 0x42, 0x45, 0x82, 0xb5, 0x18, 0x41, 0x67, 0x69, 0x74, 0x68, 0x75,
 	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x72, 0x79, 0x73, 0x6d, 0x61, 0x74, 0x69, 0x63, 0x6c,
-	0x61, 0x62, 0x73, 0x2f, 0x70, 0x72, 0x79, 0x73, 0x6d, 0x2f, 0x76, 0x34, 0x2f, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x2f, 0x65, 0x74, 0x68, 0x2f, 0x76, 0x31, 0xaa, 0x02, 0x0f, 0x45, 0x74, 0x68, 0x65,
-	0x72, 0x65, 0x75, 0x6d, 0x2e, 0x45, 0x74, 0x68, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x0f, 0x45, 0x74,
-	0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x5c, 0x45, 0x74, 0x68, 0x5c, 0x76, 0x31, 0x62, 0x06, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x61, 0x62, 0x73, 0x2f, 0x70, 0x72, 0x79, 0x73, 0x6d, 0x2f, 0x76, 0x34, 0x2f, 0x63, 0x6f, 0x6e,
+	0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x2d, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2f, 0x70, 0x72, 0x69,
+	0x6d, 0x69, 0x74, 0x69, 0x76, 0x65, 0x73, 0x2e, 0x53, 0x6c, 0x6f, 0x74, 0x52, 0x04, 0x73, 0x6c,
+	0x6f, 0x74, 0x12, 0x78, 0x0a, 0x0f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f,
+	0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x42, 0x4f, 0x82, 0xb5, 0x18,
+	0x4b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x72, 0x79, 0x73,
+	0x6d, 0x61, 0x74, 0x69, 0x63, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x70, 0x72, 0x79, 0x73, 0x6d, 0x2f,
+	0x76, 0x34, 0x2f, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x2d, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2f, 0x70, 0x72, 0x69, 0x6d, 0x69, 0x74, 0x69, 0x76, 0x65, 0x73, 0x2e, 0x56, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x52, 0x0e, 0x76, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x14, 0x0a, 0x05,
+	0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x69, 0x6e, 0x64,
+	0x65, 0x78, 0x12, 0x28, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x72, 0x6f, 0x6f, 0x74,
+	0x5f, 0x31, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x06, 0x8a, 0xb5, 0x18, 0x02, 0x33, 0x32,
+	0x52, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x6f, 0x6f, 0x74, 0x31, 0x12, 0x2c, 0x0a, 0x0e,
+	0x73, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x5f, 0x31, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x0c, 0x42, 0x06, 0x8a, 0xb5, 0x18, 0x02, 0x33, 0x32, 0x52, 0x0c, 0x73, 0x69,
+	0x67, 0x6e, 0x69, 0x6e, 0x67, 0x52, 0x6f, 0x6f, 0x74, 0x31, 0x12, 0x27, 0x0a, 0x0b, 0x73, 0x69,
+	0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x31, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0c, 0x42,
+	0x06, 0x8a, 0xb5, 0x18, 0x02, 0x39, 0x36, 0x52, 0x0a, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x31, 0x12, 0x28, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x72, 0x6f, 0x6f,
+	0x74, 0x5f, 0x32, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x06, 0x8a, 0xb5, 0x18, 0x02, 0x33,
+	0x32, 0x52, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x6f, 0x6f, 0x74, 0x32, 0x12, 0x2c, 0x0a,
+	0x0e, 0x73, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x5f, 0x32, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x06, 0x8a, 0xb5, 0x18, 0x02, 0x33, 0x32, 0x52, 0x0c, 0x73,
+	0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x52, 0x6f, 0x6f, 0x74, 0x32, 0x12, 0x27, 0x0a, 0x0b, 0x73,
+	0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x32, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0c,
+	0x42, 0x06, 0x8a, 0xb5, 0x18, 0x02, 0x39, 0x36, 0x52, 0x0a, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74,
+	0x75, 0x72, 0x65, 0x32, 0x42, 0x7e, 0x0a, 0x13, 0x6f, 0x72, 0x67, 0x2e, 0x65, 0x74, 0x68, 0x65,
+	0x72, 0x65, 0x75, 0x6d, 0x2e, 0x65, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x42, 0x11, 0x42, 0x65, 0x61,
+	0x63, 0x6f, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01,
+	0x5a, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x72, 0x79,
+	0x73, 0x6d, 0x61, 0x74, 0x69, 0x63, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x70, 0x72, 0x79, 0x73, 0x6d,
+	0x2f, 0x76, 0x34, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x65, 0x74, 0x68, 0x2f, 0x76, 0x31,
+	0xaa, 0x02, 0x0f, 0x45, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x45, 0x74, 0x68, 0x2e,
+	0x56, 0x31, 0xca, 0x02, 0x0f, 0x45, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x5c, 0x45, 0x74,
+	0x68, 0x5c, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -981,7 +1133,7 @@ func file_proto_eth_v1_events_proto_rawDescGZIP() []byte {
 	return file_proto_eth_v1_events_proto_rawDescData
 }
 
-var file_proto_eth_v1_events_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_proto_eth_v1_events_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
 var file_proto_eth_v1_events_proto_goTypes = []interface{}{
 	(*StreamEventsRequest)(nil),                          // 0: ethereum.eth.v1.StreamEventsRequest
 	(*EventHead)(nil),                                    // 1: ethereum.eth.v1.EventHead
@@ -991,16 +1143,17 @@ var file_proto_eth_v1_events_proto_goTypes = []interface{}{
 	(*EventPayloadAttributeV1)(nil),                      // 5: ethereum.eth.v1.EventPayloadAttributeV1
 	(*EventPayloadAttributeV2)(nil),                      // 6: ethereum.eth.v1.EventPayloadAttributeV2
 	(*EventBlobSidecar)(nil),                             // 7: ethereum.eth.v1.EventBlobSidecar
-	(*EventPayloadAttributeV1_BasePayloadAttribute)(nil), // 8: ethereum.eth.v1.EventPayloadAttributeV1.BasePayloadAttribute
-	(*EventPayloadAttributeV2_BasePayloadAttribute)(nil), // 9: ethereum.eth.v1.EventPayloadAttributeV2.BasePayloadAttribute
-	(*v1.PayloadAttributes)(nil),                         // 10: ethereum.engine.v1.PayloadAttributes
-	(*v1.PayloadAttributesV2)(nil),                       // 11: ethereum.engine.v1.PayloadAttributesV2
+	(*EventEquivocation)(nil),                            // 8: ethereum.eth.v1.EventEquivocation
+	(*EventPayloadAttributeV1_BasePayloadAttribute)(nil), // 9: ethereum.eth.v1.EventPayloadAttributeV1.BasePayloadAttribute
+	(*EventPayloadAttributeV2_BasePayloadAttribute)(nil), // 10: ethereum.eth.v1.EventPayloadAttributeV2.BasePayloadAttribute
+	(*v1.PayloadAttributes)(nil),                         // 11: ethereum.engine.v1.PayloadAttributes
+	(*v1.PayloadAttributesV2)(nil),                       // 12: ethereum.engine.v1.PayloadAttributesV2
 }
 var file_proto_eth_v1_events_proto_depIdxs = []int32{
-	8,  // 0: ethereum.eth.v1.EventPayloadAttributeV1.data:type_name -> ethereum.eth.v1.EventPayloadAttributeV1.BasePayloadAttribute
-	9,  // 1: ethereum.eth.v1.EventPayloadAttributeV2.data:type_name -> ethereum.eth.v1.EventPayloadAttributeV2.BasePayloadAttribute
-	10, // 2: ethereum.eth.v1.EventPayloadAttributeV1.BasePayloadAttribute.payload_attributes:type_name -> ethereum.engine.v1.PayloadAttributes
-	11, // 3: ethereum.eth.v1.EventPayloadAttributeV2.BasePayloadAttribute.payload_attributes:type_name -> ethereum.engine.v1.PayloadAttributesV2
+	9,  // 0: ethereum.eth.v1.EventPayloadAttributeV1.data:type_name -> ethereum.eth.v1.EventPayloadAttributeV1.BasePayloadAttribute
+	10, // 1: ethereum.eth.v1.EventPayloadAttributeV2.data:type_name -> ethereum.eth.v1.EventPayloadAttributeV2.BasePayloadAttribute
+	11, // 2: ethereum.eth.v1.EventPayloadAttributeV1.BasePayloadAttribute.payload_attributes:type_name -> ethereum.engine.v1.PayloadAttributes
+	12, // 3: ethereum.eth.v1.EventPayloadAttributeV2.BasePayloadAttribute.payload_attributes:type_name -> ethereum.engine.v1.PayloadAttributesV2
 	4,  // [4:4] is the sub-list for method output_type
 	4,  // [4:4] is the sub-list for method input_type
 	4,  // [4:4] is the sub-list for extension type_name
@@ -1111,7 +1264,7 @@ func file_proto_eth_v1_events_proto_init() {
 			}
 		}
 		file_proto_eth_v1_events_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EventPayloadAttributeV1_BasePayloadAttribute); i {
+			switch v := v.(*EventEquivocation); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1123,6 +1276,18 @@ func file_proto_eth_v1_events_proto_init() {
 			}
 		}
 		file_proto_eth_v1_events_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventPayloadAttributeV1_BasePayloadAttribute); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_eth_v1_events_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*EventPayloadAttributeV2_BasePayloadAttribute); i {
 			case 0:
 				return &v.state
@@ -1141,7 +1306,7 @@ func file_proto_eth_v1_events_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_proto_eth_v1_events_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   10,
+			NumMessages:   11,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -23,7 +23,7 @@ func (x *SyncCommittee) Equals(other *SyncCommittee) bool {
 }
 
 func FloorLog2(x uint64) int {
-	return bits.Len64(uint64(x - 1))
+	return bits.Len64(x) - 1
 }
 
 func isEmptyWithLength(bb [][]byte, length uint64) bool {
@@ -35,7 +35,8 @@ func isEmptyWithLength(bb [][]byte, length uint64) bool {
 		return false
 	}
 	for _, b := range bb {
-		if !bytes.Equal(b, []byte{}) {
+		// Empty branches are zeroed once they have been ssz encoded.
+		if !bytes.Equal(b, []byte{}) && !bytes.Equal(b, make([]byte, 32)) {
 			return false
 		}
 	}
@@ -1,5 +1,5 @@
 // Code generated by fastssz. DO NOT EDIT.
-// Hash: 9f41b0f86969914896f5badce70d6303794e4bdeb09b0c2d95d11631f7863042
+// Hash: c422cb417123c1d40a2dba3b4887934a6bb1be9918e984eca4921e46db5bc451
 package eth
 
 import (
@@ -6404,6 +6404,624 @@ func (b *BlindedBeaconBlockContentsDeneb) HashTreeRootWith(hh *ssz.Hasher) (err
 	return
 }
 
+// MarshalSSZ ssz marshals the LightClientBootstrap object
+func (l *LightClientBootstrap) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(l)
+}
+
+// MarshalSSZTo ssz marshals the LightClientBootstrap object to a target array
+func (l *LightClientBootstrap) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'Header'
+	if l.Header == nil {
+		l.Header = new(v1.BeaconBlockHeader)
+	}
+	if dst, err = l.Header.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	// Field (1) 'CurrentSyncCommittee'
+	if l.CurrentSyncCommittee == nil {
+		l.CurrentSyncCommittee = new(SyncCommittee)
+	}
+	if dst, err = l.CurrentSyncCommittee.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	// Field (2) 'CurrentSyncCommitteeBranch'
+	if size := len(l.CurrentSyncCommitteeBranch); size != 5 {
+		err = ssz.ErrVectorLengthFn("--.CurrentSyncCommitteeBranch", size, 5)
+		return
+	}
+	for ii := 0; ii < 5; ii++ {
+		if size := len(l.CurrentSyncCommitteeBranch[ii]); size != 32 {
+			err = ssz.ErrBytesLengthFn("--.CurrentSyncCommitteeBranch[ii]", size, 32)
+			return
+		}
+		dst = append(dst, l.CurrentSyncCommitteeBranch[ii]...)
+	}
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the LightClientBootstrap object
+func (l *LightClientBootstrap) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 24896 {
+		return ssz.ErrSize
+	}
+
+	// Field (0) 'Header'
+	if l.Header == nil {
+		l.Header = new(v1.BeaconBlockHeader)
+	}
+	if err = l.Header.UnmarshalSSZ(buf[0:112]); err != nil {
+		return err
+	}
+
+	// Field (1) 'CurrentSyncCommittee'
+	if l.CurrentSyncCommittee == nil {
+		l.CurrentSyncCommittee = new(SyncCommittee)
+	}
+	if err = l.CurrentSyncCommittee.UnmarshalSSZ(buf[112:24736]); err != nil {
+		return err
+	}
+
+	// Field (2) 'CurrentSyncCommitteeBranch'
+	l.CurrentSyncCommitteeBranch = make([][]byte, 5)
+	for ii := 0; ii < 5; ii++ {
+		if cap(l.CurrentSyncCommitteeBranch[ii]) == 0 {
+			l.CurrentSyncCommitteeBranch[ii] = make([]byte, 0, len(buf[24736:24896][ii*32:(ii+1)*32]))
+		}
+		l.CurrentSyncCommitteeBranch[ii] = append(l.CurrentSyncCommitteeBranch[ii], buf[24736:24896][ii*32:(ii+1)*32]...)
+	}
+
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the LightClientBootstrap object
+func (l *LightClientBootstrap) SizeSSZ() (size int) {
+	size = 24896
+	return
+}
+
+// HashTreeRoot ssz hashes the LightClientBootstrap object
+func (l *LightClientBootstrap) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(l)
+}
+
+// HashTreeRootWith ssz hashes the LightClientBootstrap object with a hasher
+func (l *LightClientBootstrap) HashTreeRootWith(hh *ssz.Hasher) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Header'
+	if err = l.Header.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	// Field (1) 'CurrentSyncCommittee'
+	if err = l.CurrentSyncCommittee.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	// Field (2) 'CurrentSyncCommitteeBranch'
+	{
+		if size := len(l.CurrentSyncCommitteeBranch); size != 5 {
+			err = ssz.ErrVectorLengthFn("--.CurrentSyncCommitteeBranch", size, 5)
+			return
+		}
+		subIndx := hh.Index()
+		for _, i := range l.CurrentSyncCommitteeBranch {
+			if len(i) != 32 {
+				err = ssz.ErrBytesLength
+				return
+			}
+			hh.Append(i)
+		}
+
+		if ssz.EnableVectorizedHTR {
+			hh.MerkleizeVectorizedHTR(subIndx)
+		} else {
+			hh.Merkleize(subIndx)
+		}
+	}
+
+	if ssz.EnableVectorizedHTR {
+		hh.MerkleizeVectorizedHTR(indx)
+	} else {
+		hh.Merkleize(indx)
+	}
+	return
+}
+
+// MarshalSSZ ssz marshals the LightClientUpdate object
+func (l *LightClientUpdate) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(l)
+}
+
+// MarshalSSZTo ssz marshals the LightClientUpdate object to a target array
+func (l *LightClientUpdate) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'AttestedHeader'
+	if l.AttestedHeader == nil {
+		l.AttestedHeader = new(v1.BeaconBlockHeader)
+	}
+	if dst, err = l.AttestedHeader.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	// Field (1) 'NextSyncCommittee'
+	if l.NextSyncCommittee == nil {
+		l.NextSyncCommittee = new(SyncCommittee)
+	}
+	if dst, err = l.NextSyncCommittee.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	// Field (2) 'NextSyncCommitteeBranch'
+	if size := len(l.NextSyncCommitteeBranch); size != 5 {
+		err = ssz.ErrVectorLengthFn("--.NextSyncCommitteeBranch", size, 5)
+		return
+	}
+	for ii := 0; ii < 5; ii++ {
+		if size := len(l.NextSyncCommitteeBranch[ii]); size != 32 {
+			err = ssz.ErrBytesLengthFn("--.NextSyncCommitteeBranch[ii]", size, 32)
+			return
+		}
+		dst = append(dst, l.NextSyncCommitteeBranch[ii]...)
+	}
+
+	// Field (3) 'FinalizedHeader'
+	if l.FinalizedHeader == nil {
+		l.FinalizedHeader = new(v1.BeaconBlockHeader)
+	}
+	if dst, err = l.FinalizedHeader.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	// Field (4) 'FinalityBranch'
+	if size := len(l.FinalityBranch); size != 6 {
+		err = ssz.ErrVectorLengthFn("--.FinalityBranch", size, 6)
+		return
+	}
+	for ii := 0; ii < 6; ii++ {
+		if size := len(l.FinalityBranch[ii]); size != 32 {
+			err = ssz.ErrBytesLengthFn("--.FinalityBranch[ii]", size, 32)
+			return
+		}
+		dst = append(dst, l.FinalityBranch[ii]...)
+	}
+
+	// Field (5) 'SyncAggregate'
+	if l.SyncAggregate == nil {
+		l.SyncAggregate = new(v1.SyncAggregate)
+	}
+	if dst, err = l.SyncAggregate.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	// Field (6) 'SignatureSlot'
+	dst = ssz.MarshalUint64(dst, uint64(l.SignatureSlot))
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the LightClientUpdate object
+func (l *LightClientUpdate) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 25368 {
+		return ssz.ErrSize
+	}
+
+	// Field (0) 'AttestedHeader'
+	if l.AttestedHeader == nil {
+		l.AttestedHeader = new(v1.BeaconBlockHeader)
+	}
+	if err = l.AttestedHeader.UnmarshalSSZ(buf[0:112]); err != nil {
+		return err
+	}
+
+	// Field (1) 'NextSyncCommittee'
+	if l.NextSyncCommittee == nil {
+		l.NextSyncCommittee = new(SyncCommittee)
+	}
+	if err = l.NextSyncCommittee.UnmarshalSSZ(buf[112:24736]); err != nil {
+		return err
+	}
+
+	// Field (2) 'NextSyncCommitteeBranch'
+	l.NextSyncCommitteeBranch = make([][]byte, 5)
+	for ii := 0; ii < 5; ii++ {
+		if cap(l.NextSyncCommitteeBranch[ii]) == 0 {
+			l.NextSyncCommitteeBranch[ii] = make([]byte, 0, len(buf[24736:24896][ii*32:(ii+1)*32]))
+		}
+		l.NextSyncCommitteeBranch[ii] = append(l.NextSyncCommitteeBranch[ii], buf[24736:24896][ii*32:(ii+1)*32]...)
+	}
+
+	// Field (3) 'FinalizedHeader'
+	if l.FinalizedHeader == nil {
+		l.FinalizedHeader = new(v1.BeaconBlockHeader)
+	}
+	if err = l.FinalizedHeader.UnmarshalSSZ(buf[24896:25008]); err != nil {
+		return err
+	}
+
+	// Field (4) 'FinalityBranch'
+	l.FinalityBranch = make([][]byte, 6)
+	for ii := 0; ii < 6; ii++ {
+		if cap(l.FinalityBranch[ii]) == 0 {
+			l.FinalityBranch[ii] = make([]byte, 0, len(buf[25008:25200][ii*32:(ii+1)*32]))
+		}
+		l.FinalityBranch[ii] = append(l.FinalityBranch[ii], buf[25008:25200][ii*32:(ii+1)*32]...)
+	}
+
+	// Field (5) 'SyncAggregate'
+	if l.SyncAggregate == nil {
+		l.SyncAggregate = new(v1.SyncAggregate)
+	}
+	if err = l.SyncAggregate.UnmarshalSSZ(buf[25200:25360]); err != nil {
+		return err
+	}
+
+	// Field (6) 'SignatureSlot'
+	l.SignatureSlot = github_com_prysmaticlabs_prysm_v4_consensus_types_primitives.Slot(ssz.UnmarshallUint64(buf[25360:25368]))
+
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the LightClientUpdate object
+func (l *LightClientUpdate) SizeSSZ() (size int) {
+	size = 25368
+	return
+}
+
+// HashTreeRoot ssz hashes the LightClientUpdate object
+func (l *LightClientUpdate) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(l)
+}
+
+// HashTreeRootWith ssz hashes the LightClientUpdate object with a hasher
+func (l *LightClientUpdate) HashTreeRootWith(hh *ssz.Hasher) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'AttestedHeader'
+	if err = l.AttestedHeader.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	// Field (1) 'NextSyncCommittee'
+	if err = l.NextSyncCommittee.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	// Field (2) 'NextSyncCommitteeBranch'
+	{
+		if size := len(l.NextSyncCommitteeBranch); size != 5 {
+			err = ssz.ErrVectorLengthFn("--.NextSyncCommitteeBranch", size, 5)
+			return
+		}
+		subIndx := hh.Index()
+		for _, i := range l.NextSyncCommitteeBranch {
+			if len(i) != 32 {
+				err = ssz.ErrBytesLength
+				return
+			}
+			hh.Append(i)
+		}
+
+		if ssz.EnableVectorizedHTR {
+			hh.MerkleizeVectorizedHTR(subIndx)
+		} else {
+			hh.Merkleize(subIndx)
+		}
+	}
+
+	// Field (3) 'FinalizedHeader'
+	if err = l.FinalizedHeader.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	// Field (4) 'FinalityBranch'
+	{
+		if size := len(l.FinalityBranch); size != 6 {
+			err = ssz.ErrVectorLengthFn("--.FinalityBranch", size, 6)
+			return
+		}
+		subIndx := hh.Index()
+		for _, i := range l.FinalityBranch {
+			if len(i) != 32 {
+				err = ssz.ErrBytesLength
+				return
+			}
+			hh.Append(i)
+		}
+
+		if ssz.EnableVectorizedHTR {
+			hh.MerkleizeVectorizedHTR(subIndx)
+		} else {
+			hh.Merkleize(subIndx)
+		}
+	}
+
+	// Field (5) 'SyncAggregate'
+	if err = l.SyncAggregate.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	// Field (6) 'SignatureSlot'
+	hh.PutUint64(uint64(l.SignatureSlot))
+
+	if ssz.EnableVectorizedHTR {
+		hh.MerkleizeVectorizedHTR(indx)
+	} else {
+		hh.Merkleize(indx)
+	}
+	return
+}
+
+// MarshalSSZ ssz marshals the LightClientFinalityUpdate object
+func (l *LightClientFinalityUpdate) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(l)
+}
+
+// MarshalSSZTo ssz marshals the LightClientFinalityUpdate object to a target array
+func (l *LightClientFinalityUpdate) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'AttestedHeader'
+	if l.AttestedHeader == nil {
+		l.AttestedHeader = new(v1.BeaconBlockHeader)
+	}
+	if dst, err = l.AttestedHeader.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	// Field (1) 'FinalizedHeader'
+	if l.FinalizedHeader == nil {
+		l.FinalizedHeader = new(v1.BeaconBlockHeader)
+	}
+	if dst, err = l.FinalizedHeader.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	// Field (2) 'FinalityBranch'
+	if size := len(l.FinalityBranch); size != 6 {
+		err = ssz.ErrVectorLengthFn("--.FinalityBranch", size, 6)
+		return
+	}
+	for ii := 0; ii < 6; ii++ {
+		if size := len(l.FinalityBranch[ii]); size != 32 {
+			err = ssz.ErrBytesLengthFn("--.FinalityBranch[ii]", size, 32)
+			return
+		}
+		dst = append(dst, l.FinalityBranch[ii]...)
+	}
+
+	// Field (3) 'SyncAggregate'
+	if l.SyncAggregate == nil {
+		l.SyncAggregate = new(v1.SyncAggregate)
+	}
+	if dst, err = l.SyncAggregate.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	// Field (4) 'SignatureSlot'
+	dst = ssz.MarshalUint64(dst, uint64(l.SignatureSlot))
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the LightClientFinalityUpdate object
+func (l *LightClientFinalityUpdate) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 584 {
+		return ssz.ErrSize
+	}
+
+	// Field (0) 'AttestedHeader'
+	if l.AttestedHeader == nil {
+		l.AttestedHeader = new(v1.BeaconBlockHeader)
+	}
+	if err = l.AttestedHeader.UnmarshalSSZ(buf[0:112]); err != nil {
+		return err
+	}
+
+	// Field (1) 'FinalizedHeader'
+	if l.FinalizedHeader == nil {
+		l.FinalizedHeader = new(v1.BeaconBlockHeader)
+	}
+	if err = l.FinalizedHeader.UnmarshalSSZ(buf[112:224]); err != nil {
+		return err
+	}
+
+	// Field (2) 'FinalityBranch'
+	l.FinalityBranch = make([][]byte, 6)
+	for ii := 0; ii < 6; ii++ {
+		if cap(l.FinalityBranch[ii]) == 0 {
+			l.FinalityBranch[ii] = make([]byte, 0, len(buf[224:416][ii*32:(ii+1)*32]))
+		}
+		l.FinalityBranch[ii] = append(l.FinalityBranch[ii], buf[224:416][ii*32:(ii+1)*32]...)
+	}
+
+	// Field (3) 'SyncAggregate'
+	if l.SyncAggregate == nil {
+		l.SyncAggregate = new(v1.SyncAggregate)
+	}
+	if err = l.SyncAggregate.UnmarshalSSZ(buf[416:576]); err != nil {
+		return err
+	}
+
+	// Field (4) 'SignatureSlot'
+	l.SignatureSlot = github_com_prysmaticlabs_prysm_v4_consensus_types_primitives.Slot(ssz.UnmarshallUint64(buf[576:584]))
+
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the LightClientFinalityUpdate object
+func (l *LightClientFinalityUpdate) SizeSSZ() (size int) {
+	size = 584
+	return
+}
+
+// HashTreeRoot ssz hashes the LightClientFinalityUpdate object
+func (l *LightClientFinalityUpdate) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(l)
+}
+
+// HashTreeRootWith ssz hashes the LightClientFinalityUpdate object with a hasher
+func (l *LightClientFinalityUpdate) HashTreeRootWith(hh *ssz.Hasher) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'AttestedHeader'
+	if err = l.AttestedHeader.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	// Field (1) 'FinalizedHeader'
+	if err = l.FinalizedHeader.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	// Field (2) 'FinalityBranch'
+	{
+		if size := len(l.FinalityBranch); size != 6 {
+			err = ssz.ErrVectorLengthFn("--.FinalityBranch", size, 6)
+			return
+		}
+		subIndx := hh.Index()
+		for _, i := range l.FinalityBranch {
+			if len(i) != 32 {
+				err = ssz.ErrBytesLength
+				return
+			}
+			hh.Append(i)
+		}
+
+		if ssz.EnableVectorizedHTR {
+			hh.MerkleizeVectorizedHTR(subIndx)
+		} else {
+			hh.Merkleize(subIndx)
+		}
+	}
+
+	// Field (3) 'SyncAggregate'
+	if err = l.SyncAggregate.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	// Field (4) 'SignatureSlot'
+	hh.PutUint64(uint64(l.SignatureSlot))
+
+	if ssz.EnableVectorizedHTR {
+		hh.MerkleizeVectorizedHTR(indx)
+	} else {
+		hh.Merkleize(indx)
+	}
+	return
+}
+
+// MarshalSSZ ssz marshals the LightClientOptimisticUpdate object
+func (l *LightClientOptimisticUpdate) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(l)
+}
+
+// MarshalSSZTo ssz marshals the LightClientOptimisticUpdate object to a target array
+func (l *LightClientOptimisticUpdate) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'AttestedHeader'
+	if l.AttestedHeader == nil {
+		l.AttestedHeader = new(v1.BeaconBlockHeader)
+	}
+	if dst, err = l.AttestedHeader.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	// Field (1) 'SyncAggregate'
+	if l.SyncAggregate == nil {
+		l.SyncAggregate = new(v1.SyncAggregate)
+	}
+	if dst, err = l.SyncAggregate.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	// Field (2) 'SignatureSlot'
+	dst = ssz.MarshalUint64(dst, uint64(l.SignatureSlot))
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the LightClientOptimisticUpdate object
+func (l *LightClientOptimisticUpdate) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 280 {
+		return ssz.ErrSize
+	}
+
+	// Field (0) 'AttestedHeader'
+	if l.AttestedHeader == nil {
+		l.AttestedHeader = new(v1.BeaconBlockHeader)
+	}
+	if err = l.AttestedHeader.UnmarshalSSZ(buf[0:112]); err != nil {
+		return err
+	}
+
+	// Field (1) 'SyncAggregate'
+	if l.SyncAggregate == nil {
+		l.SyncAggregate = new(v1.SyncAggregate)
+	}
+	if err = l.SyncAggregate.UnmarshalSSZ(buf[112:272]); err != nil {
+		return err
+	}
+
+	// Field (2) 'SignatureSlot'
+	l.SignatureSlot = github_com_prysmaticlabs_prysm_v4_consensus_types_primitives.Slot(ssz.UnmarshallUint64(buf[272:280]))
+
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the LightClientOptimisticUpdate object
+func (l *LightClientOptimisticUpdate) SizeSSZ() (size int) {
+	size = 280
+	return
+}
+
+// HashTreeRoot ssz hashes the LightClientOptimisticUpdate object
+func (l *LightClientOptimisticUpdate) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(l)
+}
+
+// HashTreeRootWith ssz hashes the LightClientOptimisticUpdate object with a hasher
+func (l *LightClientOptimisticUpdate) HashTreeRootWith(hh *ssz.Hasher) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'AttestedHeader'
+	if err = l.AttestedHeader.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	// Field (1) 'SyncAggregate'
+	if err = l.SyncAggregate.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	// Field (2) 'SignatureSlot'
+	hh.PutUint64(uint64(l.SignatureSlot))
+
+	if ssz.EnableVectorizedHTR {
+		hh.MerkleizeVectorizedHTR(indx)
+	} else {
+		hh.Merkleize(indx)
+	}
+	return
+}
+
 // MarshalSSZ ssz marshals the BlobSidecars object
 func (b *BlobSidecars) MarshalSSZ() ([]byte, error) {
 	return ssz.MarshalSSZ(b)
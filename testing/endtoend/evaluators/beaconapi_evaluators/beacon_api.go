@@ -13,6 +13,7 @@ import (
 	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/rpc/apimiddleware"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/rpc/eth/beacon"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/rpc/eth/node"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/rpc/eth/validator"
 	"github.com/prysmaticlabs/prysm/v4/config/params"
 	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
@@ -49,10 +50,10 @@ var beaconPathsAndObjects = map[string]metadata{
 			return []string{"head"}
 		},
 		prysmResps: map[string]interface{}{
-			"json": &apimiddleware.StateRootResponseJson{},
+			"json": &beacon.GetStateRootResponse{},
 		},
 		lighthouseResps: map[string]interface{}{
-			"json": &apimiddleware.StateRootResponseJson{},
+			"json": &beacon.GetStateRootResponse{},
 		},
 	},
 	"/beacon/states/{param1}/finality_checkpoints": {
@@ -80,11 +81,11 @@ var beaconPathsAndObjects = map[string]metadata{
 
 		},
 		prysmResps: map[string]interface{}{
-			"json": &apimiddleware.BlockResponseJson{},
+			"json": &beacon.GetBlockV2Response{},
 			"ssz":  []byte{},
 		},
 		lighthouseResps: map[string]interface{}{
-			"json": &apimiddleware.BlockResponseJson{},
+			"json": &beacon.GetBlockV2Response{},
 			"ssz":  []byte{},
 		},
 	},
@@ -207,17 +208,17 @@ var beaconPathsAndObjects = map[string]metadata{
 			return []string{}
 		},
 		prysmResps: map[string]interface{}{
-			"json": &apimiddleware.IdentityResponseJson{},
+			"json": &node.IdentityResponse{},
 		},
 		lighthouseResps: map[string]interface{}{
-			"json": &apimiddleware.IdentityResponseJson{},
+			"json": &node.IdentityResponse{},
 		},
 		customEvaluation: func(prysmResp interface{}, lhouseResp interface{}) error {
-			castedp, ok := prysmResp.(*apimiddleware.IdentityResponseJson)
+			castedp, ok := prysmResp.(*node.IdentityResponse)
 			if !ok {
 				return errors.New("failed to cast type")
 			}
-			castedl, ok := lhouseResp.(*apimiddleware.IdentityResponseJson)
+			castedl, ok := lhouseResp.(*node.IdentityResponse)
 			if !ok {
 				return errors.New("failed to cast type")
 			}
@@ -236,17 +237,17 @@ var beaconPathsAndObjects = map[string]metadata{
 			return []string{}
 		},
 		prysmResps: map[string]interface{}{
-			"json": &apimiddleware.PeersResponseJson{},
+			"json": &node.PeersResponse{},
 		},
 		lighthouseResps: map[string]interface{}{
-			"json": &apimiddleware.PeersResponseJson{},
+			"json": &node.PeersResponse{},
 		},
 		customEvaluation: func(prysmResp interface{}, lhouseResp interface{}) error {
-			castedp, ok := prysmResp.(*apimiddleware.PeersResponseJson)
+			castedp, ok := prysmResp.(*node.PeersResponse)
 			if !ok {
 				return errors.New("failed to cast type")
 			}
-			castedl, ok := lhouseResp.(*apimiddleware.PeersResponseJson)
+			castedl, ok := lhouseResp.(*node.PeersResponse)
 			if !ok {
 				return errors.New("failed to cast type")
 			}
@@ -1,15 +1,13 @@
 package evaluators
 
 import (
-	"context"
+	"encoding/json"
 	"strconv"
 
 	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/rpc/eth/beacon"
 	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
 	mathutil "github.com/prysmaticlabs/prysm/v4/math"
-	"github.com/prysmaticlabs/prysm/v4/proto/eth/service"
-	v1 "github.com/prysmaticlabs/prysm/v4/proto/eth/v1"
-	v2 "github.com/prysmaticlabs/prysm/v4/proto/eth/v2"
 	"github.com/prysmaticlabs/prysm/v4/testing/endtoend/policies"
 	"github.com/prysmaticlabs/prysm/v4/testing/endtoend/types"
 	"github.com/prysmaticlabs/prysm/v4/time/slots"
@@ -24,38 +22,28 @@ var OptimisticSyncEnabled = types.Evaluator{
 }
 
 func optimisticSyncEnabled(_ *types.EvaluationContext, conns ...*grpc.ClientConn) error {
-	for _, conn := range conns {
-		client := service.NewBeaconChainClient(conn)
-		head, err := client.GetBlindedBlock(context.Background(), &v1.BlockRequest{BlockId: []byte("head")})
-		if err != nil {
+	for nodeIdx := range conns {
+		head := &beacon.GetBlockV2Response{}
+		if err := doMiddlewareJSONGetRequestV1("/beacon/blinded_blocks/head", nodeIdx, head); err != nil {
 			return err
 		}
-		headSlot := uint64(0)
-		switch hb := head.Data.Message.(type) {
-		case *v2.SignedBlindedBeaconBlockContainer_Phase0Block:
-			headSlot = uint64(hb.Phase0Block.Slot)
-		case *v2.SignedBlindedBeaconBlockContainer_AltairBlock:
-			headSlot = uint64(hb.AltairBlock.Slot)
-		case *v2.SignedBlindedBeaconBlockContainer_BellatrixBlock:
-			headSlot = uint64(hb.BellatrixBlock.Slot)
-		case *v2.SignedBlindedBeaconBlockContainer_CapellaBlock:
-			headSlot = uint64(hb.CapellaBlock.Slot)
-		default:
-			return errors.New("no valid block type retrieved")
+		headSlot, err := blockSlot(head)
+		if err != nil {
+			return err
 		}
-		currEpoch := slots.ToEpoch(primitives.Slot(headSlot))
+		currEpoch := slots.ToEpoch(headSlot)
 		startSlot, err := slots.EpochStart(currEpoch)
 		if err != nil {
 			return err
 		}
 		isOptimistic := false
-		for i := startSlot; i <= primitives.Slot(headSlot); i++ {
+		for i := startSlot; i <= headSlot; i++ {
 			castI, err := mathutil.Int(uint64(i))
 			if err != nil {
 				return err
 			}
-			block, err := client.GetBlindedBlock(context.Background(), &v1.BlockRequest{BlockId: []byte(strconv.Itoa(castI))})
-			if err != nil {
+			block := &beacon.GetBlockV2Response{}
+			if err := doMiddlewareJSONGetRequestV1("/beacon/blinded_blocks/"+strconv.Itoa(castI), nodeIdx, block); err != nil {
 				// Continue in the event of non-existent blocks.
 				continue
 			}
@@ -70,3 +58,20 @@ func optimisticSyncEnabled(_ *types.EvaluationContext, conns ...*grpc.ClientConn
 	}
 	return nil
 }
+
+func blockSlot(resp *beacon.GetBlockV2Response) (primitives.Slot, error) {
+	if resp.Data == nil {
+		return 0, errors.New("no valid block retrieved")
+	}
+	msg := &struct {
+		Slot string `json:"slot"`
+	}{}
+	if err := json.Unmarshal(resp.Data.Message, msg); err != nil {
+		return 0, errors.Wrap(err, "could not decode block message")
+	}
+	slot, err := strconv.ParseUint(msg.Slot, 10, 64)
+	if err != nil {
+		return 0, errors.Wrap(err, "could not parse block slot")
+	}
+	return primitives.Slot(slot), nil
+}
@@ -11,9 +11,9 @@ import (
 	"github.com/prysmaticlabs/prysm/v4/consensus-types/interfaces"
 	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/v4/encoding/bytesutil"
-	ethpbservice "github.com/prysmaticlabs/prysm/v4/proto/eth/service"
-	"github.com/prysmaticlabs/prysm/v4/proto/eth/v2"
+	"github.com/prysmaticlabs/prysm/v4/encoding/ssz/detect"
 	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v4/runtime/version"
 	"github.com/prysmaticlabs/prysm/v4/testing/endtoend/helpers"
 	e2eparams "github.com/prysmaticlabs/prysm/v4/testing/endtoend/params"
 	"github.com/prysmaticlabs/prysm/v4/testing/endtoend/policies"
@@ -109,7 +109,7 @@ func validatorsAreActive(ec *types.EvaluationContext, conns ...*grpc.ClientConn)
 func validatorsParticipating(_ *types.EvaluationContext, conns ...*grpc.ClientConn) error {
 	conn := conns[0]
 	client := ethpb.NewBeaconChainClient(conn)
-	debugClient := ethpbservice.NewBeaconDebugClient(conn)
+	debugClient := ethpb.NewDebugClient(conn)
 	validatorRequest := &ethpb.GetValidatorParticipationRequest{}
 	participation, err := client.GetValidatorParticipation(context.Background(), validatorRequest)
 	if err != nil {
@@ -128,33 +128,36 @@ func validatorsParticipating(_ *types.EvaluationContext, conns ...*grpc.ClientCo
 		expected = 0.95
 	}
 	if partRate < expected {
-		st, err := debugClient.GetBeaconStateV2(context.Background(), &eth.BeaconStateRequestV2{StateId: []byte("head")})
+		chainHead, err := client.GetChainHead(context.Background(), &emptypb.Empty{})
+		if err != nil {
+			return errors.Wrap(err, "could not get chain head")
+		}
+		stObj, err := debugClient.GetBeaconState(context.Background(), &ethpb.BeaconStateRequest{
+			QueryFilter: &ethpb.BeaconStateRequest_Slot{Slot: chainHead.HeadSlot},
+		})
 		if err != nil {
 			return errors.Wrap(err, "failed to get beacon state")
 		}
+		versionedMarshaler, err := detect.FromState(stObj.Encoded)
+		if err != nil {
+			return errors.Wrap(err, "could not get state marshaler")
+		}
+		st, err := versionedMarshaler.UnmarshalBeaconState(stObj.Encoded)
+		if err != nil {
+			return errors.Wrap(err, "could not get state")
+		}
 		var missSrcVals []uint64
 		var missTgtVals []uint64
 		var missHeadVals []uint64
-		switch obj := st.Data.State.(type) {
-		case *eth.BeaconStateContainer_Phase0State:
-		// Do Nothing
-		case *eth.BeaconStateContainer_AltairState:
-			missSrcVals, missTgtVals, missHeadVals, err = findMissingValidators(obj.AltairState.PreviousEpochParticipation)
+		if st.Version() >= version.Altair {
+			prevParticipation, err := st.PreviousEpochParticipation()
 			if err != nil {
-				return errors.Wrap(err, "failed to get missing validators")
+				return errors.Wrap(err, "failed to get previous epoch participation")
 			}
-		case *eth.BeaconStateContainer_BellatrixState:
-			missSrcVals, missTgtVals, missHeadVals, err = findMissingValidators(obj.BellatrixState.PreviousEpochParticipation)
+			missSrcVals, missTgtVals, missHeadVals, err = findMissingValidators(prevParticipation)
 			if err != nil {
 				return errors.Wrap(err, "failed to get missing validators")
 			}
-		case *eth.BeaconStateContainer_CapellaState:
-			missSrcVals, missTgtVals, missHeadVals, err = findMissingValidators(obj.CapellaState.PreviousEpochParticipation)
-			if err != nil {
-				return errors.Wrap(err, "failed to get missing validators")
-			}
-		default:
-			return fmt.Errorf("unrecognized version: %v", st.Version)
 		}
 		return fmt.Errorf(
 			"validator participation was below for epoch %d, expected %f, received: %f."+
@@ -5,12 +5,16 @@ import (
 
 	"github.com/prysmaticlabs/prysm/v4/validator/keymanager"
 	remoteweb3signer "github.com/prysmaticlabs/prysm/v4/validator/keymanager/remote-web3signer"
+	"github.com/prysmaticlabs/prysm/v4/validator/keymanager/secretstore"
+	"github.com/prysmaticlabs/prysm/v4/validator/keymanager/threshold"
 )
 
 // InitKeymanagerConfig defines configuration options for initializing a keymanager.
 type InitKeymanagerConfig struct {
-	ListenForChanges bool
-	Web3SignerConfig *remoteweb3signer.SetupConfig
+	ListenForChanges      bool
+	Web3SignerConfig      *remoteweb3signer.SetupConfig
+	ThresholdSignerConfig *threshold.SetupConfig
+	SecretStoreConfig     *secretstore.SetupConfig
 }
 
 // Wallet defines a struct which has capabilities and knowledge of how
@@ -19,6 +19,8 @@ import (
 	"github.com/prysmaticlabs/prysm/v4/validator/keymanager/derived"
 	"github.com/prysmaticlabs/prysm/v4/validator/keymanager/local"
 	remoteweb3signer "github.com/prysmaticlabs/prysm/v4/validator/keymanager/remote-web3signer"
+	"github.com/prysmaticlabs/prysm/v4/validator/keymanager/secretstore"
+	"github.com/prysmaticlabs/prysm/v4/validator/keymanager/threshold"
 	"github.com/sirupsen/logrus"
 	"github.com/urfave/cli/v2"
 )
@@ -204,6 +206,28 @@ func NewWalletForWeb3Signer() *Wallet {
 	}
 }
 
+// NewWalletForThresholdSigner returns a new wallet for a threshold signer which is temporary and not stored locally.
+func NewWalletForThresholdSigner() *Wallet {
+	// wallet is just a temporary wallet for the threshold signer used to call initialize keymanager.
+	return &Wallet{
+		walletDir:      "",
+		accountsPath:   "",
+		keymanagerKind: keymanager.Threshold,
+		walletPassword: "",
+	}
+}
+
+// NewWalletForSecretStore returns a new wallet for a secret store which is temporary and not stored locally.
+func NewWalletForSecretStore() *Wallet {
+	// wallet is just a temporary wallet for the secret store used to call initialize keymanager.
+	return &Wallet{
+		walletDir:      "",
+		accountsPath:   "",
+		keymanagerKind: keymanager.SecretStore,
+		walletPassword: "",
+	}
+}
+
 // OpenWallet instantiates a wallet from a specified path. It checks the
 // type of keymanager associated with the wallet by reading files in the wallet
 // path, if applicable. If a wallet does not exist, returns an appropriate error.
@@ -299,6 +323,25 @@ func (w *Wallet) InitializeKeymanager(ctx context.Context, cfg iface.InitKeymana
 		if err != nil {
 			return nil, errors.Wrap(err, "could not initialize web3signer keymanager")
 		}
+	case keymanager.Threshold:
+		if cfg.ThresholdSignerConfig == nil {
+			return nil, errors.New("threshold signer config is nil")
+		}
+		km, err = threshold.NewKeymanager(ctx, cfg.ThresholdSignerConfig)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not initialize threshold keymanager")
+		}
+	case keymanager.SecretStore:
+		if cfg.SecretStoreConfig == nil {
+			return nil, errors.New("secret store config is nil")
+		}
+		km, err = secretstore.NewKeymanager(ctx, &secretstore.SetupConfig{
+			Provider:         cfg.SecretStoreConfig.Provider,
+			ListenForChanges: cfg.ListenForChanges,
+		})
+		if err != nil {
+			return nil, errors.Wrap(err, "could not initialize secret store keymanager")
+		}
 	default:
 		return nil, fmt.Errorf("keymanager kind not supported: %s", w.keymanagerKind)
 	}
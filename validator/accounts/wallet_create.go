@@ -65,6 +65,10 @@ func (acm *AccountsCLIManager) WalletCreate(ctx context.Context) (*wallet.Wallet
 		)
 	case keymanager.Web3Signer:
 		return nil, errors.New("web3signer keymanager does not require persistent wallets.")
+	case keymanager.Threshold:
+		return nil, errors.New("threshold keymanager does not require persistent wallets.")
+	case keymanager.SecretStore:
+		return nil, errors.New("secret store keymanager does not require persistent wallets.")
 	default:
 		return nil, errors.Wrapf(err, errKeymanagerNotSupported, w.KeymanagerKind())
 	}
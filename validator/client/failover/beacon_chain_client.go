@@ -0,0 +1,55 @@
+package failover
+
+import (
+	"context"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v4/validator/client/iface"
+)
+
+type beaconChainClient struct {
+	nodes *Nodes
+}
+
+// NewBeaconChainClient returns a beacon chain client that queries the healthiest beacon node,
+// failing over to the other nodes on errors.
+func NewBeaconChainClient(nodes *Nodes) iface.BeaconChainClient {
+	return &beaconChainClient{nodes: nodes}
+}
+
+func (c *beaconChainClient) GetChainHead(ctx context.Context, in *empty.Empty) (*ethpb.ChainHead, error) {
+	return call(ctx, c.nodes, "GetChainHead", func(n *Node) (*ethpb.ChainHead, error) {
+		return n.beaconChainClient.GetChainHead(ctx, in)
+	})
+}
+
+func (c *beaconChainClient) ListValidatorBalances(ctx context.Context, in *ethpb.ListValidatorBalancesRequest) (*ethpb.ValidatorBalances, error) {
+	return call(ctx, c.nodes, "ListValidatorBalances", func(n *Node) (*ethpb.ValidatorBalances, error) {
+		return n.beaconChainClient.ListValidatorBalances(ctx, in)
+	})
+}
+
+func (c *beaconChainClient) ListValidators(ctx context.Context, in *ethpb.ListValidatorsRequest) (*ethpb.Validators, error) {
+	return call(ctx, c.nodes, "ListValidators", func(n *Node) (*ethpb.Validators, error) {
+		return n.beaconChainClient.ListValidators(ctx, in)
+	})
+}
+
+func (c *beaconChainClient) GetValidatorQueue(ctx context.Context, in *empty.Empty) (*ethpb.ValidatorQueue, error) {
+	return call(ctx, c.nodes, "GetValidatorQueue", func(n *Node) (*ethpb.ValidatorQueue, error) {
+		return n.beaconChainClient.GetValidatorQueue(ctx, in)
+	})
+}
+
+func (c *beaconChainClient) GetValidatorPerformance(ctx context.Context, in *ethpb.ValidatorPerformanceRequest) (*ethpb.ValidatorPerformanceResponse, error) {
+	return call(ctx, c.nodes, "GetValidatorPerformance", func(n *Node) (*ethpb.ValidatorPerformanceResponse, error) {
+		return n.beaconChainClient.GetValidatorPerformance(ctx, in)
+	})
+}
+
+func (c *beaconChainClient) GetValidatorParticipation(ctx context.Context, in *ethpb.GetValidatorParticipationRequest) (*ethpb.ValidatorParticipationResponse, error) {
+	return call(ctx, c.nodes, "GetValidatorParticipation", func(n *Node) (*ethpb.ValidatorParticipationResponse, error) {
+		return n.beaconChainClient.GetValidatorParticipation(ctx, in)
+	})
+}
@@ -0,0 +1,5 @@
+package failover
+
+import "github.com/sirupsen/logrus"
+
+var log = logrus.WithField("prefix", "failover")
@@ -0,0 +1,33 @@
+package failover
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	nodeHealthScore = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "validator",
+			Name:      "beacon_node_health_score",
+			Help:      "The health score of a beacon node the validator client is connected to, higher is healthier.",
+		},
+		[]string{"endpoint"},
+	)
+	nodeRequestFailures = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "validator",
+			Name:      "beacon_node_request_failures_total",
+			Help:      "The number of requests to a beacon node that failed.",
+		},
+		[]string{"endpoint", "method"},
+	)
+	nodeFailovers = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "validator",
+			Name:      "beacon_node_failovers_total",
+			Help:      "The number of requests that were retried on another beacon node after a failure.",
+		},
+		[]string{"method"},
+	)
+)
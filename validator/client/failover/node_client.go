@@ -0,0 +1,43 @@
+package failover
+
+import (
+	"context"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v4/validator/client/iface"
+)
+
+type nodeClient struct {
+	nodes *Nodes
+}
+
+// NewNodeClient returns a node client that queries the healthiest beacon node, failing over to the
+// other nodes on errors.
+func NewNodeClient(nodes *Nodes) iface.NodeClient {
+	return &nodeClient{nodes: nodes}
+}
+
+func (c *nodeClient) GetSyncStatus(ctx context.Context, in *empty.Empty) (*ethpb.SyncStatus, error) {
+	return call(ctx, c.nodes, "GetSyncStatus", func(n *Node) (*ethpb.SyncStatus, error) {
+		return n.nodeClient.GetSyncStatus(ctx, in)
+	})
+}
+
+func (c *nodeClient) GetGenesis(ctx context.Context, in *empty.Empty) (*ethpb.Genesis, error) {
+	return call(ctx, c.nodes, "GetGenesis", func(n *Node) (*ethpb.Genesis, error) {
+		return n.nodeClient.GetGenesis(ctx, in)
+	})
+}
+
+func (c *nodeClient) GetVersion(ctx context.Context, in *empty.Empty) (*ethpb.Version, error) {
+	return call(ctx, c.nodes, "GetVersion", func(n *Node) (*ethpb.Version, error) {
+		return n.nodeClient.GetVersion(ctx, in)
+	})
+}
+
+func (c *nodeClient) ListPeers(ctx context.Context, in *empty.Empty) (*ethpb.Peers, error) {
+	return call(ctx, c.nodes, "ListPeers", func(n *Node) (*ethpb.Peers, error) {
+		return n.nodeClient.ListPeers(ctx, in)
+	})
+}
@@ -0,0 +1,283 @@
+// Package failover lets the validator client use several beacon nodes at once. Requests for data
+// are sent to the healthiest node and retried on the next healthiest one when they fail, while
+// signed messages are broadcast to every node.
+package failover
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v4/validator/client/iface"
+)
+
+const (
+	// maxScore is the score of a synced node at the highest known head that never errors.
+	maxScore = 100
+	// syncingPenalty is subtracted from the score of a node that reports it is syncing or
+	// whose sync status could not be determined.
+	syncingPenalty = 50
+	// slotLagPenalty is subtracted for every slot the head of a node is behind the best known head.
+	slotLagPenalty = 5
+	// maxSlotLagPenalty caps the penalty for head slot lag.
+	maxSlotLagPenalty = 30
+	// errorRatePenalty is the penalty of a node whose recent requests all failed.
+	errorRatePenalty = 40
+	// latencyBucket is the latency step that costs a node one point.
+	latencyBucket = 250 * time.Millisecond
+	// maxLatencyPenalty caps the penalty for latency.
+	maxLatencyPenalty = 10
+	// ewmaWeight is the weight of the newest sample in the error rate and latency moving averages.
+	ewmaWeight = 0.2
+	// healthCheckTimeout bounds each request of a health check, so that a hung node is marked
+	// unhealthy instead of holding up the checks of the other nodes.
+	healthCheckTimeout = 3 * time.Second
+)
+
+// Node is a single beacon node, reachable through the clients of one transport.
+type Node struct {
+	endpoint          string
+	validatorClient   iface.ValidatorClient
+	nodeClient        iface.NodeClient
+	beaconChainClient iface.BeaconChainClient
+
+	synced    bool
+	headSlot  primitives.Slot
+	errorRate float64
+	latency   time.Duration
+}
+
+// NewNode creates a beacon node from the clients connected to it.
+func NewNode(endpoint string, v iface.ValidatorClient, n iface.NodeClient, b iface.BeaconChainClient) *Node {
+	return &Node{
+		endpoint:          endpoint,
+		validatorClient:   v,
+		nodeClient:        n,
+		beaconChainClient: b,
+		// Assume a node is healthy until the first health check says otherwise.
+		synced: true,
+	}
+}
+
+// Endpoint of the beacon node.
+func (n *Node) Endpoint() string {
+	return n.endpoint
+}
+
+// Nodes tracks the health of a set of beacon nodes and ranks them from the healthiest to the
+// least healthy one.
+type Nodes struct {
+	nodes []*Node
+	sync.RWMutex
+}
+
+// NewNodes creates the set of beacon nodes. Nodes that are equally healthy are ranked in the order
+// given, so the first node acts as the primary.
+func NewNodes(nodes ...*Node) *Nodes {
+	return &Nodes{nodes: nodes}
+}
+
+// Start checks the health of the beacon nodes every interval until the context is canceled.
+func (n *Nodes) Start(ctx context.Context, interval time.Duration) {
+	go func() {
+		n.CheckHealth(ctx)
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				n.CheckHealth(ctx)
+			}
+		}
+	}()
+}
+
+// CheckHealth queries the sync status and head of every beacon node.
+func (n *Nodes) CheckHealth(ctx context.Context) {
+	var wg sync.WaitGroup
+	for _, node := range n.nodes {
+		wg.Add(1)
+		go func(node *Node) {
+			defer wg.Done()
+			n.checkNode(ctx, node)
+		}(node)
+	}
+	wg.Wait()
+
+	for _, node := range n.Ranked() {
+		nodeHealthScore.WithLabelValues(node.endpoint).Set(float64(n.Score(node)))
+	}
+}
+
+func (n *Nodes) checkNode(ctx context.Context, node *Node) {
+	reqCtx, cancel := context.WithTimeout(ctx, healthCheckTimeout)
+	start := time.Now()
+	status, err := node.nodeClient.GetSyncStatus(reqCtx, &empty.Empty{})
+	cancel()
+	n.record(node, time.Since(start), err)
+	if err != nil {
+		n.Lock()
+		node.synced = false
+		n.Unlock()
+		log.WithError(err).WithField("endpoint", node.endpoint).Debug("Could not get beacon node sync status")
+		return
+	}
+	reqCtx, cancel = context.WithTimeout(ctx, healthCheckTimeout)
+	start = time.Now()
+	head, err := node.beaconChainClient.GetChainHead(reqCtx, &empty.Empty{})
+	cancel()
+	n.record(node, time.Since(start), err)
+
+	n.Lock()
+	defer n.Unlock()
+	node.synced = !status.Syncing
+	if err != nil {
+		log.WithError(err).WithField("endpoint", node.endpoint).Debug("Could not get beacon node chain head")
+		return
+	}
+	node.headSlot = head.HeadSlot
+}
+
+// record updates the error rate and latency of a node with the outcome of a request.
+func (n *Nodes) record(node *Node, latency time.Duration, err error) {
+	n.Lock()
+	defer n.Unlock()
+	failed := 0.0
+	if err != nil {
+		failed = 1
+	}
+	node.errorRate = (1-ewmaWeight)*node.errorRate + ewmaWeight*failed
+	if err == nil {
+		if node.latency == 0 {
+			node.latency = latency
+		} else {
+			node.latency = time.Duration((1-ewmaWeight)*float64(node.latency) + ewmaWeight*float64(latency))
+		}
+	}
+}
+
+// Score of a beacon node, between 0 and maxScore.
+func (n *Nodes) Score(node *Node) int {
+	n.RLock()
+	defer n.RUnlock()
+	return n.score(node, n.bestHeadSlot())
+}
+
+func (n *Nodes) score(node *Node, bestHead primitives.Slot) int {
+	score := maxScore
+	if !node.synced {
+		score -= syncingPenalty
+	}
+	lag := int(bestHead - node.headSlot)
+	if lag*slotLagPenalty > maxSlotLagPenalty {
+		score -= maxSlotLagPenalty
+	} else {
+		score -= lag * slotLagPenalty
+	}
+	score -= int(node.errorRate * errorRatePenalty)
+	latencyPenalty := int(node.latency / latencyBucket)
+	if latencyPenalty > maxLatencyPenalty {
+		latencyPenalty = maxLatencyPenalty
+	}
+	score -= latencyPenalty
+	if score < 0 {
+		return 0
+	}
+	return score
+}
+
+func (n *Nodes) bestHeadSlot() primitives.Slot {
+	var best primitives.Slot
+	for _, node := range n.nodes {
+		if node.headSlot > best {
+			best = node.headSlot
+		}
+	}
+	return best
+}
+
+// Ranked returns the beacon nodes from the healthiest to the least healthy one.
+func (n *Nodes) Ranked() []*Node {
+	n.RLock()
+	defer n.RUnlock()
+	bestHead := n.bestHeadSlot()
+	scores := make(map[*Node]int, len(n.nodes))
+	for _, node := range n.nodes {
+		scores[node] = n.score(node, bestHead)
+	}
+	ranked := make([]*Node, len(n.nodes))
+	copy(ranked, n.nodes)
+	sort.SliceStable(ranked, func(i, j int) bool {
+		return scores[ranked[i]] > scores[ranked[j]]
+	})
+	return ranked
+}
+
+// call sends a request to the healthiest beacon node, and to the next healthiest one each time
+// the request fails.
+func call[T any](ctx context.Context, n *Nodes, method string, f func(*Node) (T, error)) (T, error) {
+	var res T
+	var err error
+	for i, node := range n.Ranked() {
+		if i > 0 {
+			nodeFailovers.WithLabelValues(method).Inc()
+		}
+		start := time.Now()
+		res, err = f(node)
+		n.record(node, time.Since(start), err)
+		if err == nil {
+			return res, nil
+		}
+		nodeRequestFailures.WithLabelValues(node.endpoint, method).Inc()
+		if ctx.Err() != nil {
+			break
+		}
+		log.WithError(err).WithField("endpoint", node.endpoint).WithField("method", method).Warn("Beacon node request failed")
+	}
+	return res, errors.Wrapf(err, "%s failed on all beacon nodes", method)
+}
+
+// broadcast sends a request to every beacon node at once. It returns the response of the first node
+// that accepts it, without waiting for the other nodes, whose requests complete in the background.
+// It fails when the request failed on every node.
+func broadcast[T any](ctx context.Context, n *Nodes, method string, f func(*Node) (T, error)) (T, error) {
+	type result struct {
+		rank int
+		res  T
+		err  error
+	}
+	ranked := n.Ranked()
+	// Buffered, so that requests completing after a success don't block.
+	results := make(chan result, len(ranked))
+	for i, node := range ranked {
+		go func(i int, node *Node) {
+			start := time.Now()
+			res, err := f(node)
+			n.record(node, time.Since(start), err)
+			if err != nil {
+				nodeRequestFailures.WithLabelValues(node.endpoint, method).Inc()
+				if ctx.Err() == nil {
+					log.WithError(err).WithField("endpoint", node.endpoint).WithField("method", method).Warn("Beacon node request failed")
+				}
+			}
+			results <- result{rank: i, res: res, err: err}
+		}(i, node)
+	}
+
+	errs := make([]error, len(ranked))
+	for range ranked {
+		r := <-results
+		if r.err == nil {
+			return r.res, nil
+		}
+		errs[r.rank] = r.err
+	}
+	var res T
+	return res, errors.Wrapf(errs[0], "%s failed on all beacon nodes", method)
+}
@@ -0,0 +1,192 @@
+package failover
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v4/testing/assert"
+	"github.com/prysmaticlabs/prysm/v4/testing/require"
+	validatormock "github.com/prysmaticlabs/prysm/v4/testing/validator-mock"
+)
+
+type testNodes struct {
+	nodes       *Nodes
+	validators  []*validatormock.MockValidatorClient
+	nodeClients []*validatormock.MockNodeClient
+	chains      []*validatormock.MockBeaconChainClient
+}
+
+func setupNodes(t *testing.T, count int) *testNodes {
+	ctrl := gomock.NewController(t)
+	tn := &testNodes{}
+	var nodes []*Node
+	for i := 0; i < count; i++ {
+		v := validatormock.NewMockValidatorClient(ctrl)
+		n := validatormock.NewMockNodeClient(ctrl)
+		b := validatormock.NewMockBeaconChainClient(ctrl)
+		tn.validators = append(tn.validators, v)
+		tn.nodeClients = append(tn.nodeClients, n)
+		tn.chains = append(tn.chains, b)
+		nodes = append(nodes, NewNode(string(rune('a'+i)), v, n, b))
+	}
+	tn.nodes = NewNodes(nodes...)
+	return tn
+}
+
+func (tn *testNodes) expectHealth(i int, syncing bool, head primitives.Slot) {
+	tn.nodeClients[i].EXPECT().GetSyncStatus(gomock.Any(), gomock.Any()).Return(&ethpb.SyncStatus{Syncing: syncing}, nil)
+	tn.chains[i].EXPECT().GetChainHead(gomock.Any(), gomock.Any()).Return(&ethpb.ChainHead{HeadSlot: head}, nil)
+}
+
+func endpoints(nodes []*Node) []string {
+	e := make([]string, len(nodes))
+	for i, n := range nodes {
+		e[i] = n.Endpoint()
+	}
+	return e
+}
+
+func TestNodes_Ranked(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("configured order when equally healthy", func(t *testing.T) {
+		tn := setupNodes(t, 3)
+		for i := 0; i < 3; i++ {
+			tn.expectHealth(i, false, 10)
+		}
+		tn.nodes.CheckHealth(ctx)
+		assert.DeepEqual(t, []string{"a", "b", "c"}, endpoints(tn.nodes.Ranked()))
+	})
+	t.Run("syncing and lagging nodes ranked last", func(t *testing.T) {
+		tn := setupNodes(t, 3)
+		tn.expectHealth(0, true, 10)
+		tn.expectHealth(1, false, 8)
+		tn.expectHealth(2, false, 10)
+		tn.nodes.CheckHealth(ctx)
+		assert.DeepEqual(t, []string{"c", "b", "a"}, endpoints(tn.nodes.Ranked()))
+		assert.Equal(t, maxScore, tn.nodes.Score(tn.nodes.nodes[2]))
+		assert.Equal(t, maxScore-2*slotLagPenalty, tn.nodes.Score(tn.nodes.nodes[1]))
+	})
+	t.Run("unreachable node ranked last", func(t *testing.T) {
+		tn := setupNodes(t, 2)
+		tn.nodeClients[0].EXPECT().GetSyncStatus(gomock.Any(), gomock.Any()).Return(nil, errors.New("connection refused"))
+		tn.expectHealth(1, false, 10)
+		tn.nodes.CheckHealth(ctx)
+		assert.DeepEqual(t, []string{"b", "a"}, endpoints(tn.nodes.Ranked()))
+	})
+}
+
+func TestNodes_ScoreLatencyAndErrors(t *testing.T) {
+	tn := setupNodes(t, 1)
+	node := tn.nodes.nodes[0]
+	tn.nodes.record(node, 10*time.Second, nil)
+	assert.Equal(t, maxScore-maxLatencyPenalty, tn.nodes.Score(node))
+	for i := 0; i < 50; i++ {
+		tn.nodes.record(node, 0, errors.New("failed"))
+	}
+	assert.Equal(t, maxScore-maxLatencyPenalty-errorRatePenalty+1, tn.nodes.Score(node))
+}
+
+func TestValidatorClient_FailsOver(t *testing.T) {
+	ctx := context.Background()
+	tn := setupNodes(t, 3)
+	c := NewValidatorClient(tn.nodes)
+
+	want := &ethpb.AttestationData{Slot: 5}
+	tn.validators[0].EXPECT().GetAttestationData(gomock.Any(), gomock.Any()).Return(nil, errors.New("node restarting"))
+	tn.validators[1].EXPECT().GetAttestationData(gomock.Any(), gomock.Any()).Return(want, nil)
+	got, err := c.GetAttestationData(ctx, &ethpb.AttestationDataRequest{Slot: 5})
+	require.NoError(t, err)
+	assert.DeepEqual(t, want, got)
+	// The failing node is no longer the primary.
+	assert.DeepEqual(t, []string{"b", "c", "a"}, endpoints(tn.nodes.Ranked()))
+
+	for _, v := range tn.validators {
+		v.EXPECT().GetDuties(gomock.Any(), gomock.Any()).Return(nil, errors.New("down"))
+	}
+	_, err = c.GetDuties(ctx, &ethpb.DutiesRequest{})
+	require.ErrorContains(t, "GetDuties failed on all beacon nodes", err)
+}
+
+func TestNodes_CheckHealthTimeout(t *testing.T) {
+	tn := setupNodes(t, 2)
+	// A hung node is bounded by the health check timeout.
+	tn.nodeClients[0].EXPECT().GetSyncStatus(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, _ *empty.Empty) (*ethpb.SyncStatus, error) {
+			_, ok := ctx.Deadline()
+			assert.Equal(t, true, ok)
+			return nil, context.DeadlineExceeded
+		})
+	tn.expectHealth(1, false, 10)
+	tn.nodes.CheckHealth(context.Background())
+	assert.DeepEqual(t, []string{"b", "a"}, endpoints(tn.nodes.Ranked()))
+}
+
+func TestValidatorClient_Broadcasts(t *testing.T) {
+	ctx := context.Background()
+	tn := setupNodes(t, 3)
+	c := NewValidatorClient(tn.nodes)
+
+	var wg sync.WaitGroup
+	wg.Add(3)
+	att := &ethpb.Attestation{}
+	tn.validators[0].EXPECT().ProposeAttestation(gomock.Any(), att).DoAndReturn(
+		func(context.Context, *ethpb.Attestation) (*ethpb.AttestResponse, error) {
+			defer wg.Done()
+			return nil, errors.New("down")
+		})
+	for i, root := range []byte{'b', 'c'} {
+		resp := &ethpb.AttestResponse{AttestationDataRoot: []byte{root}}
+		tn.validators[i+1].EXPECT().ProposeAttestation(gomock.Any(), att).DoAndReturn(
+			func(context.Context, *ethpb.Attestation) (*ethpb.AttestResponse, error) {
+				defer wg.Done()
+				return resp, nil
+			})
+	}
+	resp, err := c.ProposeAttestation(ctx, att)
+	require.NoError(t, err)
+	assert.Equal(t, true, resp.AttestationDataRoot[0] == 'b' || resp.AttestationDataRoot[0] == 'c')
+	wg.Wait()
+
+	blk := &ethpb.GenericSignedBeaconBlock{}
+	for _, v := range tn.validators {
+		v.EXPECT().ProposeBeaconBlock(gomock.Any(), blk).Return(nil, errors.New("down"))
+	}
+	_, err = c.ProposeBeaconBlock(ctx, blk)
+	require.ErrorContains(t, "ProposeBeaconBlock failed on all beacon nodes", err)
+}
+
+func TestValidatorClient_BroadcastDoesNotWaitForHungNode(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	tn := setupNodes(t, 2)
+	c := NewValidatorClient(tn.nodes)
+
+	hung := make(chan struct{})
+	att := &ethpb.Attestation{}
+	tn.validators[0].EXPECT().ProposeAttestation(gomock.Any(), att).DoAndReturn(
+		func(ctx context.Context, _ *ethpb.Attestation) (*ethpb.AttestResponse, error) {
+			defer close(hung)
+			<-ctx.Done()
+			return nil, ctx.Err()
+		})
+	tn.validators[1].EXPECT().ProposeAttestation(gomock.Any(), att).Return(&ethpb.AttestResponse{AttestationDataRoot: []byte{'b'}}, nil)
+	resp, err := c.ProposeAttestation(ctx, att)
+	require.NoError(t, err)
+	assert.DeepEqual(t, []byte{'b'}, resp.AttestationDataRoot)
+
+	// The request to the hung node is still running in the background.
+	select {
+	case <-hung:
+		t.Fatal("Request to the hung node returned before its context was canceled")
+	default:
+	}
+	cancel()
+	<-hung
+}
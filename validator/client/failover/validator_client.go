@@ -0,0 +1,171 @@
+package failover
+
+import (
+	"context"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v4/validator/client/iface"
+)
+
+type validatorClient struct {
+	nodes *Nodes
+}
+
+// NewValidatorClient returns a validator client that reads duties and data from the healthiest
+// beacon node, failing over to the other nodes on errors, and broadcasts signed messages to all
+// of them.
+func NewValidatorClient(nodes *Nodes) iface.ValidatorClient {
+	return &validatorClient{nodes: nodes}
+}
+
+func (c *validatorClient) GetDuties(ctx context.Context, in *ethpb.DutiesRequest) (*ethpb.DutiesResponse, error) {
+	return call(ctx, c.nodes, "GetDuties", func(n *Node) (*ethpb.DutiesResponse, error) {
+		return n.validatorClient.GetDuties(ctx, in)
+	})
+}
+
+func (c *validatorClient) DomainData(ctx context.Context, in *ethpb.DomainRequest) (*ethpb.DomainResponse, error) {
+	return call(ctx, c.nodes, "DomainData", func(n *Node) (*ethpb.DomainResponse, error) {
+		return n.validatorClient.DomainData(ctx, in)
+	})
+}
+
+func (c *validatorClient) WaitForChainStart(ctx context.Context, in *empty.Empty) (*ethpb.ChainStartResponse, error) {
+	return call(ctx, c.nodes, "WaitForChainStart", func(n *Node) (*ethpb.ChainStartResponse, error) {
+		return n.validatorClient.WaitForChainStart(ctx, in)
+	})
+}
+
+func (c *validatorClient) WaitForActivation(ctx context.Context, in *ethpb.ValidatorActivationRequest) (ethpb.BeaconNodeValidator_WaitForActivationClient, error) {
+	return call(ctx, c.nodes, "WaitForActivation", func(n *Node) (ethpb.BeaconNodeValidator_WaitForActivationClient, error) {
+		return n.validatorClient.WaitForActivation(ctx, in)
+	})
+}
+
+func (c *validatorClient) ValidatorIndex(ctx context.Context, in *ethpb.ValidatorIndexRequest) (*ethpb.ValidatorIndexResponse, error) {
+	return call(ctx, c.nodes, "ValidatorIndex", func(n *Node) (*ethpb.ValidatorIndexResponse, error) {
+		return n.validatorClient.ValidatorIndex(ctx, in)
+	})
+}
+
+func (c *validatorClient) ValidatorStatus(ctx context.Context, in *ethpb.ValidatorStatusRequest) (*ethpb.ValidatorStatusResponse, error) {
+	return call(ctx, c.nodes, "ValidatorStatus", func(n *Node) (*ethpb.ValidatorStatusResponse, error) {
+		return n.validatorClient.ValidatorStatus(ctx, in)
+	})
+}
+
+func (c *validatorClient) MultipleValidatorStatus(ctx context.Context, in *ethpb.MultipleValidatorStatusRequest) (*ethpb.MultipleValidatorStatusResponse, error) {
+	return call(ctx, c.nodes, "MultipleValidatorStatus", func(n *Node) (*ethpb.MultipleValidatorStatusResponse, error) {
+		return n.validatorClient.MultipleValidatorStatus(ctx, in)
+	})
+}
+
+func (c *validatorClient) GetBeaconBlock(ctx context.Context, in *ethpb.BlockRequest) (*ethpb.GenericBeaconBlock, error) {
+	return call(ctx, c.nodes, "GetBeaconBlock", func(n *Node) (*ethpb.GenericBeaconBlock, error) {
+		return n.validatorClient.GetBeaconBlock(ctx, in)
+	})
+}
+
+func (c *validatorClient) ProposeBeaconBlock(ctx context.Context, in *ethpb.GenericSignedBeaconBlock) (*ethpb.ProposeResponse, error) {
+	return broadcast(ctx, c.nodes, "ProposeBeaconBlock", func(n *Node) (*ethpb.ProposeResponse, error) {
+		return n.validatorClient.ProposeBeaconBlock(ctx, in)
+	})
+}
+
+func (c *validatorClient) PrepareBeaconProposer(ctx context.Context, in *ethpb.PrepareBeaconProposerRequest) (*empty.Empty, error) {
+	return broadcast(ctx, c.nodes, "PrepareBeaconProposer", func(n *Node) (*empty.Empty, error) {
+		return n.validatorClient.PrepareBeaconProposer(ctx, in)
+	})
+}
+
+func (c *validatorClient) GetFeeRecipientByPubKey(ctx context.Context, in *ethpb.FeeRecipientByPubKeyRequest) (*ethpb.FeeRecipientByPubKeyResponse, error) {
+	return call(ctx, c.nodes, "GetFeeRecipientByPubKey", func(n *Node) (*ethpb.FeeRecipientByPubKeyResponse, error) {
+		return n.validatorClient.GetFeeRecipientByPubKey(ctx, in)
+	})
+}
+
+func (c *validatorClient) GetAttestationData(ctx context.Context, in *ethpb.AttestationDataRequest) (*ethpb.AttestationData, error) {
+	return call(ctx, c.nodes, "GetAttestationData", func(n *Node) (*ethpb.AttestationData, error) {
+		return n.validatorClient.GetAttestationData(ctx, in)
+	})
+}
+
+func (c *validatorClient) ProposeAttestation(ctx context.Context, in *ethpb.Attestation) (*ethpb.AttestResponse, error) {
+	return broadcast(ctx, c.nodes, "ProposeAttestation", func(n *Node) (*ethpb.AttestResponse, error) {
+		return n.validatorClient.ProposeAttestation(ctx, in)
+	})
+}
+
+func (c *validatorClient) SubmitAggregateSelectionProof(ctx context.Context, in *ethpb.AggregateSelectionRequest) (*ethpb.AggregateSelectionResponse, error) {
+	return call(ctx, c.nodes, "SubmitAggregateSelectionProof", func(n *Node) (*ethpb.AggregateSelectionResponse, error) {
+		return n.validatorClient.SubmitAggregateSelectionProof(ctx, in)
+	})
+}
+
+func (c *validatorClient) SubmitSignedAggregateSelectionProof(ctx context.Context, in *ethpb.SignedAggregateSubmitRequest) (*ethpb.SignedAggregateSubmitResponse, error) {
+	return broadcast(ctx, c.nodes, "SubmitSignedAggregateSelectionProof", func(n *Node) (*ethpb.SignedAggregateSubmitResponse, error) {
+		return n.validatorClient.SubmitSignedAggregateSelectionProof(ctx, in)
+	})
+}
+
+func (c *validatorClient) ProposeExit(ctx context.Context, in *ethpb.SignedVoluntaryExit) (*ethpb.ProposeExitResponse, error) {
+	return broadcast(ctx, c.nodes, "ProposeExit", func(n *Node) (*ethpb.ProposeExitResponse, error) {
+		return n.validatorClient.ProposeExit(ctx, in)
+	})
+}
+
+func (c *validatorClient) SubscribeCommitteeSubnets(ctx context.Context, in *ethpb.CommitteeSubnetsSubscribeRequest, validatorIndices []primitives.ValidatorIndex) (*empty.Empty, error) {
+	return broadcast(ctx, c.nodes, "SubscribeCommitteeSubnets", func(n *Node) (*empty.Empty, error) {
+		return n.validatorClient.SubscribeCommitteeSubnets(ctx, in, validatorIndices)
+	})
+}
+
+func (c *validatorClient) CheckDoppelGanger(ctx context.Context, in *ethpb.DoppelGangerRequest) (*ethpb.DoppelGangerResponse, error) {
+	return call(ctx, c.nodes, "CheckDoppelGanger", func(n *Node) (*ethpb.DoppelGangerResponse, error) {
+		return n.validatorClient.CheckDoppelGanger(ctx, in)
+	})
+}
+
+func (c *validatorClient) GetSyncMessageBlockRoot(ctx context.Context, in *empty.Empty) (*ethpb.SyncMessageBlockRootResponse, error) {
+	return call(ctx, c.nodes, "GetSyncMessageBlockRoot", func(n *Node) (*ethpb.SyncMessageBlockRootResponse, error) {
+		return n.validatorClient.GetSyncMessageBlockRoot(ctx, in)
+	})
+}
+
+func (c *validatorClient) SubmitSyncMessage(ctx context.Context, in *ethpb.SyncCommitteeMessage) (*empty.Empty, error) {
+	return broadcast(ctx, c.nodes, "SubmitSyncMessage", func(n *Node) (*empty.Empty, error) {
+		return n.validatorClient.SubmitSyncMessage(ctx, in)
+	})
+}
+
+func (c *validatorClient) GetSyncSubcommitteeIndex(ctx context.Context, in *ethpb.SyncSubcommitteeIndexRequest) (*ethpb.SyncSubcommitteeIndexResponse, error) {
+	return call(ctx, c.nodes, "GetSyncSubcommitteeIndex", func(n *Node) (*ethpb.SyncSubcommitteeIndexResponse, error) {
+		return n.validatorClient.GetSyncSubcommitteeIndex(ctx, in)
+	})
+}
+
+func (c *validatorClient) GetSyncCommitteeContribution(ctx context.Context, in *ethpb.SyncCommitteeContributionRequest) (*ethpb.SyncCommitteeContribution, error) {
+	return call(ctx, c.nodes, "GetSyncCommitteeContribution", func(n *Node) (*ethpb.SyncCommitteeContribution, error) {
+		return n.validatorClient.GetSyncCommitteeContribution(ctx, in)
+	})
+}
+
+func (c *validatorClient) SubmitSignedContributionAndProof(ctx context.Context, in *ethpb.SignedContributionAndProof) (*empty.Empty, error) {
+	return broadcast(ctx, c.nodes, "SubmitSignedContributionAndProof", func(n *Node) (*empty.Empty, error) {
+		return n.validatorClient.SubmitSignedContributionAndProof(ctx, in)
+	})
+}
+
+func (c *validatorClient) StreamBlocksAltair(ctx context.Context, in *ethpb.StreamBlocksRequest) (ethpb.BeaconNodeValidator_StreamBlocksAltairClient, error) {
+	return call(ctx, c.nodes, "StreamBlocksAltair", func(n *Node) (ethpb.BeaconNodeValidator_StreamBlocksAltairClient, error) {
+		return n.validatorClient.StreamBlocksAltair(ctx, in)
+	})
+}
+
+func (c *validatorClient) SubmitValidatorRegistrations(ctx context.Context, in *ethpb.SignedValidatorRegistrationsV1) (*empty.Empty, error) {
+	return broadcast(ctx, c.nodes, "SubmitValidatorRegistrations", func(n *Node) (*empty.Empty, error) {
+		return n.validatorClient.SubmitValidatorRegistrations(ctx, in)
+	})
+}
@@ -14,6 +14,7 @@ import (
 	grpcutil "github.com/prysmaticlabs/prysm/v4/api/grpc"
 	"github.com/prysmaticlabs/prysm/v4/async/event"
 	lruwrpr "github.com/prysmaticlabs/prysm/v4/cache/lru"
+	"github.com/prysmaticlabs/prysm/v4/config/features"
 	fieldparams "github.com/prysmaticlabs/prysm/v4/config/fieldparams"
 	"github.com/prysmaticlabs/prysm/v4/config/params"
 	validatorserviceconfig "github.com/prysmaticlabs/prysm/v4/config/validator/service"
@@ -22,6 +23,7 @@ import (
 	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/v4/validator/accounts/wallet"
 	beaconChainClientFactory "github.com/prysmaticlabs/prysm/v4/validator/client/beacon-chain-client-factory"
+	"github.com/prysmaticlabs/prysm/v4/validator/client/failover"
 	"github.com/prysmaticlabs/prysm/v4/validator/client/iface"
 	nodeClientFactory "github.com/prysmaticlabs/prysm/v4/validator/client/node-client-factory"
 	validatorClientFactory "github.com/prysmaticlabs/prysm/v4/validator/client/validator-client-factory"
@@ -31,6 +33,8 @@ import (
 	"github.com/prysmaticlabs/prysm/v4/validator/keymanager"
 	"github.com/prysmaticlabs/prysm/v4/validator/keymanager/local"
 	remoteweb3signer "github.com/prysmaticlabs/prysm/v4/validator/keymanager/remote-web3signer"
+	"github.com/prysmaticlabs/prysm/v4/validator/keymanager/secretstore"
+	"github.com/prysmaticlabs/prysm/v4/validator/keymanager/threshold"
 	"go.opencensus.io/plugin/ocgrpc"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
@@ -56,7 +60,8 @@ type ValidatorService struct {
 	emitAccountMetrics    bool
 	logValidatorBalances  bool
 	interopKeysConfig     *local.InteropKeymanagerConfig
-	conn                  validatorHelpers.NodeConnection
+	conns                 []validatorHelpers.NodeConnection
+	nodes                 *failover.Nodes
 	grpcRetryDelay        time.Duration
 	grpcRetries           uint
 	maxCallRecvMsgSize    int
@@ -70,9 +75,12 @@ type ValidatorService struct {
 	ctx                   context.Context
 	validator             iface.Validator
 	db                    db.Database
+	slashingProtection    db.SlashingProtection
 	grpcHeaders           []string
 	graffiti              []byte
 	Web3SignerConfig      *remoteweb3signer.SetupConfig
+	ThresholdSignerConfig *threshold.SetupConfig
+	SecretStoreConfig     *secretstore.SetupConfig
 	proposerSettings      *validatorserviceconfig.ProposerSettings
 }
 
@@ -90,14 +98,17 @@ type Config struct {
 	GraffitiStruct             *graffiti.Graffiti
 	Validator                  iface.Validator
 	ValDB                      db.Database
+	SlashingProtection         db.SlashingProtection // Overrides the validator database for slashing protection.
 	CertFlag                   string
 	DataDir                    string
 	GrpcHeadersFlag            string
 	GraffitiFlag               string
-	Endpoint                   string
+	Endpoint                   string // Comma separated gRPC endpoints of the beacon nodes.
 	Web3SignerConfig           *remoteweb3signer.SetupConfig
+	ThresholdSignerConfig      *threshold.SetupConfig
+	SecretStoreConfig          *secretstore.SetupConfig
 	ProposerSettings           *validatorserviceconfig.ProposerSettings
-	BeaconApiEndpoint          string
+	BeaconApiEndpoint          string // Comma separated REST API endpoints of the beacon nodes.
 	BeaconApiTimeout           time.Duration
 }
 
@@ -120,12 +131,15 @@ func NewValidatorService(ctx context.Context, cfg *Config) (*ValidatorService, e
 		grpcHeaders:           strings.Split(cfg.GrpcHeadersFlag, ","),
 		validator:             cfg.Validator,
 		db:                    cfg.ValDB,
+		slashingProtection:    cfg.SlashingProtection,
 		wallet:                cfg.Wallet,
 		walletInitializedFeed: cfg.WalletInitializedFeed,
 		useWeb:                cfg.UseWeb,
 		interopKeysConfig:     cfg.InteropKeysConfig,
 		graffitiStruct:        cfg.GraffitiStruct,
 		Web3SignerConfig:      cfg.Web3SignerConfig,
+		ThresholdSignerConfig: cfg.ThresholdSignerConfig,
+		SecretStoreConfig:     cfg.SecretStoreConfig,
 		proposerSettings:      cfg.ProposerSettings,
 	}
 
@@ -141,22 +155,65 @@ func NewValidatorService(ctx context.Context, cfg *Config) (*ValidatorService, e
 
 	s.ctx = grpcutil.AppendHeaders(ctx, s.grpcHeaders)
 
-	grpcConn, err := grpc.DialContext(ctx, s.endpoint, dialOpts...)
-	if err != nil {
-		return s, err
+	endpoints := strings.Split(s.endpoint, ",")
+	grpcConns := make([]*grpc.ClientConn, len(endpoints))
+	for i, endpoint := range endpoints {
+		grpcConn, err := grpc.DialContext(ctx, endpoint, dialOpts...)
+		if err != nil {
+			return s, err
+		}
+		grpcConns[i] = grpcConn
 	}
 	if s.withCert != "" {
 		log.Info("Established secure gRPC connection")
 	}
-	s.conn = validatorHelpers.NewNodeConnection(
-		grpcConn,
-		cfg.BeaconApiEndpoint,
-		cfg.BeaconApiTimeout,
-	)
+
+	// With the REST API enabled, each REST endpoint is a beacon node which falls back to one of
+	// the gRPC connections for the requests that are not served over REST.
+	apiEndpoints := strings.Split(cfg.BeaconApiEndpoint, ",")
+	count := len(grpcConns)
+	if features.Get().EnableBeaconRESTApi {
+		count = len(apiEndpoints)
+	}
+	for i := 0; i < count; i++ {
+		s.conns = append(s.conns, validatorHelpers.NewNodeConnection(
+			grpcConns[i%len(grpcConns)],
+			apiEndpoints[i%len(apiEndpoints)],
+			cfg.BeaconApiTimeout,
+		))
+	}
+	if count > 1 {
+		nodes := make([]*failover.Node, count)
+		for i, conn := range s.conns {
+			endpoint := endpoints[i%len(endpoints)]
+			if features.Get().EnableBeaconRESTApi {
+				endpoint = conn.GetBeaconApiUrl()
+			}
+			nodes[i] = failover.NewNode(
+				endpoint,
+				validatorClientFactory.NewValidatorClient(conn),
+				nodeClientFactory.NewNodeClient(conn),
+				beaconChainClientFactory.NewBeaconChainClient(conn),
+			)
+		}
+		s.nodes = failover.NewNodes(nodes...)
+		log.WithField("count", count).Info("Using multiple beacon nodes with failover")
+	}
 
 	return s, nil
 }
 
+// clients returns the clients used to talk to the beacon nodes. With several beacon nodes, they
+// fail over between them.
+func (v *ValidatorService) clients() (iface.ValidatorClient, iface.BeaconChainClient, iface.NodeClient) {
+	if v.nodes != nil {
+		return failover.NewValidatorClient(v.nodes), failover.NewBeaconChainClient(v.nodes), failover.NewNodeClient(v.nodes)
+	}
+	return validatorClientFactory.NewValidatorClient(v.conns[0]),
+		beaconChainClientFactory.NewBeaconChainClient(v.conns[0]),
+		nodeClientFactory.NewNodeClient(v.conns[0])
+}
+
 // Start the validator service. Launches the main go routine for the validator
 // client.
 func (v *ValidatorService) Start() {
@@ -187,14 +244,18 @@ func (v *ValidatorService) Start() {
 		return
 	}
 
-	validatorClient := validatorClientFactory.NewValidatorClient(v.conn)
-	beaconClient := beaconChainClientFactory.NewBeaconChainClient(v.conn)
+	if v.nodes != nil {
+		// Check the health of the beacon nodes twice per slot.
+		v.nodes.Start(v.ctx, time.Duration(params.BeaconConfig().SecondsPerSlot)*time.Second/2)
+	}
+	validatorClient, beaconClient, nodeClient := v.clients()
 
 	valStruct := &validator{
 		db:                             v.db,
+		slashingProtection:             v.slashingProtection,
 		validatorClient:                validatorClient,
 		beaconClient:                   beaconClient,
-		node:                           nodeClientFactory.NewNodeClient(v.conn),
+		node:                           nodeClient,
 		graffiti:                       v.graffiti,
 		logValidatorBalances:           v.logValidatorBalances,
 		emitAccountMetrics:             v.emitAccountMetrics,
@@ -216,6 +277,8 @@ func (v *ValidatorService) Start() {
 		graffitiOrderedIndex:           graffitiOrderedIndex,
 		eipImportBlacklistedPublicKeys: slashablePublicKeys,
 		Web3SignerConfig:               v.Web3SignerConfig,
+		ThresholdSignerConfig:          v.ThresholdSignerConfig,
+		SecretStoreConfig:              v.SecretStoreConfig,
 		proposerSettings:               v.proposerSettings,
 		walletInitializedChannel:       make(chan *wallet.Wallet, 1),
 	}
@@ -238,15 +301,25 @@ func (v *ValidatorService) Start() {
 func (v *ValidatorService) Stop() error {
 	v.cancel()
 	log.Info("Stopping service")
-	if v.conn != nil {
-		return v.conn.GetGrpcClientConn().Close()
+	var err error
+	// With more REST than gRPC endpoints, several node connections share one gRPC connection.
+	closed := make(map[*grpc.ClientConn]bool, len(v.conns))
+	for _, conn := range v.conns {
+		grpcConn := conn.GetGrpcClientConn()
+		if closed[grpcConn] {
+			continue
+		}
+		closed[grpcConn] = true
+		if closeErr := grpcConn.Close(); closeErr != nil && err == nil {
+			err = closeErr
+		}
 	}
-	return nil
+	return err
 }
 
 // Status of the validator service.
 func (v *ValidatorService) Status() error {
-	if v.conn == nil {
+	if len(v.conns) == 0 {
 		return errors.New("no connection to beacon RPC")
 	}
 	return nil
@@ -338,7 +411,7 @@ func ConstructDialOptions(
 
 // Syncing returns whether or not the beacon node is currently synchronizing the chain.
 func (v *ValidatorService) Syncing(ctx context.Context) (bool, error) {
-	nc := ethpb.NewNodeClient(v.conn.GetGrpcClientConn())
+	_, _, nc := v.clients()
 	resp, err := nc.GetSyncStatus(ctx, &emptypb.Empty{})
 	if err != nil {
 		return false, err
@@ -349,6 +422,6 @@ func (v *ValidatorService) Syncing(ctx context.Context) (bool, error) {
 // GenesisInfo queries the beacon node for the chain genesis info containing
 // the genesis time along with the validator deposit contract address.
 func (v *ValidatorService) GenesisInfo(ctx context.Context) (*ethpb.Genesis, error) {
-	nc := ethpb.NewNodeClient(v.conn.GetGrpcClientConn())
+	_, _, nc := v.clients()
 	return nc.GetGenesis(ctx, &emptypb.Empty{})
 }
@@ -5,9 +5,11 @@ import (
 	"testing"
 	"time"
 
+	"github.com/prysmaticlabs/prysm/v4/config/features"
 	"github.com/prysmaticlabs/prysm/v4/runtime"
 	"github.com/prysmaticlabs/prysm/v4/testing/assert"
 	"github.com/prysmaticlabs/prysm/v4/testing/require"
+	"github.com/prysmaticlabs/prysm/v4/validator/client/failover"
 	logTest "github.com/sirupsen/logrus/hooks/test"
 	"google.golang.org/grpc/metadata"
 )
@@ -72,3 +74,31 @@ func TestStart_GrpcHeaders(t *testing.T) {
 		}
 	}
 }
+
+func TestNew_MultipleBeaconNodes(t *testing.T) {
+	vs, err := NewValidatorService(context.Background(), &Config{Endpoint: "127.0.0.1:4000,127.0.0.1:4001"})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(vs.conns))
+	require.NotNil(t, vs.nodes)
+	assert.NoError(t, vs.Stop())
+
+	vs, err = NewValidatorService(context.Background(), &Config{Endpoint: "127.0.0.1:4000"})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(vs.conns))
+	require.Equal(t, (*failover.Nodes)(nil), vs.nodes)
+	assert.NoError(t, vs.Stop())
+}
+
+func TestStop_SharedGrpcConnection(t *testing.T) {
+	resetCfg := features.InitWithReset(&features.Flags{EnableBeaconRESTApi: true})
+	defer resetCfg()
+
+	vs, err := NewValidatorService(context.Background(), &Config{
+		Endpoint:          "127.0.0.1:4000",
+		BeaconApiEndpoint: "http://127.0.0.1:3500,http://127.0.0.1:3501",
+	})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(vs.conns))
+	require.Equal(t, vs.conns[0].GetGrpcClientConn(), vs.conns[1].GetGrpcClientConn())
+	assert.NoError(t, vs.Stop())
+}
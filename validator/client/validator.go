@@ -42,6 +42,8 @@ import (
 	"github.com/prysmaticlabs/prysm/v4/validator/keymanager"
 	"github.com/prysmaticlabs/prysm/v4/validator/keymanager/local"
 	remoteweb3signer "github.com/prysmaticlabs/prysm/v4/validator/keymanager/remote-web3signer"
+	"github.com/prysmaticlabs/prysm/v4/validator/keymanager/secretstore"
+	"github.com/prysmaticlabs/prysm/v4/validator/keymanager/threshold"
 	"github.com/sirupsen/logrus"
 	"go.opencensus.io/trace"
 	"google.golang.org/grpc/codes"
@@ -94,6 +96,7 @@ type validator struct {
 	graffitiStruct                     *graffiti.Graffiti
 	node                               iface.NodeClient
 	db                                 vdb.Database
+	slashingProtection                 vdb.SlashingProtection
 	beaconClient                       iface.BeaconChainClient
 	keyManager                         keymanager.IKeymanager
 	ticker                             slots.Ticker
@@ -102,6 +105,8 @@ type validator struct {
 	voteStats                          voteStats
 	syncCommitteeStats                 syncCommitteeStats
 	Web3SignerConfig                   *remoteweb3signer.SetupConfig
+	ThresholdSignerConfig              *threshold.SetupConfig
+	SecretStoreConfig                  *secretstore.SetupConfig
 	proposerSettings                   *validatorserviceconfig.ProposerSettings
 	walletInitializedChannel           chan *wallet.Wallet
 }
@@ -145,7 +150,12 @@ func (v *validator) WaitForKeymanagerInitialization(ctx context.Context) error {
 			if v.Web3SignerConfig != nil {
 				v.Web3SignerConfig.GenesisValidatorsRoot = genesisRoot
 			}
-			keyManager, err := v.wallet.InitializeKeymanager(ctx, accountsiface.InitKeymanagerConfig{ListenForChanges: true, Web3SignerConfig: v.Web3SignerConfig})
+			keyManager, err := v.wallet.InitializeKeymanager(ctx, accountsiface.InitKeymanagerConfig{
+				ListenForChanges:      true,
+				Web3SignerConfig:      v.Web3SignerConfig,
+				ThresholdSignerConfig: v.ThresholdSignerConfig,
+				SecretStoreConfig:     v.SecretStoreConfig,
+			})
 			if err != nil {
 				return errors.Wrap(err, "could not initialize key manager")
 			}
@@ -6,3 +6,8 @@ import "github.com/prysmaticlabs/prysm/v4/validator/db/iface"
 // key-value or relational database in practice. This is the full database interface which should
 // not be used often. Prefer a more restrictive interface in this package.
 type Database = iface.ValidatorDB
+
+// SlashingProtection defines the checks made against the slashing protection history before
+// signing, which may be backed by the validator database or a database shared by several
+// validator clients.
+type SlashingProtection = iface.SlashingProtection
@@ -16,9 +16,25 @@ import (
 // Ensure the kv store implements the interface.
 var _ = ValidatorDB(&kv.Store{})
 
+// SlashingProtection defines the checks a validator client makes before signing a block or an
+// attestation. A check that passes also records the message as signed. The local kv store checks
+// and then queues the record, relying on the per key signing locks of the validator client, so it
+// only protects a single validator client. Implementations shared by several validator clients,
+// such as the postgres store, must check and record as a single atomic operation so that two of
+// them never sign conflicting messages.
+type SlashingProtection interface {
+	SlashableAttestationCheck(
+		ctx context.Context, pubKey [fieldparams.BLSPubkeyLength]byte, signingRoot [32]byte, att *ethpb.IndexedAttestation,
+	) (kv.SlashingKind, error)
+	SlashableProposalCheck(
+		ctx context.Context, pubKey [fieldparams.BLSPubkeyLength]byte, slot primitives.Slot, signingRoot [32]byte,
+	) error
+}
+
 // ValidatorDB defines the necessary methods for a Prysm validator DB.
 type ValidatorDB interface {
 	io.Closer
+	SlashingProtection
 	backup.BackupExporter
 	DatabasePath() string
 	ClearDB() error
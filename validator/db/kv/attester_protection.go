@@ -104,6 +104,13 @@ func (s *Store) AttestationHistoryForPubKey(ctx context.Context, pubKey [fieldpa
 	records := make([]*AttestationRecord, 0)
 	ctx, span := trace.StartSpan(ctx, "Validator.AttestationHistoryForPubKey")
 	defer span.End()
+	if s.minimalSlashingProtection {
+		source, target, exists, err := s.attestationWatermarks(pubKey)
+		if exists {
+			records = append(records, &AttestationRecord{PubKey: pubKey, Source: source, Target: target})
+		}
+		return records, err
+	}
 	err := s.view(func(tx *bolt.Tx) error {
 		bucket := tx.Bucket(pubKeysBucket)
 		pkBucket := bucket.Bucket(pubKey[:])
@@ -145,6 +152,11 @@ func (s *Store) CheckSlashableAttestation(
 ) (SlashingKind, error) {
 	ctx, span := trace.StartSpan(ctx, "Validator.CheckSlashableAttestation")
 	defer span.End()
+	// A minimal database keeps no history to find double or surround votes in, signing
+	// is instead refused below the low watermarks of the public key.
+	if s.minimalSlashingProtection {
+		return NotSlashable, nil
+	}
 	var slashKind SlashingKind
 	err := s.view(func(tx *bolt.Tx) error {
 		if ctx.Err() != nil {
@@ -292,6 +304,9 @@ func (s *Store) SaveAttestationsForPubKey(
 			len(atts),
 		)
 	}
+	if s.minimalSlashingProtection {
+		return s.raiseAttestationWatermarks(pubKey, atts)
+	}
 	records := make([]*AttestationRecord, len(atts))
 	for i, a := range atts {
 		records[i] = &AttestationRecord{
@@ -311,6 +326,9 @@ func (s *Store) SaveAttestationForPubKey(
 ) error {
 	ctx, span := trace.StartSpan(ctx, "Validator.SaveAttestationForPubKey")
 	defer span.End()
+	if s.minimalSlashingProtection {
+		return s.raiseAttestationWatermarks(pubKey, []*ethpb.IndexedAttestation{att})
+	}
 	s.batchedAttestationsChan <- &AttestationRecordSaveRequest{
 		ctx: ctx,
 		record: &AttestationRecord{
@@ -521,6 +539,9 @@ func (s *Store) saveAttestationRecords(ctx context.Context, atts []*AttestationR
 func (s *Store) AttestedPublicKeys(ctx context.Context) ([][fieldparams.BLSPubkeyLength]byte, error) {
 	ctx, span := trace.StartSpan(ctx, "Validator.AttestedPublicKeys")
 	defer span.End()
+	if s.minimalSlashingProtection {
+		return s.minimalPublicKeys(minimalAttestationsBucket)
+	}
 	var err error
 	attestedPublicKeys := make([][fieldparams.BLSPubkeyLength]byte, 0)
 	err = s.view(func(tx *bolt.Tx) error {
@@ -541,6 +562,9 @@ func (s *Store) SigningRootAtTargetEpoch(ctx context.Context, pubKey [fieldparam
 	ctx, span := trace.StartSpan(ctx, "Validator.SigningRootAtTargetEpoch")
 	defer span.End()
 	var signingRoot [32]byte
+	if s.minimalSlashingProtection {
+		return signingRoot, nil
+	}
 	err := s.view(func(tx *bolt.Tx) error {
 		bucket := tx.Bucket(pubKeysBucket)
 		pkBucket := bucket.Bucket(pubKey[:])
@@ -563,6 +587,10 @@ func (s *Store) SigningRootAtTargetEpoch(ctx context.Context, pubKey [fieldparam
 func (s *Store) LowestSignedSourceEpoch(ctx context.Context, publicKey [fieldparams.BLSPubkeyLength]byte) (primitives.Epoch, bool, error) {
 	ctx, span := trace.StartSpan(ctx, "Validator.LowestSignedSourceEpoch")
 	defer span.End()
+	if s.minimalSlashingProtection {
+		source, _, exists, err := s.attestationWatermarks(publicKey)
+		return source, exists, err
+	}
 
 	var err error
 	var lowestSignedSourceEpoch primitives.Epoch
@@ -586,6 +614,10 @@ func (s *Store) LowestSignedSourceEpoch(ctx context.Context, publicKey [fieldpar
 func (s *Store) LowestSignedTargetEpoch(ctx context.Context, publicKey [fieldparams.BLSPubkeyLength]byte) (primitives.Epoch, bool, error) {
 	ctx, span := trace.StartSpan(ctx, "Validator.LowestSignedTargetEpoch")
 	defer span.End()
+	if s.minimalSlashingProtection {
+		_, target, exists, err := s.attestationWatermarks(publicKey)
+		return target, exists, err
+	}
 
 	var err error
 	var lowestSignedTargetEpoch primitives.Epoch
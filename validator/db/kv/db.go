@@ -67,6 +67,7 @@ type Store struct {
 	batchedAttestationsChan            chan *AttestationRecordSaveRequest
 	batchAttestationsFlushedFeed       *event.Feed
 	batchedAttestationsFlushInProgress abool.AtomicBool
+	minimalSlashingProtection          bool
 }
 
 // Close closes the underlying boltdb database.
@@ -153,10 +154,20 @@ func NewKVStore(ctx context.Context, dirPath string, config *Config) (*Store, er
 			migrationsBucket,
 			graffitiBucket,
 			proposerSettingsBucket,
+			minimalAttestationsBucket,
+			minimalProposalsBucket,
+			slashingProtectionSchemaBucket,
 		)
 	}); err != nil {
 		return nil, err
 	}
+	minimalRequested := features.Get().EnableMinimalSlashingProtection
+	if err := kv.loadSlashingProtectionSchema(minimalRequested); err != nil {
+		return nil, errors.Wrap(err, "could not load slashing protection schema")
+	}
+	if minimalRequested && !kv.minimalSlashingProtection {
+		log.Warn("Slashing protection database uses the complete schema, run `validator db migrate minimal` to convert it")
+	}
 
 	// Initialize the required public keys into the DB to ensure they're not empty.
 	if config != nil {
@@ -0,0 +1,303 @@
+package kv
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	fieldparams "github.com/prysmaticlabs/prysm/v4/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v4/config/params"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v4/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+	bolt "go.etcd.io/bbolt"
+	"go.opencensus.io/trace"
+)
+
+// The minimal slashing protection schema only keeps, for each public key, the highest signed
+// source and target epochs and the highest proposed slot. These act as the EIP-3076 low watermarks:
+// the database behaves as if the history of each key was a single attestation and a single block,
+// without signing roots, at those watermarks. Any attestation with a source lower than the
+// watermark or a target lower than or equal to it, and any block at or below the proposal
+// watermark, is refused.
+
+// MinimalSlashingProtection returns true if the database uses the minimal slashing protection schema.
+func (s *Store) MinimalSlashingProtection() bool {
+	return s.minimalSlashingProtection
+}
+
+// loadSlashingProtectionSchema reads the slashing protection schema stored in the database.
+// A database without any slashing protection history is marked as minimal if requested, while
+// an existing database always keeps its schema until it is explicitly migrated.
+func (s *Store) loadSlashingProtectionSchema(minimalRequested bool) error {
+	return s.update(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket(slashingProtectionSchemaBucket)
+		if bkt.Get(minimalSchemaKey) != nil {
+			s.minimalSlashingProtection = true
+			return nil
+		}
+		if !minimalRequested || hasCompleteSlashingProtectionHistory(tx) {
+			return nil
+		}
+		s.minimalSlashingProtection = true
+		return bkt.Put(minimalSchemaKey, []byte{1})
+	})
+}
+
+func hasCompleteSlashingProtectionHistory(tx *bolt.Tx) bool {
+	for _, b := range [][]byte{pubKeysBucket, lowestSignedProposalsBucket} {
+		if k, _ := tx.Bucket(b).Cursor().First(); k != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// MigrateToMinimalSlashingProtection converts the complete slashing protection history of every
+// public key into its low watermarks and deletes the history. Signing roots cannot be kept.
+func (s *Store) MigrateToMinimalSlashingProtection(ctx context.Context) error {
+	ctx, span := trace.StartSpan(ctx, "Validator.MigrateToMinimalSlashingProtection")
+	defer span.End()
+	if s.minimalSlashingProtection {
+		return nil
+	}
+	if err := s.update(func(tx *bolt.Tx) error {
+		attBkt := tx.Bucket(minimalAttestationsBucket)
+		if err := tx.Bucket(pubKeysBucket).ForEach(func(pubKey, _ []byte) error {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			source, target, exists := highestSignedEpochs(tx, pubKey)
+			if !exists {
+				return nil
+			}
+			return attBkt.Put(pubKey, encodeWatermarks(source, target))
+		}); err != nil {
+			return err
+		}
+		proposalBkt := tx.Bucket(minimalProposalsBucket)
+		if err := tx.Bucket(historicProposalsBucket).ForEach(func(pubKey, _ []byte) error {
+			slot, exists := highestProposedSlot(tx, pubKey)
+			if !exists {
+				return nil
+			}
+			return proposalBkt.Put(pubKey, bytesutil.SlotToBytesBigEndian(slot))
+		}); err != nil {
+			return err
+		}
+		for _, b := range [][]byte{
+			pubKeysBucket,
+			historicProposalsBucket,
+			lowestSignedSourceBucket,
+			lowestSignedTargetBucket,
+			lowestSignedProposalsBucket,
+			highestSignedProposalsBucket,
+		} {
+			if err := tx.DeleteBucket(b); err != nil {
+				return errors.Wrapf(err, "could not delete bucket %s", b)
+			}
+			if _, err := tx.CreateBucket(b); err != nil {
+				return errors.Wrapf(err, "could not create bucket %s", b)
+			}
+		}
+		return tx.Bucket(slashingProtectionSchemaBucket).Put(minimalSchemaKey, []byte{1})
+	}); err != nil {
+		return err
+	}
+	s.minimalSlashingProtection = true
+	return nil
+}
+
+// MigrateToCompleteSlashingProtection converts the low watermarks of every public key into a
+// complete slashing protection history made of a single attestation and a single block without
+// signing roots, which the complete schema treats as conflicting with anything at the same
+// target epoch or slot.
+func (s *Store) MigrateToCompleteSlashingProtection(ctx context.Context) error {
+	ctx, span := trace.StartSpan(ctx, "Validator.MigrateToCompleteSlashingProtection")
+	defer span.End()
+	if !s.minimalSlashingProtection {
+		return nil
+	}
+	var records []*AttestationRecord
+	proposals := make(map[[fieldparams.BLSPubkeyLength]byte]primitives.Slot)
+	if err := s.view(func(tx *bolt.Tx) error {
+		if err := tx.Bucket(minimalAttestationsBucket).ForEach(func(pubKey, enc []byte) error {
+			source, target := decodeWatermarks(enc)
+			records = append(records, &AttestationRecord{
+				PubKey: bytesutil.ToBytes48(pubKey),
+				Source: source,
+				Target: target,
+			})
+			return nil
+		}); err != nil {
+			return err
+		}
+		return tx.Bucket(minimalProposalsBucket).ForEach(func(pubKey, enc []byte) error {
+			proposals[bytesutil.ToBytes48(pubKey)] = bytesutil.BytesToSlotBigEndian(enc)
+			return nil
+		})
+	}); err != nil {
+		return err
+	}
+
+	// The watermarks are only deleted once they are all written in the complete schema,
+	// so that an interrupted migration can simply be run again.
+	s.minimalSlashingProtection = false
+	if err := s.saveAttestationRecords(ctx, records); err != nil {
+		s.minimalSlashingProtection = true
+		return errors.Wrap(err, "could not save attestation history")
+	}
+	for pubKey, slot := range proposals {
+		if err := s.SaveProposalHistoryForSlot(ctx, pubKey, slot, params.BeaconConfig().ZeroHash[:]); err != nil {
+			s.minimalSlashingProtection = true
+			return errors.Wrap(err, "could not save proposal history")
+		}
+	}
+	return s.update(func(tx *bolt.Tx) error {
+		for _, b := range [][]byte{minimalAttestationsBucket, minimalProposalsBucket} {
+			if err := tx.DeleteBucket(b); err != nil {
+				return errors.Wrapf(err, "could not delete bucket %s", b)
+			}
+			if _, err := tx.CreateBucket(b); err != nil {
+				return errors.Wrapf(err, "could not create bucket %s", b)
+			}
+		}
+		return tx.Bucket(slashingProtectionSchemaBucket).Delete(minimalSchemaKey)
+	})
+}
+
+// highestSignedEpochs returns the highest source and target epochs in the complete attesting
+// history of a public key, including the lowest signed epochs which outlive pruning.
+func highestSignedEpochs(tx *bolt.Tx, pubKey []byte) (source, target primitives.Epoch, exists bool) {
+	if enc := tx.Bucket(lowestSignedSourceBucket).Get(pubKey); len(enc) >= 8 {
+		source = bytesutil.BytesToEpochBigEndian(enc)
+		exists = true
+	}
+	if enc := tx.Bucket(lowestSignedTargetBucket).Get(pubKey); len(enc) >= 8 {
+		target = bytesutil.BytesToEpochBigEndian(enc)
+		exists = true
+	}
+	pkBucket := tx.Bucket(pubKeysBucket).Bucket(pubKey)
+	if pkBucket == nil {
+		return
+	}
+	sourceEpochsBucket := pkBucket.Bucket(attestationSourceEpochsBucket)
+	if sourceEpochsBucket == nil {
+		return
+	}
+	// Errors can only come from the callback, which never fails.
+	_ = sourceEpochsBucket.ForEach(func(sourceBytes, targetEpochsList []byte) error {
+		exists = true
+		if s := bytesutil.BytesToEpochBigEndian(sourceBytes); s > source {
+			source = s
+		}
+		for i := 0; i+8 <= len(targetEpochsList); i += 8 {
+			if t := bytesutil.BytesToEpochBigEndian(targetEpochsList[i : i+8]); t > target {
+				target = t
+			}
+		}
+		return nil
+	})
+	return
+}
+
+// highestProposedSlot returns the highest slot in the complete proposal history of a public key.
+func highestProposedSlot(tx *bolt.Tx, pubKey []byte) (primitives.Slot, bool) {
+	var slot primitives.Slot
+	var exists bool
+	if enc := tx.Bucket(highestSignedProposalsBucket).Get(pubKey); len(enc) >= 8 {
+		slot = bytesutil.BytesToSlotBigEndian(enc)
+		exists = true
+	}
+	if valBucket := tx.Bucket(historicProposalsBucket).Bucket(pubKey); valBucket != nil {
+		if k, _ := valBucket.Cursor().Last(); k != nil {
+			if s := bytesutil.BytesToSlotBigEndian(k); !exists || s > slot {
+				slot = s
+			}
+			exists = true
+		}
+	}
+	return slot, exists
+}
+
+func encodeWatermarks(source, target primitives.Epoch) []byte {
+	return append(bytesutil.EpochToBytesBigEndian(source), bytesutil.EpochToBytesBigEndian(target)...)
+}
+
+func decodeWatermarks(enc []byte) (source, target primitives.Epoch) {
+	if len(enc) < 16 {
+		return 0, 0
+	}
+	return bytesutil.BytesToEpochBigEndian(enc[:8]), bytesutil.BytesToEpochBigEndian(enc[8:16])
+}
+
+func (s *Store) attestationWatermarks(pubKey [fieldparams.BLSPubkeyLength]byte) (source, target primitives.Epoch, exists bool, err error) {
+	err = s.view(func(tx *bolt.Tx) error {
+		enc := tx.Bucket(minimalAttestationsBucket).Get(pubKey[:])
+		if len(enc) < 16 {
+			return nil
+		}
+		exists = true
+		source, target = decodeWatermarks(enc)
+		return nil
+	})
+	return
+}
+
+func (s *Store) proposalWatermark(pubKey [fieldparams.BLSPubkeyLength]byte) (slot primitives.Slot, exists bool, err error) {
+	err = s.view(func(tx *bolt.Tx) error {
+		enc := tx.Bucket(minimalProposalsBucket).Get(pubKey[:])
+		if len(enc) < 8 {
+			return nil
+		}
+		exists = true
+		slot = bytesutil.BytesToSlotBigEndian(enc)
+		return nil
+	})
+	return
+}
+
+// raiseAttestationWatermarks raises the watermarks of a public key to the highest source and target
+// epochs among the given attestations.
+func (s *Store) raiseAttestationWatermarks(pubKey [fieldparams.BLSPubkeyLength]byte, atts []*ethpb.IndexedAttestation) error {
+	return s.update(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket(minimalAttestationsBucket)
+		enc := bkt.Get(pubKey[:])
+		exists := len(enc) >= 16
+		source, target := decodeWatermarks(enc)
+		for _, att := range atts {
+			if !exists || att.Data.Source.Epoch > source {
+				source = att.Data.Source.Epoch
+			}
+			if !exists || att.Data.Target.Epoch > target {
+				target = att.Data.Target.Epoch
+			}
+			exists = true
+		}
+		if !exists {
+			return nil
+		}
+		return bkt.Put(pubKey[:], encodeWatermarks(source, target))
+	})
+}
+
+// raiseProposalWatermark raises the proposal watermark of a public key to the given slot.
+func (s *Store) raiseProposalWatermark(pubKey [fieldparams.BLSPubkeyLength]byte, slot primitives.Slot) error {
+	return s.update(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket(minimalProposalsBucket)
+		if enc := bkt.Get(pubKey[:]); len(enc) >= 8 && bytesutil.BytesToSlotBigEndian(enc) >= slot {
+			return nil
+		}
+		return bkt.Put(pubKey[:], bytesutil.SlotToBytesBigEndian(slot))
+	})
+}
+
+func (s *Store) minimalPublicKeys(bucket []byte) ([][fieldparams.BLSPubkeyLength]byte, error) {
+	keys := make([][fieldparams.BLSPubkeyLength]byte, 0)
+	err := s.view(func(tx *bolt.Tx) error {
+		return tx.Bucket(bucket).ForEach(func(pubKey, _ []byte) error {
+			keys = append(keys, bytesutil.ToBytes48(pubKey))
+			return nil
+		})
+	})
+	return keys, err
+}
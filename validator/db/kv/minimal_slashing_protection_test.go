@@ -0,0 +1,176 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v4/config/features"
+	fieldparams "github.com/prysmaticlabs/prysm/v4/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v4/testing/assert"
+	"github.com/prysmaticlabs/prysm/v4/testing/require"
+)
+
+func minimalTestAtt(source, target primitives.Epoch) *ethpb.IndexedAttestation {
+	return &ethpb.IndexedAttestation{
+		Data: &ethpb.AttestationData{
+			Source: &ethpb.Checkpoint{Epoch: source},
+			Target: &ethpb.Checkpoint{Epoch: target},
+		},
+	}
+}
+
+func setupMinimalDB(t *testing.T, pubkeys [][fieldparams.BLSPubkeyLength]byte) *Store {
+	resetCfg := features.InitWithReset(&features.Flags{EnableMinimalSlashingProtection: true})
+	defer resetCfg()
+	db := setupDB(t, pubkeys)
+	require.Equal(t, true, db.MinimalSlashingProtection())
+	return db
+}
+
+func TestStore_MinimalSlashingProtection_ExistingHistoryKeepsSchema(t *testing.T) {
+	ctx := context.Background()
+	pubKey := [fieldparams.BLSPubkeyLength]byte{1}
+	db := setupDB(t, [][fieldparams.BLSPubkeyLength]byte{pubKey})
+	require.NoError(t, db.SaveProposalHistoryForSlot(ctx, pubKey, 1, []byte{1}))
+	require.NoError(t, db.Close())
+
+	resetCfg := features.InitWithReset(&features.Flags{EnableMinimalSlashingProtection: true})
+	defer resetCfg()
+	db, err := NewKVStore(ctx, db.databasePath, &Config{})
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, db.Close())
+	}()
+	assert.Equal(t, false, db.MinimalSlashingProtection())
+}
+
+func TestStore_MinimalSlashingProtection_Attestations(t *testing.T) {
+	ctx := context.Background()
+	pubKey := [fieldparams.BLSPubkeyLength]byte{1}
+	db := setupMinimalDB(t, [][fieldparams.BLSPubkeyLength]byte{pubKey})
+
+	_, err := db.SlashableAttestationCheck(ctx, pubKey, [32]byte{1}, minimalTestAtt(2, 3))
+	require.NoError(t, err)
+	_, err = db.SlashableAttestationCheck(ctx, pubKey, [32]byte{2}, minimalTestAtt(3, 5))
+	require.NoError(t, err)
+
+	// Anything at or below the watermarks is refused, even an attestation which was never signed.
+	_, err = db.SlashableAttestationCheck(ctx, pubKey, [32]byte{2}, minimalTestAtt(3, 5))
+	require.ErrorContains(t, "could not sign attestation lower than or equal to lowest target epoch", err)
+	_, err = db.SlashableAttestationCheck(ctx, pubKey, [32]byte{3}, minimalTestAtt(3, 4))
+	require.ErrorContains(t, "could not sign attestation lower than or equal to lowest target epoch", err)
+	_, err = db.SlashableAttestationCheck(ctx, pubKey, [32]byte{4}, minimalTestAtt(2, 6))
+	require.ErrorContains(t, "could not sign attestation lower than lowest source epoch", err)
+	_, err = db.SlashableAttestationCheck(ctx, pubKey, [32]byte{5}, minimalTestAtt(4, 6))
+	require.NoError(t, err)
+
+	history, err := db.AttestationHistoryForPubKey(ctx, pubKey)
+	require.NoError(t, err)
+	require.DeepEqual(t, []*AttestationRecord{{PubKey: pubKey, Source: 4, Target: 6}}, history)
+
+	// Saving older attestations, as done when importing, never lowers the watermarks.
+	require.NoError(t, db.SaveAttestationsForPubKey(
+		ctx, pubKey, [][32]byte{{6}, {7}}, []*ethpb.IndexedAttestation{minimalTestAtt(1, 2), minimalTestAtt(5, 5)},
+	))
+	source, exists, err := db.LowestSignedSourceEpoch(ctx, pubKey)
+	require.NoError(t, err)
+	require.Equal(t, true, exists)
+	assert.Equal(t, primitives.Epoch(5), source)
+	target, exists, err := db.LowestSignedTargetEpoch(ctx, pubKey)
+	require.NoError(t, err)
+	require.Equal(t, true, exists)
+	assert.Equal(t, primitives.Epoch(6), target)
+
+	keys, err := db.AttestedPublicKeys(ctx)
+	require.NoError(t, err)
+	require.DeepEqual(t, [][fieldparams.BLSPubkeyLength]byte{pubKey}, keys)
+}
+
+func TestStore_MinimalSlashingProtection_Proposals(t *testing.T) {
+	ctx := context.Background()
+	pubKey := [fieldparams.BLSPubkeyLength]byte{1}
+	db := setupMinimalDB(t, [][fieldparams.BLSPubkeyLength]byte{pubKey})
+
+	require.NoError(t, db.SlashableProposalCheck(ctx, pubKey, 10, [32]byte{1}))
+	require.ErrorIs(t, db.SlashableProposalCheck(ctx, pubKey, 10, [32]byte{1}), ErrDoubleProposal)
+	require.ErrorContains(t, "could not sign block with slot <= lowest signed slot", db.SlashableProposalCheck(ctx, pubKey, 9, [32]byte{2}))
+	require.NoError(t, db.SlashableProposalCheck(ctx, pubKey, 11, [32]byte{3}))
+
+	require.NoError(t, db.SaveProposalHistoryForSlot(ctx, pubKey, 5, []byte{4}))
+	proposals, err := db.ProposalHistoryForPubKey(ctx, pubKey)
+	require.NoError(t, err)
+	require.DeepEqual(t, []*Proposal{{Slot: 11}}, proposals)
+
+	keys, err := db.ProposedPublicKeys(ctx)
+	require.NoError(t, err)
+	require.DeepEqual(t, [][fieldparams.BLSPubkeyLength]byte{pubKey}, keys)
+}
+
+func TestStore_MigrateToMinimalSlashingProtection(t *testing.T) {
+	ctx := context.Background()
+	pubKey := [fieldparams.BLSPubkeyLength]byte{1}
+	db := setupDB(t, [][fieldparams.BLSPubkeyLength]byte{pubKey})
+	require.NoError(t, db.SaveAttestationsForPubKey(
+		ctx,
+		pubKey,
+		[][32]byte{{1}, {2}, {3}},
+		[]*ethpb.IndexedAttestation{minimalTestAtt(1, 2), minimalTestAtt(4, 5), minimalTestAtt(2, 7)},
+	))
+	require.NoError(t, db.SaveProposalHistoryForSlot(ctx, pubKey, 20, []byte{1}))
+	require.NoError(t, db.SaveProposalHistoryForSlot(ctx, pubKey, 30, []byte{2}))
+
+	require.NoError(t, db.MigrateToMinimalSlashingProtection(ctx))
+	require.Equal(t, true, db.MinimalSlashingProtection())
+
+	history, err := db.AttestationHistoryForPubKey(ctx, pubKey)
+	require.NoError(t, err)
+	require.DeepEqual(t, []*AttestationRecord{{PubKey: pubKey, Source: 4, Target: 7}}, history)
+	slot, exists, err := db.HighestSignedProposal(ctx, pubKey)
+	require.NoError(t, err)
+	require.Equal(t, true, exists)
+	assert.Equal(t, primitives.Slot(30), slot)
+
+	// The complete history is gone and the schema survives a restart.
+	require.NoError(t, db.Close())
+	db, err = NewKVStore(ctx, db.databasePath, &Config{})
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, db.Close())
+	}()
+	require.Equal(t, true, db.MinimalSlashingProtection())
+	db.minimalSlashingProtection = false
+	history, err = db.AttestationHistoryForPubKey(ctx, pubKey)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(history))
+	proposals, err := db.ProposalHistoryForPubKey(ctx, pubKey)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(proposals))
+}
+
+func TestStore_MigrateToCompleteSlashingProtection(t *testing.T) {
+	ctx := context.Background()
+	pubKey := [fieldparams.BLSPubkeyLength]byte{1}
+	db := setupMinimalDB(t, [][fieldparams.BLSPubkeyLength]byte{pubKey})
+	_, err := db.SlashableAttestationCheck(ctx, pubKey, [32]byte{1}, minimalTestAtt(4, 7))
+	require.NoError(t, err)
+	require.NoError(t, db.SlashableProposalCheck(ctx, pubKey, 30, [32]byte{1}))
+
+	require.NoError(t, db.MigrateToCompleteSlashingProtection(ctx))
+	require.Equal(t, false, db.MinimalSlashingProtection())
+
+	history, err := db.AttestationHistoryForPubKey(ctx, pubKey)
+	require.NoError(t, err)
+	require.DeepEqual(t, []*AttestationRecord{{PubKey: pubKey, Source: 4, Target: 7}}, history)
+
+	// The watermarks are still enforced by the complete schema.
+	_, err = db.SlashableAttestationCheck(ctx, pubKey, [32]byte{1}, minimalTestAtt(4, 7))
+	require.ErrorContains(t, "could not sign attestation lower than or equal to lowest target epoch", err)
+	require.ErrorIs(t, db.SlashableProposalCheck(ctx, pubKey, 30, [32]byte{1}), ErrDoubleProposal)
+	require.NoError(t, db.SlashableProposalCheck(ctx, pubKey, 31, [32]byte{1}))
+
+	keys, err := db.minimalPublicKeys(minimalAttestationsBucket)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(keys))
+}
@@ -29,6 +29,9 @@ type Proposal struct {
 func (s *Store) ProposedPublicKeys(ctx context.Context) ([][fieldparams.BLSPubkeyLength]byte, error) {
 	ctx, span := trace.StartSpan(ctx, "Validator.ProposedPublicKeys")
 	defer span.End()
+	if s.minimalSlashingProtection {
+		return s.minimalPublicKeys(minimalProposalsBucket)
+	}
 	var err error
 	proposedPublicKeys := make([][fieldparams.BLSPubkeyLength]byte, 0)
 	err = s.view(func(tx *bolt.Tx) error {
@@ -49,6 +52,10 @@ func (s *Store) ProposedPublicKeys(ctx context.Context) ([][fieldparams.BLSPubke
 func (s *Store) ProposalHistoryForSlot(ctx context.Context, publicKey [fieldparams.BLSPubkeyLength]byte, slot primitives.Slot) ([32]byte, bool, error) {
 	ctx, span := trace.StartSpan(ctx, "Validator.ProposalHistoryForSlot")
 	defer span.End()
+	if s.minimalSlashingProtection {
+		watermark, exists, err := s.proposalWatermark(publicKey)
+		return [32]byte{}, exists && watermark == slot, err
+	}
 
 	var err error
 	var proposalExists bool
@@ -76,6 +83,13 @@ func (s *Store) ProposalHistoryForPubKey(ctx context.Context, publicKey [fieldpa
 	defer span.End()
 
 	proposals := make([]*Proposal, 0)
+	if s.minimalSlashingProtection {
+		slot, exists, err := s.proposalWatermark(publicKey)
+		if exists {
+			proposals = append(proposals, &Proposal{Slot: slot})
+		}
+		return proposals, err
+	}
 	err := s.view(func(tx *bolt.Tx) error {
 		bucket := tx.Bucket(historicProposalsBucket)
 		valBucket := bucket.Bucket(publicKey[:])
@@ -102,6 +116,9 @@ func (s *Store) ProposalHistoryForPubKey(ctx context.Context, publicKey [fieldpa
 func (s *Store) SaveProposalHistoryForSlot(ctx context.Context, pubKey [fieldparams.BLSPubkeyLength]byte, slot primitives.Slot, signingRoot []byte) error {
 	ctx, span := trace.StartSpan(ctx, "Validator.SaveProposalHistoryForEpoch")
 	defer span.End()
+	if s.minimalSlashingProtection {
+		return s.raiseProposalWatermark(pubKey, slot)
+	}
 
 	err := s.update(func(tx *bolt.Tx) error {
 		bucket := tx.Bucket(historicProposalsBucket)
@@ -149,6 +166,9 @@ func (s *Store) SaveProposalHistoryForSlot(ctx context.Context, pubKey [fieldpar
 func (s *Store) LowestSignedProposal(ctx context.Context, publicKey [fieldparams.BLSPubkeyLength]byte) (primitives.Slot, bool, error) {
 	ctx, span := trace.StartSpan(ctx, "Validator.LowestSignedProposal")
 	defer span.End()
+	if s.minimalSlashingProtection {
+		return s.proposalWatermark(publicKey)
+	}
 
 	var err error
 	var lowestSignedProposalSlot primitives.Slot
@@ -172,6 +192,9 @@ func (s *Store) LowestSignedProposal(ctx context.Context, publicKey [fieldparams
 func (s *Store) HighestSignedProposal(ctx context.Context, publicKey [fieldparams.BLSPubkeyLength]byte) (primitives.Slot, bool, error) {
 	ctx, span := trace.StartSpan(ctx, "Validator.HighestSignedProposal")
 	defer span.End()
+	if s.minimalSlashingProtection {
+		return s.proposalWatermark(publicKey)
+	}
 
 	var err error
 	var highestSignedProposalSlot primitives.Slot
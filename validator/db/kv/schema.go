@@ -28,6 +28,13 @@ var (
 	attestationSourceEpochsBucket = []byte("att-source-epochs-bucket")
 	attestationTargetEpochsBucket = []byte("att-target-epochs-bucket")
 
+	// Minimal slashing protection buckets, keeping the low watermarks of each public key, and
+	// the key marking a database using the minimal schema.
+	minimalAttestationsBucket      = []byte("minimal-attestations-bucket")
+	minimalProposalsBucket         = []byte("minimal-proposals-bucket")
+	slashingProtectionSchemaBucket = []byte("slashing-protection-schema")
+	minimalSchemaKey               = []byte("minimal")
+
 	// Migrations
 	migrationsBucket = []byte("migrations")
 
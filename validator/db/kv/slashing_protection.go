@@ -0,0 +1,125 @@
+package kv
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	fieldparams "github.com/prysmaticlabs/prysm/v4/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v4/config/params"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1/slashings"
+	"go.opencensus.io/trace"
+)
+
+var (
+	// ErrDoubleProposal is returned when a proposal conflicts with a proposal signed earlier at the same slot.
+	ErrDoubleProposal = errors.New("attempted to sign a double proposal, block rejected by local protection")
+
+	lowerThanLowestSourceMessage = "could not sign attestation lower than lowest source epoch in db, %d < %d"
+	lowerThanLowestTargetMessage = "could not sign attestation lower than or equal to lowest target epoch in db, %d <= %d"
+	lowerThanLowestSlotMessage   = "could not sign block with slot <= lowest signed slot in db, lowest signed slot: %d >= block slot: %d"
+)
+
+// LowerThanLowestSourceError is returned when an attestation has a source epoch lower than the lowest
+// source epoch signed by the validator.
+func LowerThanLowestSourceError(source, lowestSource primitives.Epoch) error {
+	return fmt.Errorf(lowerThanLowestSourceMessage, source, lowestSource)
+}
+
+// LowerThanLowestTargetError is returned when an attestation has a target epoch lower than or equal to
+// the lowest target epoch signed by the validator.
+func LowerThanLowestTargetError(target, lowestTarget primitives.Epoch) error {
+	return fmt.Errorf(lowerThanLowestTargetMessage, target, lowestTarget)
+}
+
+// LowerThanLowestSlotError is returned when a proposal has a slot lower than or equal to the lowest
+// slot proposed by the validator.
+func LowerThanLowestSlotError(lowestSlot, slot primitives.Slot) error {
+	return fmt.Errorf(lowerThanLowestSlotMessage, lowestSlot, slot)
+}
+
+// SlashableAttestationCheck checks an attestation is not slashable given the attesting history
+// of the public key, following the EIP-3076 rules, and saves it to the history if it is not.
+func (s *Store) SlashableAttestationCheck(
+	ctx context.Context, pubKey [fieldparams.BLSPubkeyLength]byte, signingRoot [32]byte, att *ethpb.IndexedAttestation,
+) (SlashingKind, error) {
+	ctx, span := trace.StartSpan(ctx, "Validator.SlashableAttestationCheck")
+	defer span.End()
+
+	// Based on EIP3076, validator should refuse to sign any attestation with source epoch less
+	// than the minimum source epoch present in that signer’s attestations.
+	lowestSourceEpoch, exists, err := s.LowestSignedSourceEpoch(ctx, pubKey)
+	if err != nil {
+		return NotSlashable, err
+	}
+	if exists && att.Data.Source.Epoch < lowestSourceEpoch {
+		return NotSlashable, LowerThanLowestSourceError(att.Data.Source.Epoch, lowestSourceEpoch)
+	}
+	existingSigningRoot, err := s.SigningRootAtTargetEpoch(ctx, pubKey, att.Data.Target.Epoch)
+	if err != nil {
+		return NotSlashable, err
+	}
+	signingRootsDiffer := slashings.SigningRootsDiffer(existingSigningRoot, signingRoot)
+
+	// Based on EIP3076, validator should refuse to sign any attestation with target epoch less
+	// than or equal to the minimum target epoch present in that signer’s attestations.
+	lowestTargetEpoch, exists, err := s.LowestSignedTargetEpoch(ctx, pubKey)
+	if err != nil {
+		return NotSlashable, err
+	}
+	if signingRootsDiffer && exists && att.Data.Target.Epoch <= lowestTargetEpoch {
+		return NotSlashable, LowerThanLowestTargetError(att.Data.Target.Epoch, lowestTargetEpoch)
+	}
+
+	slashingKind, err := s.CheckSlashableAttestation(ctx, pubKey, signingRoot, att)
+	if err != nil {
+		return slashingKind, err
+	}
+	if err := s.SaveAttestationForPubKey(ctx, pubKey, signingRoot, att); err != nil {
+		return NotSlashable, errors.Wrap(err, "could not save attestation history for validator public key")
+	}
+	return NotSlashable, nil
+}
+
+// SlashableProposalCheck checks a proposal is not slashable given the proposal history of the
+// public key, following the EIP-3076 rules, and saves it to the history if it is not.
+func (s *Store) SlashableProposalCheck(
+	ctx context.Context, pubKey [fieldparams.BLSPubkeyLength]byte, slot primitives.Slot, signingRoot [32]byte,
+) error {
+	ctx, span := trace.StartSpan(ctx, "Validator.SlashableProposalCheck")
+	defer span.End()
+
+	prevSigningRoot, proposalAtSlotExists, err := s.ProposalHistoryForSlot(ctx, pubKey, slot)
+	if err != nil {
+		return errors.Wrap(err, "failed to get proposal history")
+	}
+
+	lowestSignedProposalSlot, lowestProposalExists, err := s.LowestSignedProposal(ctx, pubKey)
+	if err != nil {
+		return err
+	}
+
+	// If a proposal exists in our history for the slot, we check the following:
+	// If the signing root is empty (zero hash), then we consider it slashable. If signing root is not empty,
+	// we check if it is different than the incoming block's signing root. If that is the case,
+	// we consider that proposal slashable.
+	signingRootIsDifferent := prevSigningRoot == params.BeaconConfig().ZeroHash || prevSigningRoot != signingRoot
+	if proposalAtSlotExists && signingRootIsDifferent {
+		return ErrDoubleProposal
+	}
+
+	// Based on EIP3076, validator should refuse to sign any proposal with slot less
+	// than or equal to the minimum signed proposal present in the DB for that public key.
+	// In the case the slot of the incoming block is equal to the minimum signed proposal, we
+	// then also check the signing root is different.
+	if lowestProposalExists && signingRootIsDifferent && lowestSignedProposalSlot >= slot {
+		return LowerThanLowestSlotError(lowestSignedProposalSlot, slot)
+	}
+
+	if err := s.SaveProposalHistoryForSlot(ctx, pubKey, slot, signingRoot[:]); err != nil {
+		return errors.Wrap(err, "failed to save updated proposal history")
+	}
+	return nil
+}
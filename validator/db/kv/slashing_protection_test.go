@@ -0,0 +1,60 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	fieldparams "github.com/prysmaticlabs/prysm/v4/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v4/testing/require"
+)
+
+func TestStore_SlashableAttestationCheck(t *testing.T) {
+	ctx := context.Background()
+	pubKey := [fieldparams.BLSPubkeyLength]byte{1}
+	db := setupDB(t, [][fieldparams.BLSPubkeyLength]byte{pubKey})
+
+	att := func(source, target primitives.Epoch) *ethpb.IndexedAttestation {
+		return &ethpb.IndexedAttestation{
+			Data: &ethpb.AttestationData{
+				Source: &ethpb.Checkpoint{Epoch: source},
+				Target: &ethpb.Checkpoint{Epoch: target},
+			},
+		}
+	}
+
+	kind, err := db.SlashableAttestationCheck(ctx, pubKey, [32]byte{1}, att(2, 3))
+	require.NoError(t, err)
+	require.Equal(t, NotSlashable, kind)
+
+	// Re-signing the same data is allowed.
+	_, err = db.SlashableAttestationCheck(ctx, pubKey, [32]byte{1}, att(2, 3))
+	require.NoError(t, err)
+
+	_, err = db.SlashableAttestationCheck(ctx, pubKey, [32]byte{2}, att(3, 5))
+	require.NoError(t, err)
+
+	kind, err = db.SlashableAttestationCheck(ctx, pubKey, [32]byte{3}, att(3, 5))
+	require.NotNil(t, err)
+	require.Equal(t, DoubleVote, kind)
+
+	kind, err = db.SlashableAttestationCheck(ctx, pubKey, [32]byte{4}, att(2, 6))
+	require.NotNil(t, err)
+	require.Equal(t, SurroundingVote, kind)
+
+	_, err = db.SlashableAttestationCheck(ctx, pubKey, [32]byte{5}, att(2, 3))
+	require.ErrorContains(t, "could not sign attestation lower than or equal to lowest target epoch", err)
+}
+
+func TestStore_SlashableProposalCheck(t *testing.T) {
+	ctx := context.Background()
+	pubKey := [fieldparams.BLSPubkeyLength]byte{1}
+	db := setupDB(t, [][fieldparams.BLSPubkeyLength]byte{pubKey})
+
+	require.NoError(t, db.SlashableProposalCheck(ctx, pubKey, 10, [32]byte{1}))
+	// Re-signing the same block is allowed.
+	require.NoError(t, db.SlashableProposalCheck(ctx, pubKey, 10, [32]byte{1}))
+	require.ErrorIs(t, db.SlashableProposalCheck(ctx, pubKey, 10, [32]byte{2}), ErrDoubleProposal)
+	require.ErrorContains(t, "could not sign block with slot <= lowest signed slot", db.SlashableProposalCheck(ctx, pubKey, 9, [32]byte{3}))
+}
@@ -46,3 +46,50 @@ func MigrateDown(cliCtx *cli.Context) error {
 	log.Info("Running migrations")
 	return validatorDB.RunDownMigrations(ctx)
 }
+
+// MigrateToMinimal converts the slashing protection history of a validator database to the
+// minimal schema, which only keeps the low watermarks of each public key.
+func MigrateToMinimal(cliCtx *cli.Context) error {
+	validatorDB, err := openForSchemaMigration(cliCtx)
+	if err != nil {
+		return err
+	}
+	defer closeDB(validatorDB)
+	if validatorDB.MinimalSlashingProtection() {
+		log.Info("Slashing protection database already uses the minimal schema")
+		return nil
+	}
+	log.Warn("Converting slashing protection history to the minimal schema, signing roots and past history are discarded")
+	return validatorDB.MigrateToMinimalSlashingProtection(context.Background())
+}
+
+// MigrateToComplete converts the slashing protection history of a validator database from the
+// minimal schema back to the complete one.
+func MigrateToComplete(cliCtx *cli.Context) error {
+	validatorDB, err := openForSchemaMigration(cliCtx)
+	if err != nil {
+		return err
+	}
+	defer closeDB(validatorDB)
+	if !validatorDB.MinimalSlashingProtection() {
+		log.Info("Slashing protection database already uses the complete schema")
+		return nil
+	}
+	log.Info("Converting slashing protection history to the complete schema")
+	return validatorDB.MigrateToCompleteSlashingProtection(context.Background())
+}
+
+func openForSchemaMigration(cliCtx *cli.Context) (*kv.Store, error) {
+	dataDir := cliCtx.String(cmd.DataDirFlag.Name)
+	if !file.FileExists(path.Join(dataDir, kv.ProtectionDbFileName)) {
+		return nil, errors.New("No validator db found at path, nothing to migrate")
+	}
+	log.Info("Opening DB")
+	return kv.NewKVStore(context.Background(), dataDir, &kv.Config{})
+}
+
+func closeDB(validatorDB *kv.Store) {
+	if err := validatorDB.Close(); err != nil {
+		log.WithError(err).Error("Could not close validator database")
+	}
+}
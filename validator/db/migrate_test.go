@@ -1,12 +1,16 @@
 package db
 
 import (
+	"context"
 	"flag"
 	"testing"
 
 	"github.com/prysmaticlabs/prysm/v4/cmd"
+	fieldparams "github.com/prysmaticlabs/prysm/v4/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/v4/testing/assert"
 	"github.com/prysmaticlabs/prysm/v4/testing/require"
+	"github.com/prysmaticlabs/prysm/v4/validator/db/kv"
 	dbtest "github.com/prysmaticlabs/prysm/v4/validator/db/testing"
 	"github.com/urfave/cli/v2"
 )
@@ -54,3 +58,33 @@ func TestMigrateDown_OK(t *testing.T) {
 	cliCtx := cli.NewContext(&app, set, nil)
 	assert.NoError(t, MigrateDown(cliCtx))
 }
+
+func TestMigrateToMinimal_RoundTrip(t *testing.T) {
+	ctx := context.Background()
+	pubKey := [fieldparams.BLSPubkeyLength]byte{1}
+	validatorDB := dbtest.SetupDB(t, [][fieldparams.BLSPubkeyLength]byte{pubKey})
+	require.NoError(t, validatorDB.SaveProposalHistoryForSlot(ctx, pubKey, 10, []byte{1}))
+	dbPath := validatorDB.DatabasePath()
+	require.NoError(t, validatorDB.Close())
+	app := cli.App{}
+	set := flag.NewFlagSet("test", 0)
+	set.String(cmd.DataDirFlag.Name, dbPath, "")
+	require.NoError(t, set.Set(cmd.DataDirFlag.Name, dbPath))
+	cliCtx := cli.NewContext(&app, set, nil)
+
+	require.NoError(t, MigrateToMinimal(cliCtx))
+	store, err := kv.NewKVStore(ctx, dbPath, &kv.Config{})
+	require.NoError(t, err)
+	assert.Equal(t, true, store.MinimalSlashingProtection())
+	require.NoError(t, store.Close())
+
+	require.NoError(t, MigrateToComplete(cliCtx))
+	store, err = kv.NewKVStore(ctx, dbPath, &kv.Config{})
+	require.NoError(t, err)
+	assert.Equal(t, false, store.MinimalSlashingProtection())
+	slot, exists, err := store.HighestSignedProposal(ctx, pubKey)
+	require.NoError(t, err)
+	require.Equal(t, true, exists)
+	assert.Equal(t, primitives.Slot(10), slot)
+	require.NoError(t, store.Close())
+}
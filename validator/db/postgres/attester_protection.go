@@ -0,0 +1,110 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/pkg/errors"
+	fieldparams "github.com/prysmaticlabs/prysm/v4/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v4/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1/slashings"
+	"github.com/prysmaticlabs/prysm/v4/validator/db/kv"
+	"go.opencensus.io/trace"
+)
+
+// SlashableAttestationCheck checks an attestation is neither a double vote nor a surround vote, nor
+// lower than the lowest signed source and target epochs of the public key, and saves it if so.
+func (s *Store) SlashableAttestationCheck(
+	ctx context.Context, pubKey [fieldparams.BLSPubkeyLength]byte, signingRoot [32]byte, att *ethpb.IndexedAttestation,
+) (kv.SlashingKind, error) {
+	ctx, span := trace.StartSpan(ctx, "postgres.SlashableAttestationCheck")
+	defer span.End()
+
+	source, err := toInt64(uint64(att.Data.Source.Epoch))
+	if err != nil {
+		return kv.NotSlashable, err
+	}
+	target, err := toInt64(uint64(att.Data.Target.Epoch))
+	if err != nil {
+		return kv.NotSlashable, err
+	}
+
+	slashingKind := kv.NotSlashable
+	err = s.withLockedKey(ctx, pubKey[:], func(tx *sql.Tx) error {
+		var lowestSource, lowestTarget sql.NullInt64
+		if err := tx.QueryRowContext(ctx,
+			`SELECT MIN(source_epoch), MIN(target_epoch) FROM signed_attestations WHERE pubkey = $1`, pubKey[:],
+		).Scan(&lowestSource, &lowestTarget); err != nil {
+			return errors.Wrap(err, "could not get lowest signed epochs")
+		}
+		// Based on EIP3076, validator should refuse to sign any attestation with source epoch less
+		// than the minimum source epoch present in that signer’s attestations.
+		if lowestSource.Valid && source < lowestSource.Int64 {
+			return kv.LowerThanLowestSourceError(att.Data.Source.Epoch, primitives.Epoch(lowestSource.Int64))
+		}
+
+		var existingRoot []byte
+		var existingSigner string
+		err := tx.QueryRowContext(ctx,
+			`SELECT signing_root, signer FROM signed_attestations WHERE pubkey = $1 AND target_epoch = $2`, pubKey[:], target,
+		).Scan(&existingRoot, &existingSigner)
+		if err != nil && !errors.Is(err, sql.ErrNoRows) {
+			return errors.Wrap(err, "could not get signing root at target epoch")
+		}
+		exists := err == nil
+		signingRootsDiffer := slashings.SigningRootsDiffer(bytesutil.ToBytes32(existingRoot), signingRoot)
+
+		// Based on EIP3076, validator should refuse to sign any attestation with target epoch less
+		// than or equal to the minimum target epoch present in that signer’s attestations.
+		if signingRootsDiffer && lowestTarget.Valid && target <= lowestTarget.Int64 {
+			return kv.LowerThanLowestTargetError(att.Data.Target.Epoch, primitives.Epoch(lowestTarget.Int64))
+		}
+		if exists {
+			if signingRootsDiffer {
+				slashingKind = kv.DoubleVote
+				return fmt.Errorf("double vote found, existing attestation at target epoch %d with conflicting signing root %#x", target, existingRoot)
+			}
+			if existingSigner != s.signer {
+				return ErrSignedByAnotherClient
+			}
+			// Signing the same attestation again is safe, and it is already recorded.
+			return nil
+		}
+
+		var otherSource, otherTarget int64
+		err = tx.QueryRowContext(ctx,
+			`SELECT source_epoch, target_epoch FROM signed_attestations
+			WHERE pubkey = $1 AND source_epoch > $2 AND target_epoch < $3 LIMIT 1`, pubKey[:], source, target,
+		).Scan(&otherSource, &otherTarget)
+		if err == nil {
+			slashingKind = kv.SurroundingVote
+			return fmt.Errorf("attestation with (source %d, target %d) surrounds another with (source %d, target %d)", source, target, otherSource, otherTarget)
+		}
+		if !errors.Is(err, sql.ErrNoRows) {
+			return errors.Wrap(err, "could not check for surrounding votes")
+		}
+		err = tx.QueryRowContext(ctx,
+			`SELECT source_epoch, target_epoch FROM signed_attestations
+			WHERE pubkey = $1 AND source_epoch < $2 AND target_epoch > $3 LIMIT 1`, pubKey[:], source, target,
+		).Scan(&otherSource, &otherTarget)
+		if err == nil {
+			slashingKind = kv.SurroundedVote
+			return fmt.Errorf("attestation with (source %d, target %d) is surrounded by another with (source %d, target %d)", source, target, otherSource, otherTarget)
+		}
+		if !errors.Is(err, sql.ErrNoRows) {
+			return errors.Wrap(err, "could not check for surrounded votes")
+		}
+
+		if _, err := tx.ExecContext(ctx,
+			`INSERT INTO signed_attestations (pubkey, source_epoch, target_epoch, signing_root, signer) VALUES ($1, $2, $3, $4, $5)`,
+			pubKey[:], source, target, signingRoot[:], s.signer,
+		); err != nil {
+			return errors.Wrap(err, "could not save attestation")
+		}
+		return nil
+	})
+	return slashingKind, err
+}
@@ -0,0 +1,237 @@
+package postgres
+
+import (
+	"context"
+	"crypto/rand"
+	"database/sql"
+	"io"
+	"net"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	embeddedpostgres "github.com/fergusstrange/embedded-postgres"
+	fieldparams "github.com/prysmaticlabs/prysm/v4/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v4/testing/assert"
+	"github.com/prysmaticlabs/prysm/v4/testing/require"
+	"github.com/prysmaticlabs/prysm/v4/validator/db/kv"
+)
+
+// testDBURLEnv names the environment variable holding the connection URL of a PostgreSQL database
+// used to run the tests with concurrent validator clients. When it is not set, the tests run
+// against an embedded database.
+const testDBURLEnv = "SLASHING_PROTECTION_TEST_DB_URL"
+
+var (
+	embeddedDBOnce sync.Once
+	embeddedDB     *embeddedpostgres.EmbeddedPostgres
+	embeddedDBDir  string
+	embeddedDBURL  string
+	embeddedDBErr  error
+)
+
+func TestMain(m *testing.M) {
+	code := m.Run()
+	if embeddedDB != nil {
+		if err := embeddedDB.Stop(); err != nil {
+			log.WithError(err).Error("Could not stop embedded database")
+		}
+	}
+	if embeddedDBDir != "" {
+		if err := os.RemoveAll(embeddedDBDir); err != nil {
+			log.WithError(err).Error("Could not remove embedded database directory")
+		}
+	}
+	os.Exit(code)
+}
+
+// testDBURL returns the connection URL of the test database, starting the embedded database
+// the first time it is needed.
+func testDBURL(t *testing.T) string {
+	if url := os.Getenv(testDBURLEnv); url != "" {
+		return url
+	}
+	embeddedDBOnce.Do(func() {
+		embeddedDBURL, embeddedDBErr = startEmbeddedDB()
+	})
+	require.NoError(t, embeddedDBErr, "Could not start embedded database")
+	return embeddedDBURL
+}
+
+func startEmbeddedDB() (string, error) {
+	dir, err := os.MkdirTemp("", "slashing-protection-db")
+	if err != nil {
+		return "", err
+	}
+	embeddedDBDir = dir
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return "", err
+	}
+	port := uint32(l.Addr().(*net.TCPAddr).Port)
+	if err := l.Close(); err != nil {
+		return "", err
+	}
+	cfg := embeddedpostgres.DefaultConfig().
+		Port(port).
+		RuntimePath(filepath.Join(dir, "runtime")).
+		Logger(io.Discard)
+	db := embeddedpostgres.NewDatabase(cfg)
+	if err := db.Start(); err != nil {
+		return "", err
+	}
+	embeddedDB = db
+	return cfg.GetConnectionURL() + "?sslmode=disable", nil
+}
+
+// setupClients connects n validator clients to the test database.
+func setupClients(t *testing.T, n int) []*Store {
+	url := testDBURL(t)
+	clients := make([]*Store, n)
+	for i := range clients {
+		s, err := NewStore(context.Background(), url)
+		require.NoError(t, err)
+		t.Cleanup(func() {
+			assert.NoError(t, s.Close())
+		})
+		clients[i] = s
+	}
+	return clients
+}
+
+// randomPubKey returns a public key that no other test uses, so that tests sharing the database
+// do not need to clean it up.
+func randomPubKey(t *testing.T) [fieldparams.BLSPubkeyLength]byte {
+	var k [fieldparams.BLSPubkeyLength]byte
+	_, err := rand.Read(k[:])
+	require.NoError(t, err)
+	return k
+}
+
+// concurrently runs f for every client at the same time and returns the results in client order.
+func concurrently(clients []*Store, f func(i int, s *Store) error) []error {
+	errs := make([]error, len(clients))
+	start := make(chan struct{})
+	var wg sync.WaitGroup
+	for i, s := range clients {
+		wg.Add(1)
+		go func(i int, s *Store) {
+			defer wg.Done()
+			<-start
+			errs[i] = f(i, s)
+		}(i, s)
+	}
+	close(start)
+	wg.Wait()
+	return errs
+}
+
+func requireOneSigned(t *testing.T, errs []error) {
+	signed := 0
+	for _, err := range errs {
+		if err == nil {
+			signed++
+		}
+	}
+	require.Equal(t, 1, signed, "errors: %v", errs)
+}
+
+func TestStore_ConcurrentClients_SignOnce(t *testing.T) {
+	ctx := context.Background()
+	clients := setupClients(t, 4)
+	root := [32]byte{'a'}
+	// Repeat with fresh keys to let the clients race on the first insert of the key as well.
+	for i := 0; i < 20; i++ {
+		pub := randomPubKey(t)
+		errs := concurrently(clients, func(_ int, s *Store) error {
+			_, err := s.SlashableAttestationCheck(ctx, pub, root, att(3, 4))
+			return err
+		})
+		requireOneSigned(t, errs)
+		for _, err := range errs {
+			if err != nil {
+				require.ErrorIs(t, err, ErrSignedByAnotherClient)
+			}
+		}
+
+		errs = concurrently(clients, func(_ int, s *Store) error {
+			return s.SlashableProposalCheck(ctx, pub, 10, root)
+		})
+		requireOneSigned(t, errs)
+	}
+}
+
+func TestStore_ConcurrentClients_ConflictingMessages(t *testing.T) {
+	ctx := context.Background()
+	clients := setupClients(t, 2)
+	t.Run("double vote", func(t *testing.T) {
+		pub := randomPubKey(t)
+		errs := concurrently(clients, func(i int, s *Store) error {
+			_, err := s.SlashableAttestationCheck(ctx, pub, [32]byte{byte(i)}, att(3, 4))
+			return err
+		})
+		requireOneSigned(t, errs)
+	})
+	t.Run("surround vote", func(t *testing.T) {
+		pub := randomPubKey(t)
+		atts := [][2]primitives.Epoch{{1, 6}, {2, 3}}
+		errs := concurrently(clients, func(i int, s *Store) error {
+			_, err := s.SlashableAttestationCheck(ctx, pub, [32]byte{byte(i)}, att(atts[i][0], atts[i][1]))
+			return err
+		})
+		requireOneSigned(t, errs)
+	})
+	t.Run("double proposal", func(t *testing.T) {
+		pub := randomPubKey(t)
+		errs := concurrently(clients, func(i int, s *Store) error {
+			return s.SlashableProposalCheck(ctx, pub, 10, [32]byte{byte(i)})
+		})
+		requireOneSigned(t, errs)
+		for _, err := range errs {
+			if err != nil {
+				require.ErrorIs(t, err, kv.ErrDoubleProposal)
+			}
+		}
+	})
+}
+
+func TestStore_ConcurrentClients_CheckWaitsForKeyLock(t *testing.T) {
+	ctx := context.Background()
+	clients := setupClients(t, 2)
+	pub := randomPubKey(t)
+
+	// The first client holds the row lock of the key, as it does during a check.
+	locked := make(chan struct{})
+	release := make(chan struct{})
+	held := make(chan error, 1)
+	go func() {
+		held <- clients[0].withLockedKey(ctx, pub[:], func(_ *sql.Tx) error {
+			close(locked)
+			<-release
+			return nil
+		})
+	}()
+	<-locked
+
+	done := make(chan error, 1)
+	go func() {
+		_, err := clients[1].SlashableAttestationCheck(ctx, pub, [32]byte{'a'}, att(3, 4))
+		done <- err
+	}()
+	select {
+	case err := <-done:
+		t.Fatalf("Check did not wait for the lock of the key: %v", err)
+	case <-time.After(200 * time.Millisecond):
+	}
+	close(release)
+	require.NoError(t, <-held)
+	select {
+	case err := <-done:
+		require.NoError(t, err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("Check did not complete once the lock of the key was released")
+	}
+}
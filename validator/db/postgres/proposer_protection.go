@@ -0,0 +1,74 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/pkg/errors"
+	fieldparams "github.com/prysmaticlabs/prysm/v4/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v4/config/params"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v4/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/v4/validator/db/kv"
+	"go.opencensus.io/trace"
+)
+
+// SlashableProposalCheck checks a proposal is neither a double proposal nor lower than the lowest
+// signed slot of the public key, and saves it if so.
+func (s *Store) SlashableProposalCheck(
+	ctx context.Context, pubKey [fieldparams.BLSPubkeyLength]byte, slot primitives.Slot, signingRoot [32]byte,
+) error {
+	ctx, span := trace.StartSpan(ctx, "postgres.SlashableProposalCheck")
+	defer span.End()
+
+	dbSlot, err := toInt64(uint64(slot))
+	if err != nil {
+		return err
+	}
+	return s.withLockedKey(ctx, pubKey[:], func(tx *sql.Tx) error {
+		var existingRoot []byte
+		var existingSigner string
+		err := tx.QueryRowContext(ctx,
+			`SELECT signing_root, signer FROM signed_blocks WHERE pubkey = $1 AND slot = $2`, pubKey[:], dbSlot,
+		).Scan(&existingRoot, &existingSigner)
+		if err != nil && !errors.Is(err, sql.ErrNoRows) {
+			return errors.Wrap(err, "failed to get proposal history")
+		}
+		exists := err == nil
+
+		var lowestSlot sql.NullInt64
+		if err := tx.QueryRowContext(ctx,
+			`SELECT MIN(slot) FROM signed_blocks WHERE pubkey = $1`, pubKey[:],
+		).Scan(&lowestSlot); err != nil {
+			return errors.Wrap(err, "could not get lowest signed slot")
+		}
+
+		// A proposal with an empty signing root in the history is considered slashable, as is a
+		// proposal with a different signing root.
+		prevSigningRoot := bytesutil.ToBytes32(existingRoot)
+		signingRootIsDifferent := prevSigningRoot == params.BeaconConfig().ZeroHash || prevSigningRoot != signingRoot
+		if exists && signingRootIsDifferent {
+			return kv.ErrDoubleProposal
+		}
+		// Based on EIP3076, validator should refuse to sign any proposal with slot less
+		// than or equal to the minimum signed proposal present in the DB for that public key.
+		if lowestSlot.Valid && signingRootIsDifferent && lowestSlot.Int64 >= dbSlot {
+			return kv.LowerThanLowestSlotError(primitives.Slot(lowestSlot.Int64), slot)
+		}
+		if exists {
+			if existingSigner != s.signer {
+				return ErrSignedByAnotherClient
+			}
+			// Signing the same block again is safe, and it is already recorded.
+			return nil
+		}
+
+		if _, err := tx.ExecContext(ctx,
+			`INSERT INTO signed_blocks (pubkey, slot, signing_root, signer) VALUES ($1, $2, $3, $4)`,
+			pubKey[:], dbSlot, signingRoot[:], s.signer,
+		); err != nil {
+			return errors.Wrap(err, "failed to save updated proposal history")
+		}
+		return nil
+	})
+}
@@ -0,0 +1,25 @@
+package postgres
+
+// The validators table holds a row per public key, which is locked for the duration of every
+// check so that checks for the same key made by different validator clients are serialized.
+var schema = []string{
+	`CREATE TABLE IF NOT EXISTS validators (
+		pubkey BYTEA PRIMARY KEY
+	)`,
+	`CREATE TABLE IF NOT EXISTS signed_attestations (
+		pubkey       BYTEA  NOT NULL REFERENCES validators (pubkey),
+		source_epoch BIGINT NOT NULL,
+		target_epoch BIGINT NOT NULL,
+		signing_root BYTEA  NOT NULL,
+		signer       TEXT   NOT NULL,
+		PRIMARY KEY (pubkey, target_epoch)
+	)`,
+	`CREATE INDEX IF NOT EXISTS signed_attestations_source_idx ON signed_attestations (pubkey, source_epoch)`,
+	`CREATE TABLE IF NOT EXISTS signed_blocks (
+		pubkey       BYTEA  NOT NULL REFERENCES validators (pubkey),
+		slot         BIGINT NOT NULL,
+		signing_root BYTEA  NOT NULL,
+		signer       TEXT   NOT NULL,
+		PRIMARY KEY (pubkey, slot)
+	)`,
+}
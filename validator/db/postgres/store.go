@@ -0,0 +1,105 @@
+// Package postgres implements slashing protection in a PostgreSQL compatible database, which can
+// be shared by several validator clients signing with the same keys, e.g. in active-active or
+// hot-standby setups.
+//
+// Every check runs in a transaction holding a row lock on the public key, so checks for the same
+// key never interleave. A message is signed exactly once: a validator client is allowed to sign
+// again a message it signed before, but not a message signed by another validator client, even an
+// identical one.
+package postgres
+
+import (
+	"context"
+	"crypto/rand"
+	"database/sql"
+	"encoding/hex"
+	"math"
+
+	// Registers the postgres database/sql driver.
+	_ "github.com/lib/pq"
+	"github.com/pkg/errors"
+)
+
+// ErrSignedByAnotherClient is returned when a message was already signed by another validator client
+// sharing the database.
+var ErrSignedByAnotherClient = errors.New("message already signed by another validator client")
+
+// Store is a slashing protection backend in a PostgreSQL compatible database.
+type Store struct {
+	db     *sql.DB
+	signer string
+}
+
+// NewStore connects to the database at the given connection URL and creates the slashing
+// protection tables if they do not exist yet.
+func NewStore(ctx context.Context, url string) (*Store, error) {
+	db, err := sql.Open("postgres", url)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not open slashing protection database")
+	}
+	s, err := newStore(ctx, db)
+	if err != nil {
+		if closeErr := db.Close(); closeErr != nil {
+			log.WithError(closeErr).Error("Could not close slashing protection database")
+		}
+		return nil, err
+	}
+	log.WithField("signer", s.signer).Info("Using shared slashing protection database")
+	return s, nil
+}
+
+func newStore(ctx context.Context, db *sql.DB) (*Store, error) {
+	if err := db.PingContext(ctx); err != nil {
+		return nil, errors.Wrap(err, "could not connect to slashing protection database")
+	}
+	for _, stmt := range schema {
+		if _, err := db.ExecContext(ctx, stmt); err != nil {
+			return nil, errors.Wrap(err, "could not create slashing protection schema")
+		}
+	}
+	id := make([]byte, 16)
+	if _, err := rand.Read(id); err != nil {
+		return nil, errors.Wrap(err, "could not generate signer identifier")
+	}
+	return &Store{db: db, signer: hex.EncodeToString(id)}, nil
+}
+
+// Close the connections to the database.
+func (s *Store) Close() error {
+	return s.db.Close()
+}
+
+// withLockedKey runs f in a transaction holding the row lock of the public key, committing the
+// transaction if f succeeds and rolling it back otherwise.
+func (s *Store) withLockedKey(ctx context.Context, pubKey []byte, f func(tx *sql.Tx) error) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return errors.Wrap(err, "could not begin transaction")
+	}
+	if _, err := tx.ExecContext(ctx, `INSERT INTO validators (pubkey) VALUES ($1) ON CONFLICT DO NOTHING`, pubKey); err != nil {
+		return rollback(tx, errors.Wrap(err, "could not insert public key"))
+	}
+	var locked []byte
+	if err := tx.QueryRowContext(ctx, `SELECT pubkey FROM validators WHERE pubkey = $1 FOR UPDATE`, pubKey).Scan(&locked); err != nil {
+		return rollback(tx, errors.Wrap(err, "could not lock public key"))
+	}
+	if err := f(tx); err != nil {
+		return rollback(tx, err)
+	}
+	return errors.Wrap(tx.Commit(), "could not commit transaction")
+}
+
+func rollback(tx *sql.Tx, err error) error {
+	if rbErr := tx.Rollback(); rbErr != nil {
+		log.WithError(rbErr).Error("Could not roll back slashing protection transaction")
+	}
+	return err
+}
+
+// toInt64 converts an epoch or a slot to the signed integer type of the database.
+func toInt64(v uint64) (int64, error) {
+	if v > math.MaxInt64 {
+		return 0, errors.Errorf("value %d is too large to be stored", v)
+	}
+	return int64(v), nil
+}
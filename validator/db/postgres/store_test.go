@@ -0,0 +1,204 @@
+package postgres
+
+import (
+	"context"
+	"database/sql/driver"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/pkg/errors"
+	fieldparams "github.com/prysmaticlabs/prysm/v4/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v4/testing/assert"
+	"github.com/prysmaticlabs/prysm/v4/testing/require"
+	"github.com/prysmaticlabs/prysm/v4/validator/db/kv"
+)
+
+var pubKey = [fieldparams.BLSPubkeyLength]byte{1}
+
+func setupStore(t *testing.T) (*Store, sqlmock.Sqlmock) {
+	db, mock, err := sqlmock.New(sqlmock.MonitorPingsOption(true))
+	require.NoError(t, err)
+	mock.ExpectPing()
+	for range schema {
+		mock.ExpectExec("CREATE").WillReturnResult(sqlmock.NewResult(0, 0))
+	}
+	s, err := newStore(context.Background(), db)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+	return s, mock
+}
+
+func expectLock(mock sqlmock.Sqlmock) {
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO validators").WithArgs(pubKey[:]).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectQuery("SELECT pubkey FROM validators WHERE pubkey = \\$1 FOR UPDATE").
+		WithArgs(pubKey[:]).WillReturnRows(sqlmock.NewRows([]string{"pubkey"}).AddRow(pubKey[:]))
+}
+
+func att(source, target primitives.Epoch) *ethpb.IndexedAttestation {
+	return &ethpb.IndexedAttestation{Data: &ethpb.AttestationData{
+		Source: &ethpb.Checkpoint{Epoch: source},
+		Target: &ethpb.Checkpoint{Epoch: target},
+	}}
+}
+
+func expectLowestEpochs(mock sqlmock.Sqlmock, source, target driver.Value) {
+	mock.ExpectQuery("SELECT MIN\\(source_epoch\\), MIN\\(target_epoch\\)").
+		WithArgs(pubKey[:]).WillReturnRows(sqlmock.NewRows([]string{"min", "min"}).AddRow(source, target))
+}
+
+func expectAtTarget(mock sqlmock.Sqlmock, target int64, rows *sqlmock.Rows) {
+	mock.ExpectQuery("SELECT signing_root, signer FROM signed_attestations").WithArgs(pubKey[:], target).WillReturnRows(rows)
+}
+
+func noRows() *sqlmock.Rows {
+	return sqlmock.NewRows([]string{"a", "b"})
+}
+
+func TestStore_SlashableAttestationCheck(t *testing.T) {
+	ctx := context.Background()
+	root := [32]byte{'a'}
+
+	t.Run("saves attestation", func(t *testing.T) {
+		s, mock := setupStore(t)
+		expectLock(mock)
+		expectLowestEpochs(mock, 1, 2)
+		expectAtTarget(mock, 4, noRows())
+		mock.ExpectQuery("source_epoch > \\$2 AND target_epoch < \\$3").WithArgs(pubKey[:], 3, 4).WillReturnRows(noRows())
+		mock.ExpectQuery("source_epoch < \\$2 AND target_epoch > \\$3").WithArgs(pubKey[:], 3, 4).WillReturnRows(noRows())
+		mock.ExpectExec("INSERT INTO signed_attestations").WithArgs(pubKey[:], 3, 4, root[:], s.signer).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		kind, err := s.SlashableAttestationCheck(ctx, pubKey, root, att(3, 4))
+		require.NoError(t, err)
+		assert.Equal(t, kv.NotSlashable, kind)
+	})
+	t.Run("double vote", func(t *testing.T) {
+		s, mock := setupStore(t)
+		expectLock(mock)
+		expectLowestEpochs(mock, 1, 2)
+		expectAtTarget(mock, 4, sqlmock.NewRows([]string{"signing_root", "signer"}).AddRow([]byte{'b'}, s.signer))
+		mock.ExpectRollback()
+
+		kind, err := s.SlashableAttestationCheck(ctx, pubKey, root, att(3, 4))
+		require.ErrorContains(t, "double vote found", err)
+		assert.Equal(t, kv.DoubleVote, kind)
+	})
+	t.Run("surrounding vote", func(t *testing.T) {
+		s, mock := setupStore(t)
+		expectLock(mock)
+		expectLowestEpochs(mock, 1, 2)
+		expectAtTarget(mock, 6, noRows())
+		mock.ExpectQuery("source_epoch > \\$2 AND target_epoch < \\$3").WithArgs(pubKey[:], 1, 6).
+			WillReturnRows(sqlmock.NewRows([]string{"source_epoch", "target_epoch"}).AddRow(2, 3))
+		mock.ExpectRollback()
+
+		kind, err := s.SlashableAttestationCheck(ctx, pubKey, root, att(1, 6))
+		require.ErrorContains(t, "surrounds another with (source 2, target 3)", err)
+		assert.Equal(t, kv.SurroundingVote, kind)
+	})
+	t.Run("surrounded vote", func(t *testing.T) {
+		s, mock := setupStore(t)
+		expectLock(mock)
+		expectLowestEpochs(mock, 1, 2)
+		expectAtTarget(mock, 5, noRows())
+		mock.ExpectQuery("source_epoch > \\$2 AND target_epoch < \\$3").WithArgs(pubKey[:], 3, 5).WillReturnRows(noRows())
+		mock.ExpectQuery("source_epoch < \\$2 AND target_epoch > \\$3").WithArgs(pubKey[:], 3, 5).
+			WillReturnRows(sqlmock.NewRows([]string{"source_epoch", "target_epoch"}).AddRow(2, 6))
+		mock.ExpectRollback()
+
+		kind, err := s.SlashableAttestationCheck(ctx, pubKey, root, att(3, 5))
+		require.ErrorContains(t, "is surrounded by another with (source 2, target 6)", err)
+		assert.Equal(t, kv.SurroundedVote, kind)
+	})
+	t.Run("lower than lowest source", func(t *testing.T) {
+		s, mock := setupStore(t)
+		expectLock(mock)
+		expectLowestEpochs(mock, 3, 4)
+		mock.ExpectRollback()
+
+		_, err := s.SlashableAttestationCheck(ctx, pubKey, root, att(2, 5))
+		require.ErrorContains(t, "could not sign attestation lower than lowest source epoch", err)
+	})
+	t.Run("same attestation signed again", func(t *testing.T) {
+		s, mock := setupStore(t)
+		expectLock(mock)
+		expectLowestEpochs(mock, 3, 4)
+		expectAtTarget(mock, 4, sqlmock.NewRows([]string{"signing_root", "signer"}).AddRow(root[:], s.signer))
+		mock.ExpectCommit()
+
+		_, err := s.SlashableAttestationCheck(ctx, pubKey, root, att(3, 4))
+		require.NoError(t, err)
+	})
+	t.Run("same attestation signed by another client", func(t *testing.T) {
+		s, mock := setupStore(t)
+		expectLock(mock)
+		expectLowestEpochs(mock, 3, 4)
+		expectAtTarget(mock, 4, sqlmock.NewRows([]string{"signing_root", "signer"}).AddRow(root[:], "other"))
+		mock.ExpectRollback()
+
+		_, err := s.SlashableAttestationCheck(ctx, pubKey, root, att(3, 4))
+		require.ErrorIs(t, err, ErrSignedByAnotherClient)
+	})
+	t.Run("database failure", func(t *testing.T) {
+		s, mock := setupStore(t)
+		mock.ExpectBegin().WillReturnError(errors.New("connection lost"))
+
+		_, err := s.SlashableAttestationCheck(ctx, pubKey, root, att(3, 4))
+		require.ErrorContains(t, "connection lost", err)
+	})
+}
+
+func TestStore_SlashableProposalCheck(t *testing.T) {
+	ctx := context.Background()
+	root := [32]byte{'a'}
+	expectAtSlot := func(mock sqlmock.Sqlmock, slot int64, rows *sqlmock.Rows) {
+		mock.ExpectQuery("SELECT signing_root, signer FROM signed_blocks").WithArgs(pubKey[:], slot).WillReturnRows(rows)
+	}
+	expectLowestSlot := func(mock sqlmock.Sqlmock, slot driver.Value) {
+		mock.ExpectQuery("SELECT MIN\\(slot\\)").WithArgs(pubKey[:]).WillReturnRows(sqlmock.NewRows([]string{"min"}).AddRow(slot))
+	}
+
+	t.Run("saves proposal", func(t *testing.T) {
+		s, mock := setupStore(t)
+		expectLock(mock)
+		expectAtSlot(mock, 10, noRows())
+		expectLowestSlot(mock, nil)
+		mock.ExpectExec("INSERT INTO signed_blocks").WithArgs(pubKey[:], 10, root[:], s.signer).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		require.NoError(t, s.SlashableProposalCheck(ctx, pubKey, 10, root))
+	})
+	t.Run("double proposal", func(t *testing.T) {
+		s, mock := setupStore(t)
+		expectLock(mock)
+		expectAtSlot(mock, 10, sqlmock.NewRows([]string{"signing_root", "signer"}).AddRow([]byte{'b'}, s.signer))
+		expectLowestSlot(mock, 10)
+		mock.ExpectRollback()
+
+		require.ErrorIs(t, s.SlashableProposalCheck(ctx, pubKey, 10, root), kv.ErrDoubleProposal)
+	})
+	t.Run("lower than lowest slot", func(t *testing.T) {
+		s, mock := setupStore(t)
+		expectLock(mock)
+		expectAtSlot(mock, 9, noRows())
+		expectLowestSlot(mock, 10)
+		mock.ExpectRollback()
+
+		err := s.SlashableProposalCheck(ctx, pubKey, 9, root)
+		require.ErrorContains(t, "could not sign block with slot <= lowest signed slot", err)
+	})
+	t.Run("same proposal signed by another client", func(t *testing.T) {
+		s, mock := setupStore(t)
+		expectLock(mock)
+		expectAtSlot(mock, 10, sqlmock.NewRows([]string{"signing_root", "signer"}).AddRow(root[:], "other"))
+		expectLowestSlot(mock, 10)
+		mock.ExpectRollback()
+
+		require.ErrorIs(t, s.SlashableProposalCheck(ctx, pubKey, 10, root), ErrSignedByAnotherClient)
+	})
+}
@@ -3,6 +3,7 @@ package internal
 import (
 	"bytes"
 	"context"
+	"crypto/tls"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -42,37 +43,88 @@ type HttpSignerClient interface {
 
 // ApiClient a wrapper object around web3signer APIs. Please refer to the docs from Consensys' web3signer project.
 type ApiClient struct {
-	BaseURL    *url.URL
-	RestClient *http.Client
+	BaseURL *url.URL
+	// FallbackURLs are the web3signers tried in order when the ones before them cannot serve a request.
+	FallbackURLs []*url.URL
+	RestClient   *http.Client
+	health       endpointHealth
+}
+
+// Option configures an ApiClient.
+type Option func(client *ApiClient) error
+
+// WithFallbackEndpoints sets the web3signers to fail over to, in order, when the base endpoint is unavailable.
+func WithFallbackEndpoints(endpoints ...string) Option {
+	return func(client *ApiClient) error {
+		for _, endpoint := range endpoints {
+			u, err := parseEndpoint(endpoint)
+			if err != nil {
+				return err
+			}
+			client.FallbackURLs = append(client.FallbackURLs, u)
+		}
+		return nil
+	}
+}
+
+// WithTLSConfig sets the TLS configuration used to connect to web3signers, such as the client
+// certificate and the certificate authorities of mutual TLS.
+func WithTLSConfig(cfg *tls.Config) Option {
+	return func(client *ApiClient) error {
+		client.RestClient.Transport = &http.Transport{
+			Proxy:           http.ProxyFromEnvironment,
+			TLSClientConfig: cfg,
+		}
+		return nil
+	}
 }
 
 // NewApiClient method instantiates a new ApiClient object.
-func NewApiClient(baseEndpoint string) (*ApiClient, error) {
-	u, err := url.ParseRequestURI(baseEndpoint)
+func NewApiClient(baseEndpoint string, opts ...Option) (*ApiClient, error) {
+	u, err := parseEndpoint(baseEndpoint)
+	if err != nil {
+		return nil, err
+	}
+	client := &ApiClient{
+		BaseURL:    u,
+		RestClient: &http.Client{},
+	}
+	for _, opt := range opts {
+		if err := opt(client); err != nil {
+			return nil, err
+		}
+	}
+	for _, endpoint := range client.endpoints() {
+		endpointUp.WithLabelValues(endpoint.Redacted()).Set(1)
+	}
+	return client, nil
+}
+
+func parseEndpoint(endpoint string) (*url.URL, error) {
+	u, err := url.ParseRequestURI(endpoint)
 	if err != nil {
 		return nil, errors.Wrap(err, "invalid format, unable to parse url")
 	}
 	if u.Scheme == "" || u.Host == "" {
-		return nil, fmt.Errorf("web3signer url must be in the format of http(s)://host:port url used: %v", baseEndpoint)
+		return nil, fmt.Errorf("web3signer url must be in the format of http(s)://host:port url used: %v", endpoint)
 	}
-	return &ApiClient{
-		BaseURL:    u,
-		RestClient: &http.Client{},
-	}, nil
+	return u, nil
 }
 
 // Sign is a wrapper method around the web3signer sign api.
 func (client *ApiClient) Sign(ctx context.Context, pubKey string, request SignRequestJson) (bls.Signature, error) {
 	requestPath := ethApiNamespace + pubKey
-	resp, err := client.doRequest(ctx, http.MethodPost, client.BaseURL.String()+requestPath, bytes.NewBuffer(request))
+	resp, endpoint, err := client.doRequestWithFailover(ctx, http.MethodPost, requestPath, request)
 	if err != nil {
 		return nil, err
 	}
 	if resp.StatusCode == http.StatusNotFound {
+		closeBody(resp.Body)
 		return nil, fmt.Errorf("public key not found")
 	}
 	if resp.StatusCode == http.StatusPreconditionFailed {
-		return nil, fmt.Errorf("signing operation failed due to slashing protection rules,  Signing Request URL: %v, Status: %v", client.BaseURL.String()+requestPath, resp.StatusCode)
+		closeBody(resp.Body)
+		return nil, fmt.Errorf("signing operation failed due to slashing protection rules,  Signing Request URL: %v, Status: %v", endpoint+requestPath, resp.StatusCode)
 	}
 	contentType := resp.Header.Get("Content-Type")
 	if strings.HasPrefix(contentType, "application/json") {
@@ -112,11 +164,13 @@ func (client *ApiClient) GetPublicKeys(ctx context.Context, url string) ([][fiel
 	return decodedKeys, nil
 }
 
-// ReloadSignerKeys is a wrapper method around the web3signer reload api.
+// ReloadSignerKeys is a wrapper method around the web3signer reload api, called on every web3signer.
 func (client *ApiClient) ReloadSignerKeys(ctx context.Context) error {
 	const requestPath = "/reload"
-	if _, err := client.doRequest(ctx, http.MethodPost, client.BaseURL.String()+requestPath, nil); err != nil {
-		return err
+	for _, u := range client.endpoints() {
+		if _, err := client.doRequest(ctx, http.MethodPost, u.String()+requestPath, nil); err != nil {
+			return err
+		}
 	}
 	return nil
 }
@@ -124,7 +178,7 @@ func (client *ApiClient) ReloadSignerKeys(ctx context.Context) error {
 // GetServerStatus is a wrapper method around the web3signer upcheck api
 func (client *ApiClient) GetServerStatus(ctx context.Context) (string, error) {
 	const requestPath = "/upcheck"
-	resp, err := client.doRequest(ctx, http.MethodGet, client.BaseURL.String()+requestPath, nil /* no body needed on get request */)
+	resp, _, err := client.doRequestWithFailover(ctx, http.MethodGet, requestPath, nil /* no body needed on get request */)
 	if err != nil {
 		return "", err
 	}
@@ -135,6 +189,42 @@ func (client *ApiClient) GetServerStatus(ctx context.Context) (string, error) {
 	return status, nil
 }
 
+// doRequestWithFailover sends a request to the web3signers in order until one of them is able to
+// serve it. Web3signers which recently failed are only tried once the others failed as well.
+// It returns the response and the endpoint which served it.
+func (client *ApiClient) doRequestWithFailover(ctx context.Context, httpMethod, requestPath string, body []byte) (*http.Response, string, error) {
+	var err error
+	for i, u := range client.health.order(client.endpoints()) {
+		endpoint := u.String()
+		if i > 0 {
+			log.WithError(err).WithField("endpoint", u.Redacted()).Warn("Failing over to another web3signer")
+		}
+		var reqBody io.Reader
+		if body != nil {
+			reqBody = bytes.NewReader(body)
+		}
+		var resp *http.Response
+		resp, err = client.doRequest(ctx, httpMethod, endpoint+requestPath, reqBody)
+		var unavailable *unavailableError
+		if !errors.As(err, &unavailable) {
+			if err == nil {
+				client.health.up(u)
+			}
+			return resp, endpoint, err
+		}
+		client.health.down(u)
+		if ctx.Err() != nil {
+			break
+		}
+	}
+	return nil, "", err
+}
+
+// endpoints returns the base URL followed by the fallback URLs.
+func (client *ApiClient) endpoints() []*url.URL {
+	return append([]*url.URL{client.BaseURL}, client.FallbackURLs...)
+}
+
 // doRequest is a utility method for requests.
 func (client *ApiClient) doRequest(ctx context.Context, httpMethod, fullPath string, body io.Reader) (*http.Response, error) {
 	var requestDump []byte
@@ -158,11 +248,17 @@ func (client *ApiClient) doRequest(ctx context.Context, httpMethod, fullPath str
 		signRequestDurationSeconds.WithLabelValues(req.Method, "error").Observe(duration.Seconds())
 		err = errors.Wrap(err, "failed to execute json request")
 		tracing.AnnotateError(span, err)
-		return resp, err
+		return resp, &unavailableError{err: err}
 	} else {
 		signRequestDurationSeconds.WithLabelValues(req.Method, strconv.Itoa(resp.StatusCode)).Observe(duration.Seconds())
 	}
 	if resp.StatusCode != http.StatusOK {
+		// The body of the request was consumed when sending it.
+		if req.GetBody != nil {
+			if req.Body, err = req.GetBody(); err != nil {
+				return nil, err
+			}
+		}
 		requestDump, err = httputil.DumpRequestOut(req, true)
 		if err != nil {
 			return nil, err
@@ -177,10 +273,11 @@ func (client *ApiClient) doRequest(ctx context.Context, httpMethod, fullPath str
 			"response": string(responseDump),
 		}).Error("web3signer request failed")
 	}
-	if resp.StatusCode == http.StatusInternalServerError {
+	if resp.StatusCode >= http.StatusInternalServerError {
+		closeBody(resp.Body)
 		err = fmt.Errorf("internal Web3Signer server error, Signing Request URL: %v Status: %v", fullPath, resp.StatusCode)
 		tracing.AnnotateError(span, err)
-		return nil, err
+		return nil, &unavailableError{err: err}
 	} else if resp.StatusCode == http.StatusBadRequest {
 		err = fmt.Errorf("bad request format, Signing Request URL: %v Status: %v", fullPath, resp.StatusCode)
 		tracing.AnnotateError(span, err)
@@ -3,14 +3,27 @@ package internal_test
 import (
 	"bytes"
 	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
 	"encoding/json"
+	"encoding/pem"
 	"fmt"
 	"io"
+	"math/big"
 	"net/http"
+	"net/http/httptest"
 	"net/url"
+	"os"
+	"path/filepath"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/prysmaticlabs/prysm/v4/network"
 	"github.com/prysmaticlabs/prysm/v4/testing/require"
 	"github.com/prysmaticlabs/prysm/v4/validator/keymanager/remote-web3signer/internal"
 	"github.com/stretchr/testify/assert"
@@ -201,3 +214,116 @@ func TestClient_GetServerStatus_HappyPath(t *testing.T) {
 	assert.NotNil(t, resp)
 	assert.Nil(t, err)
 }
+
+const testSignature = "0xb3baa751d0a9132cfe93e4e3d5ff9075111100e3789dca219ade5a24d27e19d16b3353149da1833e9b691bb38634e8dc04469be7032132906c927d7e1a49b414730612877bc6b2810c8f202daf793d1ab0d6b5cb21d52f9e52e883859887a5d9"
+
+func TestClient_Sign_Failover(t *testing.T) {
+	var down, up, missing atomic.Int32
+	downSrv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		down.Add(1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer downSrv.Close()
+	missingSrv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		missing.Add(1)
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer missingSrv.Close()
+	upSrv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		up.Add(1)
+		_, err := w.Write([]byte(testSignature))
+		require.NoError(t, err)
+	}))
+	defer upSrv.Close()
+
+	cl, err := internal.NewApiClient(downSrv.URL, internal.WithFallbackEndpoints(upSrv.URL))
+	require.NoError(t, err)
+	sig, err := cl.Sign(context.Background(), "0xa2b5", []byte("{}"))
+	require.NoError(t, err)
+	assert.Equal(t, testSignature, fmt.Sprintf("%#x", sig.Marshal()))
+	assert.Equal(t, int32(1), down.Load())
+	assert.Equal(t, int32(1), up.Load())
+
+	// The failed web3signer is not tried first again until its backoff expires.
+	_, err = cl.Sign(context.Background(), "0xa2b5", []byte("{}"))
+	require.NoError(t, err)
+	assert.Equal(t, int32(1), down.Load())
+	assert.Equal(t, int32(2), up.Load())
+
+	// Client errors are returned rather than failing over.
+	cl, err = internal.NewApiClient(missingSrv.URL, internal.WithFallbackEndpoints(upSrv.URL))
+	require.NoError(t, err)
+	_, err = cl.Sign(context.Background(), "0xa2b5", []byte("{}"))
+	require.ErrorContains(t, "public key not found", err)
+	assert.Equal(t, int32(1), missing.Load())
+	assert.Equal(t, int32(2), up.Load())
+
+	// When every web3signer fails, the last error is returned.
+	cl, err = internal.NewApiClient(downSrv.URL, internal.WithFallbackEndpoints(downSrv.URL+"/"))
+	require.NoError(t, err)
+	_, err = cl.Sign(context.Background(), "0xa2b5", []byte("{}"))
+	require.ErrorContains(t, "Status: 503", err)
+	assert.Equal(t, int32(3), down.Load())
+}
+
+func TestClient_MutualTLS(t *testing.T) {
+	dir := t.TempDir()
+	clientCert, clientKey := writeSelfSignedCert(t, dir)
+	clientCA := x509.NewCertPool()
+	clientCA.AddCert(clientCert)
+
+	srv := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, err := w.Write([]byte(`"OK"`))
+		require.NoError(t, err)
+	}))
+	srv.TLS = &tls.Config{ClientAuth: tls.RequireAndVerifyClientCert, ClientCAs: clientCA, MinVersion: tls.VersionTLS12}
+	srv.StartTLS()
+	defer srv.Close()
+	caPath := filepath.Join(dir, "ca.crt")
+	require.NoError(t, os.WriteFile(caPath, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: srv.Certificate().Raw}), 0600))
+
+	// Without a client certificate, the web3signer refuses the connection.
+	tlsConfig, err := network.NewTLSConfig(caPath, "", "")
+	require.NoError(t, err)
+	cl, err := internal.NewApiClient(srv.URL, internal.WithTLSConfig(tlsConfig))
+	require.NoError(t, err)
+	_, err = cl.GetServerStatus(context.Background())
+	require.ErrorContains(t, "failed to execute json request", err)
+
+	tlsConfig, err = network.NewTLSConfig(caPath, filepath.Join(dir, "client.crt"), clientKey)
+	require.NoError(t, err)
+	cl, err = internal.NewApiClient(srv.URL, internal.WithTLSConfig(tlsConfig))
+	require.NoError(t, err)
+	status, err := cl.GetServerStatus(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "OK", status)
+
+	_, err = network.NewTLSConfig(caPath, filepath.Join(dir, "client.crt"), "")
+	require.ErrorContains(t, "both a client certificate and a client key are needed", err)
+}
+
+// writeSelfSignedCert writes a self-signed client certificate and its key to dir, returning the
+// certificate and the path of the key.
+func writeSelfSignedCert(t *testing.T, dir string) (*x509.Certificate, string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "client.crt"), pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0600))
+	keyPath := filepath.Join(dir, "client.key")
+	require.NoError(t, os.WriteFile(keyPath, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer}), 0600))
+	return cert, keyPath
+}
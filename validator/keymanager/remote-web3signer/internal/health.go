@@ -0,0 +1,69 @@
+package internal
+
+import (
+	"net/url"
+	"sync"
+	"time"
+)
+
+// endpointBackoff is how long a web3signer which failed to serve a request is only tried after the others.
+const endpointBackoff = 30 * time.Second
+
+// unavailableError is returned when a web3signer could not be reached or failed with a server
+// error, in which case the request is sent to the next web3signer.
+type unavailableError struct {
+	err error
+}
+
+func (e *unavailableError) Error() string {
+	return e.err.Error()
+}
+
+func (e *unavailableError) Unwrap() error {
+	return e.err
+}
+
+// endpointHealth tracks the web3signers which recently failed to serve a request.
+type endpointHealth struct {
+	sync.Mutex
+	downUntil map[string]time.Time
+}
+
+// order returns the endpoints which are up, in their configured order, followed by the ones
+// which recently failed, so that a request is still attempted when every web3signer failed.
+func (h *endpointHealth) order(endpoints []*url.URL) []*url.URL {
+	h.Lock()
+	defer h.Unlock()
+	now := time.Now()
+	ordered := make([]*url.URL, 0, len(endpoints))
+	var down []*url.URL
+	for _, u := range endpoints {
+		if now.Before(h.downUntil[u.String()]) {
+			down = append(down, u)
+			continue
+		}
+		ordered = append(ordered, u)
+	}
+	return append(ordered, down...)
+}
+
+func (h *endpointHealth) up(u *url.URL) {
+	h.Lock()
+	defer h.Unlock()
+	if _, ok := h.downUntil[u.String()]; ok {
+		delete(h.downUntil, u.String())
+		log.WithField("endpoint", u.Redacted()).Info("Web3signer is available again")
+	}
+	endpointUp.WithLabelValues(u.Redacted()).Set(1)
+}
+
+func (h *endpointHealth) down(u *url.URL) {
+	h.Lock()
+	defer h.Unlock()
+	if h.downUntil == nil {
+		h.downUntil = make(map[string]time.Time)
+	}
+	h.downUntil[u.String()] = time.Now().Add(endpointBackoff)
+	endpointUp.WithLabelValues(u.Redacted()).Set(0)
+	endpointErrorsTotal.WithLabelValues(u.Redacted()).Inc()
+}
@@ -14,4 +14,18 @@ var (
 		},
 		[]string{"method", "status_code"},
 	)
+	endpointUp = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "remote_web3signer_internal_client_endpoint_up",
+			Help: "Whether a web3signer endpoint served its last request (1) or failed to (0)",
+		},
+		[]string{"endpoint"},
+	)
+	endpointErrorsTotal = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "remote_web3signer_internal_client_endpoint_errors_total",
+			Help: "Total number of requests a web3signer endpoint could not serve, causing a failover",
+		},
+		[]string{"endpoint"},
+	)
 )
@@ -6,6 +6,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"path/filepath"
+	"sync"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/go-playground/validator/v10"
@@ -15,6 +17,7 @@ import (
 	fieldparams "github.com/prysmaticlabs/prysm/v4/config/fieldparams"
 	"github.com/prysmaticlabs/prysm/v4/crypto/bls"
 	"github.com/prysmaticlabs/prysm/v4/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/v4/network"
 	ethpbservice "github.com/prysmaticlabs/prysm/v4/proto/eth/service"
 	validatorpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1/validator-client"
 	"github.com/prysmaticlabs/prysm/v4/validator/accounts/petnames"
@@ -31,11 +34,25 @@ type SetupConfig struct {
 	BaseEndpoint          string
 	GenesisValidatorsRoot []byte
 
+	// FallbackEndpoints are web3signers, sharing the keys of the base endpoint, which are tried in
+	// order when the base endpoint is unavailable.
+	FallbackEndpoints []string
+
+	// CACertPath is the certificate authority used to verify the certificates of web3signers.
+	// ClientCertPath and ClientKeyPath are the certificate presented to them for mutual TLS.
+	CACertPath     string
+	ClientCertPath string
+	ClientKeyPath  string
+
 	// Either URL or keylist must be set.
 	// If the URL is set, the keymanager will fetch the public keys from the URL.
 	// caution: this option is susceptible to slashing if the web3signer's validator keys are shared across validators
 	PublicKeysURL string
 
+	// PublicKeysURLPollInterval is how often the public keys are fetched again from PublicKeysURL,
+	// so that keys added or removed on the web3signer are used without a restart. Zero disables polling.
+	PublicKeysURLPollInterval time.Duration
+
 	// Either URL or keylist must be set.
 	// a static list of public keys to be passed by the user to determine what accounts should sign.
 	// This will provide a layer of safety against slashing if the web3signer is shared across validators.
@@ -51,18 +68,28 @@ type Keymanager struct {
 	accountsChangedFeed   *event.Feed
 	validator             *validator.Validate
 	publicKeysUrlCalled   bool
+	lock                  sync.RWMutex
 }
 
-// NewKeymanager instantiates a new web3signer key manager.
-func NewKeymanager(_ context.Context, cfg *SetupConfig) (*Keymanager, error) {
+// NewKeymanager instantiates a new web3signer key manager. If a poll interval is configured, the
+// public keys URL is polled until the context is done.
+func NewKeymanager(ctx context.Context, cfg *SetupConfig) (*Keymanager, error) {
 	if cfg.BaseEndpoint == "" || !bytesutil.IsValidRoot(cfg.GenesisValidatorsRoot) {
 		return nil, fmt.Errorf("invalid setup config, one or more configs are empty: BaseEndpoint: %v, GenesisValidatorsRoot: %#x", cfg.BaseEndpoint, cfg.GenesisValidatorsRoot)
 	}
-	client, err := internal.NewApiClient(cfg.BaseEndpoint)
+	opts := []internal.Option{internal.WithFallbackEndpoints(cfg.FallbackEndpoints...)}
+	if cfg.CACertPath != "" || cfg.ClientCertPath != "" || cfg.ClientKeyPath != "" {
+		tlsConfig, err := network.NewTLSConfig(cfg.CACertPath, cfg.ClientCertPath, cfg.ClientKeyPath)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not load web3signer TLS configuration")
+		}
+		opts = append(opts, internal.WithTLSConfig(tlsConfig))
+	}
+	client, err := internal.NewApiClient(cfg.BaseEndpoint, opts...)
 	if err != nil {
 		return nil, errors.Wrap(err, "could not create apiClient")
 	}
-	return &Keymanager{
+	km := &Keymanager{
 		client:                internal.HttpSignerClient(client),
 		genesisValidatorsRoot: cfg.GenesisValidatorsRoot,
 		accountsChangedFeed:   new(event.Feed),
@@ -70,13 +97,19 @@ func NewKeymanager(_ context.Context, cfg *SetupConfig) (*Keymanager, error) {
 		providedPublicKeys:    cfg.ProvidedPublicKeys,
 		validator:             validator.New(),
 		publicKeysUrlCalled:   false,
-	}, nil
+	}
+	if cfg.PublicKeysURL != "" && cfg.PublicKeysURLPollInterval > 0 {
+		go km.pollPublicKeys(ctx, cfg.PublicKeysURLPollInterval)
+	}
+	return km, nil
 }
 
 // FetchValidatingPublicKeys fetches the validating public keys
 // from the remote server or from the provided keys if there are no existing public keys set
 // or provides the existing keys in the keymanager.
 func (km *Keymanager) FetchValidatingPublicKeys(ctx context.Context) ([][fieldparams.BLSPubkeyLength]byte, error) {
+	km.lock.Lock()
+	defer km.lock.Unlock()
 	if km.publicKeysURL != "" && !km.publicKeysUrlCalled {
 		providedPublicKeys, err := km.client.GetPublicKeys(ctx, km.publicKeysURL)
 		if err != nil {
@@ -90,6 +123,69 @@ func (km *Keymanager) FetchValidatingPublicKeys(ctx context.Context) ([][fieldpa
 	return km.providedPublicKeys, nil
 }
 
+// pollPublicKeys fetches the public keys from the public keys URL at every interval until the
+// context is done.
+func (km *Keymanager) pollPublicKeys(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := km.reloadPublicKeys(ctx); err != nil {
+				log.WithError(err).Error("Could not reload public keys from web3signer public keys url")
+			}
+		}
+	}
+}
+
+// reloadPublicKeys fetches the public keys from the public keys URL and, if keys were added or
+// removed, publishes the new keys to the subscribers of account changes.
+func (km *Keymanager) reloadPublicKeys(ctx context.Context) error {
+	pubKeys, err := km.client.GetPublicKeys(ctx, km.publicKeysURL)
+	if err != nil {
+		erroredResponsesTotal.Inc()
+		return errors.Wrapf(err, "could not get public keys from remote server url: %v", km.publicKeysURL)
+	}
+	km.lock.Lock()
+	added, removed := diffPublicKeys(km.providedPublicKeys, pubKeys)
+	km.publicKeysUrlCalled = true
+	km.providedPublicKeys = pubKeys
+	km.lock.Unlock()
+	if added == 0 && removed == 0 {
+		return nil
+	}
+	log.WithFields(log.Fields{
+		"added":   added,
+		"removed": removed,
+		"total":   len(pubKeys),
+	}).Info("Reloaded public keys from web3signer public keys url")
+	km.accountsChangedFeed.Send(copyPublicKeys(pubKeys))
+	return nil
+}
+
+// diffPublicKeys returns how many keys of next are not in prev, and how many keys of prev are not in next.
+func diffPublicKeys(prev, next [][fieldparams.BLSPubkeyLength]byte) (added, removed int) {
+	prevKeys := make(map[[fieldparams.BLSPubkeyLength]byte]bool, len(prev))
+	for _, k := range prev {
+		prevKeys[k] = true
+	}
+	nextKeys := make(map[[fieldparams.BLSPubkeyLength]byte]bool, len(next))
+	for _, k := range next {
+		nextKeys[k] = true
+		if !prevKeys[k] {
+			added++
+		}
+	}
+	for k := range prevKeys {
+		if !nextKeys[k] {
+			removed++
+		}
+	}
+	return added, removed
+}
+
 // Sign signs the message by using a remote web3signer server.
 func (km *Keymanager) Sign(ctx context.Context, request *validatorpb.SignRequest) (bls.Signature, error) {
 	signRequest, err := getSignRequestJson(ctx, km.validator, request, km.genesisValidatorsRoot)
@@ -336,6 +432,7 @@ func (km *Keymanager) AddPublicKeys(ctx context.Context, pubKeys [][fieldparams.
 	if ctx == nil {
 		return nil, errors.New("context is nil")
 	}
+	km.lock.Lock()
 	importedRemoteKeysStatuses := make([]*ethpbservice.ImportedRemoteKeysStatus, len(pubKeys))
 	for i, pubKey := range pubKeys {
 		found := false
@@ -359,7 +456,9 @@ func (km *Keymanager) AddPublicKeys(ctx context.Context, pubKeys [][fieldparams.
 		}
 		log.Debug("Added pubkey to keymanager for web3signer", "pubkey", hexutil.Encode(pubKey[:]))
 	}
-	km.accountsChangedFeed.Send(km.providedPublicKeys)
+	currentKeys := copyPublicKeys(km.providedPublicKeys)
+	km.lock.Unlock()
+	km.accountsChangedFeed.Send(currentKeys)
 	return importedRemoteKeysStatuses, nil
 }
 
@@ -368,8 +467,10 @@ func (km *Keymanager) DeletePublicKeys(ctx context.Context, pubKeys [][fieldpara
 	if ctx == nil {
 		return nil, errors.New("context is nil")
 	}
+	km.lock.Lock()
 	deletedRemoteKeysStatuses := make([]*ethpbservice.DeletedRemoteKeysStatus, len(pubKeys))
 	if len(km.providedPublicKeys) == 0 {
+		km.lock.Unlock()
 		for i := range deletedRemoteKeysStatuses {
 			deletedRemoteKeysStatuses[i] = &ethpbservice.DeletedRemoteKeysStatus{
 				Status:  ethpbservice.DeletedRemoteKeysStatus_NOT_FOUND,
@@ -397,6 +498,13 @@ func (km *Keymanager) DeletePublicKeys(ctx context.Context, pubKeys [][fieldpara
 			}
 		}
 	}
-	km.accountsChangedFeed.Send(km.providedPublicKeys)
+	currentKeys := copyPublicKeys(km.providedPublicKeys)
+	km.lock.Unlock()
+	km.accountsChangedFeed.Send(currentKeys)
 	return deletedRemoteKeysStatuses, nil
 }
+
+// copyPublicKeys copies public keys before they are published, as the keymanager modifies its keys in place.
+func copyPublicKeys(pubKeys [][fieldparams.BLSPubkeyLength]byte) [][fieldparams.BLSPubkeyLength]byte {
+	return append(make([][fieldparams.BLSPubkeyLength]byte, 0, len(pubKeys)), pubKeys...)
+}
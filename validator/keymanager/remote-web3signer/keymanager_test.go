@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	fieldparams "github.com/prysmaticlabs/prysm/v4/config/fieldparams"
@@ -345,3 +346,76 @@ func TestKeymanager_DeletePublicKeys(t *testing.T) {
 		require.Equal(t, ethpbservice.DeletedRemoteKeysStatus_NOT_FOUND, status.Status)
 	}
 }
+
+func TestKeymanager_ReloadPublicKeys(t *testing.T) {
+	ctx := context.Background()
+	root, err := hexutil.Decode("0x270d43e74ce340de4bca2b1936beca0f4f5408d9e78aec4850920baf659d5b69")
+	require.NoError(t, err)
+	key1 := "0xa2b5aaad9c6efefe7bb9b1243a043404f3362937cfb6b31833929833173f476630ea2cfeb0d9ddf15f97ca8685948820"
+	key2 := "0xb89bebc699769726a318c8e9971bd3171297c61aea4a6578a7a4f94b547dcba5bac16a89108b6b6a1fe3695d1a874a0b"
+	km, err := NewKeymanager(ctx, &SetupConfig{
+		BaseEndpoint:          "http://example.com",
+		GenesisValidatorsRoot: root,
+		PublicKeysURL:         "http://example2.com/api/v1/eth2/publicKeys",
+	})
+	require.NoError(t, err)
+	client := &MockClient{PublicKeys: []string{key1}}
+	km.client = client
+	keys, err := km.FetchValidatingPublicKeys(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(keys))
+
+	keysChan := make(chan [][fieldparams.BLSPubkeyLength]byte, 1)
+	sub := km.SubscribeAccountChanges(keysChan)
+	defer sub.Unsubscribe()
+
+	// Unchanged keys are not published.
+	require.NoError(t, km.reloadPublicKeys(ctx))
+	require.Equal(t, 0, len(keysChan))
+
+	client.PublicKeys = []string{key2, key1}
+	require.NoError(t, km.reloadPublicKeys(ctx))
+	published := <-keysChan
+	require.Equal(t, 2, len(published))
+	assert.Equal(t, key2, hexutil.Encode(published[0][:]))
+	keys, err = km.FetchValidatingPublicKeys(ctx)
+	require.NoError(t, err)
+	require.DeepEqual(t, published, keys)
+
+	client.PublicKeys = []string{key2}
+	require.NoError(t, km.reloadPublicKeys(ctx))
+	published = <-keysChan
+	require.Equal(t, 1, len(published))
+	assert.Equal(t, key2, hexutil.Encode(published[0][:]))
+
+	client.isThrowingError = true
+	require.ErrorContains(t, "mock error", km.reloadPublicKeys(ctx))
+	keys, err = km.FetchValidatingPublicKeys(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(keys))
+}
+
+func TestKeymanager_PollPublicKeys(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	root, err := hexutil.Decode("0x270d43e74ce340de4bca2b1936beca0f4f5408d9e78aec4850920baf659d5b69")
+	require.NoError(t, err)
+	km, err := NewKeymanager(ctx, &SetupConfig{
+		BaseEndpoint:          "http://example.com",
+		GenesisValidatorsRoot: root,
+		PublicKeysURL:         "http://example2.com/api/v1/eth2/publicKeys",
+	})
+	require.NoError(t, err)
+	km.client = &MockClient{PublicKeys: []string{"0xa2b5aaad9c6efefe7bb9b1243a043404f3362937cfb6b31833929833173f476630ea2cfeb0d9ddf15f97ca8685948820"}}
+	keysChan := make(chan [][fieldparams.BLSPubkeyLength]byte, 1)
+	sub := km.SubscribeAccountChanges(keysChan)
+	defer sub.Unsubscribe()
+
+	go km.pollPublicKeys(ctx, 10*time.Millisecond)
+	select {
+	case keys := <-keysChan:
+		require.Equal(t, 1, len(keys))
+	case <-time.After(5 * time.Second):
+		t.Fatal("Public keys were not published")
+	}
+}
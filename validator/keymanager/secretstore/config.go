@@ -0,0 +1,93 @@
+package secretstore
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v4/network"
+)
+
+const (
+	// FileProviderName is the name of the provider reading secrets from directories.
+	FileProviderName = "file"
+	// VaultProviderName is the name of the provider reading secrets from HashiCorp Vault.
+	VaultProviderName = "vault"
+)
+
+// FileConfig is the content of the YAML or JSON configuration file of a secret store keymanager.
+type FileConfig struct {
+	// Provider is the name of the provider of secrets, either "file" or "vault".
+	Provider string `json:"provider"`
+	// RefreshInterval is how often secrets which are not leased are fetched again, such as "10m".
+	// They are only fetched once if it is empty.
+	RefreshInterval string              `json:"refresh_interval"`
+	File            *FileProviderConfig `json:"file"`
+	Vault           *VaultFileConfig    `json:"vault"`
+}
+
+// FileProviderConfig configures the provider reading secrets from directories.
+type FileProviderConfig struct {
+	KeystoresDir string `json:"keystores_dir"`
+	PasswordsDir string `json:"passwords_dir"`
+}
+
+// VaultFileConfig configures the provider reading secrets from HashiCorp Vault.
+type VaultFileConfig struct {
+	Address   string `json:"address"`
+	Mount     string `json:"mount"`
+	Path      string `json:"path"`
+	Namespace string `json:"namespace"`
+	TokenFile string `json:"token_file"`
+	// CACert, ClientCert and ClientKey are the paths of the certificates used for mutual TLS.
+	CACert     string `json:"ca_cert"`
+	ClientCert string `json:"client_cert"`
+	ClientKey  string `json:"client_key"`
+}
+
+// SetupConfigFromFile parses the configuration file of a secret store keymanager and creates its provider.
+func SetupConfigFromFile(f *FileConfig) (*SetupConfig, error) {
+	if f == nil {
+		return nil, errors.New("secret store config is nil")
+	}
+	var refreshInterval time.Duration
+	if f.RefreshInterval != "" {
+		var err error
+		if refreshInterval, err = time.ParseDuration(f.RefreshInterval); err != nil {
+			return nil, errors.Wrap(err, "could not parse refresh interval")
+		}
+	}
+	var provider Provider
+	var err error
+	switch f.Provider {
+	case FileProviderName:
+		if f.File == nil {
+			return nil, errors.New("file provider is not configured")
+		}
+		provider, err = NewFileProvider(f.File.KeystoresDir, f.File.PasswordsDir, refreshInterval)
+	case VaultProviderName:
+		if f.Vault == nil {
+			return nil, errors.New("vault provider is not configured")
+		}
+		cfg := &VaultConfig{
+			Address:         f.Vault.Address,
+			Mount:           f.Vault.Mount,
+			Path:            f.Vault.Path,
+			Namespace:       f.Vault.Namespace,
+			TokenPath:       f.Vault.TokenFile,
+			RefreshInterval: refreshInterval,
+		}
+		if f.Vault.CACert != "" || f.Vault.ClientCert != "" || f.Vault.ClientKey != "" {
+			if cfg.TLSConfig, err = network.NewTLSConfig(f.Vault.CACert, f.Vault.ClientCert, f.Vault.ClientKey); err != nil {
+				return nil, errors.Wrap(err, "could not load vault TLS configuration")
+			}
+		}
+		provider, err = NewVaultProvider(cfg)
+	default:
+		return nil, fmt.Errorf("unknown secret store provider %q, expected %q or %q", f.Provider, FileProviderName, VaultProviderName)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &SetupConfig{Provider: provider}, nil
+}
@@ -0,0 +1,72 @@
+package secretstore
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v4/validator/keymanager"
+)
+
+// FileProvider is a reference provider reading keystores and passwords from directories, such as
+// the mount point of secrets in memory. The password of a keystore named <name>.json is the
+// content of the file <name>.txt in the passwords directory.
+type FileProvider struct {
+	keystoresDir    string
+	passwordsDir    string
+	refreshInterval time.Duration
+}
+
+// NewFileProvider returns a provider reading keystores and passwords from directories. The files
+// are read again at every refresh interval, or only once if it is zero.
+func NewFileProvider(keystoresDir, passwordsDir string, refreshInterval time.Duration) (*FileProvider, error) {
+	if keystoresDir == "" || passwordsDir == "" {
+		return nil, errors.New("both a keystores directory and a passwords directory are needed")
+	}
+	return &FileProvider{
+		keystoresDir:    keystoresDir,
+		passwordsDir:    passwordsDir,
+		refreshInterval: refreshInterval,
+	}, nil
+}
+
+// Secrets reads every keystore of the keystores directory and its password.
+func (p *FileProvider) Secrets(_ context.Context) ([]*Secret, time.Duration, error) {
+	entries, err := os.ReadDir(p.keystoresDir)
+	if err != nil {
+		return nil, 0, errors.Wrap(err, "could not read keystores directory")
+	}
+	secrets := make([]*Secret, 0, len(entries))
+	for _, entry := range entries {
+		if entry.IsDir() || filepath.Ext(entry.Name()) != ".json" {
+			continue
+		}
+		keystoreFile, err := os.ReadFile(filepath.Join(p.keystoresDir, entry.Name())) // #nosec G304 -- file of the configured directory
+		if err != nil {
+			return nil, 0, errors.Wrapf(err, "could not read keystore %s", entry.Name())
+		}
+		keystore := &keymanager.Keystore{}
+		if err := json.Unmarshal(keystoreFile, keystore); err != nil {
+			return nil, 0, errors.Wrapf(err, "could not decode keystore %s", entry.Name())
+		}
+		passwordName := strings.TrimSuffix(entry.Name(), ".json") + ".txt"
+		password, err := os.ReadFile(filepath.Join(p.passwordsDir, passwordName)) // #nosec G304 -- file of the configured directory
+		if err != nil {
+			return nil, 0, errors.Wrapf(err, "could not read password of keystore %s", entry.Name())
+		}
+		secrets = append(secrets, &Secret{
+			Keystore: keystore,
+			Password: strings.TrimRight(string(password), "\r\n"),
+		})
+	}
+	return secrets, p.refreshInterval, nil
+}
+
+func (p *FileProvider) String() string {
+	return fmt.Sprintf("file(%s)", p.keystoresDir)
+}
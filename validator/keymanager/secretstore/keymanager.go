@@ -0,0 +1,250 @@
+// Package secretstore defines a keymanager which fetches the EIP-2335 keystores of validators and
+// their passwords from an external secret store, such as HashiCorp Vault, through a pluggable
+// provider. Keystores are only decrypted in memory and nothing is written to disk. Secrets are
+// fetched again before their lease expires, and validator keys added to or removed from the
+// secret store are published to subscribers of account changes.
+package secretstore
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/logrusorgru/aurora"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v4/async/event"
+	fieldparams "github.com/prysmaticlabs/prysm/v4/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v4/crypto/bls"
+	"github.com/prysmaticlabs/prysm/v4/encoding/bytesutil"
+	ethpbservice "github.com/prysmaticlabs/prysm/v4/proto/eth/service"
+	validatorpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1/validator-client"
+	"github.com/prysmaticlabs/prysm/v4/validator/keymanager"
+	remoteweb3signer "github.com/prysmaticlabs/prysm/v4/validator/keymanager/remote-web3signer"
+	"github.com/sirupsen/logrus"
+	keystorev4 "github.com/wealdtech/go-eth2-wallet-encryptor-keystorev4"
+)
+
+// refreshRetryInterval is how long to wait before fetching secrets again after a failure.
+const refreshRetryInterval = 10 * time.Second
+
+// SetupConfig includes configuration values for initializing a secret store keymanager.
+type SetupConfig struct {
+	Provider Provider
+	// ListenForChanges fetches the secrets again before their lease expires, and publishes the
+	// validator keys which were added or removed.
+	ListenForChanges bool
+}
+
+// Keymanager defines the secret store keymanager.
+type Keymanager struct {
+	provider            Provider
+	lock                sync.RWMutex
+	keys                map[[fieldparams.BLSPubkeyLength]byte]bls.SecretKey
+	publicKeys          [][fieldparams.BLSPubkeyLength]byte
+	accountsChangedFeed *event.Feed
+}
+
+// NewKeymanager instantiates a new secret store keymanager, fetching and decrypting the keystores
+// of the secret store.
+func NewKeymanager(ctx context.Context, cfg *SetupConfig) (*Keymanager, error) {
+	if cfg == nil || cfg.Provider == nil {
+		return nil, errors.New("secret store provider is nil")
+	}
+	km := &Keymanager{
+		provider:            cfg.Provider,
+		keys:                make(map[[fieldparams.BLSPubkeyLength]byte]bls.SecretKey),
+		accountsChangedFeed: new(event.Feed),
+	}
+	lease, err := km.loadKeys(ctx)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not load validator keys from %s", km.provider)
+	}
+	if cfg.ListenForChanges && lease > 0 {
+		go km.refreshKeys(ctx, lease)
+	}
+	return km, nil
+}
+
+// loadKeys fetches the secrets from the provider and decrypts the keystores which are not known
+// yet. Keys which were added or removed are published to the subscribers of account changes.
+// It returns the lease of the secrets.
+func (km *Keymanager) loadKeys(ctx context.Context) (time.Duration, error) {
+	secrets, lease, err := km.provider.Secrets(ctx)
+	if err != nil {
+		return 0, err
+	}
+	km.lock.RLock()
+	known := km.keys
+	km.lock.RUnlock()
+
+	decryptor := keystorev4.New()
+	keys := make(map[[fieldparams.BLSPubkeyLength]byte]bls.SecretKey, len(secrets))
+	publicKeys := make([][fieldparams.BLSPubkeyLength]byte, 0, len(secrets))
+	for _, secret := range secrets {
+		pubKey, secretKey, err := decryptSecret(decryptor, secret, known)
+		if err != nil {
+			return 0, err
+		}
+		if _, ok := keys[pubKey]; ok {
+			log.Warnf("Duplicate key in secret store will be ignored: %#x", pubKey)
+			continue
+		}
+		keys[pubKey] = secretKey
+		publicKeys = append(publicKeys, pubKey)
+	}
+
+	km.lock.Lock()
+	added, removed := 0, 0
+	for pubKey := range keys {
+		if _, ok := km.keys[pubKey]; !ok {
+			added++
+		}
+	}
+	for pubKey := range km.keys {
+		if _, ok := keys[pubKey]; !ok {
+			removed++
+		}
+	}
+	km.keys = keys
+	km.publicKeys = publicKeys
+	km.lock.Unlock()
+	validatorKeys.Set(float64(len(publicKeys)))
+	if added == 0 && removed == 0 {
+		return lease, nil
+	}
+	log.WithFields(logrus.Fields{
+		"added":       added,
+		"removed":     removed,
+		"total":       len(publicKeys),
+		"secretStore": km.provider.String(),
+	}).Info("Loaded validator keys from secret store")
+	km.accountsChangedFeed.Send(append(make([][fieldparams.BLSPubkeyLength]byte, 0, len(publicKeys)), publicKeys...))
+	return lease, nil
+}
+
+// decryptSecret decrypts the keystore of a secret, unless the keystore names its public key and
+// the key is already known.
+func decryptSecret(
+	decryptor *keystorev4.Encryptor,
+	secret *Secret,
+	known map[[fieldparams.BLSPubkeyLength]byte]bls.SecretKey,
+) ([fieldparams.BLSPubkeyLength]byte, bls.SecretKey, error) {
+	var pubKey [fieldparams.BLSPubkeyLength]byte
+	if secret.Keystore == nil {
+		return pubKey, nil, errors.New("secret has no keystore")
+	}
+	if secret.Keystore.Pubkey != "" {
+		pubKeyBytes, err := hex.DecodeString(strings.TrimPrefix(secret.Keystore.Pubkey, "0x"))
+		if err != nil {
+			return pubKey, nil, errors.Wrap(err, "could not decode pubkey from keystore")
+		}
+		pubKey = bytesutil.ToBytes48(pubKeyBytes)
+		if secretKey, ok := known[pubKey]; ok {
+			return pubKey, secretKey, nil
+		}
+	}
+	secretKeyBytes, err := decryptor.Decrypt(secret.Keystore.Crypto, secret.Password)
+	if err != nil && strings.Contains(err.Error(), keymanager.IncorrectPasswordErrMsg) {
+		return pubKey, nil, fmt.Errorf("incorrect password for key 0x%s", secret.Keystore.Pubkey)
+	}
+	if err != nil {
+		return pubKey, nil, errors.Wrap(err, "could not decrypt keystore")
+	}
+	secretKey, err := bls.SecretKeyFromBytes(secretKeyBytes)
+	if err != nil {
+		return pubKey, nil, errors.Wrap(err, "could not initialize private key from bytes")
+	}
+	derived := bytesutil.ToBytes48(secretKey.PublicKey().Marshal())
+	if secret.Keystore.Pubkey != "" && derived != pubKey {
+		return pubKey, nil, fmt.Errorf("keystore of 0x%s holds the key of %#x", secret.Keystore.Pubkey, derived)
+	}
+	return derived, secretKey, nil
+}
+
+// refreshKeys fetches the secrets again once two thirds of their lease elapsed, until the context
+// is done or the secrets are no longer leased.
+func (km *Keymanager) refreshKeys(ctx context.Context, lease time.Duration) {
+	wait := lease * 2 / 3
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(wait):
+		}
+		var err error
+		lease, err = km.loadKeys(ctx)
+		if err != nil {
+			refreshErrorsTotal.Inc()
+			log.WithError(err).WithField("secretStore", km.provider.String()).Error("Could not refresh validator keys from secret store")
+			wait = refreshRetryInterval
+			continue
+		}
+		if lease == 0 {
+			return
+		}
+		wait = lease * 2 / 3
+	}
+}
+
+// FetchValidatingPublicKeys returns the public keys of the validators of the secret store.
+func (km *Keymanager) FetchValidatingPublicKeys(_ context.Context) ([][fieldparams.BLSPubkeyLength]byte, error) {
+	km.lock.RLock()
+	defer km.lock.RUnlock()
+	return append(make([][fieldparams.BLSPubkeyLength]byte, 0, len(km.publicKeys)), km.publicKeys...), nil
+}
+
+// Sign signs a message using a validator key of the secret store.
+func (km *Keymanager) Sign(_ context.Context, req *validatorpb.SignRequest) (bls.Signature, error) {
+	if req == nil || req.PublicKey == nil {
+		return nil, errors.New("nil public key in request")
+	}
+	km.lock.RLock()
+	secretKey, ok := km.keys[bytesutil.ToBytes48(req.PublicKey)]
+	km.lock.RUnlock()
+	if !ok {
+		return nil, errors.New("no signing key found in secret store keys")
+	}
+	return secretKey.Sign(req.SigningRoot), nil
+}
+
+// SubscribeAccountChanges returns the event subscription for changes to public keys.
+func (km *Keymanager) SubscribeAccountChanges(pubKeysChan chan [][fieldparams.BLSPubkeyLength]byte) event.Subscription {
+	return km.accountsChangedFeed.Subscribe(pubKeysChan)
+}
+
+// ExtractKeystores is not supported for the secret store keymanager type, as keys must not leave memory.
+func (*Keymanager) ExtractKeystores(
+	_ context.Context, _ []bls.PublicKey, _ string,
+) ([]*keymanager.Keystore, error) {
+	return nil, errors.New("extracting keys is not supported for a secret store keymanager")
+}
+
+// DeleteKeystores is not supported for the secret store keymanager type.
+func (*Keymanager) DeleteKeystores(context.Context, [][]byte) ([]*ethpbservice.DeletedKeystoreStatus, error) {
+	return nil, errors.New("Wrong wallet type: secret-store. Only Imported or Derived wallets can delete accounts")
+}
+
+// ListKeymanagerAccounts prints the public keys of the validators of the secret store.
+func (km *Keymanager) ListKeymanagerAccounts(ctx context.Context, _ keymanager.ListKeymanagerAccountConfig) error {
+	au := aurora.NewAurora(true)
+	fmt.Printf("(keymanager kind) %s\n", au.BrightGreen("secret store").Bold())
+	fmt.Printf("(secret store) %s\n", km.provider)
+	fmt.Println(" ")
+	validatingPubKeys, err := km.FetchValidatingPublicKeys(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not fetch validating public keys")
+	}
+	if len(validatingPubKeys) == 1 {
+		fmt.Print("Showing 1 validator account\n")
+	} else if len(validatingPubKeys) == 0 {
+		fmt.Print("No accounts found\n")
+		return nil
+	} else {
+		fmt.Printf("Showing %d validator accounts\n", len(validatingPubKeys))
+	}
+	remoteweb3signer.DisplayRemotePublicKeys(validatingPubKeys)
+	return nil
+}
@@ -0,0 +1,159 @@
+package secretstore
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	fieldparams "github.com/prysmaticlabs/prysm/v4/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v4/crypto/bls"
+	validatorpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1/validator-client"
+	"github.com/prysmaticlabs/prysm/v4/testing/assert"
+	"github.com/prysmaticlabs/prysm/v4/testing/require"
+	"github.com/prysmaticlabs/prysm/v4/validator/keymanager"
+	keystorev4 "github.com/wealdtech/go-eth2-wallet-encryptor-keystorev4"
+)
+
+func createRandomKeystore(t testing.TB, password string) (bls.SecretKey, *keymanager.Keystore) {
+	encryptor := keystorev4.New()
+	id, err := uuid.NewRandom()
+	require.NoError(t, err)
+	secretKey, err := bls.RandKey()
+	require.NoError(t, err)
+	cryptoFields, err := encryptor.Encrypt(secretKey.Marshal(), password)
+	require.NoError(t, err)
+	return secretKey, &keymanager.Keystore{
+		Crypto:      cryptoFields,
+		Pubkey:      fmt.Sprintf("%x", secretKey.PublicKey().Marshal()),
+		ID:          id.String(),
+		Version:     encryptor.Version(),
+		Description: encryptor.Name(),
+	}
+}
+
+type mockProvider struct {
+	sync.Mutex
+	secrets []*Secret
+	lease   time.Duration
+}
+
+func (p *mockProvider) Secrets(_ context.Context) ([]*Secret, time.Duration, error) {
+	p.Lock()
+	defer p.Unlock()
+	return p.secrets, p.lease, nil
+}
+
+func (*mockProvider) String() string {
+	return "mock"
+}
+
+func TestKeymanager_FileProvider(t *testing.T) {
+	keystoresDir, passwordsDir := t.TempDir(), t.TempDir()
+	secretKeys := make([]bls.SecretKey, 2)
+	for i := range secretKeys {
+		var keystore *keymanager.Keystore
+		secretKeys[i], keystore = createRandomKeystore(t, fmt.Sprintf("password%d", i))
+		encoded, err := json.Marshal(keystore)
+		require.NoError(t, err)
+		require.NoError(t, os.WriteFile(filepath.Join(keystoresDir, fmt.Sprintf("keystore-%d.json", i)), encoded, 0600))
+		require.NoError(t, os.WriteFile(filepath.Join(passwordsDir, fmt.Sprintf("keystore-%d.txt", i)), []byte(fmt.Sprintf("password%d\n", i)), 0600))
+	}
+	cfg, err := SetupConfigFromFile(&FileConfig{
+		Provider: FileProviderName,
+		File:     &FileProviderConfig{KeystoresDir: keystoresDir, PasswordsDir: passwordsDir},
+	})
+	require.NoError(t, err)
+	km, err := NewKeymanager(context.Background(), cfg)
+	require.NoError(t, err)
+
+	pubKeys, err := km.FetchValidatingPublicKeys(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, 2, len(pubKeys))
+	root := []byte("signing root")
+	for _, secretKey := range secretKeys {
+		sig, err := km.Sign(context.Background(), &validatorpb.SignRequest{PublicKey: secretKey.PublicKey().Marshal(), SigningRoot: root})
+		require.NoError(t, err)
+		assert.DeepEqual(t, secretKey.Sign(root).Marshal(), sig.Marshal())
+	}
+
+	// A wrong password fails to load the keys.
+	require.NoError(t, os.WriteFile(filepath.Join(passwordsDir, "keystore-1.txt"), []byte("wrong"), 0600))
+	_, err = NewKeymanager(context.Background(), cfg)
+	require.ErrorContains(t, "incorrect password", err)
+}
+
+func TestKeymanager_RefreshKeys(t *testing.T) {
+	secretKey1, keystore1 := createRandomKeystore(t, "password1")
+	secretKey2, keystore2 := createRandomKeystore(t, "password2")
+	provider := &mockProvider{
+		secrets: []*Secret{{Keystore: keystore1, Password: "password1"}},
+		lease:   30 * time.Millisecond,
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	km, err := NewKeymanager(ctx, &SetupConfig{Provider: provider, ListenForChanges: true})
+	require.NoError(t, err)
+	keysChan := make(chan [][fieldparams.BLSPubkeyLength]byte, 1)
+	sub := km.SubscribeAccountChanges(keysChan)
+	defer sub.Unsubscribe()
+
+	// A key added to the secret store is published and used to sign.
+	provider.Lock()
+	provider.secrets = []*Secret{{Keystore: keystore1, Password: "password1"}, {Keystore: keystore2, Password: "password2"}}
+	provider.Unlock()
+	select {
+	case pubKeys := <-keysChan:
+		require.Equal(t, 2, len(pubKeys))
+	case <-time.After(10 * time.Second):
+		t.Fatal("Added key was not published")
+	}
+	_, err = km.Sign(ctx, &validatorpb.SignRequest{PublicKey: secretKey2.PublicKey().Marshal(), SigningRoot: []byte("root")})
+	require.NoError(t, err)
+
+	// A key removed from the secret store is published and no longer signs.
+	provider.Lock()
+	provider.secrets = []*Secret{{Keystore: keystore2, Password: "password2"}}
+	provider.Unlock()
+	select {
+	case pubKeys := <-keysChan:
+		require.Equal(t, 1, len(pubKeys))
+	case <-time.After(10 * time.Second):
+		t.Fatal("Removed key was not published")
+	}
+	_, err = km.Sign(ctx, &validatorpb.SignRequest{PublicKey: secretKey1.PublicKey().Marshal(), SigningRoot: []byte("root")})
+	require.ErrorContains(t, "no signing key found", err)
+}
+
+func TestDecryptSecret_MismatchedPubkey(t *testing.T) {
+	_, keystore := createRandomKeystore(t, "password")
+	other, err := bls.RandKey()
+	require.NoError(t, err)
+	keystore.Pubkey = fmt.Sprintf("%x", other.PublicKey().Marshal())
+	_, _, err = decryptSecret(keystorev4.New(), &Secret{Keystore: keystore, Password: "password"}, nil)
+	require.ErrorContains(t, "holds the key of", err)
+}
+
+func TestSetupConfigFromFile(t *testing.T) {
+	_, err := SetupConfigFromFile(&FileConfig{Provider: "disk"})
+	require.ErrorContains(t, `unknown secret store provider "disk"`, err)
+	_, err = SetupConfigFromFile(&FileConfig{Provider: VaultProviderName})
+	require.ErrorContains(t, "vault provider is not configured", err)
+	_, err = SetupConfigFromFile(&FileConfig{Provider: VaultProviderName, Vault: &VaultFileConfig{Address: "vault:8200", Path: "validators", TokenFile: "token"}})
+	require.ErrorContains(t, "vault address must be in the format of http(s)://host:port", err)
+	_, err = SetupConfigFromFile(&FileConfig{Provider: VaultProviderName, Vault: &VaultFileConfig{Address: "https://vault:8200", Path: "validators", TokenFile: "token", ClientCert: "client.crt"}})
+	require.ErrorContains(t, "both a client certificate and a client key are needed", err)
+
+	cfg, err := SetupConfigFromFile(&FileConfig{
+		Provider:        VaultProviderName,
+		RefreshInterval: "5m",
+		Vault:           &VaultFileConfig{Address: "https://vault:8200", Path: "validators/host1", TokenFile: "token"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "vault(https://vault:8200/v1/secret/data/validators/host1)", cfg.Provider.String())
+}
@@ -0,0 +1,5 @@
+package secretstore
+
+import "github.com/sirupsen/logrus"
+
+var log = logrus.WithField("prefix", "secret-store-keymanager")
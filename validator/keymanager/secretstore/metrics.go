@@ -0,0 +1,17 @@
+package secretstore
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	validatorKeys = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "secret_store_validator_keys",
+		Help: "Number of validator keys loaded from the secret store",
+	})
+	refreshErrorsTotal = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "secret_store_refresh_errors_total",
+		Help: "Total number of failures to fetch the validator keys again from the secret store",
+	})
+)
@@ -0,0 +1,23 @@
+package secretstore
+
+import (
+	"context"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/v4/validator/keymanager"
+)
+
+// Secret is an EIP-2335 keystore of a validator and the password decrypting it.
+type Secret struct {
+	Keystore *keymanager.Keystore
+	Password string
+}
+
+// Provider fetches the keystores of validators and their passwords from a secret store.
+type Provider interface {
+	// Secrets returns every keystore and password of the secret store, together with the duration
+	// of their lease, after which they are fetched again. A zero lease never expires.
+	Secrets(ctx context.Context) ([]*Secret, time.Duration, error)
+	// String describes the secret store, without any credential, for logs.
+	String() string
+}
@@ -0,0 +1,211 @@
+package secretstore
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v4/validator/keymanager"
+)
+
+// vaultRequestTimeout is how long a request to Vault may take.
+const vaultRequestTimeout = 30 * time.Second
+
+// VaultConfig configures a provider reading secrets from the KV version 2 secrets engine of a
+// HashiCorp Vault compatible server.
+type VaultConfig struct {
+	// Address is the base URL of the server, such as https://vault:8200.
+	Address string
+	// Mount is the path the KV secrets engine is mounted on, "secret" by default.
+	Mount string
+	// Path is the path under which every validator is a secret, with a "keystore" field holding
+	// the EIP-2335 keystore and a "password" field holding its password.
+	Path string
+	// Namespace is the Vault Enterprise namespace of the secrets, if any.
+	Namespace string
+	// TokenPath is the file holding the Vault token. It is read at every refresh, so that the
+	// token can be renewed by an agent.
+	TokenPath string
+	// TLSConfig is used to connect to the server, if set.
+	TLSConfig *tls.Config
+	// RefreshInterval is how often the secrets are fetched again when Vault does not lease them.
+	RefreshInterval time.Duration
+}
+
+// errVaultPathNotFound is returned when the secrets path is not found after secrets were read from it.
+var errVaultPathNotFound = errors.New("vault secrets path not found")
+
+// VaultProvider reads keystores and passwords from the KV version 2 secrets engine of a HashiCorp
+// Vault compatible server.
+type VaultProvider struct {
+	cfg    *VaultConfig
+	base   *url.URL
+	client *http.Client
+	// listed is set once secrets were listed under the path. Secrets are not fetched concurrently.
+	listed bool
+}
+
+type vaultListResponse struct {
+	Data struct {
+		Keys []string `json:"keys"`
+	} `json:"data"`
+}
+
+type vaultReadResponse struct {
+	LeaseDuration int64 `json:"lease_duration"`
+	Data          struct {
+		Data struct {
+			Keystore json.RawMessage `json:"keystore"`
+			Password string          `json:"password"`
+		} `json:"data"`
+	} `json:"data"`
+}
+
+// NewVaultProvider returns a provider reading secrets from a HashiCorp Vault compatible server.
+func NewVaultProvider(cfg *VaultConfig) (*VaultProvider, error) {
+	if cfg == nil {
+		return nil, errors.New("vault config is nil")
+	}
+	base, err := url.ParseRequestURI(cfg.Address)
+	if err != nil || (base.Scheme != "http" && base.Scheme != "https") {
+		return nil, fmt.Errorf("vault address must be in the format of http(s)://host:port, got %s", cfg.Address)
+	}
+	if cfg.TokenPath == "" {
+		return nil, errors.New("a vault token file is needed")
+	}
+	if cfg.Path == "" {
+		return nil, errors.New("a vault secrets path is needed")
+	}
+	if cfg.Mount == "" {
+		cfg.Mount = "secret"
+	}
+	client := &http.Client{Timeout: vaultRequestTimeout}
+	if cfg.TLSConfig != nil {
+		client.Transport = &http.Transport{
+			Proxy:           http.ProxyFromEnvironment,
+			TLSClientConfig: cfg.TLSConfig,
+		}
+	}
+	return &VaultProvider{cfg: cfg, base: base, client: client}, nil
+}
+
+// Secrets lists the secrets under the configured path and reads each of them. The lease of the
+// secrets is the shortest lease given by Vault, or the refresh interval if none is. A path without
+// secrets is an error once secrets were listed under it.
+func (p *VaultProvider) Secrets(ctx context.Context) ([]*Secret, time.Duration, error) {
+	token, err := os.ReadFile(p.cfg.TokenPath) // #nosec G304 -- path is provided by the user
+	if err != nil {
+		return nil, 0, errors.Wrap(err, "could not read vault token")
+	}
+	tokenStr := strings.TrimSpace(string(token))
+	path := strings.Trim(p.cfg.Path, "/")
+
+	var list vaultListResponse
+	found, err := p.get(ctx, tokenStr, "metadata/"+path+"?list=true", &list)
+	if err != nil {
+		return nil, 0, errors.Wrap(err, "could not list vault secrets")
+	}
+	if !found {
+		// Vault responds with a not found status when there are no secrets under a path, which is
+		// only accepted for the initial load. Later on, it more likely comes from a misconfigured
+		// or replaced server and is an error, so that the keys loaded before are kept.
+		if p.listed {
+			return nil, 0, errors.Wrap(errVaultPathNotFound, path)
+		}
+		return nil, p.cfg.RefreshInterval, nil
+	}
+	p.listed = true
+	lease := time.Duration(0)
+	secrets := make([]*Secret, 0, len(list.Data.Keys))
+	for _, key := range list.Data.Keys {
+		if strings.HasSuffix(key, "/") {
+			continue
+		}
+		var resp vaultReadResponse
+		found, err := p.get(ctx, tokenStr, "data/"+path+"/"+url.PathEscape(key), &resp)
+		if err != nil {
+			return nil, 0, errors.Wrapf(err, "could not read vault secret %s", key)
+		}
+		if !found {
+			// The secret was deleted since it was listed.
+			continue
+		}
+		keystore, err := decodeVaultKeystore(resp.Data.Data.Keystore)
+		if err != nil {
+			return nil, 0, errors.Wrapf(err, "could not decode keystore of vault secret %s", key)
+		}
+		secrets = append(secrets, &Secret{Keystore: keystore, Password: resp.Data.Data.Password})
+		if d := time.Duration(resp.LeaseDuration) * time.Second; d > 0 && (lease == 0 || d < lease) {
+			lease = d
+		}
+	}
+	if lease == 0 {
+		lease = p.cfg.RefreshInterval
+	}
+	return secrets, lease, nil
+}
+
+// get sends a GET request to the KV secrets engine and decodes its response. It returns false if
+// the secret is not found.
+func (p *VaultProvider) get(ctx context.Context, token, path string, v interface{}) (bool, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, p.base.String()+"/v1/"+strings.Trim(p.cfg.Mount, "/")+"/"+path, nil)
+	if err != nil {
+		return false, err
+	}
+	req.Header.Set("X-Vault-Token", token)
+	if p.cfg.Namespace != "" {
+		req.Header.Set("X-Vault-Namespace", p.cfg.Namespace)
+	}
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return false, err
+	}
+	defer func() {
+		if err := resp.Body.Close(); err != nil {
+			log.WithError(err).Error("Could not close response body")
+		}
+	}()
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		return false, nil
+	default:
+		body, err := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		if err != nil {
+			return false, err
+		}
+		return false, fmt.Errorf("vault responded with status code %d: %s", resp.StatusCode, strings.TrimSpace(string(body)))
+	}
+	if err := json.NewDecoder(resp.Body).Decode(v); err != nil {
+		return false, errors.Wrap(err, "could not decode vault response")
+	}
+	return true, nil
+}
+
+// decodeVaultKeystore decodes a keystore stored either as a JSON object or as a string holding it.
+func decodeVaultKeystore(raw json.RawMessage) (*keymanager.Keystore, error) {
+	if len(raw) == 0 {
+		return nil, errors.New("secret has no keystore field")
+	}
+	var encoded string
+	if err := json.Unmarshal(raw, &encoded); err == nil {
+		raw = json.RawMessage(encoded)
+	}
+	keystore := &keymanager.Keystore{}
+	if err := json.Unmarshal(raw, keystore); err != nil {
+		return nil, err
+	}
+	return keystore, nil
+}
+
+func (p *VaultProvider) String() string {
+	return fmt.Sprintf("vault(%s/v1/%s/data/%s)", p.base.Redacted(), strings.Trim(p.cfg.Mount, "/"), strings.Trim(p.cfg.Path, "/"))
+}
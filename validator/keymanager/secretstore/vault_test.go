@@ -0,0 +1,97 @@
+package secretstore
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/v4/testing/assert"
+	"github.com/prysmaticlabs/prysm/v4/testing/require"
+)
+
+func TestVaultProvider_Secrets(t *testing.T) {
+	_, keystore1 := createRandomKeystore(t, "password1")
+	_, keystore2 := createRandomKeystore(t, "password2")
+	encoded2, err := json.Marshal(keystore2)
+	require.NoError(t, err)
+	secrets := map[string]interface{}{
+		// Keystores can be stored as JSON objects or as strings.
+		"validator-1": map[string]interface{}{"lease_duration": 3600, "data": map[string]interface{}{"data": map[string]interface{}{"keystore": keystore1, "password": "password1"}}},
+		"validator-2": map[string]interface{}{"lease_duration": 600, "data": map[string]interface{}{"data": map[string]interface{}{"keystore": string(encoded2), "password": "password2"}}},
+	}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Vault-Token") != "s.token" || r.Header.Get("X-Vault-Namespace") != "staking" {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		var resp interface{}
+		switch r.URL.Path {
+		case "/v1/kv/metadata/validators/host1":
+			require.Equal(t, "true", r.URL.Query().Get("list"))
+			resp = map[string]interface{}{"data": map[string]interface{}{"keys": []string{"validator-1", "validator-2", "nested/"}}}
+		case "/v1/kv/data/validators/host1/validator-1":
+			resp = secrets["validator-1"]
+		case "/v1/kv/data/validators/host1/validator-2":
+			resp = secrets["validator-2"]
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		require.NoError(t, json.NewEncoder(w).Encode(resp))
+	}))
+	defer srv.Close()
+
+	tokenPath := filepath.Join(t.TempDir(), "token")
+	require.NoError(t, os.WriteFile(tokenPath, []byte("s.token\n"), 0600))
+	cfg := &VaultConfig{
+		Address:         srv.URL,
+		Mount:           "kv",
+		Path:            "/validators/host1/",
+		Namespace:       "staking",
+		TokenPath:       tokenPath,
+		RefreshInterval: time.Minute,
+	}
+	p, err := NewVaultProvider(cfg)
+	require.NoError(t, err)
+	got, lease, err := p.Secrets(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, 2, len(got))
+	assert.Equal(t, 10*time.Minute, lease)
+	assert.Equal(t, keystore1.Pubkey, got[0].Keystore.Pubkey)
+	assert.Equal(t, "password1", got[0].Password)
+	assert.Equal(t, keystore2.Pubkey, got[1].Keystore.Pubkey)
+	assert.Equal(t, "password2", got[1].Password)
+
+	// The keymanager decrypts the keystores of Vault.
+	km, err := NewKeymanager(context.Background(), &SetupConfig{Provider: p})
+	require.NoError(t, err)
+	pubKeys, err := km.FetchValidatingPublicKeys(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 2, len(pubKeys))
+
+	// Once secrets were loaded, a path without secrets is an error and the keys are kept.
+	cfg.Path = "validators/host2"
+	_, err = km.loadKeys(context.Background())
+	require.ErrorIs(t, err, errVaultPathNotFound)
+	pubKeys, err = km.FetchValidatingPublicKeys(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 2, len(pubKeys))
+
+	// A path without secrets is leased for the refresh interval on the initial load.
+	empty, err := NewVaultProvider(cfg)
+	require.NoError(t, err)
+	got, lease, err = empty.Secrets(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(got))
+	assert.Equal(t, time.Minute, lease)
+
+	// A rejected token fails.
+	require.NoError(t, os.WriteFile(tokenPath, []byte("s.expired"), 0600))
+	_, _, err = p.Secrets(context.Background())
+	require.ErrorContains(t, "vault responded with status code 403", err)
+}
@@ -0,0 +1,233 @@
+package threshold
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+	fieldparams "github.com/prysmaticlabs/prysm/v4/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v4/crypto/bls"
+	"github.com/prysmaticlabs/prysm/v4/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/v4/validator/keymanager"
+	keystorev4 "github.com/wealdtech/go-eth2-wallet-encryptor-keystorev4"
+)
+
+// DefaultSignTimeout is how long a signature waits for the partial signatures of peers by default.
+const DefaultSignTimeout = 2 * time.Second
+
+// minAuthSecretLength is the minimum length in bytes of the secret shared by peers.
+const minAuthSecretLength = 32
+
+// FileConfig is the content of the YAML or JSON configuration file of a threshold signer.
+// Every participant holds a share of each validator key, identified by its share index, and
+// runs a validator client with the same list of keys. The shares are stored in EIP-2335
+// keystores sharing one password. Hex values must be quoted in YAML files.
+type FileConfig struct {
+	// Threshold is the number of partial signatures needed to produce a signature.
+	Threshold uint64 `json:"threshold"`
+	// ShareIndex is the index of the shares held by this participant, starting at 1.
+	ShareIndex uint64 `json:"share_index"`
+	// ListenAddress is the address on which partial signatures are served to peers.
+	ListenAddress string `json:"listen_address"`
+	// AuthSecret is the hex encoded secret, shared by all participants, authenticating peers.
+	AuthSecret string `json:"auth_secret"`
+	// KeystorePasswordFile is the file holding the password of the keystores of the shares.
+	KeystorePasswordFile string `json:"keystore_password_file"`
+	// Timeout is how long a signature waits for the partial signatures of peers, such as "2s".
+	Timeout string        `json:"timeout"`
+	Peers   []*PeerConfig `json:"peers"`
+	Keys    []*KeyConfig  `json:"keys"`
+}
+
+// PeerConfig is another participant of a threshold signer.
+type PeerConfig struct {
+	ShareIndex uint64 `json:"share_index"`
+	URL        string `json:"url"`
+}
+
+// KeyConfig is a validator key of a threshold signer.
+type KeyConfig struct {
+	// PublicKey is the hex encoded public key of the validator.
+	PublicKey string `json:"public_key"`
+	// Keystore is the path to the EIP-2335 keystore of the share of the validator secret key held
+	// by this participant.
+	Keystore string `json:"keystore"`
+	// SharePublicKeys are the hex encoded public keys of the shares of every participant, ordered
+	// by share index, used to verify partial signatures.
+	SharePublicKeys []string `json:"share_public_keys"`
+}
+
+// SetupConfig includes configuration values for initializing a threshold keymanager.
+type SetupConfig struct {
+	Threshold     uint64
+	ShareIndex    uint64
+	ListenAddress string
+	AuthSecret    []byte
+	Timeout       time.Duration
+	// Peers maps the share index of every other participant to the base URL it listens on.
+	Peers map[uint64]string
+	Keys  []*KeyShare
+}
+
+// KeyShare is the share of a validator key held by this participant.
+type KeyShare struct {
+	PublicKey   bls.PublicKey
+	SecretShare bls.SecretKey
+	// SharePublicKeys maps share indices to the public keys of the shares of every participant.
+	SharePublicKeys map[uint64]bls.PublicKey
+}
+
+// SetupConfigFromFile parses and checks the configuration file of a threshold signer.
+func SetupConfigFromFile(f *FileConfig) (*SetupConfig, error) {
+	if f == nil {
+		return nil, errors.New("threshold signer config is nil")
+	}
+	n := uint64(len(f.Peers)) + 1
+	if f.Threshold < 2 || f.Threshold > n {
+		return nil, fmt.Errorf("threshold %d must be between 2 and the number of participants %d", f.Threshold, n)
+	}
+	if f.ShareIndex == 0 || f.ShareIndex > n {
+		return nil, fmt.Errorf("share index %d must be between 1 and the number of participants %d", f.ShareIndex, n)
+	}
+	secret, err := hexutil.Decode(f.AuthSecret)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not decode auth secret")
+	}
+	if len(secret) < minAuthSecretLength {
+		return nil, fmt.Errorf("auth secret must be at least %d bytes", minAuthSecretLength)
+	}
+	cfg := &SetupConfig{
+		Threshold:     f.Threshold,
+		ShareIndex:    f.ShareIndex,
+		ListenAddress: f.ListenAddress,
+		AuthSecret:    secret,
+		Timeout:       DefaultSignTimeout,
+		Peers:         make(map[uint64]string, len(f.Peers)),
+	}
+	if f.Timeout != "" {
+		if cfg.Timeout, err = time.ParseDuration(f.Timeout); err != nil {
+			return nil, errors.Wrap(err, "could not parse timeout")
+		}
+	}
+	for _, p := range f.Peers {
+		if p.ShareIndex == 0 || p.ShareIndex > n || p.ShareIndex == f.ShareIndex {
+			return nil, fmt.Errorf("peer share index %d is invalid", p.ShareIndex)
+		}
+		if _, ok := cfg.Peers[p.ShareIndex]; ok {
+			return nil, fmt.Errorf("duplicate peer share index %d", p.ShareIndex)
+		}
+		u, err := url.ParseRequestURI(p.URL)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") {
+			return nil, fmt.Errorf("peer url must be in the format of http(s)://host:port, got %s", p.URL)
+		}
+		cfg.Peers[p.ShareIndex] = p.URL
+	}
+	var password string
+	if len(f.Keys) > 0 {
+		if f.KeystorePasswordFile == "" {
+			return nil, errors.New("a keystore password file is needed")
+		}
+		enc, err := os.ReadFile(f.KeystorePasswordFile) // #nosec G304 -- path is provided by the user
+		if err != nil {
+			return nil, errors.Wrap(err, "could not read keystore password file")
+		}
+		password = strings.TrimRight(string(enc), "\r\n")
+	}
+	decryptor := keystorev4.New()
+	for _, k := range f.Keys {
+		key, err := keyShareFromConfig(decryptor, k, password, f.Threshold, n, f.ShareIndex)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid key %s", k.PublicKey)
+		}
+		cfg.Keys = append(cfg.Keys, key)
+	}
+	return cfg, nil
+}
+
+func keyShareFromConfig(decryptor *keystorev4.Encryptor, k *KeyConfig, password string, threshold, n, shareIndex uint64) (*KeyShare, error) {
+	pubKeyBytes, err := hexutil.Decode(k.PublicKey)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not decode public key")
+	}
+	pubKey, err := bls.PublicKeyFromBytes(pubKeyBytes)
+	if err != nil {
+		return nil, err
+	}
+	secret, err := decryptShare(decryptor, k.Keystore, password)
+	if err != nil {
+		return nil, err
+	}
+	if uint64(len(k.SharePublicKeys)) != n {
+		return nil, fmt.Errorf("got %d share public keys for %d participants", len(k.SharePublicKeys), n)
+	}
+	key := &KeyShare{
+		PublicKey:       pubKey,
+		SecretShare:     secret,
+		SharePublicKeys: make(map[uint64]bls.PublicKey, n),
+	}
+	indices := make([]uint64, 0, threshold)
+	shares := make([]bls.PublicKey, 0, threshold)
+	for i, s := range k.SharePublicKeys {
+		b, err := hexutil.Decode(s)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not decode share public key")
+		}
+		sharePubKey, err := bls.PublicKeyFromBytes(b)
+		if err != nil {
+			return nil, err
+		}
+		index := uint64(i) + 1
+		key.SharePublicKeys[index] = sharePubKey
+		if index <= threshold {
+			indices = append(indices, index)
+			shares = append(shares, sharePubKey)
+		}
+	}
+	if !key.SharePublicKeys[shareIndex].Equals(secret.PublicKey()) {
+		return nil, errors.New("secret share does not match its share public key")
+	}
+	recovered, err := bls.RecoverPublicKey(shares, indices)
+	if err != nil {
+		return nil, err
+	}
+	if !recovered.Equals(pubKey) {
+		return nil, errors.New("share public keys do not combine into the public key")
+	}
+	return key, nil
+}
+
+// decryptShare reads the EIP-2335 keystore at the given path and decrypts the secret share it holds.
+func decryptShare(decryptor *keystorev4.Encryptor, path, password string) (bls.SecretKey, error) {
+	if path == "" {
+		return nil, errors.New("a keystore is needed")
+	}
+	enc, err := os.ReadFile(path) // #nosec G304 -- path is provided by the user
+	if err != nil {
+		return nil, errors.Wrap(err, "could not read keystore")
+	}
+	keystore := &keymanager.Keystore{}
+	if err := json.Unmarshal(enc, keystore); err != nil {
+		return nil, errors.Wrapf(err, "could not decode keystore %s", path)
+	}
+	secretBytes, err := decryptor.Decrypt(keystore.Crypto, password)
+	if err != nil && strings.Contains(err.Error(), keymanager.IncorrectPasswordErrMsg) {
+		return nil, fmt.Errorf("incorrect password for keystore %s", path)
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not decrypt keystore %s", path)
+	}
+	secret, err := bls.SecretKeyFromBytes(secretBytes)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not initialize secret share from bytes")
+	}
+	return secret, nil
+}
+
+func (k *KeyShare) publicKeyBytes() [fieldparams.BLSPubkeyLength]byte {
+	return bytesutil.ToBytes48(k.PublicKey.Marshal())
+}
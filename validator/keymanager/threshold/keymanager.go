@@ -0,0 +1,216 @@
+// Package threshold defines a keymanager which holds a Shamir share of each validator key rather
+// than the key itself. A validator runs on several validator clients, each holding a different
+// share, and a signature is only produced once a threshold of them have signed the same signing
+// root, so that no single machine can sign on behalf of the validator.
+package threshold
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/logrusorgru/aurora"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v4/async/event"
+	fieldparams "github.com/prysmaticlabs/prysm/v4/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v4/crypto/bls"
+	"github.com/prysmaticlabs/prysm/v4/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/v4/network"
+	ethpbservice "github.com/prysmaticlabs/prysm/v4/proto/eth/service"
+	validatorpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1/validator-client"
+	"github.com/prysmaticlabs/prysm/v4/validator/keymanager"
+	remoteweb3signer "github.com/prysmaticlabs/prysm/v4/validator/keymanager/remote-web3signer"
+	"github.com/sirupsen/logrus"
+)
+
+// Keymanager defines the threshold keymanager.
+type Keymanager struct {
+	threshold           uint64
+	shareIndex          uint64
+	timeout             time.Duration
+	authSecret          []byte
+	peers               map[uint64]string
+	keys                map[[fieldparams.BLSPubkeyLength]byte]*KeyShare
+	publicKeys          [][fieldparams.BLSPubkeyLength]byte
+	partials            *partialSignatures
+	client              *http.Client
+	accountsChangedFeed *event.Feed
+}
+
+// NewKeymanager instantiates a new threshold keymanager. If a listen address is configured,
+// partial signatures are served to peers on it until the context is done.
+func NewKeymanager(ctx context.Context, cfg *SetupConfig) (*Keymanager, error) {
+	if cfg == nil {
+		return nil, errors.New("threshold signer config is nil")
+	}
+	if cfg.Threshold == 0 || cfg.Threshold > uint64(len(cfg.Peers))+1 {
+		return nil, fmt.Errorf("threshold %d cannot be reached with %d peers", cfg.Threshold, len(cfg.Peers))
+	}
+	km := &Keymanager{
+		threshold:           cfg.Threshold,
+		shareIndex:          cfg.ShareIndex,
+		timeout:             cfg.Timeout,
+		authSecret:          cfg.AuthSecret,
+		peers:               cfg.Peers,
+		keys:                make(map[[fieldparams.BLSPubkeyLength]byte]*KeyShare, len(cfg.Keys)),
+		publicKeys:          make([][fieldparams.BLSPubkeyLength]byte, 0, len(cfg.Keys)),
+		partials:            newPartialSignatures(),
+		client:              network.NewHttpClientWithSecret(string(cfg.AuthSecret)),
+		accountsChangedFeed: new(event.Feed),
+	}
+	if km.timeout == 0 {
+		km.timeout = DefaultSignTimeout
+	}
+	for _, key := range cfg.Keys {
+		pubKey := key.publicKeyBytes()
+		if _, ok := km.keys[pubKey]; ok {
+			return nil, fmt.Errorf("duplicate public key %#x", pubKey)
+		}
+		km.keys[pubKey] = key
+		km.publicKeys = append(km.publicKeys, pubKey)
+	}
+	if cfg.ListenAddress != "" {
+		if err := km.serve(ctx, cfg.ListenAddress); err != nil {
+			return nil, err
+		}
+	}
+	return km, nil
+}
+
+func (km *Keymanager) serve(ctx context.Context, address string) error {
+	listener, err := net.Listen("tcp", address)
+	if err != nil {
+		return errors.Wrapf(err, "could not listen on %s", address)
+	}
+	srv := &http.Server{
+		Handler:           km,
+		ReadHeaderTimeout: time.Second,
+	}
+	go func() {
+		if err := srv.Serve(listener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			log.WithError(err).Error("Partial signature server stopped")
+		}
+	}()
+	go func() {
+		<-ctx.Done()
+		if err := srv.Close(); err != nil {
+			log.WithError(err).Error("Could not stop partial signature server")
+		}
+	}()
+	log.WithFields(logrus.Fields{
+		"address":    listener.Addr().String(),
+		"shareIndex": km.shareIndex,
+		"threshold":  km.threshold,
+		"peers":      len(km.peers),
+	}).Info("Serving partial signatures to threshold signer peers")
+	return nil
+}
+
+// FetchValidatingPublicKeys returns the public keys of the validators this participant holds a share of.
+func (km *Keymanager) FetchValidatingPublicKeys(_ context.Context) ([][fieldparams.BLSPubkeyLength]byte, error) {
+	return km.publicKeys, nil
+}
+
+// Sign signs the signing root of the request with the share of this participant, then waits
+// for peers to produce their partial signatures of the same root and combines a threshold of
+// them into the signature of the validator.
+func (km *Keymanager) Sign(ctx context.Context, req *validatorpb.SignRequest) (bls.Signature, error) {
+	if req == nil {
+		return nil, errors.New("nil sign request provided")
+	}
+	pubKey := bytesutil.ToBytes48(req.PublicKey)
+	key, ok := km.keys[pubKey]
+	if !ok {
+		return nil, errors.New("no signing key found")
+	}
+	if len(req.SigningRoot) != 32 {
+		return nil, fmt.Errorf("signing root must be 32 bytes, got %d", len(req.SigningRoot))
+	}
+	root := bytesutil.ToBytes32(req.SigningRoot)
+	signRequestsTotal.Inc()
+	start := time.Now()
+
+	partial := key.SecretShare.Sign(root[:])
+	km.partials.add(pubKey, root, partial.Marshal())
+	indices := []uint64{km.shareIndex}
+	sigs := []bls.Signature{partial}
+
+	ctx, cancel := context.WithTimeout(ctx, km.timeout)
+	defer cancel()
+	type result struct {
+		shareIndex uint64
+		sig        bls.Signature
+	}
+	results := make(chan result, len(km.peers))
+	for shareIndex := range km.peers {
+		go func(shareIndex uint64) {
+			sig, err := km.fetchPartialSignature(ctx, shareIndex, key, root)
+			if err != nil && ctx.Err() == nil {
+				log.WithError(err).WithField("shareIndex", shareIndex).Warn("Could not get partial signature from peer")
+			}
+			results <- result{shareIndex: shareIndex, sig: sig}
+		}(shareIndex)
+	}
+	for received := 0; uint64(len(sigs)) < km.threshold && received < len(km.peers); received++ {
+		r := <-results
+		if r.sig == nil {
+			continue
+		}
+		indices = append(indices, r.shareIndex)
+		sigs = append(sigs, r.sig)
+	}
+	if uint64(len(sigs)) < km.threshold {
+		failedSignaturesTotal.Inc()
+		return nil, fmt.Errorf("got %d of the %d partial signatures needed for signing root %#x", len(sigs), km.threshold, root)
+	}
+	sig, err := bls.RecoverSignature(sigs, indices)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not combine partial signatures")
+	}
+	if !sig.Verify(key.PublicKey, root[:]) {
+		return nil, errors.New("combined signature does not verify")
+	}
+	signatureLatency.Observe(float64(time.Since(start).Milliseconds()))
+	return sig, nil
+}
+
+// SubscribeAccountChanges returns the event subscription for changes to public keys.
+func (km *Keymanager) SubscribeAccountChanges(pubKeysChan chan [][fieldparams.BLSPubkeyLength]byte) event.Subscription {
+	return km.accountsChangedFeed.Subscribe(pubKeysChan)
+}
+
+// ExtractKeystores is not supported for the threshold keymanager type.
+func (*Keymanager) ExtractKeystores(
+	_ context.Context, _ []bls.PublicKey, _ string,
+) ([]*keymanager.Keystore, error) {
+	return nil, errors.New("extracting keys is not supported for a threshold keymanager")
+}
+
+// DeleteKeystores is not supported for the threshold keymanager type.
+func (*Keymanager) DeleteKeystores(context.Context, [][]byte) ([]*ethpbservice.DeletedKeystoreStatus, error) {
+	return nil, errors.New("Wrong wallet type: threshold. Only Imported or Derived wallets can delete accounts")
+}
+
+// ListKeymanagerAccounts prints the public keys of the validators this participant holds a share of.
+func (km *Keymanager) ListKeymanagerAccounts(ctx context.Context, _ keymanager.ListKeymanagerAccountConfig) error {
+	au := aurora.NewAurora(true)
+	fmt.Printf("(keymanager kind) %s\n", au.BrightGreen("threshold").Bold())
+	fmt.Printf("(share index) %d, (threshold) %d of %d\n", km.shareIndex, km.threshold, len(km.peers)+1)
+	fmt.Println(" ")
+	validatingPubKeys, err := km.FetchValidatingPublicKeys(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not fetch validating public keys")
+	}
+	if len(validatingPubKeys) == 1 {
+		fmt.Print("Showing 1 validator account\n")
+	} else if len(validatingPubKeys) == 0 {
+		fmt.Print("No accounts found\n")
+		return nil
+	} else {
+		fmt.Printf("Showing %d validator accounts\n", len(validatingPubKeys))
+	}
+	remoteweb3signer.DisplayRemotePublicKeys(validatingPubKeys)
+	return nil
+}
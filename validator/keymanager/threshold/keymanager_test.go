@@ -0,0 +1,209 @@
+package threshold
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/prysmaticlabs/prysm/v4/crypto/bls"
+	validatorpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1/validator-client"
+	"github.com/prysmaticlabs/prysm/v4/testing/assert"
+	"github.com/prysmaticlabs/prysm/v4/testing/require"
+	"github.com/prysmaticlabs/prysm/v4/validator/keymanager"
+	keystorev4 "github.com/wealdtech/go-eth2-wallet-encryptor-keystorev4"
+)
+
+var testAuthSecret = hexutil.Encode(make([]byte, minAuthSecretLength))
+
+const testKeystorePassword = "password"
+
+// writeShareKeystore encrypts the share in an EIP-2335 keystore written to the given directory.
+func writeShareKeystore(t *testing.T, dir string, share bls.SecretKey) string {
+	encryptor := keystorev4.New()
+	cryptoFields, err := encryptor.Encrypt(share.Marshal(), testKeystorePassword)
+	require.NoError(t, err)
+	enc, err := json.Marshal(&keymanager.Keystore{
+		Crypto:      cryptoFields,
+		Pubkey:      fmt.Sprintf("%x", share.PublicKey().Marshal()),
+		Version:     encryptor.Version(),
+		Description: encryptor.Name(),
+	})
+	require.NoError(t, err)
+	path := filepath.Join(dir, fmt.Sprintf("keystore-%x.json", share.PublicKey().Marshal()[:8]))
+	require.NoError(t, os.WriteFile(path, enc, 0600))
+	return path
+}
+
+// setupParticipants returns the configuration files of n participants holding shares of a key.
+func setupParticipants(t *testing.T, threshold, n uint64) (bls.SecretKey, []*FileConfig, []*httptest.Server, []*Keymanager) {
+	secret, err := bls.RandKey()
+	require.NoError(t, err)
+	shares, err := bls.SplitSecretKey(secret, threshold, n)
+	require.NoError(t, err)
+	dir := t.TempDir()
+	passwordFile := filepath.Join(dir, "password.txt")
+	require.NoError(t, os.WriteFile(passwordFile, []byte(testKeystorePassword+"\n"), 0600))
+	sharePubKeys := make([]string, n)
+	keystores := make([]string, n)
+	for i, share := range shares {
+		sharePubKeys[i] = hexutil.Encode(share.PublicKey().Marshal())
+		keystores[i] = writeShareKeystore(t, dir, share)
+	}
+
+	kms := make([]*Keymanager, n)
+	servers := make([]*httptest.Server, n)
+	for i := range servers {
+		i := i
+		servers[i] = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			kms[i].ServeHTTP(w, r)
+		}))
+		t.Cleanup(servers[i].Close)
+	}
+	configs := make([]*FileConfig, n)
+	for i := range configs {
+		configs[i] = &FileConfig{
+			Threshold:            threshold,
+			ShareIndex:           uint64(i) + 1,
+			AuthSecret:           testAuthSecret,
+			KeystorePasswordFile: passwordFile,
+			Timeout:              "1s",
+			Keys: []*KeyConfig{{
+				PublicKey:       hexutil.Encode(secret.PublicKey().Marshal()),
+				Keystore:        keystores[i],
+				SharePublicKeys: sharePubKeys,
+			}},
+		}
+		for j, srv := range servers {
+			if j != i {
+				configs[i].Peers = append(configs[i].Peers, &PeerConfig{ShareIndex: uint64(j) + 1, URL: srv.URL})
+			}
+		}
+		cfg, err := SetupConfigFromFile(configs[i])
+		require.NoError(t, err)
+		kms[i], err = NewKeymanager(context.Background(), cfg)
+		require.NoError(t, err)
+	}
+	return secret, configs, servers, kms
+}
+
+func TestKeymanager_Sign(t *testing.T) {
+	secret, _, _, kms := setupParticipants(t, 2, 3)
+	root := [32]byte{'r', 'o', 'o', 't'}
+	req := &validatorpb.SignRequest{PublicKey: secret.PublicKey().Marshal(), SigningRoot: root[:]}
+
+	// A single participant cannot sign on its own.
+	_, err := kms[0].Sign(context.Background(), req)
+	require.ErrorContains(t, "got 1 of the 2 partial signatures needed", err)
+
+	// Once another participant signed the same root, both get the signature of the validator.
+	sig, err := kms[2].Sign(context.Background(), req)
+	require.NoError(t, err)
+	assert.DeepEqual(t, secret.Sign(root[:]).Marshal(), sig.Marshal())
+	sig, err = kms[0].Sign(context.Background(), req)
+	require.NoError(t, err)
+	assert.DeepEqual(t, secret.Sign(root[:]).Marshal(), sig.Marshal())
+
+	// A participant which never signed another root does not contribute to it.
+	other := [32]byte{'o', 't', 'h', 'e', 'r'}
+	_, err = kms[1].Sign(context.Background(), &validatorpb.SignRequest{PublicKey: req.PublicKey, SigningRoot: other[:]})
+	require.ErrorContains(t, "got 1 of the 2 partial signatures needed", err)
+}
+
+func TestKeymanager_SignConcurrently(t *testing.T) {
+	secret, _, _, kms := setupParticipants(t, 3, 4)
+	root := [32]byte{'r', 'o', 'o', 't'}
+	req := &validatorpb.SignRequest{PublicKey: secret.PublicKey().Marshal(), SigningRoot: root[:]}
+	errs := make(chan error, len(kms))
+	for _, km := range kms[:3] {
+		go func(km *Keymanager) {
+			sig, err := km.Sign(context.Background(), req)
+			if err == nil && !sig.Verify(secret.PublicKey(), root[:]) {
+				t.Error("Signature does not verify")
+			}
+			errs <- err
+		}(km)
+	}
+	for i := 0; i < 3; i++ {
+		require.NoError(t, <-errs)
+	}
+}
+
+func TestKeymanager_ServeHTTP_RequiresAuth(t *testing.T) {
+	secret, _, servers, kms := setupParticipants(t, 2, 2)
+	root := [32]byte{1}
+	_, err := kms[0].Sign(context.Background(), &validatorpb.SignRequest{PublicKey: secret.PublicKey().Marshal(), SigningRoot: root[:]})
+	require.ErrorContains(t, "got 1 of the 2", err)
+
+	url := servers[0].URL + partialSignaturesPath + "?public_key=" + hexutil.Encode(secret.PublicKey().Marshal()) + "&signing_root=" + hexutil.Encode(root[:])
+	resp, err := http.Get(url)
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+	assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+
+	// A peer with a different secret is not trusted.
+	cfg, err := SetupConfigFromFile(&FileConfig{
+		Threshold:  2,
+		ShareIndex: 2,
+		AuthSecret: hexutil.Encode([]byte("another secret of thirty two bytes")),
+		Peers:      []*PeerConfig{{ShareIndex: 1, URL: servers[0].URL}},
+	})
+	require.NoError(t, err)
+	km, err := NewKeymanager(context.Background(), cfg)
+	require.NoError(t, err)
+	ctx, cancel := context.WithTimeout(context.Background(), 3*pollInterval)
+	defer cancel()
+	_, err = km.fetchPartialSignature(ctx, 1, kms[0].keys[kms[0].publicKeys[0]], root)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestSetupConfigFromFile(t *testing.T) {
+	_, configs, _, _ := setupParticipants(t, 2, 3)
+	cfg, err := SetupConfigFromFile(configs[1])
+	require.NoError(t, err)
+	assert.Equal(t, uint64(2), cfg.ShareIndex)
+	assert.Equal(t, time.Second, cfg.Timeout)
+	assert.Equal(t, 2, len(cfg.Peers))
+	require.Equal(t, 1, len(cfg.Keys))
+
+	// The share of another participant is refused.
+	wrongShare := *configs[1].Keys[0]
+	wrongShare.Keystore = configs[0].Keys[0].Keystore
+	f := *configs[1]
+	f.Keys = []*KeyConfig{&wrongShare}
+	_, err = SetupConfigFromFile(&f)
+	require.ErrorContains(t, "secret share does not match its share public key", err)
+
+	// Share public keys of another key are refused.
+	_, otherConfigs, _, _ := setupParticipants(t, 2, 3)
+	wrongShares := *configs[1].Keys[0]
+	wrongShares.SharePublicKeys = otherConfigs[1].Keys[0].SharePublicKeys
+	wrongShares.Keystore = otherConfigs[1].Keys[0].Keystore
+	f.Keys = []*KeyConfig{&wrongShares}
+	_, err = SetupConfigFromFile(&f)
+	require.ErrorContains(t, "share public keys do not combine into the public key", err)
+
+	// Keystores are only decrypted with their password.
+	wrongPassword := filepath.Join(t.TempDir(), "password.txt")
+	require.NoError(t, os.WriteFile(wrongPassword, []byte("wrong"), 0600))
+	f = *configs[1]
+	f.KeystorePasswordFile = wrongPassword
+	_, err = SetupConfigFromFile(&f)
+	require.ErrorContains(t, "incorrect password for keystore", err)
+
+	f = *configs[1]
+	f.Threshold = 4
+	_, err = SetupConfigFromFile(&f)
+	require.ErrorContains(t, "threshold 4 must be between 2 and the number of participants 3", err)
+
+	f = *configs[1]
+	f.AuthSecret = "0x1234"
+	_, err = SetupConfigFromFile(&f)
+	require.ErrorContains(t, "auth secret must be at least 32 bytes", err)
+}
@@ -0,0 +1,26 @@
+package threshold
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	signRequestsTotal = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "threshold_signer_sign_requests_total",
+		Help: "Total number of sign requests",
+	})
+	failedSignaturesTotal = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "threshold_signer_failed_signatures_total",
+		Help: "Total number of sign requests for which not enough peers produced a partial signature",
+	})
+	invalidPartialSignaturesTotal = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "threshold_signer_invalid_partial_signatures_total",
+		Help: "Total number of invalid partial signatures received from peers",
+	})
+	signatureLatency = promauto.NewHistogram(prometheus.HistogramOpts{
+		Name:    "threshold_signer_signature_latency_milliseconds",
+		Help:    "Time taken to collect and combine enough partial signatures",
+		Buckets: []float64{5, 10, 25, 50, 100, 250, 500, 1000, 2000, 4000},
+	})
+)
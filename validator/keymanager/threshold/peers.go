@@ -0,0 +1,209 @@
+package threshold
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/pkg/errors"
+	fieldparams "github.com/prysmaticlabs/prysm/v4/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v4/crypto/bls"
+	"github.com/prysmaticlabs/prysm/v4/encoding/bytesutil"
+)
+
+const (
+	// partialSignaturesPath is the path on which partial signatures are served to peers.
+	partialSignaturesPath = "/threshold/v1/partial_signatures"
+	// partialSignatureRetention is how long partial signatures are served to peers.
+	partialSignatureRetention = 10 * time.Minute
+	// pollInterval is how often peers are asked for a partial signature they have not produced yet.
+	pollInterval = 100 * time.Millisecond
+	// maxTokenAge is how far the issuance time of a peer token may be from the current time.
+	maxTokenAge = 5 * time.Second
+)
+
+var errNotSigned = errors.New("peer has not signed the signing root")
+
+type partialKey struct {
+	pubKey [fieldparams.BLSPubkeyLength]byte
+	root   [32]byte
+}
+
+type partialSignature struct {
+	sig      []byte
+	signedAt time.Time
+}
+
+// partialSignatures holds the partial signatures produced by this participant. Only the signing
+// roots which the validator client of this participant asked to sign are ever served to peers,
+// so that a signature requires a threshold of validator clients to agree on the same root.
+type partialSignatures struct {
+	sync.RWMutex
+	sigs map[partialKey]*partialSignature
+}
+
+func newPartialSignatures() *partialSignatures {
+	return &partialSignatures{sigs: make(map[partialKey]*partialSignature)}
+}
+
+func (p *partialSignatures) add(pubKey [fieldparams.BLSPubkeyLength]byte, root [32]byte, sig []byte) {
+	p.Lock()
+	defer p.Unlock()
+	now := time.Now()
+	for k, v := range p.sigs {
+		if now.Sub(v.signedAt) > partialSignatureRetention {
+			delete(p.sigs, k)
+		}
+	}
+	p.sigs[partialKey{pubKey: pubKey, root: root}] = &partialSignature{sig: sig, signedAt: now}
+}
+
+func (p *partialSignatures) get(pubKey [fieldparams.BLSPubkeyLength]byte, root [32]byte) ([]byte, bool) {
+	p.RLock()
+	defer p.RUnlock()
+	v, ok := p.sigs[partialKey{pubKey: pubKey, root: root}]
+	if !ok {
+		return nil, false
+	}
+	return v.sig, true
+}
+
+type partialSignatureResponse struct {
+	ShareIndex uint64 `json:"share_index"`
+	Signature  string `json:"signature"`
+}
+
+// ServeHTTP serves the partial signature of this participant for a public key and a signing root,
+// passed as the public_key and signing_root query parameters, to authenticated peers.
+func (km *Keymanager) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path != partialSignaturesPath || r.Method != http.MethodGet {
+		http.NotFound(w, r)
+		return
+	}
+	if err := km.authenticate(r); err != nil {
+		http.Error(w, err.Error(), http.StatusUnauthorized)
+		return
+	}
+	pubKey, err := hexutil.Decode(r.URL.Query().Get("public_key"))
+	if err != nil || len(pubKey) != fieldparams.BLSPubkeyLength {
+		http.Error(w, "invalid public key", http.StatusBadRequest)
+		return
+	}
+	root, err := hexutil.Decode(r.URL.Query().Get("signing_root"))
+	if err != nil || len(root) != 32 {
+		http.Error(w, "invalid signing root", http.StatusBadRequest)
+		return
+	}
+	sig, ok := km.partials.get(bytesutil.ToBytes48(pubKey), bytesutil.ToBytes32(root))
+	if !ok {
+		http.Error(w, errNotSigned.Error(), http.StatusNotFound)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(&partialSignatureResponse{
+		ShareIndex: km.shareIndex,
+		Signature:  hexutil.Encode(sig),
+	}); err != nil {
+		log.WithError(err).Error("Could not write partial signature")
+	}
+}
+
+// authenticate checks the JWT bearer token of a peer request, signed with the shared auth secret.
+func (km *Keymanager) authenticate(r *http.Request) error {
+	authHeader := r.Header.Get("Authorization")
+	if !strings.HasPrefix(authHeader, "Bearer ") {
+		return errors.New("invalid auth header, needs Bearer {token}")
+	}
+	claims := jwt.MapClaims{}
+	if _, err := jwt.ParseWithClaims(strings.TrimPrefix(authHeader, "Bearer "), claims, func(token *jwt.Token) (interface{}, error) {
+		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, fmt.Errorf("unexpected JWT signing method: %v", token.Header["alg"])
+		}
+		return km.authSecret, nil
+	}); err != nil {
+		return errors.Wrap(err, "could not parse JWT token")
+	}
+	iat, ok := claims["iat"].(float64)
+	if !ok {
+		return errors.New("JWT token has no issuance time")
+	}
+	if age := time.Since(time.Unix(int64(iat), 0)); age > maxTokenAge || age < -maxTokenAge {
+		return errors.New("JWT token is stale")
+	}
+	return nil
+}
+
+// fetchPartialSignature asks a peer for its partial signature of a signing root until the peer
+// has produced it or the context is done. Partial signatures are checked against the public key
+// of the share of the peer.
+func (km *Keymanager) fetchPartialSignature(
+	ctx context.Context, shareIndex uint64, key *KeyShare, root [32]byte,
+) (bls.Signature, error) {
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+	for {
+		sig, err := km.requestPartialSignature(ctx, shareIndex, key, root)
+		if err == nil {
+			if !sig.Verify(key.SharePublicKeys[shareIndex], root[:]) {
+				invalidPartialSignaturesTotal.Inc()
+				return nil, fmt.Errorf("invalid partial signature from peer %d", shareIndex)
+			}
+			return sig, nil
+		}
+		if !errors.Is(err, errNotSigned) {
+			log.WithError(err).WithField("shareIndex", shareIndex).Debug("Could not fetch partial signature")
+		}
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+func (km *Keymanager) requestPartialSignature(
+	ctx context.Context, shareIndex uint64, key *KeyShare, root [32]byte,
+) (bls.Signature, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, strings.TrimSuffix(km.peers[shareIndex], "/")+partialSignaturesPath, nil)
+	if err != nil {
+		return nil, err
+	}
+	q := req.URL.Query()
+	q.Set("public_key", hexutil.Encode(key.PublicKey.Marshal()))
+	q.Set("signing_root", hexutil.Encode(root[:]))
+	req.URL.RawQuery = q.Encode()
+	resp, err := km.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := resp.Body.Close(); err != nil {
+			log.WithError(err).Error("Could not close response body")
+		}
+	}()
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		return nil, errNotSigned
+	default:
+		return nil, fmt.Errorf("peer responded with status code %d", resp.StatusCode)
+	}
+	var body partialSignatureResponse
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		return nil, errors.Wrap(err, "could not decode partial signature")
+	}
+	if body.ShareIndex != shareIndex {
+		return nil, fmt.Errorf("expected share index %d, got %d", shareIndex, body.ShareIndex)
+	}
+	sig, err := hexutil.Decode(body.Signature)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not decode partial signature")
+	}
+	return bls.SignatureFromBytes(sig)
+}
@@ -103,6 +103,10 @@ const (
 	Derived
 	// Web3Signer keymanager capable of signing data using a remote signer called Web3Signer.
 	Web3Signer
+	// Threshold keymanager holding a share of each validator key, which signs together with peer validator clients.
+	Threshold
+	// SecretStore keymanager fetching encrypted keystores and their passwords from an external secret store.
+	SecretStore
 )
 
 // IncorrectPasswordErrMsg defines a common error string representing an EIP-2335
@@ -122,6 +126,10 @@ func (k Kind) String() string {
 		return "direct"
 	case Web3Signer:
 		return "web3signer"
+	case Threshold:
+		return "threshold"
+	case SecretStore:
+		return "secret-store"
 	default:
 		return fmt.Sprintf("%d", int(k))
 	}
@@ -136,6 +144,10 @@ func ParseKind(k string) (Kind, error) {
 		return Local, nil
 	case "web3signer":
 		return Web3Signer, nil
+	case "threshold":
+		return Threshold, nil
+	case "secret-store":
+		return SecretStore, nil
 	default:
 		return 0, fmt.Errorf("%s is not an allowed keymanager", k)
 	}
@@ -11,11 +11,15 @@ import (
 	"github.com/prysmaticlabs/prysm/v4/validator/keymanager/derived"
 	"github.com/prysmaticlabs/prysm/v4/validator/keymanager/local"
 	remoteweb3signer "github.com/prysmaticlabs/prysm/v4/validator/keymanager/remote-web3signer"
+	"github.com/prysmaticlabs/prysm/v4/validator/keymanager/secretstore"
+	"github.com/prysmaticlabs/prysm/v4/validator/keymanager/threshold"
 )
 
 var (
 	_ = keymanager.IKeymanager(&local.Keymanager{})
 	_ = keymanager.IKeymanager(&derived.Keymanager{})
+	_ = keymanager.IKeymanager(&threshold.Keymanager{})
+	_ = keymanager.IKeymanager(&secretstore.Keymanager{})
 
 	// More granular assertions.
 	_ = keymanager.KeysFetcher(&local.Keymanager{})
@@ -42,7 +42,6 @@ import (
 	"github.com/prysmaticlabs/prysm/v4/monitoring/backup"
 	"github.com/prysmaticlabs/prysm/v4/monitoring/prometheus"
 	tracing2 "github.com/prysmaticlabs/prysm/v4/monitoring/tracing"
-	ethpbservice "github.com/prysmaticlabs/prysm/v4/proto/eth/service"
 	pb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
 	validatorpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1/validator-client"
 	"github.com/prysmaticlabs/prysm/v4/runtime"
@@ -53,11 +52,13 @@ import (
 	"github.com/prysmaticlabs/prysm/v4/validator/client"
 	"github.com/prysmaticlabs/prysm/v4/validator/db/iface"
 	"github.com/prysmaticlabs/prysm/v4/validator/db/kv"
+	"github.com/prysmaticlabs/prysm/v4/validator/db/postgres"
 	g "github.com/prysmaticlabs/prysm/v4/validator/graffiti"
 	"github.com/prysmaticlabs/prysm/v4/validator/keymanager/local"
 	remoteweb3signer "github.com/prysmaticlabs/prysm/v4/validator/keymanager/remote-web3signer"
+	"github.com/prysmaticlabs/prysm/v4/validator/keymanager/secretstore"
+	"github.com/prysmaticlabs/prysm/v4/validator/keymanager/threshold"
 	"github.com/prysmaticlabs/prysm/v4/validator/rpc"
-	validatormiddleware "github.com/prysmaticlabs/prysm/v4/validator/rpc/apimiddleware"
 	"github.com/prysmaticlabs/prysm/v4/validator/web"
 	"github.com/sirupsen/logrus"
 	"github.com/urfave/cli/v2"
@@ -72,6 +73,7 @@ type ValidatorClient struct {
 	ctx               context.Context
 	cancel            context.CancelFunc
 	db                *kv.Store
+	protectionDB      *postgres.Store
 	services          *runtime.ServiceRegistry // Lifecycle and service store.
 	lock              sync.RWMutex
 	wallet            *wallet.Wallet
@@ -190,6 +192,11 @@ func (c *ValidatorClient) Close() {
 	defer c.lock.Unlock()
 
 	c.services.StopAll()
+	if c.protectionDB != nil {
+		if err := c.protectionDB.Close(); err != nil {
+			log.WithError(err).Error("Could not close slashing protection database")
+		}
+	}
 	log.Info("Stopping Prysm validator")
 	c.cancel()
 	close(c.stop)
@@ -202,6 +209,10 @@ func (c *ValidatorClient) initializeFromCLI(cliCtx *cli.Context) error {
 		// Custom Check For Web3Signer
 		if cliCtx.IsSet(flags.Web3SignerURLFlag.Name) {
 			c.wallet = wallet.NewWalletForWeb3Signer()
+		} else if cliCtx.IsSet(flags.ThresholdSignerConfigFlag.Name) {
+			c.wallet = wallet.NewWalletForThresholdSigner()
+		} else if cliCtx.IsSet(flags.SecretStoreConfigFlag.Name) {
+			c.wallet = wallet.NewWalletForSecretStore()
 		} else {
 			w, err := wallet.OpenWalletOrElseCli(cliCtx, func(cliCtx *cli.Context) (*wallet.Wallet, error) {
 				return nil, wallet.ErrNoWalletFound
@@ -267,10 +278,11 @@ func (c *ValidatorClient) initializeFromCLI(cliCtx *cli.Context) error {
 		return err
 	}
 	if cliCtx.Bool(flags.EnableRPCFlag.Name) {
-		if err := c.registerRPCService(cliCtx); err != nil {
+		router := mux.NewRouter()
+		if err := c.registerRPCService(cliCtx, router); err != nil {
 			return err
 		}
-		if err := c.registerRPCGatewayService(cliCtx); err != nil {
+		if err := c.registerRPCGatewayService(cliCtx, router); err != nil {
 			return err
 		}
 	}
@@ -282,6 +294,10 @@ func (c *ValidatorClient) initializeForWeb(cliCtx *cli.Context) error {
 	dataDir := cliCtx.String(flags.WalletDirFlag.Name)
 	if cliCtx.IsSet(flags.Web3SignerURLFlag.Name) {
 		c.wallet = wallet.NewWalletForWeb3Signer()
+	} else if cliCtx.IsSet(flags.ThresholdSignerConfigFlag.Name) {
+		c.wallet = wallet.NewWalletForThresholdSigner()
+	} else if cliCtx.IsSet(flags.SecretStoreConfigFlag.Name) {
+		c.wallet = wallet.NewWalletForSecretStore()
 	} else {
 		// Read the wallet password file from the cli context.
 		if err = setWalletPasswordFilePath(cliCtx); err != nil {
@@ -342,10 +358,11 @@ func (c *ValidatorClient) initializeForWeb(cliCtx *cli.Context) error {
 	if err := c.registerValidatorService(cliCtx); err != nil {
 		return err
 	}
-	if err := c.registerRPCService(cliCtx); err != nil {
+	router := mux.NewRouter()
+	if err := c.registerRPCService(cliCtx, router); err != nil {
 		return err
 	}
-	if err := c.registerRPCGatewayService(cliCtx); err != nil {
+	if err := c.registerRPCGatewayService(cliCtx, router); err != nil {
 		return err
 	}
 	gatewayHost := cliCtx.String(flags.GRPCGatewayHost.Name)
@@ -410,11 +427,30 @@ func (c *ValidatorClient) registerValidatorService(cliCtx *cli.Context) error {
 		return err
 	}
 
+	tsc, err := ThresholdSignerConfig(c.cliCtx)
+	if err != nil {
+		return err
+	}
+
+	ssc, err := SecretStoreConfig(c.cliCtx)
+	if err != nil {
+		return err
+	}
+
 	bpc, err := proposerSettings(c.cliCtx, c.db)
 	if err != nil {
 		return err
 	}
 
+	var protection iface.SlashingProtection
+	if c.cliCtx.IsSet(flags.SlashingProtectionDBURLFlag.Name) {
+		c.protectionDB, err = postgres.NewStore(c.cliCtx.Context, c.cliCtx.String(flags.SlashingProtectionDBURLFlag.Name))
+		if err != nil {
+			return errors.Wrap(err, "could not open slashing protection database")
+		}
+		protection = c.protectionDB
+	}
+
 	v, err := client.NewValidatorService(c.cliCtx.Context, &client.Config{
 		Endpoint:                   endpoint,
 		DataDir:                    dataDir,
@@ -427,12 +463,15 @@ func (c *ValidatorClient) registerValidatorService(cliCtx *cli.Context) error {
 		GrpcRetryDelay:             grpcRetryDelay,
 		GrpcHeadersFlag:            c.cliCtx.String(flags.GrpcHeadersFlag.Name),
 		ValDB:                      c.db,
+		SlashingProtection:         protection,
 		UseWeb:                     c.cliCtx.Bool(flags.EnableWebFlag.Name),
 		InteropKeysConfig:          interopKeysConfig,
 		Wallet:                     c.wallet,
 		WalletInitializedFeed:      c.walletInitialized,
 		GraffitiStruct:             gStruct,
 		Web3SignerConfig:           wsc,
+		ThresholdSignerConfig:      tsc,
+		SecretStoreConfig:          ssc,
 		ProposerSettings:           bpc,
 		BeaconApiTimeout:           time.Second * 30,
 		BeaconApiEndpoint:          c.cliCtx.String(flags.BeaconRESTApiProviderFlag.Name),
@@ -456,8 +495,27 @@ func Web3SignerConfig(cliCtx *cli.Context) (*remoteweb3signer.SetupConfig, error
 			return nil, fmt.Errorf("web3signer url must be in the format of http(s)://host:port url used: %v", urlStr)
 		}
 		web3signerConfig = &remoteweb3signer.SetupConfig{
-			BaseEndpoint:          u.String(),
-			GenesisValidatorsRoot: nil,
+			BaseEndpoint:              u.String(),
+			GenesisValidatorsRoot:     nil,
+			CACertPath:                cliCtx.String(flags.Web3SignerTLSCACertFlag.Name),
+			ClientCertPath:            cliCtx.String(flags.Web3SignerTLSClientCertFlag.Name),
+			ClientKeyPath:             cliCtx.String(flags.Web3SignerTLSClientKeyFlag.Name),
+			PublicKeysURLPollInterval: cliCtx.Duration(flags.Web3SignerPublicKeysPollIntervalFlag.Name),
+		}
+		for _, fallback := range cliCtx.StringSlice(flags.Web3SignerFallbackURLsFlag.Name) {
+			for _, fallbackStr := range strings.Split(fallback, ",") {
+				fu, err := url.ParseRequestURI(fallbackStr)
+				if err != nil {
+					return nil, errors.Wrapf(err, "web3signer fallback url %s is invalid", fallbackStr)
+				}
+				if fu.Scheme == "" || fu.Host == "" {
+					return nil, fmt.Errorf("web3signer fallback url must be in the format of http(s)://host:port url used: %v", fallbackStr)
+				}
+				web3signerConfig.FallbackEndpoints = append(web3signerConfig.FallbackEndpoints, fu.String())
+			}
+		}
+		if (web3signerConfig.ClientCertPath == "") != (web3signerConfig.ClientKeyPath == "") {
+			return nil, fmt.Errorf("%s and %s must be used together", flags.Web3SignerTLSClientCertFlag.Name, flags.Web3SignerTLSClientKeyFlag.Name)
 		}
 		if cliCtx.IsSet(flags.WalletPasswordFileFlag.Name) {
 			log.Warnf("%s was provided while using web3signer and will be ignored", flags.WalletPasswordFileFlag.Name)
@@ -492,6 +550,46 @@ func Web3SignerConfig(cliCtx *cli.Context) (*remoteweb3signer.SetupConfig, error
 	return web3signerConfig, nil
 }
 
+// ThresholdSignerConfig reads the configuration file of a threshold signer, if any.
+func ThresholdSignerConfig(cliCtx *cli.Context) (*threshold.SetupConfig, error) {
+	if !cliCtx.IsSet(flags.ThresholdSignerConfigFlag.Name) {
+		return nil, nil
+	}
+	if cliCtx.IsSet(flags.Web3SignerURLFlag.Name) {
+		return nil, fmt.Errorf("%s cannot be used with %s", flags.ThresholdSignerConfigFlag.Name, flags.Web3SignerURLFlag.Name)
+	}
+	var fileConfig threshold.FileConfig
+	if err := unmarshalFromFile(cliCtx.Context, cliCtx.String(flags.ThresholdSignerConfigFlag.Name), &fileConfig); err != nil {
+		return nil, err
+	}
+	cfg, err := threshold.SetupConfigFromFile(&fileConfig)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid threshold signer config")
+	}
+	return cfg, nil
+}
+
+// SecretStoreConfig reads the configuration file of a secret store, if any.
+func SecretStoreConfig(cliCtx *cli.Context) (*secretstore.SetupConfig, error) {
+	if !cliCtx.IsSet(flags.SecretStoreConfigFlag.Name) {
+		return nil, nil
+	}
+	for _, f := range []string{flags.Web3SignerURLFlag.Name, flags.ThresholdSignerConfigFlag.Name} {
+		if cliCtx.IsSet(f) {
+			return nil, fmt.Errorf("%s cannot be used with %s", flags.SecretStoreConfigFlag.Name, f)
+		}
+	}
+	var fileConfig secretstore.FileConfig
+	if err := unmarshalFromFile(cliCtx.Context, cliCtx.String(flags.SecretStoreConfigFlag.Name), &fileConfig); err != nil {
+		return nil, err
+	}
+	cfg, err := secretstore.SetupConfigFromFile(&fileConfig)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid secret store config")
+	}
+	return cfg, nil
+}
+
 func proposerSettings(cliCtx *cli.Context, db iface.ValidatorDB) (*validatorServiceConfig.ProposerSettings, error) {
 	var fileConfig *validatorpb.ProposerSettingsPayload
 
@@ -722,7 +820,7 @@ func reviewGasLimit(gasLimit validator.Uint64) validator.Uint64 {
 	return gasLimit
 }
 
-func (c *ValidatorClient) registerRPCService(cliCtx *cli.Context) error {
+func (c *ValidatorClient) registerRPCService(cliCtx *cli.Context, router *mux.Router) error {
 	var vs *client.ValidatorService
 	if err := c.services.FetchService(&vs); err != nil {
 		return err
@@ -762,11 +860,12 @@ func (c *ValidatorClient) registerRPCService(cliCtx *cli.Context) error {
 		ClientGrpcRetryDelay:     grpcRetryDelay,
 		ClientGrpcHeaders:        strings.Split(grpcHeaders, ","),
 		ClientWithCert:           clientCert,
+		Router:                   router,
 	})
 	return c.services.RegisterService(server)
 }
 
-func (c *ValidatorClient) registerRPCGatewayService(cliCtx *cli.Context) error {
+func (c *ValidatorClient) registerRPCGatewayService(cliCtx *cli.Context, router *mux.Router) error {
 	gatewayHost := cliCtx.String(flags.GRPCGatewayHost.Name)
 	if gatewayHost != flags.DefaultGatewayHost {
 		log.WithField("web-host", gatewayHost).Warn(
@@ -796,7 +895,6 @@ func (c *ValidatorClient) registerRPCGatewayService(cliCtx *cli.Context) error {
 		validatorpb.RegisterAccountsHandler,
 		validatorpb.RegisterBeaconHandler,
 		validatorpb.RegisterSlashingProtectionHandler,
-		ethpbservice.RegisterKeyManagementHandler,
 	}
 	gwmux := gwruntime.NewServeMux(
 		gwruntime.WithMarshalerOption(gwruntime.MIMEWildcard, &gwruntime.HTTPBodyMarshaler{
@@ -815,17 +913,13 @@ func (c *ValidatorClient) registerRPCGatewayService(cliCtx *cli.Context) error {
 		),
 		gwruntime.WithForwardResponseOption(gateway.HttpResponseModifier),
 	)
-	muxHandler := func(apiMware *apimiddleware.ApiProxyMiddleware, h http.HandlerFunc, w http.ResponseWriter, req *http.Request) {
+	muxHandler := func(_ *apimiddleware.ApiProxyMiddleware, h http.HandlerFunc, w http.ResponseWriter, req *http.Request) {
 		// The validator gateway handler requires this special logic as it serves two kinds of APIs, namely
 		// the standard validator keymanager API under the /eth namespace, and the Prysm internal
 		// validator API under the /api namespace. Finally, it also serves requests to host the validator web UI.
-		if strings.HasPrefix(req.URL.Path, "/api/eth/") {
-			req.URL.Path = strings.Replace(req.URL.Path, "/api", "", 1)
-			// If the prefix has /eth/, we handle it with the standard API gateway middleware.
-			apiMware.ServeHTTP(w, req)
-		} else if strings.HasPrefix(req.URL.Path, "/api") {
+		if strings.HasPrefix(req.URL.Path, "/api") {
 			req.URL.Path = strings.Replace(req.URL.Path, "/api", "", 1)
-			// Else, we handle with the Prysm API gateway without a middleware.
+			// The router serves both the native keymanager handlers and the Prysm API gateway.
 			h(w, req)
 		} else {
 			// Finally, we handle with the web server.
@@ -837,17 +931,16 @@ func (c *ValidatorClient) registerRPCGatewayService(cliCtx *cli.Context) error {
 	// remove "/accounts/", "/v2/" after WebUI DEPRECATED
 	pbHandler := &gateway.PbMux{
 		Registrations: registrations,
-		Patterns:      []string{"/accounts/", "/v2/", "/internal/eth/v1/"},
+		Patterns:      []string{"/accounts/", "/v2/"},
 		Mux:           gwmux,
 	}
 	opts := []gateway.Option{
-		gateway.WithRouter(mux.NewRouter()),
+		gateway.WithRouter(router),
 		gateway.WithRemoteAddr(rpcAddr),
 		gateway.WithGatewayAddr(gatewayAddress),
 		gateway.WithMaxCallRecvMsgSize(maxCallSize),
 		gateway.WithPbHandlers([]*gateway.PbMux{pbHandler}),
 		gateway.WithAllowedOrigins(allowedOrigins),
-		gateway.WithApiMiddleware(&validatormiddleware.ValidatorEndpointFactory{}),
 		gateway.WithMuxHandler(muxHandler),
 		gateway.WithTimeout(uint64(timeout)),
 	}
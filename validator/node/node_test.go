@@ -2,6 +2,7 @@ package node
 
 import (
 	"context"
+	"encoding/json"
 	"flag"
 	"fmt"
 	"net/http"
@@ -9,6 +10,7 @@ import (
 	"os"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
@@ -17,6 +19,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v4/config/params"
 	validatorserviceconfig "github.com/prysmaticlabs/prysm/v4/config/validator/service"
 	"github.com/prysmaticlabs/prysm/v4/consensus-types/validator"
+	"github.com/prysmaticlabs/prysm/v4/crypto/bls"
 	"github.com/prysmaticlabs/prysm/v4/encoding/bytesutil"
 	"github.com/prysmaticlabs/prysm/v4/testing/assert"
 	"github.com/prysmaticlabs/prysm/v4/testing/require"
@@ -27,6 +30,7 @@ import (
 	remoteweb3signer "github.com/prysmaticlabs/prysm/v4/validator/keymanager/remote-web3signer"
 	logtest "github.com/sirupsen/logrus/hooks/test"
 	"github.com/urfave/cli/v2"
+	keystorev4 "github.com/wealdtech/go-eth2-wallet-encryptor-keystorev4"
 )
 
 // Test that the sharding node can build with default flag values.
@@ -208,6 +212,129 @@ func TestWeb3SignerConfig(t *testing.T) {
 	}
 }
 
+func TestWeb3SignerConfig_FailoverAndTLS(t *testing.T) {
+	app := cli.App{}
+	set := flag.NewFlagSet("test", 0)
+	set.String(flags.Web3SignerURLFlag.Name, "", "")
+	set.String(flags.Web3SignerTLSCACertFlag.Name, "", "")
+	set.String(flags.Web3SignerTLSClientCertFlag.Name, "", "")
+	set.String(flags.Web3SignerTLSClientKeyFlag.Name, "", "")
+	set.Duration(flags.Web3SignerPublicKeysPollIntervalFlag.Name, 0, "")
+	require.NoError(t, flags.Web3SignerFallbackURLsFlag.Apply(set))
+	require.NoError(t, flags.Web3SignerPublicValidatorKeysFlag.Apply(set))
+	require.NoError(t, set.Set(flags.Web3SignerURLFlag.Name, "https://signer1:9000"))
+	require.NoError(t, set.Set(flags.Web3SignerFallbackURLsFlag.Name, "https://signer2:9000,https://signer3:9000"))
+	require.NoError(t, set.Set(flags.Web3SignerPublicValidatorKeysFlag.Name, "https://signer1:9000/api/v1/eth2/publicKeys"))
+	require.NoError(t, set.Set(flags.Web3SignerPublicKeysPollIntervalFlag.Name, "1m"))
+	require.NoError(t, set.Set(flags.Web3SignerTLSCACertFlag.Name, "/path/to/ca.crt"))
+	require.NoError(t, set.Set(flags.Web3SignerTLSClientCertFlag.Name, "/path/to/client.crt"))
+
+	// A client certificate cannot be used without its key.
+	_, err := Web3SignerConfig(cli.NewContext(&app, set, nil))
+	require.ErrorContains(t, "must be used together", err)
+
+	require.NoError(t, set.Set(flags.Web3SignerTLSClientKeyFlag.Name, "/path/to/client.key"))
+	got, err := Web3SignerConfig(cli.NewContext(&app, set, nil))
+	require.NoError(t, err)
+	require.DeepEqual(t, &remoteweb3signer.SetupConfig{
+		BaseEndpoint:              "https://signer1:9000",
+		FallbackEndpoints:         []string{"https://signer2:9000", "https://signer3:9000"},
+		CACertPath:                "/path/to/ca.crt",
+		ClientCertPath:            "/path/to/client.crt",
+		ClientKeyPath:             "/path/to/client.key",
+		PublicKeysURL:             "https://signer1:9000/api/v1/eth2/publicKeys",
+		PublicKeysURLPollInterval: time.Minute,
+	}, got)
+
+	require.NoError(t, set.Set(flags.Web3SignerFallbackURLsFlag.Name, "signer4"))
+	_, err = Web3SignerConfig(cli.NewContext(&app, set, nil))
+	require.ErrorContains(t, "web3signer fallback url signer4 is invalid", err)
+}
+
+func TestThresholdSignerConfig(t *testing.T) {
+	secret, err := bls.RandKey()
+	require.NoError(t, err)
+	shares, err := bls.SplitSecretKey(secret, 2, 3)
+	require.NoError(t, err)
+	dir := t.TempDir()
+	encryptor := keystorev4.New()
+	cryptoFields, err := encryptor.Encrypt(shares[0].Marshal(), "password")
+	require.NoError(t, err)
+	keystore, err := json.Marshal(&keymanager.Keystore{Crypto: cryptoFields, Version: encryptor.Version(), Description: encryptor.Name()})
+	require.NoError(t, err)
+	keystorePath := filepath.Join(dir, "keystore.json")
+	require.NoError(t, os.WriteFile(keystorePath, keystore, 0600))
+	passwordPath := filepath.Join(dir, "password.txt")
+	require.NoError(t, os.WriteFile(passwordPath, []byte("password"), 0600))
+	config := fmt.Sprintf(`threshold: 2
+share_index: 1
+listen_address: 127.0.0.1:0
+auth_secret: "%#x"
+keystore_password_file: %s
+timeout: 1500ms
+peers:
+  - share_index: 2
+    url: http://127.0.0.1:7601
+  - share_index: 3
+    url: http://127.0.0.1:7602
+keys:
+  - public_key: "%#x"
+    keystore: %s
+    share_public_keys: ["%#x", "%#x", "%#x"]
+`, make([]byte, 32), passwordPath, secret.PublicKey().Marshal(), keystorePath,
+		shares[0].PublicKey().Marshal(), shares[1].PublicKey().Marshal(), shares[2].PublicKey().Marshal())
+	path := filepath.Join(dir, "threshold.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(config), 0600))
+
+	app := cli.App{}
+	set := flag.NewFlagSet("test", 0)
+	set.String(flags.ThresholdSignerConfigFlag.Name, path, "")
+	require.NoError(t, set.Set(flags.ThresholdSignerConfigFlag.Name, path))
+	cfg, err := ThresholdSignerConfig(cli.NewContext(&app, set, nil))
+	require.NoError(t, err)
+	assert.Equal(t, uint64(2), cfg.Threshold)
+	assert.Equal(t, uint64(1), cfg.ShareIndex)
+	assert.Equal(t, 1500*time.Millisecond, cfg.Timeout)
+	assert.DeepEqual(t, map[uint64]string{2: "http://127.0.0.1:7601", 3: "http://127.0.0.1:7602"}, cfg.Peers)
+	require.Equal(t, 1, len(cfg.Keys))
+	assert.DeepEqual(t, secret.PublicKey().Marshal(), cfg.Keys[0].PublicKey.Marshal())
+
+	// Without the flag, no threshold signer is configured.
+	cfg, err = ThresholdSignerConfig(cli.NewContext(&app, flag.NewFlagSet("empty", 0), nil))
+	require.NoError(t, err)
+	assert.Equal(t, true, cfg == nil)
+}
+
+func TestSecretStoreConfig(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "secret-store.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(`provider: file
+refresh_interval: 5m
+file:
+  keystores_dir: `+dir+`
+  passwords_dir: `+dir+`
+`), 0600))
+
+	app := cli.App{}
+	set := flag.NewFlagSet("test", 0)
+	set.String(flags.SecretStoreConfigFlag.Name, path, "")
+	set.String(flags.Web3SignerURLFlag.Name, "", "")
+	require.NoError(t, set.Set(flags.SecretStoreConfigFlag.Name, path))
+	cfg, err := SecretStoreConfig(cli.NewContext(&app, set, nil))
+	require.NoError(t, err)
+	assert.Equal(t, "file("+dir+")", cfg.Provider.String())
+
+	// Keys cannot come from both a secret store and a web3signer.
+	require.NoError(t, set.Set(flags.Web3SignerURLFlag.Name, "http://localhost:9000"))
+	_, err = SecretStoreConfig(cli.NewContext(&app, set, nil))
+	require.ErrorContains(t, "secret-store-config cannot be used with validators-external-signer-url", err)
+
+	// Without the flag, no secret store is configured.
+	cfg, err = SecretStoreConfig(cli.NewContext(&app, flag.NewFlagSet("empty", 0), nil))
+	require.NoError(t, err)
+	assert.Equal(t, true, cfg == nil)
+}
+
 func TestProposerSettings(t *testing.T) {
 	hook := logtest.NewGlobal()
 
@@ -0,0 +1,908 @@
+package rpc
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/gorilla/mux"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/rpc/eth/shared"
+	fieldparams "github.com/prysmaticlabs/prysm/v4/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v4/config/params"
+	validatorServiceConfig "github.com/prysmaticlabs/prysm/v4/config/validator/service"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/validator"
+	"github.com/prysmaticlabs/prysm/v4/encoding/bytesutil"
+	http2 "github.com/prysmaticlabs/prysm/v4/network/http"
+	ethpbservice "github.com/prysmaticlabs/prysm/v4/proto/eth/service"
+	eth "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v4/validator/client"
+	"github.com/prysmaticlabs/prysm/v4/validator/keymanager"
+	"github.com/prysmaticlabs/prysm/v4/validator/keymanager/derived"
+	slashingprotection "github.com/prysmaticlabs/prysm/v4/validator/slashing-protection-history"
+	"github.com/prysmaticlabs/prysm/v4/validator/slashing-protection-history/format"
+	"go.opencensus.io/trace"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// ListKeystores implements the standard validator key management API.
+func (s *Server) ListKeystores(w http.ResponseWriter, r *http.Request) {
+	ctx, span := trace.StartSpan(r.Context(), "validator.keymanagerAPI.ListKeystores")
+	defer span.End()
+
+	if !s.walletInitialized {
+		http2.HandleError(w, "Prysm Wallet not initialized. Please create a new wallet.", http.StatusServiceUnavailable)
+		return
+	}
+	if s.validatorService == nil {
+		http2.HandleError(w, "Validator service not ready. Please try again once validator is ready.", http.StatusServiceUnavailable)
+		return
+	}
+	km, err := s.validatorService.Keymanager()
+	if err != nil {
+		http2.HandleError(w, "Could not get Prysm keymanager (possibly due to beacon node unavailable): "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if s.wallet.KeymanagerKind() != keymanager.Derived && s.wallet.KeymanagerKind() != keymanager.Local {
+		http2.HandleError(w, "Prysm validator keys are not stored locally with this keymanager type.", http.StatusBadRequest)
+		return
+	}
+	pubKeys, err := km.FetchValidatingPublicKeys(ctx)
+	if err != nil {
+		http2.HandleError(w, "Could not retrieve keystores: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	keystoreResponse := make([]*Keystore, len(pubKeys))
+	for i := 0; i < len(pubKeys); i++ {
+		keystoreResponse[i] = &Keystore{
+			ValidatingPubkey: hexutil.Encode(pubKeys[i][:]),
+		}
+		if s.wallet.KeymanagerKind() == keymanager.Derived {
+			keystoreResponse[i].DerivationPath = fmt.Sprintf(derived.ValidatingKeyDerivationPathTemplate, i)
+		}
+	}
+	http2.WriteJson(w, &ListKeystoresResponse{
+		Data: keystoreResponse,
+	})
+}
+
+// ImportKeystores allows for importing keystores into Prysm with their slashing protection history.
+func (s *Server) ImportKeystores(w http.ResponseWriter, r *http.Request) {
+	ctx, span := trace.StartSpan(r.Context(), "validator.keymanagerAPI.ImportKeystores")
+	defer span.End()
+
+	var req ImportKeystoresRequest
+	err := json.NewDecoder(r.Body).Decode(&req)
+	switch {
+	case err == io.EOF:
+		http2.HandleError(w, "No data submitted", http.StatusBadRequest)
+		return
+	case err != nil:
+		http2.HandleError(w, "Could not decode request body: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if !s.walletInitialized {
+		statuses := groupImportErrors(&req, "Prysm Wallet not initialized. Please create a new wallet.")
+		http2.WriteJson(w, &ImportKeystoresResponse{Data: statuses})
+		return
+	}
+	if s.validatorService == nil {
+		statuses := groupImportErrors(&req, "Validator service not ready. Please try again once validator is ready.")
+		http2.WriteJson(w, &ImportKeystoresResponse{Data: statuses})
+		return
+	}
+	km, err := s.validatorService.Keymanager()
+	if err != nil {
+		http2.HandleError(w, "Could not get keymanager (possibly due to beacon node unavailable): "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	importer, ok := km.(keymanager.Importer)
+	if !ok {
+		statuses := groupImportErrors(&req, "Keymanager kind cannot import keys")
+		http2.WriteJson(w, &ImportKeystoresResponse{Data: statuses})
+		return
+	}
+	if len(req.Keystores) == 0 {
+		http2.WriteJson(w, &ImportKeystoresResponse{Data: make([]*Status, 0)})
+		return
+	}
+	keystores := make([]*keymanager.Keystore, len(req.Keystores))
+	for i := 0; i < len(req.Keystores); i++ {
+		k := &keymanager.Keystore{}
+		err = json.Unmarshal([]byte(req.Keystores[i]), k)
+		if k.Description == "" && k.Name != "" {
+			k.Description = k.Name
+		}
+		if err != nil {
+			// we want to ignore unmarshal errors for now, proper status in importKeystore
+			k.Pubkey = "invalid format"
+		}
+		keystores[i] = k
+	}
+	if req.SlashingProtection != "" {
+		if err := slashingprotection.ImportStandardProtectionJSON(
+			ctx, s.valDB, bytes.NewBuffer([]byte(req.SlashingProtection)),
+		); err != nil {
+			statuses := groupImportErrors(&req, fmt.Sprintf("could not import slashing protection: %v", err))
+			http2.WriteJson(w, &ImportKeystoresResponse{Data: statuses})
+			return
+		}
+	}
+	if len(req.Passwords) == 0 {
+		req.Passwords = make([]string, len(req.Keystores))
+	}
+
+	// req.Passwords and req.Keystores are checked for 0 length in code above.
+	if len(req.Passwords) > len(req.Keystores) {
+		req.Passwords = req.Passwords[:len(req.Keystores)]
+	}
+	if len(req.Passwords) < len(req.Keystores) {
+		passwordList := make([]string, len(req.Keystores))
+		copy(passwordList, req.Passwords)
+		req.Passwords = passwordList
+	}
+
+	statuses, err := importer.ImportKeystores(ctx, keystores, req.Passwords)
+	if err != nil {
+		http2.HandleError(w, "Could not import keystores: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	// If any of the keys imported had a slashing protection history before, we
+	// stop marking them as deleted from our validator database.
+	resp := make([]*Status, len(statuses))
+	for i, st := range statuses {
+		resp[i] = &Status{Status: strings.ToLower(st.Status.String()), Message: st.Message}
+	}
+	http2.WriteJson(w, &ImportKeystoresResponse{Data: resp})
+}
+
+func groupImportErrors(req *ImportKeystoresRequest, errorMessage string) []*Status {
+	statuses := make([]*Status, len(req.Keystores))
+	for i := 0; i < len(req.Keystores); i++ {
+		statuses[i] = &Status{
+			Status:  strings.ToLower(ethpbservice.ImportedKeystoreStatus_ERROR.String()),
+			Message: errorMessage,
+		}
+	}
+	return statuses
+}
+
+// DeleteKeystores allows for deleting specified public keys from Prysm.
+func (s *Server) DeleteKeystores(w http.ResponseWriter, r *http.Request) {
+	ctx, span := trace.StartSpan(r.Context(), "validator.keymanagerAPI.DeleteKeystores")
+	defer span.End()
+
+	var req DeleteKeystoresRequest
+	err := json.NewDecoder(r.Body).Decode(&req)
+	switch {
+	case err == io.EOF:
+		http2.HandleError(w, "No data submitted", http.StatusBadRequest)
+		return
+	case err != nil:
+		http2.HandleError(w, "Could not decode request body: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if !s.walletInitialized {
+		statuses := groupExportErrors(&req, "Prysm Wallet not initialized. Please create a new wallet.")
+		http2.WriteJson(w, &DeleteKeystoresResponse{Data: statuses})
+		return
+	}
+	if s.validatorService == nil {
+		statuses := groupExportErrors(&req, "Validator service not ready")
+		http2.WriteJson(w, &DeleteKeystoresResponse{Data: statuses})
+		return
+	}
+	km, err := s.validatorService.Keymanager()
+	if err != nil {
+		http2.HandleError(w, "Could not get keymanager (possibly due to beacon node unavailable): "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if len(req.Pubkeys) == 0 {
+		http2.WriteJson(w, &DeleteKeystoresResponse{Data: make([]*Status, 0)})
+		return
+	}
+	pubkeys := make([][]byte, len(req.Pubkeys))
+	for i, pk := range req.Pubkeys {
+		pubkeys[i], err = hexutil.Decode(pk)
+		if err != nil {
+			http2.HandleError(w, fmt.Sprintf("Pubkey at index %d is not a valid hex string: %v", i, err), http.StatusBadRequest)
+			return
+		}
+	}
+	statuses, err := km.DeleteKeystores(ctx, pubkeys)
+	if err != nil {
+		http2.HandleError(w, "Could not delete keys: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	statuses, err = s.transformDeletedKeysStatuses(ctx, pubkeys, statuses)
+	if err != nil {
+		http2.HandleError(w, "Could not transform deleted keys statuses: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	exportedHistory, err := s.slashingProtectionHistoryForDeletedKeys(ctx, pubkeys, statuses)
+	if err != nil {
+		log.WithError(err).Warn("Could not get slashing protection history for deleted keys")
+		statuses := groupExportErrors(&req, "Non duplicate keys that were existing were deleted, but could not export slashing protection history.")
+		http2.WriteJson(w, &DeleteKeystoresResponse{Data: statuses})
+		return
+	}
+	jsonHist, err := json.Marshal(exportedHistory)
+	if err != nil {
+		http2.HandleError(w, "Could not JSON marshal slashing protection history: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	resp := make([]*Status, len(statuses))
+	for i, st := range statuses {
+		resp[i] = &Status{Status: strings.ToLower(st.Status.String()), Message: st.Message}
+	}
+	http2.WriteJson(w, &DeleteKeystoresResponse{
+		Data:               resp,
+		SlashingProtection: string(jsonHist),
+	})
+}
+
+func groupExportErrors(req *DeleteKeystoresRequest, errorMessage string) []*Status {
+	statuses := make([]*Status, len(req.Pubkeys))
+	for i := 0; i < len(req.Pubkeys); i++ {
+		statuses[i] = &Status{
+			Status:  strings.ToLower(ethpbservice.DeletedKeystoreStatus_ERROR.String()),
+			Message: errorMessage,
+		}
+	}
+	return statuses
+}
+
+// For a list of deleted keystore statuses, we check if any NOT_FOUND status actually
+// has a corresponding public key in the database. In this case, we transform the status
+// to NOT_ACTIVE, as we do have slashing protection history for it and should not mark it
+// as NOT_FOUND when returning a response to the caller.
+func (s *Server) transformDeletedKeysStatuses(
+	ctx context.Context, pubKeys [][]byte, statuses []*ethpbservice.DeletedKeystoreStatus,
+) ([]*ethpbservice.DeletedKeystoreStatus, error) {
+	pubKeysInDB, err := s.publicKeysInDB(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not get public keys from DB: %v", err)
+	}
+	if len(pubKeysInDB) > 0 {
+		for i := 0; i < len(pubKeys); i++ {
+			keyExistsInDB := pubKeysInDB[bytesutil.ToBytes48(pubKeys[i])]
+			if keyExistsInDB && statuses[i].Status == ethpbservice.DeletedKeystoreStatus_NOT_FOUND {
+				statuses[i].Status = ethpbservice.DeletedKeystoreStatus_NOT_ACTIVE
+			}
+		}
+	}
+	return statuses, nil
+}
+
+// Gets a map of all public keys in the database, useful for O(1) lookups.
+func (s *Server) publicKeysInDB(ctx context.Context) (map[[fieldparams.BLSPubkeyLength]byte]bool, error) {
+	pubKeysInDB := make(map[[fieldparams.BLSPubkeyLength]byte]bool)
+	attestedPublicKeys, err := s.valDB.AttestedPublicKeys(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not get attested public keys from DB: %v", err)
+	}
+	proposedPublicKeys, err := s.valDB.ProposedPublicKeys(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not get proposed public keys from DB: %v", err)
+	}
+	for _, pk := range append(attestedPublicKeys, proposedPublicKeys...) {
+		pubKeysInDB[pk] = true
+	}
+	return pubKeysInDB, nil
+}
+
+// Exports slashing protection data for a list of DELETED or NOT_ACTIVE keys only to be used
+// as part of the DeleteKeystores endpoint.
+func (s *Server) slashingProtectionHistoryForDeletedKeys(
+	ctx context.Context, pubKeys [][]byte, statuses []*ethpbservice.DeletedKeystoreStatus,
+) (*format.EIPSlashingProtectionFormat, error) {
+	// We select the keys that were DELETED or NOT_ACTIVE from the previous action
+	// and use that to filter our slashing protection export.
+	filteredKeys := make([][]byte, 0, len(pubKeys))
+	for i, pk := range pubKeys {
+		if statuses[i].Status == ethpbservice.DeletedKeystoreStatus_DELETED ||
+			statuses[i].Status == ethpbservice.DeletedKeystoreStatus_NOT_ACTIVE {
+			filteredKeys = append(filteredKeys, pk)
+		}
+	}
+	return slashingprotection.ExportStandardProtectionJSON(ctx, s.valDB, filteredKeys...)
+}
+
+// ListRemoteKeys returns a list of all public keys defined for web3signer keymanager type.
+func (s *Server) ListRemoteKeys(w http.ResponseWriter, r *http.Request) {
+	ctx, span := trace.StartSpan(r.Context(), "validator.keymanagerAPI.ListRemoteKeys")
+	defer span.End()
+
+	if !s.walletInitialized {
+		http2.HandleError(w, "Prysm Wallet not initialized. Please create a new wallet.", http.StatusServiceUnavailable)
+		return
+	}
+	if s.validatorService == nil {
+		http2.HandleError(w, "Validator service not ready.", http.StatusServiceUnavailable)
+		return
+	}
+	km, err := s.validatorService.Keymanager()
+	if err != nil {
+		http2.HandleError(w, "Could not get Prysm keymanager (possibly due to beacon node unavailable): "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if s.wallet.KeymanagerKind() != keymanager.Web3Signer {
+		http2.HandleError(w, "Prysm Wallet is not of type Web3Signer. Please execute validator client with web3signer flags.", http.StatusBadRequest)
+		return
+	}
+	pubKeys, err := km.FetchValidatingPublicKeys(ctx)
+	if err != nil {
+		http2.HandleError(w, "Could not retrieve keystores: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	keystoreResponse := make([]*RemoteKey, len(pubKeys))
+	for i := 0; i < len(pubKeys); i++ {
+		keystoreResponse[i] = &RemoteKey{
+			Pubkey:   hexutil.Encode(pubKeys[i][:]),
+			Url:      s.validatorService.Web3SignerConfig.BaseEndpoint,
+			Readonly: true,
+		}
+	}
+	http2.WriteJson(w, &ListRemoteKeysResponse{
+		Data: keystoreResponse,
+	})
+}
+
+// ImportRemoteKeys imports a list of public keys defined for web3signer keymanager type.
+func (s *Server) ImportRemoteKeys(w http.ResponseWriter, r *http.Request) {
+	ctx, span := trace.StartSpan(r.Context(), "validator.keymanagerAPI.ImportRemoteKeys")
+	defer span.End()
+
+	if !s.walletInitialized {
+		http2.HandleError(w, "Prysm Wallet not initialized. Please create a new wallet.", http.StatusServiceUnavailable)
+		return
+	}
+	if s.validatorService == nil {
+		http2.HandleError(w, "Validator service not ready.", http.StatusServiceUnavailable)
+		return
+	}
+	km, err := s.validatorService.Keymanager()
+	if err != nil {
+		http2.HandleError(w, "Could not get Prysm keymanager (possibly due to beacon node unavailable): "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if s.wallet.KeymanagerKind() != keymanager.Web3Signer {
+		http2.HandleError(w, "Prysm Wallet is not of type Web3Signer. Please execute validator client with web3signer flags.", http.StatusBadRequest)
+		return
+	}
+
+	var req ImportRemoteKeysRequest
+	err = json.NewDecoder(r.Body).Decode(&req)
+	switch {
+	case err == io.EOF:
+		http2.HandleError(w, "No data submitted", http.StatusBadRequest)
+		return
+	case err != nil:
+		http2.HandleError(w, "Could not decode request body: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	adder, ok := km.(keymanager.PublicKeyAdder)
+	if !ok {
+		statuses := groupImportRemoteKeysErrors(&req, "Keymanager kind cannot import public keys for web3signer keymanager type.")
+		http2.WriteJson(w, &RemoteKeysResponse{Data: statuses})
+		return
+	}
+
+	remoteKeys := make([][fieldparams.BLSPubkeyLength]byte, len(req.RemoteKeys))
+	isUrlUsed := false
+	for i, obj := range req.RemoteKeys {
+		pubkey, ok := shared.ValidateHex(w, fmt.Sprintf("remote_keys[%d].pubkey", i), obj.Pubkey, fieldparams.BLSPubkeyLength)
+		if !ok {
+			return
+		}
+		remoteKeys[i] = bytesutil.ToBytes48(pubkey)
+		if obj.Url != "" {
+			isUrlUsed = true
+		}
+	}
+	if isUrlUsed {
+		log.Warnf("Setting web3signer base url for imported keys is not supported. Prysm only uses the url from --validators-external-signer-url flag for web3signer.")
+	}
+
+	statuses, err := adder.AddPublicKeys(ctx, remoteKeys)
+	if err != nil {
+		sts := groupImportRemoteKeysErrors(&req, fmt.Sprintf("Could not add keys;error: %v", err))
+		http2.WriteJson(w, &RemoteKeysResponse{Data: sts})
+		return
+	}
+	resp := make([]*Status, len(statuses))
+	for i, st := range statuses {
+		resp[i] = &Status{Status: strings.ToLower(st.Status.String()), Message: st.Message}
+	}
+	http2.WriteJson(w, &RemoteKeysResponse{
+		Data: resp,
+	})
+}
+
+func groupImportRemoteKeysErrors(req *ImportRemoteKeysRequest, errorMessage string) []*Status {
+	statuses := make([]*Status, len(req.RemoteKeys))
+	for i := 0; i < len(req.RemoteKeys); i++ {
+		statuses[i] = &Status{
+			Status:  strings.ToLower(ethpbservice.ImportedRemoteKeysStatus_ERROR.String()),
+			Message: errorMessage,
+		}
+	}
+	return statuses
+}
+
+// DeleteRemoteKeys deletes a list of public keys defined for web3signer keymanager type.
+func (s *Server) DeleteRemoteKeys(w http.ResponseWriter, r *http.Request) {
+	ctx, span := trace.StartSpan(r.Context(), "validator.keymanagerAPI.DeleteRemoteKeys")
+	defer span.End()
+
+	if !s.walletInitialized {
+		http2.HandleError(w, "Prysm Wallet not initialized. Please create a new wallet.", http.StatusServiceUnavailable)
+		return
+	}
+	if s.validatorService == nil {
+		http2.HandleError(w, "Validator service not ready.", http.StatusServiceUnavailable)
+		return
+	}
+	km, err := s.validatorService.Keymanager()
+	if err != nil {
+		http2.HandleError(w, "Could not get Prysm keymanager (possibly due to beacon node unavailable): "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if s.wallet.KeymanagerKind() != keymanager.Web3Signer {
+		http2.HandleError(w, "Prysm Wallet is not of type Web3Signer. Please execute validator client with web3signer flags.", http.StatusBadRequest)
+		return
+	}
+
+	var req DeleteRemoteKeysRequest
+	err = json.NewDecoder(r.Body).Decode(&req)
+	switch {
+	case err == io.EOF:
+		http2.HandleError(w, "No data submitted", http.StatusBadRequest)
+		return
+	case err != nil:
+		http2.HandleError(w, "Could not decode request body: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	deleter, ok := km.(keymanager.PublicKeyDeleter)
+	if !ok {
+		statuses := groupDeleteRemoteKeysErrors(&req, "Keymanager kind cannot delete public keys for web3signer keymanager type.")
+		http2.WriteJson(w, &RemoteKeysResponse{Data: statuses})
+		return
+	}
+	remoteKeys := make([][fieldparams.BLSPubkeyLength]byte, len(req.Pubkeys))
+	for i, key := range req.Pubkeys {
+		pubkey, ok := shared.ValidateHex(w, fmt.Sprintf("pubkeys[%d]", i), key, fieldparams.BLSPubkeyLength)
+		if !ok {
+			return
+		}
+		remoteKeys[i] = bytesutil.ToBytes48(pubkey)
+	}
+	statuses, err := deleter.DeletePublicKeys(ctx, remoteKeys)
+	if err != nil {
+		sts := groupDeleteRemoteKeysErrors(&req, fmt.Sprintf("Could not delete keys;error: %v", err))
+		http2.WriteJson(w, &RemoteKeysResponse{Data: sts})
+		return
+	}
+	resp := make([]*Status, len(statuses))
+	for i, st := range statuses {
+		resp[i] = &Status{Status: strings.ToLower(st.Status.String()), Message: st.Message}
+	}
+	http2.WriteJson(w, &RemoteKeysResponse{
+		Data: resp,
+	})
+}
+
+func groupDeleteRemoteKeysErrors(req *DeleteRemoteKeysRequest, errorMessage string) []*Status {
+	statuses := make([]*Status, len(req.Pubkeys))
+	for i := 0; i < len(req.Pubkeys); i++ {
+		statuses[i] = &Status{
+			Status:  strings.ToLower(ethpbservice.DeletedRemoteKeysStatus_ERROR.String()),
+			Message: errorMessage,
+		}
+	}
+	return statuses
+}
+
+// GetGasLimit returns the gas limit measured in gwei defined for the custom mev builder by public key
+func (s *Server) GetGasLimit(w http.ResponseWriter, r *http.Request) {
+	_, span := trace.StartSpan(r.Context(), "validator.keymanagerAPI.GetGasLimit")
+	defer span.End()
+
+	if s.validatorService == nil {
+		http2.HandleError(w, "Validator service not ready", http.StatusServiceUnavailable)
+		return
+	}
+	rawPubkey := mux.Vars(r)["pubkey"]
+	validatorKey, ok := shared.ValidateHex(w, "pubkey", rawPubkey, fieldparams.BLSPubkeyLength)
+	if !ok {
+		return
+	}
+
+	resp := &GetGasLimitResponse{
+		Data: &GasLimitMetaData{
+			Pubkey: rawPubkey,
+		},
+	}
+	settings := s.validatorService.ProposerSettings()
+	if settings != nil {
+		proposerOption, found := settings.ProposeConfig[bytesutil.ToBytes48(validatorKey)]
+		if found {
+			if proposerOption.BuilderConfig != nil {
+				resp.Data.GasLimit = strconv.FormatUint(uint64(proposerOption.BuilderConfig.GasLimit), 10)
+				http2.WriteJson(w, resp)
+				return
+			}
+		} else if settings.DefaultConfig != nil && settings.DefaultConfig.BuilderConfig != nil {
+			resp.Data.GasLimit = strconv.FormatUint(uint64(s.validatorService.ProposerSettings().DefaultConfig.BuilderConfig.GasLimit), 10)
+			http2.WriteJson(w, resp)
+			return
+		}
+	}
+	resp.Data.GasLimit = strconv.FormatUint(params.BeaconConfig().DefaultBuilderGasLimit, 10)
+	http2.WriteJson(w, resp)
+}
+
+// SetGasLimit updates the gas limit by public key
+func (s *Server) SetGasLimit(w http.ResponseWriter, r *http.Request) {
+	ctx, span := trace.StartSpan(r.Context(), "validator.keymanagerAPI.SetGasLimit")
+	defer span.End()
+
+	if s.validatorService == nil {
+		http2.HandleError(w, "Validator service not ready", http.StatusServiceUnavailable)
+		return
+	}
+	rawPubkey := mux.Vars(r)["pubkey"]
+	validatorKey, ok := shared.ValidateHex(w, "pubkey", rawPubkey, fieldparams.BLSPubkeyLength)
+	if !ok {
+		return
+	}
+
+	var req SetGasLimitRequest
+	err := json.NewDecoder(r.Body).Decode(&req)
+	switch {
+	case err == io.EOF:
+		http2.HandleError(w, "No data submitted", http.StatusBadRequest)
+		return
+	case err != nil:
+		http2.HandleError(w, "Could not decode request body: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+	gasLimit, ok := shared.ValidateUint(w, "gas_limit", req.GasLimit)
+	if !ok {
+		return
+	}
+
+	settings := s.validatorService.ProposerSettings()
+	if settings == nil {
+		http2.HandleError(w, "No proposer settings were found to update", http.StatusBadRequest)
+		return
+	} else if settings.ProposeConfig == nil {
+		if settings.DefaultConfig == nil || settings.DefaultConfig.BuilderConfig == nil || !settings.DefaultConfig.BuilderConfig.Enabled {
+			http2.HandleError(w, "Gas limit changes only apply when builder is enabled", http.StatusBadRequest)
+			return
+		}
+		settings.ProposeConfig = make(map[[fieldparams.BLSPubkeyLength]byte]*validatorServiceConfig.ProposerOption)
+		option := settings.DefaultConfig.Clone()
+		option.BuilderConfig.GasLimit = validator.Uint64(gasLimit)
+		settings.ProposeConfig[bytesutil.ToBytes48(validatorKey)] = option
+	} else {
+		proposerOption, found := settings.ProposeConfig[bytesutil.ToBytes48(validatorKey)]
+		if found {
+			if proposerOption.BuilderConfig == nil || !proposerOption.BuilderConfig.Enabled {
+				http2.HandleError(w, "Gas limit changes only apply when builder is enabled", http.StatusBadRequest)
+				return
+			} else {
+				proposerOption.BuilderConfig.GasLimit = validator.Uint64(gasLimit)
+			}
+		} else {
+			if settings.DefaultConfig == nil {
+				http2.HandleError(w, "Gas limit changes only apply when builder is enabled", http.StatusBadRequest)
+				return
+			}
+			option := settings.DefaultConfig.Clone()
+			option.BuilderConfig.GasLimit = validator.Uint64(gasLimit)
+			settings.ProposeConfig[bytesutil.ToBytes48(validatorKey)] = option
+		}
+	}
+	// save the settings
+	if err := s.validatorService.SetProposerSettings(ctx, settings); err != nil {
+		http2.HandleError(w, "Could not set proposer settings: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	// override the 200 success with 202 according to the specs
+	w.WriteHeader(http.StatusAccepted)
+}
+
+// DeleteGasLimit deletes the gas limit by public key
+func (s *Server) DeleteGasLimit(w http.ResponseWriter, r *http.Request) {
+	ctx, span := trace.StartSpan(r.Context(), "validator.keymanagerAPI.DeleteGasLimit")
+	defer span.End()
+
+	if s.validatorService == nil {
+		http2.HandleError(w, "Validator service not ready", http.StatusServiceUnavailable)
+		return
+	}
+	rawPubkey := mux.Vars(r)["pubkey"]
+	validatorKey, ok := shared.ValidateHex(w, "pubkey", rawPubkey, fieldparams.BLSPubkeyLength)
+	if !ok {
+		return
+	}
+
+	proposerSettings := s.validatorService.ProposerSettings()
+	if proposerSettings != nil && proposerSettings.ProposeConfig != nil {
+		proposerOption, found := proposerSettings.ProposeConfig[bytesutil.ToBytes48(validatorKey)]
+		if found && proposerOption.BuilderConfig != nil {
+			// If proposerSettings has default value, use it.
+			if proposerSettings.DefaultConfig != nil && proposerSettings.DefaultConfig.BuilderConfig != nil {
+				proposerOption.BuilderConfig.GasLimit = proposerSettings.DefaultConfig.BuilderConfig.GasLimit
+			} else {
+				// Fallback to using global default.
+				proposerOption.BuilderConfig.GasLimit = validator.Uint64(params.BeaconConfig().DefaultBuilderGasLimit)
+			}
+			// save the settings
+			if err := s.validatorService.SetProposerSettings(ctx, proposerSettings); err != nil {
+				http2.HandleError(w, "Could not set proposer settings: "+err.Error(), http.StatusInternalServerError)
+				return
+			}
+			// Successfully deleted gas limit (reset to proposer config default or global default).
+			// Return with success http code "204".
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+	}
+	// Otherwise, either no proposerOption is found for the pubkey or proposerOption.BuilderConfig is not enabled at all,
+	// we respond "not found".
+	http2.HandleError(w, fmt.Sprintf("No gas limit found for pubkey: %q", rawPubkey), http.StatusNotFound)
+}
+
+// ListFeeRecipientByPubkey returns the public key to eth address mapping object to the end user.
+func (s *Server) ListFeeRecipientByPubkey(w http.ResponseWriter, r *http.Request) {
+	ctx, span := trace.StartSpan(r.Context(), "validator.keymanagerAPI.ListFeeRecipientByPubkey")
+	defer span.End()
+
+	if s.validatorService == nil {
+		http2.HandleError(w, "Validator service not ready", http.StatusServiceUnavailable)
+		return
+	}
+	rawPubkey := mux.Vars(r)["pubkey"]
+	validatorKey, ok := shared.ValidateHex(w, "pubkey", rawPubkey, fieldparams.BLSPubkeyLength)
+	if !ok {
+		return
+	}
+
+	finalResp := &GetFeeRecipientByPubkeyResponse{
+		Data: &FeeRecipient{
+			Pubkey: rawPubkey,
+		},
+	}
+
+	proposerSettings := s.validatorService.ProposerSettings()
+
+	// If fee recipient is defined for this specific pubkey in proposer configuration, use it
+	if proposerSettings != nil && proposerSettings.ProposeConfig != nil {
+		proposerOption, found := proposerSettings.ProposeConfig[bytesutil.ToBytes48(validatorKey)]
+
+		if found && proposerOption.FeeRecipientConfig != nil {
+			finalResp.Data.Ethaddress = proposerOption.FeeRecipientConfig.FeeRecipient.String()
+			http2.WriteJson(w, finalResp)
+			return
+		}
+	}
+
+	// If fee recipient is defined in default configuration, use it
+	if proposerSettings != nil && proposerSettings.DefaultConfig != nil && proposerSettings.DefaultConfig.FeeRecipientConfig != nil {
+		finalResp.Data.Ethaddress = proposerSettings.DefaultConfig.FeeRecipientConfig.FeeRecipient.String()
+		http2.WriteJson(w, finalResp)
+		return
+	}
+
+	// Else, use the one defined in beacon node TODO: remove this with db removal
+	resp, err := s.beaconNodeValidatorClient.GetFeeRecipientByPubKey(ctx, &eth.FeeRecipientByPubKeyRequest{
+		PublicKey: validatorKey,
+	})
+	if err != nil {
+		http2.HandleError(w, "Failed to retrieve default fee recipient from beacon node", http.StatusInternalServerError)
+		return
+	}
+
+	if resp != nil && len(resp.FeeRecipient) != 0 {
+		finalResp.Data.Ethaddress = common.BytesToAddress(resp.FeeRecipient).String()
+		http2.WriteJson(w, finalResp)
+		return
+	}
+
+	http2.HandleError(w, "No fee recipient set", http.StatusBadRequest)
+}
+
+// SetFeeRecipientByPubkey updates the eth address mapped to the public key.
+func (s *Server) SetFeeRecipientByPubkey(w http.ResponseWriter, r *http.Request) {
+	ctx, span := trace.StartSpan(r.Context(), "validator.keymanagerAPI.SetFeeRecipientByPubkey")
+	defer span.End()
+
+	if s.validatorService == nil {
+		http2.HandleError(w, "Validator service not ready", http.StatusServiceUnavailable)
+		return
+	}
+	rawPubkey := mux.Vars(r)["pubkey"]
+	validatorKey, ok := shared.ValidateHex(w, "pubkey", rawPubkey, fieldparams.BLSPubkeyLength)
+	if !ok {
+		return
+	}
+
+	var req SetFeeRecipientByPubkeyRequest
+	err := json.NewDecoder(r.Body).Decode(&req)
+	switch {
+	case err == io.EOF:
+		http2.HandleError(w, "No data submitted", http.StatusBadRequest)
+		return
+	case err != nil:
+		http2.HandleError(w, "Could not decode request body: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+	ethAddress, ok := shared.ValidateHex(w, "Ethereum address", req.Ethaddress, fieldparams.FeeRecipientLength)
+	if !ok {
+		return
+	}
+	feeRecipient := common.BytesToAddress(ethAddress)
+
+	settings := s.validatorService.ProposerSettings()
+	switch {
+	case settings == nil:
+		settings = &validatorServiceConfig.ProposerSettings{
+			ProposeConfig: map[[fieldparams.BLSPubkeyLength]byte]*validatorServiceConfig.ProposerOption{
+				bytesutil.ToBytes48(validatorKey): {
+					FeeRecipientConfig: &validatorServiceConfig.FeeRecipientConfig{
+						FeeRecipient: feeRecipient,
+					},
+					BuilderConfig: nil,
+				},
+			},
+			DefaultConfig: nil,
+		}
+	case settings.ProposeConfig == nil:
+		var builderConfig *validatorServiceConfig.BuilderConfig
+		if settings.DefaultConfig != nil && settings.DefaultConfig.BuilderConfig != nil {
+			builderConfig = settings.DefaultConfig.BuilderConfig.Clone()
+		}
+		settings.ProposeConfig = map[[fieldparams.BLSPubkeyLength]byte]*validatorServiceConfig.ProposerOption{
+			bytesutil.ToBytes48(validatorKey): {
+				FeeRecipientConfig: &validatorServiceConfig.FeeRecipientConfig{
+					FeeRecipient: feeRecipient,
+				},
+				BuilderConfig: builderConfig,
+			},
+		}
+	default:
+		proposerOption, found := settings.ProposeConfig[bytesutil.ToBytes48(validatorKey)]
+		if found && proposerOption != nil {
+			proposerOption.FeeRecipientConfig = &validatorServiceConfig.FeeRecipientConfig{
+				FeeRecipient: feeRecipient,
+			}
+		} else {
+			var builderConfig = &validatorServiceConfig.BuilderConfig{}
+			if settings.DefaultConfig != nil && settings.DefaultConfig.BuilderConfig != nil {
+				builderConfig = settings.DefaultConfig.BuilderConfig.Clone()
+			}
+			settings.ProposeConfig[bytesutil.ToBytes48(validatorKey)] = &validatorServiceConfig.ProposerOption{
+				FeeRecipientConfig: &validatorServiceConfig.FeeRecipientConfig{
+					FeeRecipient: feeRecipient,
+				},
+				BuilderConfig: builderConfig,
+			}
+		}
+	}
+	// save the settings
+	if err := s.validatorService.SetProposerSettings(ctx, settings); err != nil {
+		http2.HandleError(w, "Could not set proposer settings: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	// override the 200 success with 202 according to the specs
+	w.WriteHeader(http.StatusAccepted)
+}
+
+// DeleteFeeRecipientByPubkey updates the eth address mapped to the public key to the default fee recipient listed
+func (s *Server) DeleteFeeRecipientByPubkey(w http.ResponseWriter, r *http.Request) {
+	ctx, span := trace.StartSpan(r.Context(), "validator.keymanagerAPI.DeleteFeeRecipientByPubkey")
+	defer span.End()
+
+	if s.validatorService == nil {
+		http2.HandleError(w, "Validator service not ready", http.StatusServiceUnavailable)
+		return
+	}
+	rawPubkey := mux.Vars(r)["pubkey"]
+	validatorKey, ok := shared.ValidateHex(w, "pubkey", rawPubkey, fieldparams.BLSPubkeyLength)
+	if !ok {
+		return
+	}
+
+	settings := s.validatorService.ProposerSettings()
+
+	if settings != nil && settings.ProposeConfig != nil {
+		proposerOption, found := settings.ProposeConfig[bytesutil.ToBytes48(validatorKey)]
+		if found {
+			proposerOption.FeeRecipientConfig = nil
+		}
+	}
+
+	// save the settings
+	if err := s.validatorService.SetProposerSettings(ctx, settings); err != nil {
+		http2.HandleError(w, "Could not set proposer settings: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	// override the 200 success with 204 according to the specs
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// SetVoluntaryExit creates a signed voluntary exit message and returns a VoluntaryExit object.
+func (s *Server) SetVoluntaryExit(w http.ResponseWriter, r *http.Request) {
+	ctx, span := trace.StartSpan(r.Context(), "validator.keymanagerAPI.SetVoluntaryExit")
+	defer span.End()
+
+	if s.validatorService == nil {
+		http2.HandleError(w, "Validator service not ready", http.StatusServiceUnavailable)
+		return
+	}
+	if s.wallet == nil {
+		http2.HandleError(w, "No wallet found", http.StatusServiceUnavailable)
+		return
+	}
+	rawPubkey := mux.Vars(r)["pubkey"]
+	pubkey, ok := shared.ValidateHex(w, "pubkey", rawPubkey, fieldparams.BLSPubkeyLength)
+	if !ok {
+		return
+	}
+	ok, _, e := shared.UintFromQuery(w, r, "epoch")
+	if !ok {
+		return
+	}
+	epoch := primitives.Epoch(e)
+
+	km, err := s.validatorService.Keymanager()
+	if err != nil {
+		http2.HandleError(w, "Could not get keymanager: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if epoch == 0 {
+		genesisResponse, err := s.beaconNodeClient.GetGenesis(ctx, &emptypb.Empty{})
+		if err != nil {
+			http2.HandleError(w, "Failed to get genesis time: "+err.Error(), http.StatusInternalServerError)
+			return
+		}
+		currentEpoch, err := client.CurrentEpoch(genesisResponse.GenesisTime)
+		if err != nil {
+			http2.HandleError(w, "Failed to get current epoch: "+err.Error(), http.StatusInternalServerError)
+			return
+		}
+		epoch = currentEpoch
+	}
+	sve, err := client.CreateSignedVoluntaryExit(
+		ctx,
+		s.beaconNodeValidatorClient,
+		km.Sign,
+		pubkey,
+		epoch,
+	)
+	if err != nil {
+		http2.HandleError(w, "Could not create voluntary exit: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	http2.WriteJson(w, &SetVoluntaryExitResponse{
+		Data: shared.SignedVoluntaryExitFromConsensus(sve),
+	})
+}
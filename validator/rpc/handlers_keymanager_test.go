@@ -7,15 +7,18 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/golang/mock/gomock"
-	"github.com/golang/protobuf/ptypes/empty"
 	"github.com/google/uuid"
-	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/gorilla/mux"
 	fieldparams "github.com/prysmaticlabs/prysm/v4/config/fieldparams"
 	"github.com/prysmaticlabs/prysm/v4/config/params"
 	validatorserviceconfig "github.com/prysmaticlabs/prysm/v4/config/validator/service"
@@ -23,7 +26,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v4/consensus-types/validator"
 	"github.com/prysmaticlabs/prysm/v4/crypto/bls"
 	"github.com/prysmaticlabs/prysm/v4/encoding/bytesutil"
-	ethpbservice "github.com/prysmaticlabs/prysm/v4/proto/eth/service"
+	http2 "github.com/prysmaticlabs/prysm/v4/network/http"
 	eth "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
 	validatorpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1/validator-client"
 	"github.com/prysmaticlabs/prysm/v4/testing/assert"
@@ -42,8 +45,6 @@ import (
 	"github.com/prysmaticlabs/prysm/v4/validator/slashing-protection-history/format"
 	mocks "github.com/prysmaticlabs/prysm/v4/validator/testing"
 	keystorev4 "github.com/wealdtech/go-eth2-wallet-encryptor-keystorev4"
-	"google.golang.org/grpc"
-	"google.golang.org/grpc/codes"
 	"google.golang.org/protobuf/types/known/emptypb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
@@ -51,8 +52,15 @@ import (
 func TestServer_ListKeystores(t *testing.T) {
 	t.Run("wallet not ready", func(t *testing.T) {
 		s := Server{}
-		_, err := s.ListKeystores(context.Background(), &empty.Empty{})
-		require.ErrorContains(t, "Prysm Wallet not initialized. Please create a new wallet.", err)
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/eth/v1/keystores", nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+		s.ListKeystores(writer, request)
+		require.Equal(t, http.StatusServiceUnavailable, writer.Code)
+		e := &http2.DefaultErrorJson{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), e))
+		assert.Equal(t, http.StatusServiceUnavailable, e.Code)
+		assert.StringContains(t, "Prysm Wallet not initialized. Please create a new wallet.", e.Message)
 	})
 	ctx := context.Background()
 	localWalletDir := setupWalletDir(t)
@@ -90,11 +98,16 @@ func TestServer_ListKeystores(t *testing.T) {
 	require.NoError(t, err)
 
 	t.Run("returns proper data with existing keystores", func(t *testing.T) {
-		resp, err := s.ListKeystores(context.Background(), &empty.Empty{})
-		require.NoError(t, err)
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/eth/v1/keystores", nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+		s.ListKeystores(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		resp := &ListKeystoresResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
 		require.Equal(t, numAccounts, len(resp.Data))
 		for i := 0; i < numAccounts; i++ {
-			require.DeepEqual(t, expectedKeys[i][:], resp.Data[i].ValidatingPubkey)
+			require.Equal(t, hexutil.Encode(expectedKeys[i][:]), resp.Data[i].ValidatingPubkey)
 			require.Equal(
 				t,
 				fmt.Sprintf(derived.ValidatingKeyDerivationPathTemplate, i),
@@ -107,9 +120,16 @@ func TestServer_ListKeystores(t *testing.T) {
 func TestServer_ImportKeystores(t *testing.T) {
 	t.Run("wallet not ready", func(t *testing.T) {
 		s := Server{}
-		response, err := s.ImportKeystores(context.Background(), &ethpbservice.ImportKeystoresRequest{})
-		require.NoError(t, err)
-		require.Equal(t, 0, len(response.Data))
+		var body bytes.Buffer
+		require.NoError(t, json.NewEncoder(&body).Encode(&ImportKeystoresRequest{}))
+		request := httptest.NewRequest(http.MethodPost, "http://example.com/eth/v1/keystores", &body)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+		s.ImportKeystores(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		resp := &ImportKeystoresResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		require.Equal(t, 0, len(resp.Data))
 	})
 	ctx := context.Background()
 	localWalletDir := setupWalletDir(t)
@@ -138,41 +158,60 @@ func TestServer_ImportKeystores(t *testing.T) {
 		wallet:            w,
 		validatorService:  vs,
 	}
+	importKeystores := func(t *testing.T, req *ImportKeystoresRequest) *ImportKeystoresResponse {
+		var body bytes.Buffer
+		require.NoError(t, json.NewEncoder(&body).Encode(req))
+		request := httptest.NewRequest(http.MethodPost, "http://example.com/eth/v1/keystores", &body)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+		s.ImportKeystores(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		resp := &ImportKeystoresResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		return resp
+	}
+	t.Run("no body", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodPost, "http://example.com/eth/v1/keystores", nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+		s.ImportKeystores(writer, request)
+		require.Equal(t, http.StatusBadRequest, writer.Code)
+		e := &http2.DefaultErrorJson{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), e))
+		assert.Equal(t, http.StatusBadRequest, e.Code)
+		assert.StringContains(t, "No data submitted", e.Message)
+	})
 	t.Run("200 response even if faulty keystore in request", func(t *testing.T) {
-		response, err := s.ImportKeystores(context.Background(), &ethpbservice.ImportKeystoresRequest{
+		resp := importKeystores(t, &ImportKeystoresRequest{
 			Keystores: []string{"hi"},
 			Passwords: []string{"hi"},
 		})
-		require.NoError(t, err)
-		require.Equal(t, 1, len(response.Data))
-		require.Equal(t, ethpbservice.ImportedKeystoreStatus_ERROR, response.Data[0].Status)
+		require.Equal(t, 1, len(resp.Data))
+		require.Equal(t, "error", resp.Data[0].Status)
 	})
 	t.Run("200 response even if  no passwords in request", func(t *testing.T) {
-		response, err := s.ImportKeystores(context.Background(), &ethpbservice.ImportKeystoresRequest{
+		resp := importKeystores(t, &ImportKeystoresRequest{
 			Keystores: []string{"hi"},
 			Passwords: []string{},
 		})
-		require.NoError(t, err)
-		require.Equal(t, 1, len(response.Data))
-		require.Equal(t, ethpbservice.ImportedKeystoreStatus_ERROR, response.Data[0].Status)
+		require.Equal(t, 1, len(resp.Data))
+		require.Equal(t, "error", resp.Data[0].Status)
 	})
 	t.Run("200 response even if  keystores more than passwords in request", func(t *testing.T) {
-		response, err := s.ImportKeystores(context.Background(), &ethpbservice.ImportKeystoresRequest{
+		resp := importKeystores(t, &ImportKeystoresRequest{
 			Keystores: []string{"hi", "hi"},
 			Passwords: []string{"hi"},
 		})
-		require.NoError(t, err)
-		require.Equal(t, 2, len(response.Data))
-		require.Equal(t, ethpbservice.ImportedKeystoreStatus_ERROR, response.Data[0].Status)
+		require.Equal(t, 2, len(resp.Data))
+		require.Equal(t, "error", resp.Data[0].Status)
 	})
 	t.Run("200 response even if number of passwords does not match number of keystores", func(t *testing.T) {
-		response, err := s.ImportKeystores(context.Background(), &ethpbservice.ImportKeystoresRequest{
+		resp := importKeystores(t, &ImportKeystoresRequest{
 			Keystores: []string{"hi"},
 			Passwords: []string{"hi", "hi"},
 		})
-		require.NoError(t, err)
-		require.Equal(t, 1, len(response.Data))
-		require.Equal(t, ethpbservice.ImportedKeystoreStatus_ERROR, response.Data[0].Status)
+		require.Equal(t, 1, len(resp.Data))
+		require.Equal(t, "error", resp.Data[0].Status)
 	})
 	t.Run("200 response even if faulty slashing protection data", func(t *testing.T) {
 		numKeystores := 5
@@ -185,15 +224,14 @@ func TestServer_ImportKeystores(t *testing.T) {
 			require.NoError(t, err)
 			passwords[i] = password
 		}
-		resp, err := s.ImportKeystores(context.Background(), &ethpbservice.ImportKeystoresRequest{
+		resp := importKeystores(t, &ImportKeystoresRequest{
 			Keystores:          encodedKeystores,
 			Passwords:          passwords,
 			SlashingProtection: "foobar",
 		})
-		require.NoError(t, err)
 		require.Equal(t, numKeystores, len(resp.Data))
 		for _, st := range resp.Data {
-			require.Equal(t, ethpbservice.ImportedKeystoreStatus_ERROR, st.Status)
+			require.Equal(t, "error", st.Status)
 		}
 	})
 	t.Run("returns proper statuses for keystores in request", func(t *testing.T) {
@@ -241,15 +279,14 @@ func TestServer_ImportKeystores(t *testing.T) {
 		encodedSlashingProtection, err := json.Marshal(mockJSON)
 		require.NoError(t, err)
 
-		resp, err := s.ImportKeystores(context.Background(), &ethpbservice.ImportKeystoresRequest{
+		resp := importKeystores(t, &ImportKeystoresRequest{
 			Keystores:          encodedKeystores,
 			Passwords:          passwords,
 			SlashingProtection: string(encodedSlashingProtection),
 		})
-		require.NoError(t, err)
 		require.Equal(t, numKeystores, len(resp.Data))
 		for _, status := range resp.Data {
-			require.Equal(t, ethpbservice.ImportedKeystoreStatus_IMPORTED, status.Status)
+			require.Equal(t, "imported", status.Status)
 		}
 	})
 }
@@ -277,22 +314,36 @@ func TestServer_ImportKeystores_WrongKeymanagerKind(t *testing.T) {
 		wallet:            w,
 		validatorService:  vs,
 	}
-	response, err := s.ImportKeystores(context.Background(), &ethpbservice.ImportKeystoresRequest{
+	var body bytes.Buffer
+	require.NoError(t, json.NewEncoder(&body).Encode(&ImportKeystoresRequest{
 		Keystores: []string{"hi"},
 		Passwords: []string{"hi"},
-	})
-	require.NoError(t, err)
-	require.Equal(t, 1, len(response.Data))
-	require.Equal(t, ethpbservice.ImportedKeystoreStatus_ERROR, response.Data[0].Status)
-	require.Equal(t, "Keymanager kind cannot import keys", response.Data[0].Message)
+	}))
+	request := httptest.NewRequest(http.MethodPost, "http://example.com/eth/v1/keystores", &body)
+	writer := httptest.NewRecorder()
+	writer.Body = &bytes.Buffer{}
+	s.ImportKeystores(writer, request)
+	require.Equal(t, http.StatusOK, writer.Code)
+	resp := &ImportKeystoresResponse{}
+	require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+	require.Equal(t, 1, len(resp.Data))
+	require.Equal(t, "error", resp.Data[0].Status)
+	require.Equal(t, "Keymanager kind cannot import keys", resp.Data[0].Message)
 }
 
 func TestServer_DeleteKeystores(t *testing.T) {
 	t.Run("wallet not ready", func(t *testing.T) {
 		s := Server{}
-		response, err := s.DeleteKeystores(context.Background(), &ethpbservice.DeleteKeystoresRequest{})
-		require.NoError(t, err)
-		require.Equal(t, 0, len(response.Data))
+		var body bytes.Buffer
+		require.NoError(t, json.NewEncoder(&body).Encode(&DeleteKeystoresRequest{}))
+		request := httptest.NewRequest(http.MethodDelete, "http://example.com/eth/v1/keystores", &body)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+		s.DeleteKeystores(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		resp := &DeleteKeystoresResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		require.Equal(t, 0, len(resp.Data))
 	})
 	ctx := context.Background()
 	srv := setupServerWithWallet(t)
@@ -338,14 +389,39 @@ func TestServer_DeleteKeystores(t *testing.T) {
 	})
 	require.NoError(t, err)
 
+	deleteKeystores := func(t *testing.T, req *DeleteKeystoresRequest) *DeleteKeystoresResponse {
+		var body bytes.Buffer
+		require.NoError(t, json.NewEncoder(&body).Encode(req))
+		request := httptest.NewRequest(http.MethodDelete, "http://example.com/eth/v1/keystores", &body)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+		srv.DeleteKeystores(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		resp := &DeleteKeystoresResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		return resp
+	}
+
 	t.Run("no slashing protection response if no keys in request even if we have a history in DB", func(t *testing.T) {
-		resp, err := srv.DeleteKeystores(context.Background(), &ethpbservice.DeleteKeystoresRequest{
+		resp := deleteKeystores(t, &DeleteKeystoresRequest{
 			Pubkeys: nil,
 		})
-		require.NoError(t, err)
 		require.Equal(t, "", resp.SlashingProtection)
 	})
 
+	t.Run("invalid pubkey", func(t *testing.T) {
+		var body bytes.Buffer
+		require.NoError(t, json.NewEncoder(&body).Encode(&DeleteKeystoresRequest{Pubkeys: []string{"foo"}}))
+		request := httptest.NewRequest(http.MethodDelete, "http://example.com/eth/v1/keystores", &body)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+		srv.DeleteKeystores(writer, request)
+		require.Equal(t, http.StatusBadRequest, writer.Code)
+		e := &http2.DefaultErrorJson{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), e))
+		assert.StringContains(t, "Pubkey at index 0 is not a valid hex string", e.Message)
+	})
+
 	// For ease of test setup, we'll give each public key a string identifier.
 	publicKeysWithId := map[string][fieldparams.BLSPubkeyLength]byte{
 		"a": publicKeys[0],
@@ -359,7 +435,7 @@ func TestServer_DeleteKeystores(t *testing.T) {
 	}
 	tests := []struct {
 		keys         []*keyCase
-		wantStatuses []ethpbservice.DeletedKeystoreStatus_Status
+		wantStatuses []string
 	}{
 		{
 			keys: []*keyCase{
@@ -368,11 +444,11 @@ func TestServer_DeleteKeystores(t *testing.T) {
 				{id: "d"},
 				{id: "c", wantProtectionData: true},
 			},
-			wantStatuses: []ethpbservice.DeletedKeystoreStatus_Status{
-				ethpbservice.DeletedKeystoreStatus_DELETED,
-				ethpbservice.DeletedKeystoreStatus_NOT_ACTIVE,
-				ethpbservice.DeletedKeystoreStatus_NOT_FOUND,
-				ethpbservice.DeletedKeystoreStatus_DELETED,
+			wantStatuses: []string{
+				"deleted",
+				"not_active",
+				"not_found",
+				"deleted",
 			},
 		},
 		{
@@ -380,28 +456,27 @@ func TestServer_DeleteKeystores(t *testing.T) {
 				{id: "a", wantProtectionData: true},
 				{id: "c", wantProtectionData: true},
 			},
-			wantStatuses: []ethpbservice.DeletedKeystoreStatus_Status{
-				ethpbservice.DeletedKeystoreStatus_NOT_ACTIVE,
-				ethpbservice.DeletedKeystoreStatus_NOT_ACTIVE,
+			wantStatuses: []string{
+				"not_active",
+				"not_active",
 			},
 		},
 		{
 			keys: []*keyCase{
 				{id: "x"},
 			},
-			wantStatuses: []ethpbservice.DeletedKeystoreStatus_Status{
-				ethpbservice.DeletedKeystoreStatus_NOT_FOUND,
+			wantStatuses: []string{
+				"not_found",
 			},
 		},
 	}
 	for _, tc := range tests {
-		keys := make([][]byte, len(tc.keys))
+		keys := make([]string, len(tc.keys))
 		for i := 0; i < len(tc.keys); i++ {
 			pk := publicKeysWithId[tc.keys[i].id]
-			keys[i] = pk[:]
+			keys[i] = hexutil.Encode(pk[:])
 		}
-		resp, err := srv.DeleteKeystores(ctx, &ethpbservice.DeleteKeystoresRequest{Pubkeys: keys})
-		require.NoError(t, err)
+		resp := deleteKeystores(t, &DeleteKeystoresRequest{Pubkeys: keys})
 		require.Equal(t, len(keys), len(resp.Data))
 		slashingProtectionData := &format.EIPSlashingProtectionFormat{}
 		require.NoError(t, json.Unmarshal([]byte(resp.SlashingProtection), slashingProtectionData))
@@ -418,7 +493,7 @@ func TestServer_DeleteKeystores(t *testing.T) {
 				// We check that we can find the key in the slashing protection data.
 				var found bool
 				for _, dt := range slashingProtectionData.Data {
-					if dt.Pubkey == fmt.Sprintf("%#x", keys[i]) {
+					if dt.Pubkey == keys[i] {
 						found = true
 						break
 					}
@@ -458,14 +533,21 @@ func TestServer_DeleteKeystores_FailedSlashingProtectionExport(t *testing.T) {
 		require.NoError(t, validatorDB.Close())
 	}()
 
-	response, err := srv.DeleteKeystores(context.Background(), &ethpbservice.DeleteKeystoresRequest{
-		Pubkeys: [][]byte{[]byte("a")},
-	})
-	require.NoError(t, err)
-	require.Equal(t, 1, len(response.Data))
-	require.Equal(t, ethpbservice.DeletedKeystoreStatus_ERROR, response.Data[0].Status)
+	var body bytes.Buffer
+	require.NoError(t, json.NewEncoder(&body).Encode(&DeleteKeystoresRequest{
+		Pubkeys: []string{hexutil.Encode([]byte("a"))},
+	}))
+	request := httptest.NewRequest(http.MethodDelete, "http://example.com/eth/v1/keystores", &body)
+	writer := httptest.NewRecorder()
+	writer.Body = &bytes.Buffer{}
+	srv.DeleteKeystores(writer, request)
+	require.Equal(t, http.StatusOK, writer.Code)
+	resp := &DeleteKeystoresResponse{}
+	require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+	require.Equal(t, 1, len(resp.Data))
+	require.Equal(t, "error", resp.Data[0].Status)
 	require.Equal(t, "Non duplicate keys that were existing were deleted, but could not export slashing protection history.",
-		response.Data[0].Message,
+		resp.Data[0].Message,
 	)
 }
 
@@ -493,9 +575,19 @@ func TestServer_DeleteKeystores_WrongKeymanagerKind(t *testing.T) {
 		wallet:            w,
 		validatorService:  vs,
 	}
-	_, err = s.DeleteKeystores(ctx, &ethpbservice.DeleteKeystoresRequest{Pubkeys: [][]byte{[]byte("a")}})
-	require.ErrorContains(t, "Wrong wallet type", err)
-	require.ErrorContains(t, "Only Imported or Derived wallets can delete accounts", err)
+	var body bytes.Buffer
+	require.NoError(t, json.NewEncoder(&body).Encode(&DeleteKeystoresRequest{
+		Pubkeys: []string{hexutil.Encode([]byte("a"))},
+	}))
+	request := httptest.NewRequest(http.MethodDelete, "http://example.com/eth/v1/keystores", &body)
+	writer := httptest.NewRecorder()
+	writer.Body = &bytes.Buffer{}
+	s.DeleteKeystores(writer, request)
+	require.Equal(t, http.StatusInternalServerError, writer.Code)
+	e := &http2.DefaultErrorJson{}
+	require.NoError(t, json.Unmarshal(writer.Body.Bytes(), e))
+	assert.StringContains(t, "Wrong wallet type", e.Message)
+	assert.StringContains(t, "Only Imported or Derived wallets can delete accounts", e.Message)
 }
 
 func setupServerWithWallet(t testing.TB) *Server {
@@ -550,8 +642,14 @@ func createRandomKeystore(t testing.TB, password string) *keymanager.Keystore {
 func TestServer_ListRemoteKeys(t *testing.T) {
 	t.Run("wallet not ready", func(t *testing.T) {
 		s := Server{}
-		_, err := s.ListKeystores(context.Background(), &empty.Empty{})
-		require.ErrorContains(t, "Prysm Wallet not initialized. Please create a new wallet.", err)
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/eth/v1/remotekeys", nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+		s.ListRemoteKeys(writer, request)
+		require.Equal(t, http.StatusServiceUnavailable, writer.Code)
+		e := &http2.DefaultErrorJson{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), e))
+		assert.StringContains(t, "Prysm Wallet not initialized. Please create a new wallet.", e.Message)
 	})
 	ctx := context.Background()
 	w := wallet.NewWalletForWeb3Signer()
@@ -584,10 +682,18 @@ func TestServer_ListRemoteKeys(t *testing.T) {
 	require.NoError(t, err)
 
 	t.Run("returns proper data with existing pub keystores", func(t *testing.T) {
-		resp, err := s.ListRemoteKeys(context.Background(), &empty.Empty{})
-		require.NoError(t, err)
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/eth/v1/remotekeys", nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+		s.ListRemoteKeys(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		resp := &ListRemoteKeysResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		require.Equal(t, len(expectedKeys), len(resp.Data))
 		for i := 0; i < len(resp.Data); i++ {
-			require.DeepEqual(t, expectedKeys[i][:], resp.Data[i].Pubkey)
+			require.Equal(t, hexutil.Encode(expectedKeys[i][:]), resp.Data[i].Pubkey)
+			require.Equal(t, "http://example.com", resp.Data[i].Url)
+			require.Equal(t, true, resp.Data[i].Readonly)
 		}
 	})
 }
@@ -595,8 +701,14 @@ func TestServer_ListRemoteKeys(t *testing.T) {
 func TestServer_ImportRemoteKeys(t *testing.T) {
 	t.Run("wallet not ready", func(t *testing.T) {
 		s := Server{}
-		_, err := s.ListKeystores(context.Background(), &empty.Empty{})
-		require.ErrorContains(t, "Prysm Wallet not initialized. Please create a new wallet.", err)
+		request := httptest.NewRequest(http.MethodPost, "http://example.com/eth/v1/remotekeys", nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+		s.ImportRemoteKeys(writer, request)
+		require.Equal(t, http.StatusServiceUnavailable, writer.Code)
+		e := &http2.DefaultErrorJson{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), e))
+		assert.StringContains(t, "Prysm Wallet not initialized. Please create a new wallet.", e.Message)
 	})
 	ctx := context.Background()
 	w := wallet.NewWalletForWeb3Signer()
@@ -622,42 +734,65 @@ func TestServer_ImportRemoteKeys(t *testing.T) {
 		wallet:            w,
 		validatorService:  vs,
 	}
-	bytevalue, err := hexutil.Decode("0x93247f2209abcacf57b75a51dafae777f9dd38bc7053d1af526f220a7489a6d3a2753e5f3e8b1cfe39b56f43611df74a")
-	require.NoError(t, err)
-	remoteKeys := []*ethpbservice.ImportRemoteKeysRequest_Keystore{
-		{
-			Pubkey: bytevalue,
-		},
-	}
+	pubkey := "0x93247f2209abcacf57b75a51dafae777f9dd38bc7053d1af526f220a7489a6d3a2753e5f3e8b1cfe39b56f43611df74a"
 
 	t.Run("returns proper data with existing pub keystores", func(t *testing.T) {
-		resp, err := s.ImportRemoteKeys(context.Background(), &ethpbservice.ImportRemoteKeysRequest{
-			RemoteKeys: remoteKeys,
-		})
-		expectedStatuses := []*ethpbservice.ImportedRemoteKeysStatus{
+		var body bytes.Buffer
+		require.NoError(t, json.NewEncoder(&body).Encode(&ImportRemoteKeysRequest{
+			RemoteKeys: []*RemoteKey{{Pubkey: pubkey}},
+		}))
+		request := httptest.NewRequest(http.MethodPost, "http://example.com/eth/v1/remotekeys", &body)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+		s.ImportRemoteKeys(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		resp := &RemoteKeysResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		expectedStatuses := []*Status{
 			{
-				Status:  ethpbservice.ImportedRemoteKeysStatus_IMPORTED,
-				Message: fmt.Sprintf("Successfully added pubkey: %v", hexutil.Encode(bytevalue)),
+				Status:  "imported",
+				Message: fmt.Sprintf("Successfully added pubkey: %v", pubkey),
 			},
 		}
-		require.NoError(t, err)
+		require.Equal(t, len(expectedStatuses), len(resp.Data))
 		for i := 0; i < len(resp.Data); i++ {
 			require.DeepEqual(t, expectedStatuses[i], resp.Data[i])
 		}
 	})
+	t.Run("invalid pubkey", func(t *testing.T) {
+		var body bytes.Buffer
+		require.NoError(t, json.NewEncoder(&body).Encode(&ImportRemoteKeysRequest{
+			RemoteKeys: []*RemoteKey{{Pubkey: "0x1234"}},
+		}))
+		request := httptest.NewRequest(http.MethodPost, "http://example.com/eth/v1/remotekeys", &body)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+		s.ImportRemoteKeys(writer, request)
+		require.Equal(t, http.StatusBadRequest, writer.Code)
+		e := &http2.DefaultErrorJson{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), e))
+		assert.StringContains(t, "Invalid remote_keys[0].pubkey", e.Message)
+	})
 }
 
 func TestServer_DeleteRemoteKeys(t *testing.T) {
 	t.Run("wallet not ready", func(t *testing.T) {
 		s := Server{}
-		_, err := s.ListKeystores(context.Background(), &empty.Empty{})
-		require.ErrorContains(t, "Prysm Wallet not initialized. Please create a new wallet.", err)
+		request := httptest.NewRequest(http.MethodDelete, "http://example.com/eth/v1/remotekeys", nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+		s.DeleteRemoteKeys(writer, request)
+		require.Equal(t, http.StatusServiceUnavailable, writer.Code)
+		e := &http2.DefaultErrorJson{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), e))
+		assert.StringContains(t, "Prysm Wallet not initialized. Please create a new wallet.", e.Message)
 	})
 	ctx := context.Background()
 	w := wallet.NewWalletForWeb3Signer()
 	root := make([]byte, fieldparams.RootLength)
 	root[0] = 1
-	bytevalue, err := hexutil.Decode("0x93247f2209abcacf57b75a51dafae777f9dd38bc7053d1af526f220a7489a6d3a2753e5f3e8b1cfe39b56f43611df74a")
+	pubkey := "0x93247f2209abcacf57b75a51dafae777f9dd38bc7053d1af526f220a7489a6d3a2753e5f3e8b1cfe39b56f43611df74a"
+	bytevalue, err := hexutil.Decode(pubkey)
 	require.NoError(t, err)
 	pubkeys := [][fieldparams.BLSPubkeyLength]byte{bytesutil.ToBytes48(bytevalue)}
 	config := &remoteweb3signer.SetupConfig{
@@ -682,19 +817,26 @@ func TestServer_DeleteRemoteKeys(t *testing.T) {
 	}
 
 	t.Run("returns proper data with existing pub keystores", func(t *testing.T) {
-		resp, err := s.DeleteRemoteKeys(context.Background(), &ethpbservice.DeleteRemoteKeysRequest{
-			Pubkeys: [][]byte{bytevalue},
-		})
-		expectedStatuses := []*ethpbservice.DeletedRemoteKeysStatus{
+		var body bytes.Buffer
+		require.NoError(t, json.NewEncoder(&body).Encode(&DeleteRemoteKeysRequest{
+			Pubkeys: []string{pubkey},
+		}))
+		request := httptest.NewRequest(http.MethodDelete, "http://example.com/eth/v1/remotekeys", &body)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+		s.DeleteRemoteKeys(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		resp := &RemoteKeysResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		expectedStatuses := []*Status{
 			{
-				Status:  ethpbservice.DeletedRemoteKeysStatus_DELETED,
-				Message: fmt.Sprintf("Successfully deleted pubkey: %v", hexutil.Encode(bytevalue)),
+				Status:  "deleted",
+				Message: fmt.Sprintf("Successfully deleted pubkey: %v", pubkey),
 			},
 		}
-		require.NoError(t, err)
+		require.Equal(t, len(expectedStatuses), len(resp.Data))
 		for i := 0; i < len(resp.Data); i++ {
 			require.DeepEqual(t, expectedStatuses[i], resp.Data[i])
-
 		}
 		expectedKeys, err := km.FetchValidatingPublicKeys(ctx)
 		require.NoError(t, err)
@@ -704,7 +846,8 @@ func TestServer_DeleteRemoteKeys(t *testing.T) {
 
 func TestServer_ListFeeRecipientByPubkey(t *testing.T) {
 	ctx := context.Background()
-	byteval, err := hexutil.Decode("0xaf2e7ba294e03438ea819bd4033c6c1bf6b04320ee2075b77273c08d02f8a61bcc303c2c06bd3713cb442072ae591493")
+	pubkey := "0xaf2e7ba294e03438ea819bd4033c6c1bf6b04320ee2075b77273c08d02f8a61bcc303c2c06bd3713cb442072ae591493"
+	byteval, err := hexutil.Decode(pubkey)
 	require.NoError(t, err)
 
 	type want struct {
@@ -785,18 +928,23 @@ func TestServer_ListFeeRecipientByPubkey(t *testing.T) {
 				beaconNodeValidatorClient: mockValidatorClient,
 			}
 
-			got, err := s.ListFeeRecipientByPubkey(ctx, &ethpbservice.PubkeyRequest{Pubkey: byteval})
-			require.NoError(t, err)
-
-			assert.Equal(t, tt.want.EthAddress, common.BytesToAddress(got.Data.Ethaddress).Hex())
+			request := httptest.NewRequest(http.MethodGet, fmt.Sprintf("http://example.com/eth/v1/validator/%s/feerecipient", pubkey), nil)
+			request = mux.SetURLVars(request, map[string]string{"pubkey": pubkey})
+			writer := httptest.NewRecorder()
+			writer.Body = &bytes.Buffer{}
+			s.ListFeeRecipientByPubkey(writer, request)
+			require.Equal(t, http.StatusOK, writer.Code)
+			resp := &GetFeeRecipientByPubkeyResponse{}
+			require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+			assert.Equal(t, pubkey, resp.Data.Pubkey)
+			assert.Equal(t, tt.want.EthAddress, resp.Data.Ethaddress)
 		})
 	}
 }
 
 func TestServer_ListFeeRecipientByPubKey_BeaconNodeError(t *testing.T) {
 	ctx := context.Background()
-	byteval, err := hexutil.Decode("0xaf2e7ba294e03438ea819bd4033c6c1bf6b04320ee2075b77273c08d02f8a61bcc303c2c06bd3713cb442072ae591493")
-	require.NoError(t, err)
+	pubkey := "0xaf2e7ba294e03438ea819bd4033c6c1bf6b04320ee2075b77273c08d02f8a61bcc303c2c06bd3713cb442072ae591493"
 
 	ctrl := gomock.NewController(t)
 	mockValidatorClient := validatormock.NewMockValidatorClient(ctrl)
@@ -813,14 +961,20 @@ func TestServer_ListFeeRecipientByPubKey_BeaconNodeError(t *testing.T) {
 		beaconNodeValidatorClient: mockValidatorClient,
 	}
 
-	_, err = s.ListFeeRecipientByPubkey(ctx, &ethpbservice.PubkeyRequest{Pubkey: byteval})
-	require.ErrorContains(t, "Failed to retrieve default fee recipient from beacon node", err)
+	request := httptest.NewRequest(http.MethodGet, fmt.Sprintf("http://example.com/eth/v1/validator/%s/feerecipient", pubkey), nil)
+	request = mux.SetURLVars(request, map[string]string{"pubkey": pubkey})
+	writer := httptest.NewRecorder()
+	writer.Body = &bytes.Buffer{}
+	s.ListFeeRecipientByPubkey(writer, request)
+	require.Equal(t, http.StatusInternalServerError, writer.Code)
+	e := &http2.DefaultErrorJson{}
+	require.NoError(t, json.Unmarshal(writer.Body.Bytes(), e))
+	assert.StringContains(t, "Failed to retrieve default fee recipient from beacon node", e.Message)
 }
 
 func TestServer_ListFeeRecipientByPubKey_NoFeeRecipientSet(t *testing.T) {
 	ctx := context.Background()
-	byteval, err := hexutil.Decode("0xaf2e7ba294e03438ea819bd4033c6c1bf6b04320ee2075b77273c08d02f8a61bcc303c2c06bd3713cb442072ae591493")
-	require.NoError(t, err)
+	pubkey := "0xaf2e7ba294e03438ea819bd4033c6c1bf6b04320ee2075b77273c08d02f8a61bcc303c2c06bd3713cb442072ae591493"
 
 	ctrl := gomock.NewController(t)
 	mockValidatorClient := validatormock.NewMockValidatorClient(ctrl)
@@ -837,31 +991,44 @@ func TestServer_ListFeeRecipientByPubKey_NoFeeRecipientSet(t *testing.T) {
 		beaconNodeValidatorClient: mockValidatorClient,
 	}
 
-	_, err = s.ListFeeRecipientByPubkey(ctx, &ethpbservice.PubkeyRequest{Pubkey: byteval})
-	require.ErrorContains(t, "No fee recipient set", err)
+	request := httptest.NewRequest(http.MethodGet, fmt.Sprintf("http://example.com/eth/v1/validator/%s/feerecipient", pubkey), nil)
+	request = mux.SetURLVars(request, map[string]string{"pubkey": pubkey})
+	writer := httptest.NewRecorder()
+	writer.Body = &bytes.Buffer{}
+	s.ListFeeRecipientByPubkey(writer, request)
+	require.Equal(t, http.StatusBadRequest, writer.Code)
+	e := &http2.DefaultErrorJson{}
+	require.NoError(t, json.Unmarshal(writer.Body.Bytes(), e))
+	assert.StringContains(t, "No fee recipient set", e.Message)
 }
 
 func TestServer_ListFeeRecipientByPubkey_ValidatorServiceNil(t *testing.T) {
-	ctx := grpc.NewContextWithServerTransportStream(context.Background(), &runtime.ServerTransportStream{})
-
 	s := &Server{}
 
-	_, err := s.ListFeeRecipientByPubkey(ctx, nil)
-	require.ErrorContains(t, "Validator service not ready", err)
+	request := httptest.NewRequest(http.MethodGet, "http://example.com/eth/v1/validator/0x00/feerecipient", nil)
+	writer := httptest.NewRecorder()
+	writer.Body = &bytes.Buffer{}
+	s.ListFeeRecipientByPubkey(writer, request)
+	require.Equal(t, http.StatusServiceUnavailable, writer.Code)
+	e := &http2.DefaultErrorJson{}
+	require.NoError(t, json.Unmarshal(writer.Body.Bytes(), e))
+	assert.StringContains(t, "Validator service not ready", e.Message)
 }
 
 func TestServer_ListFeeRecipientByPubkey_InvalidPubKey(t *testing.T) {
-	ctx := grpc.NewContextWithServerTransportStream(context.Background(), &runtime.ServerTransportStream{})
 	s := &Server{
 		validatorService: &client.ValidatorService{},
 	}
 
-	req := &ethpbservice.PubkeyRequest{
-		Pubkey: []byte{},
-	}
-
-	_, err := s.ListFeeRecipientByPubkey(ctx, req)
-	require.ErrorContains(t, "not a valid bls public key", err)
+	request := httptest.NewRequest(http.MethodGet, "http://example.com/eth/v1/validator/0x00/feerecipient", nil)
+	request = mux.SetURLVars(request, map[string]string{"pubkey": "0x00"})
+	writer := httptest.NewRecorder()
+	writer.Body = &bytes.Buffer{}
+	s.ListFeeRecipientByPubkey(writer, request)
+	require.Equal(t, http.StatusBadRequest, writer.Code)
+	e := &http2.DefaultErrorJson{}
+	require.NoError(t, json.Unmarshal(writer.Body.Bytes(), e))
+	assert.StringContains(t, "Invalid pubkey", e.Message)
 }
 
 func TestServer_FeeRecipientByPubkey(t *testing.T) {
@@ -869,9 +1036,10 @@ func TestServer_FeeRecipientByPubkey(t *testing.T) {
 	defer ctrl.Finish()
 
 	beaconClient := validatormock.NewMockValidatorClient(ctrl)
-	ctx := grpc.NewContextWithServerTransportStream(context.Background(), &runtime.ServerTransportStream{})
+	ctx := context.Background()
 
-	byteval, err := hexutil.Decode("0xaf2e7ba294e03438ea819bd4033c6c1bf6b04320ee2075b77273c08d02f8a61bcc303c2c06bd3713cb442072ae591493")
+	pubkey := "0xaf2e7ba294e03438ea819bd4033c6c1bf6b04320ee2075b77273c08d02f8a61bcc303c2c06bd3713cb442072ae591493"
+	byteval, err := hexutil.Decode(pubkey)
 	require.NoError(t, err)
 
 	type want struct {
@@ -1021,8 +1189,14 @@ func TestServer_FeeRecipientByPubkey(t *testing.T) {
 				valDB:                     validatorDB,
 			}
 
-			_, err = s.SetFeeRecipientByPubkey(ctx, &ethpbservice.SetFeeRecipientByPubkeyRequest{Pubkey: byteval, Ethaddress: common.HexToAddress(tt.args).Bytes()})
-			require.NoError(t, err)
+			var body bytes.Buffer
+			require.NoError(t, json.NewEncoder(&body).Encode(&SetFeeRecipientByPubkeyRequest{Ethaddress: tt.args}))
+			request := httptest.NewRequest(http.MethodPost, fmt.Sprintf("http://example.com/eth/v1/validator/%s/feerecipient", pubkey), &body)
+			request = mux.SetURLVars(request, map[string]string{"pubkey": pubkey})
+			writer := httptest.NewRecorder()
+			writer.Body = &bytes.Buffer{}
+			s.SetFeeRecipientByPubkey(writer, request)
+			require.Equal(t, http.StatusAccepted, writer.Code)
 
 			assert.Equal(t, tt.want.valEthAddress, s.validatorService.ProposerSettings().ProposeConfig[bytesutil.ToBytes48(byteval)].FeeRecipientConfig.FeeRecipient.Hex())
 		})
@@ -1030,49 +1204,58 @@ func TestServer_FeeRecipientByPubkey(t *testing.T) {
 }
 
 func TestServer_SetFeeRecipientByPubkey_ValidatorServiceNil(t *testing.T) {
-	ctx := grpc.NewContextWithServerTransportStream(context.Background(), &runtime.ServerTransportStream{})
-
 	s := &Server{}
 
-	_, err := s.SetFeeRecipientByPubkey(ctx, nil)
-	require.ErrorContains(t, "Validator service not ready", err)
+	request := httptest.NewRequest(http.MethodPost, "http://example.com/eth/v1/validator/0x00/feerecipient", nil)
+	writer := httptest.NewRecorder()
+	writer.Body = &bytes.Buffer{}
+	s.SetFeeRecipientByPubkey(writer, request)
+	require.Equal(t, http.StatusServiceUnavailable, writer.Code)
+	e := &http2.DefaultErrorJson{}
+	require.NoError(t, json.Unmarshal(writer.Body.Bytes(), e))
+	assert.StringContains(t, "Validator service not ready", e.Message)
 }
 
 func TestServer_SetFeeRecipientByPubkey_InvalidPubKey(t *testing.T) {
-	ctx := grpc.NewContextWithServerTransportStream(context.Background(), &runtime.ServerTransportStream{})
 	s := &Server{
 		validatorService: &client.ValidatorService{},
 	}
 
-	req := &ethpbservice.SetFeeRecipientByPubkeyRequest{
-		Pubkey: []byte{},
-	}
-
-	_, err := s.SetFeeRecipientByPubkey(ctx, req)
-	require.ErrorContains(t, "not a valid bls public key", err)
+	request := httptest.NewRequest(http.MethodPost, "http://example.com/eth/v1/validator/0x00/feerecipient", nil)
+	request = mux.SetURLVars(request, map[string]string{"pubkey": "0x00"})
+	writer := httptest.NewRecorder()
+	writer.Body = &bytes.Buffer{}
+	s.SetFeeRecipientByPubkey(writer, request)
+	require.Equal(t, http.StatusBadRequest, writer.Code)
+	e := &http2.DefaultErrorJson{}
+	require.NoError(t, json.Unmarshal(writer.Body.Bytes(), e))
+	assert.StringContains(t, "Invalid pubkey", e.Message)
 }
 
-func TestServer_SetGasLimit_InvalidFeeRecipient(t *testing.T) {
-	ctx := grpc.NewContextWithServerTransportStream(context.Background(), &runtime.ServerTransportStream{})
-
-	byteval, err := hexutil.Decode("0xaf2e7ba294e03438ea819bd4033c6c1bf6b04320ee2075b77273c08d02f8a61bcc303c2c06bd3713cb442072ae591493")
-	require.NoError(t, err)
+func TestServer_SetFeeRecipientByPubkey_InvalidFeeRecipient(t *testing.T) {
+	pubkey := "0xaf2e7ba294e03438ea819bd4033c6c1bf6b04320ee2075b77273c08d02f8a61bcc303c2c06bd3713cb442072ae591493"
 
 	s := &Server{
 		validatorService: &client.ValidatorService{},
 	}
 
-	req := &ethpbservice.SetFeeRecipientByPubkeyRequest{
-		Pubkey: byteval,
-	}
-
-	_, err = s.SetFeeRecipientByPubkey(ctx, req)
-	require.ErrorContains(t, "Fee recipient is not a valid Ethereum address", err)
+	var body bytes.Buffer
+	require.NoError(t, json.NewEncoder(&body).Encode(&SetFeeRecipientByPubkeyRequest{Ethaddress: "0x1234"}))
+	request := httptest.NewRequest(http.MethodPost, fmt.Sprintf("http://example.com/eth/v1/validator/%s/feerecipient", pubkey), &body)
+	request = mux.SetURLVars(request, map[string]string{"pubkey": pubkey})
+	writer := httptest.NewRecorder()
+	writer.Body = &bytes.Buffer{}
+	s.SetFeeRecipientByPubkey(writer, request)
+	require.Equal(t, http.StatusBadRequest, writer.Code)
+	e := &http2.DefaultErrorJson{}
+	require.NoError(t, json.Unmarshal(writer.Body.Bytes(), e))
+	assert.StringContains(t, "Invalid Ethereum address", e.Message)
 }
 
 func TestServer_DeleteFeeRecipientByPubkey(t *testing.T) {
-	ctx := grpc.NewContextWithServerTransportStream(context.Background(), &runtime.ServerTransportStream{})
-	byteval, err := hexutil.Decode("0xaf2e7ba294e03438ea819bd4033c6c1bf6b04320ee2075b77273c08d02f8a61bcc303c2c06bd3713cb442072ae591493")
+	ctx := context.Background()
+	pubkey := "0xaf2e7ba294e03438ea819bd4033c6c1bf6b04320ee2075b77273c08d02f8a61bcc303c2c06bd3713cb442072ae591493"
+	byteval, err := hexutil.Decode(pubkey)
 	require.NoError(t, err)
 	type want struct {
 		EthAddress string
@@ -1120,8 +1303,12 @@ func TestServer_DeleteFeeRecipientByPubkey(t *testing.T) {
 				validatorService: vs,
 				valDB:            validatorDB,
 			}
-			_, err = s.DeleteFeeRecipientByPubkey(ctx, &ethpbservice.PubkeyRequest{Pubkey: byteval})
-			require.NoError(t, err)
+			request := httptest.NewRequest(http.MethodDelete, fmt.Sprintf("http://example.com/eth/v1/validator/%s/feerecipient", pubkey), nil)
+			request = mux.SetURLVars(request, map[string]string{"pubkey": pubkey})
+			writer := httptest.NewRecorder()
+			writer.Body = &bytes.Buffer{}
+			s.DeleteFeeRecipientByPubkey(writer, request)
+			require.Equal(t, http.StatusNoContent, writer.Code)
 
 			assert.Equal(t, true, s.validatorService.ProposerSettings().ProposeConfig[bytesutil.ToBytes48(byteval)].FeeRecipientConfig == nil)
 		})
@@ -1129,26 +1316,32 @@ func TestServer_DeleteFeeRecipientByPubkey(t *testing.T) {
 }
 
 func TestServer_DeleteFeeRecipientByPubkey_ValidatorServiceNil(t *testing.T) {
-	ctx := grpc.NewContextWithServerTransportStream(context.Background(), &runtime.ServerTransportStream{})
-
 	s := &Server{}
 
-	_, err := s.DeleteFeeRecipientByPubkey(ctx, nil)
-	require.ErrorContains(t, "Validator service not ready", err)
+	request := httptest.NewRequest(http.MethodDelete, "http://example.com/eth/v1/validator/0x00/feerecipient", nil)
+	writer := httptest.NewRecorder()
+	writer.Body = &bytes.Buffer{}
+	s.DeleteFeeRecipientByPubkey(writer, request)
+	require.Equal(t, http.StatusServiceUnavailable, writer.Code)
+	e := &http2.DefaultErrorJson{}
+	require.NoError(t, json.Unmarshal(writer.Body.Bytes(), e))
+	assert.StringContains(t, "Validator service not ready", e.Message)
 }
 
 func TestServer_DeleteFeeRecipientByPubkey_InvalidPubKey(t *testing.T) {
-	ctx := grpc.NewContextWithServerTransportStream(context.Background(), &runtime.ServerTransportStream{})
 	s := &Server{
 		validatorService: &client.ValidatorService{},
 	}
 
-	req := &ethpbservice.PubkeyRequest{
-		Pubkey: []byte{},
-	}
-
-	_, err := s.DeleteFeeRecipientByPubkey(ctx, req)
-	require.ErrorContains(t, "not a valid bls public key", err)
+	request := httptest.NewRequest(http.MethodDelete, "http://example.com/eth/v1/validator/0x00/feerecipient", nil)
+	request = mux.SetURLVars(request, map[string]string{"pubkey": "0x00"})
+	writer := httptest.NewRecorder()
+	writer.Body = &bytes.Buffer{}
+	s.DeleteFeeRecipientByPubkey(writer, request)
+	require.Equal(t, http.StatusBadRequest, writer.Code)
+	e := &http2.DefaultErrorJson{}
+	require.NoError(t, json.Unmarshal(writer.Body.Bytes(), e))
+	assert.StringContains(t, "Invalid pubkey", e.Message)
 }
 
 func TestServer_GetGasLimit(t *testing.T) {
@@ -1214,9 +1407,17 @@ func TestServer_GetGasLimit(t *testing.T) {
 			s := &Server{
 				validatorService: vs,
 			}
-			got, err := s.GetGasLimit(ctx, &ethpbservice.PubkeyRequest{Pubkey: tt.pubkey[:]})
-			require.NoError(t, err)
-			assert.Equal(t, tt.want, got.Data.GasLimit)
+			pubkey := hexutil.Encode(tt.pubkey[:])
+			request := httptest.NewRequest(http.MethodGet, fmt.Sprintf("http://example.com/eth/v1/validator/%s/gas_limit", pubkey), nil)
+			request = mux.SetURLVars(request, map[string]string{"pubkey": pubkey})
+			writer := httptest.NewRecorder()
+			writer.Body = &bytes.Buffer{}
+			s.GetGasLimit(writer, request)
+			require.Equal(t, http.StatusOK, writer.Code)
+			resp := &GetGasLimitResponse{}
+			require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+			assert.Equal(t, pubkey, resp.Data.Pubkey)
+			assert.Equal(t, strconv.FormatUint(tt.want, 10), resp.Data.GasLimit)
 		})
 	}
 }
@@ -1225,7 +1426,7 @@ func TestServer_SetGasLimit(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 	beaconClient := validatormock.NewMockValidatorClient(ctrl)
-	ctx := grpc.NewContextWithServerTransportStream(context.Background(), &runtime.ServerTransportStream{})
+	ctx := context.Background()
 
 	pubkey1, err := hexutil.Decode("0xaf2e7ba294e03438ea819bd4033c6c1bf6b04320ee2075b77273c08d02f8a61bcc303c2c06bd3713cb442072ae591493")
 	pubkey2, err2 := hexutil.Decode("0xbedefeaa94e03438ea819bd4033c6c1bf6b04320ee2075b77273c08d02f8a61bcc303c2cdddddddddddddddddddddddd")
@@ -1256,7 +1457,7 @@ func TestServer_SetGasLimit(t *testing.T) {
 			pubkey:           pubkey1,
 			newGasLimit:      9999,
 			proposerSettings: nil,
-			wantErr:          "no proposer settings were found to update",
+			wantErr:          "No proposer settings were found to update",
 		},
 		{
 			name:        "ProposerSettings.ProposeConfig is nil AND ProposerSettings.DefaultConfig is nil",
@@ -1266,7 +1467,7 @@ func TestServer_SetGasLimit(t *testing.T) {
 				ProposeConfig: nil,
 				DefaultConfig: nil,
 			},
-			wantErr: "gas limit changes only apply when builder is enabled",
+			wantErr: "Gas limit changes only apply when builder is enabled",
 		},
 		{
 			name:        "ProposerSettings.ProposeConfig is nil AND ProposerSettings.DefaultConfig.BuilderConfig is nil",
@@ -1278,7 +1479,7 @@ func TestServer_SetGasLimit(t *testing.T) {
 					BuilderConfig: nil,
 				},
 			},
-			wantErr: "gas limit changes only apply when builder is enabled",
+			wantErr: "Gas limit changes only apply when builder is enabled",
 		},
 		{
 			name:        "ProposerSettings.ProposeConfig is defined for pubkey, BuilderConfig is nil AND ProposerSettings.DefaultConfig is nil",
@@ -1292,7 +1493,7 @@ func TestServer_SetGasLimit(t *testing.T) {
 				},
 				DefaultConfig: nil,
 			},
-			wantErr: "gas limit changes only apply when builder is enabled",
+			wantErr: "Gas limit changes only apply when builder is enabled",
 		},
 		{
 			name:        "ProposerSettings.ProposeConfig is defined for pubkey, BuilderConfig is defined AND ProposerSettings.DefaultConfig is nil",
@@ -1306,7 +1507,7 @@ func TestServer_SetGasLimit(t *testing.T) {
 				},
 				DefaultConfig: nil,
 			},
-			wantErr: "gas limit changes only apply when builder is enabled",
+			wantErr: "Gas limit changes only apply when builder is enabled",
 		},
 		{
 			name:        "ProposerSettings.ProposeConfig is NOT defined for pubkey, BuilderConfig is defined AND ProposerSettings.DefaultConfig is nil",
@@ -1377,11 +1578,21 @@ func TestServer_SetGasLimit(t *testing.T) {
 				).Return(tt.beaconReturn.resp, tt.beaconReturn.error)
 			}
 
-			_, err = s.SetGasLimit(ctx, &ethpbservice.SetGasLimitRequest{Pubkey: tt.pubkey, GasLimit: tt.newGasLimit})
+			pubkey := hexutil.Encode(tt.pubkey)
+			var body bytes.Buffer
+			require.NoError(t, json.NewEncoder(&body).Encode(&SetGasLimitRequest{GasLimit: strconv.FormatUint(tt.newGasLimit, 10)}))
+			request := httptest.NewRequest(http.MethodPost, fmt.Sprintf("http://example.com/eth/v1/validator/%s/gas_limit", pubkey), &body)
+			request = mux.SetURLVars(request, map[string]string{"pubkey": pubkey})
+			writer := httptest.NewRecorder()
+			writer.Body = &bytes.Buffer{}
+			s.SetGasLimit(writer, request)
 			if tt.wantErr != "" {
-				require.ErrorContains(t, tt.wantErr, err)
+				require.Equal(t, http.StatusBadRequest, writer.Code)
+				e := &http2.DefaultErrorJson{}
+				require.NoError(t, json.Unmarshal(writer.Body.Bytes(), e))
+				assert.StringContains(t, tt.wantErr, e.Message)
 			} else {
-				require.NoError(t, err)
+				require.Equal(t, http.StatusAccepted, writer.Code)
 				for _, w := range tt.w {
 					assert.Equal(t, w.gaslimit, uint64(s.validatorService.ProposerSettings().ProposeConfig[bytesutil.ToBytes48(w.pubkey)].BuilderConfig.GasLimit))
 				}
@@ -1391,30 +1602,55 @@ func TestServer_SetGasLimit(t *testing.T) {
 }
 
 func TestServer_SetGasLimit_ValidatorServiceNil(t *testing.T) {
-	ctx := grpc.NewContextWithServerTransportStream(context.Background(), &runtime.ServerTransportStream{})
-
 	s := &Server{}
 
-	_, err := s.SetGasLimit(ctx, nil)
-	require.ErrorContains(t, "Validator service not ready", err)
+	request := httptest.NewRequest(http.MethodPost, "http://example.com/eth/v1/validator/0x00/gas_limit", nil)
+	writer := httptest.NewRecorder()
+	writer.Body = &bytes.Buffer{}
+	s.SetGasLimit(writer, request)
+	require.Equal(t, http.StatusServiceUnavailable, writer.Code)
+	e := &http2.DefaultErrorJson{}
+	require.NoError(t, json.Unmarshal(writer.Body.Bytes(), e))
+	assert.StringContains(t, "Validator service not ready", e.Message)
 }
 
 func TestServer_SetGasLimit_InvalidPubKey(t *testing.T) {
-	ctx := grpc.NewContextWithServerTransportStream(context.Background(), &runtime.ServerTransportStream{})
 	s := &Server{
 		validatorService: &client.ValidatorService{},
 	}
 
-	req := &ethpbservice.SetGasLimitRequest{
-		Pubkey: []byte{},
+	request := httptest.NewRequest(http.MethodPost, "http://example.com/eth/v1/validator/0x00/gas_limit", nil)
+	request = mux.SetURLVars(request, map[string]string{"pubkey": "0x00"})
+	writer := httptest.NewRecorder()
+	writer.Body = &bytes.Buffer{}
+	s.SetGasLimit(writer, request)
+	require.Equal(t, http.StatusBadRequest, writer.Code)
+	e := &http2.DefaultErrorJson{}
+	require.NoError(t, json.Unmarshal(writer.Body.Bytes(), e))
+	assert.StringContains(t, "Invalid pubkey", e.Message)
+}
+
+func TestServer_SetGasLimit_InvalidGasLimit(t *testing.T) {
+	pubkey := "0xaf2e7ba294e03438ea819bd4033c6c1bf6b04320ee2075b77273c08d02f8a61bcc303c2c06bd3713cb442072ae591493"
+	s := &Server{
+		validatorService: &client.ValidatorService{},
 	}
 
-	_, err := s.SetGasLimit(ctx, req)
-	require.ErrorContains(t, "not a valid bls public key", err)
+	var body bytes.Buffer
+	require.NoError(t, json.NewEncoder(&body).Encode(&SetGasLimitRequest{GasLimit: "foo"}))
+	request := httptest.NewRequest(http.MethodPost, fmt.Sprintf("http://example.com/eth/v1/validator/%s/gas_limit", pubkey), &body)
+	request = mux.SetURLVars(request, map[string]string{"pubkey": pubkey})
+	writer := httptest.NewRecorder()
+	writer.Body = &bytes.Buffer{}
+	s.SetGasLimit(writer, request)
+	require.Equal(t, http.StatusBadRequest, writer.Code)
+	e := &http2.DefaultErrorJson{}
+	require.NoError(t, json.Unmarshal(writer.Body.Bytes(), e))
+	assert.StringContains(t, "gas_limit is invalid", e.Message)
 }
 
 func TestServer_DeleteGasLimit(t *testing.T) {
-	ctx := grpc.NewContextWithServerTransportStream(context.Background(), &runtime.ServerTransportStream{})
+	ctx := context.Background()
 	pubkey1, err := hexutil.Decode("0xaf2e7ba294e03438ea819bd4033c6c1bf6b04320ee2075b77273c08d02f8a61bcc303c2c06bd3713cb442072ae591493")
 	pubkey2, err2 := hexutil.Decode("0xbedefeaa94e03438ea819bd4033c6c1bf6b04320ee2075b77273c08d02f8a61bcc303c2cdddddddddddddddddddddddd")
 	require.NoError(t, err)
@@ -1438,7 +1674,7 @@ func TestServer_DeleteGasLimit(t *testing.T) {
 		name             string
 		pubkey           []byte
 		proposerSettings *validatorserviceconfig.ProposerSettings
-		wantError        error
+		wantError        int
 		w                []want
 	}{
 		{
@@ -1457,7 +1693,6 @@ func TestServer_DeleteGasLimit(t *testing.T) {
 					BuilderConfig: &validatorserviceconfig.BuilderConfig{GasLimit: validator.Uint64(5555)},
 				},
 			},
-			wantError: nil,
 			w: []want{
 				{
 					pubkey: pubkey1,
@@ -1483,7 +1718,6 @@ func TestServer_DeleteGasLimit(t *testing.T) {
 					},
 				},
 			},
-			wantError: nil,
 			w: []want{
 				{
 					pubkey: pubkey1,
@@ -1506,7 +1740,7 @@ func TestServer_DeleteGasLimit(t *testing.T) {
 					},
 				},
 			},
-			wantError: fmt.Errorf("%s", codes.NotFound.String()),
+			wantError: http.StatusNotFound,
 			w: []want{
 				// pubkey1's gaslimit is unaffected
 				{
@@ -1518,7 +1752,7 @@ func TestServer_DeleteGasLimit(t *testing.T) {
 		{
 			name:      "delete nonexist gas limit 2",
 			pubkey:    pubkey2,
-			wantError: fmt.Errorf("%s", codes.NotFound.String()),
+			wantError: http.StatusNotFound,
 			w:         []want{},
 		},
 	}
@@ -1539,11 +1773,20 @@ func TestServer_DeleteGasLimit(t *testing.T) {
 			}
 			// Set up global default value for builder gas limit.
 			params.BeaconConfig().DefaultBuilderGasLimit = uint64(globalDefaultGasLimit)
-			_, err = s.DeleteGasLimit(ctx, &ethpbservice.DeleteGasLimitRequest{Pubkey: tt.pubkey})
-			if tt.wantError != nil {
-				assert.ErrorContains(t, fmt.Sprintf("code = %s", tt.wantError.Error()), err)
+
+			pubkey := hexutil.Encode(tt.pubkey)
+			request := httptest.NewRequest(http.MethodDelete, fmt.Sprintf("http://example.com/eth/v1/validator/%s/gas_limit", pubkey), nil)
+			request = mux.SetURLVars(request, map[string]string{"pubkey": pubkey})
+			writer := httptest.NewRecorder()
+			writer.Body = &bytes.Buffer{}
+			s.DeleteGasLimit(writer, request)
+			if tt.wantError != 0 {
+				require.Equal(t, tt.wantError, writer.Code)
+				e := &http2.DefaultErrorJson{}
+				require.NoError(t, json.Unmarshal(writer.Body.Bytes(), e))
+				assert.StringContains(t, "No gas limit found for pubkey", e.Message)
 			} else {
-				require.NoError(t, err)
+				require.Equal(t, http.StatusNoContent, writer.Code)
 			}
 			for _, w := range tt.w {
 				assert.Equal(t, w.gaslimit, s.validatorService.ProposerSettings().ProposeConfig[bytesutil.ToBytes48(w.pubkey)].BuilderConfig.GasLimit)
@@ -1556,7 +1799,7 @@ func TestServer_SetVoluntaryExit(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
-	ctx := grpc.NewContextWithServerTransportStream(context.Background(), &runtime.ServerTransportStream{})
+	ctx := context.Background()
 	defaultWalletPath = setupWalletDir(t)
 	opts := []accounts.Option{
 		accounts.WithWalletDir(defaultWalletPath),
@@ -1643,24 +1886,50 @@ func TestServer_SetVoluntaryExit(t *testing.T) {
 			},
 		},
 	}
+	setVoluntaryExit := func(t *testing.T, epoch primitives.Epoch) *SetVoluntaryExitResponse {
+		pubkey := hexutil.Encode(pubKeys[0][:])
+		url := fmt.Sprintf("http://example.com/eth/v1/validator/%s/voluntary_exit", pubkey)
+		if epoch != 0 {
+			url += fmt.Sprintf("?epoch=%d", epoch)
+		}
+		request := httptest.NewRequest(http.MethodPost, url, nil)
+		request = mux.SetURLVars(request, map[string]string{"pubkey": pubkey})
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+		s.SetVoluntaryExit(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		resp := &SetVoluntaryExitResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		return resp
+	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			resp, err := s.SetVoluntaryExit(ctx, &ethpbservice.SetVoluntaryExitRequest{Pubkey: pubKeys[0][:], Epoch: tt.epoch})
-			require.NoError(t, err)
+			resp := setVoluntaryExit(t, tt.epoch)
 			if tt.w.epoch == 0 {
 				genesisResponse, err := s.beaconNodeClient.GetGenesis(ctx, &emptypb.Empty{})
 				require.NoError(t, err)
 				tt.w.epoch, err = client.CurrentEpoch(genesisResponse.GenesisTime)
 				require.NoError(t, err)
-				resp2, err := s.SetVoluntaryExit(ctx, &ethpbservice.SetVoluntaryExitRequest{Pubkey: pubKeys[0][:], Epoch: tt.epoch})
+				resp2 := setVoluntaryExit(t, tt.epoch)
+				tt.w.signature, err = hexutil.Decode(resp2.Data.Signature)
 				require.NoError(t, err)
-				tt.w.signature = resp2.Data.Signature
 			}
-			require.Equal(t, uint64(tt.w.epoch), resp.Data.Message.Epoch)
-			require.Equal(t, tt.w.validatorIndex, resp.Data.Message.ValidatorIndex)
+			require.Equal(t, strconv.FormatUint(uint64(tt.w.epoch), 10), resp.Data.Message.Epoch)
+			require.Equal(t, strconv.FormatUint(tt.w.validatorIndex, 10), resp.Data.Message.ValidatorIndex)
 			require.NotEmpty(t, resp.Data.Signature)
-			ok = bytes.Equal(tt.w.signature, resp.Data.Signature)
-			require.Equal(t, true, ok)
+			require.Equal(t, hexutil.Encode(tt.w.signature), resp.Data.Signature)
 		})
 	}
+	t.Run("invalid epoch", func(t *testing.T) {
+		pubkey := hexutil.Encode(pubKeys[0][:])
+		request := httptest.NewRequest(http.MethodPost, fmt.Sprintf("http://example.com/eth/v1/validator/%s/voluntary_exit?epoch=foo", pubkey), nil)
+		request = mux.SetURLVars(request, map[string]string{"pubkey": pubkey})
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+		s.SetVoluntaryExit(writer, request)
+		require.Equal(t, http.StatusBadRequest, writer.Code)
+		e := &http2.DefaultErrorJson{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), e))
+		assert.Equal(t, true, strings.Contains(e.Message, "epoch is invalid"))
+	})
 }
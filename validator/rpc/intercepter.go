@@ -3,9 +3,11 @@ package rpc
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"strings"
 
 	"github.com/golang-jwt/jwt/v4"
+	http2 "github.com/prysmaticlabs/prysm/v4/network/http"
 	"github.com/sirupsen/logrus"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
@@ -33,6 +35,27 @@ func (s *Server) JWTInterceptor() grpc.UnaryServerInterceptor {
 	}
 }
 
+// JWTHandler wraps an HTTP handler so that it is only called for requests carrying a valid bearer token.
+func (s *Server) JWTHandler(next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		authHeader := r.Header.Get("Authorization")
+		if authHeader == "" {
+			http2.HandleError(w, "Authorization token could not be found", http.StatusUnauthorized)
+			return
+		}
+		if !strings.Contains(authHeader, "Bearer ") {
+			http2.HandleError(w, "Invalid auth header, needs Bearer {token}", http.StatusUnauthorized)
+			return
+		}
+		token := strings.Split(authHeader, "Bearer ")[1]
+		if _, err := jwt.Parse(token, s.validateJWT); err != nil {
+			http2.HandleError(w, "Could not parse JWT token: "+err.Error(), http.StatusUnauthorized)
+			return
+		}
+		next(w, r)
+	}
+}
+
 // Authorize the token received is valid.
 func (s *Server) authorize(ctx context.Context) error {
 	md, ok := metadata.FromIncomingContext(ctx)
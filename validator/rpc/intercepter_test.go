@@ -2,6 +2,8 @@ package rpc
 
 import (
 	"context"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	"github.com/golang-jwt/jwt/v4"
@@ -67,3 +69,57 @@ func TestServer_JWTInterceptor_InvalidSigningType(t *testing.T) {
 	_, err := ss.validateJWT(token)
 	require.ErrorContains(t, "unexpected JWT signing method", err)
 }
+
+func TestServer_JWTHandler(t *testing.T) {
+	s := &Server{
+		jwtSecret: []byte("testKey"),
+	}
+	called := false
+	handler := s.JWTHandler(func(w http.ResponseWriter, r *http.Request) {
+		called = true
+		w.WriteHeader(http.StatusOK)
+	})
+
+	t.Run("valid token", func(t *testing.T) {
+		called = false
+		token, err := createTokenString(s.jwtSecret)
+		require.NoError(t, err)
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/eth/v1/keystores", nil)
+		request.Header.Set("Authorization", "Bearer "+token)
+		writer := httptest.NewRecorder()
+		handler(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		require.Equal(t, true, called)
+	})
+	t.Run("missing header", func(t *testing.T) {
+		called = false
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/eth/v1/keystores", nil)
+		writer := httptest.NewRecorder()
+		handler(writer, request)
+		require.Equal(t, http.StatusUnauthorized, writer.Code)
+		require.StringContains(t, "Authorization token could not be found", writer.Body.String())
+		require.Equal(t, false, called)
+	})
+	t.Run("not a bearer token", func(t *testing.T) {
+		called = false
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/eth/v1/keystores", nil)
+		request.Header.Set("Authorization", "Basic foo")
+		writer := httptest.NewRecorder()
+		handler(writer, request)
+		require.Equal(t, http.StatusUnauthorized, writer.Code)
+		require.StringContains(t, "Invalid auth header", writer.Body.String())
+		require.Equal(t, false, called)
+	})
+	t.Run("bad token", func(t *testing.T) {
+		called = false
+		token, err := createTokenString([]byte("badTestKey"))
+		require.NoError(t, err)
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/eth/v1/keystores", nil)
+		request.Header.Set("Authorization", "Bearer "+token)
+		writer := httptest.NewRecorder()
+		handler(writer, request)
+		require.Equal(t, http.StatusUnauthorized, writer.Code)
+		require.StringContains(t, "signature is invalid", writer.Body.String())
+		require.Equal(t, false, called)
+	})
+}
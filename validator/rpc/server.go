@@ -4,9 +4,11 @@ import (
 	"context"
 	"fmt"
 	"net"
+	"net/http"
 	"path/filepath"
 	"time"
 
+	"github.com/gorilla/mux"
 	middleware "github.com/grpc-ecosystem/go-grpc-middleware"
 	recovery "github.com/grpc-ecosystem/go-grpc-middleware/recovery"
 	grpcopentracing "github.com/grpc-ecosystem/go-grpc-middleware/tracing/opentracing"
@@ -14,7 +16,6 @@ import (
 	"github.com/prysmaticlabs/prysm/v4/async/event"
 	"github.com/prysmaticlabs/prysm/v4/io/logs"
 	"github.com/prysmaticlabs/prysm/v4/monitoring/tracing"
-	ethpbservice "github.com/prysmaticlabs/prysm/v4/proto/eth/service"
 	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
 	validatorpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1/validator-client"
 	"github.com/prysmaticlabs/prysm/v4/validator/accounts/wallet"
@@ -52,6 +53,7 @@ type Config struct {
 	WalletInitializedFeed    *event.Feed
 	NodeGatewayEndpoint      string
 	Wallet                   *wallet.Wallet
+	Router                   *mux.Router
 }
 
 // Server defining a gRPC server for the remote signer API.
@@ -93,12 +95,13 @@ type Server struct {
 	validatorGatewayPort      int
 	beaconApiEndpoint         string
 	beaconApiTimeout          time.Duration
+	router                    *mux.Router
 }
 
 // NewServer instantiates a new gRPC server.
 func NewServer(ctx context.Context, cfg *Config) *Server {
 	ctx, cancel := context.WithCancel(ctx)
-	return &Server{
+	server := &Server{
 		ctx:                      ctx,
 		cancel:                   cancel,
 		logsStreamer:             logs.NewStreamServer(),
@@ -126,7 +129,30 @@ func NewServer(ctx context.Context, cfg *Config) *Server {
 		validatorMonitoringPort:  cfg.ValidatorMonitoringPort,
 		validatorGatewayHost:     cfg.ValidatorGatewayHost,
 		validatorGatewayPort:     cfg.ValidatorGatewayPort,
+		router:                   cfg.Router,
 	}
+	if server.router == nil {
+		server.router = mux.NewRouter()
+	}
+	server.registerKeymanagerRoutes()
+	return server
+}
+
+// registerKeymanagerRoutes serves the standard validator keymanager API through native HTTP handlers.
+func (s *Server) registerKeymanagerRoutes() {
+	s.router.HandleFunc("/eth/v1/keystores", s.JWTHandler(s.ListKeystores)).Methods(http.MethodGet)
+	s.router.HandleFunc("/eth/v1/keystores", s.JWTHandler(s.ImportKeystores)).Methods(http.MethodPost)
+	s.router.HandleFunc("/eth/v1/keystores", s.JWTHandler(s.DeleteKeystores)).Methods(http.MethodDelete)
+	s.router.HandleFunc("/eth/v1/remotekeys", s.JWTHandler(s.ListRemoteKeys)).Methods(http.MethodGet)
+	s.router.HandleFunc("/eth/v1/remotekeys", s.JWTHandler(s.ImportRemoteKeys)).Methods(http.MethodPost)
+	s.router.HandleFunc("/eth/v1/remotekeys", s.JWTHandler(s.DeleteRemoteKeys)).Methods(http.MethodDelete)
+	s.router.HandleFunc("/eth/v1/validator/{pubkey}/feerecipient", s.JWTHandler(s.ListFeeRecipientByPubkey)).Methods(http.MethodGet)
+	s.router.HandleFunc("/eth/v1/validator/{pubkey}/feerecipient", s.JWTHandler(s.SetFeeRecipientByPubkey)).Methods(http.MethodPost)
+	s.router.HandleFunc("/eth/v1/validator/{pubkey}/feerecipient", s.JWTHandler(s.DeleteFeeRecipientByPubkey)).Methods(http.MethodDelete)
+	s.router.HandleFunc("/eth/v1/validator/{pubkey}/gas_limit", s.JWTHandler(s.GetGasLimit)).Methods(http.MethodGet)
+	s.router.HandleFunc("/eth/v1/validator/{pubkey}/gas_limit", s.JWTHandler(s.SetGasLimit)).Methods(http.MethodPost)
+	s.router.HandleFunc("/eth/v1/validator/{pubkey}/gas_limit", s.JWTHandler(s.DeleteGasLimit)).Methods(http.MethodDelete)
+	s.router.HandleFunc("/eth/v1/validator/{pubkey}/voluntary_exit", s.JWTHandler(s.SetVoluntaryExit)).Methods(http.MethodPost)
 }
 
 // Start the gRPC server.
@@ -179,7 +205,6 @@ func (s *Server) Start() {
 	validatorpb.RegisterHealthServer(s.grpcServer, s)
 	validatorpb.RegisterBeaconServer(s.grpcServer, s)
 	validatorpb.RegisterAccountsServer(s.grpcServer, s)
-	ethpbservice.RegisterKeyManagementServer(s.grpcServer, s)
 	validatorpb.RegisterSlashingProtectionServer(s.grpcServer, s)
 
 	go func() {
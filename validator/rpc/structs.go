@@ -0,0 +1,93 @@
+package rpc
+
+import (
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/rpc/eth/shared"
+)
+
+// local keymanager api
+type ListKeystoresResponse struct {
+	Data []*Keystore `json:"data"`
+}
+
+type Keystore struct {
+	ValidatingPubkey string `json:"validating_pubkey"`
+	DerivationPath   string `json:"derivation_path"`
+}
+
+type ImportKeystoresRequest struct {
+	Keystores          []string `json:"keystores"`
+	Passwords          []string `json:"passwords"`
+	SlashingProtection string   `json:"slashing_protection"`
+}
+
+type ImportKeystoresResponse struct {
+	Data []*Status `json:"data"`
+}
+
+type DeleteKeystoresRequest struct {
+	Pubkeys []string `json:"pubkeys"`
+}
+
+type DeleteKeystoresResponse struct {
+	Data               []*Status `json:"data"`
+	SlashingProtection string    `json:"slashing_protection"`
+}
+
+type Status struct {
+	Status  string `json:"status"`
+	Message string `json:"message"`
+}
+
+// remote keymanager api
+type ListRemoteKeysResponse struct {
+	Data []*RemoteKey `json:"data"`
+}
+
+type RemoteKey struct {
+	Pubkey   string `json:"pubkey"`
+	Url      string `json:"url"`
+	Readonly bool   `json:"readonly"`
+}
+
+type ImportRemoteKeysRequest struct {
+	RemoteKeys []*RemoteKey `json:"remote_keys"`
+}
+
+type DeleteRemoteKeysRequest struct {
+	Pubkeys []string `json:"pubkeys"`
+}
+
+type RemoteKeysResponse struct {
+	Data []*Status `json:"data"`
+}
+
+// proposer settings api
+type GetFeeRecipientByPubkeyResponse struct {
+	Data *FeeRecipient `json:"data"`
+}
+
+type FeeRecipient struct {
+	Pubkey     string `json:"pubkey"`
+	Ethaddress string `json:"ethaddress"`
+}
+
+type SetFeeRecipientByPubkeyRequest struct {
+	Ethaddress string `json:"ethaddress"`
+}
+
+type GetGasLimitResponse struct {
+	Data *GasLimitMetaData `json:"data"`
+}
+
+type GasLimitMetaData struct {
+	Pubkey   string `json:"pubkey"`
+	GasLimit string `json:"gas_limit"`
+}
+
+type SetGasLimitRequest struct {
+	GasLimit string `json:"gas_limit"`
+}
+
+type SetVoluntaryExitResponse struct {
+	Data *shared.SignedVoluntaryExit `json:"data"`
+}
@@ -1,9 +1,12 @@
 package rpc
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"path/filepath"
 	"testing"
 
@@ -14,7 +17,6 @@ import (
 	"github.com/prysmaticlabs/prysm/v4/crypto/bls"
 	"github.com/prysmaticlabs/prysm/v4/crypto/rand"
 	"github.com/prysmaticlabs/prysm/v4/io/file"
-	ethpbservice "github.com/prysmaticlabs/prysm/v4/proto/eth/service"
 	pb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1/validator-client"
 	"github.com/prysmaticlabs/prysm/v4/testing/assert"
 	"github.com/prysmaticlabs/prysm/v4/testing/require"
@@ -76,7 +78,7 @@ func TestServer_CreateWallet_Local(t *testing.T) {
 		passwords[i] = password
 	}
 
-	importReq := &ethpbservice.ImportKeystoresRequest{
+	importReq := &ImportKeystoresRequest{
 		Keystores: encodedKeystores,
 		Passwords: passwords,
 	}
@@ -103,8 +105,13 @@ func TestServer_CreateWallet_Local(t *testing.T) {
 		keystores[i] = string(encodedFile)
 	}
 	importReq.Keystores = keystores
-	_, err = s.ImportKeystores(ctx, importReq)
-	require.NoError(t, err)
+	var body bytes.Buffer
+	require.NoError(t, json.NewEncoder(&body).Encode(importReq))
+	request := httptest.NewRequest(http.MethodPost, "http://example.com/eth/v1/keystores", &body)
+	writer := httptest.NewRecorder()
+	writer.Body = &bytes.Buffer{}
+	s.ImportKeystores(writer, request)
+	require.Equal(t, http.StatusOK, writer.Code)
 }
 
 func TestServer_CreateWallet_Local_PasswordTooWeak(t *testing.T) {
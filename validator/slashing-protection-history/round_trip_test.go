@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/prysmaticlabs/prysm/v4/config/features"
 	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/v4/testing/assert"
 	"github.com/prysmaticlabs/prysm/v4/testing/require"
@@ -138,6 +139,60 @@ func TestImportExport_RoundTrip_SkippedAttestationEpochs(t *testing.T) {
 	require.DeepEqual(t, wanted.Data, eipStandard.Data)
 }
 
+func TestImportExport_RoundTrip_MinimalSlashingProtection(t *testing.T) {
+	resetCfg := features.InitWithReset(&features.Flags{EnableMinimalSlashingProtection: true})
+	defer resetCfg()
+	ctx := context.Background()
+	pubKeys, err := slashtest.CreateRandomPubKeys(1)
+	require.NoError(t, err)
+	validatorDB := dbtest.SetupDB(t, pubKeys)
+	imported := &format.EIPSlashingProtectionFormat{
+		Metadata: struct {
+			InterchangeFormatVersion string `json:"interchange_format_version"`
+			GenesisValidatorsRoot    string `json:"genesis_validators_root"`
+		}{
+			InterchangeFormatVersion: format.InterchangeFormatVersion,
+			GenesisValidatorsRoot:    fmt.Sprintf("%#x", [32]byte{1}),
+		},
+		Data: []*format.ProtectionData{
+			{
+				Pubkey: fmt.Sprintf("%#x", pubKeys[0]),
+				SignedAttestations: []*format.SignedAttestation{
+					{SourceEpoch: "1", TargetEpoch: "2", SigningRoot: fmt.Sprintf("%#x", [32]byte{1})},
+					{SourceEpoch: "8", TargetEpoch: "9", SigningRoot: fmt.Sprintf("%#x", [32]byte{2})},
+				},
+				SignedBlocks: []*format.SignedBlock{
+					{Slot: "3", SigningRoot: fmt.Sprintf("%#x", [32]byte{3})},
+					{Slot: "5", SigningRoot: fmt.Sprintf("%#x", [32]byte{4})},
+				},
+			},
+		},
+	}
+	blob, err := json.Marshal(imported)
+	require.NoError(t, err)
+	require.NoError(t, history.ImportStandardProtectionJSON(ctx, validatorDB, bytes.NewBuffer(blob)))
+
+	// Only the low watermarks are exported, without signing roots.
+	exported, err := history.ExportStandardProtectionJSON(ctx, validatorDB)
+	require.NoError(t, err)
+	require.Equal(t, imported.Metadata, exported.Metadata)
+	require.DeepEqual(t, []*format.ProtectionData{
+		{
+			Pubkey:             fmt.Sprintf("%#x", pubKeys[0]),
+			SignedAttestations: []*format.SignedAttestation{{SourceEpoch: "8", TargetEpoch: "9"}},
+			SignedBlocks:       []*format.SignedBlock{{Slot: "5"}},
+		},
+	}, exported.Data)
+
+	// Importing the export again is a no-op and does not flag the key as slashable.
+	blob, err = json.Marshal(exported)
+	require.NoError(t, err)
+	require.NoError(t, history.ImportStandardProtectionJSON(ctx, validatorDB, bytes.NewBuffer(blob)))
+	slashable, err := validatorDB.EIPImportBlacklistedPublicKeys(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 0, len(slashable))
+}
+
 func TestImportExport_FilterKeys(t *testing.T) {
 	ctx := context.Background()
 	numValidators := 10